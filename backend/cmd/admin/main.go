@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"log"
 	"net"
 	"os"
@@ -35,8 +36,15 @@ func main() {
 		log.Fatalf("Failed to connect to MongoDB: %v", err)
 	}
 
+	// Transport security (TLS / mTLS, or plaintext when explicitly allowed)
+	serverCreds, err := shared.ServerCredentials(cfg.GRPC.TLS)
+	if err != nil {
+		log.Fatalf("Failed to configure gRPC transport security: %v", err)
+	}
+
 	// 3. Create gRPC Server
 	grpcServer := grpc.NewServer(
+		grpc.Creds(serverCreds),
 		grpc.MaxRecvMsgSize(cfg.GRPC.MaxRecvMsgSize),
 		grpc.MaxSendMsgSize(cfg.GRPC.MaxSendMsgSize),
 	)
@@ -46,6 +54,34 @@ func main() {
 	adminService := admin.NewAdminService(client, db, cfg)
 	pb.RegisterAdminServiceServer(grpcServer, adminService)
 
+	// Emails stored before normalization: report case-only duplicates, lowercase the rest
+	if dupes, err := adminService.FindDuplicateEmails(context.Background()); err != nil {
+		log.Printf("Warning: %v", err)
+	} else {
+		for _, d := range dupes {
+			log.Printf("Warning: accounts %v share the email %q ignoring case", d.UserIDs, d.Email)
+		}
+		if n, err := adminService.NormalizeStoredEmails(context.Background(), dupes); err != nil {
+			log.Printf("Warning: %v", err)
+		} else if n > 0 {
+			log.Printf("Normalized %d stored emails", n)
+		}
+	}
+
+	// Indexes back GetResourceHistory and enforce case-insensitive unique emails
+	if err := adminService.EnsureIndexes(context.Background()); err != nil {
+		log.Printf("Warning: %v", err)
+	}
+
+	// Defaults for config keys a fresh deployment has not set; existing values are kept
+	created, err := shared.EnsureDefaultConfig(context.Background(), db)
+	if err != nil {
+		log.Printf("Warning: %v", err)
+	}
+	for _, key := range created {
+		log.Printf("Created default system config %s", key)
+	}
+
 	// 5. Register Health Check
 	healthServer := health.NewServer()
 	grpc_health_v1.RegisterHealthServer(grpcServer, healthServer)
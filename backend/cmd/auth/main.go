@@ -36,15 +36,23 @@ func main() {
 		log.Fatalf("Failed to connect to MongoDB: %v", err)
 	}
 
-	// 3. Create gRPC Server
+	// 3. Initialize Auth Service
+	// We pass the full config to access Security settings (JWT Secret, BCrypt cost)
+	authService := auth.NewAuthService(db, cfg)
+
+	// Transport security (TLS / mTLS, or plaintext when explicitly allowed)
+	serverCreds, err := shared.ServerCredentials(cfg.GRPC.TLS)
+	if err != nil {
+		log.Fatalf("Failed to configure gRPC transport security: %v", err)
+	}
+
+	// 4. Create gRPC Server (interceptor gates the admin-only session RPCs)
 	grpcServer := grpc.NewServer(
+		grpc.Creds(serverCreds),
 		grpc.MaxRecvMsgSize(cfg.GRPC.MaxRecvMsgSize),
 		grpc.MaxSendMsgSize(cfg.GRPC.MaxSendMsgSize),
+		grpc.UnaryInterceptor(authService.UnaryInterceptor()),
 	)
-
-	// 4. Initialize Auth Service
-	// We pass the full config to access Security settings (JWT Secret, BCrypt cost)
-	authService := auth.NewAuthService(db, cfg)
 	pb.RegisterAuthServiceServer(grpcServer, authService)
 
 	// 5. Register Health Server
@@ -61,7 +69,23 @@ func main() {
 		log.Fatalf("Failed to listen on port %s: %v", cfg.ServicePort, err)
 	}
 
-	// 8. Graceful Shutdown
+	// 8. Reload JWT keys on SIGHUP so secrets can be rotated without a restart
+	reload := make(chan os.Signal, 1)
+	signal.Notify(reload, syscall.SIGHUP)
+	go func() {
+		for range reload {
+			if err := shared.ReloadEnv(".env"); err != nil {
+				log.Printf("Warning: could not re-read .env, using current environment: %v", err)
+			}
+			if err := authService.SetJWTKeys(shared.LoadJWTKeys()); err != nil {
+				log.Printf("Failed to reload JWT keys, keeping current set: %v", err)
+				continue
+			}
+			log.Println("JWT keys reloaded")
+		}
+	}()
+
+	// 9. Graceful Shutdown
 	go func() {
 		log.Printf("Auth Service is listening on port %s", cfg.ServicePort)
 		if err := grpcServer.Serve(listener); err != nil {
@@ -0,0 +1,86 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"stdiscm_p4/backend/internal/consistency"
+	"stdiscm_p4/backend/internal/shared"
+)
+
+// Exit codes, so cron can tell a dirty database from a failed run
+const (
+	exitClean      = 0
+	exitViolations = 1
+	exitError      = 2
+)
+
+func main() {
+	fix := flag.Bool("fix", false, "repair safe cases: remove cart entries for missing courses, flag orphaned grades")
+	collection := flag.String("collection", "", "comma-separated collections to scan (default: all)")
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "Usage: consistency [--fix] [--collection users,courses,...]\n\n")
+		fmt.Fprintf(flag.CommandLine.Output(), "Scans for broken references between users, courses, enrollments, grades,\n")
+		fmt.Fprintf(flag.CommandLine.Output(), "prerequisites and carts. Exits %d when clean, %d when violations were found\n", exitClean, exitViolations)
+		fmt.Fprintf(flag.CommandLine.Output(), "(including ones repaired by --fix), and %d on error.\n\n", exitError)
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	selected, err := consistency.ParseCollections(*collection)
+	if err != nil {
+		log.Printf("ERROR: %v", err)
+		os.Exit(exitError)
+	}
+
+	if err := shared.LoadEnv(".env"); err != nil {
+		log.Println("Warning: .env file not found, using system environment variables")
+	}
+	cfg, err := shared.LoadServiceConfig("consistency")
+	if err != nil {
+		log.Printf("ERROR: Failed to load configuration: %v", err)
+		os.Exit(exitError)
+	}
+	client, db, err := shared.ConnectMongoDB(&cfg.MongoDB)
+	if err != nil {
+		log.Printf("ERROR: Failed to connect to MongoDB: %v", err)
+		os.Exit(exitError)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	checker := consistency.NewChecker(db, selected, *fix)
+	summaries, runErr := checker.Run(ctx,
+		func(s consistency.Summary) {
+			fmt.Printf("\n== %s/%s: %s\n", s.Collection, s.Category, s.Description)
+		},
+		func(v consistency.Violation) {
+			suffix := ""
+			if v.Repaired {
+				suffix = " [repaired]"
+			}
+			fmt.Printf("  %s: %s%s\n", v.DocumentID, v.Detail, suffix)
+		},
+	)
+	shared.DisconnectMongoDB(client)
+
+	fmt.Printf("\n%-40s %8s %8s\n", "CATEGORY", "FOUND", "REPAIRED")
+	for _, s := range summaries {
+		fmt.Printf("%-40s %8d %8d\n", s.Collection+"/"+s.Category, s.Found, s.Repaired)
+	}
+
+	if runErr != nil {
+		log.Printf("ERROR: Scan aborted: %v", runErr)
+		os.Exit(exitError)
+	}
+	if consistency.Total(summaries) > 0 {
+		os.Exit(exitViolations)
+	}
+	os.Exit(exitClean)
+}
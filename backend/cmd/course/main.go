@@ -6,11 +6,13 @@
 package main
 
 import (
+	"context"
 	"log"
 	"net"
 	"os"
 	"os/signal"
 	"syscall"
+	"time"
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/health"
@@ -22,6 +24,9 @@ import (
 	"stdiscm_p4/backend/internal/shared"
 )
 
+// catalogStatsInterval is how often the catalog cache hit rate is logged
+const catalogStatsInterval = 5 * time.Minute
+
 func main() {
 	// Load environment variables
 	if err := shared.LoadEnv(".env"); err != nil {
@@ -59,8 +64,15 @@ func main() {
 		}
 	}()
 
+	// Transport security (TLS / mTLS, or plaintext when explicitly allowed)
+	serverCreds, err := shared.ServerCredentials(config.GRPC.TLS)
+	if err != nil {
+		log.Fatalf("Failed to configure gRPC transport security: %v", err)
+	}
+
 	// Create gRPC server with configuration
 	grpcServer := grpc.NewServer(
+		grpc.Creds(serverCreds),
 		grpc.MaxRecvMsgSize(config.GRPC.MaxRecvMsgSize),
 		grpc.MaxSendMsgSize(config.GRPC.MaxSendMsgSize),
 	)
@@ -69,6 +81,39 @@ func main() {
 	courseService := course.NewCourseService(db)
 	pb.RegisterCourseServiceServer(grpcServer, courseService)
 
+	// Text index backs full-text course search; ListCourses falls back to regex without it
+	if err := courseService.EnsureIndexes(context.Background()); err != nil {
+		log.Printf("Warning: %v", err)
+	}
+
+	// Courses stored before departments existed would otherwise drop out of department filters
+	if n, err := courseService.BackfillDepartments(context.Background()); err != nil {
+		log.Printf("Warning: %v", err)
+	} else if n > 0 {
+		log.Printf("Backfilled departments on %d courses", n)
+	}
+
+	// Likewise for the parsed schedules behind the day and time filters
+	if n, err := courseService.BackfillScheduleSlots(context.Background()); err != nil {
+		log.Printf("Warning: %v", err)
+	} else if n > 0 {
+		log.Printf("Backfilled schedule slots on %d courses", n)
+	}
+
+	// Catalog cache hit rate, logged while there is catalog traffic
+	go func() {
+		var lastHits, lastMisses uint64
+		for range time.Tick(catalogStatsInterval) {
+			hits, misses := courseService.CatalogCacheStats()
+			dh, dm := hits-lastHits, misses-lastMisses
+			lastHits, lastMisses = hits, misses
+			if dh+dm > 0 {
+				log.Printf("Catalog cache: %.1f%% hit rate (%d hits, %d misses in the last %s)",
+					100*float64(dh)/float64(dh+dm), dh, dm, catalogStatsInterval)
+			}
+		}
+	}()
+
 	// Register health check service
 	healthServer := health.NewServer()
 	grpc_health_v1.RegisterHealthServer(grpcServer, healthServer)
@@ -6,14 +6,15 @@
 package main
 
 import (
+	"context"
 	"log"
 	"net"
 	"os"
 	"os/signal"
 	"syscall"
+	"time"
 
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/health"
 	"google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/reflection"
@@ -61,9 +62,13 @@ func main() {
 	// Required for checking prerequisites and course details
 	// ========================================================================
 	courseServiceAddr := shared.GetEnv("COURSE_SERVICE_ADDR", "localhost:50052")
+	clientCreds, err := shared.ClientCredentials(config.GRPC.TLS)
+	if err != nil {
+		log.Fatalf("Failed to configure gRPC transport security: %v", err)
+	}
 	courseConn, err := grpc.NewClient(
 		courseServiceAddr,
-		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithTransportCredentials(clientCreds),
 	)
 	if err != nil {
 		log.Fatalf("Failed to connect to Course Service: %v", err)
@@ -71,8 +76,15 @@ func main() {
 	defer courseConn.Close()
 	courseClient := pb_course.NewCourseServiceClient(courseConn)
 
+	// Transport security (TLS / mTLS, or plaintext when explicitly allowed)
+	serverCreds, err := shared.ServerCredentials(config.GRPC.TLS)
+	if err != nil {
+		log.Fatalf("Failed to configure gRPC transport security: %v", err)
+	}
+
 	// Create gRPC server
 	grpcServer := grpc.NewServer(
+		grpc.Creds(serverCreds),
 		grpc.MaxRecvMsgSize(config.GRPC.MaxRecvMsgSize),
 		grpc.MaxSendMsgSize(config.GRPC.MaxSendMsgSize),
 	)
@@ -82,6 +94,14 @@ func main() {
 	enrollmentService := enrollment.NewEnrollmentService(mongoClient, db, courseClient)
 	pb.RegisterEnrollmentServiceServer(grpcServer, enrollmentService)
 
+	if err := enrollmentService.EnsureIndexes(context.Background()); err != nil {
+		log.Printf("Warning: %v", err)
+	}
+
+	// Stale carts are also expired lazily on read; this keeps them out of storage
+	cleanupCtx, stopCleanup := context.WithCancel(context.Background())
+	go enrollmentService.RunCartCleanup(cleanupCtx, time.Hour)
+
 	// Register health check service
 	healthServer := health.NewServer()
 	grpc_health_v1.RegisterHealthServer(grpcServer, healthServer)
@@ -110,6 +130,7 @@ func main() {
 	<-quit
 
 	log.Println("Shutting down Enrollment Service...")
+	stopCleanup()
 	healthServer.SetServingStatus("enrollment.EnrollmentService", grpc_health_v1.HealthCheckResponse_NOT_SERVING)
 	grpcServer.GracefulStop()
 	log.Println("Enrollment Service stopped")
@@ -6,6 +6,7 @@ import (
 	"os"
 	"os/signal"
 	"stdiscm_p4/backend/internal/gateway"
+	"stdiscm_p4/backend/internal/shared"
 	"syscall"
 	"time"
 )
@@ -19,16 +20,24 @@ func main() {
 	defer serviceClients.Close()
 
 	// 2. Setup Routes and Middleware
-	router := gateway.SetupRoutes(serviceClients)
+	router, err := gateway.SetupRoutes(serviceClients)
+	if err != nil {
+		serviceClients.Close()
+		log.Fatalf("FATAL: %v", err)
+	}
 
 	// 3. Configure Server
+	// Route deadlines bound each request; the server timeouts only need to outlast
+	// the longest of them so slow uploads and reports are not cut off mid-request
 	port := gateway.GetEnv("PORT", "8080")
+	longest := shared.LoadGatewayTimeouts().Longest()
 	server := &http.Server{
-		Addr:         ":" + port,
-		Handler:      router,
-		ReadTimeout:  15 * time.Second,
-		WriteTimeout: 15 * time.Second,
-		IdleTimeout:  60 * time.Second,
+		Addr:              ":" + port,
+		Handler:           router,
+		ReadHeaderTimeout: 15 * time.Second,
+		ReadTimeout:       longest,
+		WriteTimeout:      longest + 5*time.Second,
+		IdleTimeout:       60 * time.Second,
 	}
 
 	// 4. Start Server in a Goroutine
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"log"
 	"net"
 	"os"
@@ -36,8 +37,15 @@ func main() {
 		log.Fatalf("Failed to connect to MongoDB: %v", err)
 	}
 
+	// Transport security (TLS / mTLS, or plaintext when explicitly allowed)
+	serverCreds, err := shared.ServerCredentials(cfg.GRPC.TLS)
+	if err != nil {
+		log.Fatalf("Failed to configure gRPC transport security: %v", err)
+	}
+
 	// 3. Create gRPC Server with config
 	grpcServer := grpc.NewServer(
+		grpc.Creds(serverCreds),
 		grpc.MaxRecvMsgSize(cfg.GRPC.MaxRecvMsgSize),
 		grpc.MaxSendMsgSize(cfg.GRPC.MaxSendMsgSize),
 	)
@@ -46,6 +54,17 @@ func main() {
 	gradeService := grade.NewGradeService(db)
 	pb.RegisterGradeServiceServer(grpcServer, gradeService)
 
+	if err := gradeService.EnsureIndexes(context.Background()); err != nil {
+		log.Printf("Warning: %v", err)
+	}
+
+	// Grades stored before term keys existed would otherwise sort last
+	if n, err := gradeService.BackfillTermKeys(context.Background()); err != nil {
+		log.Printf("Warning: %v", err)
+	} else if n > 0 {
+		log.Printf("Backfilled term keys on %d grades", n)
+	}
+
 	// 5. Register Health Check
 	healthServer := health.NewServer()
 	grpc_health_v1.RegisterHealthServer(grpcServer, healthServer)
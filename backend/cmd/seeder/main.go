@@ -91,7 +91,8 @@ func main() {
 	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
 	defer cancel()
 
-	// --- 1. Seed Users ---
+	// --- 1. Seed Departments and Users ---
+	seedDepartments(ctx, db)
 	seedUsers(ctx, db)
 
 	// --- 2. Seed Courses and Prerequisites ---
@@ -137,6 +138,24 @@ func main() {
 // SEEDING FUNCTIONS
 // ============================================================================
 
+func seedDepartments(ctx context.Context, db *mongo.Database) {
+	log.Println("--- Seeding Departments ---")
+	departmentsCol := db.Collection("departments")
+
+	departments := []shared.Department{
+		{Code: "CS", Name: "Computer Science", CreatedAt: time.Now()},
+		{Code: "MATH", Name: "Mathematics", CreatedAt: time.Now()},
+		{Code: "HIS", Name: "History", CreatedAt: time.Now()},
+		{Code: "IS", Name: "Information Systems", CreatedAt: time.Now()},
+	}
+	for _, d := range departments {
+		if _, err := departmentsCol.InsertOne(ctx, d); err != nil {
+			log.Fatalf("Error seeding department %s: %v", d.Code, err)
+		}
+		log.Printf("Seeded Department: %s (%s)", d.Code, d.Name)
+	}
+}
+
 func seedUsers(ctx context.Context, db *mongo.Database) {
 	log.Println("--- Seeding Users ---")
 	usersCol := db.Collection("users")
@@ -188,7 +207,10 @@ func seedCourses(ctx context.Context, db *mongo.Database, seeds []CourseSeed) ma
 			IsOpen:    s.IsOpen,
 			Semester:  s.Semester,
 			CreatedAt: now,
-			UpdatedAt: now,
+			// Department is parsed from the code, as admin course creation does
+			Department:   shared.DepartmentFromCode(s.Code),
+			ScheduleSlot: shared.NewScheduleSlot(s.Schedule),
+			UpdatedAt:    now,
 		}
 
 		_, err := coursesCol.InsertOne(ctx, course)
@@ -281,6 +303,7 @@ func seedEnrollmentsAndGrades(ctx context.Context, db *mongo.Database, seeds []E
 				"course_title": course.Title,
 				"units":        course.Units,
 				"semester":     course.Semester,
+				"term_key":     shared.SemesterSortKey(course.Semester),
 			}
 
 			_, err := gradesCol.InsertOne(ctx, gradeDoc)
@@ -320,9 +343,9 @@ func seedSystemConfig(ctx context.Context, db *mongo.Database) {
 func updateCourseEnrollmentCounts(ctx context.Context, db *mongo.Database) {
 	log.Println("--- Updating Course Enrollment Counts ---")
 
-	// Aggregation pipeline to count active enrollments per course
+	// Aggregation pipeline to count seat-holding enrollments per course
 	pipeline := []bson.M{
-		{"$match": bson.M{"status": shared.StatusEnrolled}},
+		{"$match": shared.SeatHoldingFilter()},
 		{"$group": bson.M{
 			"_id":   "$course_id",
 			"count": bson.M{"$sum": 1},
@@ -5,6 +5,11 @@ import (
 	"crypto/rand"
 	"encoding/base64"
 	"fmt"
+	"log"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
 	"time"
 
 	"go.mongodb.org/mongo-driver/bson"
@@ -14,36 +19,72 @@ import (
 	"golang.org/x/crypto/bcrypt"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
-	"google.golang.org/protobuf/types/known/timestamppb"
 
 	pb "stdiscm_p4/backend/internal/pb/admin"
 	"stdiscm_p4/backend/internal/shared"
 )
 
+// maxBulkCourses caps the rows accepted by a single BulkCreateCourses call
+const maxBulkCourses = 1000
+
+// maxTrendBuckets caps the buckets GetEnrollmentTrend returns (about 3 months hourly)
+const maxTrendBuckets = 24 * 92
+
+// Page sizes for GetResourceHistory
+const (
+	defaultHistoryPageSize = 100
+	maxHistoryPageSize     = 500
+)
+
+// trendIntervals maps GetEnrollmentTrend intervals to their bucket width
+var trendIntervals = map[string]time.Duration{"hour": time.Hour, "day": 24 * time.Hour}
+
 // AdminService implements the gRPC AdminService
 type AdminService struct {
 	pb.UnimplementedAdminServiceServer
-	client          *mongo.Client
-	db              *mongo.Database
-	config          *shared.ServiceConfig
-	coursesCol      *mongo.Collection
-	usersCol        *mongo.Collection
-	systemConfigCol *mongo.Collection
-	enrollmentsCol  *mongo.Collection
-	auditLogsCol    *mongo.Collection
+	client             *mongo.Client
+	db                 *mongo.Database
+	config             *shared.ServiceConfig
+	coursesCol         *mongo.Collection
+	usersCol           *mongo.Collection
+	systemConfigCol    *mongo.Collection
+	enrollmentsCol     *mongo.Collection
+	prerequisitesCol   *mongo.Collection
+	auditLogsCol       *mongo.Collection
+	transferCreditsCol *mongo.Collection
+	holdsCol           *mongo.Collection
+	eventsCol          *mongo.Collection
+	departmentsCol     *mongo.Collection
+	closuresCol        *mongo.Collection
+	cartsCol           *mongo.Collection
+	seatHoldsCol       *mongo.Collection
+	notificationsCol   *mongo.Collection
+	gradesCol          *mongo.Collection
+	sessionsCol        *mongo.Collection
 }
 
 // NewAdminService creates a new AdminService instance
 func NewAdminService(client *mongo.Client, db *mongo.Database, config *shared.ServiceConfig) *AdminService {
 	return &AdminService{
-		client:          client,
-		db:              db,
-		config:          config,
-		coursesCol:      db.Collection("courses"),
-		usersCol:        db.Collection("users"),
-		systemConfigCol: db.Collection("system_config"),
-		enrollmentsCol:  db.Collection("enrollments"),
-		auditLogsCol:    db.Collection("audit_logs"),
+		client:             client,
+		db:                 db,
+		config:             config,
+		coursesCol:         db.Collection("courses"),
+		usersCol:           db.Collection("users"),
+		systemConfigCol:    db.Collection("system_config"),
+		enrollmentsCol:     db.Collection("enrollments"),
+		prerequisitesCol:   db.Collection("prerequisites"),
+		auditLogsCol:       db.Collection("audit_logs"),
+		transferCreditsCol: db.Collection("transfer_credits"),
+		holdsCol:           db.Collection("holds"),
+		eventsCol:          db.Collection("enrollment_events"),
+		departmentsCol:     db.Collection("departments"),
+		closuresCol:        db.Collection("semester_closures"),
+		cartsCol:           db.Collection("carts"),
+		seatHoldsCol:       db.Collection("seat_holds"),
+		notificationsCol:   db.Collection("notifications"),
+		gradesCol:          db.Collection("grades"),
+		sessionsCol:        db.Collection("sessions"),
 	}
 }
 
@@ -55,17 +96,35 @@ func (s *AdminService) CreateCourse(ctx context.Context, req *pb.CreateCourseReq
 	if req == nil || req.Code == "" || req.Title == "" || req.Semester == "" {
 		return nil, status.Error(codes.InvalidArgument, "code, title, and semester are required")
 	}
+	if err := validateCourseText(req.Title, req.Description); err != nil {
+		return nil, err
+	}
 
-	if req.Units < 1 || req.Units > 5 {
-		return &pb.CreateCourseResponse{Success: false, Message: "units must be between 1 and 5"}, nil
+	if msg := validateCourseLimits(req.Units, req.Capacity, req.MinYearLevel); msg != "" {
+		return &pb.CreateCourseResponse{Success: false, Message: msg}, nil
+	}
+	if msg := validateCourseSchedule(req.Schedule, req.Unscheduled); msg != "" {
+		return &pb.CreateCourseResponse{Success: false, Message: msg}, nil
 	}
-	if req.Capacity < 5 || req.Capacity > 100 {
-		return &pb.CreateCourseResponse{Success: false, Message: "capacity must be between 5 and 100"}, nil
+	if msg := validateCourseWindow(req.EnrollOpenAt, req.EnrollCloseAt); msg != "" {
+		return &pb.CreateCourseResponse{Success: false, Message: msg}, nil
 	}
+	allowedMajors := normalizeMajors(req.AllowedMajors)
 
 	queryCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
 	defer cancel()
 
+	if req.Department != "" {
+		code, msg, err := s.resolveCourseDepartment(queryCtx, req.Department)
+		if err != nil {
+			return nil, err
+		}
+		if msg != "" {
+			return &pb.CreateCourseResponse{Success: false, Message: msg}, nil
+		}
+		req.Department = code
+	}
+
 	// Check duplicates
 	count, err := s.coursesCol.CountDocuments(queryCtx, bson.M{"code": req.Code, "semester": req.Semester})
 	if err != nil {
@@ -78,39 +137,42 @@ func (s *AdminService) CreateCourse(ctx context.Context, req *pb.CreateCourseReq
 	// Verify faculty
 	if req.FacultyId != "" {
 		if err := s.verifyFaculty(queryCtx, req.FacultyId); err != nil {
-			return &pb.CreateCourseResponse{Success: false, Message: "faculty not found"}, nil
+			return nil, err
 		}
 	}
 
-	// Use Shared ID generation (Course Code as prefix is fine, but using ID directly is safer)
-	courseID := req.Code // Using Code as ID as per original intent, or generate unique?
-	// Original code: courseID := generateCourseID(req.Code). Let's use shared.
-	courseID = shared.GenerateID(req.Code)
-
-	courseDoc := bson.M{
-		"_id":         courseID,
-		"code":        req.Code,
-		"title":       req.Title,
-		"description": req.Description,
-		"units":       req.Units,
-		"schedule":    req.Schedule,
-		"room":        req.Room,
-		"capacity":    req.Capacity,
-		"enrolled":    0,
-		"faculty_id":  req.FacultyId,
-		"is_open":     false,
-		"semester":    req.Semester,
-		"created_at":  primitive.NewDateTimeFromTime(time.Now()),
-		"updated_at":  primitive.NewDateTimeFromTime(time.Now()),
+	def := &pb.CourseDefinition{
+		Code: req.Code, Title: req.Title, Description: req.Description, Units: req.Units,
+		Schedule: req.Schedule, Room: req.Room, Capacity: req.Capacity, FacultyId: req.FacultyId,
+		Semester: req.Semester, AllowedMajors: allowedMajors, MinYearLevel: req.MinYearLevel,
+		Department: req.Department, EnrollOpenAt: req.EnrollOpenAt, EnrollCloseAt: req.EnrollCloseAt,
 	}
 
-	_, err = s.coursesCol.InsertOne(queryCtx, courseDoc)
+	// The course and its audit entry are written together or not at all; a
+	// department parsed from the code is registered with them. A taken ID rolls
+	// the transaction back and it runs again under a new one.
+	var courseDoc bson.M
+	courseID, err := shared.InsertWithCourseID(req.Code, req.Semester, func(courseID string) error {
+		courseDoc = newCourseDocument(courseID, def)
+		department, _ := courseDoc["department"].(string)
+		return shared.WithTransaction(queryCtx, s.client, func(sessCtx mongo.SessionContext) error {
+			if _, err := s.coursesCol.InsertOne(sessCtx, courseDoc); err != nil {
+				return err
+			}
+			if err := shared.RegisterDepartments(sessCtx, s.departmentsCol, department); err != nil {
+				return err
+			}
+			return shared.RecordAuditEvent(sessCtx, s.client, s.auditLogsCol, req.AdminId, shared.ActionCourseCreate, courseID, nil)
+		})
+	})
 	if err != nil {
 		return nil, status.Error(codes.Internal, "failed to create course")
 	}
+	s.courseCatalogChanged(queryCtx)
 
-	// Log Audit
-	shared.LogAuditEvent(queryCtx, s.auditLogsCol, "admin", shared.ActionCourseCreate, courseID, nil)
+	department, _ := courseDoc["department"].(string)
+	isOpen, _ := courseDoc["is_open"].(bool)
+	opensAt, closesAt, _ := shared.ParseCourseWindow(req.EnrollOpenAt, req.EnrollCloseAt)
 
 	return &pb.CreateCourseResponse{
 		Success:  true,
@@ -118,16 +180,182 @@ func (s *AdminService) CreateCourse(ctx context.Context, req *pb.CreateCourseReq
 		Course: &pb.Course{
 			Id: courseID, Code: req.Code, Title: req.Title, Description: req.Description,
 			Units: req.Units, Schedule: req.Schedule, Room: req.Room, Capacity: req.Capacity,
-			FacultyId: req.FacultyId, Semester: req.Semester, IsOpen: false,
+			FacultyId: req.FacultyId, Semester: req.Semester, IsOpen: isOpen,
+			AllowedMajors: allowedMajors, MinYearLevel: req.MinYearLevel,
+			SeatsAvailable: req.Capacity, IsFull: req.Capacity == 0,
+			Department:   department,
+			EnrollOpenAt: shared.ToProtoTime(opensAt), EnrollCloseAt: shared.ToProtoTime(closesAt),
 		},
 		Message: "course created successfully",
 	}, nil
 }
 
+// BulkCreateCourses creates many course offerings at once. Each row is validated
+// and created independently; prerequisites are linked in a second pass so rows
+// may reference courses defined later in the same batch.
+func (s *AdminService) BulkCreateCourses(ctx context.Context, req *pb.BulkCreateCoursesRequest) (*pb.BulkCreateCoursesResponse, error) {
+	if req == nil || len(req.Courses) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "at least one course is required")
+	}
+	if len(req.Courses) > maxBulkCourses {
+		return nil, status.Errorf(codes.InvalidArgument, "at most %d courses per import", maxBulkCourses)
+	}
+
+	queryCtx, cancel := context.WithTimeout(ctx, 60*time.Second)
+	defer cancel()
+
+	results := make([]*pb.BulkCourseResult, len(req.Courses))
+	created := make(map[string]string) // code|semester -> course ID, for rows created in this batch
+	seen := make(map[string]bool)
+	faculty := make(map[string]bool)
+	departments := make(map[string]string) // department as given -> code, "" when unknown
+
+	// Pass 1: validate and create each row
+	for i, def := range req.Courses {
+		def.Code = strings.TrimSpace(def.Code)
+		def.Semester = strings.TrimSpace(def.Semester)
+		result := &pb.BulkCourseResult{Row: int32(i + 1), Code: def.Code, Semester: def.Semester}
+		results[i] = result
+
+		key := courseKey(def.Code, def.Semester)
+		if def.Code == "" || def.Title == "" || def.Semester == "" {
+			result.Error = "code, title, and semester are required"
+			continue
+		}
+		if err := validateCourseText(def.Title, def.Description); err != nil {
+			result.Error = err.Error()
+			continue
+		}
+		if msg := validateCourseLimits(def.Units, def.Capacity, def.MinYearLevel); msg != "" {
+			result.Error = msg
+			continue
+		}
+		if msg := validateCourseSchedule(def.Schedule, def.Unscheduled); msg != "" {
+			result.Error = msg
+			continue
+		}
+		if msg := validateCourseWindow(def.EnrollOpenAt, def.EnrollCloseAt); msg != "" {
+			result.Error = msg
+			continue
+		}
+		if seen[key] {
+			result.Error = fmt.Sprintf("duplicate of an earlier row for %s in %s", def.Code, def.Semester)
+			continue
+		}
+		seen[key] = true
+
+		count, err := s.coursesCol.CountDocuments(queryCtx, bson.M{"code": def.Code, "semester": def.Semester})
+		if err != nil {
+			result.Error = "db error"
+			continue
+		}
+		if count > 0 {
+			result.Error = fmt.Sprintf("course %s already exists for %s", def.Code, def.Semester)
+			continue
+		}
+
+		if def.FacultyId != "" {
+			ok, checked := faculty[def.FacultyId]
+			if !checked {
+				ok = s.verifyFaculty(queryCtx, def.FacultyId) == nil
+				faculty[def.FacultyId] = ok
+			}
+			if !ok {
+				result.Error = "faculty not found"
+				continue
+			}
+		}
+
+		if def.Department != "" {
+			code, checked := departments[def.Department]
+			if !checked {
+				var err error
+				if code, _, err = s.resolveCourseDepartment(queryCtx, def.Department); err != nil {
+					result.Error = "db error"
+					continue
+				}
+				departments[def.Department] = code
+			}
+			if code == "" {
+				result.Error = fmt.Sprintf("unknown department %q", def.Department)
+				continue
+			}
+			def.Department = code
+		}
+
+		def.AllowedMajors = normalizeMajors(def.AllowedMajors)
+		// As in CreateCourse, the department is registered in the course's transaction
+		courseID, err := shared.InsertWithCourseID(def.Code, def.Semester, func(courseID string) error {
+			courseDoc := newCourseDocument(courseID, def)
+			department, _ := courseDoc["department"].(string)
+			return shared.WithTransaction(queryCtx, s.client, func(sessCtx mongo.SessionContext) error {
+				if _, err := s.coursesCol.InsertOne(sessCtx, courseDoc); err != nil {
+					return err
+				}
+				return shared.RegisterDepartments(sessCtx, s.departmentsCol, department)
+			})
+		})
+		if err != nil {
+			result.Error = "failed to create course"
+			continue
+		}
+
+		result.Success = true
+		result.CourseId = courseID
+		created[key] = courseID
+	}
+
+	// Pass 2: link prerequisites now that the whole batch exists
+	for i, def := range req.Courses {
+		result := results[i]
+		if !result.Success {
+			continue
+		}
+		for _, code := range def.PrerequisiteCodes {
+			code = strings.TrimSpace(code)
+			if code == "" {
+				continue
+			}
+			if err := s.linkPrerequisite(queryCtx, result.CourseId, code, def.Semester, created); err != nil {
+				result.PrerequisiteErrors = append(result.PrerequisiteErrors, fmt.Sprintf("%s: %v", code, err))
+			}
+		}
+	}
+
+	var createdCount, failedCount int32
+	for _, r := range results {
+		if r.Success {
+			createdCount++
+		} else {
+			failedCount++
+		}
+	}
+
+	shared.LogAuditEvent(queryCtx, s.auditLogsCol, req.AdminId, shared.ActionCourseCreate, "bulk_import", map[string]interface{}{
+		"rows":    len(req.Courses),
+		"created": createdCount,
+		"failed":  failedCount,
+	})
+	if createdCount > 0 {
+		s.courseCatalogChanged(queryCtx)
+	}
+
+	return &pb.BulkCreateCoursesResponse{
+		Success:      failedCount == 0,
+		Message:      fmt.Sprintf("created %d of %d courses", createdCount, len(req.Courses)),
+		CreatedCount: createdCount,
+		FailedCount:  failedCount,
+		Results:      results,
+	}, nil
+}
+
 func (s *AdminService) UpdateCourse(ctx context.Context, req *pb.UpdateCourseRequest) (*pb.UpdateCourseResponse, error) {
 	if req == nil || req.CourseId == "" {
 		return nil, status.Error(codes.InvalidArgument, "course_id is required")
 	}
+	if err := validateCourseText(req.Title, req.Description); err != nil {
+		return nil, err
+	}
 
 	queryCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
 	defer cancel()
@@ -136,7 +364,13 @@ func (s *AdminService) UpdateCourse(ctx context.Context, req *pb.UpdateCourseReq
 	var existingCourse bson.M
 	err := s.coursesCol.FindOne(queryCtx, bson.M{"_id": req.CourseId}).Decode(&existingCourse)
 	if err == mongo.ErrNoDocuments {
-		return &pb.UpdateCourseResponse{Success: false, Message: "course not found"}, nil
+		return nil, shared.ErrCourseNotFound.WithParam("course_id", req.CourseId)
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, "db error")
+	}
+	if cancelled, _ := shared.GetBool(existingCourse["cancelled"]); cancelled && req.IsOpen {
+		return &pb.UpdateCourseResponse{Success: false, Message: "a cancelled course cannot be reopened"}, nil
 	}
 
 	update := bson.M{}
@@ -149,12 +383,25 @@ func (s *AdminService) UpdateCourse(ctx context.Context, req *pb.UpdateCourseReq
 	if req.Units > 0 {
 		update["units"] = req.Units
 	}
-	if req.Schedule != "" {
+	if req.Schedule != "" || req.Unscheduled {
+		if msg := validateCourseSchedule(req.Schedule, req.Unscheduled); msg != "" {
+			return &pb.UpdateCourseResponse{Success: false, Message: msg}, nil
+		}
 		update["schedule"] = req.Schedule
 	}
 	if req.Room != "" {
 		update["room"] = req.Room
 	}
+	if req.Department != "" {
+		code, msg, err := s.resolveCourseDepartment(queryCtx, req.Department)
+		if err != nil {
+			return nil, err
+		}
+		if msg != "" {
+			return &pb.UpdateCourseResponse{Success: false, Message: msg}, nil
+		}
+		update["department"] = code
+	}
 
 	if req.Capacity > 0 {
 		currentEnrolled, _ := shared.GetInt32(existingCourse["enrolled"])
@@ -166,15 +413,65 @@ func (s *AdminService) UpdateCourse(ctx context.Context, req *pb.UpdateCourseReq
 
 	if req.FacultyId != "" {
 		if err := s.verifyFaculty(queryCtx, req.FacultyId); err != nil {
-			return &pb.UpdateCourseResponse{Success: false, Message: "faculty not found"}, nil
+			return nil, err
 		}
 		update["faculty_id"] = req.FacultyId
 	}
 
+	// The parsed slot follows the schedule so day and time filters see the change
+	unset := bson.M{}
+	if _, ok := update["schedule"]; ok {
+		if slot := shared.NewScheduleSlot(req.Schedule); slot != nil {
+			update["schedule_slot"] = slot
+		} else {
+			unset["schedule_slot"] = ""
+		}
+	}
+
+	// Restrictions are replaced as a unit so they can also be cleared
+	if req.UpdateRestrictions {
+		if req.MinYearLevel < 0 {
+			return &pb.UpdateCourseResponse{Success: false, Message: "min_year_level cannot be negative"}, nil
+		}
+		if majors := normalizeMajors(req.AllowedMajors); len(majors) > 0 {
+			update["allowed_majors"] = majors
+		} else {
+			unset["allowed_majors"] = ""
+		}
+		if req.MinYearLevel > 0 {
+			update["min_year_level"] = req.MinYearLevel
+		} else {
+			unset["min_year_level"] = ""
+		}
+	}
+
+	// The window is replaced as a unit so either bound can also be cleared
+	if req.UpdateEnrollWindow {
+		opensAt, closesAt, err := shared.ParseCourseWindow(req.EnrollOpenAt, req.EnrollCloseAt)
+		if err != nil {
+			return &pb.UpdateCourseResponse{Success: false, Message: err.Error()}, nil
+		}
+		if !opensAt.IsZero() {
+			update["enroll_open_at"] = primitive.NewDateTimeFromTime(opensAt)
+		} else {
+			unset["enroll_open_at"] = ""
+		}
+		if !closesAt.IsZero() {
+			update["enroll_close_at"] = primitive.NewDateTimeFromTime(closesAt)
+		} else {
+			unset["enroll_close_at"] = ""
+		}
+	}
+
 	update["is_open"] = req.IsOpen
 	update["updated_at"] = primitive.NewDateTimeFromTime(time.Now())
 
-	_, err = s.coursesCol.UpdateOne(queryCtx, bson.M{"_id": req.CourseId}, bson.M{"$set": update})
+	updateDoc := bson.M{"$set": update}
+	if len(unset) > 0 {
+		updateDoc["$unset"] = unset
+	}
+
+	_, err = s.coursesCol.UpdateOne(queryCtx, bson.M{"_id": req.CourseId}, updateDoc)
 	if err != nil {
 		return nil, status.Error(codes.Internal, "failed to update")
 	}
@@ -183,7 +480,8 @@ func (s *AdminService) UpdateCourse(ctx context.Context, req *pb.UpdateCourseReq
 	var updatedDoc bson.M
 	s.coursesCol.FindOne(queryCtx, bson.M{"_id": req.CourseId}).Decode(&updatedDoc)
 
-	shared.LogAuditEvent(queryCtx, s.auditLogsCol, "admin", shared.ActionCourseUpdate, req.CourseId, nil)
+	shared.LogAuditEvent(queryCtx, s.auditLogsCol, req.AdminId, shared.ActionCourseUpdate, req.CourseId, nil)
+	s.courseCatalogChanged(queryCtx)
 
 	return &pb.UpdateCourseResponse{
 		Success: true,
@@ -217,12 +515,142 @@ func (s *AdminService) DeleteCourse(ctx context.Context, req *pb.DeleteCourseReq
 		return nil, status.Error(codes.Internal, "failed to delete")
 	}
 	if res.DeletedCount == 0 {
-		return &pb.DeleteCourseResponse{Success: false, Message: "course not found"}, nil
+		return nil, shared.ErrCourseNotFound.WithParam("course_id", req.CourseId)
 	}
+	s.courseCatalogChanged(queryCtx)
 
 	return &pb.DeleteCourseResponse{Success: true, Message: "course deleted successfully"}, nil
 }
 
+// CancelCourse closes a course for good partway through enrollment. Everyone
+// enrolled is dropped (with drop_reason course_cancelled) and notified, and the
+// course is taken out of carts and seat holds, all in one transaction. Unlike
+// DeleteCourse the course and its enrollment history are kept.
+func (s *AdminService) CancelCourse(ctx context.Context, req *pb.CancelCourseRequest) (*pb.CancelCourseResponse, error) {
+	if req == nil || req.CourseId == "" {
+		return nil, status.Error(codes.InvalidArgument, "course_id required")
+	}
+	reason := strings.TrimSpace(req.Reason)
+	if err := shared.ValidateText("reason", reason, shared.MaxReasonLength, true); err != nil {
+		return nil, err
+	}
+
+	queryCtx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	var affected, cartsUpdated int32
+	var course shared.Course
+	err := shared.WithTransaction(queryCtx, s.client, func(sessCtx mongo.SessionContext) error {
+		affected, cartsUpdated = 0, 0
+
+		// 1. Mark the course cancelled; the filter makes a second cancel fail here
+		now := time.Now()
+		err := s.coursesCol.FindOneAndUpdate(sessCtx,
+			bson.M{"_id": req.CourseId, "cancelled": bson.M{"$ne": true}},
+			bson.M{"$set": bson.M{"is_open": false, "cancelled": true, "cancelled_at": now, "enrolled": 0, "updated_at": now}},
+		).Decode(&course)
+		if err == mongo.ErrNoDocuments {
+			count, cErr := s.coursesCol.CountDocuments(sessCtx, bson.M{"_id": req.CourseId})
+			if cErr != nil {
+				return cErr
+			}
+			if count == 0 {
+				return shared.ErrCourseNotFound.WithParam("course_id", req.CourseId)
+			}
+			return shared.ErrCourseCancelled.Newf("course %s has already been cancelled", req.CourseId).WithParam("course_id", req.CourseId)
+		}
+		if err != nil {
+			return err
+		}
+
+		// 2. Drop every active enrollment, remembering who to notify
+		cursor, err := s.enrollmentsCol.Find(sessCtx,
+			bson.M{"course_id": req.CourseId, "status": shared.StatusEnrolled},
+			options.Find().SetProjection(bson.M{"student_id": 1}),
+		)
+		if err != nil {
+			return err
+		}
+		var enrolled []struct {
+			StudentID string `bson:"student_id"`
+		}
+		if err := cursor.All(sessCtx, &enrolled); err != nil {
+			return err
+		}
+		if len(enrolled) > 0 {
+			res, err := s.enrollmentsCol.UpdateMany(sessCtx,
+				bson.M{"course_id": req.CourseId, "status": shared.StatusEnrolled},
+				bson.M{"$set": bson.M{"status": shared.StatusDropped, "dropped_at": now, "drop_reason": shared.DropReasonCourseCancelled}},
+			)
+			if err != nil {
+				return err
+			}
+			affected = int32(res.ModifiedCount)
+			if err := shared.RecordEnrollmentEvent(sessCtx, s.client, s.eventsCol, req.CourseId, -affected, shared.EventSourceOverride); err != nil {
+				return err
+			}
+		}
+
+		// 3. Nobody can enroll from a cart or keep a seat held any more
+		res, err := s.cartsCol.UpdateMany(sessCtx,
+			bson.M{"course_ids": req.CourseId},
+			bson.M{"$pull": bson.M{"course_ids": req.CourseId}, "$unset": bson.M{"validation_results": ""}},
+		)
+		if err != nil {
+			return err
+		}
+		cartsUpdated = int32(res.ModifiedCount)
+		if _, err := s.seatHoldsCol.DeleteMany(sessCtx, bson.M{"course_id": req.CourseId}); err != nil {
+			return err
+		}
+
+		// 4. Tell each dropped student
+		message := fmt.Sprintf("%s (%s) has been cancelled and your enrollment was dropped", course.Code, course.Semester)
+		if reason != "" {
+			message += ": " + reason
+		}
+		notifications := make([]interface{}, 0, len(enrolled))
+		studentIDs := make([]string, 0, len(enrolled))
+		for _, e := range enrolled {
+			studentIDs = append(studentIDs, e.StudentID)
+			notifications = append(notifications, shared.Notification{
+				ID:        shared.GenerateNotificationID(),
+				UserID:    e.StudentID,
+				Type:      shared.NotificationCourseCancelled,
+				Message:   message,
+				CourseID:  req.CourseId,
+				CreatedAt: now,
+			})
+		}
+		if len(notifications) > 0 {
+			if _, err := s.notificationsCol.InsertMany(sessCtx, notifications); err != nil {
+				return err
+			}
+		}
+
+		return shared.RecordAuditEvent(sessCtx, s.client, s.auditLogsCol, req.AdminId, shared.ActionCourseCancel, req.CourseId, map[string]interface{}{
+			"reason":            reason,
+			"students_affected": affected,
+			"student_ids":       studentIDs,
+			"carts_updated":     cartsUpdated,
+		})
+	})
+	if err != nil {
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+		return nil, status.Errorf(codes.Internal, "failed to cancel course: %v", err)
+	}
+	s.courseCatalogChanged(queryCtx)
+
+	return &pb.CancelCourseResponse{
+		Success:          true,
+		Message:          fmt.Sprintf("cancelled %s: %d students dropped", course.Code, affected),
+		StudentsAffected: affected,
+		CartsUpdated:     cartsUpdated,
+	}, nil
+}
+
 func (s *AdminService) AssignFaculty(ctx context.Context, req *pb.AssignFacultyRequest) (*pb.AssignFacultyResponse, error) {
 	if req == nil || req.CourseId == "" || req.FacultyId == "" {
 		return nil, status.Error(codes.InvalidArgument, "args required")
@@ -232,7 +660,7 @@ func (s *AdminService) AssignFaculty(ctx context.Context, req *pb.AssignFacultyR
 	defer cancel()
 
 	if err := s.verifyFaculty(queryCtx, req.FacultyId); err != nil {
-		return &pb.AssignFacultyResponse{Success: false, Message: "faculty not found or inactive"}, nil
+		return nil, err
 	}
 
 	res, err := s.coursesCol.UpdateOne(queryCtx, bson.M{"_id": req.CourseId}, bson.M{
@@ -242,280 +670,2114 @@ func (s *AdminService) AssignFaculty(ctx context.Context, req *pb.AssignFacultyR
 		return nil, status.Error(codes.Internal, "db error")
 	}
 	if res.MatchedCount == 0 {
-		return &pb.AssignFacultyResponse{Success: false, Message: "course not found"}, nil
+		return nil, shared.ErrCourseNotFound.WithParam("course_id", req.CourseId)
 	}
 
+	s.courseCatalogChanged(queryCtx)
 	return &pb.AssignFacultyResponse{Success: true, Message: "faculty assigned successfully"}, nil
 }
 
-// ============================================================================
-// User Management
-// ============================================================================
-
-func (s *AdminService) CreateUser(ctx context.Context, req *pb.CreateUserRequest) (*pb.CreateUserResponse, error) {
-	if req.Email == "" || req.Role == "" || req.Name == "" {
-		return nil, status.Error(codes.InvalidArgument, "missing fields")
+// AddPrerequisite makes prereq_id a prerequisite of course_id. Both courses must
+// exist, and the prerequisite must not already depend on the course, directly or
+// through other prerequisites, since that would make neither course takeable.
+func (s *AdminService) AddPrerequisite(ctx context.Context, req *pb.AddPrerequisiteRequest) (*pb.AddPrerequisiteResponse, error) {
+	if req == nil || req.CourseId == "" || req.PrereqId == "" {
+		return nil, status.Error(codes.InvalidArgument, "course_id and prereq_id are required")
 	}
-	if !shared.IsValidRole(req.Role) {
-		return &pb.CreateUserResponse{Success: false, Message: "invalid role"}, nil
+	if req.CourseId == req.PrereqId {
+		return nil, status.Error(codes.InvalidArgument, "course cannot require itself")
 	}
 
 	queryCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
 	defer cancel()
 
-	// Check email
-	count, _ := s.usersCol.CountDocuments(queryCtx, bson.M{"email": req.Email})
-	if count > 0 {
-		return &pb.CreateUserResponse{Success: false, Message: "email exists"}, nil
+	for _, id := range []string{req.CourseId, req.PrereqId} {
+		count, err := s.coursesCol.CountDocuments(queryCtx, bson.M{"_id": id})
+		if err != nil {
+			return nil, status.Error(codes.Internal, "db error")
+		}
+		if count == 0 {
+			return nil, shared.ErrCourseNotFound.WithParam("course_id", id)
+		}
 	}
 
-	// Use Shared ID Gen
-	userID := shared.GenerateID(req.Role)
+	// The cycle check and the insert share a transaction. Every added link also bumps
+	// the catalog version, so two adds racing to close a cycle conflict on that
+	// write and the retried one sees the other's link.
+	link := bson.M{"course_id": req.CourseId, "prereq_id": req.PrereqId}
+	var added bool
+	err := shared.WithTransaction(queryCtx, s.client, func(sessCtx mongo.SessionContext) error {
+		cycle, err := s.prerequisiteDependsOn(sessCtx, req.PrereqId, req.CourseId)
+		if err != nil {
+			return status.Error(codes.Internal, "failed to check prerequisite chain")
+		}
+		if cycle {
+			return shared.ErrPrereqCycle.Newf("%s already requires %s", req.PrereqId, req.CourseId).
+				WithParam("course_id", req.CourseId).WithParam("prereq_id", req.PrereqId)
+		}
 
-	// Password
-	initPwd := s.generateRandomPassword()
-	hash, _ := bcrypt.GenerateFromPassword([]byte(initPwd), s.config.Security.BCryptCost)
+		res, err := s.prerequisitesCol.UpdateOne(sessCtx, link, bson.M{"$setOnInsert": link}, options.Update().SetUpsert(true))
+		if err != nil {
+			return err
+		}
+		added = res.UpsertedCount > 0
+		if !added {
+			return nil
+		}
+		if err := shared.BumpCatalogVersion(sessCtx, s.systemConfigCol); err != nil {
+			return err
+		}
+		return shared.RecordAuditEvent(sessCtx, s.client, s.auditLogsCol, req.AdminId, shared.ActionPrereqAdd, req.CourseId, map[string]interface{}{
+			"prereq_id": req.PrereqId,
+		})
+	})
+	if err != nil {
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+		log.Printf("Error adding prerequisite %s to %s: %v", req.PrereqId, req.CourseId, err)
+		return nil, status.Error(codes.Internal, "failed to add prerequisite")
+	}
 
-	userDoc := bson.M{
-		"_id": userID, "email": req.Email, "password_hash": string(hash),
-		"role": req.Role, "name": req.Name, "is_active": true,
-		"created_at": primitive.NewDateTimeFromTime(time.Now()),
+	message := "prerequisite already exists"
+	if added {
+		message = "prerequisite added"
 	}
+	return &pb.AddPrerequisiteResponse{Success: true, Message: message}, nil
+}
 
-	if req.Role == shared.RoleStudent {
-		if req.StudentId == "" {
-			req.StudentId = shared.GenerateID("STU")
-		} // Simplified ID gen
-		userDoc["student_id"] = req.StudentId
-		userDoc["major"] = req.Major
-		userDoc["year_level"] = req.YearLevel
-	} else if req.Role == shared.RoleFaculty {
-		if req.FacultyId == "" {
-			req.FacultyId = shared.GenerateID("FAC")
-		}
-		userDoc["faculty_id"] = req.FacultyId
-		userDoc["department"] = req.Department
+// RemovePrerequisite drops prereq_id from course_id's prerequisites
+func (s *AdminService) RemovePrerequisite(ctx context.Context, req *pb.RemovePrerequisiteRequest) (*pb.RemovePrerequisiteResponse, error) {
+	if req == nil || req.CourseId == "" || req.PrereqId == "" {
+		return nil, status.Error(codes.InvalidArgument, "course_id and prereq_id are required")
 	}
 
-	_, err := s.usersCol.InsertOne(queryCtx, userDoc)
+	queryCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	res, err := s.prerequisitesCol.DeleteMany(queryCtx, bson.M{"course_id": req.CourseId, "prereq_id": req.PrereqId})
 	if err != nil {
-		return nil, status.Error(codes.Internal, "failed to create user")
+		return nil, status.Error(codes.Internal, "failed to remove prerequisite")
+	}
+	if res.DeletedCount == 0 {
+		return nil, status.Error(codes.NotFound, "prerequisite not found")
 	}
 
-	shared.LogAuditEvent(queryCtx, s.auditLogsCol, "admin", shared.ActionUserCreate, userID, nil)
+	shared.LogAuditEvent(queryCtx, s.auditLogsCol, req.AdminId, shared.ActionPrereqRemove, req.CourseId, map[string]interface{}{
+		"prereq_id": req.PrereqId,
+	})
+	s.courseCatalogChanged(queryCtx)
 
-	// Map to proto (simplified)
-	return &pb.CreateUserResponse{
-		Success: true, UserId: userID, InitialPassword: initPwd,
-		Message: "user created",
-		User:    &pb.User{Id: userID, Email: req.Email, Name: req.Name, Role: req.Role, IsActive: true},
-	}, nil
+	return &pb.RemovePrerequisiteResponse{Success: true, Message: "prerequisite removed"}, nil
 }
 
-func (s *AdminService) ListUsers(ctx context.Context, req *pb.ListUsersRequest) (*pb.ListUsersResponse, error) {
-	queryCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
-	defer cancel()
-
-	filter := bson.M{}
-	if req.Role != "" {
-		filter["role"] = req.Role
+// ValidateCourseSchedule previews a schedule for course creation: it parses the
+// schedule and lists room/faculty conflicts it would create, without writing anything
+func (s *AdminService) ValidateCourseSchedule(ctx context.Context, req *pb.ValidateCourseScheduleRequest) (*pb.ValidateCourseScheduleResponse, error) {
+	if req == nil || strings.TrimSpace(req.Schedule) == "" || req.Semester == "" {
+		return nil, status.Error(codes.InvalidArgument, "schedule and semester are required")
 	}
-	if req.ActiveOnly {
-		filter["is_active"] = true
+
+	if err := shared.ValidateSchedule(req.Schedule); err != nil {
+		return &pb.ValidateCourseScheduleResponse{
+			Valid: false, ParseError: err.Error(), Message: "schedule could not be parsed",
+		}, nil
 	}
+	days, startTime, endTime := shared.ParseSchedule(req.Schedule)
+	resp := &pb.ValidateCourseScheduleResponse{Days: days, StartTime: startTime, EndTime: endTime}
 
-	cursor, err := s.usersCol.Find(queryCtx, filter, options.Find().SetLimit(100))
-	if err != nil {
-		return nil, err
+	// Only courses sharing the room or instructor can conflict
+	var or []bson.M
+	if req.Room != "" {
+		or = append(or, bson.M{"room": req.Room})
+	}
+	if req.FacultyId != "" {
+		or = append(or, bson.M{"faculty_id": req.FacultyId})
 	}
-	defer cursor.Close(queryCtx)
 
-	var users []*pb.User
-	for cursor.Next(queryCtx) {
-		var u shared.User
-		if err := cursor.Decode(&u); err == nil {
-			users = append(users, s.userToProto(&u))
+	if len(or) > 0 {
+		queryCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+		defer cancel()
+
+		cursor, err := s.coursesCol.Find(queryCtx, bson.M{"semester": req.Semester, "$or": or})
+		if err != nil {
+			return nil, status.Error(codes.Internal, "db error")
+		}
+		defer cursor.Close(queryCtx)
+
+		for cursor.Next(queryCtx) {
+			var course shared.Course
+			if err := cursor.Decode(&course); err != nil {
+				continue
+			}
+			if shared.ValidateSchedule(course.Schedule) != nil {
+				continue
+			}
+			cDays, cStart, cEnd := shared.ParseSchedule(course.Schedule)
+			if !shared.DaysOverlap(days, cDays) || !shared.TimesOverlap(startTime, endTime, cStart, cEnd) {
+				continue
+			}
+
+			if req.Room != "" && course.Room == req.Room {
+				resp.Conflicts = append(resp.Conflicts, &pb.ScheduleConflict{
+					CourseId: course.ID, CourseCode: course.Code, Schedule: course.Schedule,
+					ConflictType: "room",
+					Details:      fmt.Sprintf("Room %s is used by %s (%s)", course.Room, course.Code, course.Schedule),
+				})
+			}
+			if req.FacultyId != "" && course.FacultyID == req.FacultyId {
+				resp.Conflicts = append(resp.Conflicts, &pb.ScheduleConflict{
+					CourseId: course.ID, CourseCode: course.Code, Schedule: course.Schedule,
+					ConflictType: "faculty",
+					Details:      fmt.Sprintf("Instructor already teaches %s (%s)", course.Code, course.Schedule),
+				})
+			}
 		}
 	}
-	return &pb.ListUsersResponse{Users: users, TotalCount: int32(len(users))}, nil
+
+	resp.Valid = len(resp.Conflicts) == 0
+	resp.Message = "schedule is valid"
+	if !resp.Valid {
+		resp.Message = fmt.Sprintf("schedule has %d conflict(s)", len(resp.Conflicts))
+	}
+	return resp, nil
 }
 
-func (s *AdminService) ResetPassword(ctx context.Context, req *pb.ResetPasswordRequest) (*pb.ResetPasswordResponse, error) {
-	if req.UserId == "" {
-		return nil, status.Error(codes.InvalidArgument, "id required")
+// ============================================================================
+// Department Management
+// ============================================================================
+
+// CreateDepartment adds a department courses and faculty can be assigned to
+func (s *AdminService) CreateDepartment(ctx context.Context, req *pb.CreateDepartmentRequest) (*pb.CreateDepartmentResponse, error) {
+	code := shared.NormalizeDepartment(req.Code)
+	name := strings.TrimSpace(req.Name)
+	if code == "" || name == "" {
+		return nil, status.Error(codes.InvalidArgument, "code and name are required")
+	}
+	if !shared.IsValidDepartmentCode(code) {
+		return &pb.CreateDepartmentResponse{Success: false, Message: "department code must be 1 to 10 letters"}, nil
+	}
+	if err := shared.ValidateText("name", name, shared.MaxNameLength, false); err != nil {
+		return nil, err
 	}
 
-	queryCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	queryCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
 	defer cancel()
 
-	newPwd := s.generateRandomPassword()
-	hash, _ := bcrypt.GenerateFromPassword([]byte(newPwd), s.config.Security.BCryptCost)
+	if taken, err := s.departmentNameTaken(queryCtx, name, code); err != nil {
+		return nil, err
+	} else if taken {
+		return &pb.CreateDepartmentResponse{Success: false, Message: fmt.Sprintf("department name %q is already used", name)}, nil
+	}
 
-	res, err := s.usersCol.UpdateOne(queryCtx, bson.M{"_id": req.UserId}, bson.M{
-		"$set": bson.M{"password_hash": string(hash), "updated_at": time.Now()},
+	// The department and its audit entry are written together or not at all
+	department := shared.Department{Code: code, Name: name, CreatedAt: time.Now()}
+	err := shared.WithTransaction(queryCtx, s.client, func(sessCtx mongo.SessionContext) error {
+		if _, err := s.departmentsCol.InsertOne(sessCtx, department); err != nil {
+			return err
+		}
+		return shared.RecordAuditEvent(sessCtx, s.client, s.auditLogsCol, req.AdminId, shared.ActionDeptCreate, code, map[string]interface{}{"name": name})
 	})
-	if err != nil {
-		return nil, status.Error(codes.Internal, "db error")
+	if mongo.IsDuplicateKeyError(err) {
+		return &pb.CreateDepartmentResponse{Success: false, Message: fmt.Sprintf("department %s already exists", code)}, nil
 	}
-	if res.MatchedCount == 0 {
-		return &pb.ResetPasswordResponse{Success: false, Message: "user not found"}, nil
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to create department")
 	}
 
-	return &pb.ResetPasswordResponse{Success: true, NewPassword: newPwd, Message: "password reset"}, nil
+	return &pb.CreateDepartmentResponse{Success: true, Message: "department created", Department: departmentToProto(&department)}, nil
 }
 
-func (s *AdminService) ToggleUserStatus(ctx context.Context, req *pb.ToggleUserStatusRequest) (*pb.ToggleUserStatusResponse, error) {
-	queryCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+// UpdateDepartment renames a department. Faculty store the name, so those in the
+// department are renamed with it in the same transaction.
+func (s *AdminService) UpdateDepartment(ctx context.Context, req *pb.UpdateDepartmentRequest) (*pb.UpdateDepartmentResponse, error) {
+	code := shared.NormalizeDepartment(req.Code)
+	name := strings.TrimSpace(req.Name)
+	if code == "" || name == "" {
+		return nil, status.Error(codes.InvalidArgument, "code and name are required")
+	}
+	if err := shared.ValidateText("name", name, shared.MaxNameLength, false); err != nil {
+		return nil, err
+	}
+
+	queryCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
 	defer cancel()
 
-	_, err := s.usersCol.UpdateOne(queryCtx, bson.M{"_id": req.UserId}, bson.M{
+	if taken, err := s.departmentNameTaken(queryCtx, name, code); err != nil {
+		return nil, err
+	} else if taken {
+		return &pb.UpdateDepartmentResponse{Success: false, Message: fmt.Sprintf("department name %q is already used", name)}, nil
+	}
+
+	var department shared.Department
+	var facultyUpdated int64
+	err := shared.WithTransaction(queryCtx, s.client, func(sessCtx mongo.SessionContext) error {
+		if err := s.departmentsCol.FindOne(sessCtx, bson.M{"_id": code}).Decode(&department); err != nil {
+			return err
+		}
+		previous := department.Name
+		department.Name, department.UpdatedAt = name, time.Now()
+		if _, err := s.departmentsCol.UpdateOne(sessCtx,
+			bson.M{"_id": code},
+			bson.M{"$set": bson.M{"name": department.Name, "updated_at": department.UpdatedAt}},
+		); err != nil {
+			return err
+		}
+
+		res, err := s.usersCol.UpdateMany(sessCtx,
+			bson.M{"role": shared.RoleFaculty, "department": previous},
+			bson.M{"$set": bson.M{"department": name}},
+			options.Update().SetCollation(shared.DepartmentCollation),
+		)
+		if err != nil {
+			return err
+		}
+		facultyUpdated = res.ModifiedCount
+
+		return shared.RecordAuditEvent(sessCtx, s.client, s.auditLogsCol, req.AdminId, shared.ActionDeptUpdate, code, map[string]interface{}{
+			"previous_name":   previous,
+			"name":            name,
+			"faculty_updated": facultyUpdated,
+		})
+	})
+	if err == mongo.ErrNoDocuments {
+		return nil, status.Errorf(codes.NotFound, "department %s not found", code)
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to update department")
+	}
+
+	return &pb.UpdateDepartmentResponse{
+		Success:        true,
+		Message:        "department renamed",
+		Department:     departmentToProto(&department),
+		FacultyUpdated: int32(facultyUpdated),
+	}, nil
+}
+
+// DeleteDepartment removes a department no course or faculty member belongs to
+func (s *AdminService) DeleteDepartment(ctx context.Context, req *pb.DeleteDepartmentRequest) (*pb.DeleteDepartmentResponse, error) {
+	code := shared.NormalizeDepartment(req.Code)
+	if code == "" {
+		return nil, status.Error(codes.InvalidArgument, "code is required")
+	}
+
+	queryCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	// The checks, the delete and its audit entry share a transaction
+	err := shared.WithTransaction(queryCtx, s.client, func(sessCtx mongo.SessionContext) error {
+		var department shared.Department
+		if err := s.departmentsCol.FindOne(sessCtx, bson.M{"_id": code}).Decode(&department); err != nil {
+			if err == mongo.ErrNoDocuments {
+				return status.Errorf(codes.NotFound, "department %s not found", code)
+			}
+			return err
+		}
+
+		courseCount, err := s.coursesCol.CountDocuments(sessCtx, bson.M{"department": code})
+		if err != nil {
+			return err
+		}
+		facultyCount, err := s.usersCol.CountDocuments(sessCtx,
+			bson.M{"role": shared.RoleFaculty, "department": department.Name},
+			options.Count().SetCollation(shared.DepartmentCollation),
+		)
+		if err != nil {
+			return err
+		}
+		if courseCount > 0 || facultyCount > 0 {
+			return status.Errorf(codes.FailedPrecondition, "department %s still has %d courses and %d faculty", code, courseCount, facultyCount)
+		}
+
+		if _, err := s.departmentsCol.DeleteOne(sessCtx, bson.M{"_id": code}); err != nil {
+			return err
+		}
+		return shared.RecordAuditEvent(sessCtx, s.client, s.auditLogsCol, req.AdminId, shared.ActionDeptDelete, code, map[string]interface{}{"name": department.Name})
+	})
+	if err != nil {
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+		return nil, status.Error(codes.Internal, "failed to delete department")
+	}
+
+	return &pb.DeleteDepartmentResponse{Success: true, Message: fmt.Sprintf("department %s deleted", code)}, nil
+}
+
+// departmentNameTaken reports whether another department already uses name, ignoring case
+func (s *AdminService) departmentNameTaken(ctx context.Context, name, code string) (bool, error) {
+	count, err := s.departmentsCol.CountDocuments(ctx,
+		bson.M{"name": name, "_id": bson.M{"$ne": code}},
+		options.Count().SetCollation(shared.DepartmentCollation),
+	)
+	if err != nil {
+		return false, status.Error(codes.Internal, "db error")
+	}
+	return count > 0, nil
+}
+
+// resolveCourseDepartment maps a department given by code or name to the code
+// courses store, returning a message when no department matches
+func (s *AdminService) resolveCourseDepartment(ctx context.Context, department string) (string, string, error) {
+	found, err := shared.FindDepartment(ctx, s.departmentsCol, department)
+	if err == mongo.ErrNoDocuments {
+		return "", fmt.Sprintf("unknown department %q", strings.TrimSpace(department)), nil
+	}
+	if err != nil {
+		return "", "", status.Error(codes.Internal, "db error")
+	}
+	return found.Code, "", nil
+}
+
+// departmentToProto maps a stored department to the Protobuf message
+func departmentToProto(d *shared.Department) *pb.Department {
+	return &pb.Department{
+		Code:      d.Code,
+		Name:      d.Name,
+		CreatedAt: shared.ToProtoTime(d.CreatedAt),
+		UpdatedAt: shared.ToProtoTime(d.UpdatedAt),
+	}
+}
+
+// ============================================================================
+// User Management
+// ============================================================================
+
+func (s *AdminService) CreateUser(ctx context.Context, req *pb.CreateUserRequest) (*pb.CreateUserResponse, error) {
+	req.Email = shared.NormalizeEmail(req.Email)
+	if req.Email == "" || req.Role == "" || req.Name == "" {
+		return nil, status.Error(codes.InvalidArgument, "missing fields")
+	}
+	if err := shared.ValidateText("name", req.Name, shared.MaxNameLength, false); err != nil {
+		return nil, err
+	}
+	if !shared.IsValidRole(req.Role) {
+		return &pb.CreateUserResponse{Success: false, Message: "invalid role"}, nil
+	}
+
+	queryCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	// Check email, ignoring case so older mixed-case accounts still collide
+	count, _ := s.usersCol.CountDocuments(queryCtx, bson.M{"email": req.Email}, options.Count().SetCollation(shared.EmailCollation))
+	if count > 0 {
+		return &pb.CreateUserResponse{Success: false, Message: "email exists"}, nil
+	}
+
+	// Use Shared ID Gen
+	userID := shared.GenerateID(req.Role)
+
+	// Password
+	initPwd := s.generateRandomPassword()
+	hash, _ := bcrypt.GenerateFromPassword([]byte(initPwd), s.config.Security.BCryptCost)
+
+	userDoc := bson.M{
+		"_id": userID, "email": req.Email, "password_hash": string(hash),
+		"role": req.Role, "name": req.Name, "is_active": true,
+		"must_change_password": true,
+		"created_at":           primitive.NewDateTimeFromTime(time.Now()),
+	}
+
+	if req.Role == shared.RoleStudent {
+		if req.StudentId == "" {
+			req.StudentId = shared.GenerateID("STU")
+		} // Simplified ID gen
+		userDoc["student_id"] = req.StudentId
+		userDoc["major"] = req.Major
+		userDoc["year_level"] = req.YearLevel
+	} else if req.Role == shared.RoleFaculty {
+		if req.FacultyId == "" {
+			req.FacultyId = shared.GenerateID("FAC")
+		}
+		userDoc["faculty_id"] = req.FacultyId
+		if req.Department != "" {
+			// Faculty store the department's name, whichever way it was given
+			department, err := shared.FindDepartment(queryCtx, s.departmentsCol, req.Department)
+			if err == mongo.ErrNoDocuments {
+				return &pb.CreateUserResponse{Success: false, Message: fmt.Sprintf("unknown department %q", req.Department)}, nil
+			}
+			if err != nil {
+				return nil, status.Error(codes.Internal, "db error")
+			}
+			userDoc["department"] = department.Name
+		}
+	}
+
+	err := shared.WithTransaction(queryCtx, s.client, func(sessCtx mongo.SessionContext) error {
+		if _, err := s.usersCol.InsertOne(sessCtx, userDoc); err != nil {
+			return err
+		}
+		return shared.RecordAuditEvent(sessCtx, s.client, s.auditLogsCol, req.AdminId, shared.ActionUserCreate, userID, nil)
+	})
+	if mongo.IsDuplicateKeyError(err) {
+		return &pb.CreateUserResponse{Success: false, Message: "email exists"}, nil
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to create user")
+	}
+
+	// Map to proto (simplified)
+	return &pb.CreateUserResponse{
+		Success: true, UserId: userID, InitialPassword: initPwd,
+		Message: "user created",
+		User:    &pb.User{Id: userID, Email: req.Email, Name: req.Name, Role: req.Role, IsActive: true},
+	}, nil
+}
+
+func (s *AdminService) ListUsers(ctx context.Context, req *pb.ListUsersRequest) (*pb.ListUsersResponse, error) {
+	queryCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	filter := bson.M{}
+	if req.Role != "" {
+		filter["role"] = req.Role
+	}
+	if req.ActiveOnly {
+		filter["is_active"] = true
+	}
+
+	cursor, err := s.usersCol.Find(queryCtx, filter, options.Find().SetLimit(100))
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(queryCtx)
+
+	var users []*pb.User
+	for cursor.Next(queryCtx) {
+		var u shared.User
+		if err := cursor.Decode(&u); err == nil {
+			users = append(users, s.userToProto(&u))
+		}
+	}
+	return &pb.ListUsersResponse{Users: users, TotalCount: int32(len(users))}, nil
+}
+
+// GetUserDetail returns one user with what support staff usually look up next: active
+// sessions, and for a student their enrollments this semester and CGPA, or for a
+// faculty member the courses they teach this semester. Each part is one query,
+// however many enrollments or courses there are.
+func (s *AdminService) GetUserDetail(ctx context.Context, req *pb.GetUserDetailRequest) (*pb.GetUserDetailResponse, error) {
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	queryCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	var user shared.User
+	if err := s.usersCol.FindOne(queryCtx, bson.M{"_id": req.UserId}).Decode(&user); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, status.Error(codes.NotFound, "user not found")
+		}
+		return nil, status.Error(codes.Internal, "db error")
+	}
+
+	resp := &pb.GetUserDetailResponse{
+		Success:  true,
+		Message:  "user retrieved",
+		User:     s.userToProto(&user),
+		Semester: s.getStringConfig(queryCtx, shared.ConfigCurrentSemester, ""),
+	}
+
+	sessions, err := s.sessionsCol.CountDocuments(queryCtx, bson.M{"user_id": user.ID, "expires_at": bson.M{"$gt": time.Now()}})
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to count sessions")
+	}
+	resp.ActiveSessions = int32(sessions)
+
+	switch user.Role {
+	case shared.RoleStudent:
+		err = s.fillStudentDetail(queryCtx, &user, resp)
+	case shared.RoleFaculty:
+		err = s.fillFacultyDetail(queryCtx, &user, resp)
+	}
+	if err != nil {
+		log.Printf("Error loading details of user %s: %v", user.ID, err)
+		return nil, status.Error(codes.Internal, "failed to load user details")
+	}
+	return resp, nil
+}
+
+// fillStudentDetail adds a student's enrollments in the current semester's courses
+// and their CGPA. Enrollments and grades may be keyed by student number or user ID.
+func (s *AdminService) fillStudentDetail(ctx context.Context, user *shared.User, resp *pb.GetUserDetailResponse) error {
+	studentIDs := []string{user.ID}
+	if user.StudentID != "" && user.StudentID != user.ID {
+		studentIDs = append(studentIDs, user.StudentID)
+	}
+
+	if resp.Semester != "" {
+		cursor, err := s.coursesCol.Find(ctx, bson.M{"semester": resp.Semester},
+			options.Find().SetProjection(bson.M{"code": 1, "title": 1, "units": 1}))
+		if err != nil {
+			return err
+		}
+		var courses []shared.Course
+		if err := cursor.All(ctx, &courses); err != nil {
+			return err
+		}
+		byID := make(map[string]*shared.Course, len(courses))
+		courseIDs := make([]string, 0, len(courses))
+		for i := range courses {
+			byID[courses[i].ID] = &courses[i]
+			courseIDs = append(courseIDs, courses[i].ID)
+		}
+
+		if len(courseIDs) > 0 {
+			cursor, err = s.enrollmentsCol.Find(ctx,
+				bson.M{"student_id": bson.M{"$in": studentIDs}, "course_id": bson.M{"$in": courseIDs}},
+				options.Find().SetSort(bson.D{{Key: "enrolled_at", Value: 1}, {Key: "_id", Value: 1}}))
+			if err != nil {
+				return err
+			}
+			var enrollments []shared.Enrollment
+			if err := cursor.All(ctx, &enrollments); err != nil {
+				return err
+			}
+			for _, e := range enrollments {
+				c := byID[e.CourseID]
+				resp.Enrollments = append(resp.Enrollments, &pb.UserEnrollment{
+					EnrollmentId: e.ID,
+					CourseId:     e.CourseID,
+					CourseCode:   c.Code,
+					CourseTitle:  c.Title,
+					Units:        c.Units,
+					Status:       e.Status,
+				})
+			}
+		}
+	}
+
+	// CGPA over published grades, computed as the grade service computes it
+	cursor, err := s.gradesCol.Find(ctx,
+		bson.M{"student_id": bson.M{"$in": studentIDs}, "published": true},
+		options.Find().SetProjection(bson.M{"course_id": 1, "grade": 1, "units": 1, "semester": 1}))
+	if err != nil {
+		return err
+	}
+	var records []shared.GPARecord
+	if err := cursor.All(ctx, &records); err != nil {
+		return err
+	}
+	resp.Cgpa = shared.ComputeGPA(records).CGPA
+	return nil
+}
+
+// fillFacultyDetail adds the courses a faculty member teaches in the current semester
+func (s *AdminService) fillFacultyDetail(ctx context.Context, user *shared.User, resp *pb.GetUserDetailResponse) error {
+	if resp.Semester == "" {
+		return nil
+	}
+	cursor, err := s.coursesCol.Find(ctx,
+		bson.M{"faculty_id": user.ID, "semester": resp.Semester},
+		options.Find().SetSort(bson.D{{Key: "code", Value: 1}, {Key: "_id", Value: 1}}))
+	if err != nil {
+		return err
+	}
+	var docs []bson.M
+	if err := cursor.All(ctx, &docs); err != nil {
+		return err
+	}
+	for _, doc := range docs {
+		resp.AssignedCourses = append(resp.AssignedCourses, s.documentToCourse(doc))
+	}
+	return nil
+}
+
+func (s *AdminService) ResetPassword(ctx context.Context, req *pb.ResetPasswordRequest) (*pb.ResetPasswordResponse, error) {
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "id required")
+	}
+
+	queryCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	newPwd := s.generateRandomPassword()
+	hash, _ := bcrypt.GenerateFromPassword([]byte(newPwd), s.config.Security.BCryptCost)
+
+	res, err := s.usersCol.UpdateOne(queryCtx, bson.M{"_id": req.UserId}, bson.M{
+		"$set": bson.M{"password_hash": string(hash), "must_change_password": true, "updated_at": time.Now()},
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, "db error")
+	}
+	if res.MatchedCount == 0 {
+		return nil, status.Error(codes.NotFound, "user not found")
+	}
+
+	return &pb.ResetPasswordResponse{Success: true, NewPassword: newPwd, Message: "password reset"}, nil
+}
+
+func (s *AdminService) ToggleUserStatus(ctx context.Context, req *pb.ToggleUserStatusRequest) (*pb.ToggleUserStatusResponse, error) {
+	queryCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	res, err := s.usersCol.UpdateOne(queryCtx, bson.M{"_id": req.UserId}, bson.M{
 		"$set": bson.M{"is_active": req.Activate, "updated_at": time.Now()},
 	})
 	if err != nil {
 		return nil, status.Error(codes.Internal, "db error")
 	}
+	if res.MatchedCount == 0 {
+		return nil, status.Error(codes.NotFound, "user not found")
+	}
+
+	return &pb.ToggleUserStatusResponse{Success: true, Message: "status updated"}, nil
+}
+
+// AddTransferCredit records credit a student earned elsewhere as equivalent to a local course
+func (s *AdminService) AddTransferCredit(ctx context.Context, req *pb.AddTransferCreditRequest) (*pb.AddTransferCreditResponse, error) {
+	courseCode := strings.ToUpper(strings.TrimSpace(req.CourseCode))
+	if req.StudentId == "" || courseCode == "" || strings.TrimSpace(req.SourceInstitution) == "" {
+		return nil, status.Error(codes.InvalidArgument, "student_id, course_code, and source_institution are required")
+	}
+	if req.EquivalentUnits < 1 {
+		return nil, status.Error(codes.InvalidArgument, "equivalent_units must be positive")
+	}
+
+	queryCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	studentID, err := s.resolveStudentNumber(queryCtx, req.StudentId)
+	if err != nil {
+		return nil, err
+	}
+
+	count, err := s.transferCreditsCol.CountDocuments(queryCtx, bson.M{"student_id": studentID, "course_code": courseCode})
+	if err != nil {
+		return nil, status.Error(codes.Internal, "db error")
+	}
+	if count > 0 {
+		return nil, status.Errorf(codes.AlreadyExists, "transfer credit for %s already recorded", courseCode)
+	}
+
+	credit := shared.TransferCredit{
+		ID:                shared.GenerateTransferCreditID(),
+		StudentID:         studentID,
+		CourseCode:        courseCode,
+		EquivalentUnits:   req.EquivalentUnits,
+		SourceInstitution: strings.TrimSpace(req.SourceInstitution),
+		AddedBy:           req.AdminId,
+		CreatedAt:         time.Now(),
+	}
+	if _, err := s.transferCreditsCol.InsertOne(queryCtx, credit); err != nil {
+		return nil, status.Error(codes.Internal, "failed to add transfer credit")
+	}
+
+	shared.LogAuditEvent(queryCtx, s.auditLogsCol, req.AdminId, shared.ActionTransferAdd, credit.ID, map[string]interface{}{
+		"student_id":  studentID,
+		"course_code": courseCode,
+		"units":       credit.EquivalentUnits,
+	})
+
+	return &pb.AddTransferCreditResponse{
+		Success: true,
+		Message: "transfer credit added",
+		TransferCredit: &pb.TransferCredit{
+			Id: credit.ID, StudentId: credit.StudentID, CourseCode: credit.CourseCode,
+			EquivalentUnits: credit.EquivalentUnits, SourceInstitution: credit.SourceInstitution,
+			AddedBy: credit.AddedBy, CreatedAt: shared.ToProtoTime(credit.CreatedAt),
+		},
+	}, nil
+}
+
+// ============================================================================
+// Holds
+// ============================================================================
+
+// PlaceHold blocks a student from enrolling until the hold is cleared.
+// A student can carry at most one active hold of each type.
+func (s *AdminService) PlaceHold(ctx context.Context, req *pb.PlaceHoldRequest) (*pb.PlaceHoldResponse, error) {
+	holdType := strings.ToLower(strings.TrimSpace(req.Type))
+	if req.StudentId == "" || !shared.IsValidHoldType(holdType) {
+		return nil, status.Error(codes.InvalidArgument, "student_id and a valid type (financial, disciplinary, academic, administrative) are required")
+	}
+	if err := shared.ValidateText("reason", req.Reason, shared.MaxReasonLength, true); err != nil {
+		return nil, err
+	}
+
+	queryCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	studentID, err := s.resolveStudentNumber(queryCtx, req.StudentId)
+	if err != nil {
+		return nil, err
+	}
+
+	count, err := s.holdsCol.CountDocuments(queryCtx, bson.M{"student_id": studentID, "type": holdType, "active": true})
+	if err != nil {
+		return nil, status.Error(codes.Internal, "db error")
+	}
+	if count > 0 {
+		return nil, status.Errorf(codes.AlreadyExists, "student already has an active %s hold", holdType)
+	}
+
+	hold := shared.Hold{
+		ID:        shared.GenerateHoldID(),
+		StudentID: studentID,
+		Type:      holdType,
+		Reason:    strings.TrimSpace(req.Reason),
+		Active:    true,
+		PlacedBy:  req.AdminId,
+		PlacedAt:  time.Now(),
+	}
+	if _, err := s.holdsCol.InsertOne(queryCtx, hold); err != nil {
+		return nil, status.Error(codes.Internal, "failed to place hold")
+	}
+
+	shared.LogAuditEvent(queryCtx, s.auditLogsCol, req.AdminId, shared.ActionHoldPlace, hold.ID, map[string]interface{}{
+		"student_id": studentID,
+		"type":       holdType,
+		"reason":     hold.Reason,
+	})
+
+	return &pb.PlaceHoldResponse{Success: true, Message: fmt.Sprintf("%s hold placed", holdType), Hold: holdToProto(&hold)}, nil
+}
+
+// ClearHold deactivates a hold, keeping the record for history
+func (s *AdminService) ClearHold(ctx context.Context, req *pb.ClearHoldRequest) (*pb.ClearHoldResponse, error) {
+	if req.HoldId == "" {
+		return nil, status.Error(codes.InvalidArgument, "hold_id required")
+	}
+
+	queryCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	var hold shared.Hold
+	err := s.holdsCol.FindOneAndUpdate(queryCtx,
+		bson.M{"_id": req.HoldId, "active": true},
+		bson.M{"$set": bson.M{"active": false, "cleared_by": req.AdminId, "cleared_at": time.Now()}},
+		options.FindOneAndUpdate().SetReturnDocument(options.After),
+	).Decode(&hold)
+	if err == mongo.ErrNoDocuments {
+		count, _ := s.holdsCol.CountDocuments(queryCtx, bson.M{"_id": req.HoldId})
+		if count == 0 {
+			return nil, status.Error(codes.NotFound, "hold not found")
+		}
+		return nil, status.Error(codes.FailedPrecondition, "hold is already cleared")
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to clear hold")
+	}
+
+	shared.LogAuditEvent(queryCtx, s.auditLogsCol, req.AdminId, shared.ActionHoldClear, hold.ID, map[string]interface{}{
+		"student_id": hold.StudentID,
+		"type":       hold.Type,
+	})
+
+	return &pb.ClearHoldResponse{Success: true, Message: fmt.Sprintf("%s hold cleared", hold.Type), Hold: holdToProto(&hold)}, nil
+}
+
+// GetStudentHolds lists a student's holds, active only unless include_cleared is set
+func (s *AdminService) GetStudentHolds(ctx context.Context, req *pb.GetStudentHoldsRequest) (*pb.GetStudentHoldsResponse, error) {
+	if req.StudentId == "" {
+		return nil, status.Error(codes.InvalidArgument, "student_id required")
+	}
+
+	queryCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	studentID, err := s.resolveStudentNumber(queryCtx, req.StudentId)
+	if err != nil {
+		return nil, err
+	}
+
+	filter := bson.M{"student_id": studentID}
+	if !req.IncludeCleared {
+		filter["active"] = true
+	}
+	cursor, err := s.holdsCol.Find(queryCtx, filter, options.Find().SetSort(bson.M{"placed_at": -1}))
+	if err != nil {
+		return nil, status.Error(codes.Internal, "db error")
+	}
+	var holds []shared.Hold
+	if err := cursor.All(queryCtx, &holds); err != nil {
+		return nil, status.Error(codes.Internal, "failed to decode holds")
+	}
+
+	results := make([]*pb.Hold, 0, len(holds))
+	for i := range holds {
+		results = append(results, holdToProto(&holds[i]))
+	}
+	return &pb.GetStudentHoldsResponse{Success: true, Message: fmt.Sprintf("%d holds", len(results)), Holds: results}, nil
+}
+
+// holdToProto maps a stored hold to the Protobuf message
+func holdToProto(h *shared.Hold) *pb.Hold {
+	return &pb.Hold{
+		Id:        h.ID,
+		StudentId: h.StudentID,
+		Type:      h.Type,
+		Reason:    h.Reason,
+		Active:    h.Active,
+		PlacedBy:  h.PlacedBy,
+		PlacedAt:  shared.ToProtoTime(h.PlacedAt),
+		ClearedBy: h.ClearedBy,
+		ClearedAt: shared.ToProtoTime(h.ClearedAt),
+	}
+}
+
+// ============================================================================
+// System Config
+// ============================================================================
+
+// SetEnrollmentPeriod validates the new period and writes both dates in one
+// transaction, so a failure can never leave a new start paired with the old end.
+// An end date in the past is refused unless allow_past_end is set.
+func (s *AdminService) SetEnrollmentPeriod(ctx context.Context, req *pb.SetEnrollmentPeriodRequest) (*pb.SetEnrollmentPeriodResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "start_date and end_date are required")
+	}
+	_, end, err := shared.ParseEnrollmentPeriod(req.StartDate, req.EndDate)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	var warning string
+	if end.Before(time.Now()) {
+		if !req.AllowPastEnd {
+			return nil, status.Error(codes.InvalidArgument, "end_date is in the past; set allow_past_end to close enrollment with it")
+		}
+		warning = "end_date is in the past, so enrollment is now closed"
+	}
+
+	adminID := req.AdminId
+	if adminID == "" {
+		adminID = "admin"
+	}
+
+	queryCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	err = shared.WithTransaction(queryCtx, s.client, func(sessCtx mongo.SessionContext) error {
+		if err := s.setSystemConfig(sessCtx, adminID, shared.ConfigEnrollmentStart, strings.TrimSpace(req.StartDate)); err != nil {
+			return err
+		}
+		return s.setSystemConfig(sessCtx, adminID, shared.ConfigEnrollmentEnd, strings.TrimSpace(req.EndDate))
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to set enrollment period")
+	}
+	return &pb.SetEnrollmentPeriodResponse{Success: true, Message: "dates set", Warning: warning}, nil
+}
+
+func (s *AdminService) ToggleEnrollment(ctx context.Context, req *pb.ToggleEnrollmentRequest) (*pb.ToggleEnrollmentResponse, error) {
+	val := "false"
+	if req.Enable {
+		val = "true"
+	}
+	if _, err := s.UpdateSystemConfig(ctx, &pb.UpdateSystemConfigRequest{Key: shared.ConfigEnrollmentOn, Value: val, AdminId: req.AdminId}); err != nil {
+		return nil, status.Error(codes.Internal, "failed to toggle enrollment")
+	}
+	return &pb.ToggleEnrollmentResponse{Success: true, EnrollmentOpen: req.Enable, Message: "enrollment toggled"}, nil
+}
+
+func (s *AdminService) GetSystemConfig(ctx context.Context, req *pb.GetSystemConfigRequest) (*pb.GetSystemConfigResponse, error) {
+	queryCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	filter := bson.M{}
+	if req.Key != "" {
+		filter["key"] = req.Key
+	}
+	cursor, err := s.systemConfigCol.Find(queryCtx, filter)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(queryCtx)
+
+	var configs []*pb.SystemConfig
+	for cursor.Next(queryCtx) {
+		var c shared.SystemConfig
+		if err := cursor.Decode(&c); err == nil {
+			configs = append(configs, &pb.SystemConfig{
+				Key: c.Key, Value: c.Value, UpdatedAt: shared.ToProtoTime(c.UpdatedAt), UpdatedBy: c.UpdatedBy,
+			})
+		}
+	}
+	return &pb.GetSystemConfigResponse{Configs: configs}, nil
+}
+
+func (s *AdminService) UpdateSystemConfig(ctx context.Context, req *pb.UpdateSystemConfigRequest) (*pb.UpdateSystemConfigResponse, error) {
+	if err := shared.ValidateConfigValue(req.Key, req.Value); err != nil {
+		return nil, err
+	}
+
+	queryCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	err := shared.WithTransaction(queryCtx, s.client, func(sessCtx mongo.SessionContext) error {
+		return s.setSystemConfig(sessCtx, req.AdminId, req.Key, req.Value)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.UpdateSystemConfigResponse{Success: true, Message: "updated"}, nil
+}
+
+// setSystemConfig upserts one config key and its audit entry; callers run it inside
+// shared.WithTransaction so a failed audit write undoes the change
+func (s *AdminService) setSystemConfig(sessCtx mongo.SessionContext, adminID, key, value string) error {
+	opts := options.Update().SetUpsert(true)
+	_, err := s.systemConfigCol.UpdateOne(sessCtx, bson.M{"key": key}, bson.M{
+		"$set": bson.M{"value": value, "updated_by": adminID, "updated_at": time.Now()},
+	}, opts)
+	if err != nil {
+		return err
+	}
+	return shared.RecordAuditEvent(sessCtx, s.client, s.auditLogsCol, adminID, shared.ActionConfigChange, key, nil)
+}
+
+// ============================================================================
+// Overrides (Transactions)
+// ============================================================================
+
+func (s *AdminService) OverrideEnrollment(ctx context.Context, req *pb.OverrideEnrollmentRequest) (*pb.OverrideEnrollmentResponse, error) {
+	if req.Action != "force_enroll" && req.Action != "force_drop" {
+		return nil, status.Error(codes.InvalidArgument, "invalid action")
+	}
+	if err := shared.ValidateText("reason", req.Reason, shared.MaxReasonLength, true); err != nil {
+		return nil, err
+	}
+
+	queryCtx, cancel := context.WithTimeout(ctx, 15*time.Second)
+	defer cancel()
+
+	// 1. Verify Entities
+	var student shared.User
+	if err := s.usersCol.FindOne(queryCtx, bson.M{"_id": req.StudentId, "role": shared.RoleStudent}).Decode(&student); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, shared.NewError(codes.NotFound, shared.ErrCodeStudentNotFound, "student not found")
+		}
+		return nil, status.Error(codes.Internal, "db error")
+	}
+
+	var course shared.Course
+	if err := s.coursesCol.FindOne(queryCtx, bson.M{"_id": req.CourseId}).Decode(&course); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, shared.ErrCourseNotFound.WithParam("course_id", req.CourseId)
+		}
+		return nil, status.Error(codes.Internal, "db error")
+	}
+	if req.Action == "force_enroll" && course.Cancelled {
+		return &pb.OverrideEnrollmentResponse{Success: false, Message: "course has been cancelled", ErrorCode: string(shared.ErrCodeCourseCancelled)}, nil
+	}
+	if req.Action == "force_enroll" {
+		closed, err := shared.IsSemesterClosed(queryCtx, s.closuresCol, course.Semester)
+		if err != nil {
+			return nil, status.Error(codes.Internal, "db error")
+		}
+		if closed {
+			return &pb.OverrideEnrollmentResponse{Success: false, Message: fmt.Sprintf("semester %s has been closed", course.Semester), ErrorCode: string(shared.ErrCodeSemesterClosed)}, nil
+		}
+	}
+
+	autoClose := s.getBoolConfig(queryCtx, shared.ConfigOverrideAutoClose, true)
+
+	// 2. Perform Transaction using Shared Helper
+	// Overrides intentionally skip course restrictions (allowed majors, min year level)
+	// and capacity; force_enroll may push enrolled past capacity
+	var updated shared.Course
+	var closed bool
+	err := shared.WithTransaction(queryCtx, s.client, func(sessCtx mongo.SessionContext) error {
+		closed = false
+		details := map[string]interface{}{"reason": req.Reason}
+		after := options.FindOneAndUpdate().SetReturnDocument(options.After)
+
+		if req.Action == "force_enroll" {
+			// Check existing
+			count, _ := s.enrollmentsCol.CountDocuments(sessCtx, bson.M{"student_id": req.StudentId, "course_id": req.CourseId, "status": shared.StatusEnrolled})
+			if count > 0 {
+				return shared.ErrAlreadyEnrolled.WithParam("course_id", req.CourseId)
+			}
+
+			// Create Enrollment, parsing the schedule exactly as EnrollAll does
+			_, err := s.enrollmentsCol.InsertOne(sessCtx, shared.Enrollment{
+				ID:           shared.GenerateEnrollmentID(),
+				StudentID:    req.StudentId,
+				CourseID:     req.CourseId,
+				Status:       shared.StatusEnrolled,
+				EnrolledAt:   time.Now(),
+				ScheduleInfo: shared.NewScheduleInfo(course.Schedule),
+			})
+			if err != nil {
+				return err
+			}
+
+			// Inc Course
+			if err := s.coursesCol.FindOneAndUpdate(sessCtx, bson.M{"_id": req.CourseId}, bson.M{"$inc": bson.M{"enrolled": 1}}, after).Decode(&updated); err != nil {
+				return err
+			}
+			if err := shared.RecordEnrollmentEvent(sessCtx, s.client, s.eventsCol, req.CourseId, 1, shared.EventSourceOverride); err != nil {
+				return err
+			}
+
+			// Close the course once the override fills it
+			if autoClose && updated.IsOpen && updated.Enrolled >= updated.Capacity {
+				if _, err := s.coursesCol.UpdateOne(sessCtx, bson.M{"_id": req.CourseId}, bson.M{
+					"$set": bson.M{"is_open": false, "updated_at": time.Now()},
+				}); err != nil {
+					return err
+				}
+				updated.IsOpen = false
+				closed = true
+			}
+
+			details["overage"] = int32(0)
+			if updated.Enrolled > updated.Capacity {
+				details["overage"] = updated.Enrolled - updated.Capacity
+			}
+			details["auto_closed"] = closed
+
+		} else { // force_drop
+			enrollment, err := shared.FindCurrentEnrollment(sessCtx, s.enrollmentsCol, req.StudentId, req.CourseId)
+			if err == mongo.ErrNoDocuments {
+				return shared.ErrNotEnrolled.WithParam("course_id", req.CourseId)
+			}
+			if err != nil {
+				return err
+			}
+			// Forced drops do not count against the student's max_drops_per_semester
+			if err := shared.TransitionEnrollment(sessCtx, s.enrollmentsCol, enrollment, shared.StatusDropped,
+				bson.M{"$set": bson.M{"dropped_at": time.Now(), "drop_reason": shared.DropReasonAdminForced}},
+			); err != nil {
+				return err
+			}
+
+			// Dec Course
+			if err := s.coursesCol.FindOneAndUpdate(sessCtx, bson.M{"_id": req.CourseId}, bson.M{"$inc": bson.M{"enrolled": -1}}, after).Decode(&updated); err != nil {
+				return err
+			}
+			if err := shared.RecordEnrollmentEvent(sessCtx, s.client, s.eventsCol, req.CourseId, -1, shared.EventSourceOverride); err != nil {
+				return err
+			}
+		}
+
+		details["enrolled"] = updated.Enrolled
+		details["capacity"] = updated.Capacity
+		shared.LogAuditEvent(sessCtx, s.auditLogsCol, req.AdminId, req.Action, fmt.Sprintf("%s:%s", req.StudentId, req.CourseId), details)
+		return nil
+	})
+
+	if err != nil {
+		return &pb.OverrideEnrollmentResponse{Success: false, Message: err.Error(), ErrorCode: string(shared.ErrorCodeOf(err))}, nil
+	}
+
+	message := "override successful"
+	if closed {
+		message = "override successful; course is now full and has been closed"
+		s.courseCatalogChanged(queryCtx)
+	}
+	return &pb.OverrideEnrollmentResponse{
+		Success:      true,
+		Message:      message,
+		Enrolled:     updated.Enrolled,
+		Capacity:     updated.Capacity,
+		CourseClosed: closed,
+	}, nil
+}
+
+// Outcomes reported by RestoreEnrollment
+const (
+	restoreOutcomeRestored     = "restored"
+	restoreOutcomeOverCapacity = "restored_over_capacity"
+	restoreOutcomeRefused      = "refused"
+)
+
+// RestoreEnrollment flips a dropped enrollment back to enrolled, keeping the
+// original document (and enrolled_at). The seat is only taken when one is free
+// unless force is set; drops older than the restore window are refused.
+func (s *AdminService) RestoreEnrollment(ctx context.Context, req *pb.RestoreEnrollmentRequest) (*pb.RestoreEnrollmentResponse, error) {
+	if req.EnrollmentId == "" {
+		return nil, status.Error(codes.InvalidArgument, "enrollment_id required")
+	}
+	if err := shared.ValidateText("reason", req.Reason, shared.MaxReasonLength, true); err != nil {
+		return nil, err
+	}
+
+	queryCtx, cancel := context.WithTimeout(ctx, 15*time.Second)
+	defer cancel()
+
+	window := time.Duration(s.getIntConfig(queryCtx, shared.ConfigRestoreWindow, shared.DefaultRestoreWindowHours)) * time.Hour
+
+	resp := &pb.RestoreEnrollmentResponse{EnrollmentId: req.EnrollmentId}
+	refuse := func(msg string) {
+		resp.Success = false
+		resp.Outcome = restoreOutcomeRefused
+		resp.Message = msg
+	}
+
+	err := shared.WithTransaction(queryCtx, s.client, func(sessCtx mongo.SessionContext) error {
+		var enrollment shared.Enrollment
+		if err := s.enrollmentsCol.FindOne(sessCtx, bson.M{"_id": req.EnrollmentId}).Decode(&enrollment); err != nil {
+			if err == mongo.ErrNoDocuments {
+				return status.Error(codes.NotFound, "enrollment not found")
+			}
+			return err
+		}
+		if err := shared.CheckTransition(enrollment.Status, shared.StatusEnrolled); err != nil {
+			return err
+		}
+
+		// 1. Only recent drops can be restored, and never into a cancelled course
+		if enrollment.DroppedAt.IsZero() || time.Since(enrollment.DroppedAt) > window {
+			refuse(fmt.Sprintf("enrollment was dropped more than %s ago", window))
+			return nil
+		}
+		cancelled, err := s.coursesCol.CountDocuments(sessCtx, bson.M{"_id": enrollment.CourseID, "cancelled": true})
+		if err != nil {
+			return err
+		}
+		if cancelled > 0 {
+			refuse("course has been cancelled")
+			return nil
+		}
+
+		// 2. The student may have re-enrolled in the course since
+		count, err := s.enrollmentsCol.CountDocuments(sessCtx, bson.M{
+			"student_id": enrollment.StudentID,
+			"course_id":  enrollment.CourseID,
+			"status":     shared.StatusEnrolled,
+		})
+		if err != nil {
+			return err
+		}
+		if count > 0 {
+			refuse("student is already enrolled in this course")
+			return nil
+		}
+
+		// 3. Take the seat back; without force only while one is free
+		courseFilter := bson.M{"_id": enrollment.CourseID}
+		if !req.Force {
+			courseFilter["$expr"] = bson.M{"$lt": bson.A{"$enrolled", "$capacity"}}
+		}
+		var course shared.Course
+		err = s.coursesCol.FindOneAndUpdate(sessCtx, courseFilter,
+			bson.M{"$inc": bson.M{"enrolled": 1}},
+			options.FindOneAndUpdate().SetReturnDocument(options.After),
+		).Decode(&course)
+		if err == mongo.ErrNoDocuments {
+			if req.Force {
+				return status.Error(codes.NotFound, "course not found")
+			}
+			refuse("course is full; set force to restore over capacity")
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		// 4. Reactivate the original enrollment document
+		if err := shared.TransitionEnrollment(sessCtx, s.enrollmentsCol, &enrollment, shared.StatusEnrolled,
+			bson.M{"$unset": bson.M{"dropped_at": "", "drop_reason": ""}},
+		); err != nil {
+			return err
+		}
+		if err := shared.RecordEnrollmentEvent(sessCtx, s.client, s.eventsCol, enrollment.CourseID, 1, shared.EventSourceOverride); err != nil {
+			return err
+		}
+
+		resp.Success = true
+		resp.Outcome = restoreOutcomeRestored
+		resp.Message = "enrollment restored"
+		if course.Enrolled > course.Capacity {
+			resp.Outcome = restoreOutcomeOverCapacity
+			resp.Message = "enrollment restored over capacity"
+		}
+		resp.Enrolled = course.Enrolled
+		resp.Capacity = course.Capacity
+
+		shared.LogAuditEvent(sessCtx, s.auditLogsCol, req.AdminId, shared.ActionEnrollRestore, enrollment.ID, map[string]interface{}{
+			"reason":     req.Reason,
+			"student_id": enrollment.StudentID,
+			"course_id":  enrollment.CourseID,
+			"dropped_at": enrollment.DroppedAt,
+			"outcome":    resp.Outcome,
+			"forced":     req.Force,
+			"enrolled":   course.Enrolled,
+			"capacity":   course.Capacity,
+		})
+		return nil
+	})
+
+	if err != nil {
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+		return nil, status.Errorf(codes.Internal, "failed to restore enrollment: %v", err)
+	}
+	return resp, nil
+}
+
+// RecalculateEnrollmentCounts repairs drifted courses.enrolled values by
+// recounting seat-holding enrollments, optionally limited to one semester
+func (s *AdminService) RecalculateEnrollmentCounts(ctx context.Context, req *pb.RecalculateEnrollmentCountsRequest) (*pb.RecalculateEnrollmentCountsResponse, error) {
+	queryCtx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	// 1. Load the courses in scope with their stored counts
+	courseFilter := bson.M{}
+	if req.Semester != "" {
+		courseFilter["semester"] = req.Semester
+	}
+	opts := options.Find().SetProjection(bson.M{"_id": 1, "code": 1, "enrolled": 1})
+	cursor, err := s.coursesCol.Find(queryCtx, courseFilter, opts)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to load courses")
+	}
+	var courses []shared.Course
+	if err := cursor.All(queryCtx, &courses); err != nil {
+		return nil, status.Error(codes.Internal, "failed to decode courses")
+	}
+	if len(courses) == 0 {
+		return &pb.RecalculateEnrollmentCountsResponse{Success: true, Message: "no courses found"}, nil
+	}
+
+	courseIDs := make([]string, 0, len(courses))
+	for _, c := range courses {
+		courseIDs = append(courseIDs, c.ID)
+	}
+
+	// 2. Count seat-holding enrollments per course
+	match := shared.SeatHoldingFilter()
+	match["course_id"] = bson.M{"$in": courseIDs}
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: match}},
+		{{Key: "$group", Value: bson.M{"_id": "$course_id", "count": bson.M{"$sum": 1}}}},
+	}
+	aggCursor, err := s.enrollmentsCol.Aggregate(queryCtx, pipeline)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to count enrollments")
+	}
+	var results []struct {
+		ID    string `bson:"_id"`
+		Count int32  `bson:"count"`
+	}
+	if err := aggCursor.All(queryCtx, &results); err != nil {
+		return nil, status.Error(codes.Internal, "failed to decode enrollment counts")
+	}
+	actual := make(map[string]int32, len(results))
+	for _, r := range results {
+		actual[r.ID] = r.Count
+	}
+
+	// 3. Correct mismatches. The update is conditioned on the stored value so a
+	// concurrent enroll/drop is not overwritten; such courses are left for a rerun.
+	var corrections []*pb.EnrollmentCountCorrection
+	var skipped int
+	for _, c := range courses {
+		count := actual[c.ID]
+		if c.Enrolled == count {
+			continue
+		}
+
+		// A missing field decodes as 0, so match it as well
+		var storedFilter interface{} = c.Enrolled
+		if c.Enrolled == 0 {
+			storedFilter = bson.M{"$in": bson.A{0, nil}}
+		}
+		res, err := s.coursesCol.UpdateOne(queryCtx,
+			bson.M{"_id": c.ID, "enrolled": storedFilter},
+			bson.M{"$set": bson.M{"enrolled": count, "updated_at": time.Now()}},
+		)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to update course %s: %v", c.ID, err)
+		}
+		if res.MatchedCount == 0 {
+			skipped++
+			continue
+		}
+
+		corrections = append(corrections, &pb.EnrollmentCountCorrection{
+			CourseId:      c.ID,
+			CourseCode:    c.Code,
+			PreviousCount: c.Enrolled,
+			ActualCount:   count,
+			Delta:         count - c.Enrolled,
+		})
+	}
+
+	// 4. Audit
+	details := map[string]interface{}{
+		"semester":        req.Semester,
+		"courses_checked": len(courses),
+		"corrected":       len(corrections),
+		"skipped":         skipped,
+	}
+	if len(corrections) > 0 {
+		changed := make([]map[string]interface{}, 0, len(corrections))
+		for _, c := range corrections {
+			changed = append(changed, map[string]interface{}{"course_id": c.CourseId, "from": c.PreviousCount, "to": c.ActualCount})
+		}
+		details["corrections"] = changed
+	}
+	shared.LogAuditEvent(ctx, s.auditLogsCol, req.AdminId, shared.ActionCountRepair, "courses.enrolled", details)
+
+	message := fmt.Sprintf("checked %d courses, corrected %d", len(courses), len(corrections))
+	if skipped > 0 {
+		message += fmt.Sprintf(", skipped %d changed concurrently", skipped)
+	}
+
+	return &pb.RecalculateEnrollmentCountsResponse{
+		Success:        true,
+		Message:        message,
+		CoursesChecked: int32(len(courses)),
+		Corrections:    corrections,
+	}, nil
+}
+
+// CloseSemester rolls a semester over: enrollments still active in its courses become
+// completed and, with the term over, the courses are closed and no longer hold seats.
+// The closure record is written last, so a run that failed partway (without a real
+// transaction) can simply be repeated; once it exists the semester cannot be closed again.
+func (s *AdminService) CloseSemester(ctx context.Context, req *pb.CloseSemesterRequest) (*pb.CloseSemesterResponse, error) {
+	semester := strings.TrimSpace(req.Semester)
+	if semester == "" {
+		return nil, status.Error(codes.InvalidArgument, "semester is required")
+	}
+
+	queryCtx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	var closure shared.SemesterClosure
+	err := shared.WithTransaction(queryCtx, s.client, func(sessCtx mongo.SessionContext) error {
+		closed, err := shared.IsSemesterClosed(sessCtx, s.closuresCol, semester)
+		if err != nil {
+			return err
+		}
+		if closed {
+			return shared.ErrSemesterClosed.Newf("semester %s has already been closed", semester).WithParam("semester", semester)
+		}
+
+		// 1. Find the semester's courses
+		cursor, err := s.coursesCol.Find(sessCtx, bson.M{"semester": semester}, options.Find().SetProjection(bson.M{"_id": 1}))
+		if err != nil {
+			return err
+		}
+		var courses []struct {
+			ID string `bson:"_id"`
+		}
+		if err := cursor.All(sessCtx, &courses); err != nil {
+			return err
+		}
+		if len(courses) == 0 {
+			return status.Errorf(codes.NotFound, "no courses found for semester %s", semester)
+		}
+		courseIDs := make([]string, 0, len(courses))
+		for _, c := range courses {
+			courseIDs = append(courseIDs, c.ID)
+		}
+
+		// 2. Complete the active enrollments
+		now := time.Now()
+		closure = shared.SemesterClosure{Semester: semester, ClosedBy: req.AdminId, ClosedAt: now, CoursesClosed: int32(len(courseIDs))}
+		res, err := s.enrollmentsCol.UpdateMany(sessCtx,
+			bson.M{"course_id": bson.M{"$in": courseIDs}, "status": shared.StatusEnrolled},
+			bson.M{"$set": bson.M{"status": shared.StatusCompleted, "completed_at": now}},
+		)
+		if err != nil {
+			return err
+		}
+		closure.EnrollmentsCompleted = int32(res.ModifiedCount)
+
+		// 3. Seats held by late withdrawals lapse with the term, leaving none to count
+		if _, err := s.enrollmentsCol.UpdateMany(sessCtx,
+			bson.M{"course_id": bson.M{"$in": courseIDs}, "status": shared.StatusWithdrawn, "seat_held": true},
+			bson.M{"$unset": bson.M{"seat_held": ""}},
+		); err != nil {
+			return err
+		}
+		if _, err := s.coursesCol.UpdateMany(sessCtx,
+			bson.M{"_id": bson.M{"$in": courseIDs}},
+			bson.M{"$set": bson.M{"enrolled": 0, "is_open": false, "updated_at": now}},
+		); err != nil {
+			return err
+		}
+
+		// 4. Record the closure; its _id is the semester, so a concurrent run fails here
+		if _, err := s.closuresCol.InsertOne(sessCtx, &closure); err != nil {
+			if mongo.IsDuplicateKeyError(err) {
+				return shared.ErrSemesterClosed.Newf("semester %s has already been closed", semester).WithParam("semester", semester)
+			}
+			return err
+		}
+
+		return shared.RecordAuditEvent(sessCtx, s.client, s.auditLogsCol, req.AdminId, shared.ActionSemesterClose, semester, map[string]interface{}{
+			"courses_closed":        closure.CoursesClosed,
+			"enrollments_completed": closure.EnrollmentsCompleted,
+		})
+	})
+	if err != nil {
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+		return nil, status.Errorf(codes.Internal, "failed to close semester: %v", err)
+	}
+	s.courseCatalogChanged(queryCtx)
+
+	return &pb.CloseSemesterResponse{
+		Success:              true,
+		Message:              fmt.Sprintf("closed %s: %d enrollments completed in %d courses", semester, closure.EnrollmentsCompleted, closure.CoursesClosed),
+		CoursesClosed:        closure.CoursesClosed,
+		EnrollmentsCompleted: closure.EnrollmentsCompleted,
+	}, nil
+}
+
+// PublishAllGrades publishes every unpublished grade in a semester's courses at once,
+// for when faculty have not published by the deadline. Grades are published, students
+// optionally notified and one audit event recorded in a single transaction. The
+// request must set confirm, as no faculty member gets to review the grades first.
+func (s *AdminService) PublishAllGrades(ctx context.Context, req *pb.PublishAllGradesRequest) (*pb.PublishAllGradesResponse, error) {
+	semester := strings.TrimSpace(req.Semester)
+	if semester == "" {
+		return nil, status.Error(codes.InvalidArgument, "semester is required")
+	}
+	if !req.Confirm {
+		return nil, shared.ErrInvalidField.Newf("set confirm to publish every grade of %s", semester).WithParam("field", "confirm")
+	}
+
+	queryCtx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	var published, coursesAffected, notified int32
+	err := shared.WithTransaction(queryCtx, s.client, func(sessCtx mongo.SessionContext) error {
+		published, coursesAffected, notified = 0, 0, 0
+
+		// 1. Find the semester's courses
+		courseIDs, err := s.coursesCol.Distinct(sessCtx, "_id", bson.M{"semester": semester})
+		if err != nil {
+			return err
+		}
+		if len(courseIDs) == 0 {
+			return status.Errorf(codes.NotFound, "no courses found for semester %s", semester)
+		}
+
+		// 2. Collect the unpublished grades, remembering who to notify
+		filter := bson.M{"course_id": bson.M{"$in": courseIDs}, "published": bson.M{"$ne": true}}
+		cursor, err := s.gradesCol.Find(sessCtx, filter, options.Find().SetProjection(bson.M{"student_id": 1, "course_id": 1}))
+		if err != nil {
+			return err
+		}
+		var pending []struct {
+			StudentID string `bson:"student_id"`
+			CourseID  string `bson:"course_id"`
+		}
+		if err := cursor.All(sessCtx, &pending); err != nil {
+			return err
+		}
+		if len(pending) == 0 {
+			return nil
+		}
+
+		// 3. Publish them
+		now := time.Now()
+		res, err := s.gradesCol.UpdateMany(sessCtx, filter, bson.M{"$set": bson.M{
+			"published":        true,
+			"published_at":     now,
+			"last_modified_by": req.AdminId,
+			"last_modified_at": now,
+		}})
+		if err != nil {
+			return err
+		}
+		published = int32(res.ModifiedCount)
+
+		courses := make(map[string]bool)
+		var students []string
+		seen := make(map[string]bool)
+		for _, g := range pending {
+			courses[g.CourseID] = true
+			if g.StudentID != "" && !seen[g.StudentID] {
+				seen[g.StudentID] = true
+				students = append(students, g.StudentID)
+			}
+		}
+		coursesAffected = int32(len(courses))
+
+		// 4. Tell each student once, however many of their grades were published
+		if req.NotifyStudents && len(students) > 0 {
+			message := fmt.Sprintf("Your grades for %s have been published", semester)
+			notifications := make([]interface{}, 0, len(students))
+			for _, studentID := range students {
+				notifications = append(notifications, shared.Notification{
+					ID:        shared.GenerateNotificationID(),
+					UserID:    studentID,
+					Type:      shared.NotificationGradesPublished,
+					Message:   message,
+					CreatedAt: now,
+				})
+			}
+			if _, err := s.notificationsCol.InsertMany(sessCtx, notifications); err != nil {
+				return err
+			}
+			notified = int32(len(students))
+		}
+
+		return shared.RecordAuditEvent(sessCtx, s.client, s.auditLogsCol, req.AdminId, shared.ActionGradesPublish, semester, map[string]interface{}{
+			"grades_published":  published,
+			"courses_affected":  coursesAffected,
+			"students_notified": notified,
+		})
+	})
+	if err != nil {
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+		return nil, status.Errorf(codes.Internal, "failed to publish grades: %v", err)
+	}
+
+	message := "no grades to publish"
+	if published > 0 {
+		message = fmt.Sprintf("published %d grades in %d courses of %s", published, coursesAffected, semester)
+	}
+	return &pb.PublishAllGradesResponse{
+		Success:          true,
+		Message:          message,
+		GradesPublished:  published,
+		CoursesAffected:  coursesAffected,
+		StudentsNotified: notified,
+	}, nil
+}
+
+// GetNearlyFullCourses lists courses whose fill rate has reached the threshold,
+// fullest first, so admins can decide where to open another section
+func (s *AdminService) GetNearlyFullCourses(ctx context.Context, req *pb.GetNearlyFullCoursesRequest) (*pb.GetNearlyFullCoursesResponse, error) {
+	if req.Threshold < 0 || req.Threshold > 100 {
+		return nil, status.Error(codes.InvalidArgument, "threshold must be between 1 and 100")
+	}
+
+	queryCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	threshold := req.Threshold
+	if threshold == 0 {
+		threshold = shared.GetCapacityWarningPercent(queryCtx, s.systemConfigCol)
+	}
+
+	// enrolled / capacity >= threshold%, kept in integers
+	filter := bson.M{
+		"capacity": bson.M{"$gt": 0},
+		"$expr": bson.M{"$gte": bson.A{
+			bson.M{"$multiply": bson.A{"$enrolled", 100}},
+			bson.M{"$multiply": bson.A{"$capacity", threshold}},
+		}},
+	}
+	if req.Semester != "" {
+		filter["semester"] = req.Semester
+	}
+
+	cursor, err := s.coursesCol.Find(queryCtx, filter)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to load courses")
+	}
+	var courses []shared.Course
+	if err := cursor.All(queryCtx, &courses); err != nil {
+		return nil, status.Error(codes.Internal, "failed to decode courses")
+	}
+
+	sort.SliceStable(courses, func(i, j int) bool {
+		if fi, fj := courses[i].FillRate(), courses[j].FillRate(); fi != fj {
+			return fi > fj
+		}
+		return courses[i].Code < courses[j].Code
+	})
 
-	return &pb.ToggleUserStatusResponse{Success: true, Message: "status updated"}, nil
+	results := make([]*pb.NearlyFullCourse, 0, len(courses))
+	for i := range courses {
+		c := &courses[i]
+		results = append(results, &pb.NearlyFullCourse{
+			CourseId:   c.ID,
+			CourseCode: c.Code,
+			Title:      c.Title,
+			Semester:   c.Semester,
+			Enrolled:   c.Enrolled,
+			Capacity:   c.Capacity,
+			FillRate:   math.Round(c.FillRate()*10) / 10,
+			IsOpen:     c.IsOpen,
+		})
+	}
+
+	return &pb.GetNearlyFullCoursesResponse{
+		Success:   true,
+		Message:   fmt.Sprintf("%d courses at or above %d%% capacity", len(results), threshold),
+		Threshold: threshold,
+		Courses:   results,
+	}, nil
 }
 
-// ============================================================================
-// System Config
-// ============================================================================
+// GetCourseFillTimeline returns the course's enrolled count over time. The timeline
+// is derived from enrolled_at/dropped_at in the enrollments collection, so it needs
+// no extra storage and covers history from before this report existed.
+func (s *AdminService) GetCourseFillTimeline(ctx context.Context, req *pb.GetCourseFillTimelineRequest) (*pb.GetCourseFillTimelineResponse, error) {
+	if req.CourseId == "" {
+		return nil, status.Error(codes.InvalidArgument, "course_id is required")
+	}
 
-func (s *AdminService) SetEnrollmentPeriod(ctx context.Context, req *pb.SetEnrollmentPeriodRequest) (*pb.SetEnrollmentPeriodResponse, error) {
-	// Simple passthrough to update config
-	s.UpdateSystemConfig(ctx, &pb.UpdateSystemConfigRequest{Key: "enrollment_start", Value: req.StartDate})
-	s.UpdateSystemConfig(ctx, &pb.UpdateSystemConfigRequest{Key: "enrollment_end", Value: req.EndDate})
-	return &pb.SetEnrollmentPeriodResponse{Success: true, Message: "dates set"}, nil
-}
+	queryCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
 
-func (s *AdminService) ToggleEnrollment(ctx context.Context, req *pb.ToggleEnrollmentRequest) (*pb.ToggleEnrollmentResponse, error) {
-	val := "false"
-	if req.Enable {
-		val = "true"
+	var course shared.Course
+	if err := s.coursesCol.FindOne(queryCtx, bson.M{"_id": req.CourseId}).Decode(&course); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, shared.ErrCourseNotFound.WithParam("course_id", req.CourseId)
+		}
+		return nil, status.Error(codes.Internal, "failed to load course")
 	}
-	s.UpdateSystemConfig(ctx, &pb.UpdateSystemConfigRequest{Key: "enrollment_enabled", Value: val})
-	return &pb.ToggleEnrollmentResponse{Success: true, EnrollmentOpen: req.Enable, Message: "enrollment toggled"}, nil
+
+	opts := options.Find().SetProjection(bson.M{"status": 1, "enrolled_at": 1, "dropped_at": 1})
+	cursor, err := s.enrollmentsCol.Find(queryCtx, bson.M{"course_id": req.CourseId}, opts)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to load enrollments")
+	}
+	var enrollments []shared.Enrollment
+	if err := cursor.All(queryCtx, &enrollments); err != nil {
+		return nil, status.Error(codes.Internal, "failed to decode enrollments")
+	}
+
+	points := fillTimeline(enrollments)
+	return &pb.GetCourseFillTimelineResponse{
+		Success:    true,
+		Message:    fmt.Sprintf("%d points", len(points)),
+		CourseId:   course.ID,
+		CourseCode: course.Code,
+		Capacity:   course.Capacity,
+		Points:     points,
+	}, nil
 }
 
-func (s *AdminService) GetSystemConfig(ctx context.Context, req *pb.GetSystemConfigRequest) (*pb.GetSystemConfigResponse, error) {
-	queryCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+// GetFacultyLoadReport summarizes each faculty member's teaching load for a semester:
+// courses taught, units, enrolled students and weekly contact hours, flagging loads
+// above max_units. With include_unassigned, active faculty without courses are
+// listed last so underloaded instructors show up too.
+func (s *AdminService) GetFacultyLoadReport(ctx context.Context, req *pb.GetFacultyLoadReportRequest) (*pb.GetFacultyLoadReportResponse, error) {
+	if req.Semester == "" {
+		return nil, status.Error(codes.InvalidArgument, "semester is required")
+	}
+	if req.MaxUnits < 0 {
+		return nil, status.Error(codes.InvalidArgument, "max_units must not be negative")
+	}
+
+	queryCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
 	defer cancel()
 
-	filter := bson.M{}
-	if req.Key != "" {
-		filter["key"] = req.Key
-	}
-	cursor, err := s.systemConfigCol.Find(queryCtx, filter)
+	maxUnits := req.MaxUnits
+	if maxUnits == 0 {
+		maxUnits = int32(s.getIntConfig(queryCtx, shared.ConfigFacultyLoadMaxUnits, shared.DefaultFacultyLoadMaxUnits))
+	}
+
+	// Unassigned courses carry no faculty load
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{"semester": req.Semester, "faculty_id": bson.M{"$nin": bson.A{"", nil}}}}},
+		{{Key: "$sort", Value: bson.M{"code": 1}}},
+		{{Key: "$group", Value: bson.M{
+			"_id":            "$faculty_id",
+			"course_count":   bson.M{"$sum": 1},
+			"total_units":    bson.M{"$sum": "$units"},
+			"total_enrolled": bson.M{"$sum": "$enrolled"},
+			"course_codes":   bson.M{"$push": "$code"},
+			"schedules":      bson.M{"$push": "$schedule"},
+		}}},
+		{{Key: "$lookup", Value: bson.M{
+			"from":         "users",
+			"localField":   "_id",
+			"foreignField": "_id",
+			"as":           "faculty",
+		}}},
+		{{Key: "$set", Value: bson.M{
+			"name":  bson.M{"$arrayElemAt": bson.A{"$faculty.name", 0}},
+			"email": bson.M{"$arrayElemAt": bson.A{"$faculty.email", 0}},
+		}}},
+		{{Key: "$sort", Value: bson.D{{Key: "total_units", Value: -1}, {Key: "_id", Value: 1}}}},
+	}
+	cursor, err := s.coursesCol.Aggregate(queryCtx, pipeline)
 	if err != nil {
-		return nil, err
+		return nil, status.Error(codes.Internal, "failed to aggregate faculty load")
+	}
+	var rows []struct {
+		FacultyID     string   `bson:"_id"`
+		Name          string   `bson:"name"`
+		Email         string   `bson:"email"`
+		CourseCount   int32    `bson:"course_count"`
+		TotalUnits    int32    `bson:"total_units"`
+		TotalEnrolled int32    `bson:"total_enrolled"`
+		CourseCodes   []string `bson:"course_codes"`
+		Schedules     []string `bson:"schedules"`
+	}
+	if err := cursor.All(queryCtx, &rows); err != nil {
+		return nil, status.Error(codes.Internal, "failed to decode faculty load")
+	}
+
+	faculty := make([]*pb.FacultyLoad, 0, len(rows))
+	overloaded := 0
+	teaching := make([]string, 0, len(rows))
+	for _, r := range rows {
+		var contactHours float64
+		for _, schedule := range r.Schedules {
+			contactHours += shared.WeeklyContactHours(schedule)
+		}
+		teaching = append(teaching, r.FacultyID)
+		load := &pb.FacultyLoad{
+			FacultyId:     r.FacultyID,
+			FacultyName:   r.Name,
+			Email:         r.Email,
+			CourseCount:   r.CourseCount,
+			TotalUnits:    r.TotalUnits,
+			TotalEnrolled: r.TotalEnrolled,
+			Overloaded:    r.TotalUnits > maxUnits,
+			CourseCodes:   r.CourseCodes,
+			ContactHours:  contactHours,
+		}
+		if load.Overloaded {
+			overloaded++
+		}
+		faculty = append(faculty, load)
 	}
-	defer cursor.Close(queryCtx)
 
-	var configs []*pb.SystemConfig
-	for cursor.Next(queryCtx) {
-		var c shared.SystemConfig
-		if err := cursor.Decode(&c); err == nil {
-			configs = append(configs, &pb.SystemConfig{
-				Key: c.Key, Value: c.Value, UpdatedAt: timestamppb.New(c.UpdatedAt), UpdatedBy: c.UpdatedBy,
+	if req.IncludeUnassigned {
+		cursor, err := s.usersCol.Find(queryCtx, bson.M{
+			"role":      shared.RoleFaculty,
+			"is_active": true,
+			"_id":       bson.M{"$nin": teaching},
+		}, options.Find().SetSort(bson.M{"_id": 1}))
+		if err != nil {
+			return nil, status.Error(codes.Internal, "failed to list unassigned faculty")
+		}
+		var idle []shared.User
+		if err := cursor.All(queryCtx, &idle); err != nil {
+			return nil, status.Error(codes.Internal, "failed to decode unassigned faculty")
+		}
+		for _, u := range idle {
+			faculty = append(faculty, &pb.FacultyLoad{
+				FacultyId:   u.ID,
+				FacultyName: u.Name,
+				Email:       u.Email,
+				CourseCodes: []string{},
 			})
 		}
 	}
-	return &pb.GetSystemConfigResponse{Configs: configs}, nil
+
+	return &pb.GetFacultyLoadReportResponse{
+		Success:  true,
+		Message:  fmt.Sprintf("%d faculty teaching in %s, %d above %d units", len(teaching), req.Semester, overloaded, maxUnits),
+		Semester: req.Semester,
+		MaxUnits: maxUnits,
+		Faculty:  faculty,
+	}, nil
 }
 
-func (s *AdminService) UpdateSystemConfig(ctx context.Context, req *pb.UpdateSystemConfigRequest) (*pb.UpdateSystemConfigResponse, error) {
-	queryCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+// fillTimeline turns enrollments into cumulative (timestamp, enrolled) points: each
+// enrollment adds a seat at enrolled_at and, if it is currently dropped, frees it at
+// dropped_at. Changes sharing a timestamp (one EnrollAll transaction) form one point.
+// Records without an enrolled_at cannot be placed and are skipped.
+func fillTimeline(enrollments []shared.Enrollment) []*pb.FillTimelinePoint {
+	type change struct {
+		at    time.Time
+		delta int32
+	}
+	var changes []change
+	for _, e := range enrollments {
+		if e.EnrolledAt.IsZero() {
+			continue
+		}
+		changes = append(changes, change{e.EnrolledAt, 1})
+		if e.Status == shared.StatusDropped && !e.DroppedAt.IsZero() {
+			changes = append(changes, change{e.DroppedAt, -1})
+		}
+	}
+	sort.SliceStable(changes, func(i, j int) bool { return changes[i].at.Before(changes[j].at) })
+
+	points := []*pb.FillTimelinePoint{}
+	var enrolled int32
+	for i, c := range changes {
+		enrolled += c.delta
+		if i+1 < len(changes) && changes[i+1].at.Equal(c.at) {
+			continue
+		}
+		points = append(points, &pb.FillTimelinePoint{
+			Timestamp: shared.ToProtoTime(c.at),
+			Enrolled:  enrolled,
+		})
+	}
+	return points
+}
+
+// GetEnrollmentTrend buckets the enrollment_events of a course, or of every course
+// in a department, by hour or day so admins can see how fast seats were taken
+func (s *AdminService) GetEnrollmentTrend(ctx context.Context, req *pb.GetEnrollmentTrendRequest) (*pb.GetEnrollmentTrendResponse, error) {
+	if (req.CourseId == "") == (req.Department == "") {
+		return nil, status.Error(codes.InvalidArgument, "exactly one of course_id or department is required")
+	}
+	interval := strings.ToLower(req.Interval)
+	if interval == "" {
+		interval = "day"
+	}
+	if _, ok := trendIntervals[interval]; !ok {
+		return nil, status.Error(codes.InvalidArgument, "interval must be hour or day")
+	}
+
+	queryCtx, cancel := context.WithTimeout(ctx, 30*time.Second)
 	defer cancel()
 
-	opts := options.Update().SetUpsert(true)
-	_, err := s.systemConfigCol.UpdateOne(queryCtx, bson.M{"key": req.Key}, bson.M{
-		"$set": bson.M{"value": req.Value, "updated_by": req.AdminId, "updated_at": time.Now()},
-	}, opts)
+	match := bson.M{}
+	if req.CourseId != "" {
+		count, err := s.coursesCol.CountDocuments(queryCtx, bson.M{"_id": req.CourseId})
+		if err != nil {
+			return nil, status.Error(codes.Internal, "failed to load course")
+		}
+		if count == 0 {
+			return nil, shared.ErrCourseNotFound.WithParam("course_id", req.CourseId)
+		}
+		match["course_id"] = req.CourseId
+	} else {
+		department := bson.M{"department": shared.NormalizeDepartment(req.Department)}
+		cursor, err := s.coursesCol.Find(queryCtx, department, options.Find().SetProjection(bson.M{"_id": 1}))
+		if err != nil {
+			return nil, status.Error(codes.Internal, "failed to load courses")
+		}
+		var courses []shared.Course
+		if err := cursor.All(queryCtx, &courses); err != nil {
+			return nil, status.Error(codes.Internal, "failed to decode courses")
+		}
+		ids := make([]string, 0, len(courses))
+		for _, c := range courses {
+			ids = append(ids, c.ID)
+		}
+		match["course_id"] = bson.M{"$in": ids}
+	}
+
+	timestamp := bson.M{}
+	if req.From != nil {
+		timestamp["$gte"] = req.From.AsTime()
+	}
+	if req.To != nil {
+		timestamp["$lt"] = req.To.AsTime()
+	}
+	if len(timestamp) > 0 {
+		match["timestamp"] = timestamp
+	}
+
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: match}},
+		{{Key: "$group", Value: bson.M{
+			"_id":     bson.M{"$dateTrunc": bson.M{"date": "$timestamp", "unit": interval}},
+			"enrolls": bson.M{"$sum": bson.M{"$cond": bson.A{bson.M{"$gt": bson.A{"$delta", 0}}, "$delta", 0}}},
+			"drops":   bson.M{"$sum": bson.M{"$cond": bson.A{bson.M{"$lt": bson.A{"$delta", 0}}, bson.M{"$abs": "$delta"}, 0}}},
+		}}},
+		{{Key: "$sort", Value: bson.M{"_id": 1}}},
+	}
+	cursor, err := s.eventsCol.Aggregate(queryCtx, pipeline)
 	if err != nil {
-		return nil, err
+		return nil, status.Error(codes.Internal, "failed to aggregate enrollment events")
+	}
+	var rows []trendRow
+	if err := cursor.All(queryCtx, &rows); err != nil {
+		return nil, status.Error(codes.Internal, "failed to decode enrollment trend")
 	}
 
-	shared.LogAuditEvent(queryCtx, s.auditLogsCol, req.AdminId, shared.ActionConfigChange, req.Key, nil)
-	return &pb.UpdateSystemConfigResponse{Success: true, Message: "updated"}, nil
+	buckets, err := trendBuckets(rows, trendIntervals[interval])
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return &pb.GetEnrollmentTrendResponse{
+		Success:  true,
+		Message:  fmt.Sprintf("%d buckets", len(buckets)),
+		Interval: interval,
+		Buckets:  buckets,
+	}, nil
+}
+
+// trendRow is one bucket from the GetEnrollmentTrend aggregation
+type trendRow struct {
+	Start   time.Time `bson:"_id"`
+	Enrolls int32     `bson:"enrolls"`
+	Drops   int32     `bson:"drops"`
+}
+
+// trendBuckets turns sorted aggregation rows into a contiguous series, adding empty
+// buckets between rows so charts get an evenly spaced axis
+func trendBuckets(rows []trendRow, width time.Duration) ([]*pb.EnrollmentTrendBucket, error) {
+	buckets := []*pb.EnrollmentTrendBucket{}
+	if len(rows) == 0 {
+		return buckets, nil
+	}
+	first, last := rows[0].Start.UTC(), rows[len(rows)-1].Start.UTC()
+	if n := int(last.Sub(first)/width) + 1; n > maxTrendBuckets {
+		return nil, fmt.Errorf("range spans %d buckets (max %d); narrow it with from/to or use a wider interval", n, maxTrendBuckets)
+	}
+
+	var cumulative int32
+	next := 0
+	for start := first; !start.After(last); start = start.Add(width) {
+		bucket := &pb.EnrollmentTrendBucket{Start: shared.ToProtoTime(start)}
+		if next < len(rows) && rows[next].Start.Equal(start) {
+			bucket.Enrolls = rows[next].Enrolls
+			bucket.Drops = rows[next].Drops
+			next++
+		}
+		bucket.Net = bucket.Enrolls - bucket.Drops
+		cumulative += bucket.Net
+		bucket.Cumulative = cumulative
+		buckets = append(buckets, bucket)
+	}
+	return buckets, nil
 }
 
 // ============================================================================
-// Overrides (Transactions)
+// Audit
 // ============================================================================
 
-func (s *AdminService) OverrideEnrollment(ctx context.Context, req *pb.OverrideEnrollmentRequest) (*pb.OverrideEnrollmentResponse, error) {
-	if req.Action != "force_enroll" && req.Action != "force_drop" {
-		return nil, status.Error(codes.InvalidArgument, "invalid action")
+// GetResourceHistory returns one page of the audit events recorded against a resource, oldest first
+func (s *AdminService) GetResourceHistory(ctx context.Context, req *pb.GetResourceHistoryRequest) (*pb.GetResourceHistoryResponse, error) {
+	resource := strings.TrimSpace(req.Resource)
+	if resource == "" {
+		return nil, status.Error(codes.InvalidArgument, "resource is required")
 	}
 
-	queryCtx, cancel := context.WithTimeout(ctx, 15*time.Second)
+	queryCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
 	defer cancel()
 
-	// 1. Verify Entities
-	var student shared.User
-	if err := s.usersCol.FindOne(queryCtx, bson.M{"_id": req.StudentId, "role": shared.RoleStudent}).Decode(&student); err != nil {
-		return &pb.OverrideEnrollmentResponse{Success: false, Message: "student not found"}, nil
+	page, pageSize := shared.NormalizePage(req.Page, req.PageSize, defaultHistoryPageSize, maxHistoryPageSize)
+
+	// Served by the (resource, timestamp) index from EnsureIndexes
+	opts := options.Find().SetSort(bson.D{{Key: "timestamp", Value: 1}, {Key: "_id", Value: 1}})
+	logs, pageInfo, err := shared.Paginate[shared.AuditLog](queryCtx, s.auditLogsCol, bson.M{"resource": resource}, opts, page, pageSize)
+	if err != nil {
+		log.Printf("Error querying history of %s: %v", resource, err)
+		return nil, status.Error(codes.Internal, "db error")
 	}
 
-	var course bson.M
-	if err := s.coursesCol.FindOne(queryCtx, bson.M{"_id": req.CourseId}).Decode(&course); err != nil {
-		return &pb.OverrideEnrollmentResponse{Success: false, Message: "course not found"}, nil
+	events := make([]*pb.AuditEvent, 0, len(logs))
+	for i := range logs {
+		events = append(events, auditLogToProto(&logs[i]))
 	}
+	return &pb.GetResourceHistoryResponse{
+		Success:    true,
+		Message:    fmt.Sprintf("%d events", pageInfo.Total),
+		Resource:   resource,
+		Events:     events,
+		TotalCount: int32(pageInfo.Total),
+		Page:       pageInfo.Page,
+		PageSize:   pageInfo.PageSize,
+	}, nil
+}
 
-	// 2. Perform Transaction using Shared Helper
-	err := shared.WithTransaction(queryCtx, s.client, func(sessCtx mongo.SessionContext) error {
-		if req.Action == "force_enroll" {
-			// Check existing
-			count, _ := s.enrollmentsCol.CountDocuments(sessCtx, bson.M{"student_id": req.StudentId, "course_id": req.CourseId, "status": shared.StatusEnrolled})
-			if count > 0 {
-				return fmt.Errorf("already enrolled")
-			}
+// EnsureIndexes creates the audit log index used by GetResourceHistory
+func (s *AdminService) EnsureIndexes(ctx context.Context) error {
+	indexCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
 
-			// Create Enrollment
-			enrollmentID := shared.GenerateEnrollmentID()
-			scheduleInfo := shared.ExtractScheduleInfo(course) // Use Shared Helper
+	_, err := s.auditLogsCol.Indexes().CreateOne(indexCtx, mongo.IndexModel{
+		Keys:    bson.D{{Key: "resource", Value: 1}, {Key: "timestamp", Value: 1}},
+		Options: options.Index().SetName("audit_resource_timestamp"),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create audit log index: %w", err)
+	}
 
-			_, err := s.enrollmentsCol.InsertOne(sessCtx, bson.M{
-				"_id": enrollmentID, "student_id": req.StudentId, "course_id": req.CourseId,
-				"status": shared.StatusEnrolled, "enrolled_at": time.Now(), "schedule_info": scheduleInfo,
-			})
-			if err != nil {
-				return err
-			}
+	_, err = s.eventsCol.Indexes().CreateOne(indexCtx, mongo.IndexModel{
+		Keys:    bson.D{{Key: "course_id", Value: 1}, {Key: "timestamp", Value: 1}},
+		Options: options.Index().SetName("enrollment_event_course_timestamp"),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create enrollment event index: %w", err)
+	}
 
-			// Inc Course
-			if _, err := s.coursesCol.UpdateOne(sessCtx, bson.M{"_id": req.CourseId}, bson.M{"$inc": bson.M{"enrolled": 1}}); err != nil {
-				return err
-			}
+	// Fails while accounts differ only by email case; see FindDuplicateEmails
+	_, err = s.usersCol.Indexes().CreateOne(indexCtx, mongo.IndexModel{
+		Keys:    bson.D{{Key: "email", Value: 1}},
+		Options: options.Index().SetName("user_email_unique").SetUnique(true).SetCollation(shared.EmailCollation),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create unique email index: %w", err)
+	}
+	return nil
+}
 
-		} else { // force_drop
-			res, err := s.enrollmentsCol.UpdateOne(sessCtx,
-				bson.M{"student_id": req.StudentId, "course_id": req.CourseId, "status": shared.StatusEnrolled},
-				bson.M{"$set": bson.M{"status": shared.StatusDropped, "dropped_at": time.Now()}},
-			)
-			if err != nil {
-				return err
-			}
-			if res.MatchedCount == 0 {
-				return fmt.Errorf("enrollment not found")
-			}
+// DuplicateEmail is a login email shared, ignoring case, by more than one account
+type DuplicateEmail struct {
+	Email   string   // lowercased
+	UserIDs []string // accounts using it in any case
+}
 
-			// Dec Course
-			if _, err := s.coursesCol.UpdateOne(sessCtx, bson.M{"_id": req.CourseId}, bson.M{"$inc": bson.M{"enrolled": -1}}); err != nil {
-				return err
-			}
-		}
+// FindDuplicateEmails lists emails held by several accounts once case is ignored.
+// These predate normalization and need an admin to merge or rename them.
+func (s *AdminService) FindDuplicateEmails(ctx context.Context) ([]DuplicateEmail, error) {
+	queryCtx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
 
-		shared.LogAuditEvent(sessCtx, s.auditLogsCol, req.AdminId, req.Action, fmt.Sprintf("%s:%s", req.StudentId, req.CourseId), nil)
-		return nil
-	})
+	pipeline := mongo.Pipeline{
+		{{Key: "$group", Value: bson.M{
+			"_id":      bson.M{"$toLower": bson.M{"$trim": bson.M{"input": "$email"}}},
+			"user_ids": bson.M{"$push": "$_id"},
+			"count":    bson.M{"$sum": 1},
+		}}},
+		{{Key: "$match", Value: bson.M{"count": bson.M{"$gt": 1}}}},
+		{{Key: "$sort", Value: bson.M{"_id": 1}}},
+	}
+	cursor, err := s.usersCol.Aggregate(queryCtx, pipeline)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find duplicate emails: %w", err)
+	}
+	var rows []struct {
+		Email   string   `bson:"_id"`
+		UserIDs []string `bson:"user_ids"`
+	}
+	if err := cursor.All(queryCtx, &rows); err != nil {
+		return nil, fmt.Errorf("failed to decode duplicate emails: %w", err)
+	}
+
+	dupes := make([]DuplicateEmail, 0, len(rows))
+	for _, r := range rows {
+		dupes = append(dupes, DuplicateEmail{Email: r.Email, UserIDs: r.UserIDs})
+	}
+	return dupes, nil
+}
+
+// NormalizeStoredEmails lowercases login emails stored before normalization, so
+// Login's exact match finds them. Emails listed by FindDuplicateEmails are left
+// alone. It returns how many accounts were updated.
+func (s *AdminService) NormalizeStoredEmails(ctx context.Context, dupes []DuplicateEmail) (int64, error) {
+	updateCtx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	var skip []string
+	for _, d := range dupes {
+		skip = append(skip, d.UserIDs...)
+	}
+	filter := bson.M{"email": bson.M{"$regex": `[A-Z]|^\s|\s$`}}
+	if len(skip) > 0 {
+		filter["_id"] = bson.M{"$nin": skip}
+	}
 
+	res, err := s.usersCol.UpdateMany(updateCtx, filter, mongo.Pipeline{
+		{{Key: "$set", Value: bson.M{"email": bson.M{"$toLower": bson.M{"$trim": bson.M{"input": "$email"}}}}}},
+	})
 	if err != nil {
-		return &pb.OverrideEnrollmentResponse{Success: false, Message: err.Error()}, nil
+		return 0, fmt.Errorf("failed to normalize stored emails: %w", err)
 	}
+	return res.ModifiedCount, nil
+}
 
-	return &pb.OverrideEnrollmentResponse{Success: true, Message: "override successful"}, nil
+// auditLogToProto maps a stored audit log to the Protobuf message, rendering details as text
+func auditLogToProto(l *shared.AuditLog) *pb.AuditEvent {
+	details := make(map[string]string, len(l.Details))
+	for k, v := range l.Details {
+		if dt, ok := v.(primitive.DateTime); ok {
+			details[k] = dt.Time().UTC().Format(time.RFC3339)
+			continue
+		}
+		details[k] = fmt.Sprint(v)
+	}
+	return &pb.AuditEvent{
+		Id:        l.ID,
+		Timestamp: shared.ToProtoTime(l.Timestamp),
+		UserId:    l.UserID,
+		Action:    l.Action,
+		Resource:  l.Resource,
+		Details:   details,
+		IpAddress: l.IPAddress,
+	}
 }
 
 // ============================================================================
@@ -527,19 +2789,77 @@ func (s *AdminService) GetSystemStats(ctx context.Context, req *pb.GetSystemStat
 	defer cancel()
 
 	stats := &pb.SystemStats{}
+	now := time.Now()
+	year, month, day := now.Date()
+	midnight := time.Date(year, month, day, 0, 0, 0, 0, now.Location())
+
+	// One aggregation per collection; a failed one leaves its figures at zero
+	var roles []struct {
+		Role  string `bson:"_id"`
+		Count int32  `bson:"count"`
+	}
+	if cursor, err := s.usersCol.Aggregate(queryCtx, mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{"role": bson.M{"$in": bson.A{shared.RoleStudent, shared.RoleFaculty}}}}},
+		{{Key: "$group", Value: bson.M{"_id": "$role", "count": bson.M{"$sum": 1}}}},
+	}); err == nil && cursor.All(queryCtx, &roles) == nil {
+		for _, r := range roles {
+			switch r.Role {
+			case shared.RoleStudent:
+				stats.TotalStudents = r.Count
+			case shared.RoleFaculty:
+				stats.TotalFaculty = r.Count
+			}
+		}
+	}
 
-	// Use shared.CountDocumentsWithTimeout if available, or just standard count
-	stCount, _ := s.usersCol.CountDocuments(queryCtx, bson.M{"role": shared.RoleStudent})
-	facCount, _ := s.usersCol.CountDocuments(queryCtx, bson.M{"role": shared.RoleFaculty})
-	crsCount, _ := s.coursesCol.CountDocuments(queryCtx, bson.M{})
-	openCount, _ := s.coursesCol.CountDocuments(queryCtx, bson.M{"is_open": true})
-	enrCount, _ := s.enrollmentsCol.CountDocuments(queryCtx, bson.M{"status": shared.StatusEnrolled})
-
-	stats.TotalStudents = int32(stCount)
-	stats.TotalFaculty = int32(facCount)
-	stats.TotalCourses = int32(crsCount)
-	stats.OpenCourses = int32(openCount)
-	stats.TotalEnrollments = int32(enrCount)
+	var courses []struct {
+		Total    int32   `bson:"total"`
+		Open     int32   `bson:"open"`
+		FillRate float64 `bson:"fill_rate"`
+	}
+	if cursor, err := s.coursesCol.Aggregate(queryCtx, mongo.Pipeline{
+		{{Key: "$group", Value: bson.M{
+			"_id":   nil,
+			"total": bson.M{"$sum": 1},
+			"open":  bson.M{"$sum": bson.M{"$cond": bson.A{"$is_open", 1, 0}}},
+			// Same as Course.FillRate; $avg skips the nulls of courses without a capacity
+			"fill_rate": bson.M{"$avg": bson.M{"$cond": bson.A{
+				bson.M{"$gt": bson.A{"$capacity", 0}},
+				bson.M{"$divide": bson.A{bson.M{"$multiply": bson.A{"$enrolled", 100}}, "$capacity"}},
+				nil,
+			}}},
+		}}},
+	}); err == nil && cursor.All(queryCtx, &courses) == nil && len(courses) == 1 {
+		stats.TotalCourses = courses[0].Total
+		stats.OpenCourses = courses[0].Open
+		stats.AverageFillRate = courses[0].FillRate
+	}
+
+	var enrollments []struct {
+		Enrolled int32 `bson:"enrolled"`
+		Today    int32 `bson:"today"`
+		Drops    int32 `bson:"drops"`
+	}
+	if cursor, err := s.enrollmentsCol.Aggregate(queryCtx, mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{"$or": bson.A{
+			bson.M{"status": shared.StatusEnrolled},
+			bson.M{"enrolled_at": bson.M{"$gte": midnight}},
+			bson.M{"dropped_at": bson.M{"$gte": midnight}},
+		}}}},
+		{{Key: "$group", Value: bson.M{
+			"_id":      nil,
+			"enrolled": bson.M{"$sum": bson.M{"$cond": bson.A{bson.M{"$eq": bson.A{"$status", shared.StatusEnrolled}}, 1, 0}}},
+			"today":    bson.M{"$sum": bson.M{"$cond": bson.A{bson.M{"$gte": bson.A{"$enrolled_at", midnight}}, 1, 0}}},
+			"drops":    bson.M{"$sum": bson.M{"$cond": bson.A{bson.M{"$gte": bson.A{"$dropped_at", midnight}}, 1, 0}}},
+		}}},
+	}); err == nil && cursor.All(queryCtx, &enrollments) == nil && len(enrollments) == 1 {
+		stats.TotalEnrollments = enrollments[0].Enrolled
+		stats.EnrollmentsToday = enrollments[0].Today
+		stats.DropsToday = enrollments[0].Drops
+	}
+
+	stats.EnrollmentOpen = shared.LoadEnrollmentWindow(queryCtx, s.systemConfigCol).CanEnroll(now)
+	stats.CurrentSemester = s.getStringConfig(queryCtx, shared.ConfigCurrentSemester, "")
 
 	return &pb.GetSystemStatsResponse{Stats: stats}, nil
 }
@@ -548,9 +2868,261 @@ func (s *AdminService) GetSystemStats(ctx context.Context, req *pb.GetSystemStat
 // Helpers
 // ============================================================================
 
+// resolveStudentNumber looks up a student by user ID or student number and returns the
+// student number (falling back to the user ID), which is how student flows key records
+func (s *AdminService) resolveStudentNumber(ctx context.Context, id string) (string, error) {
+	var student shared.User
+	err := s.usersCol.FindOne(ctx, bson.M{
+		"$or":  []bson.M{{"_id": id}, {"student_id": id}},
+		"role": shared.RoleStudent,
+	}).Decode(&student)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return "", shared.NewError(codes.NotFound, shared.ErrCodeStudentNotFound, "student not found")
+		}
+		return "", status.Error(codes.Internal, "db error")
+	}
+
+	if student.StudentID == "" {
+		return student.ID, nil
+	}
+	return student.StudentID, nil
+}
+
+// courseCatalogChanged makes course services drop catalog pages cached before a course
+// change; a failure only delays the change until the cache expires
+func (s *AdminService) courseCatalogChanged(ctx context.Context) {
+	if err := shared.BumpCatalogVersion(ctx, s.systemConfigCol); err != nil {
+		log.Printf("Warning: %v", err)
+	}
+}
+
+// getBoolConfig reads a boolean system config value, falling back to def when unset or invalid
+func (s *AdminService) getBoolConfig(ctx context.Context, key string, def bool) bool {
+	var cfg shared.SystemConfig
+	if err := s.systemConfigCol.FindOne(ctx, bson.M{"key": key}).Decode(&cfg); err != nil {
+		return def
+	}
+	v, err := strconv.ParseBool(cfg.Value)
+	if err != nil {
+		return def
+	}
+	return v
+}
+
+// getStringConfig reads a system config value, falling back to def when unset or blank
+func (s *AdminService) getStringConfig(ctx context.Context, key, def string) string {
+	var cfg shared.SystemConfig
+	if err := s.systemConfigCol.FindOne(ctx, bson.M{"key": key}).Decode(&cfg); err != nil {
+		return def
+	}
+	if v := strings.TrimSpace(cfg.Value); v != "" {
+		return v
+	}
+	return def
+}
+
+// getIntConfig reads a positive integer system config value, falling back to def when unset or invalid
+func (s *AdminService) getIntConfig(ctx context.Context, key string, def int) int {
+	var cfg shared.SystemConfig
+	if err := s.systemConfigCol.FindOne(ctx, bson.M{"key": key}).Decode(&cfg); err != nil {
+		return def
+	}
+	v, err := strconv.Atoi(cfg.Value)
+	if err != nil || v <= 0 {
+		return def
+	}
+	return v
+}
+
+// verifyFaculty checks that id is an active faculty member, returning a NotFound
+// status when it is not
 func (s *AdminService) verifyFaculty(ctx context.Context, id string) error {
-	res := s.usersCol.FindOne(ctx, bson.M{"_id": id, "role": shared.RoleFaculty, "is_active": true})
-	return res.Err()
+	err := s.usersCol.FindOne(ctx, bson.M{"_id": id, "role": shared.RoleFaculty, "is_active": true}).Err()
+	if err == mongo.ErrNoDocuments {
+		return status.Error(codes.NotFound, "faculty not found or inactive")
+	}
+	if err != nil {
+		return status.Error(codes.Internal, "db error")
+	}
+	return nil
+}
+
+// linkPrerequisite records prereqCode as a prerequisite of courseID. The code is
+// resolved against the current batch first, then the same semester, then any
+// existing offering of that course.
+func (s *AdminService) linkPrerequisite(ctx context.Context, courseID, prereqCode, semester string, batch map[string]string) error {
+	prereqID, ok := batch[courseKey(prereqCode, semester)]
+	if !ok {
+		var course shared.Course
+		err := s.coursesCol.FindOne(ctx, bson.M{"code": prereqCode, "semester": semester}).Decode(&course)
+		if err == mongo.ErrNoDocuments {
+			err = s.coursesCol.FindOne(ctx, bson.M{"code": prereqCode}).Decode(&course)
+		}
+		if err == mongo.ErrNoDocuments {
+			return fmt.Errorf("course not found")
+		}
+		if err != nil {
+			return fmt.Errorf("db error")
+		}
+		prereqID = course.ID
+	}
+	if prereqID == courseID {
+		return fmt.Errorf("course cannot require itself")
+	}
+
+	link := bson.M{"course_id": courseID, "prereq_id": prereqID}
+	if _, err := s.prerequisitesCol.UpdateOne(ctx, link, bson.M{"$setOnInsert": link}, options.Update().SetUpsert(true)); err != nil {
+		return fmt.Errorf("failed to link prerequisite")
+	}
+	return nil
+}
+
+// prerequisiteDependsOn reports whether courseID requires target, directly or through
+// a chain of prerequisites. The chain is walked one level per query.
+func (s *AdminService) prerequisiteDependsOn(ctx context.Context, courseID, target string) (bool, error) {
+	visited := map[string]bool{courseID: true}
+	frontier := []string{courseID}
+	for len(frontier) > 0 {
+		cursor, err := s.prerequisitesCol.Find(ctx, bson.M{"course_id": bson.M{"$in": frontier}})
+		if err != nil {
+			return false, err
+		}
+		var links []shared.Prerequisite
+		if err := cursor.All(ctx, &links); err != nil {
+			return false, err
+		}
+
+		frontier = nil
+		for _, link := range links {
+			if link.PrereqID == target {
+				return true, nil
+			}
+			if !visited[link.PrereqID] {
+				visited[link.PrereqID] = true
+				frontier = append(frontier, link.PrereqID)
+			}
+		}
+	}
+	return false, nil
+}
+
+// courseKey identifies a course offering by code and semester
+func courseKey(code, semester string) string {
+	return code + "|" + semester
+}
+
+// validateCourseText bounds the free-text course fields
+func validateCourseText(title, description string) error {
+	if err := shared.ValidateText("title", title, shared.MaxTitleLength, false); err != nil {
+		return err
+	}
+	return shared.ValidateText("description", description, shared.MaxDescriptionLength, true)
+}
+
+// validateCourseLimits checks the numeric course fields, returning a message when out of range
+func validateCourseLimits(units, capacity, minYearLevel int32) string {
+	if units < 1 || units > 5 {
+		return "units must be between 1 and 5"
+	}
+	if capacity < 5 || capacity > 100 {
+		return "capacity must be between 5 and 100"
+	}
+	if minYearLevel < 0 {
+		return "min_year_level cannot be negative"
+	}
+	return ""
+}
+
+// validateCourseSchedule checks a schedule at write time so conflict detection never
+// sees one ParseSchedule cannot read. Only courses explicitly marked unscheduled
+// (async/online) may go without one.
+func validateCourseSchedule(schedule string, unscheduled bool) string {
+	schedule = strings.TrimSpace(schedule)
+	switch {
+	case unscheduled && schedule != "":
+		return "an unscheduled course cannot have a schedule"
+	case unscheduled:
+		return ""
+	case schedule == "":
+		return "schedule is required (mark the course unscheduled for async/online delivery)"
+	}
+	if err := shared.ValidateSchedule(schedule); err != nil {
+		return fmt.Sprintf("invalid schedule %q: %v", schedule, err)
+	}
+	return ""
+}
+
+// validateCourseWindow checks a course's own enrollment window, returning a message when invalid
+func validateCourseWindow(openAt, closeAt string) string {
+	if _, _, err := shared.ParseCourseWindow(openAt, closeAt); err != nil {
+		return err.Error()
+	}
+	return ""
+}
+
+// newCourseDocument builds the stored document for a new course offering. It is
+// created closed unless it has its own window with an open time, in which case
+// the window decides when it becomes enrollable. The window must already be valid.
+func newCourseDocument(courseID string, def *pb.CourseDefinition) bson.M {
+	opensAt, closesAt, _ := shared.ParseCourseWindow(def.EnrollOpenAt, def.EnrollCloseAt)
+
+	courseDoc := bson.M{
+		"_id":         courseID,
+		"code":        def.Code,
+		"title":       def.Title,
+		"description": def.Description,
+		"units":       def.Units,
+		"schedule":    def.Schedule,
+		"room":        def.Room,
+		"capacity":    def.Capacity,
+		"enrolled":    0,
+		"faculty_id":  def.FacultyId,
+		"is_open":     !opensAt.IsZero(),
+		"semester":    def.Semester,
+		"created_at":  primitive.NewDateTimeFromTime(time.Now()),
+		"updated_at":  primitive.NewDateTimeFromTime(time.Now()),
+	}
+	if len(def.AllowedMajors) > 0 {
+		courseDoc["allowed_majors"] = def.AllowedMajors
+	}
+	if def.MinYearLevel > 0 {
+		courseDoc["min_year_level"] = def.MinYearLevel
+	}
+	if !opensAt.IsZero() {
+		courseDoc["enroll_open_at"] = primitive.NewDateTimeFromTime(opensAt)
+	}
+	if !closesAt.IsZero() {
+		courseDoc["enroll_close_at"] = primitive.NewDateTimeFromTime(closesAt)
+	}
+
+	// An explicit department wins; otherwise it is parsed from the code
+	department := shared.NormalizeDepartment(def.Department)
+	if department == "" {
+		department = shared.DepartmentFromCode(def.Code)
+	}
+	if department != "" {
+		courseDoc["department"] = department
+	}
+	if slot := shared.NewScheduleSlot(def.Schedule); slot != nil {
+		courseDoc["schedule_slot"] = slot
+	}
+	return courseDoc
+}
+
+// normalizeMajors trims and de-duplicates course major restrictions
+func normalizeMajors(majors []string) []string {
+	seen := make(map[string]bool, len(majors))
+	var result []string
+	for _, m := range majors {
+		m = strings.TrimSpace(m)
+		if m == "" || seen[strings.ToLower(m)] {
+			continue
+		}
+		seen[strings.ToLower(m)] = true
+		result = append(result, m)
+	}
+	return result
 }
 
 func (s *AdminService) generateRandomPassword() string {
@@ -598,6 +3170,24 @@ func (s *AdminService) documentToCourse(doc bson.M) *pb.Course {
 	if v, _ := shared.GetString(doc["semester"]); v != "" {
 		c.Semester = v
 	}
+	if v, err := shared.GetStringArray(doc["allowed_majors"]); err == nil {
+		c.AllowedMajors = v
+	}
+	if v, _ := shared.GetInt32(doc["min_year_level"]); v > 0 {
+		c.MinYearLevel = v
+	}
+	if v, _ := shared.GetString(doc["department"]); v != "" {
+		c.Department = v
+	}
+	if v, err := shared.GetTime(doc["enroll_open_at"]); err == nil {
+		c.EnrollOpenAt = shared.ToProtoTime(v)
+	}
+	if v, err := shared.GetTime(doc["enroll_close_at"]); err == nil {
+		c.EnrollCloseAt = shared.ToProtoTime(v)
+	}
+	c.Cancelled, _ = shared.GetBool(doc["cancelled"])
+	c.SeatsAvailable = shared.SeatsAvailable(c.Capacity, c.Enrolled)
+	c.IsFull = c.SeatsAvailable == 0
 	return c
 }
 
@@ -605,6 +3195,8 @@ func (s *AdminService) userToProto(u *shared.User) *pb.User {
 	return &pb.User{
 		Id: u.ID, Email: u.Email, Role: u.Role, Name: u.Name,
 		StudentId: u.StudentID, FacultyId: u.FacultyID, IsActive: u.IsActive,
-		CreatedAt: timestamppb.New(u.CreatedAt),
+		Department: u.Department, Major: u.Major, YearLevel: u.YearLevel,
+		CreatedAt: shared.ToProtoTime(u.CreatedAt), LastLoginAt: shared.ToProtoTime(u.LastLoginAt),
+		MustChangePassword: u.MustChangePassword,
 	}
 }
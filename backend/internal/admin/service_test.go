@@ -2,17 +2,27 @@ package admin
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"net"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/joho/godotenv"
 	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
 	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"stdiscm_p4/backend/internal/course"
 	pb "stdiscm_p4/backend/internal/pb/admin"
+	pb_course "stdiscm_p4/backend/internal/pb/course"
 	"stdiscm_p4/backend/internal/shared"
 )
 
@@ -71,13 +81,19 @@ func TestAdminService_Integration(t *testing.T) {
 		db.Collection("courses").DeleteOne(ctx, bson.M{"code": testCourseCode})
 		db.Collection("users").DeleteOne(ctx, bson.M{"email": testStudentEmail})
 		db.Collection("users").DeleteOne(ctx, bson.M{"email": testFacultyEmail})
+		db.Collection("users").DeleteMany(ctx, bson.M{"email": bson.M{"$regex": "^admin_test_(mixed|legacy)", "$options": "i"}})
 		db.Collection("enrollments").DeleteMany(ctx, bson.M{"student_id": bson.M{"$regex": "^STU-"}}) // Clean up override enrollments
 		db.Collection("system_config").DeleteMany(ctx, bson.M{})
+		db.Collection("transfer_credits").DeleteMany(ctx, bson.M{"student_id": "STU-001"})
+		db.Collection("departments").DeleteMany(ctx, bson.M{"_id": bson.M{"$in": bson.A{"SCI", "QA", "ZZQ"}}})
 	}
 
 	cleanup()
 	defer cleanup()
 
+	// Faculty departments are checked against the department list
+	db.Collection("departments").InsertOne(ctx, shared.Department{Code: "SCI", Name: "Science", CreatedAt: time.Now()})
+
 	// IDs to be captured during tests for subsequent steps
 	var createdCourseID string
 	var createdStudentID string
@@ -118,6 +134,74 @@ func TestAdminService_Integration(t *testing.T) {
 		createdFacultyID = resp.UserId
 	})
 
+	t.Run("Email Is Case Insensitive", func(t *testing.T) {
+		resp, err := client.CreateUser(ctx, &pb.CreateUserRequest{
+			Email: "Admin_Test_Student@Example.COM", Role: "student", Name: "Case Duplicate",
+		})
+		if err != nil {
+			t.Fatalf("CreateUser failed: %v", err)
+		}
+		if resp.Success {
+			t.Error("Expected a mixed-case copy of an existing email to be rejected")
+		}
+
+		resp, err = client.CreateUser(ctx, &pb.CreateUserRequest{
+			Email: "  Admin_Test_Mixed@Example.COM ", Role: "student", Name: "Mixed Case",
+		})
+		if err != nil || !resp.Success {
+			t.Fatalf("CreateUser failed: %v / %v", err, resp)
+		}
+		if resp.User.Email != "admin_test_mixed@example.com" {
+			t.Errorf("Expected the email stored lowercased, got %q", resp.User.Email)
+		}
+	})
+
+	t.Run("Find Duplicate Emails", func(t *testing.T) {
+		// Accounts written before normalization, bypassing CreateUser
+		_, err := db.Collection("users").InsertMany(ctx, []interface{}{
+			bson.M{"_id": "admin-test-legacy-1", "email": "Admin_Test_Legacy_Dupe@Example.com", "role": "student", "name": "Legacy One"},
+			bson.M{"_id": "admin-test-legacy-2", "email": "admin_test_legacy_dupe@example.com", "role": "student", "name": "Legacy Two"},
+			bson.M{"_id": "admin-test-legacy-3", "email": "Admin_Test_Legacy_Solo@Example.com", "role": "student", "name": "Legacy Solo"},
+		})
+		if mongo.IsDuplicateKeyError(err) {
+			t.Skip("unique email index already enforced on this database")
+		}
+		if err != nil {
+			t.Fatalf("Setup failed: %v", err)
+		}
+
+		svc := NewAdminService(nil, db, cfg)
+		dupes, err := svc.FindDuplicateEmails(ctx)
+		if err != nil {
+			t.Fatalf("FindDuplicateEmails failed: %v", err)
+		}
+		found := false
+		for _, d := range dupes {
+			if d.Email == "admin_test_legacy_dupe@example.com" && len(d.UserIDs) == 2 {
+				found = true
+			}
+			if d.Email == "admin_test_legacy_solo@example.com" {
+				t.Error("A unique email should not be reported as a duplicate")
+			}
+		}
+		if !found {
+			t.Errorf("Expected the case-only duplicate to be reported, got %+v", dupes)
+		}
+
+		if _, err := svc.NormalizeStoredEmails(ctx, dupes); err != nil {
+			t.Fatalf("NormalizeStoredEmails failed: %v", err)
+		}
+		var solo, dupe shared.User
+		db.Collection("users").FindOne(ctx, bson.M{"_id": "admin-test-legacy-3"}).Decode(&solo)
+		db.Collection("users").FindOne(ctx, bson.M{"_id": "admin-test-legacy-1"}).Decode(&dupe)
+		if solo.Email != "admin_test_legacy_solo@example.com" {
+			t.Errorf("Expected the unique email lowercased, got %q", solo.Email)
+		}
+		if dupe.Email != "Admin_Test_Legacy_Dupe@Example.com" {
+			t.Errorf("Expected duplicates left for an admin to resolve, got %q", dupe.Email)
+		}
+	})
+
 	t.Run("List Users", func(t *testing.T) {
 		resp, err := client.ListUsers(ctx, &pb.ListUsersRequest{
 			Role:       "student",
@@ -172,6 +256,81 @@ func TestAdminService_Integration(t *testing.T) {
 		}
 	})
 
+	t.Run("Add Transfer Credit", func(t *testing.T) {
+		resp, err := client.AddTransferCredit(ctx, &pb.AddTransferCreditRequest{
+			StudentId:         createdStudentID,
+			CourseCode:        " cs-xfer-101 ",
+			EquivalentUnits:   3,
+			SourceInstitution: "Other University",
+			AdminId:           testAdminID,
+		})
+		if err != nil || !resp.Success {
+			t.Fatalf("AddTransferCredit failed: %v", err)
+		}
+		if resp.TransferCredit.StudentId != "STU-001" || resp.TransferCredit.CourseCode != "CS-XFER-101" {
+			t.Errorf("Expected credit keyed by student number and normalized code, got %+v", resp.TransferCredit)
+		}
+
+		// Same course code again is rejected
+		_, err = client.AddTransferCredit(ctx, &pb.AddTransferCreditRequest{
+			StudentId: "STU-001", CourseCode: "CS-XFER-101", EquivalentUnits: 3,
+			SourceInstitution: "Other University", AdminId: testAdminID,
+		})
+		if status.Code(err) != codes.AlreadyExists {
+			t.Errorf("Expected AlreadyExists for duplicate credit, got %v", err)
+		}
+
+		_, err = client.AddTransferCredit(ctx, &pb.AddTransferCreditRequest{
+			StudentId: "STU-001", CourseCode: "CS-XFER-102", EquivalentUnits: 0,
+			SourceInstitution: "Other University", AdminId: testAdminID,
+		})
+		if status.Code(err) != codes.InvalidArgument {
+			t.Errorf("Expected InvalidArgument for non-positive units, got %v", err)
+		}
+	})
+
+	t.Run("Student Holds", func(t *testing.T) {
+		defer db.Collection("holds").DeleteMany(ctx, bson.M{"student_id": "STU-001"})
+
+		resp, err := client.PlaceHold(ctx, &pb.PlaceHoldRequest{
+			StudentId: createdStudentID, Type: "Financial", Reason: "Unpaid tuition", AdminId: testAdminID,
+		})
+		if err != nil || !resp.Success {
+			t.Fatalf("PlaceHold failed: %v", err)
+		}
+		if resp.Hold.StudentId != "STU-001" || resp.Hold.Type != shared.HoldFinancial || !resp.Hold.Active {
+			t.Errorf("Expected active financial hold keyed by student number, got %+v", resp.Hold)
+		}
+
+		_, err = client.PlaceHold(ctx, &pb.PlaceHoldRequest{StudentId: "STU-001", Type: "financial", AdminId: testAdminID})
+		if status.Code(err) != codes.AlreadyExists {
+			t.Errorf("Expected AlreadyExists for a second active financial hold, got %v", err)
+		}
+		_, err = client.PlaceHold(ctx, &pb.PlaceHoldRequest{StudentId: "STU-001", Type: "parking", AdminId: testAdminID})
+		if status.Code(err) != codes.InvalidArgument {
+			t.Errorf("Expected InvalidArgument for unknown hold type, got %v", err)
+		}
+
+		clearResp, err := client.ClearHold(ctx, &pb.ClearHoldRequest{HoldId: resp.Hold.Id, AdminId: testAdminID})
+		if err != nil || clearResp.Hold.Active || clearResp.Hold.ClearedAt == nil {
+			t.Fatalf("ClearHold failed: %v %+v", err, clearResp)
+		}
+		_, err = client.ClearHold(ctx, &pb.ClearHoldRequest{HoldId: resp.Hold.Id, AdminId: testAdminID})
+		if status.Code(err) != codes.FailedPrecondition {
+			t.Errorf("Expected FailedPrecondition clearing twice, got %v", err)
+		}
+
+		// Cleared holds are only listed on request
+		list, err := client.GetStudentHolds(ctx, &pb.GetStudentHoldsRequest{StudentId: "STU-001"})
+		if err != nil || len(list.Holds) != 0 {
+			t.Errorf("Expected no active holds, got %v (err %v)", list, err)
+		}
+		list, err = client.GetStudentHolds(ctx, &pb.GetStudentHoldsRequest{StudentId: "STU-001", IncludeCleared: true})
+		if err != nil || len(list.Holds) != 1 {
+			t.Errorf("Expected 1 hold including cleared, got %v (err %v)", list, err)
+		}
+	})
+
 	// ========================================================================
 	// 2. Course Management Tests
 	// ========================================================================
@@ -185,11 +344,20 @@ func TestAdminService_Integration(t *testing.T) {
 			Room:        "WEB",
 			Capacity:    40,
 			Semester:    "TestSem",
+			AdminId:     testAdminID,
 		})
 		if err != nil || !resp.Success {
 			t.Fatalf("CreateCourse failed: %v", err)
 		}
 		createdCourseID = resp.CourseId
+		if n, _ := db.Collection("audit_logs").CountDocuments(ctx, bson.M{"resource": createdCourseID, "action": shared.ActionCourseCreate, "user_id": testAdminID}); n != 1 {
+			t.Errorf("Expected the course creation to be audited under %s, got %d entries", testAdminID, n)
+		}
+
+		// Readable ID: slug of the code and semester plus a short random suffix
+		if suffix, ok := strings.CutPrefix(createdCourseID, "testfull101-testsem-"); !ok || len(suffix) != 4 {
+			t.Errorf("Expected an ID like testfull101-testsem-xxxx, got %q", createdCourseID)
+		}
 	})
 
 	t.Run("Update Course", func(t *testing.T) {
@@ -217,26 +385,384 @@ func TestAdminService_Integration(t *testing.T) {
 		}
 	})
 
+	t.Run("Course Schedule Is Validated On Write", func(t *testing.T) {
+		var createdIDs []string
+		defer func() {
+			db.Collection("courses").DeleteMany(ctx, bson.M{"_id": bson.M{"$in": createdIDs}})
+		}()
+		create := func(code, schedule string, unscheduled bool) *pb.CreateCourseResponse {
+			resp, err := client.CreateCourse(ctx, &pb.CreateCourseRequest{
+				Code: code, Title: "Schedule Check", Units: 3, Capacity: 20, Semester: "SchedSem",
+				Schedule: schedule, Unscheduled: unscheduled,
+			})
+			if err != nil {
+				t.Fatalf("CreateCourse(%s) failed: %v", code, err)
+			}
+			if resp.Success {
+				createdIDs = append(createdIDs, resp.CourseId)
+			}
+			return resp
+		}
+
+		if resp := create("SCHED-OK", "TTH 13:00-14:30", false); !resp.Success {
+			t.Errorf("Expected a valid schedule to be accepted, got %q", resp.Message)
+		}
+		online := create("SCHED-ONLINE", "", true)
+		if !online.Success {
+			t.Errorf("Expected an unscheduled online course to be accepted, got %q", online.Message)
+		}
+
+		rejected := []struct {
+			code, schedule string
+			unscheduled    bool
+		}{
+			{"SCHED-EMPTY", "", false},
+			{"SCHED-GARBAGE", "sometime next week", false},
+			{"SCHED-DAYS", "XYZ 9:00-10:00", false},
+			{"SCHED-REVERSED", "MWF 10:00-9:00", false},
+			{"SCHED-BOTH", "MWF 9:00-10:00", true},
+		}
+		for _, r := range rejected {
+			if resp := create(r.code, r.schedule, r.unscheduled); resp.Success || resp.Message == "" {
+				t.Errorf("Expected %s (%q) to be rejected with a message, got %+v", r.code, r.schedule, resp)
+			}
+		}
+
+		// Updates are held to the same rules, and the flag clears a schedule
+		if !online.Success {
+			return
+		}
+		resp, err := client.UpdateCourse(ctx, &pb.UpdateCourseRequest{CourseId: online.CourseId, Schedule: "MWF 25:00-26:00"})
+		if err != nil || resp.Success {
+			t.Errorf("Expected a malformed schedule update to be rejected, got %v (err %v)", resp, err)
+		}
+		resp, err = client.UpdateCourse(ctx, &pb.UpdateCourseRequest{CourseId: online.CourseId, Schedule: "S 9:00-12:00"})
+		if err != nil || !resp.Success || resp.Course.Schedule != "S 9:00-12:00" {
+			t.Errorf("Expected a valid schedule update, got %v (err %v)", resp, err)
+		}
+		resp, err = client.UpdateCourse(ctx, &pb.UpdateCourseRequest{CourseId: online.CourseId, Unscheduled: true})
+		if err != nil || !resp.Success || resp.Course.Schedule != "" {
+			t.Errorf("Expected the schedule to be cleared, got %v (err %v)", resp, err)
+		}
+	})
+
+	t.Run("Text Fields Are Bounded", func(t *testing.T) {
+		expectField := func(name, field string, err error) {
+			t.Helper()
+			if status.Code(err) != codes.InvalidArgument || shared.ErrorCodeOf(err) != shared.ErrCodeInvalidField || shared.ErrorParamsOf(err)["field"] != field {
+				t.Errorf("%s: expected INVALID_FIELD for %s, got %v", name, field, err)
+			}
+		}
+
+		_, err := client.CreateCourse(ctx, &pb.CreateCourseRequest{
+			Code: "TEXT-LONG", Title: strings.Repeat("x", shared.MaxTitleLength+1), Units: 3, Capacity: 20, Semester: "TextSem", Unscheduled: true,
+		})
+		expectField("over-length title", "title", err)
+
+		_, err = client.CreateCourse(ctx, &pb.CreateCourseRequest{
+			Code: "TEXT-CTRL", Title: "Control", Description: "bad\x00byte", Units: 3, Capacity: 20, Semester: "TextSem", Unscheduled: true,
+		})
+		expectField("control character in description", "description", err)
+
+		_, err = client.UpdateCourse(ctx, &pb.UpdateCourseRequest{CourseId: createdCourseID, Title: "Tab\tbed"})
+		expectField("tab in title", "title", err)
+
+		_, err = client.CreateUser(ctx, &pb.CreateUserRequest{Email: "text.ctrl@test.com", Role: shared.RoleStudent, Name: "Evil\x1b[31mName"})
+		expectField("escape sequence in name", "name", err)
+
+		_, err = client.CreateUser(ctx, &pb.CreateUserRequest{Email: "text.long@test.com", Role: shared.RoleStudent, Name: strings.Repeat("n", shared.MaxNameLength+1)})
+		expectField("over-length name", "name", err)
+
+		_, err = client.PlaceHold(ctx, &pb.PlaceHoldRequest{StudentId: createdStudentID, Type: shared.HoldAcademic, Reason: strings.Repeat("r", shared.MaxReasonLength+1)})
+		expectField("over-length reason", "reason", err)
+
+		// Multi-line descriptions are fine and the limits count characters, not bytes
+		resp, err := client.UpdateCourse(ctx, &pb.UpdateCourseRequest{CourseId: createdCourseID, Description: "Línea uno\nLínea dos", IsOpen: true})
+		if err != nil || !resp.Success {
+			t.Errorf("Expected a multi-line description to be accepted, got %v (err %v)", resp, err)
+		}
+
+		bulk, err := client.BulkCreateCourses(ctx, &pb.BulkCreateCoursesRequest{
+			AdminId: testAdminID,
+			Courses: []*pb.CourseDefinition{{Code: "TEXT-BULK", Title: "Bulk\x07", Units: 3, Capacity: 20, Semester: "TextSem", Unscheduled: true}},
+		})
+		if err != nil || bulk.CreatedCount != 0 || !strings.Contains(bulk.Results[0].Error, "title") {
+			t.Errorf("Expected the bulk row to be rejected for its title, got %v (err %v)", bulk, err)
+		}
+
+		if n, _ := db.Collection("courses").CountDocuments(ctx, bson.M{"semester": "TextSem"}); n != 0 {
+			t.Errorf("Expected no courses to be created, got %d", n)
+		}
+	})
+
+	t.Run("Course Enrollment Window", func(t *testing.T) {
+		opensAt := time.Now().Add(48 * time.Hour).UTC().Truncate(time.Second)
+		resp, err := client.CreateCourse(ctx, &pb.CreateCourseRequest{
+			Code: "WINDOW-101", Title: "Window Check", Units: 3, Capacity: 20, Semester: "WindowSem",
+			Schedule: "S 8:00-9:00", EnrollOpenAt: opensAt.Format(time.RFC3339),
+		})
+		if err != nil || !resp.Success {
+			t.Fatalf("CreateCourse failed: %v (err %v)", resp, err)
+		}
+		defer db.Collection("courses").DeleteOne(ctx, bson.M{"_id": resp.CourseId})
+
+		// A course with its own open time is created open; the window keeps it unenrollable until then
+		var stored shared.Course
+		db.Collection("courses").FindOne(ctx, bson.M{"_id": resp.CourseId}).Decode(&stored)
+		if !stored.IsOpen || !stored.EnrollOpenAt.Equal(opensAt) || !stored.EnrollCloseAt.IsZero() {
+			t.Errorf("Expected an open course with the requested window, got %+v", stored)
+		}
+		if stored.IsOpenAt(time.Now()) {
+			t.Error("Expected a course whose window hasn't opened to be closed for enrollment")
+		}
+
+		rejected, err := client.CreateCourse(ctx, &pb.CreateCourseRequest{
+			Code: "WINDOW-102", Title: "Window Check", Units: 3, Capacity: 20, Semester: "WindowSem",
+			Schedule: "S 8:00-9:00", EnrollOpenAt: "2031-02-01", EnrollCloseAt: "2031-01-01",
+		})
+		if err != nil || rejected.Success {
+			t.Errorf("Expected a reversed window to be rejected, got %v (err %v)", rejected, err)
+		}
+
+		// Updates replace the window as a unit; empty bounds clear it
+		update, err := client.UpdateCourse(ctx, &pb.UpdateCourseRequest{
+			CourseId: resp.CourseId, IsOpen: true, UpdateEnrollWindow: true, EnrollCloseAt: "2031-01-15",
+		})
+		if err != nil || !update.Success || update.Course.EnrollOpenAt != nil || update.Course.EnrollCloseAt == nil {
+			t.Errorf("Expected only the close bound to remain, got %v (err %v)", update, err)
+		}
+		update, err = client.UpdateCourse(ctx, &pb.UpdateCourseRequest{CourseId: resp.CourseId, IsOpen: true, UpdateEnrollWindow: true})
+		if err != nil || !update.Success || update.Course.EnrollCloseAt != nil {
+			t.Errorf("Expected the window to be cleared, got %v (err %v)", update, err)
+		}
+	})
+
+	t.Run("Departments", func(t *testing.T) {
+		created, err := client.CreateDepartment(ctx, &pb.CreateDepartmentRequest{Code: "qa", Name: "Quality Assurance", AdminId: testAdminID})
+		if err != nil || !created.Success || created.Department.Code != "QA" {
+			t.Fatalf("Expected the code to be normalized to QA, got %v (err %v)", created, err)
+		}
+		dup, err := client.CreateDepartment(ctx, &pb.CreateDepartmentRequest{Code: "QAX", Name: "quality assurance"})
+		if err != nil || dup.Success {
+			t.Errorf("Expected a name already in use (ignoring case) to be rejected, got %v (err %v)", dup, err)
+		}
+		bad, err := client.CreateDepartment(ctx, &pb.CreateDepartmentRequest{Code: "Q1", Name: "Digits"})
+		if err != nil || bad.Success {
+			t.Errorf("Expected a code with digits to be rejected, got %v (err %v)", bad, err)
+		}
+		_, err = client.CreateDepartment(ctx, &pb.CreateDepartmentRequest{Code: "QAC", Name: "Quality\x00Control"})
+		if status.Code(err) != codes.InvalidArgument || shared.ErrorParamsOf(err)["field"] != "name" {
+			t.Errorf("Expected %s on name for a control character, got %v", shared.ErrCodeInvalidField, err)
+		}
+
+		// Courses accept the department by name and store its code; unknown ones are refused
+		course, err := client.CreateCourse(ctx, &pb.CreateCourseRequest{
+			Code: "DEPT-101", Title: "Department Check", Units: 3, Capacity: 20, Semester: "DeptSem",
+			Schedule: "S 10:00-11:00", Department: "quality assurance",
+		})
+		if err != nil || !course.Success || course.Course.Department != "QA" {
+			t.Fatalf("Expected the course to store department QA, got %v (err %v)", course, err)
+		}
+		defer db.Collection("courses").DeleteOne(ctx, bson.M{"_id": course.CourseId})
+		unknown, err := client.CreateCourse(ctx, &pb.CreateCourseRequest{
+			Code: "DEPT-102", Title: "Department Check", Units: 3, Capacity: 20, Semester: "DeptSem",
+			Schedule: "S 10:00-11:00", Department: "NOPE",
+		})
+		if err != nil || unknown.Success {
+			t.Errorf("Expected an unknown department to be rejected, got %v (err %v)", unknown, err)
+		}
+
+		// A department parsed from the code is registered with the course
+		derived, err := client.CreateCourse(ctx, &pb.CreateCourseRequest{
+			Code: "ZZQ-101", Title: "Derived Department", Units: 3, Capacity: 20, Semester: "DeptSem", Schedule: "S 12:00-13:00",
+		})
+		if err != nil || !derived.Success {
+			t.Fatalf("CreateCourse failed: %v (err %v)", derived, err)
+		}
+		defer db.Collection("courses").DeleteOne(ctx, bson.M{"_id": derived.CourseId})
+		if n, _ := db.Collection("departments").CountDocuments(ctx, bson.M{"_id": "ZZQ"}); n != 1 {
+			t.Error("Expected the parsed department ZZQ to be registered")
+		}
+
+		// Faculty store the department name; renaming the department renames theirs
+		faculty, err := client.CreateUser(ctx, &pb.CreateUserRequest{
+			Email: "admin_test_mixed_dept@example.com", Role: "faculty", Name: "Dept Prof", Department: "qa",
+		})
+		if err != nil || !faculty.Success {
+			t.Fatalf("CreateUser failed: %v (err %v)", faculty, err)
+		}
+		var stored shared.User
+		db.Collection("users").FindOne(ctx, bson.M{"_id": faculty.UserId}).Decode(&stored)
+		if stored.Department != "Quality Assurance" {
+			t.Errorf("Expected the faculty department to be stored by name, got %q", stored.Department)
+		}
+		rejected, err := client.CreateUser(ctx, &pb.CreateUserRequest{
+			Email: "admin_test_mixed_nodept@example.com", Role: "faculty", Name: "No Dept", Department: "Astrology",
+		})
+		if err != nil || rejected.Success {
+			t.Errorf("Expected an unknown faculty department to be rejected, got %v (err %v)", rejected, err)
+		}
+
+		renamed, err := client.UpdateDepartment(ctx, &pb.UpdateDepartmentRequest{Code: "QA", Name: "Quality Engineering", AdminId: testAdminID})
+		if err != nil || !renamed.Success || renamed.FacultyUpdated != 1 {
+			t.Errorf("Expected the rename to carry one faculty member, got %v (err %v)", renamed, err)
+		}
+
+		// Deletes wait until nothing is in the department
+		_, err = client.DeleteDepartment(ctx, &pb.DeleteDepartmentRequest{Code: "QA", AdminId: testAdminID})
+		if status.Code(err) != codes.FailedPrecondition {
+			t.Errorf("Expected FailedPrecondition while the department is in use, got %v", err)
+		}
+		db.Collection("courses").DeleteOne(ctx, bson.M{"_id": course.CourseId})
+		db.Collection("users").DeleteOne(ctx, bson.M{"_id": faculty.UserId})
+		if resp, err := client.DeleteDepartment(ctx, &pb.DeleteDepartmentRequest{Code: "QA", AdminId: testAdminID}); err != nil || !resp.Success {
+			t.Errorf("Expected the empty department to be deleted, got %v (err %v)", resp, err)
+		}
+	})
+
+	t.Run("Validate Course Schedule", func(t *testing.T) {
+		// Overlaps the test course (MWF 10:00-11:00) in the same room and with the same instructor
+		resp, err := client.ValidateCourseSchedule(ctx, &pb.ValidateCourseScheduleRequest{
+			Schedule: "MW 10:30-12:00", Room: "WEB", FacultyId: createdFacultyID, Semester: "TestSem",
+		})
+		if err != nil {
+			t.Fatalf("ValidateCourseSchedule failed: %v", err)
+		}
+		if resp.Valid || len(resp.Days) != 2 || resp.StartTime != "10:30" {
+			t.Errorf("Expected parsed but conflicting schedule, got %+v", resp)
+		}
+		types := map[string]bool{}
+		for _, c := range resp.Conflicts {
+			if c.CourseId == createdCourseID {
+				types[c.ConflictType] = true
+			}
+		}
+		if !types["room"] || !types["faculty"] {
+			t.Errorf("Expected room and faculty conflicts with %s, got %+v", createdCourseID, resp.Conflicts)
+		}
+
+		// Different days do not conflict
+		resp, err = client.ValidateCourseSchedule(ctx, &pb.ValidateCourseScheduleRequest{
+			Schedule: "TTH 10:00-11:00", Room: "WEB", FacultyId: createdFacultyID, Semester: "TestSem",
+		})
+		if err != nil || !resp.Valid {
+			t.Errorf("Expected TTH schedule to be valid, got %+v (err %v)", resp, err)
+		}
+
+		// Unparseable schedules are reported, not rejected
+		resp, err = client.ValidateCourseSchedule(ctx, &pb.ValidateCourseScheduleRequest{
+			Schedule: "MWF 11:00-10:00", Semester: "TestSem",
+		})
+		if err != nil || resp.Valid || resp.ParseError == "" {
+			t.Errorf("Expected parse error for reversed times, got %+v (err %v)", resp, err)
+		}
+	})
+
+	t.Run("Bulk Create Courses", func(t *testing.T) {
+		bulkFilter := bson.M{"code": bson.M{"$in": []string{"BULK-101", "BULK-201"}}}
+		var bulkIDs []string
+		db.Collection("courses").DeleteMany(ctx, bulkFilter)
+		defer func() {
+			db.Collection("courses").DeleteMany(ctx, bulkFilter)
+			db.Collection("prerequisites").DeleteMany(ctx, bson.M{"course_id": bson.M{"$in": bulkIDs}})
+		}()
+
+		resp, err := client.BulkCreateCourses(ctx, &pb.BulkCreateCoursesRequest{
+			AdminId: testAdminID,
+			Courses: []*pb.CourseDefinition{
+				// Forward reference: BULK-101 is defined later in the batch
+				{Code: "BULK-201", Title: "Bulk Advanced", Units: 3, Capacity: 30, Semester: "TestSem", Unscheduled: true, PrerequisiteCodes: []string{"BULK-101"}},
+				{Code: "BULK-101", Title: "Bulk Intro", Units: 3, Capacity: 30, Semester: "TestSem", Schedule: "S 8:00-9:00", FacultyId: createdFacultyID},
+				{Code: "BULK-101", Title: "Bulk Intro Again", Units: 3, Capacity: 30, Semester: "TestSem", Schedule: "S 8:00-9:00"},
+				{Code: testCourseCode, Title: "Existing", Units: 3, Capacity: 30, Semester: "TestSem", Schedule: "S 8:00-9:00"},
+				{Code: "BULK-301", Title: "Bad Units", Units: 9, Capacity: 30, Semester: "TestSem", Schedule: "S 8:00-9:00"},
+				{Code: "BULK-302", Title: "Bad Faculty", Units: 3, Capacity: 30, Semester: "TestSem", Schedule: "S 8:00-9:00", FacultyId: "no-such-faculty"},
+				{Code: "BULK-303", Title: "Bad Schedule", Units: 3, Capacity: 30, Semester: "TestSem", Schedule: "whenever"},
+			},
+		})
+		if err != nil {
+			t.Fatalf("BulkCreateCourses failed: %v", err)
+		}
+		for _, r := range resp.Results {
+			if r.Success {
+				bulkIDs = append(bulkIDs, r.CourseId)
+			}
+		}
+		if resp.Success || resp.CreatedCount != 2 || resp.FailedCount != 5 {
+			t.Fatalf("Expected 2 created and 5 failed, got %+v", resp)
+		}
+		if len(resp.Results[0].PrerequisiteErrors) != 0 {
+			t.Errorf("Expected forward prerequisite to link, got %v", resp.Results[0].PrerequisiteErrors)
+		}
+		for _, row := range []int{2, 3, 4, 5, 6} {
+			if resp.Results[row].Success || resp.Results[row].Error == "" {
+				t.Errorf("Expected row %d to fail with an error, got %+v", row+1, resp.Results[row])
+			}
+		}
+
+		count, _ := db.Collection("prerequisites").CountDocuments(ctx, bson.M{
+			"course_id": resp.Results[0].CourseId, "prereq_id": resp.Results[1].CourseId,
+		})
+		if count != 1 {
+			t.Errorf("Expected BULK-201 to require BULK-101, found %d links", count)
+		}
+	})
+
 	// ========================================================================
 	// 3. System Configuration Tests
 	// ========================================================================
 	t.Run("Set Enrollment Period", func(t *testing.T) {
 		resp, err := client.SetEnrollmentPeriod(ctx, &pb.SetEnrollmentPeriodRequest{
-			StartDate: "2024-01-01T00:00:00Z",
-			EndDate:   "2024-02-01T00:00:00Z",
+			StartDate: "2031-01-01T00:00:00Z",
+			EndDate:   "2031-02-01T00:00:00Z",
+			AdminId:   testAdminID,
 		})
-		if err != nil || !resp.Success {
+		if err != nil || !resp.Success || resp.Warning != "" {
 			t.Errorf("SetEnrollmentPeriod failed: %v", err)
 		}
+		var end shared.SystemConfig
+		db.Collection("system_config").FindOne(ctx, bson.M{"key": shared.ConfigEnrollmentEnd}).Decode(&end)
+		if end.Value != "2031-02-01T00:00:00Z" || end.UpdatedBy != testAdminID {
+			t.Errorf("Expected the end date set by %s, got %+v", testAdminID, end)
+		}
+
+		for name, req := range map[string]*pb.SetEnrollmentPeriodRequest{
+			"malformed start": {StartDate: "01/01/2031", EndDate: "2031-02-01T00:00:00Z"},
+			"reversed range":  {StartDate: "2031-02-01T00:00:00Z", EndDate: "2031-01-01T00:00:00Z"},
+			"past end":        {StartDate: "2024-01-01T00:00:00Z", EndDate: "2024-02-01T00:00:00Z"},
+		} {
+			if _, err := client.SetEnrollmentPeriod(ctx, req); status.Code(err) != codes.InvalidArgument {
+				t.Errorf("%s: expected InvalidArgument, got %v", name, err)
+			}
+		}
+		db.Collection("system_config").FindOne(ctx, bson.M{"key": shared.ConfigEnrollmentEnd}).Decode(&end)
+		if end.Value != "2031-02-01T00:00:00Z" {
+			t.Errorf("Expected rejected periods to leave the end date alone, got %q", end.Value)
+		}
+
+		// Closing enrollment retroactively needs the explicit flag and is flagged back
+		resp, err = client.SetEnrollmentPeriod(ctx, &pb.SetEnrollmentPeriodRequest{
+			StartDate: "2024-01-01T00:00:00Z", EndDate: "2024-02-01T00:00:00Z", AllowPastEnd: true, AdminId: testAdminID,
+		})
+		if err != nil || !resp.Success || resp.Warning == "" {
+			t.Errorf("Expected a past end date with allow_past_end to succeed with a warning, got %+v (err %v)", resp, err)
+		}
 	})
 
 	t.Run("Toggle Enrollment", func(t *testing.T) {
 		resp, err := client.ToggleEnrollment(ctx, &pb.ToggleEnrollmentRequest{
-			Enable: true,
+			Enable:  true,
+			AdminId: testAdminID,
 		})
 		if err != nil || !resp.Success || !resp.EnrollmentOpen {
 			t.Errorf("ToggleEnrollment failed: %v", err)
 		}
+		var entry shared.AuditLog
+		db.Collection("audit_logs").FindOne(ctx, bson.M{"resource": shared.ConfigEnrollmentOn, "action": shared.ActionConfigChange},
+			options.FindOne().SetSort(bson.D{{Key: "timestamp", Value: -1}})).Decode(&entry)
+		if entry.UserID != testAdminID {
+			t.Errorf("Expected the toggle to be audited under %s, got %q", testAdminID, entry.UserID)
+		}
 	})
 
 	t.Run("General Config CRUD", func(t *testing.T) {
@@ -259,6 +785,20 @@ func TestAdminService_Integration(t *testing.T) {
 		}
 	})
 
+	t.Run("Cart Limit Config Is Validated", func(t *testing.T) {
+		for _, tc := range []struct{ key, value string }{
+			{shared.ConfigMaxCourses, "0"},
+			{shared.ConfigMaxCourses, "six"},
+			{shared.ConfigMaxUnits, "-3"},
+			{shared.ConfigMaxUnits, "400"},
+		} {
+			_, err := client.UpdateSystemConfig(ctx, &pb.UpdateSystemConfigRequest{Key: tc.key, Value: tc.value, AdminId: testAdminID})
+			if status.Code(err) != codes.InvalidArgument || shared.ErrorParamsOf(err)["field"] != tc.key {
+				t.Errorf("Expected %s=%q to be rejected, got %v", tc.key, tc.value, err)
+			}
+		}
+	})
+
 	// ========================================================================
 	// 4. Overrides & Deletion
 	// ========================================================================
@@ -277,6 +817,18 @@ func TestAdminService_Integration(t *testing.T) {
 	})
 
 	t.Run("Override Enrollment (Force Drop)", func(t *testing.T) {
+		// The student has already used the semester's only drop; admins are exempt
+		configCol := db.Collection("system_config")
+		configCol.UpdateOne(ctx, bson.M{"key": shared.ConfigMaxDrops}, bson.M{"$set": bson.M{"value": "1"}}, options.Update().SetUpsert(true))
+		db.Collection("enrollments").InsertOne(ctx, shared.Enrollment{
+			ID: "ENR-ADMIN-SELF-DROP", StudentID: createdStudentID, CourseID: createdCourseID,
+			Status: shared.StatusDropped, EnrolledAt: time.Now().Add(-time.Hour), DroppedAt: time.Now().Add(-time.Minute),
+		})
+		defer func() {
+			configCol.DeleteOne(ctx, bson.M{"key": shared.ConfigMaxDrops})
+			db.Collection("enrollments").DeleteOne(ctx, bson.M{"_id": "ENR-ADMIN-SELF-DROP"})
+		}()
+
 		resp, err := client.OverrideEnrollment(ctx, &pb.OverrideEnrollmentRequest{
 			StudentId: createdStudentID,
 			CourseId:  createdCourseID,
@@ -287,25 +839,1081 @@ func TestAdminService_Integration(t *testing.T) {
 		if err != nil || !resp.Success {
 			t.Fatalf("OverrideEnrollment (Drop) failed: %v", err)
 		}
+
+		// Forced drops are marked so they do not count against the student
+		var dropped shared.Enrollment
+		db.Collection("enrollments").FindOne(ctx, bson.M{
+			"student_id": createdStudentID, "course_id": createdCourseID, "drop_reason": shared.DropReasonAdminForced,
+		}).Decode(&dropped)
+		if dropped.Status != shared.StatusDropped {
+			t.Errorf("Expected the forced drop to carry drop_reason %s, got %+v", shared.DropReasonAdminForced, dropped)
+		}
 	})
 
-	t.Run("Get System Stats", func(t *testing.T) {
-		resp, err := client.GetSystemStats(ctx, &pb.GetSystemStatsRequest{})
+	t.Run("Overrides Record Enrollment Events", func(t *testing.T) {
+		defer db.Collection("enrollment_events").DeleteMany(ctx, bson.M{"course_id": createdCourseID})
+
+		cursor, err := db.Collection("enrollment_events").Find(ctx, bson.M{"course_id": createdCourseID},
+			options.Find().SetSort(bson.D{{Key: "timestamp", Value: 1}}))
 		if err != nil {
-			t.Fatalf("GetSystemStats failed: %v", err)
+			t.Fatalf("Failed to read enrollment events: %v", err)
 		}
-		if resp.Stats.TotalCourses == 0 {
-			t.Error("Stats mismatch, expected courses")
+		var events []shared.EnrollmentEvent
+		cursor.All(ctx, &events)
+		if len(events) != 2 || events[0].Delta != 1 || events[1].Delta != -1 {
+			t.Fatalf("Expected +1 then -1 events, got %+v", events)
+		}
+		for _, e := range events {
+			if e.Source != shared.EventSourceOverride {
+				t.Errorf("Expected source %s, got %+v", shared.EventSourceOverride, e)
+			}
 		}
 	})
 
-	// Run Delete last since it destroys the resource
-	t.Run("Delete Course", func(t *testing.T) {
-		resp, err := client.DeleteCourse(ctx, &pb.DeleteCourseRequest{
-			CourseId: createdCourseID,
+	t.Run("Enrollment Trend", func(t *testing.T) {
+		trendIDs := []string{"TREND-101", "TREND-102"}
+		for _, id := range trendIDs {
+			db.Collection("courses").InsertOne(ctx, shared.Course{
+				ID: id, Code: id, Department: "TREND", Title: "Trend " + id, Units: 3, Capacity: 20, IsOpen: true, Semester: "TrendSem",
+			})
+		}
+		day := time.Date(2031, 3, 3, 0, 0, 0, 0, time.UTC)
+		var events []interface{}
+		for i, e := range []struct {
+			course string
+			at     time.Time
+			delta  int32
+		}{
+			{trendIDs[0], day.Add(9 * time.Hour), 1},
+			{trendIDs[0], day.Add(9*time.Hour + 30*time.Minute), 1},
+			{trendIDs[1], day.Add(11 * time.Hour), 1},
+			{trendIDs[0], day.Add(48*time.Hour + 10*time.Hour), -1},
+		} {
+			events = append(events, shared.EnrollmentEvent{
+				ID: fmt.Sprintf("EVT-TREND-%d", i), CourseID: e.course, Delta: e.delta, Source: shared.EventSourceSelf, Timestamp: e.at,
+			})
+		}
+		db.Collection("enrollment_events").InsertMany(ctx, events)
+		defer func() {
+			db.Collection("courses").DeleteMany(ctx, bson.M{"semester": "TrendSem"})
+			db.Collection("enrollment_events").DeleteMany(ctx, bson.M{"course_id": bson.M{"$in": trendIDs}})
+		}()
+
+		// Department totals by day, with the empty middle day filled in
+		resp, err := client.GetEnrollmentTrend(ctx, &pb.GetEnrollmentTrendRequest{Department: "trend"})
+		if err != nil {
+			t.Fatalf("GetEnrollmentTrend failed: %v", err)
+		}
+		want := []struct{ enrolls, drops, cumulative int32 }{{3, 0, 3}, {0, 0, 3}, {0, 1, 2}}
+		if len(resp.Buckets) != len(want) {
+			t.Fatalf("Expected %d daily buckets, got %+v", len(want), resp.Buckets)
+		}
+		for i, w := range want {
+			b := resp.Buckets[i]
+			if !b.Start.AsTime().Equal(day.Add(time.Duration(i) * 24 * time.Hour)) {
+				t.Errorf("Bucket %d: unexpected start %v", i, b.Start.AsTime())
+			}
+			if b.Enrolls != w.enrolls || b.Drops != w.drops || b.Cumulative != w.cumulative {
+				t.Errorf("Bucket %d: expected %+v, got %+v", i, w, b)
+			}
+		}
+
+		// One course by hour within the first day
+		resp, err = client.GetEnrollmentTrend(ctx, &pb.GetEnrollmentTrendRequest{
+			CourseId: trendIDs[0], Interval: "hour",
+			From: timestamppb.New(day), To: timestamppb.New(day.Add(24 * time.Hour)),
+		})
+		if err != nil {
+			t.Fatalf("GetEnrollmentTrend by hour failed: %v", err)
+		}
+		if len(resp.Buckets) != 1 || resp.Buckets[0].Enrolls != 2 {
+			t.Errorf("Expected one hourly bucket with 2 enrolls, got %+v", resp.Buckets)
+		}
+
+		_, err = client.GetEnrollmentTrend(ctx, &pb.GetEnrollmentTrendRequest{CourseId: trendIDs[0], Department: "TREND"})
+		if status.Code(err) != codes.InvalidArgument {
+			t.Errorf("Expected InvalidArgument with both course_id and department, got %v", err)
+		}
+		_, err = client.GetEnrollmentTrend(ctx, &pb.GetEnrollmentTrendRequest{CourseId: trendIDs[0], Interval: "week"})
+		if status.Code(err) != codes.InvalidArgument {
+			t.Errorf("Expected InvalidArgument for an unknown interval, got %v", err)
+		}
+	})
+
+	t.Run("Override Enrollment Past Capacity", func(t *testing.T) {
+		fullIDs := []string{"OVR-FULL-1", "OVR-FULL-2"}
+		for _, id := range fullIDs {
+			db.Collection("courses").InsertOne(ctx, shared.Course{
+				ID: id, Code: id, Title: "Override Capacity", Units: 3, Schedule: "MW 10:00-11:30",
+				Room: "R1", Capacity: 1, Enrolled: 1, IsOpen: true, Semester: "TestSem",
+			})
+		}
+		defer func() {
+			db.Collection("courses").DeleteMany(ctx, bson.M{"_id": bson.M{"$in": fullIDs}})
+			db.Collection("enrollments").DeleteMany(ctx, bson.M{"course_id": bson.M{"$in": fullIDs}})
+			db.Collection("system_config").DeleteOne(ctx, bson.M{"key": shared.ConfigOverrideAutoClose})
+		}()
+
+		// Default: the override fills the course, so it is closed
+		resp, err := client.OverrideEnrollment(ctx, &pb.OverrideEnrollmentRequest{
+			StudentId: createdStudentID,
+			CourseId:  fullIDs[0],
+			Action:    "force_enroll",
+			Reason:    "Capacity Override",
+			AdminId:   testAdminID,
 		})
 		if err != nil || !resp.Success {
-			t.Errorf("DeleteCourse failed: %v", err)
+			t.Fatalf("OverrideEnrollment past capacity failed: %v %v", err, resp)
+		}
+		if resp.Enrolled != 2 || resp.Capacity != 1 || !resp.CourseClosed {
+			t.Errorf("Expected enrolled=2 capacity=1 closed, got %d/%d closed=%v", resp.Enrolled, resp.Capacity, resp.CourseClosed)
+		}
+
+		var course shared.Course
+		db.Collection("courses").FindOne(ctx, bson.M{"_id": fullIDs[0]}).Decode(&course)
+		if course.IsOpen {
+			t.Error("Expected course to be closed after override filled it")
+		}
+
+		var entry shared.AuditLog
+		db.Collection("audit_logs").FindOne(ctx, bson.M{"resource": createdStudentID + ":" + fullIDs[0]}).Decode(&entry)
+		if overage, _ := entry.Details["overage"].(int32); overage != 1 {
+			t.Errorf("Expected audit overage 1, got %v", entry.Details["overage"])
+		}
+
+		// Auto-close disabled: the course stays open
+		db.Collection("system_config").UpdateOne(ctx,
+			bson.M{"key": shared.ConfigOverrideAutoClose},
+			bson.M{"$set": bson.M{"value": "false"}},
+			options.Update().SetUpsert(true),
+		)
+		resp, err = client.OverrideEnrollment(ctx, &pb.OverrideEnrollmentRequest{
+			StudentId: createdStudentID,
+			CourseId:  fullIDs[1],
+			Action:    "force_enroll",
+			Reason:    "Capacity Override",
+			AdminId:   testAdminID,
+		})
+		if err != nil || !resp.Success {
+			t.Fatalf("OverrideEnrollment without auto-close failed: %v %v", err, resp)
+		}
+		if resp.CourseClosed {
+			t.Error("Expected course to stay open when auto-close is disabled")
+		}
+		db.Collection("courses").FindOne(ctx, bson.M{"_id": fullIDs[1]}).Decode(&course)
+		if !course.IsOpen {
+			t.Error("Expected course is_open to remain true")
 		}
 	})
+
+	t.Run("Restore Enrollment", func(t *testing.T) {
+		restoreCourseID := "RESTORE-101"
+		db.Collection("courses").InsertOne(ctx, shared.Course{
+			ID: restoreCourseID, Code: restoreCourseID, Title: "Restore Test", Units: 3, Schedule: "S 8:00-9:00",
+			Capacity: 1, Enrolled: 0, IsOpen: true, Semester: "TestSem",
+		})
+		enrolledAt := time.Now().Add(-72 * time.Hour).Truncate(time.Millisecond)
+		dropped := []shared.Enrollment{
+			{ID: "ENR-RESTORE-1", StudentID: createdStudentID, CourseID: restoreCourseID, Status: shared.StatusDropped, EnrolledAt: enrolledAt, DroppedAt: time.Now().Add(-time.Hour)},
+			{ID: "ENR-RESTORE-2", StudentID: "STU-RESTORE-2", CourseID: restoreCourseID, Status: shared.StatusDropped, EnrolledAt: enrolledAt, DroppedAt: time.Now().Add(-time.Hour)},
+			{ID: "ENR-RESTORE-OLD", StudentID: "STU-RESTORE-3", CourseID: restoreCourseID, Status: shared.StatusDropped, EnrolledAt: enrolledAt, DroppedAt: time.Now().Add(-72 * time.Hour)},
+		}
+		for _, e := range dropped {
+			db.Collection("enrollments").InsertOne(ctx, e)
+		}
+		defer func() {
+			db.Collection("courses").DeleteOne(ctx, bson.M{"_id": restoreCourseID})
+			db.Collection("enrollments").DeleteMany(ctx, bson.M{"course_id": restoreCourseID})
+		}()
+
+		resp, err := client.RestoreEnrollment(ctx, &pb.RestoreEnrollmentRequest{EnrollmentId: "ENR-RESTORE-1", Reason: "Accidental drop", AdminId: testAdminID})
+		if err != nil || !resp.Success || resp.Outcome != "restored" || resp.Enrolled != 1 {
+			t.Fatalf("Expected restore into free seat, got %v (err %v)", resp, err)
+		}
+		var restored shared.Enrollment
+		db.Collection("enrollments").FindOne(ctx, bson.M{"_id": "ENR-RESTORE-1"}).Decode(&restored)
+		if restored.Status != shared.StatusEnrolled || !restored.DroppedAt.IsZero() || !restored.EnrolledAt.Equal(enrolledAt) {
+			t.Errorf("Expected original enrollment reactivated, got %+v", restored)
+		}
+
+		// The only seat is now taken
+		resp, err = client.RestoreEnrollment(ctx, &pb.RestoreEnrollmentRequest{EnrollmentId: "ENR-RESTORE-2", AdminId: testAdminID})
+		if err != nil || resp.Success || resp.Outcome != "refused" {
+			t.Errorf("Expected refusal for a full course, got %v (err %v)", resp, err)
+		}
+		resp, err = client.RestoreEnrollment(ctx, &pb.RestoreEnrollmentRequest{EnrollmentId: "ENR-RESTORE-2", Force: true, AdminId: testAdminID})
+		if err != nil || !resp.Success || resp.Outcome != "restored_over_capacity" || resp.Enrolled != 2 {
+			t.Errorf("Expected forced restore over capacity, got %v (err %v)", resp, err)
+		}
+
+		// Outside the default 48h window, even with force
+		resp, err = client.RestoreEnrollment(ctx, &pb.RestoreEnrollmentRequest{EnrollmentId: "ENR-RESTORE-OLD", Force: true, AdminId: testAdminID})
+		if err != nil || resp.Success || resp.Outcome != "refused" {
+			t.Errorf("Expected refusal outside the restore window, got %v (err %v)", resp, err)
+		}
+
+		_, err = client.RestoreEnrollment(ctx, &pb.RestoreEnrollmentRequest{EnrollmentId: "ENR-RESTORE-1", AdminId: testAdminID})
+		if status.Code(err) != codes.FailedPrecondition {
+			t.Errorf("Expected FailedPrecondition restoring an active enrollment, got %v", err)
+		}
+	})
+
+	t.Run("Get Nearly Full Courses", func(t *testing.T) {
+		fills := map[string]int32{"NF-95": 19, "NF-90": 18, "NF-50": 10}
+		var nfIDs []string
+		for id, enrolled := range fills {
+			nfIDs = append(nfIDs, id)
+			db.Collection("courses").InsertOne(ctx, shared.Course{
+				ID: id, Code: id, Title: "Nearly Full", Units: 3, Schedule: "S 8:00-9:00",
+				Capacity: 20, Enrolled: enrolled, IsOpen: true, Semester: "NearlyFullSem",
+			})
+		}
+		defer db.Collection("courses").DeleteMany(ctx, bson.M{"_id": bson.M{"$in": nfIDs}})
+
+		// Default threshold (90%) from config
+		resp, err := client.GetNearlyFullCourses(ctx, &pb.GetNearlyFullCoursesRequest{Semester: "NearlyFullSem"})
+		if err != nil || !resp.Success {
+			t.Fatalf("GetNearlyFullCourses failed: %v", err)
+		}
+		if resp.Threshold != shared.DefaultCapacityWarningPercent || len(resp.Courses) != 2 {
+			t.Fatalf("Expected 2 courses at %d%%, got %d at %d%%", shared.DefaultCapacityWarningPercent, len(resp.Courses), resp.Threshold)
+		}
+		if resp.Courses[0].CourseId != "NF-95" || resp.Courses[0].FillRate != 95 {
+			t.Errorf("Expected fullest course NF-95 first, got %+v", resp.Courses[0])
+		}
+
+		// Explicit threshold overrides the config
+		resp, err = client.GetNearlyFullCourses(ctx, &pb.GetNearlyFullCoursesRequest{Semester: "NearlyFullSem", Threshold: 50})
+		if err != nil || len(resp.Courses) != 3 {
+			t.Errorf("Expected 3 courses at 50%%, got %v (err %v)", resp, err)
+		}
+
+		_, err = client.GetNearlyFullCourses(ctx, &pb.GetNearlyFullCoursesRequest{Threshold: 150})
+		if status.Code(err) != codes.InvalidArgument {
+			t.Errorf("Expected InvalidArgument for threshold 150, got %v", err)
+		}
+	})
+
+	t.Run("Get Course Fill Timeline", func(t *testing.T) {
+		ftCourseID := "FT-101"
+		t0 := time.Date(2030, 1, 6, 9, 0, 0, 0, time.UTC)
+		t1, t2 := t0.Add(time.Hour), t0.Add(2*time.Hour)
+		db.Collection("courses").InsertOne(ctx, shared.Course{
+			ID: ftCourseID, Code: ftCourseID, Title: "Fill Timeline", Units: 3, Schedule: "S 8:00-9:00",
+			Capacity: 20, Enrolled: 2, IsOpen: true, Semester: "FillSem",
+		})
+		db.Collection("enrollments").InsertMany(ctx, []interface{}{
+			shared.Enrollment{ID: "ENR-FT-1", StudentID: "ft-1", CourseID: ftCourseID, Status: shared.StatusEnrolled, EnrolledAt: t0},
+			shared.Enrollment{ID: "ENR-FT-2", StudentID: "ft-2", CourseID: ftCourseID, Status: shared.StatusDropped, EnrolledAt: t1, DroppedAt: t2},
+			shared.Enrollment{ID: "ENR-FT-3", StudentID: "ft-3", CourseID: ftCourseID, Status: shared.StatusEnrolled, EnrolledAt: t1},
+		})
+		defer func() {
+			db.Collection("courses").DeleteOne(ctx, bson.M{"_id": ftCourseID})
+			db.Collection("enrollments").DeleteMany(ctx, bson.M{"course_id": ftCourseID})
+		}()
+
+		resp, err := client.GetCourseFillTimeline(ctx, &pb.GetCourseFillTimelineRequest{CourseId: ftCourseID})
+		if err != nil || !resp.Success {
+			t.Fatalf("GetCourseFillTimeline failed: %v", err)
+		}
+		// Two enrollments at t1 collapse into one point
+		want := []struct {
+			at       time.Time
+			enrolled int32
+		}{{t0, 1}, {t1, 3}, {t2, 2}}
+		if len(resp.Points) != len(want) || resp.Capacity != 20 {
+			t.Fatalf("Expected %d points for capacity 20, got %v", len(want), resp)
+		}
+		for i, w := range want {
+			if p := resp.Points[i]; !p.Timestamp.AsTime().Equal(w.at) || p.Enrolled != w.enrolled {
+				t.Errorf("Point %d: expected %d at %v, got %d at %v", i, w.enrolled, w.at, p.Enrolled, p.Timestamp.AsTime())
+			}
+		}
+
+		_, err = client.GetCourseFillTimeline(ctx, &pb.GetCourseFillTimelineRequest{CourseId: "FT-MISSING"})
+		if status.Code(err) != codes.NotFound {
+			t.Errorf("Expected NotFound for an unknown course, got %v", err)
+		}
+	})
+
+	t.Run("Manage Prerequisites", func(t *testing.T) {
+		introID, midID, advID := "PRQ-101", "PRQ-201", "PRQ-301"
+		for _, id := range []string{introID, midID, advID} {
+			db.Collection("courses").InsertOne(ctx, shared.Course{
+				ID: id, Code: id, Title: "Prereq " + id, Units: 3, Capacity: 20, IsOpen: true, Semester: "PrqSem",
+			})
+		}
+		defer func() {
+			db.Collection("courses").DeleteMany(ctx, bson.M{"semester": "PrqSem"})
+			db.Collection("prerequisites").DeleteMany(ctx, bson.M{"course_id": bson.M{"$in": []string{introID, midID, advID}}})
+		}()
+
+		// A catalog page cached before a prerequisite change is not served after it
+		catalog := course.NewCourseService(db)
+		prerequisitesInCatalog := func(courseID string) []string {
+			resp, err := catalog.ListCourses(ctx, &pb_course.ListCoursesRequest{Filters: &pb_course.CourseFilter{Semester: "PrqSem"}})
+			if err != nil {
+				t.Fatalf("ListCourses failed: %v", err)
+			}
+			for _, c := range resp.Courses {
+				if c.Id == courseID {
+					return c.Prerequisites
+				}
+			}
+			t.Fatalf("%s missing from the catalog", courseID)
+			return nil
+		}
+		if got := prerequisitesInCatalog(midID); len(got) != 0 {
+			t.Fatalf("Expected %s to start without prerequisites, got %v", midID, got)
+		}
+
+		// advanced <- mid <- intro
+		if _, err := client.AddPrerequisite(ctx, &pb.AddPrerequisiteRequest{CourseId: midID, PrereqId: introID, AdminId: testAdminID}); err != nil {
+			t.Fatalf("AddPrerequisite failed: %v", err)
+		}
+		if got := prerequisitesInCatalog(midID); len(got) != 1 || got[0] != introID {
+			t.Errorf("Expected the catalog to list %s as a prerequisite at once, got %v", introID, got)
+		}
+		if _, err := client.AddPrerequisite(ctx, &pb.AddPrerequisiteRequest{CourseId: advID, PrereqId: midID, AdminId: testAdminID}); err != nil {
+			t.Fatalf("AddPrerequisite failed: %v", err)
+		}
+		var link shared.Prerequisite
+		if err := db.Collection("prerequisites").FindOne(ctx, bson.M{"course_id": advID}).Decode(&link); err != nil || link.PrereqID != midID {
+			t.Errorf("Expected %s as the prerequisite of %s, got %+v (%v)", midID, advID, link, err)
+		}
+
+		// Direct and transitive cycles are rejected
+		_, err = client.AddPrerequisite(ctx, &pb.AddPrerequisiteRequest{CourseId: midID, PrereqId: advID, AdminId: testAdminID})
+		if shared.ErrorCodeOf(err) != shared.ErrCodePrereqCycle {
+			t.Errorf("Expected %s for a direct cycle, got %v", shared.ErrCodePrereqCycle, err)
+		}
+		_, err = client.AddPrerequisite(ctx, &pb.AddPrerequisiteRequest{CourseId: introID, PrereqId: advID, AdminId: testAdminID})
+		if shared.ErrorCodeOf(err) != shared.ErrCodePrereqCycle {
+			t.Errorf("Expected %s for a transitive cycle, got %v", shared.ErrCodePrereqCycle, err)
+		}
+		if count, _ := db.Collection("prerequisites").CountDocuments(ctx, bson.M{"course_id": introID}); count != 0 {
+			t.Errorf("Expected rejected cycle to write nothing, found %d links", count)
+		}
+
+		_, err = client.AddPrerequisite(ctx, &pb.AddPrerequisiteRequest{CourseId: introID, PrereqId: introID, AdminId: testAdminID})
+		if status.Code(err) != codes.InvalidArgument {
+			t.Errorf("Expected InvalidArgument for a self-prerequisite, got %v", err)
+		}
+		_, err = client.AddPrerequisite(ctx, &pb.AddPrerequisiteRequest{CourseId: advID, PrereqId: "PRQ-MISSING", AdminId: testAdminID})
+		if shared.ErrorCodeOf(err) != shared.ErrCodeCourseNotFound {
+			t.Errorf("Expected %s for an unknown prerequisite, got %v", shared.ErrCodeCourseNotFound, err)
+		}
+
+		// Once the chain is broken the reverse link is allowed
+		if _, err := client.RemovePrerequisite(ctx, &pb.RemovePrerequisiteRequest{CourseId: advID, PrereqId: midID, AdminId: testAdminID}); err != nil {
+			t.Fatalf("RemovePrerequisite failed: %v", err)
+		}
+		if count, _ := db.Collection("prerequisites").CountDocuments(ctx, bson.M{"course_id": advID}); count != 0 {
+			t.Errorf("Expected %s to have no prerequisites, found %d links", advID, count)
+		}
+		if _, err := client.AddPrerequisite(ctx, &pb.AddPrerequisiteRequest{CourseId: midID, PrereqId: advID, AdminId: testAdminID}); err != nil {
+			t.Errorf("Expected link to be allowed after removing the chain, got %v", err)
+		}
+
+		_, err = client.RemovePrerequisite(ctx, &pb.RemovePrerequisiteRequest{CourseId: advID, PrereqId: midID, AdminId: testAdminID})
+		if status.Code(err) != codes.NotFound {
+			t.Errorf("Expected NotFound removing a missing link, got %v", err)
+		}
+	})
+
+	t.Run("Faculty Load Report", func(t *testing.T) {
+		loadSem := "LoadSem"
+		heavyID, lightID := "fac-load-heavy", "fac-load-light"
+		db.Collection("users").InsertMany(ctx, []interface{}{
+			shared.User{ID: heavyID, Email: "heavy@load.test", Role: shared.RoleFaculty, Name: "Heavy Load", IsActive: true},
+			shared.User{ID: lightID, Email: "light@load.test", Role: shared.RoleFaculty, Name: "Light Load", IsActive: true},
+		})
+		var loadCourseIDs []string
+		for i, fac := range []string{heavyID, heavyID, heavyID, heavyID, heavyID, lightID, ""} {
+			id := fmt.Sprintf("LOAD-%d", i)
+			loadCourseIDs = append(loadCourseIDs, id)
+			db.Collection("courses").InsertOne(ctx, shared.Course{
+				ID: id, Code: id, Title: "Load", Units: 3, Schedule: "S 8:00-9:00",
+				Capacity: 20, Enrolled: 10, FacultyID: fac, Semester: loadSem,
+			})
+		}
+		defer func() {
+			db.Collection("users").DeleteMany(ctx, bson.M{"_id": bson.M{"$in": []string{heavyID, lightID}}})
+			db.Collection("courses").DeleteMany(ctx, bson.M{"_id": bson.M{"$in": loadCourseIDs}})
+		}()
+
+		resp, err := client.GetFacultyLoadReport(ctx, &pb.GetFacultyLoadReportRequest{Semester: loadSem})
+		if err != nil || !resp.Success {
+			t.Fatalf("GetFacultyLoadReport failed: %v", err)
+		}
+		// The unassigned course is not anyone's load
+		if resp.MaxUnits != shared.DefaultFacultyLoadMaxUnits || len(resp.Faculty) != 2 {
+			t.Fatalf("Expected 2 faculty at %d units, got %v", shared.DefaultFacultyLoadMaxUnits, resp)
+		}
+		heavy := resp.Faculty[0]
+		if heavy.FacultyId != heavyID || heavy.FacultyName != "Heavy Load" || heavy.CourseCount != 5 ||
+			heavy.TotalUnits != 15 || heavy.TotalEnrolled != 50 || heavy.ContactHours != 5 || !heavy.Overloaded {
+			t.Errorf("Expected the heavy load first and flagged, got %+v", heavy)
+		}
+		if light := resp.Faculty[1]; light.TotalUnits != 3 || light.Overloaded {
+			t.Errorf("Expected a 3-unit load within limits, got %+v", light)
+		}
+
+		// Active faculty without courses are only listed on request, after the loaded ones
+		idleID := "fac-load-idle"
+		db.Collection("users").InsertOne(ctx, shared.User{ID: idleID, Email: "idle@load.test", Role: shared.RoleFaculty, Name: "Idle", IsActive: true})
+		defer db.Collection("users").DeleteOne(ctx, bson.M{"_id": idleID})
+		resp, err = client.GetFacultyLoadReport(ctx, &pb.GetFacultyLoadReportRequest{Semester: loadSem, IncludeUnassigned: true})
+		if err != nil {
+			t.Fatalf("GetFacultyLoadReport with unassigned failed: %v", err)
+		}
+		var idle *pb.FacultyLoad
+		for i, f := range resp.Faculty {
+			if f.FacultyId == idleID {
+				idle = f
+				if i < 2 {
+					t.Errorf("Expected idle faculty after the loaded ones, found at %d", i)
+				}
+			}
+		}
+		if idle == nil || idle.CourseCount != 0 || idle.TotalUnits != 0 || idle.FacultyName != "Idle" {
+			t.Errorf("Expected %s listed with no load, got %+v", idleID, idle)
+		}
+
+		// An explicit threshold overrides the config
+		resp, err = client.GetFacultyLoadReport(ctx, &pb.GetFacultyLoadReportRequest{Semester: loadSem, MaxUnits: 15})
+		if err != nil || resp.Faculty[0].Overloaded {
+			t.Errorf("Expected 15 units to be within a 15-unit limit, got %v (err %v)", resp, err)
+		}
+
+		_, err = client.GetFacultyLoadReport(ctx, &pb.GetFacultyLoadReportRequest{})
+		if status.Code(err) != codes.InvalidArgument {
+			t.Errorf("Expected InvalidArgument without a semester, got %v", err)
+		}
+	})
+
+	t.Run("Get System Stats", func(t *testing.T) {
+		resp, err := client.GetSystemStats(ctx, &pb.GetSystemStatsRequest{})
+		if err != nil {
+			t.Fatalf("GetSystemStats failed: %v", err)
+		}
+		if resp.Stats.TotalCourses == 0 {
+			t.Error("Stats mismatch, expected courses")
+		}
+	})
+
+	t.Run("Ensure Default Config", func(t *testing.T) {
+		db.Collection("system_config").DeleteMany(ctx, bson.M{})
+		defer db.Collection("system_config").DeleteMany(ctx, bson.M{})
+		db.Collection("system_config").InsertMany(ctx, []interface{}{
+			shared.SystemConfig{Key: shared.ConfigEnrollmentOn, Value: "false", UpdatedBy: testAdminID},
+			shared.SystemConfig{Key: shared.ConfigMaxCourses, Value: "3", UpdatedBy: testAdminID},
+		})
+
+		created, err := shared.EnsureDefaultConfig(ctx, db)
+		if err != nil {
+			t.Fatalf("EnsureDefaultConfig failed: %v", err)
+		}
+		for _, key := range created {
+			if key == shared.ConfigEnrollmentOn || key == shared.ConfigMaxCourses {
+				t.Errorf("Expected existing key %s not to be reported as created", key)
+			}
+		}
+		if want := len(shared.DefaultConfig(time.Now())) - 2; len(created) != want {
+			t.Errorf("Expected %d defaults created, got %d", want, len(created))
+		}
+
+		want := map[string]string{shared.ConfigEnrollmentOn: "false", shared.ConfigMaxCourses: "3"}
+		for key, value := range want {
+			var cfg shared.SystemConfig
+			db.Collection("system_config").FindOne(ctx, bson.M{"key": key}).Decode(&cfg)
+			if cfg.Value != value || cfg.UpdatedBy != testAdminID {
+				t.Errorf("Expected %s to keep %q set by the admin, got %q by %q", key, value, cfg.Value, cfg.UpdatedBy)
+			}
+		}
+
+		// A second run has nothing left to create
+		if created, err := shared.EnsureDefaultConfig(ctx, db); err != nil || len(created) != 0 {
+			t.Errorf("Expected nothing created on a second run, got %v, %v", created, err)
+		}
+	})
+
+	t.Run("System Stats Enrollment Window", func(t *testing.T) {
+		now := time.Now()
+		seedConfig := func(enabled string) {
+			db.Collection("system_config").DeleteMany(ctx, bson.M{})
+			db.Collection("system_config").InsertMany(ctx, []interface{}{
+				shared.SystemConfig{Key: shared.ConfigCurrentSemester, Value: "Stats Sem 2025"},
+				shared.SystemConfig{Key: shared.ConfigEnrollmentOn, Value: enabled},
+				shared.SystemConfig{Key: shared.ConfigEnrollmentStart, Value: now.Add(-24 * time.Hour).Format(time.RFC3339)},
+				shared.SystemConfig{Key: shared.ConfigEnrollmentEnd, Value: now.Add(24 * time.Hour).Format(time.RFC3339)},
+			})
+		}
+		defer db.Collection("system_config").DeleteMany(ctx, bson.M{})
+
+		// One enrollment today and one dropped today
+		db.Collection("enrollments").InsertMany(ctx, []interface{}{
+			shared.Enrollment{ID: "ENR-STATS-1", StudentID: "STU-STATS", CourseID: "stats-course-1", Status: shared.StatusEnrolled, EnrolledAt: now},
+			shared.Enrollment{ID: "ENR-STATS-2", StudentID: "STU-STATS", CourseID: "stats-course-2", Status: shared.StatusDropped,
+				EnrolledAt: now.Add(-72 * time.Hour), DroppedAt: now},
+		})
+
+		seedConfig("true")
+		resp, err := client.GetSystemStats(ctx, &pb.GetSystemStatsRequest{})
+		if err != nil {
+			t.Fatalf("GetSystemStats failed: %v", err)
+		}
+		stats := resp.Stats
+		if !stats.EnrollmentOpen || stats.CurrentSemester != "Stats Sem 2025" {
+			t.Errorf("Expected open enrollment for Stats Sem 2025, got %v / %q", stats.EnrollmentOpen, stats.CurrentSemester)
+		}
+		if stats.EnrollmentsToday < 1 || stats.DropsToday < 1 {
+			t.Errorf("Expected today's enrollment and drop to be counted, got %d / %d", stats.EnrollmentsToday, stats.DropsToday)
+		}
+		if stats.TotalEnrollments < 1 || stats.AverageFillRate < 0 || stats.AverageFillRate > 100 {
+			t.Errorf("Unexpected totals: %+v", stats)
+		}
+
+		// Disabled enrollment is closed even inside the window
+		seedConfig("false")
+		resp, err = client.GetSystemStats(ctx, &pb.GetSystemStatsRequest{})
+		if err != nil || resp.Stats.EnrollmentOpen {
+			t.Errorf("Expected enrollment closed when disabled, got %v (err %v)", resp, err)
+		}
+	})
+
+	t.Run("Recalculate Enrollment Counts", func(t *testing.T) {
+		// After the force drop the course has no active enrollments; simulate drift
+		db.Collection("courses").UpdateOne(ctx, bson.M{"_id": createdCourseID}, bson.M{"$set": bson.M{"enrolled": 5}})
+
+		resp, err := client.RecalculateEnrollmentCounts(ctx, &pb.RecalculateEnrollmentCountsRequest{
+			Semester: "TestSem",
+			AdminId:  testAdminID,
+		})
+		if err != nil || !resp.Success {
+			t.Fatalf("RecalculateEnrollmentCounts failed: %v", err)
+		}
+
+		var found *pb.EnrollmentCountCorrection
+		for _, c := range resp.Corrections {
+			if c.CourseId == createdCourseID {
+				found = c
+			}
+		}
+		if found == nil || found.PreviousCount != 5 || found.ActualCount != 0 || found.Delta != -5 {
+			t.Errorf("Expected correction 5 -> 0 for %s, got %+v", createdCourseID, found)
+		}
+
+		var course shared.Course
+		db.Collection("courses").FindOne(ctx, bson.M{"_id": createdCourseID}).Decode(&course)
+		if course.Enrolled != 0 {
+			t.Errorf("Expected stored count to be repaired to 0, got %d", course.Enrolled)
+		}
+	})
+
+	t.Run("Close Semester", func(t *testing.T) {
+		courses := map[string]string{"CLOSE-A": "CloseSemA", "CLOSE-B": "CloseSemB"}
+		for id, semester := range courses {
+			db.Collection("courses").InsertOne(ctx, shared.Course{ID: id, Code: id, Title: "Close Semester", Units: 3, Capacity: 10, Enrolled: 2, IsOpen: true, Semester: semester})
+		}
+		db.Collection("enrollments").InsertMany(ctx, []interface{}{
+			shared.Enrollment{ID: "ENR-CLOSE-A1", StudentID: "close-s1", CourseID: "CLOSE-A", Status: shared.StatusEnrolled},
+			shared.Enrollment{ID: "ENR-CLOSE-A2", StudentID: "close-s2", CourseID: "CLOSE-A", Status: shared.StatusEnrolled},
+			shared.Enrollment{ID: "ENR-CLOSE-A3", StudentID: "close-s3", CourseID: "CLOSE-A", Status: shared.StatusDropped},
+			shared.Enrollment{ID: "ENR-CLOSE-B1", StudentID: "close-s1", CourseID: "CLOSE-B", Status: shared.StatusEnrolled},
+		})
+		defer func() {
+			db.Collection("courses").DeleteMany(ctx, bson.M{"_id": bson.M{"$in": []string{"CLOSE-A", "CLOSE-B"}}})
+			db.Collection("enrollments").DeleteMany(ctx, bson.M{"course_id": bson.M{"$in": []string{"CLOSE-A", "CLOSE-B"}}})
+			db.Collection("semester_closures").DeleteMany(ctx, bson.M{"_id": bson.M{"$in": []string{"CloseSemA", "CloseSemB"}}})
+			db.Collection("audit_logs").DeleteMany(ctx, bson.M{"resource": "CloseSemA"})
+		}()
+
+		resp, err := client.CloseSemester(ctx, &pb.CloseSemesterRequest{Semester: "CloseSemA", AdminId: testAdminID})
+		if err != nil || !resp.Success {
+			t.Fatalf("CloseSemester failed: %v", err)
+		}
+		if resp.CoursesClosed != 1 || resp.EnrollmentsCompleted != 2 {
+			t.Errorf("Expected 2 enrollments completed in 1 course, got %+v", resp)
+		}
+
+		want := map[string]string{
+			"ENR-CLOSE-A1": shared.StatusCompleted,
+			"ENR-CLOSE-A2": shared.StatusCompleted,
+			"ENR-CLOSE-A3": shared.StatusDropped,
+			"ENR-CLOSE-B1": shared.StatusEnrolled, // another semester
+		}
+		for id, wantStatus := range want {
+			var e shared.Enrollment
+			db.Collection("enrollments").FindOne(ctx, bson.M{"_id": id}).Decode(&e)
+			if e.Status != wantStatus {
+				t.Errorf("Expected %s to be %s, got %s", id, wantStatus, e.Status)
+			}
+		}
+
+		var a, b shared.Course
+		db.Collection("courses").FindOne(ctx, bson.M{"_id": "CLOSE-A"}).Decode(&a)
+		db.Collection("courses").FindOne(ctx, bson.M{"_id": "CLOSE-B"}).Decode(&b)
+		if a.Enrolled != 0 || b.Enrolled != 2 {
+			t.Errorf("Expected only the closed course to give up its seats, got %d and %d", a.Enrolled, b.Enrolled)
+		}
+		if a.IsOpen || !b.IsOpen {
+			t.Errorf("Expected only the closed semester's course to be closed, got is_open %v and %v", a.IsOpen, b.IsOpen)
+		}
+		var closure shared.SemesterClosure
+		db.Collection("semester_closures").FindOne(ctx, bson.M{"_id": "CloseSemA"}).Decode(&closure)
+		if closure.EnrollmentsCompleted != 2 || closure.CoursesClosed != 1 {
+			t.Errorf("Expected a closure record with 2 enrollments completed in 1 course, got %+v", closure)
+		}
+
+		// Reopening a course does not let anyone back into a closed semester
+		override, err := client.OverrideEnrollment(ctx, &pb.OverrideEnrollmentRequest{
+			StudentId: createdStudentID, CourseId: "CLOSE-A", Action: "force_enroll", Reason: "late add", AdminId: testAdminID,
+		})
+		if err != nil || override.Success || override.ErrorCode != string(shared.ErrCodeSemesterClosed) {
+			t.Errorf("Expected force_enroll into a closed semester to fail with SEMESTER_CLOSED, got %+v, %v", override, err)
+		}
+
+		if n, _ := db.Collection("audit_logs").CountDocuments(ctx, bson.M{"resource": "CloseSemA", "action": shared.ActionSemesterClose}); n != 1 {
+			t.Errorf("Expected one audit event, got %d", n)
+		}
+
+		_, err = client.CloseSemester(ctx, &pb.CloseSemesterRequest{Semester: "CloseSemA", AdminId: testAdminID})
+		if shared.ErrorCodeOf(err) != shared.ErrCodeSemesterClosed {
+			t.Errorf("Expected SEMESTER_CLOSED on a second run, got %v", err)
+		}
+		if _, err := client.CloseSemester(ctx, &pb.CloseSemesterRequest{Semester: "NoSuchSem"}); status.Code(err) != codes.NotFound {
+			t.Errorf("Expected NotFound for a semester without courses, got %v", err)
+		}
+	})
+
+	t.Run("Publish All Grades", func(t *testing.T) {
+		courses := map[string]string{"PUBALL-A1": "PubAllSemA", "PUBALL-A2": "PubAllSemA", "PUBALL-B": "PubAllSemB"}
+		courseIDs := make([]string, 0, len(courses))
+		for id, semester := range courses {
+			courseIDs = append(courseIDs, id)
+			db.Collection("courses").InsertOne(ctx, shared.Course{ID: id, Code: id, Title: "Publish All Grades", Units: 3, Capacity: 10, Semester: semester})
+		}
+		grade := func(enrollmentID, studentID, courseID string, published bool) bson.M {
+			return bson.M{"enrollment_id": enrollmentID, "student_id": studentID, "course_id": courseID, "grade": "A", "published": published}
+		}
+		db.Collection("grades").InsertMany(ctx, []interface{}{
+			grade("ENR-PUBALL-1", "puball-s1", "PUBALL-A1", false),
+			grade("ENR-PUBALL-2", "puball-s2", "PUBALL-A1", false),
+			grade("ENR-PUBALL-3", "puball-s1", "PUBALL-A2", false),
+			grade("ENR-PUBALL-4", "puball-s3", "PUBALL-A2", true), // already published
+			grade("ENR-PUBALL-5", "puball-s1", "PUBALL-B", false), // another semester
+		})
+		defer func() {
+			db.Collection("courses").DeleteMany(ctx, bson.M{"_id": bson.M{"$in": courseIDs}})
+			db.Collection("grades").DeleteMany(ctx, bson.M{"course_id": bson.M{"$in": courseIDs}})
+			db.Collection("notifications").DeleteMany(ctx, bson.M{"type": shared.NotificationGradesPublished, "user_id": bson.M{"$regex": "^puball-"}})
+			db.Collection("audit_logs").DeleteMany(ctx, bson.M{"resource": "PubAllSemA"})
+		}()
+
+		_, err := client.PublishAllGrades(ctx, &pb.PublishAllGradesRequest{Semester: "PubAllSemA", AdminId: testAdminID})
+		if shared.ErrorCodeOf(err) != shared.ErrCodeInvalidField || shared.ErrorParamsOf(err)["field"] != "confirm" {
+			t.Fatalf("Expected INVALID_FIELD for confirm without confirmation, got %v", err)
+		}
+		if n, _ := db.Collection("grades").CountDocuments(ctx, bson.M{"course_id": bson.M{"$in": courseIDs}, "published": true}); n != 1 {
+			t.Fatalf("Expected nothing published without confirmation, got %d published", n)
+		}
+
+		resp, err := client.PublishAllGrades(ctx, &pb.PublishAllGradesRequest{Semester: "PubAllSemA", AdminId: testAdminID, Confirm: true, NotifyStudents: true})
+		if err != nil || !resp.Success {
+			t.Fatalf("PublishAllGrades failed: %v", err)
+		}
+		if resp.GradesPublished != 3 || resp.CoursesAffected != 2 || resp.StudentsNotified != 2 {
+			t.Errorf("Expected 3 grades in 2 courses and 2 students notified, got %+v", resp)
+		}
+
+		want := map[string]bool{
+			"ENR-PUBALL-1": true,
+			"ENR-PUBALL-2": true,
+			"ENR-PUBALL-3": true,
+			"ENR-PUBALL-4": true,
+			"ENR-PUBALL-5": false,
+		}
+		for id, wantPublished := range want {
+			var g shared.Grade
+			db.Collection("grades").FindOne(ctx, bson.M{"enrollment_id": id}).Decode(&g)
+			if g.Published != wantPublished {
+				t.Errorf("Expected %s published=%v, got %v", id, wantPublished, g.Published)
+			}
+		}
+
+		if n, _ := db.Collection("notifications").CountDocuments(ctx, bson.M{"type": shared.NotificationGradesPublished, "user_id": bson.M{"$in": []string{"puball-s1", "puball-s2", "puball-s3"}}}); n != 2 {
+			t.Errorf("Expected one notification for each of 2 students, got %d", n)
+		}
+
+		if n, _ := db.Collection("audit_logs").CountDocuments(ctx, bson.M{"resource": "PubAllSemA", "action": shared.ActionGradesPublish}); n != 1 {
+			t.Errorf("Expected one audit event, got %d", n)
+		}
+		var entry shared.AuditLog
+		db.Collection("audit_logs").FindOne(ctx, bson.M{"resource": "PubAllSemA", "action": shared.ActionGradesPublish}).Decode(&entry)
+		if count, _ := entry.Details["grades_published"].(int32); count != 3 {
+			t.Errorf("Expected the audit event to record 3 grades, got %v", entry.Details["grades_published"])
+		}
+
+		resp, err = client.PublishAllGrades(ctx, &pb.PublishAllGradesRequest{Semester: "PubAllSemA", AdminId: testAdminID, Confirm: true})
+		if err != nil || resp.GradesPublished != 0 {
+			t.Errorf("Expected nothing left to publish on a second run, got %+v, %v", resp, err)
+		}
+		if _, err := client.PublishAllGrades(ctx, &pb.PublishAllGradesRequest{Semester: "NoSuchSem", Confirm: true}); status.Code(err) != codes.NotFound {
+			t.Errorf("Expected NotFound for a semester without courses, got %v", err)
+		}
+	})
+
+	t.Run("Get User Detail", func(t *testing.T) {
+		now := time.Now()
+		db.Collection("system_config").DeleteMany(ctx, bson.M{"key": shared.ConfigCurrentSemester})
+		db.Collection("system_config").InsertOne(ctx, shared.SystemConfig{Key: shared.ConfigCurrentSemester, Value: "DetailSemNow"})
+		db.Collection("users").InsertMany(ctx, []interface{}{
+			shared.User{ID: "detail-student", Email: "detail-student@test.com", PasswordHash: "secret-hash", Role: shared.RoleStudent,
+				Name: "Detail Student", StudentID: "STU-DETAIL", IsActive: true, CreatedAt: now, LastLoginAt: now},
+			shared.User{ID: "detail-faculty", Email: "detail-faculty@test.com", PasswordHash: "secret-hash", Role: shared.RoleFaculty,
+				Name: "Detail Faculty", IsActive: true, CreatedAt: now},
+		})
+		db.Collection("courses").InsertMany(ctx, []interface{}{
+			shared.Course{ID: "DETAIL-A", Code: "DETAIL-A", Title: "Detail A", Units: 3, Capacity: 10, Semester: "DetailSemNow", FacultyID: "detail-faculty"},
+			shared.Course{ID: "DETAIL-B", Code: "DETAIL-B", Title: "Detail B", Units: 2, Capacity: 10, Semester: "DetailSemNow"},
+			shared.Course{ID: "DETAIL-OLD", Code: "DETAIL-OLD", Title: "Detail Old", Units: 3, Capacity: 10, Semester: "DetailSemOld", FacultyID: "detail-faculty"},
+		})
+		db.Collection("enrollments").InsertMany(ctx, []interface{}{
+			shared.Enrollment{ID: "ENR-DETAIL-A", StudentID: "STU-DETAIL", CourseID: "DETAIL-A", Status: shared.StatusEnrolled, EnrolledAt: now},
+			shared.Enrollment{ID: "ENR-DETAIL-B", StudentID: "STU-DETAIL", CourseID: "DETAIL-B", Status: shared.StatusDropped, EnrolledAt: now},
+			shared.Enrollment{ID: "ENR-DETAIL-OLD", StudentID: "STU-DETAIL", CourseID: "DETAIL-OLD", Status: shared.StatusCompleted, EnrolledAt: now},
+		})
+		db.Collection("grades").InsertMany(ctx, []interface{}{
+			bson.M{"enrollment_id": "ENR-DETAIL-OLD", "student_id": "STU-DETAIL", "course_id": "DETAIL-OLD", "grade": "B", "units": 3, "published": true},
+			bson.M{"enrollment_id": "ENR-DETAIL-X", "student_id": "STU-DETAIL", "course_id": "DETAIL-X", "grade": "A", "units": 1, "published": true},
+			bson.M{"enrollment_id": "ENR-DETAIL-A", "student_id": "STU-DETAIL", "course_id": "DETAIL-A", "grade": "F", "units": 3, "published": false},
+		})
+		db.Collection("sessions").InsertMany(ctx, []interface{}{
+			shared.Session{ID: "SES-DETAIL-1", UserID: "detail-student", Token: "detail-1", CreatedAt: now, ExpiresAt: now.Add(time.Hour)},
+			shared.Session{ID: "SES-DETAIL-2", UserID: "detail-student", Token: "detail-2", CreatedAt: now, ExpiresAt: now.Add(-time.Hour)},
+		})
+		defer func() {
+			db.Collection("system_config").DeleteMany(ctx, bson.M{"key": shared.ConfigCurrentSemester})
+			db.Collection("users").DeleteMany(ctx, bson.M{"_id": bson.M{"$in": []string{"detail-student", "detail-faculty"}}})
+			db.Collection("courses").DeleteMany(ctx, bson.M{"_id": bson.M{"$regex": "^DETAIL-"}})
+			db.Collection("enrollments").DeleteMany(ctx, bson.M{"student_id": "STU-DETAIL"})
+			db.Collection("grades").DeleteMany(ctx, bson.M{"student_id": "STU-DETAIL"})
+			db.Collection("sessions").DeleteMany(ctx, bson.M{"user_id": "detail-student"})
+		}()
+
+		resp, err := client.GetUserDetail(ctx, &pb.GetUserDetailRequest{UserId: "detail-student"})
+		if err != nil || !resp.Success {
+			t.Fatalf("GetUserDetail failed: %v", err)
+		}
+		if resp.User.StudentId != "STU-DETAIL" || !resp.User.IsActive || resp.User.CreatedAt == nil || resp.User.LastLoginAt == nil {
+			t.Errorf("Unexpected user: %+v", resp.User)
+		}
+		if resp.Semester != "DetailSemNow" || resp.ActiveSessions != 1 {
+			t.Errorf("Expected 1 active session in DetailSemNow, got %d in %q", resp.ActiveSessions, resp.Semester)
+		}
+		got := make(map[string]string)
+		for _, e := range resp.Enrollments {
+			got[e.CourseCode] = e.Status
+			if e.CourseCode == "DETAIL-A" && e.Units != 3 {
+				t.Errorf("Expected DETAIL-A to have 3 units, got %d", e.Units)
+			}
+		}
+		if len(got) != 2 || got["DETAIL-A"] != shared.StatusEnrolled || got["DETAIL-B"] != shared.StatusDropped {
+			t.Errorf("Expected only this semester's enrollments, got %v", got)
+		}
+		// (B=3.0 x 3 + A=4.0 x 1) / 4; the unpublished F does not count
+		if want := 13.0 / 4; resp.Cgpa < want-0.001 || resp.Cgpa > want+0.001 {
+			t.Errorf("Expected CGPA %.3f, got %.3f", want, resp.Cgpa)
+		}
+		if len(resp.AssignedCourses) != 0 {
+			t.Errorf("Expected no assigned courses for a student, got %d", len(resp.AssignedCourses))
+		}
+
+		resp, err = client.GetUserDetail(ctx, &pb.GetUserDetailRequest{UserId: "detail-faculty"})
+		if err != nil {
+			t.Fatalf("GetUserDetail (faculty) failed: %v", err)
+		}
+		if len(resp.AssignedCourses) != 1 || resp.AssignedCourses[0].Id != "DETAIL-A" {
+			t.Errorf("Expected only DETAIL-A this semester, got %+v", resp.AssignedCourses)
+		}
+		if len(resp.Enrollments) != 0 || resp.Cgpa != 0 {
+			t.Errorf("Expected no student details for faculty, got %+v", resp)
+		}
+
+		if _, err := client.GetUserDetail(ctx, &pb.GetUserDetailRequest{UserId: "no-such-user"}); status.Code(err) != codes.NotFound {
+			t.Errorf("Expected NotFound for an unknown user, got %v", err)
+		}
+	})
+
+	t.Run("Cancel Course", func(t *testing.T) {
+		const courseID = "CANCEL-A"
+		db.Collection("courses").InsertOne(ctx, shared.Course{ID: courseID, Code: courseID, Title: "Cancel Course", Units: 3, Capacity: 10, Enrolled: 2, IsOpen: true, Semester: "CancelSem"})
+		db.Collection("enrollments").InsertMany(ctx, []interface{}{
+			shared.Enrollment{ID: "ENR-CANCEL-1", StudentID: "cancel-s1", CourseID: courseID, Status: shared.StatusEnrolled},
+			shared.Enrollment{ID: "ENR-CANCEL-2", StudentID: "cancel-s2", CourseID: courseID, Status: shared.StatusEnrolled},
+			shared.Enrollment{ID: "ENR-CANCEL-3", StudentID: "cancel-s3", CourseID: courseID, Status: shared.StatusDropped},
+		})
+		db.Collection("carts").InsertOne(ctx, shared.Cart{StudentID: "cancel-s4", CourseIDs: []string{courseID, "OTHER"}})
+		db.Collection("seat_holds").InsertOne(ctx, shared.SeatHold{ID: "HOLD-CANCEL", CourseID: courseID, StudentID: "cancel-s4", ExpiresAt: time.Now().Add(time.Hour)})
+		db.Collection("users").InsertOne(ctx, shared.User{ID: "cancel-s5", Email: "cancel-s5@test.com", Role: shared.RoleStudent, IsActive: true})
+		defer func() {
+			db.Collection("users").DeleteOne(ctx, bson.M{"_id": "cancel-s5"})
+			db.Collection("courses").DeleteOne(ctx, bson.M{"_id": courseID})
+			db.Collection("enrollments").DeleteMany(ctx, bson.M{"course_id": courseID})
+			db.Collection("carts").DeleteOne(ctx, bson.M{"student_id": "cancel-s4"})
+			db.Collection("seat_holds").DeleteMany(ctx, bson.M{"course_id": courseID})
+			db.Collection("notifications").DeleteMany(ctx, bson.M{"course_id": courseID})
+			db.Collection("audit_logs").DeleteMany(ctx, bson.M{"resource": courseID})
+		}()
+
+		resp, err := client.CancelCourse(ctx, &pb.CancelCourseRequest{CourseId: courseID, AdminId: testAdminID, Reason: "instructor unavailable"})
+		if err != nil || !resp.Success {
+			t.Fatalf("CancelCourse failed: %v", err)
+		}
+		if resp.StudentsAffected != 2 || resp.CartsUpdated != 1 {
+			t.Errorf("Expected 2 students and 1 cart affected, got %+v", resp)
+		}
+
+		for _, id := range []string{"ENR-CANCEL-1", "ENR-CANCEL-2"} {
+			var e shared.Enrollment
+			db.Collection("enrollments").FindOne(ctx, bson.M{"_id": id}).Decode(&e)
+			if e.Status != shared.StatusDropped || e.DropReason != shared.DropReasonCourseCancelled || e.DroppedAt.IsZero() {
+				t.Errorf("Expected %s to be dropped for the cancellation, got %+v", id, e)
+			}
+		}
+		var earlier shared.Enrollment
+		db.Collection("enrollments").FindOne(ctx, bson.M{"_id": "ENR-CANCEL-3"}).Decode(&earlier)
+		if earlier.DropReason != "" {
+			t.Errorf("Expected the earlier drop to keep no reason, got %q", earlier.DropReason)
+		}
+
+		var course shared.Course
+		db.Collection("courses").FindOne(ctx, bson.M{"_id": courseID}).Decode(&course)
+		if !course.Cancelled || course.IsOpen || course.Enrolled != 0 {
+			t.Errorf("Expected a closed, empty, cancelled course, got %+v", course)
+		}
+
+		var cart shared.Cart
+		db.Collection("carts").FindOne(ctx, bson.M{"student_id": "cancel-s4"}).Decode(&cart)
+		if len(cart.CourseIDs) != 1 || cart.CourseIDs[0] != "OTHER" {
+			t.Errorf("Expected the course to be pulled from the cart, got %v", cart.CourseIDs)
+		}
+		if n, _ := db.Collection("seat_holds").CountDocuments(ctx, bson.M{"course_id": courseID}); n != 0 {
+			t.Errorf("Expected seat holds to be released, got %d", n)
+		}
+		if n, _ := db.Collection("notifications").CountDocuments(ctx, bson.M{"course_id": courseID, "type": shared.NotificationCourseCancelled}); n != 2 {
+			t.Errorf("Expected a notification per dropped student, got %d", n)
+		}
+		if n, _ := db.Collection("audit_logs").CountDocuments(ctx, bson.M{"resource": courseID, "action": shared.ActionCourseCancel}); n != 1 {
+			t.Errorf("Expected one audit event, got %d", n)
+		}
+		var entry struct {
+			Details struct {
+				StudentIDs []string `bson:"student_ids"`
+			} `bson:"details"`
+		}
+		db.Collection("audit_logs").FindOne(ctx, bson.M{"resource": courseID, "action": shared.ActionCourseCancel}).Decode(&entry)
+		gotIDs := strings.Join(entry.Details.StudentIDs, ",")
+		if gotIDs != "cancel-s1,cancel-s2" && gotIDs != "cancel-s2,cancel-s1" {
+			t.Errorf("Expected the audit event to list cancel-s1 and cancel-s2, got %v", entry.Details.StudentIDs)
+		}
+
+		// Cancelled stays cancelled: no second run, no reopening, no way back in
+		_, err = client.CancelCourse(ctx, &pb.CancelCourseRequest{CourseId: courseID, AdminId: testAdminID})
+		if shared.ErrorCodeOf(err) != shared.ErrCodeCourseCancelled {
+			t.Errorf("Expected COURSE_CANCELLED on a second run, got %v", err)
+		}
+		if upd, err := client.UpdateCourse(ctx, &pb.UpdateCourseRequest{CourseId: courseID, IsOpen: true}); err != nil || upd.Success {
+			t.Errorf("Expected reopening to be rejected, got %+v, %v", upd, err)
+		}
+		if ovr, err := client.OverrideEnrollment(ctx, &pb.OverrideEnrollmentRequest{StudentId: "cancel-s5", CourseId: courseID, Action: "force_enroll"}); err != nil || ovr.Success {
+			t.Errorf("Expected force_enroll to be rejected, got %+v, %v", ovr, err)
+		}
+		if rst, err := client.RestoreEnrollment(ctx, &pb.RestoreEnrollmentRequest{EnrollmentId: "ENR-CANCEL-1", Force: true}); err != nil || rst.Success {
+			t.Errorf("Expected restore to be refused, got %+v, %v", rst, err)
+		}
+		if _, err := client.CancelCourse(ctx, &pb.CancelCourseRequest{CourseId: "NO-SUCH-COURSE"}); status.Code(err) != codes.NotFound {
+			t.Errorf("Expected NotFound for a missing course, got %v", err)
+		}
+	})
+
+	t.Run("Get Resource History", func(t *testing.T) {
+		resp, err := client.GetResourceHistory(ctx, &pb.GetResourceHistoryRequest{Resource: createdCourseID})
+		if err != nil || !resp.Success {
+			t.Fatalf("GetResourceHistory failed: %v", err)
+		}
+
+		// Created, then updated, in that order
+		if len(resp.Events) < 2 || resp.Events[0].Action != shared.ActionCourseCreate || resp.Events[1].Action != shared.ActionCourseUpdate {
+			t.Fatalf("Expected create then update events, got %+v", resp.Events)
+		}
+		for i := 1; i < len(resp.Events); i++ {
+			if resp.Events[i].Timestamp.AsTime().Before(resp.Events[i-1].Timestamp.AsTime()) {
+				t.Errorf("Events out of order at %d: %v", i, resp.Events)
+			}
+		}
+		for _, e := range resp.Events {
+			if e.Resource != createdCourseID {
+				t.Errorf("Unexpected event for another resource: %+v", e)
+			}
+		}
+
+		// Pages continue in the same order
+		second, err := client.GetResourceHistory(ctx, &pb.GetResourceHistoryRequest{Resource: createdCourseID, Page: 2, PageSize: 1})
+		if err != nil || len(second.Events) != 1 || second.Events[0].Id != resp.Events[1].Id || int(second.TotalCount) != len(resp.Events) {
+			t.Errorf("Expected page 2 of size 1 to hold the second event of %d, got %v (err %v)", len(resp.Events), second, err)
+		}
+
+		_, err = client.GetResourceHistory(ctx, &pb.GetResourceHistoryRequest{Resource: " "})
+		if status.Code(err) != codes.InvalidArgument {
+			t.Errorf("Expected InvalidArgument for a blank resource, got %v", err)
+		}
+	})
+
+	t.Run("Missing Entities Return NotFound", func(t *testing.T) {
+		_, err := client.UpdateCourse(ctx, &pb.UpdateCourseRequest{CourseId: "NO-SUCH-COURSE", Title: "Ghost"})
+		if shared.ErrorCodeOf(err) != shared.ErrCodeCourseNotFound {
+			t.Errorf("UpdateCourse: expected COURSE_NOT_FOUND, got %v", err)
+		}
+		_, err = client.DeleteCourse(ctx, &pb.DeleteCourseRequest{CourseId: "NO-SUCH-COURSE"})
+		if shared.ErrorCodeOf(err) != shared.ErrCodeCourseNotFound {
+			t.Errorf("DeleteCourse: expected COURSE_NOT_FOUND, got %v", err)
+		}
+		_, err = client.AssignFaculty(ctx, &pb.AssignFacultyRequest{CourseId: "NO-SUCH-COURSE", FacultyId: "NO-SUCH-FACULTY"})
+		if status.Code(err) != codes.NotFound {
+			t.Errorf("AssignFaculty: expected NotFound, got %v", err)
+		}
+		_, err = client.ResetPassword(ctx, &pb.ResetPasswordRequest{UserId: "NO-SUCH-USER"})
+		if status.Code(err) != codes.NotFound {
+			t.Errorf("ResetPassword: expected NotFound, got %v", err)
+		}
+		_, err = client.ToggleUserStatus(ctx, &pb.ToggleUserStatusRequest{UserId: "NO-SUCH-USER", Activate: true})
+		if status.Code(err) != codes.NotFound {
+			t.Errorf("ToggleUserStatus: expected NotFound, got %v", err)
+		}
+	})
+
+	// Run Delete last since it destroys the resource
+	t.Run("Delete Course", func(t *testing.T) {
+		resp, err := client.DeleteCourse(ctx, &pb.DeleteCourseRequest{
+			CourseId: createdCourseID,
+		})
+		if err != nil || !resp.Success {
+			t.Errorf("DeleteCourse failed: %v", err)
+		}
+	})
+}
+
+// TestAdminService_AuditFailureRollsBack injects write failures through collections
+// whose validators reject every document (audit) or one key (config), and checks the
+// transaction leaves nothing half-applied.
+func TestAdminService_AuditFailureRollsBack(t *testing.T) {
+	if err := godotenv.Load("../../cmd/admin/.env"); err != nil {
+		log.Println("No .env file found, using defaults")
+	}
+	cfg, _ := shared.LoadServiceConfig("admin-service")
+	mongoClient, db, err := shared.ConnectMongoDB(&cfg.MongoDB)
+	if err != nil {
+		t.Fatalf("Failed to connect to MongoDB: %v", err)
+	}
+	ctx := context.Background()
+	if mode, _ := shared.DetectTransactionMode(ctx, mongoClient); mode == shared.TransactionModeBestEffort {
+		t.Skip("standalone MongoDB cannot roll back; failed audits are only logged there")
+	}
+
+	const (
+		rejectAuditCol  = "audit_logs_reject_test"
+		rejectConfigCol = "system_config_reject_test"
+		courseCode      = "TXN-AUDIT-101"
+		userEmail       = "txn_audit_user@example.com"
+	)
+	cleanup := func() {
+		db.Collection(rejectAuditCol).Drop(ctx)
+		db.Collection(rejectConfigCol).Drop(ctx)
+		db.Collection("courses").DeleteMany(ctx, bson.M{"code": courseCode})
+		db.Collection("users").DeleteMany(ctx, bson.M{"email": userEmail})
+	}
+	cleanup()
+	defer cleanup()
+
+	never := bson.M{"never_written": bson.M{"$exists": true}}
+	if err := db.CreateCollection(ctx, rejectAuditCol, options.CreateCollection().SetValidator(never)); err != nil {
+		t.Fatalf("Failed to create rejecting audit collection: %v", err)
+	}
+	noEnd := bson.M{"key": bson.M{"$ne": shared.ConfigEnrollmentEnd}}
+	if err := db.CreateCollection(ctx, rejectConfigCol, options.CreateCollection().SetValidator(noEnd)); err != nil {
+		t.Fatalf("Failed to create rejecting config collection: %v", err)
+	}
+
+	svc := NewAdminService(mongoClient, db, cfg)
+	svc.auditLogsCol = db.Collection(rejectAuditCol)
+
+	t.Run("Create Course", func(t *testing.T) {
+		_, err := svc.CreateCourse(ctx, &pb.CreateCourseRequest{
+			Code: courseCode, Title: "Rolled Back", Units: 3, Capacity: 10, Schedule: "MWF 9:00-10:00", Semester: "TxnSem",
+		})
+		if status.Code(err) != codes.Internal {
+			t.Errorf("Expected Internal when the audit write fails, got %v", err)
+		}
+		if n, _ := db.Collection("courses").CountDocuments(ctx, bson.M{"code": courseCode}); n != 0 {
+			t.Errorf("Expected the course insert to roll back, found %d", n)
+		}
+	})
+
+	t.Run("Create User", func(t *testing.T) {
+		_, err := svc.CreateUser(ctx, &pb.CreateUserRequest{Email: userEmail, Name: "Rolled Back", Role: shared.RoleStudent})
+		if status.Code(err) != codes.Internal {
+			t.Errorf("Expected Internal when the audit write fails, got %v", err)
+		}
+		if n, _ := db.Collection("users").CountDocuments(ctx, bson.M{"email": userEmail}); n != 0 {
+			t.Errorf("Expected the user insert to roll back, found %d", n)
+		}
+	})
+
+	t.Run("Set Enrollment Period", func(t *testing.T) {
+		// Auditing works again; only the second key is rejected
+		svc.auditLogsCol = db.Collection("audit_logs")
+		svc.systemConfigCol = db.Collection(rejectConfigCol)
+
+		_, err := svc.SetEnrollmentPeriod(ctx, &pb.SetEnrollmentPeriodRequest{
+			StartDate: "2031-01-01T00:00:00Z", EndDate: "2031-01-15T00:00:00Z",
+		})
+		if status.Code(err) != codes.Internal {
+			t.Errorf("Expected Internal when the end date cannot be written, got %v", err)
+		}
+		if n, _ := svc.systemConfigCol.CountDocuments(ctx, bson.M{"key": shared.ConfigEnrollmentStart}); n != 0 {
+			t.Error("Expected the start date to roll back with the end date")
+		}
+	})
+}
+
+func TestTrendBuckets(t *testing.T) {
+	start := time.Date(2031, 3, 3, 9, 0, 0, 0, time.UTC)
+	rows := []trendRow{
+		{Start: start, Enrolls: 2},
+		{Start: start.Add(3 * time.Hour), Enrolls: 1, Drops: 2},
+	}
+	buckets, err := trendBuckets(rows, time.Hour)
+	if err != nil {
+		t.Fatalf("trendBuckets failed: %v", err)
+	}
+	if len(buckets) != 4 {
+		t.Fatalf("Expected 4 contiguous buckets, got %d", len(buckets))
+	}
+	if b := buckets[1]; b.Enrolls != 0 || b.Drops != 0 || b.Cumulative != 2 {
+		t.Errorf("Expected an empty filled-in bucket carrying the total, got %+v", b)
+	}
+	if b := buckets[3]; b.Net != -1 || b.Cumulative != 1 {
+		t.Errorf("Expected net -1 and cumulative 1 in the last bucket, got %+v", b)
+	}
+
+	if buckets, _ := trendBuckets(nil, time.Hour); buckets == nil || len(buckets) != 0 {
+		t.Errorf("Expected an empty series for no rows, got %v", buckets)
+	}
+
+	far := []trendRow{{Start: start}, {Start: start.Add(365 * 24 * time.Hour)}}
+	if _, err := trendBuckets(far, time.Hour); err == nil {
+		t.Error("Expected a year of hourly buckets to be rejected")
+	}
 }
@@ -0,0 +1,65 @@
+package auth
+
+import (
+	"context"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	pb "stdiscm_p4/backend/internal/pb/auth"
+	"stdiscm_p4/backend/internal/shared"
+)
+
+// adminOnlyMethods lists the RPCs that require an authenticated admin caller
+var adminOnlyMethods = map[string]bool{
+	pb.AuthService_ListUserSessions_FullMethodName:     true,
+	pb.AuthService_TerminateSession_FullMethodName:     true,
+	pb.AuthService_TerminateAllSessions_FullMethodName: true,
+}
+
+// UnaryInterceptor gates admin-only RPCs on the caller's token, passed as
+// "authorization: Bearer <token>" metadata. All other RPCs pass through.
+func (s *AuthService) UnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if !adminOnlyMethods[info.FullMethod] {
+			return handler(ctx, req)
+		}
+
+		token := bearerTokenFromContext(ctx)
+		if token == "" {
+			return nil, status.Error(codes.Unauthenticated, "authorization metadata required")
+		}
+
+		caller, reason := s.authenticate(ctx, token)
+		if caller == nil {
+			return nil, status.Error(codes.Unauthenticated, reason)
+		}
+		if caller.Role != shared.RoleAdmin {
+			return nil, status.Error(codes.PermissionDenied, "admin access required")
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// bearerTokenFromContext extracts the token from incoming "authorization" metadata
+func bearerTokenFromContext(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+
+	values := md.Get(shared.MetadataAuthorization)
+	if len(values) == 0 {
+		return ""
+	}
+
+	parts := strings.SplitN(values[0], " ", 2)
+	if len(parts) != 2 || strings.ToLower(parts[0]) != "bearer" {
+		return ""
+	}
+	return parts[1]
+}
@@ -3,16 +3,24 @@ package auth
 import (
 	"context"
 	"fmt"
+	"log"
+	"net"
+	"net/mail"
+	"sort"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
 	"golang.org/x/crypto/bcrypt"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
 	"google.golang.org/grpc/status"
-	"google.golang.org/protobuf/types/known/timestamppb"
 
 	pb "stdiscm_p4/backend/internal/pb/auth"
 	"stdiscm_p4/backend/internal/shared"
@@ -21,10 +29,19 @@ import (
 // AuthService implements the gRPC AuthService
 type AuthService struct {
 	pb.UnimplementedAuthServiceServer
-	db          *mongo.Database
-	config      *shared.ServiceConfig
-	usersCol    *mongo.Collection
-	sessionsCol *mongo.Collection
+	db           *mongo.Database
+	config       *shared.ServiceConfig
+	usersCol     *mongo.Collection
+	sessionsCol  *mongo.Collection
+	auditLogsCol *mongo.Collection
+
+	// JWT key set, swapped atomically on reload
+	keysMu     sync.RWMutex
+	signingKey shared.JWTKey
+	keys       map[string]string // kid -> secret
+
+	// now is the clock used for session expiry; nil means time.Now
+	now func() time.Time
 }
 
 // CustomClaims for JWT
@@ -36,12 +53,47 @@ type CustomClaims struct {
 
 // NewAuthService creates a new AuthService instance
 func NewAuthService(db *mongo.Database, config *shared.ServiceConfig) *AuthService {
-	return &AuthService{
-		db:          db,
-		config:      config,
-		usersCol:    db.Collection("users"),
-		sessionsCol: db.Collection("sessions"),
+	s := &AuthService{
+		db:           db,
+		config:       config,
+		usersCol:     db.Collection("users"),
+		sessionsCol:  db.Collection("sessions"),
+		auditLogsCol: db.Collection("audit_logs"),
 	}
+
+	keys := config.Security.JWTKeys
+	if len(keys) == 0 && config.Security.JWTSecret != "" {
+		keys = []shared.JWTKey{{ID: "v0", Secret: config.Security.JWTSecret}}
+	}
+	if err := s.SetJWTKeys(keys); err != nil {
+		log.Printf("Warning: %v", err)
+	}
+	return s
+}
+
+// SetJWTKeys replaces the JWT key set. The last key signs new tokens;
+// all keys are accepted when validating tokens by their kid header.
+func (s *AuthService) SetJWTKeys(keys []shared.JWTKey) error {
+	if len(keys) == 0 {
+		return fmt.Errorf("no JWT keys configured")
+	}
+
+	keyMap := make(map[string]string, len(keys))
+	for _, k := range keys {
+		if k.ID == "" || k.Secret == "" {
+			return fmt.Errorf("JWT key id and secret must not be empty")
+		}
+		if _, exists := keyMap[k.ID]; exists {
+			return fmt.Errorf("duplicate JWT key id: %s", k.ID)
+		}
+		keyMap[k.ID] = k.Secret
+	}
+
+	s.keysMu.Lock()
+	defer s.keysMu.Unlock()
+	s.signingKey = keys[len(keys)-1]
+	s.keys = keyMap
+	return nil
 }
 
 // Login authenticates a user and returns a JWT
@@ -57,7 +109,7 @@ func (s *AuthService) Login(ctx context.Context, req *pb.LoginRequest) (*pb.Logi
 	var user shared.User
 	filter := bson.M{
 		"$or": []bson.M{
-			{"email": req.Identifier},
+			{"email": shared.NormalizeEmail(req.Identifier)},
 			{"student_id": req.Identifier},
 			{"faculty_id": req.Identifier},
 		},
@@ -66,22 +118,23 @@ func (s *AuthService) Login(ctx context.Context, req *pb.LoginRequest) (*pb.Logi
 	err := s.usersCol.FindOne(queryCtx, filter).Decode(&user)
 	if err != nil {
 		if err == mongo.ErrNoDocuments {
-			return nil, status.Error(codes.Unauthenticated, "invalid credentials")
+			return nil, shared.NewError(codes.Unauthenticated, shared.ErrCodeInvalidCredentials, "invalid credentials")
 		}
 		return nil, status.Error(codes.Internal, "database error")
 	}
 
 	// 2. Check Password (BCrypt)
 	if err := bcrypt.CompareHashAndPassword([]byte(user.PasswordHash), []byte(req.Password)); err != nil {
-		return nil, status.Error(codes.Unauthenticated, "invalid credentials")
+		return nil, shared.NewError(codes.Unauthenticated, shared.ErrCodeInvalidCredentials, "invalid credentials")
 	}
 
 	if !user.IsActive {
-		return nil, status.Error(codes.PermissionDenied, "account is inactive")
+		return nil, shared.NewError(codes.PermissionDenied, shared.ErrCodeAccountInactive, "account is inactive")
 	}
 
-	// 3. Generate JWT using Shared Config
-	tokenString, expiresAt, err := s.generateToken(user.ID, user.Role)
+	// 3. Generate JWT using Shared Config; the session expires with it at the latest
+	now := s.currentTime()
+	tokenString, expiresAt, err := s.generateToken(user.ID, user.Role, now)
 	if err != nil {
 		return nil, status.Error(codes.Internal, "failed to generate token")
 	}
@@ -91,22 +144,34 @@ func (s *AuthService) Login(ctx context.Context, req *pb.LoginRequest) (*pb.Logi
 		ID:        shared.GenerateID("sess"),
 		UserID:    user.ID,
 		Token:     tokenString,
-		ExpiresAt: expiresAt,
-		CreatedAt: time.Now(),
+		ExpiresAt: s.sessionExpiry(now, expiresAt),
+		CreatedAt: now,
+		IPAddress: s.clientIPFromContext(ctx),
 	}
 
 	if _, err := s.sessionsCol.InsertOne(queryCtx, session); err != nil {
 		return nil, status.Error(codes.Internal, "failed to create session")
 	}
 
-	// 5. Convert to Proto User
+	// 5. Record the login; a failure here should not block the user
+	_, err = s.usersCol.UpdateOne(queryCtx, bson.M{"_id": user.ID}, bson.M{"$set": bson.M{"last_login_at": now}})
+	if err != nil {
+		log.Printf("Warning: failed to record last login for %s: %v", user.ID, err)
+	} else {
+		user.LastLoginAt = now
+	}
+
+	// 6. Convert to Proto User
 	protoUser := s.userToProto(&user)
 
 	return &pb.LoginResponse{
-		Success: true,
-		Token:   tokenString,
-		User:    protoUser,
-		Message: "login successful",
+		Success:            true,
+		Token:              tokenString,
+		User:               protoUser,
+		Message:            "login successful",
+		ExpiresAt:          shared.ToProtoTime(expiresAt),
+		ExpiresInSeconds:   int64(expiresAt.Sub(now).Seconds()),
+		MustChangePassword: user.MustChangePassword,
 	}, nil
 }
 
@@ -140,35 +205,15 @@ func (s *AuthService) ValidateToken(ctx context.Context, req *pb.ValidateTokenRe
 		return &pb.ValidateTokenResponse{Valid: false, Message: "token missing"}, nil
 	}
 
-	// 1. Parse and Verify Signature locally
-	token, claims, err := s.parseToken(req.Token)
-	if err != nil || !token.Valid {
-		return &pb.ValidateTokenResponse{Valid: false, Message: "invalid token signature"}, nil
-	}
-
-	queryCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
-	defer cancel()
-
-	// 2. Check Database for Active Session (Revocation Check)
-	count, err := s.sessionsCol.CountDocuments(queryCtx, bson.M{"token": req.Token})
-	if err != nil || count == 0 {
-		return &pb.ValidateTokenResponse{Valid: false, Message: "session expired or revoked"}, nil
-	}
-
-	// 3. Fetch User Details
-	var user shared.User
-	err = s.usersCol.FindOne(queryCtx, bson.M{"_id": claims.UserID}).Decode(&user)
-	if err != nil {
-		return &pb.ValidateTokenResponse{Valid: false, Message: "user not found"}, nil
-	}
-
-	if !user.IsActive {
-		return &pb.ValidateTokenResponse{Valid: false, Message: "account inactive"}, nil
+	user, reason := s.authenticate(ctx, req.Token)
+	if user == nil {
+		return &pb.ValidateTokenResponse{Valid: false, Message: reason}, nil
 	}
 
 	return &pb.ValidateTokenResponse{
-		Valid: true,
-		User:  s.userToProto(&user),
+		Valid:              true,
+		User:               s.userToProto(user),
+		MustChangePassword: user.MustChangePassword,
 	}, nil
 }
 
@@ -199,12 +244,13 @@ func (s *AuthService) ChangePassword(ctx context.Context, req *pb.ChangePassword
 		return nil, status.Error(codes.Internal, "failed to process password")
 	}
 
-	// 4. Update DB
+	// 4. Update DB; choosing a password clears any admin-issued one
 	_, err = s.usersCol.UpdateOne(queryCtx, bson.M{"_id": req.UserId}, bson.M{
 		"$set": bson.M{
 			"password_hash": string(newHash),
 			"updated_at":    primitive.NewDateTimeFromTime(time.Now()),
 		},
+		"$unset": bson.M{"must_change_password": ""},
 	})
 	if err != nil {
 		return nil, status.Error(codes.Internal, "failed to update password")
@@ -216,13 +262,349 @@ func (s *AuthService) ChangePassword(ctx context.Context, req *pb.ChangePassword
 	return &pb.ChangePasswordResponse{Success: true, Message: "password changed successfully"}, nil
 }
 
+// GetProfile returns the user's own profile
+func (s *AuthService) GetProfile(ctx context.Context, req *pb.GetProfileRequest) (*pb.GetProfileResponse, error) {
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	queryCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	var user shared.User
+	if err := s.usersCol.FindOne(queryCtx, bson.M{"_id": req.UserId}).Decode(&user); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, status.Error(codes.NotFound, "user not found")
+		}
+		return nil, status.Error(codes.Internal, "database error")
+	}
+
+	return &pb.GetProfileResponse{
+		Success: true,
+		User:    s.userToProto(&user),
+		Message: "profile retrieved successfully",
+	}, nil
+}
+
+// UpdateProfile lets a user edit their own contact details (display name,
+// contact email, phone). Name, role, email, IDs, major, year level,
+// department and account status are admin-managed and never changed here.
+func (s *AuthService) UpdateProfile(ctx context.Context, req *pb.UpdateProfileRequest) (*pb.UpdateProfileResponse, error) {
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	queryCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	// 1. Fetch User
+	var user shared.User
+	if err := s.usersCol.FindOne(queryCtx, bson.M{"_id": req.UserId}).Decode(&user); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, status.Error(codes.NotFound, "user not found")
+		}
+		return nil, status.Error(codes.Internal, "database error")
+	}
+
+	// 2. Validate and Build Update from Whitelisted Fields
+	set := bson.M{}
+	if displayName := strings.TrimSpace(req.DisplayName); displayName != "" {
+		if err := shared.ValidateText("display_name", displayName, shared.MaxNameLength, false); err != nil {
+			return nil, err
+		}
+		set["display_name"] = displayName
+	}
+	if contactEmail := strings.TrimSpace(req.ContactEmail); contactEmail != "" {
+		if !isValidEmail(contactEmail) {
+			return nil, status.Error(codes.InvalidArgument, "contact_email is not a valid email address")
+		}
+		if strings.EqualFold(contactEmail, user.Email) {
+			return nil, status.Error(codes.InvalidArgument, "contact_email must differ from the login email")
+		}
+		set["contact_email"] = contactEmail
+	}
+	if phone := strings.TrimSpace(req.Phone); phone != "" {
+		if !isValidPhone(phone) {
+			return nil, status.Error(codes.InvalidArgument, "phone must contain 7 to 15 digits and only +, spaces, dashes or parentheses")
+		}
+		set["phone"] = phone
+	}
+
+	if len(set) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "no editable fields provided")
+	}
+	set["updated_at"] = time.Now()
+
+	// 3. Apply and Return Updated Profile
+	after := options.FindOneAndUpdate().SetReturnDocument(options.After)
+	if err := s.usersCol.FindOneAndUpdate(queryCtx, bson.M{"_id": req.UserId}, bson.M{"$set": set}, after).Decode(&user); err != nil {
+		return nil, status.Error(codes.Internal, "failed to update profile")
+	}
+
+	// 4. Audit (field names only; values are personal data)
+	fields := make([]string, 0, len(set)-1)
+	for field := range set {
+		if field != "updated_at" {
+			fields = append(fields, field)
+		}
+	}
+	sort.Strings(fields)
+	shared.LogAuditEvent(queryCtx, s.auditLogsCol, req.UserId, shared.ActionUserUpdate, req.UserId, map[string]interface{}{
+		"fields": fields,
+		"source": "self_service",
+	})
+
+	return &pb.UpdateProfileResponse{
+		Success: true,
+		User:    s.userToProto(&user),
+		Message: "profile updated successfully",
+	}, nil
+}
+
+// isValidEmail accepts a bare address such as "name@example.com"
+func isValidEmail(email string) bool {
+	if len(email) > 254 {
+		return false
+	}
+	addr, err := mail.ParseAddress(email)
+	return err == nil && addr.Address == email && strings.Contains(email[strings.LastIndex(email, "@")+1:], ".")
+}
+
+// isValidPhone accepts 7-15 digits with an optional leading + and common separators
+func isValidPhone(phone string) bool {
+	digits := 0
+	for i, char := range phone {
+		switch {
+		case char >= '0' && char <= '9':
+			digits++
+		case char == '+' && i == 0:
+		case char == ' ' || char == '-' || char == '(' || char == ')':
+		default:
+			return false
+		}
+	}
+	return digits >= 7 && digits <= 15
+}
+
+// ============================================================================
+// Session Management (Admin)
+// ============================================================================
+
+// ListUserSessions returns all stored sessions of a user, newest first
+func (s *AuthService) ListUserSessions(ctx context.Context, req *pb.ListUserSessionsRequest) (*pb.ListUserSessionsResponse, error) {
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	queryCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	cursor, err := s.sessionsCol.Find(queryCtx, bson.M{"user_id": req.UserId})
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to fetch sessions")
+	}
+	defer cursor.Close(queryCtx)
+
+	var sessions []shared.Session
+	if err := cursor.All(queryCtx, &sessions); err != nil {
+		return nil, status.Error(codes.Internal, "failed to decode sessions")
+	}
+
+	sort.Slice(sessions, func(i, j int) bool {
+		return sessions[i].CreatedAt.After(sessions[j].CreatedAt)
+	})
+
+	pbSessions := make([]*pb.Session, 0, len(sessions))
+	for i := range sessions {
+		pbSessions = append(pbSessions, sessionToProto(&sessions[i]))
+	}
+
+	return &pb.ListUserSessionsResponse{
+		Success:  true,
+		Sessions: pbSessions,
+		Message:  fmt.Sprintf("found %d sessions", len(pbSessions)),
+	}, nil
+}
+
+// TerminateSession revokes a single session
+func (s *AuthService) TerminateSession(ctx context.Context, req *pb.TerminateSessionRequest) (*pb.TerminateSessionResponse, error) {
+	if req.SessionId == "" {
+		return nil, status.Error(codes.InvalidArgument, "session_id is required")
+	}
+
+	queryCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	filter := bson.M{"_id": req.SessionId}
+	if req.UserId != "" {
+		filter["user_id"] = req.UserId
+	}
+
+	result, err := s.sessionsCol.DeleteOne(queryCtx, filter)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to terminate session")
+	}
+	if result.DeletedCount == 0 {
+		return nil, status.Error(codes.NotFound, "session not found")
+	}
+
+	return &pb.TerminateSessionResponse{Success: true, Message: "session terminated"}, nil
+}
+
+// TerminateAllSessions revokes every session of a user (force logout everywhere)
+func (s *AuthService) TerminateAllSessions(ctx context.Context, req *pb.TerminateAllSessionsRequest) (*pb.TerminateAllSessionsResponse, error) {
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	queryCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	result, err := s.sessionsCol.DeleteMany(queryCtx, bson.M{"user_id": req.UserId})
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to terminate sessions")
+	}
+
+	return &pb.TerminateAllSessionsResponse{
+		Success:         true,
+		TerminatedCount: int32(result.DeletedCount),
+		Message:         fmt.Sprintf("terminated %d sessions", result.DeletedCount),
+	}, nil
+}
+
 // ============================================================================
 // Internal Helpers
 // ============================================================================
 
-// generateToken creates a signed JWT using Shared Config
-func (s *AuthService) generateToken(userID, role string) (string, time.Time, error) {
-	expirationTime := time.Now().Add(time.Duration(s.config.Security.JWTExpirationHours) * time.Hour)
+// authenticate checks the token signature, the session (revocation) and the
+// account status. On failure it returns a nil user and the reason.
+func (s *AuthService) authenticate(ctx context.Context, tokenString string) (*shared.User, string) {
+	// 1. Parse and Verify Signature locally
+	token, claims, err := s.parseToken(tokenString)
+	if err != nil || !token.Valid {
+		return nil, "invalid token signature"
+	}
+
+	queryCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	// 2. Check Database for Active Session (Revocation and Idle Check)
+	now := s.currentTime()
+	var session shared.Session
+	err = s.sessionsCol.FindOne(queryCtx, bson.M{"token": tokenString, "expires_at": bson.M{"$gt": now}}).Decode(&session)
+	if err != nil {
+		return nil, "session expired or revoked"
+	}
+
+	// 3. Fetch User Details
+	var user shared.User
+	err = s.usersCol.FindOne(queryCtx, bson.M{"_id": claims.UserID}).Decode(&user)
+	if err != nil {
+		return nil, "user not found"
+	}
+
+	if !user.IsActive {
+		return nil, "account inactive"
+	}
+
+	// 4. Activity slides the idle expiry forward, never past the token's own expiry
+	tokenExpiry := session.ExpiresAt
+	if claims.ExpiresAt != nil {
+		tokenExpiry = claims.ExpiresAt.Time
+	}
+	if expiry := s.sessionExpiry(now, tokenExpiry); expiry.After(session.ExpiresAt) {
+		_, err := s.sessionsCol.UpdateOne(queryCtx,
+			bson.M{"_id": session.ID},
+			bson.M{"$set": bson.M{"expires_at": expiry}},
+		)
+		if err != nil {
+			log.Printf("Warning: failed to extend session %s: %v", session.ID, err)
+		}
+	}
+
+	return &user, ""
+}
+
+// sessionExpiry is when a session used at now goes idle: now plus the configured
+// session timeout, capped at the token's expiry. A zero timeout disables idle expiry.
+func (s *AuthService) sessionExpiry(now, tokenExpiry time.Time) time.Time {
+	timeout := s.config.Security.SessionTimeout
+	if timeout <= 0 || now.Add(timeout).After(tokenExpiry) {
+		return tokenExpiry
+	}
+	return now.Add(timeout)
+}
+
+// currentTime returns the service clock
+func (s *AuthService) currentTime() time.Time {
+	if s.now != nil {
+		return s.now()
+	}
+	return time.Now()
+}
+
+// clientIPFromContext returns the client IP forwarded by the gateway, falling
+// back to the gRPC peer address. The forwarded value is only believed from
+// a trusted proxy; any other caller could put whatever it likes there.
+func (s *AuthService) clientIPFromContext(ctx context.Context) string {
+	peerIP := ""
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		peerIP = p.Addr.String()
+		if host, _, err := net.SplitHostPort(peerIP); err == nil {
+			peerIP = host
+		}
+	}
+
+	if s.isTrustedProxy(peerIP) {
+		if md, ok := metadata.FromIncomingContext(ctx); ok {
+			if values := md.Get(shared.MetadataClientIP); len(values) > 0 && values[0] != "" {
+				return values[0]
+			}
+		}
+	}
+	return peerIP
+}
+
+// isTrustedProxy reports whether addr matches one of the configured trusted
+// proxies, each an IP, a CIDR or (for non-IP transports) a literal address
+func (s *AuthService) isTrustedProxy(addr string) bool {
+	if addr == "" || s.config == nil {
+		return false
+	}
+	ip := net.ParseIP(addr)
+	for _, entry := range s.config.Security.TrustedProxies {
+		if entry == addr {
+			return true
+		}
+		if ip == nil {
+			continue
+		}
+		if _, cidr, err := net.ParseCIDR(entry); err == nil {
+			if cidr.Contains(ip) {
+				return true
+			}
+		} else if trusted := net.ParseIP(entry); trusted != nil && trusted.Equal(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// sessionToProto maps a stored session to the Protobuf message (the token is never exposed)
+func sessionToProto(sess *shared.Session) *pb.Session {
+	return &pb.Session{
+		Id:        sess.ID,
+		UserId:    sess.UserID,
+		CreatedAt: shared.ToProtoTime(sess.CreatedAt),
+		ExpiresAt: shared.ToProtoTime(sess.ExpiresAt),
+		IpAddress: sess.IPAddress,
+		Active:    !sess.IsExpired(),
+	}
+}
+
+// generateToken creates a JWT issued at now that expires after the configured token lifetime
+func (s *AuthService) generateToken(userID, role string, now time.Time) (string, time.Time, error) {
+	expirationTime := now.Add(s.config.Security.TokenLifetime())
 
 	claims := CustomClaims{
 		UserID: userID,
@@ -231,44 +613,75 @@ func (s *AuthService) generateToken(userID, role string) (string, time.Time, err
 			// Add unique ID (jti) to claims to ensure tokens are unique even if generated at the exact same timestamp
 			ID:        shared.GenerateID("jti"),
 			ExpiresAt: jwt.NewNumericDate(expirationTime),
-			IssuedAt:  jwt.NewNumericDate(time.Now()),
+			IssuedAt:  jwt.NewNumericDate(now),
 			Issuer:    "college-enrollment-system",
 		},
 	}
 
+	s.keysMu.RLock()
+	signingKey := s.signingKey
+	s.keysMu.RUnlock()
+
+	// kid lets validation pick the matching key after a rotation
 	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	tokenString, err := token.SignedString([]byte(s.config.Security.JWTSecret))
+	token.Header["kid"] = signingKey.ID
+	tokenString, err := token.SignedString([]byte(signingKey.Secret))
 
 	return tokenString, expirationTime, err
 }
 
-// parseToken validates the JWT signature and extracts claims
+// parseToken validates the JWT signature and expiry (against the service clock) and extracts claims
 func (s *AuthService) parseToken(tokenString string) (*jwt.Token, *CustomClaims, error) {
 	claims := &CustomClaims{}
 	token, err := jwt.ParseWithClaims(tokenString, claims, func(token *jwt.Token) (interface{}, error) {
 		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
 			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
 		}
-		// Use secret from Shared Config
-		return []byte(s.config.Security.JWTSecret), nil
-	})
+
+		s.keysMu.RLock()
+		defer s.keysMu.RUnlock()
+
+		if kid, ok := token.Header["kid"].(string); ok && kid != "" {
+			secret, exists := s.keys[kid]
+			if !exists {
+				return nil, fmt.Errorf("unknown key id: %s", kid)
+			}
+			return []byte(secret), nil
+		}
+
+		// Tokens issued before key ids were introduced carry no kid; accept any configured key
+		keySet := jwt.VerificationKeySet{}
+		for _, secret := range s.keys {
+			keySet.Keys = append(keySet.Keys, []byte(secret))
+		}
+		return keySet, nil
+	}, jwt.WithTimeFunc(s.currentTime))
 
 	return token, claims, err
 }
 
 // userToProto maps the shared MongoDB model to the Protobuf message
 func (s *AuthService) userToProto(u *shared.User) *pb.User {
-	return &pb.User{
+	user := &pb.User{
 		Id:         u.ID,
 		Email:      u.Email,
 		Role:       u.Role,
 		Name:       u.Name,
-		CreatedAt:  timestamppb.New(u.CreatedAt),
+		CreatedAt:  shared.ToProtoTime(u.CreatedAt),
 		StudentId:  u.StudentID,
 		FacultyId:  u.FacultyID,
 		Department: u.Department,
 		Major:      u.Major,
 		YearLevel:  u.YearLevel,
 		IsActive:   u.IsActive,
+
+		DisplayName:  u.DisplayName,
+		ContactEmail: u.ContactEmail,
+		Phone:        u.Phone,
+
+		MustChangePassword: u.MustChangePassword,
 	}
+	user.UpdatedAt = shared.ToProtoTime(u.UpdatedAt)
+	user.LastLoginAt = shared.ToProtoTime(u.LastLoginAt)
+	return user
 }
@@ -5,11 +5,16 @@ import (
 	"log"
 	"net"
 	"testing"
+	"time"
 
 	"github.com/joho/godotenv"
 	"golang.org/x/crypto/bcrypt"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
 	"google.golang.org/grpc/test/bufconn"
 
 	pb "stdiscm_p4/backend/internal/pb/auth"
@@ -27,16 +32,18 @@ func initServer() *grpc.Server {
 		log.Println("No .env file found, using defaults")
 	}
 	cfg, _ := shared.LoadServiceConfig("auth-service")
+	// bufconn peers have the address "bufconn"; trust it like the gateway
+	cfg.Security.TrustedProxies = append(cfg.Security.TrustedProxies, "bufconn")
 	_, db, err := shared.ConnectMongoDB(&cfg.MongoDB)
 	if err != nil {
 		log.Fatalf("Failed to connect to DB: %v", err)
 	}
 
 	lis = bufconn.Listen(bufSize)
-	s := grpc.NewServer()
 
 	// 2. Initialize Real Service
 	authService := NewAuthService(db, cfg)
+	s := grpc.NewServer(grpc.UnaryInterceptor(authService.UnaryInterceptor()))
 	pb.RegisterAuthServiceServer(s, authService)
 
 	go func() {
@@ -106,6 +113,19 @@ func TestAuthService_Integration(t *testing.T) {
 		if !resp.Success || resp.Token == "" {
 			t.Errorf("Expected success and token, got: %v", resp)
 		}
+		if resp.ExpiresAt == nil || resp.ExpiresInSeconds <= 0 {
+			t.Errorf("Expected token expiry, got %v / %d", resp.ExpiresAt, resp.ExpiresInSeconds)
+		}
+	})
+
+	t.Run("Login Email Ignores Case", func(t *testing.T) {
+		resp, err := client.Login(ctx, &pb.LoginRequest{
+			Identifier: " Test_Auth@Example.com",
+			Password:   testPassword,
+		})
+		if err != nil || !resp.Success {
+			t.Errorf("Expected login with a mixed-case email to succeed, got %v / %v", resp, err)
+		}
 	})
 
 	// --- 2. Test Login Failure ---
@@ -186,4 +206,340 @@ func TestAuthService_Integration(t *testing.T) {
 			t.Error("Token should be invalid after logout")
 		}
 	})
+
+	// --- 6. Test Get Profile ---
+	t.Run("Get Profile", func(t *testing.T) {
+		resp, err := client.GetProfile(ctx, &pb.GetProfileRequest{UserId: testUserID})
+		if err != nil {
+			t.Fatalf("GetProfile failed: %v", err)
+		}
+		if resp.User.Email != "test_auth@example.com" {
+			t.Errorf("Unexpected profile: %v", resp.User)
+		}
+	})
+
+	// --- 7. Test Update Profile ---
+	t.Run("Update Profile", func(t *testing.T) {
+		defer db.Collection("audit_logs").DeleteMany(ctx, map[string]interface{}{"user_id": testUserID})
+
+		resp, err := client.UpdateProfile(ctx, &pb.UpdateProfileRequest{
+			UserId:       testUserID,
+			DisplayName:  "Testy",
+			ContactEmail: "testy.contact@example.com",
+			Phone:        "+63 917 555 0101",
+		})
+		if err != nil {
+			t.Fatalf("UpdateProfile failed: %v", err)
+		}
+		if resp.User.DisplayName != "Testy" || resp.User.ContactEmail != "testy.contact@example.com" || resp.User.Phone != "+63 917 555 0101" {
+			t.Errorf("Profile not updated: %v", resp.User)
+		}
+		if resp.User.Role != "student" || !resp.User.IsActive || resp.User.Email != "test_auth@example.com" {
+			t.Error("Role, status and login email must not change")
+		}
+		if resp.User.UpdatedAt == nil {
+			t.Error("Expected updated_at to be set")
+		}
+
+		invalid := []*pb.UpdateProfileRequest{
+			{UserId: testUserID, ContactEmail: "not-an-email"},
+			{UserId: testUserID, ContactEmail: "TEST_AUTH@example.com"}, // same as login email
+			{UserId: testUserID, Phone: "call me maybe"},
+			{UserId: testUserID, Phone: "123"},
+			{UserId: testUserID}, // nothing to change
+		}
+		for _, req := range invalid {
+			if _, err := client.UpdateProfile(ctx, req); status.Code(err) != codes.InvalidArgument {
+				t.Errorf("Expected InvalidArgument for %v, got %v", req, err)
+			}
+		}
+
+		// The change is audited
+		count, _ := db.Collection("audit_logs").CountDocuments(ctx, map[string]interface{}{
+			"user_id": testUserID, "action": shared.ActionUserUpdate,
+		})
+		if count == 0 {
+			t.Error("Expected an audit entry for the profile update")
+		}
+	})
+
+	// --- 8. Test Admin Session Management ---
+	t.Run("Admin Session Management", func(t *testing.T) {
+		adminID := "test_auth_admin_001"
+		usersCol.DeleteOne(ctx, map[string]interface{}{"_id": adminID})
+		defer usersCol.DeleteOne(ctx, map[string]interface{}{"_id": adminID})
+		usersCol.InsertOne(ctx, shared.User{
+			ID: adminID, Email: "test_auth_admin@example.com", PasswordHash: string(hashedPwd),
+			Role: shared.RoleAdmin, Name: "Session Admin", IsActive: true,
+		})
+
+		adminLogin, err := client.Login(ctx, &pb.LoginRequest{Identifier: "test_auth_admin@example.com", Password: testPassword})
+		if err != nil {
+			t.Fatalf("Admin login failed: %v", err)
+		}
+
+		// Student session created with a forwarded client IP
+		ipCtx := metadata.AppendToOutgoingContext(ctx, shared.MetadataClientIP, "203.0.113.7")
+		studentLogin, err := client.Login(ipCtx, &pb.LoginRequest{Identifier: "test_auth@example.com", Password: "new_secret_456"})
+		if err != nil {
+			t.Fatalf("Student login failed: %v", err)
+		}
+
+		listReq := &pb.ListUserSessionsRequest{UserId: testUserID}
+
+		// Missing caller token
+		if _, err := client.ListUserSessions(ctx, listReq); status.Code(err) != codes.Unauthenticated {
+			t.Errorf("Expected Unauthenticated without token, got %v", err)
+		}
+
+		// Non-admin caller
+		studentCtx := metadata.AppendToOutgoingContext(ctx, shared.MetadataAuthorization, "Bearer "+studentLogin.Token)
+		if _, err := client.ListUserSessions(studentCtx, listReq); status.Code(err) != codes.PermissionDenied {
+			t.Errorf("Expected PermissionDenied for student caller, got %v", err)
+		}
+
+		adminCtx := metadata.AppendToOutgoingContext(ctx, shared.MetadataAuthorization, "Bearer "+adminLogin.Token)
+		listResp, err := client.ListUserSessions(adminCtx, listReq)
+		if err != nil {
+			t.Fatalf("ListUserSessions failed: %v", err)
+		}
+		found := false
+		for _, sess := range listResp.Sessions {
+			if sess.IpAddress == "203.0.113.7" && sess.Active {
+				found = true
+			}
+		}
+		if !found {
+			t.Errorf("Expected an active session with the forwarded IP, got %v", listResp.Sessions)
+		}
+
+		termResp, err := client.TerminateAllSessions(adminCtx, &pb.TerminateAllSessionsRequest{UserId: testUserID})
+		if err != nil {
+			t.Fatalf("TerminateAllSessions failed: %v", err)
+		}
+		if termResp.TerminatedCount == 0 {
+			t.Error("Expected at least one terminated session")
+		}
+
+		valResp, _ := client.ValidateToken(ctx, &pb.ValidateTokenRequest{Token: studentLogin.Token})
+		if valResp.Valid {
+			t.Error("Token should be invalid after sessions are terminated")
+		}
+	})
+
+	// --- 9. Test First Login Password Change ---
+	t.Run("First Login Password Change", func(t *testing.T) {
+		issuedID := "test_auth_issued_001"
+		usersCol.DeleteOne(ctx, map[string]interface{}{"_id": issuedID})
+		defer usersCol.DeleteOne(ctx, map[string]interface{}{"_id": issuedID})
+		usersCol.InsertOne(ctx, shared.User{
+			ID: issuedID, Email: "test_auth_issued@example.com", PasswordHash: string(hashedPwd),
+			Role: shared.RoleStudent, Name: "Issued Password User", IsActive: true, MustChangePassword: true,
+		})
+
+		loginResp, err := client.Login(ctx, &pb.LoginRequest{Identifier: "test_auth_issued@example.com", Password: testPassword})
+		if err != nil {
+			t.Fatalf("Login failed: %v", err)
+		}
+		if !loginResp.MustChangePassword || !loginResp.User.MustChangePassword {
+			t.Error("Expected login to flag the admin-issued password")
+		}
+		if loginResp.User.LastLoginAt == nil {
+			t.Error("Expected last_login_at to be set on login")
+		}
+
+		valResp, err := client.ValidateToken(ctx, &pb.ValidateTokenRequest{Token: loginResp.Token})
+		if err != nil || !valResp.Valid || !valResp.MustChangePassword {
+			t.Errorf("Expected a valid token flagged for password change, got %v / %v", valResp, err)
+		}
+
+		if _, err := client.ChangePassword(ctx, &pb.ChangePasswordRequest{
+			UserId: issuedID, OldPassword: testPassword, NewPassword: "chosen_secret_789",
+		}); err != nil {
+			t.Fatalf("ChangePassword failed: %v", err)
+		}
+
+		loginResp, err = client.Login(ctx, &pb.LoginRequest{Identifier: "test_auth_issued@example.com", Password: "chosen_secret_789"})
+		if err != nil {
+			t.Fatalf("Login after change failed: %v", err)
+		}
+		if loginResp.MustChangePassword {
+			t.Error("Expected the flag to clear after changing the password")
+		}
+	})
+}
+
+// TestAuthService_KeyRotation exercises JWT signing and validation across a key rotation
+func TestAuthService_KeyRotation(t *testing.T) {
+	svc := &AuthService{config: &shared.ServiceConfig{
+		Security: shared.SecurityConfig{JWTExpirationHours: 1},
+	}}
+
+	if err := svc.SetJWTKeys([]shared.JWTKey{{ID: "v1", Secret: "old-secret"}}); err != nil {
+		t.Fatalf("SetJWTKeys failed: %v", err)
+	}
+	oldToken, _, err := svc.generateToken("user-1", "student", time.Now())
+	if err != nil {
+		t.Fatalf("generateToken failed: %v", err)
+	}
+
+	// Rotate: v2 signs, v1 still verifies
+	if err := svc.SetJWTKeys([]shared.JWTKey{{ID: "v1", Secret: "old-secret"}, {ID: "v2", Secret: "new-secret"}}); err != nil {
+		t.Fatalf("SetJWTKeys failed: %v", err)
+	}
+	newToken, _, err := svc.generateToken("user-1", "student", time.Now())
+	if err != nil {
+		t.Fatalf("generateToken failed: %v", err)
+	}
+
+	token, _, err := svc.parseToken(newToken)
+	if err != nil || !token.Valid {
+		t.Fatalf("New token should be valid: %v", err)
+	}
+	if token.Header["kid"] != "v2" {
+		t.Errorf("Expected kid v2, got %v", token.Header["kid"])
+	}
+	if token, _, err := svc.parseToken(oldToken); err != nil || !token.Valid {
+		t.Errorf("Old token should still be valid during rotation: %v", err)
+	}
+
+	// Retire v1: tokens signed with it are rejected
+	if err := svc.SetJWTKeys([]shared.JWTKey{{ID: "v2", Secret: "new-secret"}}); err != nil {
+		t.Fatalf("SetJWTKeys failed: %v", err)
+	}
+	if _, _, err := svc.parseToken(oldToken); err == nil {
+		t.Error("Token signed with a retired key should be rejected")
+	}
+
+	if err := svc.SetJWTKeys(nil); err == nil {
+		t.Error("Expected error for empty key set")
+	}
+}
+
+// TestAuthService_ClientIP checks that the forwarded client IP is only
+// believed from a trusted proxy
+func TestAuthService_ClientIP(t *testing.T) {
+	svc := &AuthService{config: &shared.ServiceConfig{
+		Security: shared.SecurityConfig{TrustedProxies: []string{"10.0.0.0/24", "::1"}},
+	}}
+	forwarded := metadata.Pairs(shared.MetadataClientIP, "203.0.113.7")
+
+	tests := []struct {
+		name string
+		peer string
+		want string
+	}{
+		{"Trusted CIDR", "10.0.0.5:4000", "203.0.113.7"},
+		{"Trusted IP", "[::1]:4000", "203.0.113.7"},
+		{"Untrusted Peer", "198.51.100.9:4000", "198.51.100.9"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			addr, err := net.ResolveTCPAddr("tcp", tt.peer)
+			if err != nil {
+				t.Fatalf("ResolveTCPAddr failed: %v", err)
+			}
+			ctx := peer.NewContext(metadata.NewIncomingContext(context.Background(), forwarded), &peer.Peer{Addr: addr})
+			if got := svc.clientIPFromContext(ctx); got != tt.want {
+				t.Errorf("Expected %s, got %s", tt.want, got)
+			}
+		})
+	}
+}
+
+// TestAuthService_TokenExpiry checks that tokens expire after the configured
+// lifetime, measured on the service clock
+func TestAuthService_TokenExpiry(t *testing.T) {
+	clock := time.Date(2031, 1, 10, 8, 0, 0, 0, time.UTC)
+	svc := &AuthService{
+		config: &shared.ServiceConfig{Security: shared.SecurityConfig{JWTExpirationHours: 1}},
+		now:    func() time.Time { return clock },
+	}
+	if err := svc.SetJWTKeys([]shared.JWTKey{{ID: "v1", Secret: "expiry-secret"}}); err != nil {
+		t.Fatalf("SetJWTKeys failed: %v", err)
+	}
+
+	token, expiresAt, err := svc.generateToken("user-1", "student", clock)
+	if err != nil {
+		t.Fatalf("generateToken failed: %v", err)
+	}
+	if !expiresAt.Equal(clock.Add(time.Hour)) {
+		t.Errorf("Expected expiry one hour after issue, got %v", expiresAt)
+	}
+	// Without an idle timeout the session document expires with the token
+	if sessionExpiry := svc.sessionExpiry(clock, expiresAt); !sessionExpiry.Equal(expiresAt) {
+		t.Errorf("Expected the session to expire with the token, got %v", sessionExpiry)
+	}
+
+	clock = clock.Add(59 * time.Minute)
+	if parsed, _, err := svc.parseToken(token); err != nil || !parsed.Valid {
+		t.Fatalf("Expected the token to be valid within the hour, got %v", err)
+	}
+
+	// Past the hour the signature check fails before any session lookup
+	clock = clock.Add(2 * time.Minute)
+	resp, err := svc.ValidateToken(context.Background(), &pb.ValidateTokenRequest{Token: token})
+	if err != nil || resp.Valid {
+		t.Errorf("Expected ValidateToken to reject the token after the hour, got %v (err %v)", resp, err)
+	}
+}
+
+// TestAuthService_SessionIdleTimeout checks sliding session expiry against a fake clock
+func TestAuthService_SessionIdleTimeout(t *testing.T) {
+	if err := godotenv.Load("../../cmd/auth/.env"); err != nil {
+		log.Println("No .env file found, using defaults")
+	}
+	cfg, _ := shared.LoadServiceConfig("auth-service")
+	_, db, err := shared.ConnectMongoDB(&cfg.MongoDB)
+	if err != nil {
+		t.Fatalf("Failed to connect to DB: %v", err)
+	}
+	cfg.Security.SessionTimeout = 30 * time.Minute
+
+	ctx := context.Background()
+	userID := "test_auth_idle_001"
+	hashedPwd, _ := bcrypt.GenerateFromPassword([]byte("idle-secret"), 10)
+	usersCol := db.Collection("users")
+	usersCol.DeleteOne(ctx, map[string]interface{}{"_id": userID})
+	usersCol.InsertOne(ctx, shared.User{
+		ID: userID, Email: "test_auth_idle@example.com", PasswordHash: string(hashedPwd),
+		Role: shared.RoleStudent, Name: "Idle User", IsActive: true,
+	})
+	defer func() {
+		usersCol.DeleteOne(ctx, map[string]interface{}{"_id": userID})
+		db.Collection("sessions").DeleteMany(ctx, map[string]interface{}{"user_id": userID})
+	}()
+
+	clock := time.Now()
+	svc := NewAuthService(db, cfg)
+	svc.now = func() time.Time { return clock }
+
+	loginResp, err := svc.Login(ctx, &pb.LoginRequest{Identifier: "test_auth_idle@example.com", Password: "idle-secret"})
+	if err != nil {
+		t.Fatalf("Login failed: %v", err)
+	}
+
+	// Activity within the timeout keeps the session alive and extends it
+	clock = clock.Add(20 * time.Minute)
+	if resp, _ := svc.ValidateToken(ctx, &pb.ValidateTokenRequest{Token: loginResp.Token}); !resp.Valid {
+		t.Fatalf("Expected session to be valid after 20 idle minutes, got %q", resp.Message)
+	}
+	clock = clock.Add(20 * time.Minute)
+	if resp, _ := svc.ValidateToken(ctx, &pb.ValidateTokenRequest{Token: loginResp.Token}); !resp.Valid {
+		t.Fatalf("Expected the previous validation to extend the session, got %q", resp.Message)
+	}
+
+	// Idle past the timeout: expired even though the JWT is still good
+	clock = clock.Add(31 * time.Minute)
+	resp, _ := svc.ValidateToken(ctx, &pb.ValidateTokenRequest{Token: loginResp.Token})
+	if resp.Valid {
+		t.Error("Expected session to expire after 31 idle minutes")
+	}
+
+	// The extension never passes the token's own expiry
+	var sess shared.Session
+	db.Collection("sessions").FindOne(ctx, map[string]interface{}{"token": loginResp.Token}).Decode(&sess)
+	if sess.ExpiresAt.After(loginResp.ExpiresAt.AsTime()) {
+		t.Errorf("Session expiry %v is past the token expiry %v", sess.ExpiresAt, loginResp.ExpiresAt.AsTime())
+	}
 }
@@ -0,0 +1,332 @@
+// Package consistency scans the shared database for references that point at
+// documents which no longer exist, and repairs the cases that are safe to fix.
+package consistency
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// Violation categories
+const (
+	CategoryDuplicateStudentID        = "duplicate_student_id"
+	CategoryCourseMissingFaculty      = "course_missing_faculty"
+	CategoryEnrollmentMissingCourse   = "enrollment_missing_course"
+	CategoryEnrollmentMissingStudent  = "enrollment_missing_student"
+	CategoryOrphanedGrade             = "orphaned_grade"
+	CategoryPrerequisiteMissingCourse = "prerequisite_missing_course"
+	CategoryCartMissingCourse         = "cart_missing_course"
+	CategoryCartMissingStudent        = "cart_missing_student"
+)
+
+// Violation is one broken reference
+type Violation struct {
+	Category   string
+	Collection string
+	DocumentID string
+	Detail     string
+	Repaired   bool
+}
+
+// check finds one category of violation by streaming an aggregation over a
+// single collection. The lookups run in the database, so only the offending
+// documents are ever sent to the checker.
+type check struct {
+	category    string
+	collection  string
+	description string
+	pipeline    []bson.M
+	violation   func(doc bson.M) Violation
+	repair      func(ctx context.Context, db *mongo.Database, doc bson.M) error // nil when only reported
+}
+
+// Collections lists the collections the checker can scan, for --collection
+var Collections = []string{"users", "courses", "enrollments", "grades", "prerequisites", "carts"}
+
+// missing matches documents whose lookup found nothing
+func missing(field string) bson.M {
+	return bson.M{"$match": bson.M{field: bson.M{"$size": 0}}}
+}
+
+func lookup(from, localField, foreignField, as string) bson.M {
+	return bson.M{"$lookup": bson.M{
+		"from": from, "localField": localField, "foreignField": foreignField, "as": as,
+	}}
+}
+
+// project keeps only what a violation reports, dropping the looked-up arrays
+func project(fields ...string) bson.M {
+	p := bson.M{}
+	for _, f := range fields {
+		p[f] = 1
+	}
+	return bson.M{"$project": p}
+}
+
+func idOf(doc bson.M) string {
+	return fmt.Sprint(doc["_id"])
+}
+
+func str(doc bson.M, key string) string {
+	s, _ := doc[key].(string)
+	return s
+}
+
+var checks = []check{
+	{
+		category:    CategoryDuplicateStudentID,
+		collection:  "users",
+		description: "Student IDs shared by more than one user (enrollments cannot tell them apart)",
+		pipeline: []bson.M{
+			{"$match": bson.M{"student_id": bson.M{"$nin": bson.A{nil, ""}}}},
+			{"$group": bson.M{"_id": "$student_id", "users": bson.M{"$push": "$_id"}, "count": bson.M{"$sum": 1}}},
+			{"$match": bson.M{"count": bson.M{"$gt": 1}}},
+		},
+		violation: func(doc bson.M) Violation {
+			return Violation{DocumentID: idOf(doc), Detail: fmt.Sprintf("used by users %v", doc["users"])}
+		},
+	},
+	{
+		category:    CategoryCourseMissingFaculty,
+		collection:  "courses",
+		description: "Courses assigned to a faculty member that does not exist",
+		pipeline: []bson.M{
+			{"$match": bson.M{"faculty_id": bson.M{"$nin": bson.A{nil, ""}}}},
+			lookup("users", "faculty_id", "_id", "faculty"),
+			missing("faculty"),
+			project("code", "faculty_id"),
+		},
+		violation: func(doc bson.M) Violation {
+			return Violation{DocumentID: idOf(doc), Detail: fmt.Sprintf("%s: faculty %s not found", str(doc, "code"), str(doc, "faculty_id"))}
+		},
+	},
+	{
+		category:    CategoryEnrollmentMissingCourse,
+		collection:  "enrollments",
+		description: "Enrollments in a course that does not exist",
+		pipeline: []bson.M{
+			lookup("courses", "course_id", "_id", "course"),
+			missing("course"),
+			project("student_id", "course_id", "status"),
+		},
+		violation: func(doc bson.M) Violation {
+			return Violation{DocumentID: idOf(doc), Detail: fmt.Sprintf("student %s, %s, course %s not found",
+				str(doc, "student_id"), str(doc, "status"), str(doc, "course_id"))}
+		},
+	},
+	{
+		category:    CategoryEnrollmentMissingStudent,
+		collection:  "enrollments",
+		description: "Enrollments of a student that does not exist",
+		pipeline: []bson.M{
+			// Students are referenced by student number or by user ID
+			lookup("users", "student_id", "student_id", "by_number"),
+			missing("by_number"),
+			lookup("users", "student_id", "_id", "by_id"),
+			missing("by_id"),
+			project("student_id", "course_id"),
+		},
+		violation: func(doc bson.M) Violation {
+			return Violation{DocumentID: idOf(doc), Detail: fmt.Sprintf("course %s, student %s not found",
+				str(doc, "course_id"), str(doc, "student_id"))}
+		},
+	},
+	{
+		category:    CategoryOrphanedGrade,
+		collection:  "grades",
+		description: "Grades for an enrollment that does not exist (--fix flags them with orphaned_at; they are never deleted)",
+		pipeline: []bson.M{
+			{"$match": bson.M{"orphaned_at": bson.M{"$exists": false}}},
+			lookup("enrollments", "enrollment_id", "_id", "enrollment"),
+			missing("enrollment"),
+			project("enrollment_id", "grade"),
+		},
+		violation: func(doc bson.M) Violation {
+			return Violation{DocumentID: idOf(doc), Detail: fmt.Sprintf("grade %s, enrollment %s not found",
+				str(doc, "grade"), str(doc, "enrollment_id"))}
+		},
+		repair: func(ctx context.Context, db *mongo.Database, doc bson.M) error {
+			_, err := db.Collection("grades").UpdateOne(ctx,
+				bson.M{"_id": doc["_id"]},
+				bson.M{"$set": bson.M{"orphaned_at": time.Now()}},
+			)
+			return err
+		},
+	},
+	{
+		category:    CategoryPrerequisiteMissingCourse,
+		collection:  "prerequisites",
+		description: "Prerequisite links where either course does not exist",
+		pipeline: []bson.M{
+			lookup("courses", "course_id", "_id", "course"),
+			lookup("courses", "prereq_id", "_id", "prereq"),
+			{"$match": bson.M{"$or": bson.A{
+				bson.M{"course": bson.M{"$size": 0}},
+				bson.M{"prereq": bson.M{"$size": 0}},
+			}}},
+			{"$project": bson.M{
+				"course_id":      1,
+				"prereq_id":      1,
+				"course_missing": bson.M{"$eq": bson.A{bson.M{"$size": "$course"}, 0}},
+			}},
+		},
+		violation: func(doc bson.M) Violation {
+			missingID := str(doc, "prereq_id")
+			if gone, _ := doc["course_missing"].(bool); gone {
+				missingID = str(doc, "course_id")
+			}
+			return Violation{DocumentID: idOf(doc), Detail: fmt.Sprintf("%s requires %s, course %s not found",
+				str(doc, "course_id"), str(doc, "prereq_id"), missingID)}
+		},
+	},
+	{
+		category:    CategoryCartMissingCourse,
+		collection:  "carts",
+		description: "Cart entries for courses that do not exist (--fix removes the entries)",
+		pipeline: []bson.M{
+			lookup("courses", "course_ids", "_id", "found"),
+			{"$project": bson.M{
+				"student_id": 1,
+				"missing":    bson.M{"$setDifference": bson.A{bson.M{"$ifNull": bson.A{"$course_ids", bson.A{}}}, "$found._id"}},
+			}},
+			{"$match": bson.M{"missing.0": bson.M{"$exists": true}}},
+		},
+		violation: func(doc bson.M) Violation {
+			return Violation{DocumentID: str(doc, "student_id"), Detail: fmt.Sprintf("courses %v not found", doc["missing"])}
+		},
+		repair: func(ctx context.Context, db *mongo.Database, doc bson.M) error {
+			_, err := db.Collection("carts").UpdateOne(ctx,
+				bson.M{"_id": doc["_id"]},
+				bson.M{
+					"$pull":  bson.M{"course_ids": bson.M{"$in": doc["missing"]}},
+					"$unset": bson.M{"validation_results": ""},
+				},
+			)
+			return err
+		},
+	},
+	{
+		category:    CategoryCartMissingStudent,
+		collection:  "carts",
+		description: "Carts of a student that does not exist",
+		pipeline: []bson.M{
+			lookup("users", "student_id", "student_id", "by_number"),
+			missing("by_number"),
+			lookup("users", "student_id", "_id", "by_id"),
+			missing("by_id"),
+			project("student_id"),
+		},
+		violation: func(doc bson.M) Violation {
+			return Violation{DocumentID: str(doc, "student_id"), Detail: "student not found"}
+		},
+	},
+}
+
+// ParseCollections validates a comma-separated --collection value; empty selects every collection
+func ParseCollections(value string) (map[string]bool, error) {
+	selected := make(map[string]bool)
+	if strings.TrimSpace(value) == "" {
+		for _, c := range Collections {
+			selected[c] = true
+		}
+		return selected, nil
+	}
+
+	known := make(map[string]bool, len(Collections))
+	for _, c := range Collections {
+		known[c] = true
+	}
+	for _, c := range strings.Split(value, ",") {
+		c = strings.TrimSpace(c)
+		if !known[c] {
+			return nil, fmt.Errorf("unknown collection %q (expected one of %s)", c, strings.Join(Collections, ", "))
+		}
+		selected[c] = true
+	}
+	return selected, nil
+}
+
+// Summary counts the violations of one category
+type Summary struct {
+	Category    string
+	Collection  string
+	Description string
+	Found       int
+	Repaired    int
+}
+
+// Checker runs the checks against one database
+type Checker struct {
+	db          *mongo.Database
+	collections map[string]bool
+	fix         bool
+}
+
+// NewChecker creates a checker for the selected collections; fix enables the safe repairs
+func NewChecker(db *mongo.Database, collections map[string]bool, fix bool) *Checker {
+	return &Checker{db: db, collections: collections, fix: fix}
+}
+
+// Run streams every selected check, passing each violation to report as it is
+// found, and returns one summary per check run. A failed repair is reported on
+// the violation (Repaired stays false) rather than aborting the scan.
+func (c *Checker) Run(ctx context.Context, begin func(Summary), report func(Violation)) ([]Summary, error) {
+	var summaries []Summary
+	for _, chk := range checks {
+		if !c.collections[chk.collection] {
+			continue
+		}
+		summary := Summary{Category: chk.category, Collection: chk.collection, Description: chk.description}
+		begin(summary)
+
+		if err := c.runCheck(ctx, chk, &summary, report); err != nil {
+			return summaries, fmt.Errorf("%s: %w", chk.category, err)
+		}
+		summaries = append(summaries, summary)
+	}
+	return summaries, nil
+}
+
+func (c *Checker) runCheck(ctx context.Context, chk check, summary *Summary, report func(Violation)) error {
+	cursor, err := c.db.Collection(chk.collection).Aggregate(ctx, chk.pipeline)
+	if err != nil {
+		return err
+	}
+	defer cursor.Close(ctx)
+
+	for cursor.Next(ctx) {
+		var doc bson.M
+		if err := cursor.Decode(&doc); err != nil {
+			return err
+		}
+		v := chk.violation(doc)
+		v.Category = chk.category
+		v.Collection = chk.collection
+		summary.Found++
+
+		if c.fix && chk.repair != nil {
+			if err := chk.repair(ctx, c.db, doc); err != nil {
+				v.Detail += fmt.Sprintf(" (repair failed: %v)", err)
+			} else {
+				v.Repaired = true
+				summary.Repaired++
+			}
+		}
+		report(v)
+	}
+	return cursor.Err()
+}
+
+// Total sums the violations found across summaries
+func Total(summaries []Summary) int {
+	total := 0
+	for _, s := range summaries {
+		total += s.Found
+	}
+	return total
+}
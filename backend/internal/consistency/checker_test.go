@@ -0,0 +1,124 @@
+package consistency
+
+import (
+	"context"
+	"log"
+	"testing"
+
+	"github.com/joho/godotenv"
+	"go.mongodb.org/mongo-driver/bson"
+
+	"stdiscm_p4/backend/internal/shared"
+)
+
+func TestParseCollections(t *testing.T) {
+	all, err := ParseCollections("")
+	if err != nil || len(all) != len(Collections) {
+		t.Errorf("Expected every collection for an empty filter, got %v (err %v)", all, err)
+	}
+
+	some, err := ParseCollections("carts, grades")
+	if err != nil || len(some) != 2 || !some["carts"] || !some["grades"] {
+		t.Errorf("Expected carts and grades, got %v (err %v)", some, err)
+	}
+
+	if _, err := ParseCollections("carts,waitlists"); err == nil {
+		t.Error("Expected an unknown collection to be rejected")
+	}
+}
+
+func TestChecker_Integration(t *testing.T) {
+	if err := godotenv.Load("../../cmd/admin/.env"); err != nil {
+		log.Println("No .env file found, using defaults")
+	}
+	cfg, _ := shared.LoadServiceConfig("consistency")
+	_, db, err := shared.ConnectMongoDB(&cfg.MongoDB)
+	if err != nil {
+		t.Fatalf("Failed to connect to MongoDB: %v", err)
+	}
+	ctx := context.Background()
+
+	const (
+		courseID    = "CONS-COURSE-1"
+		goneCourse  = "CONS-NO-COURSE"
+		userID      = "cons-user-1"
+		studentID   = "cons-student-1"
+		enrollment  = "CONS-ENR-1"
+		goneEnroll  = "CONS-NO-ENR"
+		gradeDocID  = "CONS-GRADE-1"
+		goneFaculty = "CONS-NO-FACULTY"
+	)
+
+	cleanup := func() {
+		db.Collection("courses").DeleteOne(ctx, bson.M{"_id": courseID})
+		db.Collection("users").DeleteOne(ctx, bson.M{"_id": userID})
+		db.Collection("enrollments").DeleteOne(ctx, bson.M{"_id": enrollment})
+		db.Collection("grades").DeleteOne(ctx, bson.M{"_id": gradeDocID})
+		db.Collection("prerequisites").DeleteMany(ctx, bson.M{"course_id": courseID})
+		db.Collection("carts").DeleteOne(ctx, bson.M{"student_id": studentID})
+	}
+	cleanup()
+	defer cleanup()
+
+	db.Collection("users").InsertOne(ctx, shared.User{ID: userID, Email: "cons@test.com", Role: shared.RoleStudent, StudentID: studentID, IsActive: true})
+	db.Collection("courses").InsertOne(ctx, shared.Course{ID: courseID, Code: "CONS101", FacultyID: goneFaculty, Units: 3})
+	db.Collection("enrollments").InsertOne(ctx, shared.Enrollment{ID: enrollment, StudentID: studentID, CourseID: goneCourse, Status: "enrolled"})
+	db.Collection("grades").InsertOne(ctx, bson.M{"_id": gradeDocID, "enrollment_id": goneEnroll, "grade": "B"})
+	db.Collection("prerequisites").InsertOne(ctx, shared.Prerequisite{CourseID: courseID, PrereqID: goneCourse})
+	db.Collection("carts").InsertOne(ctx, shared.Cart{StudentID: studentID, CourseIDs: []string{courseID, goneCourse}})
+
+	all, _ := ParseCollections("")
+	found := make(map[string]bool)
+	summaries, err := NewChecker(db, all, true).Run(ctx, func(Summary) {}, func(v Violation) {
+		found[v.Category+" "+v.DocumentID] = true
+	})
+	if err != nil {
+		t.Fatalf("Run failed: %v", err)
+	}
+	if len(summaries) != len(checks) {
+		t.Errorf("Expected %d checks to run, got %d", len(checks), len(summaries))
+	}
+
+	for _, want := range []string{
+		CategoryCourseMissingFaculty + " " + courseID,
+		CategoryEnrollmentMissingCourse + " " + enrollment,
+		CategoryOrphanedGrade + " " + gradeDocID,
+		CategoryCartMissingCourse + " " + studentID,
+	} {
+		if !found[want] {
+			t.Errorf("Expected violation %q", want)
+		}
+	}
+	if found[CategoryEnrollmentMissingStudent+" "+enrollment] {
+		t.Error("Enrollment of an existing student reported as missing its student")
+	}
+
+	// Safe repairs: the missing course leaves the cart, the grade is flagged but kept
+	var cart shared.Cart
+	db.Collection("carts").FindOne(ctx, bson.M{"student_id": studentID}).Decode(&cart)
+	if len(cart.CourseIDs) != 1 || cart.CourseIDs[0] != courseID {
+		t.Errorf("Expected only %s left in the cart, got %v", courseID, cart.CourseIDs)
+	}
+	var grade shared.Grade
+	if err := db.Collection("grades").FindOne(ctx, bson.M{"_id": gradeDocID}).Decode(&grade); err != nil || grade.OrphanedAt.IsZero() {
+		t.Errorf("Expected the orphaned grade to be kept and flagged, got %+v (err %v)", grade, err)
+	}
+
+	// Only grades and carts selected: repaired violations are gone, the rest is not scanned
+	selected, _ := ParseCollections("grades,carts")
+	found = make(map[string]bool)
+	summaries, err = NewChecker(db, selected, false).Run(ctx, func(Summary) {}, func(v Violation) {
+		found[v.Category+" "+v.DocumentID] = true
+	})
+	if err != nil {
+		t.Fatalf("Run failed: %v", err)
+	}
+	for _, s := range summaries {
+		if s.Collection != "grades" && s.Collection != "carts" {
+			t.Errorf("Expected only grades and carts to be scanned, got %s", s.Collection)
+		}
+	}
+	if found[CategoryOrphanedGrade+" "+gradeDocID] || found[CategoryCartMissingCourse+" "+studentID] {
+		t.Error("Expected repaired violations to stay repaired")
+	}
+}
@@ -0,0 +1,162 @@
+package course
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"log"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	pb "stdiscm_p4/backend/internal/pb/course"
+	"stdiscm_p4/backend/internal/shared"
+)
+
+// catalogCacheTTL bounds how stale a cached catalog page can be. Admin course edits
+// bump the catalog version and show up at once; seat counts moved by enrollments
+// do not, so they lag by at most this long.
+const catalogCacheTTL = 10 * time.Second
+
+// maxCatalogEntries caps the distinct filter combinations kept at once
+const maxCatalogEntries = 1000
+
+// catalogCache holds recent ListCourses responses keyed by semester and filter hash.
+// An entry is served only while it is younger than ttl and was built under the
+// current catalog version.
+type catalogCache struct {
+	ttl time.Duration
+
+	mu      sync.Mutex
+	entries map[string]catalogEntry
+
+	hits   atomic.Uint64
+	misses atomic.Uint64
+}
+
+type catalogEntry struct {
+	resp      *pb.ListCoursesResponse
+	version   string
+	expiresAt time.Time
+}
+
+func newCatalogCache(ttl time.Duration) *catalogCache {
+	return &catalogCache{ttl: ttl, entries: make(map[string]catalogEntry)}
+}
+
+// catalogKey identifies a filter and sort combination; semester leads so keys group
+// by term
+func catalogKey(req *pb.ListCoursesRequest) string {
+	b, err := proto.MarshalOptions{Deterministic: true}.Marshal(&pb.ListCoursesRequest{
+		Filters:   req.Filters,
+		SortBy:    req.SortBy,
+		SortOrder: req.SortOrder,
+	})
+	if err != nil {
+		return ""
+	}
+	sum := sha256.Sum256(b)
+	return req.Filters.GetSemester() + "|" + hex.EncodeToString(sum[:])
+}
+
+// get returns the cached response for key, counting the lookup as a hit or miss
+func (c *catalogCache) get(key, version string, now time.Time) (*pb.ListCoursesResponse, bool) {
+	c.mu.Lock()
+	entry, ok := c.entries[key]
+	c.mu.Unlock()
+
+	if ok && entry.version == version && now.Before(entry.expiresAt) {
+		c.hits.Add(1)
+		return entry.resp, true
+	}
+	c.misses.Add(1)
+	return nil, false
+}
+
+// put stores a response built under version. When the cache is full expired entries
+// are dropped first, and everything if that frees nothing.
+func (c *catalogCache) put(key, version string, resp *pb.ListCoursesResponse, now time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, ok := c.entries[key]; !ok && len(c.entries) >= maxCatalogEntries {
+		for k, e := range c.entries {
+			if !now.Before(e.expiresAt) {
+				delete(c.entries, k)
+			}
+		}
+		if len(c.entries) >= maxCatalogEntries {
+			c.entries = make(map[string]catalogEntry)
+		}
+	}
+	c.entries[key] = catalogEntry{resp: resp, version: version, expiresAt: now.Add(c.ttl)}
+}
+
+// size counts the cached pages, expired ones included until put evicts them
+func (c *catalogCache) size() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.entries)
+}
+
+// CatalogCacheStats reports how many catalog lookups were served from the cache and
+// how many went to the database, for the hit rate the service logs
+func (s *CourseService) CatalogCacheStats() (hits, misses uint64) {
+	return s.catalog.hits.Load(), s.catalog.misses.Load()
+}
+
+// GetCatalogCacheStats exposes the catalog cache counters since the service started.
+// Only admins may read them.
+func (s *CourseService) GetCatalogCacheStats(ctx context.Context, req *pb.GetCatalogCacheStatsRequest) (*pb.GetCatalogCacheStatsResponse, error) {
+	if req == nil || req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	queryCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	var user shared.User
+	if err := s.db.Collection("users").FindOne(queryCtx, bson.M{"_id": req.UserId}).Decode(&user); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, status.Error(codes.PermissionDenied, "user not found")
+		}
+		return nil, status.Error(codes.Internal, "failed to retrieve user")
+	}
+	if user.Role != shared.RoleAdmin {
+		return nil, status.Error(codes.PermissionDenied, "only admins can view catalog cache stats")
+	}
+
+	hits, misses := s.CatalogCacheStats()
+	resp := &pb.GetCatalogCacheStatsResponse{
+		Hits:    int64(hits),
+		Misses:  int64(misses),
+		Entries: int32(s.catalog.size()),
+	}
+	if hits+misses > 0 {
+		resp.HitRate = float64(hits) / float64(hits+misses)
+	}
+	return resp, nil
+}
+
+// catalogVersion reads the course_catalog_version stamp admins bump on course
+// changes ("" when it was never set). A failed read returns ok false so the
+// caller skips the cache rather than serve a page it cannot validate.
+func (s *CourseService) catalogVersion(ctx context.Context) (version string, ok bool) {
+	var cfg shared.SystemConfig
+	err := s.systemConfigCol.FindOne(ctx, bson.M{"key": shared.ConfigCatalogVersion}).Decode(&cfg)
+	switch {
+	case err == nil:
+		return cfg.Value, true
+	case err == mongo.ErrNoDocuments:
+		return "", true
+	default:
+		log.Printf("Warning: failed to read %s: %v", shared.ConfigCatalogVersion, err)
+		return "", false
+	}
+}
@@ -2,40 +2,67 @@ package course
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
+	"math"
+	"net/url"
+	"regexp"
+	"sort"
 	"strings"
 	"time"
 
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
-	"google.golang.org/protobuf/types/known/timestamppb"
 
 	pb "stdiscm_p4/backend/internal/pb/course"
 	"stdiscm_p4/backend/internal/shared"
 )
 
+// maxBatchCourses caps the IDs accepted by a single BatchGetCourses call
+const maxBatchCourses = 500
+
+// maxReviewComments caps the comments returned by GetCourseReviewSummary
+const maxReviewComments = 200
+
 // CourseService implements the gRPC CourseService
 type CourseService struct {
 	pb.UnimplementedCourseServiceServer
-	db               *mongo.Database
-	coursesCol       *mongo.Collection
-	prerequisitesCol *mongo.Collection
-	enrollmentsCol   *mongo.Collection
-	gradesCol        *mongo.Collection
+	db                 *mongo.Database
+	coursesCol         *mongo.Collection
+	prerequisitesCol   *mongo.Collection
+	enrollmentsCol     *mongo.Collection
+	cartsCol           *mongo.Collection
+	gradesCol          *mongo.Collection
+	transferCreditsCol *mongo.Collection
+	reviewsCol         *mongo.Collection
+	departmentsCol     *mongo.Collection
+	seatHoldsCol       *mongo.Collection
+	systemConfigCol    *mongo.Collection
+
+	// Recent ListCourses pages for catalog browsing
+	catalog *catalogCache
 }
 
 // NewCourseService creates a new CourseService instance
 func NewCourseService(db *mongo.Database) *CourseService {
 	return &CourseService{
-		db:               db,
-		coursesCol:       db.Collection("courses"),
-		prerequisitesCol: db.Collection("prerequisites"),
-		enrollmentsCol:   db.Collection("enrollments"),
-		gradesCol:        db.Collection("grades"),
+		db:                 db,
+		coursesCol:         db.Collection("courses"),
+		prerequisitesCol:   db.Collection("prerequisites"),
+		enrollmentsCol:     db.Collection("enrollments"),
+		cartsCol:           db.Collection("carts"),
+		gradesCol:          db.Collection("grades"),
+		transferCreditsCol: db.Collection("transfer_credits"),
+		reviewsCol:         db.Collection("course_reviews"),
+		departmentsCol:     db.Collection("departments"),
+		seatHoldsCol:       db.Collection("seat_holds"),
+		systemConfigCol:    db.Collection("system_config"),
+		catalog:            newCatalogCache(catalogCacheTTL),
 	}
 }
 
@@ -45,51 +72,54 @@ func (s *CourseService) ListCourses(ctx context.Context, req *pb.ListCoursesRequ
 		return nil, status.Error(codes.InvalidArgument, "request cannot be nil")
 	}
 
-	// Build filter query
-	filter := bson.M{}
-
-	if req.Filters != nil {
-		// Filter by department (extract from course code)
-		if req.Filters.Department != "" {
-			filter["code"] = bson.M{
-				"$regex": primitive.Regex{
-					Pattern: "^" + strings.ToUpper(req.Filters.Department),
-					Options: "i",
-				},
-			}
-		}
-
-		// Search query (course code or title)
-		if req.Filters.SearchQuery != "" {
-			searchRegex := primitive.Regex{
-				Pattern: req.Filters.SearchQuery,
-				Options: "i",
-			}
-			filter["$or"] = []bson.M{
-				{"code": searchRegex},
-				{"title": searchRegex},
-			}
-		}
+	slotFilter, err := scheduleSlotFilter(req.Filters)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	sortField, sortDir, err := parseCourseSort(req.SortBy, req.SortOrder)
+	if err != nil {
+		return nil, err
+	}
 
-		// Filter by open status
-		if req.Filters.OpenOnly {
-			filter["is_open"] = true
+	// Catalog browsing is served from the cache; live requests (admins) skip it
+	var key, version string
+	cacheable := !req.Live
+	if cacheable {
+		key = catalogKey(req)
+		version, cacheable = s.catalogVersion(ctx)
+		cacheable = cacheable && key != ""
+	}
+	if cacheable {
+		if resp, ok := s.catalog.get(key, version, time.Now()); ok {
+			return resp, nil
 		}
+	}
 
-		// Filter by semester
-		if req.Filters.Semester != "" {
-			filter["semester"] = req.Filters.Semester
+	build := func(useText bool) bson.M {
+		filter := s.buildCourseFilter(req.Filters, useText)
+		for k, v := range slotFilter {
+			filter[k] = v
 		}
+		return filter
 	}
 
-	// Set query options using shared helper
-	findOptions := shared.BuildFindOptions(100, "code", 1)
+	// Build filter query
+	textSearch := req.Filters != nil && req.Filters.TextSearch != ""
+	filter := build(textSearch)
 
 	// Execute query with timeout
 	queryCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
 	defer cancel()
 
-	cursor, err := s.coursesCol.Find(queryCtx, filter, findOptions)
+	// Full-text queries are ranked by relevance unless a sort was asked for
+	rankByScore := textSearch && req.SortBy == ""
+	cursor, err := s.findCourses(queryCtx, filter, sortField, sortDir, rankByScore)
+	if err != nil && textSearch && isTextIndexMissing(err) {
+		// Text index unavailable, fall back to regex matching on code and title
+		log.Printf("Warning: text index unavailable, falling back to regex search: %v", err)
+		filter = build(false)
+		cursor, err = s.findCourses(queryCtx, filter, sortField, sortDir, false)
+	}
 	if err != nil {
 		log.Printf("Error querying courses: %v", err)
 		return nil, status.Error(codes.Internal, "failed to retrieve courses")
@@ -118,6 +148,8 @@ func (s *CourseService) ListCourses(ctx context.Context, req *pb.ListCoursesRequ
 		log.Printf("Cursor error: %v", err)
 		return nil, status.Error(codes.Internal, "error iterating courses")
 	}
+	s.loadPrerequisites(queryCtx, courses)
+	s.subtractSeatHolds(queryCtx, courses)
 
 	// Get total count using shared helper
 	totalCount, err := shared.CountDocumentsWithTimeout(ctx, s.coursesCol, filter, 5*time.Second)
@@ -126,30 +158,45 @@ func (s *CourseService) ListCourses(ctx context.Context, req *pb.ListCoursesRequ
 		totalCount = int64(len(courses))
 	}
 
-	return &pb.ListCoursesResponse{
+	resp := &pb.ListCoursesResponse{
 		Courses:    courses,
 		TotalCount: int32(totalCount),
-	}, nil
+	}
+	if cacheable {
+		s.catalog.put(key, version, resp, time.Now())
+	}
+	return resp, nil
 }
 
-// GetCourse retrieves a single course by ID
+// GetCourse retrieves a single course by ID. Seats held in carts are not counted
+// as available, since GetCourse does not know who is asking.
 func (s *CourseService) GetCourse(ctx context.Context, req *pb.GetCourseRequest) (*pb.GetCourseResponse, error) {
 	if req == nil || req.CourseId == "" {
 		return nil, status.Error(codes.InvalidArgument, "course_id is required")
 	}
 
+	course, err := s.getCourse(ctx, req.CourseId, "")
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetCourseResponse{
+		Success: true,
+		Course:  course,
+		Message: "course retrieved successfully",
+	}, nil
+}
+
+// getCourse loads a course with seats held in carts taken off seats_available,
+// except the viewer's own hold, which is theirs to use
+func (s *CourseService) getCourse(ctx context.Context, courseID, viewerID string) (*pb.Course, error) {
 	var doc bson.M
-	err := shared.FindOneWithTimeout(ctx, s.coursesCol, bson.M{"_id": req.CourseId}, &doc, 5*time.Second)
+	err := shared.FindOneWithTimeout(ctx, s.coursesCol, bson.M{"_id": courseID}, &doc, 5*time.Second)
 
 	if err != nil {
 		if err == mongo.ErrNoDocuments {
-			return &pb.GetCourseResponse{
-				Success: false,
-				Course:  nil,
-				Message: fmt.Sprintf("course not found: %s", req.CourseId),
-			}, nil
+			return nil, shared.ErrCourseNotFound.WithParam("course_id", courseID)
 		}
-		log.Printf("Error finding course %s: %v", req.CourseId, err)
+		log.Printf("Error finding course %s: %v", courseID, err)
 		return nil, status.Error(codes.Internal, "failed to retrieve course")
 	}
 
@@ -158,15 +205,129 @@ func (s *CourseService) GetCourse(ctx context.Context, req *pb.GetCourseRequest)
 		log.Printf("Error converting document to course: %v", err)
 		return nil, status.Error(codes.Internal, "failed to parse course data")
 	}
+	s.loadPrerequisites(ctx, []*pb.Course{course})
 
-	return &pb.GetCourseResponse{
-		Success: true,
-		Course:  course,
-		Message: "course retrieved successfully",
-	}, nil
+	if held := s.seatsHeld(ctx, course.Id, viewerID); held > 0 {
+		course.SeatsHeld = held
+		course.SeatsAvailable = shared.SeatsAvailable(course.Capacity, course.Enrolled+held)
+		course.IsFull = course.SeatsAvailable == 0
+	}
+	return course, nil
+}
+
+// GetCourseDetail is GetCourse for a course page. With a student_id it also reports
+// whether the course is in that student's cart, enrolled or completed, and counts
+// the student's own seat hold as available; other services call GetCourse, which
+// skips those lookups.
+func (s *CourseService) GetCourseDetail(ctx context.Context, req *pb.GetCourseDetailRequest) (*pb.GetCourseDetailResponse, error) {
+	if req == nil || req.CourseId == "" {
+		return nil, status.Error(codes.InvalidArgument, "course_id is required")
+	}
+
+	course, err := s.getCourse(ctx, req.CourseId, req.StudentId)
+	if err != nil {
+		return nil, err
+	}
+	detail := &pb.GetCourseDetailResponse{Success: true, Course: course, Message: "course retrieved successfully"}
+
+	if req.StudentId != "" {
+		detail.StudentStatus, err = s.studentCourseStatus(ctx, req.StudentId, req.CourseId)
+		if err != nil {
+			log.Printf("Error loading status of course %s for student %s: %v", req.CourseId, req.StudentId, err)
+			return nil, status.Error(codes.Internal, "failed to retrieve student course status")
+		}
+	}
+	return detail, nil
+}
+
+// studentCourseStatus checks the student's cart and enrollments for a course
+func (s *CourseService) studentCourseStatus(ctx context.Context, studentID, courseID string) (*pb.CourseStudentStatus, error) {
+	queryCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	inCart, err := s.cartsCol.CountDocuments(queryCtx,
+		bson.M{"student_id": studentID, "course_ids": courseID},
+		options.Count().SetLimit(1))
+	if err != nil {
+		return nil, err
+	}
+
+	statuses, err := s.enrollmentsCol.Distinct(queryCtx, "status", bson.M{
+		"student_id": studentID,
+		"course_id":  courseID,
+		"status":     bson.M{"$in": []string{shared.StatusEnrolled, shared.StatusCompleted}},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	st := &pb.CourseStudentStatus{InCart: inCart > 0}
+	for _, v := range statuses {
+		switch v {
+		case shared.StatusEnrolled:
+			st.Enrolled = true
+		case shared.StatusCompleted:
+			st.Completed = true
+		}
+	}
+	return st, nil
+}
+
+// BatchGetCourses retrieves several courses by ID in a single query
+func (s *CourseService) BatchGetCourses(ctx context.Context, req *pb.BatchGetCoursesRequest) (*pb.BatchGetCoursesResponse, error) {
+	if req == nil || len(req.CourseIds) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "course_ids is required")
+	}
+	if len(req.CourseIds) > maxBatchCourses {
+		return nil, status.Errorf(codes.InvalidArgument, "at most %d course_ids per request", maxBatchCourses)
+	}
+
+	queryCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	cursor, err := s.coursesCol.Find(queryCtx, bson.M{"_id": bson.M{"$in": req.CourseIds}})
+	if err != nil {
+		log.Printf("Error querying courses: %v", err)
+		return nil, status.Error(codes.Internal, "failed to retrieve courses")
+	}
+	defer cursor.Close(queryCtx)
+
+	found := make(map[string]bool, len(req.CourseIds))
+	var courses []*pb.Course
+	for cursor.Next(queryCtx) {
+		var doc bson.M
+		if err := cursor.Decode(&doc); err != nil {
+			log.Printf("Error decoding course document: %v", err)
+			continue
+		}
+
+		course, err := s.documentToCourse(queryCtx, doc)
+		if err != nil {
+			log.Printf("Error converting document to course: %v", err)
+			continue
+		}
+		found[course.Id] = true
+		courses = append(courses, course)
+	}
+	if err := cursor.Err(); err != nil {
+		log.Printf("Cursor error: %v", err)
+		return nil, status.Error(codes.Internal, "error iterating courses")
+	}
+	s.loadPrerequisites(queryCtx, courses)
+
+	var missing []string
+	for _, id := range req.CourseIds {
+		if !found[id] {
+			missing = append(missing, id)
+			found[id] = true // report duplicates once
+		}
+	}
+
+	return &pb.BatchGetCoursesResponse{Courses: courses, MissingIds: missing}, nil
 }
 
-// CheckPrerequisites verifies if a student has met prerequisites for a course
+// CheckPrerequisites verifies if a student has met prerequisites for a course.
+// With allow_in_progress, a current enrollment in a prerequisite counts provisionally.
 func (s *CourseService) CheckPrerequisites(ctx context.Context, req *pb.CheckPrerequisitesRequest) (*pb.CheckPrerequisitesResponse, error) {
 	if req == nil || req.StudentId == "" || req.CourseId == "" {
 		return nil, status.Error(codes.InvalidArgument, "student_id and course_id are required")
@@ -205,11 +366,18 @@ func (s *CourseService) CheckPrerequisites(ctx context.Context, req *pb.CheckPre
 	// Check each prerequisite
 	var prerequisiteStatuses []*pb.PrerequisiteStatus
 	allMet := true
+	provisional := false
 
 	for _, prereqID := range prerequisiteIDs {
 		prereqStatus := s.checkSinglePrerequisite(queryCtx, req.StudentId, prereqID)
+		if !prereqStatus.Met && req.AllowInProgress {
+			prereqStatus.InProgress = s.isCurrentlyEnrolled(queryCtx, req.StudentId, prereqID)
+		}
 		prerequisiteStatuses = append(prerequisiteStatuses, prereqStatus)
-		if !prereqStatus.Met {
+		switch {
+		case prereqStatus.InProgress:
+			provisional = true
+		case !prereqStatus.Met:
 			allMet = false
 		}
 	}
@@ -217,15 +385,158 @@ func (s *CourseService) CheckPrerequisites(ctx context.Context, req *pb.CheckPre
 	message := "all prerequisites met"
 	if !allMet {
 		message = "some prerequisites not met"
+	} else if provisional {
+		message = "prerequisites met provisionally, some are still in progress"
 	}
 
 	return &pb.CheckPrerequisitesResponse{
 		AllMet:        allMet,
 		Prerequisites: prerequisiteStatuses,
 		Message:       message,
+		Provisional:   allMet && provisional,
 	}, nil
 }
 
+// GetPrerequisites lists a course's prerequisites resolved to code and title, with no
+// student context. Prerequisites whose course was deleted are kept with an empty code.
+func (s *CourseService) GetPrerequisites(ctx context.Context, req *pb.GetPrerequisitesRequest) (*pb.GetPrerequisitesResponse, error) {
+	if req == nil || req.CourseId == "" {
+		return nil, status.Error(codes.InvalidArgument, "course_id is required")
+	}
+
+	count, err := shared.CountDocumentsWithTimeout(ctx, s.coursesCol, bson.M{"_id": req.CourseId}, 5*time.Second)
+	if err != nil {
+		log.Printf("Error finding course %s: %v", req.CourseId, err)
+		return nil, status.Error(codes.Internal, "failed to retrieve course")
+	}
+	if count == 0 {
+		return nil, shared.ErrCourseNotFound.WithParam("course_id", req.CourseId)
+	}
+
+	prereqIDs := s.getCoursePrerequisites(ctx, req.CourseId)
+	prerequisites := make([]*pb.Prerequisite, 0, len(prereqIDs))
+	if len(prereqIDs) == 0 {
+		return &pb.GetPrerequisitesResponse{CourseId: req.CourseId, Prerequisites: prerequisites}, nil
+	}
+
+	queryCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	opts := options.Find().SetProjection(bson.M{"code": 1, "title": 1})
+	cursor, err := s.coursesCol.Find(queryCtx, bson.M{"_id": bson.M{"$in": prereqIDs}}, opts)
+	if err != nil {
+		log.Printf("Error querying prerequisite courses: %v", err)
+		return nil, status.Error(codes.Internal, "failed to retrieve prerequisites")
+	}
+	defer cursor.Close(queryCtx)
+
+	resolved := make(map[string]shared.Course, len(prereqIDs))
+	for cursor.Next(queryCtx) {
+		var course shared.Course
+		if err := cursor.Decode(&course); err != nil {
+			log.Printf("Error decoding prerequisite course: %v", err)
+			continue
+		}
+		resolved[course.ID] = course
+	}
+	if err := cursor.Err(); err != nil {
+		log.Printf("Cursor error: %v", err)
+		return nil, status.Error(codes.Internal, "error iterating prerequisites")
+	}
+
+	for _, id := range prereqIDs {
+		course := resolved[id]
+		prerequisites = append(prerequisites, &pb.Prerequisite{
+			CourseId:   id,
+			CourseCode: course.Code,
+			Title:      course.Title,
+		})
+	}
+	sort.SliceStable(prerequisites, func(i, j int) bool {
+		return prerequisites[i].CourseCode < prerequisites[j].CourseCode
+	})
+
+	return &pb.GetPrerequisitesResponse{CourseId: req.CourseId, Prerequisites: prerequisites}, nil
+}
+
+// GetEligibleCourses lists the open courses in a semester that the student is not
+// already enrolled in and has not passed, flagging whether each can be taken and why not.
+// The student's record is loaded once so prerequisites are checked in memory rather
+// than with one CheckPrerequisites call per course.
+func (s *CourseService) GetEligibleCourses(ctx context.Context, req *pb.GetEligibleCoursesRequest) (*pb.GetEligibleCoursesResponse, error) {
+	if req == nil || req.StudentId == "" || req.Semester == "" {
+		return nil, status.Error(codes.InvalidArgument, "student_id and semester are required")
+	}
+
+	queryCtx, cancel := context.WithTimeout(ctx, 15*time.Second)
+	defer cancel()
+
+	// 1. Open courses in the semester
+	filter := s.buildCourseFilter(&pb.CourseFilter{
+		Department: req.Department,
+		Semester:   req.Semester,
+		OpenOnly:   true,
+	}, false)
+	cursor, err := s.coursesCol.Find(queryCtx, filter, shared.BuildFindOptions(0, "code", 1))
+	if err != nil {
+		log.Printf("Error querying courses: %v", err)
+		return nil, status.Error(codes.Internal, "failed to retrieve courses")
+	}
+	defer cursor.Close(queryCtx)
+
+	var courses []*pb.Course
+	for cursor.Next(queryCtx) {
+		var doc bson.M
+		if err := cursor.Decode(&doc); err != nil {
+			log.Printf("Error decoding course document: %v", err)
+			continue
+		}
+		course, err := s.documentToCourse(queryCtx, doc)
+		if err != nil {
+			log.Printf("Error converting document to course: %v", err)
+			continue
+		}
+		courses = append(courses, course)
+	}
+	if err := cursor.Err(); err != nil {
+		log.Printf("Cursor error: %v", err)
+		return nil, status.Error(codes.Internal, "error iterating courses")
+	}
+	s.loadPrerequisites(queryCtx, courses)
+
+	// 2. The student's record, shared by every course
+	var prereqIDs []string
+	for _, course := range courses {
+		prereqIDs = append(prereqIDs, course.Prerequisites...)
+	}
+	history, err := s.loadStudentHistory(queryCtx, req.StudentId, prereqIDs)
+	if err != nil {
+		log.Printf("Error loading student history: %v", err)
+		return nil, status.Error(codes.Internal, "failed to load student record")
+	}
+
+	// 3. Evaluate each course
+	resp := &pb.GetEligibleCoursesResponse{Courses: []*pb.EligibleCourse{}}
+	for _, course := range courses {
+		code := strings.ToUpper(course.Code)
+		if history.enrolled[course.Id] || history.enrolledCodes[code] || history.passedCodes[code] {
+			continue
+		}
+
+		entry := history.evaluate(course)
+		if entry.Eligible {
+			resp.EligibleCount++
+		}
+		resp.Courses = append(resp.Courses, entry)
+	}
+
+	sort.SliceStable(resp.Courses, func(i, j int) bool {
+		return resp.Courses[i].Eligible && !resp.Courses[j].Eligible
+	})
+
+	return resp, nil
+}
+
 // GetCourseAvailability checks if a course has available seats
 func (s *CourseService) GetCourseAvailability(ctx context.Context, req *pb.GetCourseAvailabilityRequest) (*pb.GetCourseAvailabilityResponse, error) {
 	if req == nil || req.CourseId == "" {
@@ -237,25 +548,18 @@ func (s *CourseService) GetCourseAvailability(ctx context.Context, req *pb.GetCo
 
 	if err != nil {
 		if err == mongo.ErrNoDocuments {
-			return &pb.GetCourseAvailabilityResponse{
-				Available:      false,
-				Capacity:       0,
-				Enrolled:       0,
-				SeatsRemaining: 0,
-				IsOpen:         false,
-				Message:        fmt.Sprintf("course not found: %s", req.CourseId),
-			}, nil
+			return nil, shared.ErrCourseNotFound.WithParam("course_id", req.CourseId)
 		}
 		log.Printf("Error finding course availability: %v", err)
 		return nil, status.Error(codes.Internal, "failed to check availability")
 	}
 
-	seatsRemaining := course.GetSeatsAvailable()
-	available := course.IsAvailable()
+	seatsRemaining := shared.SeatsAvailable(course.Capacity, course.Enrolled+s.seatsHeld(ctx, course.ID, ""))
+	available := course.IsOpenAt(time.Now()) && seatsRemaining > 0
 
 	message := "course available"
-	if !course.IsOpen {
-		message = "course is closed"
+	if reason := course.ClosedReason(time.Now()); reason != "" {
+		message = reason
 	} else if seatsRemaining == 0 {
 		message = "course is full"
 	}
@@ -270,116 +574,873 @@ func (s *CourseService) GetCourseAvailability(ctx context.Context, req *pb.GetCo
 	}, nil
 }
 
-// ============================================================================
-// Helper Functions (Private to service.go)
-// ============================================================================
-
-// documentToCourse converts a MongoDB document to a protobuf Course message
-func (s *CourseService) documentToCourse(ctx context.Context, doc bson.M) (*pb.Course, error) {
-	course := &pb.Course{}
-
-	// Required fields using shared helpers
-	id, err := shared.GetString(doc["_id"])
-	if err != nil {
-		return nil, fmt.Errorf("missing or invalid _id field")
-	}
-	course.Id = id
+// ListDepartments lists the departments courses can be filtered by
+func (s *CourseService) ListDepartments(ctx context.Context, req *pb.ListDepartmentsRequest) (*pb.ListDepartmentsResponse, error) {
+	queryCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
 
-	code, err := shared.GetString(doc["code"])
+	cursor, err := s.departmentsCol.Find(queryCtx, bson.M{}, options.Find().SetSort(bson.M{"_id": 1}))
 	if err != nil {
-		return nil, fmt.Errorf("missing or invalid code field")
+		log.Printf("Error listing departments: %v", err)
+		return nil, status.Error(codes.Internal, "failed to list departments")
 	}
-	course.Code = code
-
-	title, err := shared.GetString(doc["title"])
-	if err != nil {
-		return nil, fmt.Errorf("missing or invalid title field")
+	var departments []shared.Department
+	if err := cursor.All(queryCtx, &departments); err != nil {
+		return nil, status.Error(codes.Internal, "failed to decode departments")
 	}
-	course.Title = title
 
-	// Optional fields with safe type assertions using shared helpers
-	if description, err := shared.GetString(doc["description"]); err == nil {
-		course.Description = description
+	resp := &pb.ListDepartmentsResponse{Departments: make([]*pb.Department, 0, len(departments))}
+	for _, d := range departments {
+		resp.Departments = append(resp.Departments, &pb.Department{Code: d.Code, Name: d.Name})
 	}
+	return resp, nil
+}
 
-	if units, err := shared.GetInt32(doc["units"]); err == nil {
-		course.Units = units
+// AddCourseMaterial attaches a syllabus or resource link to a course
+func (s *CourseService) AddCourseMaterial(ctx context.Context, req *pb.AddCourseMaterialRequest) (*pb.AddCourseMaterialResponse, error) {
+	if req == nil || req.CourseId == "" || req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "course_id and user_id are required")
 	}
 
-	if schedule, err := shared.GetString(doc["schedule"]); err == nil {
-		course.Schedule = schedule
+	title := strings.TrimSpace(req.Title)
+	if title == "" || len(title) > shared.MaxMaterialTitleLength {
+		return nil, status.Errorf(codes.InvalidArgument, "title is required (max %d characters)", shared.MaxMaterialTitleLength)
 	}
-
-	if room, err := shared.GetString(doc["room"]); err == nil {
-		course.Room = room
+	link := strings.TrimSpace(req.Url)
+	if err := validateMaterialURL(link); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	if capacity, err := shared.GetInt32(doc["capacity"]); err == nil {
-		course.Capacity = capacity
-	}
+	queryCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
 
-	if enrolled, err := shared.GetInt32(doc["enrolled"]); err == nil {
-		course.Enrolled = enrolled
+	if _, err := s.authorizeCourseEditor(queryCtx, req.CourseId, req.UserId, "manage course materials"); err != nil {
+		return nil, err
 	}
 
-	if facultyID, err := shared.GetString(doc["faculty_id"]); err == nil {
-		course.FacultyId = facultyID
-		// Get faculty name (optional)
-		course.FacultyName = s.getFacultyName(ctx, facultyID)
+	material := shared.CourseMaterial{
+		ID:      shared.GenerateID("mat"),
+		Title:   title,
+		URL:     link,
+		AddedAt: time.Now(),
 	}
 
-	if isOpen, err := shared.GetBool(doc["is_open"]); err == nil {
-		course.IsOpen = isOpen
+	// The filter only matches while the list is below the cap, so concurrent adds can't exceed it
+	filter := bson.M{
+		"_id": req.CourseId,
+		fmt.Sprintf("materials.%d", shared.MaxCourseMaterials-1): bson.M{"$exists": false},
 	}
-
-	if semester, err := shared.GetString(doc["semester"]); err == nil {
-		course.Semester = semester
+	update := bson.M{
+		"$push": bson.M{"materials": material},
+		"$set":  bson.M{"updated_at": time.Now()},
 	}
 
-	// Timestamps using shared helper
-	if createdAt, err := shared.GetTime(doc["created_at"]); err == nil {
-		course.CreatedAt = timestamppb.New(createdAt)
+	res, err := s.coursesCol.UpdateOne(queryCtx, filter, update)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to add course material")
 	}
-
-	if updatedAt, err := shared.GetTime(doc["updated_at"]); err == nil {
-		course.UpdatedAt = timestamppb.New(updatedAt)
+	if res.MatchedCount == 0 {
+		return nil, status.Errorf(codes.FailedPrecondition, "course already has the maximum of %d materials", shared.MaxCourseMaterials)
 	}
 
-	// Get prerequisites
-	course.Prerequisites = s.getCoursePrerequisites(ctx, course.Id)
-
-	return course, nil
+	return &pb.AddCourseMaterialResponse{
+		Success:  true,
+		Material: materialToProto(material),
+		Message:  "course material added",
+	}, nil
 }
 
-// getFacultyName retrieves faculty name from users collection
-func (s *CourseService) getFacultyName(ctx context.Context, facultyID string) string {
-	var user shared.User
+// RemoveCourseMaterial detaches a material from a course
+func (s *CourseService) RemoveCourseMaterial(ctx context.Context, req *pb.RemoveCourseMaterialRequest) (*pb.RemoveCourseMaterialResponse, error) {
+	if req == nil || req.CourseId == "" || req.UserId == "" || req.MaterialId == "" {
+		return nil, status.Error(codes.InvalidArgument, "course_id, user_id and material_id are required")
+	}
 
-	queryCtx, cancel := context.WithTimeout(ctx, 2*time.Second)
+	queryCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
 	defer cancel()
 
-	err := s.db.Collection("users").FindOne(queryCtx, bson.M{"_id": facultyID}).Decode(&user)
+	if _, err := s.authorizeCourseEditor(queryCtx, req.CourseId, req.UserId, "manage course materials"); err != nil {
+		return nil, err
+	}
+
+	res, err := s.coursesCol.UpdateOne(queryCtx,
+		bson.M{"_id": req.CourseId, "materials.id": req.MaterialId},
+		bson.M{
+			"$pull": bson.M{"materials": bson.M{"id": req.MaterialId}},
+			"$set":  bson.M{"updated_at": time.Now()},
+		},
+	)
 	if err != nil {
-		log.Printf("Warning: Could not fetch faculty name for %s: %v", facultyID, err)
-		return ""
+		return nil, status.Error(codes.Internal, "failed to remove course material")
+	}
+	if res.MatchedCount == 0 {
+		return nil, status.Errorf(codes.NotFound, "material not found: %s", req.MaterialId)
 	}
 
-	return user.Name
+	return &pb.RemoveCourseMaterialResponse{Success: true, Message: "course material removed"}, nil
 }
 
-// getCoursePrerequisites retrieves prerequisite course IDs
-func (s *CourseService) getCoursePrerequisites(ctx context.Context, courseID string) []string {
-	queryCtx, cancel := context.WithTimeout(ctx, 3*time.Second)
-	defer cancel()
-
-	cursor, err := s.prerequisitesCol.Find(queryCtx, bson.M{"course_id": courseID})
-	if err != nil {
-		log.Printf("Warning: Could not fetch prerequisites for %s: %v", courseID, err)
-		return []string{}
+// SubmitReview records a student's evaluation of a course. The student must own an
+// enrollment in the course that was not dropped and has a published grade, and may
+// review each enrollment once. The review is stored without the student.
+func (s *CourseService) SubmitReview(ctx context.Context, req *pb.SubmitReviewRequest) (*pb.SubmitReviewResponse, error) {
+	if req == nil || req.CourseId == "" || req.StudentId == "" || req.EnrollmentId == "" {
+		return nil, status.Error(codes.InvalidArgument, "course_id, student_id and enrollment_id are required")
+	}
+	ratings := reviewRatingsFromProto(req.Ratings)
+	if err := ratings.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	comment := strings.TrimSpace(req.Comment)
+	if err := shared.ValidateText("comment", comment, shared.MaxReviewCommentLength, true); err != nil {
+		return nil, err
 	}
-	defer cursor.Close(queryCtx)
 
-	var prerequisites []string
+	queryCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	// 1. The enrollment must be the student's own, in this course
+	var enrollment shared.Enrollment
+	if err := s.enrollmentsCol.FindOne(queryCtx, bson.M{"_id": req.EnrollmentId}).Decode(&enrollment); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, shared.ErrNotEnrolled.Newf("enrollment not found")
+		}
+		return nil, status.Error(codes.Internal, "db error")
+	}
+	if enrollment.StudentID != req.StudentId {
+		return nil, status.Error(codes.PermissionDenied, "only the enrolled student can review this course")
+	}
+	if enrollment.CourseID != req.CourseId {
+		return nil, status.Error(codes.InvalidArgument, "enrollment is not for this course")
+	}
+	if enrollment.Status == shared.StatusDropped {
+		return nil, status.Error(codes.FailedPrecondition, "dropped enrollments cannot be reviewed")
+	}
+
+	// 2. Reviews open once the grade is published
+	published, err := s.gradesCol.CountDocuments(queryCtx, bson.M{"enrollment_id": enrollment.ID, "published": true})
+	if err != nil {
+		return nil, status.Error(codes.Internal, "db error")
+	}
+	if published == 0 {
+		return nil, status.Error(codes.FailedPrecondition, "the course can be reviewed once your grade is published")
+	}
+
+	var course shared.Course
+	if err := s.coursesCol.FindOne(queryCtx, bson.M{"_id": enrollment.CourseID}).Decode(&course); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, shared.ErrCourseNotFound.WithParam("course_id", enrollment.CourseID)
+		}
+		return nil, status.Error(codes.Internal, "failed to retrieve course")
+	}
+
+	// 3. One review per enrollment (also enforced by a unique index)
+	existing, err := s.reviewsCol.CountDocuments(queryCtx, bson.M{"enrollment_id": enrollment.ID})
+	if err != nil {
+		return nil, status.Error(codes.Internal, "db error")
+	}
+	if existing > 0 {
+		return nil, shared.ErrAlreadyReviewed.WithParam("enrollment_id", enrollment.ID)
+	}
+
+	review := shared.CourseReview{
+		ID:           shared.GenerateCourseReviewID(),
+		EnrollmentID: enrollment.ID,
+		CourseID:     course.ID,
+		Semester:     course.Semester,
+		Ratings:      ratings,
+		Comment:      comment,
+		SubmittedAt:  time.Now(),
+	}
+	if _, err := s.reviewsCol.InsertOne(queryCtx, review); err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return nil, shared.ErrAlreadyReviewed.WithParam("enrollment_id", enrollment.ID)
+		}
+		log.Printf("Error saving course review for %s: %v", course.ID, err)
+		return nil, status.Error(codes.Internal, "failed to submit review")
+	}
+
+	return &pb.SubmitReviewResponse{Success: true, ReviewId: review.ID, Message: "review submitted"}, nil
+}
+
+// GetCourseReviewSummary averages a course's reviews for its faculty or an admin.
+// Comments are returned to admins only, and never with anything identifying the student.
+func (s *CourseService) GetCourseReviewSummary(ctx context.Context, req *pb.GetCourseReviewSummaryRequest) (*pb.GetCourseReviewSummaryResponse, error) {
+	if req == nil || req.CourseId == "" || req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "course_id and user_id are required")
+	}
+
+	queryCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	user, err := s.authorizeCourseEditor(queryCtx, req.CourseId, req.UserId, "view course reviews")
+	if err != nil {
+		return nil, err
+	}
+
+	filter := bson.M{"course_id": req.CourseId}
+	if req.Semester != "" {
+		filter["semester"] = req.Semester
+	}
+
+	cursor, err := s.reviewsCol.Aggregate(queryCtx, []bson.M{
+		{"$match": filter},
+		{"$group": bson.M{
+			"_id":          nil,
+			"count":        bson.M{"$sum": 1},
+			"content":      bson.M{"$avg": "$ratings.content"},
+			"instruction":  bson.M{"$avg": "$ratings.instruction"},
+			"organization": bson.M{"$avg": "$ratings.organization"},
+			"overall":      bson.M{"$avg": "$ratings.overall"},
+		}},
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to summarize reviews")
+	}
+	var totals []struct {
+		Count        int32   `bson:"count"`
+		Content      float64 `bson:"content"`
+		Instruction  float64 `bson:"instruction"`
+		Organization float64 `bson:"organization"`
+		Overall      float64 `bson:"overall"`
+	}
+	if err := cursor.All(queryCtx, &totals); err != nil {
+		return nil, status.Error(codes.Internal, "failed to summarize reviews")
+	}
+
+	resp := &pb.GetCourseReviewSummaryResponse{CourseId: req.CourseId, Averages: &pb.ReviewAverages{}}
+	if len(totals) > 0 {
+		t := totals[0]
+		resp.ReviewCount = t.Count
+		resp.Averages = &pb.ReviewAverages{
+			Content:      roundRating(t.Content),
+			Instruction:  roundRating(t.Instruction),
+			Organization: roundRating(t.Organization),
+			Overall:      roundRating(t.Overall),
+		}
+	}
+
+	if user.Role != shared.RoleAdmin || resp.ReviewCount == 0 {
+		return resp, nil
+	}
+
+	commentFilter := bson.M{"comment": bson.M{"$gt": ""}}
+	for k, v := range filter {
+		commentFilter[k] = v
+	}
+	findOptions := options.Find().
+		SetSort(bson.D{{Key: "submitted_at", Value: -1}}).
+		SetLimit(maxReviewComments).
+		SetProjection(bson.M{"comment": 1, "semester": 1, "submitted_at": 1})
+	commentCursor, err := s.reviewsCol.Find(queryCtx, commentFilter, findOptions)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to retrieve review comments")
+	}
+	var reviews []shared.CourseReview
+	if err := commentCursor.All(queryCtx, &reviews); err != nil {
+		return nil, status.Error(codes.Internal, "failed to decode review comments")
+	}
+	for _, r := range reviews {
+		resp.Comments = append(resp.Comments, &pb.ReviewComment{
+			Comment:     r.Comment,
+			Semester:    r.Semester,
+			SubmittedAt: shared.ToProtoTime(r.SubmittedAt),
+		})
+	}
+	return resp, nil
+}
+
+// ============================================================================
+// Helper Functions (Private to service.go)
+// ============================================================================
+
+// authorizeCourseEditor allows admins and the faculty assigned to the course;
+// action completes the denial message (e.g. "manage course materials")
+func (s *CourseService) authorizeCourseEditor(ctx context.Context, courseID, userID, action string) (*shared.User, error) {
+	var course shared.Course
+	if err := s.coursesCol.FindOne(ctx, bson.M{"_id": courseID}).Decode(&course); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, shared.Errorf(codes.NotFound, shared.ErrCodeCourseNotFound, "course not found: %s", courseID)
+		}
+		return nil, status.Error(codes.Internal, "failed to retrieve course")
+	}
+
+	var user shared.User
+	if err := s.db.Collection("users").FindOne(ctx, bson.M{"_id": userID}).Decode(&user); err != nil {
+		return nil, status.Error(codes.PermissionDenied, "user not found")
+	}
+
+	if user.Role == shared.RoleAdmin {
+		return &user, nil
+	}
+	if user.Role == shared.RoleFaculty && course.FacultyID == userID {
+		return &user, nil
+	}
+	return nil, shared.NewError(codes.PermissionDenied, shared.ErrCodeNotCourseFaculty, "only the assigned faculty or an admin can "+action)
+}
+
+// reviewRatingsFromProto converts submitted ratings; missing ratings are zero and fail validation
+func reviewRatingsFromProto(r *pb.ReviewRatings) shared.ReviewRatings {
+	if r == nil {
+		return shared.ReviewRatings{}
+	}
+	return shared.ReviewRatings{
+		Content:      r.Content,
+		Instruction:  r.Instruction,
+		Organization: r.Organization,
+		Overall:      r.Overall,
+	}
+}
+
+// roundRating rounds an average rating to two decimals
+func roundRating(v float64) float64 {
+	return math.Round(v*100) / 100
+}
+
+// validateMaterialURL accepts absolute http(s) URLs within the length limit
+func validateMaterialURL(raw string) error {
+	if raw == "" {
+		return fmt.Errorf("url is required")
+	}
+	if len(raw) > shared.MaxMaterialURLLength {
+		return fmt.Errorf("url exceeds %d characters", shared.MaxMaterialURLLength)
+	}
+
+	u, err := url.Parse(raw)
+	if err != nil {
+		return fmt.Errorf("invalid url")
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return fmt.Errorf("url scheme must be http or https")
+	}
+	if u.Host == "" {
+		return fmt.Errorf("url must include a host")
+	}
+	return nil
+}
+
+// decodeMaterials converts the raw materials array of a course document
+func decodeMaterials(v interface{}) []*pb.CourseMaterial {
+	raw, err := bson.Marshal(bson.M{"materials": v})
+	if err != nil {
+		return nil
+	}
+
+	var wrapper struct {
+		Materials []shared.CourseMaterial `bson:"materials"`
+	}
+	if err := bson.Unmarshal(raw, &wrapper); err != nil {
+		log.Printf("Warning: Could not decode course materials: %v", err)
+		return nil
+	}
+
+	materials := make([]*pb.CourseMaterial, 0, len(wrapper.Materials))
+	for _, m := range wrapper.Materials {
+		materials = append(materials, materialToProto(m))
+	}
+	return materials
+}
+
+// materialToProto maps a stored material to the Protobuf message
+func materialToProto(m shared.CourseMaterial) *pb.CourseMaterial {
+	return &pb.CourseMaterial{
+		Id:      m.ID,
+		Title:   m.Title,
+		Url:     m.URL,
+		AddedAt: shared.ToProtoTime(m.AddedAt),
+	}
+}
+
+// EnsureIndexes creates the text index used for full-text course search and the
+// course_reviews indexes (one review per enrollment, and summaries by course)
+func (s *CourseService) EnsureIndexes(ctx context.Context) error {
+	indexCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	_, err := s.coursesCol.Indexes().CreateOne(indexCtx, mongo.IndexModel{
+		Keys:    bson.D{{Key: "title", Value: "text"}, {Key: "description", Value: "text"}},
+		Options: options.Index().SetName("course_text_search").SetWeights(bson.M{"title": 3, "description": 1}),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create course text index: %w", err)
+	}
+
+	_, err = s.coursesCol.Indexes().CreateOne(indexCtx, mongo.IndexModel{
+		Keys:    bson.D{{Key: "schedule_slot.start_minute", Value: 1}, {Key: "schedule_slot.end_minute", Value: 1}},
+		Options: options.Index().SetName("course_schedule_slot"),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create course schedule index: %w", err)
+	}
+
+	_, err = s.reviewsCol.Indexes().CreateMany(indexCtx, []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "enrollment_id", Value: 1}},
+			Options: options.Index().SetName("course_review_enrollment").SetUnique(true),
+		},
+		{
+			Keys:    bson.D{{Key: "course_id", Value: 1}, {Key: "semester", Value: 1}},
+			Options: options.Index().SetName("course_review_course"),
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create course review indexes: %w", err)
+	}
+	return nil
+}
+
+// BackfillScheduleSlots parses the schedule of courses stored before the slot was
+// written at create time, so day and time filters see them. Unscheduled courses
+// and invalid schedules are left without a slot. It returns how many courses
+// were updated.
+func (s *CourseService) BackfillScheduleSlots(ctx context.Context) (int64, error) {
+	backfillCtx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	cursor, err := s.coursesCol.Find(backfillCtx,
+		bson.M{"schedule_slot": bson.M{"$exists": false}, "schedule": bson.M{"$nin": bson.A{"", nil}}},
+		options.Find().SetProjection(bson.M{"schedule": 1}),
+	)
+	if err != nil {
+		return 0, fmt.Errorf("failed to find courses without a schedule slot: %w", err)
+	}
+	var courses []shared.Course
+	if err := cursor.All(backfillCtx, &courses); err != nil {
+		return 0, fmt.Errorf("failed to decode courses without a schedule slot: %w", err)
+	}
+
+	var updated int64
+	for _, c := range courses {
+		slot := shared.NewScheduleSlot(c.Schedule)
+		if slot == nil {
+			continue
+		}
+		res, err := s.coursesCol.UpdateOne(backfillCtx,
+			bson.M{"_id": c.ID, "schedule": c.Schedule},
+			bson.M{"$set": bson.M{"schedule_slot": slot}},
+		)
+		if err != nil {
+			return updated, fmt.Errorf("failed to backfill schedule slot for %s: %w", c.ID, err)
+		}
+		updated += res.ModifiedCount
+	}
+	return updated, nil
+}
+
+// BackfillDepartments sets department on courses stored before it was written at
+// create time, parsing it from the course code, then registers every course
+// department missing from the departments collection so ListDepartments covers
+// the catalog. Codes with no letter segment are left without a department. It
+// returns how many courses were updated.
+func (s *CourseService) BackfillDepartments(ctx context.Context) (int64, error) {
+	backfillCtx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	cursor, err := s.coursesCol.Find(backfillCtx,
+		bson.M{"department": bson.M{"$exists": false}},
+		options.Find().SetProjection(bson.M{"code": 1}),
+	)
+	if err != nil {
+		return 0, fmt.Errorf("failed to find courses without a department: %w", err)
+	}
+	var courses []shared.Course
+	if err := cursor.All(backfillCtx, &courses); err != nil {
+		return 0, fmt.Errorf("failed to decode courses without a department: %w", err)
+	}
+
+	byDepartment := make(map[string][]string)
+	for _, c := range courses {
+		if department := shared.DepartmentFromCode(c.Code); department != "" {
+			byDepartment[department] = append(byDepartment[department], c.ID)
+		}
+	}
+
+	var updated int64
+	for department, ids := range byDepartment {
+		res, err := s.coursesCol.UpdateMany(backfillCtx,
+			bson.M{"_id": bson.M{"$in": ids}, "department": bson.M{"$exists": false}},
+			bson.M{"$set": bson.M{"department": department}},
+		)
+		if err != nil {
+			return updated, fmt.Errorf("failed to backfill department %q: %w", department, err)
+		}
+		updated += res.ModifiedCount
+	}
+
+	inUse, err := s.coursesCol.Distinct(backfillCtx, "department", bson.M{"department": bson.M{"$nin": bson.A{"", nil}}})
+	if err != nil {
+		return updated, fmt.Errorf("failed to list course departments: %w", err)
+	}
+	departmentCodes := make([]string, 0, len(inUse))
+	for _, v := range inUse {
+		if code, ok := v.(string); ok {
+			departmentCodes = append(departmentCodes, code)
+		}
+	}
+	if err := shared.RegisterDepartments(backfillCtx, s.departmentsCol, departmentCodes...); err != nil {
+		return updated, err
+	}
+	return updated, nil
+}
+
+// buildCourseFilter converts the request filters into a MongoDB query.
+// When useText is set, the text_search term is matched against the text index;
+// otherwise it is matched with the same regex used for search_query.
+func (s *CourseService) buildCourseFilter(filters *pb.CourseFilter, useText bool) bson.M {
+	// Cancelled courses are hidden unless asked for
+	filter := bson.M{"cancelled": bson.M{"$ne": true}}
+	if filters == nil {
+		return filter
+	}
+	if filters.IncludeCancelled {
+		delete(filter, "cancelled")
+	}
+
+	// Filter by department (stored on the course, so any code format works)
+	if department := shared.NormalizeDepartment(filters.Department); department != "" {
+		filter["department"] = department
+	}
+
+	// Search query (course code or title)
+	searchQuery := filters.SearchQuery
+	if filters.TextSearch != "" {
+		if useText {
+			filter["$text"] = bson.M{"$search": filters.TextSearch}
+			searchQuery = ""
+		} else if searchQuery == "" {
+			searchQuery = regexp.QuoteMeta(filters.TextSearch)
+		}
+	}
+	if searchQuery != "" {
+		searchRegex := primitive.Regex{
+			Pattern: searchQuery,
+			Options: "i",
+		}
+		filter["$or"] = []bson.M{
+			{"code": searchRegex},
+			{"title": searchRegex},
+		}
+	}
+
+	// Filter by open status
+	if filters.OpenOnly {
+		filter["is_open"] = true
+	}
+
+	// Filter by semester
+	if filters.Semester != "" {
+		filter["semester"] = filters.Semester
+	}
+
+	return filter
+}
+
+// scheduleSlotFilter matches courses whose parsed schedule fits the requested
+// days and time window: every meeting day is one of the days, and the meeting
+// starts and ends within the window. Unscheduled courses never match.
+func scheduleSlotFilter(filters *pb.CourseFilter) (bson.M, error) {
+	filter := bson.M{}
+	if filters == nil {
+		return filter, nil
+	}
+
+	if len(filters.Days) > 0 {
+		days, err := shared.ParseMeetingDays(strings.Join(filters.Days, ","))
+		if err != nil {
+			return nil, err
+		}
+		filter["schedule_slot"] = bson.M{"$exists": true}
+		filter["schedule_slot.days"] = bson.M{"$not": bson.M{"$elemMatch": bson.M{"$nin": days}}}
+	}
+
+	if w := filters.TimeWindow; w != nil && (w.Start != "" || w.End != "") {
+		from, to, err := shared.ParseTimeWindow(w.Start, w.End)
+		if err != nil {
+			return nil, err
+		}
+		filter["schedule_slot.start_minute"] = bson.M{"$gte": from}
+		filter["schedule_slot.end_minute"] = bson.M{"$lte": to}
+	}
+	return filter, nil
+}
+
+// courseSortFields allowlists the ListCourses sort_by values. seats_available is not
+// stored; findCourses computes it from capacity and enrolled.
+var courseSortFields = map[string]bool{
+	"code":            true,
+	"title":           true,
+	"units":           true,
+	"seats_available": true,
+	"enrolled":        true,
+}
+
+// parseCourseSort validates sort_by and sort_order, defaulting to code ascending
+func parseCourseSort(sortBy, sortOrder string) (string, int, error) {
+	field := sortBy
+	if field == "" {
+		field = "code"
+	}
+	if !courseSortFields[field] {
+		return "", 0, shared.ErrInvalidField.Newf("cannot sort courses by %q", sortBy).WithParam("field", "sort_by")
+	}
+
+	switch strings.ToLower(sortOrder) {
+	case "", "asc":
+		return field, 1, nil
+	case "desc":
+		return field, -1, nil
+	default:
+		return "", 0, shared.ErrInvalidField.Newf("sort_order must be asc or desc, got %q", sortOrder).WithParam("field", "sort_order")
+	}
+}
+
+// findCourses runs a catalog query, returning at most 100 courses. Ties break on
+// code so pages come back in a stable order. Sorting by seats_available goes
+// through an aggregation that adds the computed field first.
+func (s *CourseService) findCourses(ctx context.Context, filter bson.M, field string, dir int, rankByScore bool) (*mongo.Cursor, error) {
+	sort := bson.D{{Key: field, Value: dir}}
+	if field != "code" {
+		sort = append(sort, bson.E{Key: "code", Value: 1})
+	}
+
+	if field == "seats_available" {
+		pipeline := mongo.Pipeline{
+			{{Key: "$match", Value: filter}},
+			{{Key: "$addFields", Value: bson.M{"seats_available": bson.M{
+				"$max": bson.A{0, bson.M{"$subtract": bson.A{"$capacity", "$enrolled"}}},
+			}}}},
+			{{Key: "$sort", Value: sort}},
+			{{Key: "$limit", Value: 100}},
+		}
+		return s.coursesCol.Aggregate(ctx, pipeline)
+	}
+
+	findOptions := shared.BuildFindOptions(100, "code", 1)
+	if rankByScore {
+		findOptions.SetProjection(bson.M{"score": bson.M{"$meta": "textScore"}})
+		findOptions.SetSort(bson.D{{Key: "score", Value: bson.M{"$meta": "textScore"}}})
+	} else {
+		findOptions.SetSort(sort)
+	}
+	return s.coursesCol.Find(ctx, filter, findOptions)
+}
+
+// isTextIndexMissing reports whether a query failed because no text index exists
+func isTextIndexMissing(err error) bool {
+	var srvErr mongo.ServerError
+	if errors.As(err, &srvErr) && srvErr.HasErrorCode(27) { // IndexNotFound
+		return true
+	}
+	return strings.Contains(err.Error(), "text index required")
+}
+
+// documentToCourse converts a MongoDB document to a protobuf Course message
+func (s *CourseService) documentToCourse(ctx context.Context, doc bson.M) (*pb.Course, error) {
+	course := &pb.Course{}
+
+	// Required fields using shared helpers
+	id, err := shared.GetString(doc["_id"])
+	if err != nil {
+		return nil, fmt.Errorf("missing or invalid _id field")
+	}
+	course.Id = id
+
+	code, err := shared.GetString(doc["code"])
+	if err != nil {
+		return nil, fmt.Errorf("missing or invalid code field")
+	}
+	course.Code = code
+
+	title, err := shared.GetString(doc["title"])
+	if err != nil {
+		return nil, fmt.Errorf("missing or invalid title field")
+	}
+	course.Title = title
+
+	// Optional fields with safe type assertions using shared helpers
+	if description, err := shared.GetString(doc["description"]); err == nil {
+		course.Description = description
+	}
+
+	if units, err := shared.GetInt32(doc["units"]); err == nil {
+		course.Units = units
+	}
+
+	if schedule, err := shared.GetString(doc["schedule"]); err == nil {
+		course.Schedule = schedule
+	}
+
+	if room, err := shared.GetString(doc["room"]); err == nil {
+		course.Room = room
+	}
+
+	if capacity, err := shared.GetInt32(doc["capacity"]); err == nil {
+		course.Capacity = capacity
+	}
+
+	if enrolled, err := shared.GetInt32(doc["enrolled"]); err == nil {
+		course.Enrolled = enrolled
+	}
+
+	// Seat availability is computed here so every client sees the same numbers
+	course.SeatsAvailable = shared.SeatsAvailable(course.Capacity, course.Enrolled)
+	course.IsFull = course.SeatsAvailable == 0
+
+	if facultyID, err := shared.GetString(doc["faculty_id"]); err == nil {
+		course.FacultyId = facultyID
+		// Get faculty name (optional)
+		course.FacultyName = s.getFacultyName(ctx, facultyID)
+	}
+
+	if isOpen, err := shared.GetBool(doc["is_open"]); err == nil {
+		course.IsOpen = isOpen
+	}
+
+	if semester, err := shared.GetString(doc["semester"]); err == nil {
+		course.Semester = semester
+	}
+	if department, err := shared.GetString(doc["department"]); err == nil {
+		course.Department = department
+	}
+
+	// Optional per-course window; enrollable combines it with is_open
+	openAt, _ := shared.GetTime(doc["enroll_open_at"])
+	closeAt, _ := shared.GetTime(doc["enroll_close_at"])
+	course.EnrollOpenAt = shared.ToProtoTime(openAt)
+	course.EnrollCloseAt = shared.ToProtoTime(closeAt)
+	course.Cancelled, _ = shared.GetBool(doc["cancelled"])
+	course.Enrollable = !course.Cancelled && shared.CourseClosedReason(course.IsOpen, openAt, closeAt, time.Now()) == ""
+
+	// Enrollment restrictions (absent means unrestricted)
+	if majors, err := shared.GetStringArray(doc["allowed_majors"]); err == nil {
+		course.AllowedMajors = majors
+	}
+	if minYear, err := shared.GetInt32(doc["min_year_level"]); err == nil {
+		course.MinYearLevel = minYear
+	}
+
+	// Present only when the query ranked by text score
+	if score, ok := doc["score"].(float64); ok {
+		course.Score = score
+	}
+
+	// Timestamps using shared helper
+	// Missing or zero times are left nil
+	createdAt, _ := shared.GetTime(doc["created_at"])
+	course.CreatedAt = shared.ToProtoTime(createdAt)
+	updatedAt, _ := shared.GetTime(doc["updated_at"])
+	course.UpdatedAt = shared.ToProtoTime(updatedAt)
+
+	if materials, ok := doc["materials"]; ok && materials != nil {
+		course.Materials = decodeMaterials(materials)
+	}
+
+	return course, nil
+}
+
+// seatsHeld counts the active seat holds on a course, leaving out exceptStudentID's
+// when set, or 0 while seat holds are off. A failed count is logged and treated as
+// no holds, since EnrollAll rechecks seats.
+func (s *CourseService) seatsHeld(ctx context.Context, courseID, exceptStudentID string) int32 {
+	if shared.GetSeatHoldDuration(ctx, s.systemConfigCol) == 0 {
+		return 0
+	}
+	held, err := shared.CountSeatHolds(ctx, s.seatHoldsCol, courseID, exceptStudentID, time.Now())
+	if err != nil {
+		log.Printf("Warning: failed to count seat holds for %s: %v", courseID, err)
+		return 0
+	}
+	return held
+}
+
+// subtractSeatHolds takes the seats held in carts off each course's seats_available
+// with one count for the whole page, as getCourse does for a single course. A failed
+// count is logged and leaves the seats as they are, since EnrollAll rechecks seats.
+func (s *CourseService) subtractSeatHolds(ctx context.Context, courses []*pb.Course) {
+	if len(courses) == 0 || shared.GetSeatHoldDuration(ctx, s.systemConfigCol) == 0 {
+		return
+	}
+	courseIDs := make([]string, 0, len(courses))
+	for _, c := range courses {
+		courseIDs = append(courseIDs, c.Id)
+	}
+	held, err := shared.CountSeatHoldsByCourse(ctx, s.seatHoldsCol, courseIDs, "", time.Now())
+	if err != nil {
+		log.Printf("Warning: failed to count seat holds: %v", err)
+		return
+	}
+	for _, c := range courses {
+		if n := held[c.Id]; n > 0 {
+			c.SeatsHeld = n
+			c.SeatsAvailable = shared.SeatsAvailable(c.Capacity, c.Enrolled+n)
+			c.IsFull = c.SeatsAvailable == 0
+		}
+	}
+}
+
+// getFacultyName retrieves faculty name from users collection
+func (s *CourseService) getFacultyName(ctx context.Context, facultyID string) string {
+	var user shared.User
+
+	queryCtx, cancel := context.WithTimeout(ctx, 2*time.Second)
+	defer cancel()
+
+	err := s.db.Collection("users").FindOne(queryCtx, bson.M{"_id": facultyID}).Decode(&user)
+	if err != nil {
+		log.Printf("Warning: Could not fetch faculty name for %s: %v", facultyID, err)
+		return ""
+	}
+
+	return user.Name
+}
+
+// loadPrerequisites fills in the prerequisite course IDs of every course with one
+// query. A failed query is logged and leaves the prerequisites empty.
+func (s *CourseService) loadPrerequisites(ctx context.Context, courses []*pb.Course) {
+	if len(courses) == 0 {
+		return
+	}
+	byID := make(map[string]*pb.Course, len(courses))
+	courseIDs := make([]string, 0, len(courses))
+	for _, c := range courses {
+		byID[c.Id] = c
+		courseIDs = append(courseIDs, c.Id)
+	}
+
+	queryCtx, cancel := context.WithTimeout(ctx, 3*time.Second)
+	defer cancel()
+
+	cursor, err := s.prerequisitesCol.Find(queryCtx, bson.M{"course_id": bson.M{"$in": courseIDs}})
+	if err != nil {
+		log.Printf("Warning: Could not fetch prerequisites: %v", err)
+		return
+	}
+	defer cursor.Close(queryCtx)
+
+	for cursor.Next(queryCtx) {
+		var prereq shared.Prerequisite
+		if err := cursor.Decode(&prereq); err != nil {
+			continue
+		}
+		if c := byID[prereq.CourseID]; c != nil {
+			c.Prerequisites = append(c.Prerequisites, prereq.PrereqID)
+		}
+	}
+}
+
+// getCoursePrerequisites retrieves prerequisite course IDs
+func (s *CourseService) getCoursePrerequisites(ctx context.Context, courseID string) []string {
+	queryCtx, cancel := context.WithTimeout(ctx, 3*time.Second)
+	defer cancel()
+
+	cursor, err := s.prerequisitesCol.Find(queryCtx, bson.M{"course_id": courseID})
+	if err != nil {
+		log.Printf("Warning: Could not fetch prerequisites for %s: %v", courseID, err)
+		return []string{}
+	}
+	defer cursor.Close(queryCtx)
+
+	var prerequisites []string
 	for cursor.Next(queryCtx) {
 		var prereq shared.Prerequisite
 		if err := cursor.Decode(&prereq); err != nil {
@@ -415,7 +1476,7 @@ func (s *CourseService) checkSinglePrerequisite(ctx context.Context, studentID,
 
 	if err != nil {
 		if err == mongo.ErrNoDocuments {
-			return prereqStatus // Not completed
+			return s.applyTransferCredit(ctx, studentID, prereqStatus) // Not completed here
 		}
 		log.Printf("Error checking enrollment for prerequisite: %v", err)
 		return prereqStatus
@@ -430,7 +1491,7 @@ func (s *CourseService) checkSinglePrerequisite(ctx context.Context, studentID,
 
 	if err != nil {
 		if err == mongo.ErrNoDocuments {
-			return prereqStatus // Grade not published yet
+			return s.applyTransferCredit(ctx, studentID, prereqStatus) // Grade not published yet
 		}
 		log.Printf("Error checking grade for prerequisite: %v", err)
 		return prereqStatus
@@ -441,7 +1502,224 @@ func (s *CourseService) checkSinglePrerequisite(ctx context.Context, studentID,
 	// Check if grade is passing using shared helper
 	if shared.IsPassingGrade(grade.Grade) {
 		prereqStatus.Met = true
+		prereqStatus.MetVia = "completed"
+		return prereqStatus
+	}
+
+	return s.applyTransferCredit(ctx, studentID, prereqStatus)
+}
+
+// isCurrentlyEnrolled reports whether the student is actively enrolled in a course
+func (s *CourseService) isCurrentlyEnrolled(ctx context.Context, studentID, courseID string) bool {
+	err := s.enrollmentsCol.FindOne(ctx, bson.M{
+		"student_id": studentID,
+		"course_id":  courseID,
+		"status":     shared.StatusEnrolled,
+	}).Err()
+	if err != nil && err != mongo.ErrNoDocuments {
+		log.Printf("Error checking in-progress enrollment for prerequisite: %v", err)
+	}
+	return err == nil
+}
+
+// studentHistory is the part of a student's record that decides course eligibility,
+// loaded in a fixed number of queries so many courses can be checked in memory
+type studentHistory struct {
+	major     string
+	yearLevel int32
+
+	enrolled      map[string]bool   // course IDs with an active enrollment
+	enrolledCodes map[string]bool   // codes of courses with an active enrollment
+	grades        map[string]string // course ID -> published grade of a completed enrollment
+	passedCodes   map[string]bool   // codes of courses passed in any semester
+	transferCodes map[string]bool   // codes covered by transfer credit
+	codes         map[string]string // course ID -> code for enrolled courses and prerequisites
+}
+
+// loadStudentHistory reads the student's profile, enrollments, published grades and
+// transfer credits, plus the codes of the given prerequisite courses
+func (s *CourseService) loadStudentHistory(ctx context.Context, studentID string, prereqIDs []string) (*studentHistory, error) {
+	h := &studentHistory{
+		enrolled:      map[string]bool{},
+		enrolledCodes: map[string]bool{},
+		grades:        map[string]string{},
+		passedCodes:   map[string]bool{},
+		transferCodes: map[string]bool{},
+		codes:         map[string]string{},
+	}
+
+	// Profile for course restrictions (a missing profile fails restricted courses)
+	var user shared.User
+	err := s.db.Collection("users").FindOne(ctx, bson.M{"$or": []bson.M{{"student_id": studentID}, {"_id": studentID}}}).Decode(&user)
+	if err != nil && err != mongo.ErrNoDocuments {
+		return nil, err
+	}
+	h.major, h.yearLevel = user.Major, user.YearLevel
+
+	// Active and completed enrollments
+	var enrollments []shared.Enrollment
+	cursor, err := s.enrollmentsCol.Find(ctx, bson.M{
+		"student_id": studentID,
+		"status":     bson.M{"$in": []string{shared.StatusEnrolled, shared.StatusCompleted}},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := cursor.All(ctx, &enrollments); err != nil {
+		return nil, err
+	}
+
+	courseIDs := append([]string{}, prereqIDs...)
+	completed := map[string]string{} // enrollment ID -> course ID
+	var completedIDs []string
+	for _, e := range enrollments {
+		courseIDs = append(courseIDs, e.CourseID)
+		if e.Status == shared.StatusEnrolled {
+			h.enrolled[e.CourseID] = true
+		} else {
+			completed[e.ID] = e.CourseID
+			completedIDs = append(completedIDs, e.ID)
+		}
+	}
+
+	// Course codes, so other offerings of the same course are recognized
+	if len(courseIDs) > 0 {
+		var docs []shared.Course
+		cursor, err := s.coursesCol.Find(ctx, bson.M{"_id": bson.M{"$in": courseIDs}},
+			options.Find().SetProjection(bson.M{"code": 1}))
+		if err != nil {
+			return nil, err
+		}
+		if err := cursor.All(ctx, &docs); err != nil {
+			return nil, err
+		}
+		for _, c := range docs {
+			h.codes[c.ID] = strings.ToUpper(c.Code)
+		}
+	}
+	for courseID := range h.enrolled {
+		if code := h.codes[courseID]; code != "" {
+			h.enrolledCodes[code] = true
+		}
+	}
+
+	// Published grades of completed enrollments; a passing grade wins over a failed attempt
+	if len(completedIDs) > 0 {
+		var grades []shared.Grade
+		cursor, err := s.gradesCol.Find(ctx, bson.M{"enrollment_id": bson.M{"$in": completedIDs}, "published": true})
+		if err != nil {
+			return nil, err
+		}
+		if err := cursor.All(ctx, &grades); err != nil {
+			return nil, err
+		}
+		for _, g := range grades {
+			courseID := completed[g.EnrollmentID]
+			if shared.IsPassingGrade(g.Grade) {
+				h.grades[courseID] = g.Grade
+				if code := h.codes[courseID]; code != "" {
+					h.passedCodes[code] = true
+				}
+			} else if _, ok := h.grades[courseID]; !ok {
+				h.grades[courseID] = g.Grade
+			}
+		}
+	}
+
+	// Transfer credits
+	var credits []shared.TransferCredit
+	cursor, err = s.transferCreditsCol.Find(ctx, bson.M{"student_id": studentID})
+	if err != nil {
+		return nil, err
+	}
+	if err := cursor.All(ctx, &credits); err != nil {
+		return nil, err
+	}
+	for _, c := range credits {
+		h.transferCodes[strings.ToUpper(c.CourseCode)] = true
+	}
+
+	return h, nil
+}
+
+// prerequisiteStatus mirrors checkSinglePrerequisite using the loaded history,
+// also flagging prerequisites the student is currently taking
+func (h *studentHistory) prerequisiteStatus(prereqID string) *pb.PrerequisiteStatus {
+	st := &pb.PrerequisiteStatus{CourseId: prereqID, CourseCode: h.codes[prereqID], Grade: h.grades[prereqID]}
+	switch {
+	case shared.IsPassingGrade(st.Grade):
+		st.Met, st.MetVia = true, "completed"
+	case st.CourseCode != "" && h.transferCodes[st.CourseCode]:
+		st.Met, st.MetVia = true, "transfer"
+	default:
+		st.InProgress = h.enrolled[prereqID]
+	}
+	return st
+}
+
+// evaluate decides whether the student can take the course, giving the first
+// reason they cannot: restrictions, then missing prerequisites, then seats
+func (h *studentHistory) evaluate(course *pb.Course) *pb.EligibleCourse {
+	entry := &pb.EligibleCourse{Course: course, Prerequisites: []*pb.PrerequisiteStatus{}}
+
+	var missing, inProgress []string
+	for _, prereqID := range course.Prerequisites {
+		st := h.prerequisiteStatus(prereqID)
+		entry.Prerequisites = append(entry.Prerequisites, st)
+		if st.Met {
+			continue
+		}
+		label := st.CourseCode
+		if label == "" {
+			label = prereqID
+		}
+		if st.InProgress {
+			inProgress = append(inProgress, label)
+		} else {
+			missing = append(missing, label)
+		}
+	}
+
+	limits := shared.Course{
+		AllowedMajors: course.AllowedMajors,
+		MinYearLevel:  course.MinYearLevel,
+		Capacity:      course.Capacity,
+		Enrolled:      course.Enrolled,
+	}
+	switch violation := limits.RestrictionViolation(h.major, h.yearLevel); {
+	case violation != "":
+		entry.Reason = violation
+	case len(missing) > 0:
+		entry.Reason = "missing prerequisites: " + strings.Join(missing, ", ")
+	case len(inProgress) > 0:
+		entry.Reason = "prerequisites still in progress: " + strings.Join(inProgress, ", ")
+	case limits.GetSeatsAvailable() <= 0:
+		entry.Reason = "course is full"
+	default:
+		entry.Eligible = true
+	}
+	return entry
+}
+
+// applyTransferCredit marks a prerequisite as met when the student holds
+// transfer credit for the same course code
+func (s *CourseService) applyTransferCredit(ctx context.Context, studentID string, prereqStatus *pb.PrerequisiteStatus) *pb.PrerequisiteStatus {
+	if prereqStatus.CourseCode == "" {
+		return prereqStatus
+	}
+
+	err := s.transferCreditsCol.FindOne(ctx, bson.M{
+		"student_id":  studentID,
+		"course_code": strings.ToUpper(prereqStatus.CourseCode),
+	}).Err()
+	if err != nil {
+		if err != mongo.ErrNoDocuments {
+			log.Printf("Error checking transfer credit for prerequisite: %v", err)
+		}
+		return prereqStatus
 	}
 
+	prereqStatus.Met = true
+	prereqStatus.MetVia = "transfer"
 	return prereqStatus
 }
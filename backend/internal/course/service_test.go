@@ -2,13 +2,22 @@ package course
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"net"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/joho/godotenv"
+	"go.mongodb.org/mongo-driver/event"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
 	"google.golang.org/grpc/test/bufconn"
 
 	pb "stdiscm_p4/backend/internal/pb/course"
@@ -29,7 +38,9 @@ func initServer() *grpc.Server {
 	lis = bufconn.Listen(bufSize)
 	s := grpc.NewServer()
 
+	// Subtests write courses straight to the database, so this server never caches the catalog
 	courseService := NewCourseService(db)
+	courseService.catalog = newCatalogCache(0)
 	pb.RegisterCourseServiceServer(s, courseService)
 
 	go func() {
@@ -104,6 +115,112 @@ func TestCourseService_Integration(t *testing.T) {
 		if !resp.Success || resp.Course.Title != "Test Course" {
 			t.Error("Failed to retrieve correct course details")
 		}
+
+		_, err = client.GetCourse(ctx, &pb.GetCourseRequest{CourseId: "NO-SUCH-COURSE"})
+		if shared.ErrorCodeOf(err) != shared.ErrCodeCourseNotFound {
+			t.Errorf("Expected COURSE_NOT_FOUND for a missing course, got %v", err)
+		}
+	})
+
+	t.Run("Get Course Detail", func(t *testing.T) {
+		students := []string{"DETAIL-NONE", "DETAIL-CART", "DETAIL-ENROLLED", "DETAIL-COMPLETED"}
+		db.Collection("carts").InsertOne(ctx, shared.Cart{StudentID: "DETAIL-CART", CourseIDs: []string{testCourseID}, UpdatedAt: time.Now()})
+		db.Collection("enrollments").InsertMany(ctx, []interface{}{
+			shared.Enrollment{ID: "ENR-DETAIL-1", StudentID: "DETAIL-ENROLLED", CourseID: testCourseID, Status: shared.StatusEnrolled, EnrolledAt: time.Now()},
+			shared.Enrollment{ID: "ENR-DETAIL-2", StudentID: "DETAIL-COMPLETED", CourseID: testCourseID, Status: shared.StatusCompleted, EnrolledAt: time.Now()},
+			// A dropped enrollment is neither enrolled nor completed
+			shared.Enrollment{ID: "ENR-DETAIL-3", StudentID: "DETAIL-NONE", CourseID: testCourseID, Status: shared.StatusDropped, EnrolledAt: time.Now()},
+		})
+		defer func() {
+			db.Collection("carts").DeleteMany(ctx, map[string]interface{}{"student_id": map[string]interface{}{"$in": students}})
+			db.Collection("enrollments").DeleteMany(ctx, map[string]interface{}{"student_id": map[string]interface{}{"$in": students}})
+		}()
+
+		// Without a student the course comes back unannotated
+		resp, err := client.GetCourseDetail(ctx, &pb.GetCourseDetailRequest{CourseId: testCourseID})
+		if err != nil {
+			t.Fatalf("GetCourseDetail failed: %v", err)
+		}
+		if resp.Course.GetTitle() != "Test Course" || resp.StudentStatus != nil {
+			t.Errorf("Expected the course without a student status, got %+v", resp)
+		}
+
+		for _, tc := range []struct {
+			studentID                   string
+			inCart, enrolled, completed bool
+		}{
+			{"DETAIL-NONE", false, false, false},
+			{"DETAIL-CART", true, false, false},
+			{"DETAIL-ENROLLED", false, true, false},
+			{"DETAIL-COMPLETED", false, false, true},
+		} {
+			resp, err := client.GetCourseDetail(ctx, &pb.GetCourseDetailRequest{CourseId: testCourseID, StudentId: tc.studentID})
+			if err != nil {
+				t.Fatalf("GetCourseDetail for %s failed: %v", tc.studentID, err)
+			}
+			st := resp.StudentStatus
+			if st == nil || st.InCart != tc.inCart || st.Enrolled != tc.enrolled || st.Completed != tc.completed {
+				t.Errorf("%s: expected in_cart=%v enrolled=%v completed=%v, got %+v", tc.studentID, tc.inCart, tc.enrolled, tc.completed, st)
+			}
+		}
+
+		_, err = client.GetCourseDetail(ctx, &pb.GetCourseDetailRequest{CourseId: "NO-SUCH-COURSE", StudentId: "DETAIL-CART"})
+		if shared.ErrorCodeOf(err) != shared.ErrCodeCourseNotFound {
+			t.Errorf("Expected COURSE_NOT_FOUND for a missing course, got %v", err)
+		}
+	})
+
+	t.Run("Course Detail Counts Own Seat Hold", func(t *testing.T) {
+		const holdCourseID, holderID = "CS-TEST-HELD", "DETAIL-HOLDER"
+		db.Collection("courses").InsertOne(ctx, shared.Course{
+			ID: holdCourseID, Code: "CSH101", Title: "Held Seat", Units: 3, Capacity: 2, Enrolled: 1, IsOpen: true, Semester: "Fall 2045",
+		})
+		configCol := db.Collection("system_config")
+		configCol.UpdateOne(ctx,
+			map[string]interface{}{"key": shared.ConfigSeatHoldsEnabled},
+			map[string]interface{}{"$set": map[string]interface{}{"value": "true"}},
+			options.Update().SetUpsert(true),
+		)
+		db.Collection("seat_holds").InsertOne(ctx, shared.SeatHold{ID: "HOLD-DETAIL", CourseID: holdCourseID, StudentID: holderID, ExpiresAt: time.Now().Add(time.Hour)})
+		defer func() {
+			db.Collection("courses").DeleteOne(ctx, map[string]interface{}{"_id": holdCourseID})
+			db.Collection("seat_holds").DeleteMany(ctx, map[string]interface{}{"course_id": holdCourseID})
+			configCol.DeleteOne(ctx, map[string]interface{}{"key": shared.ConfigSeatHoldsEnabled})
+		}()
+
+		// Everyone else sees the last seat taken
+		resp, err := client.GetCourse(ctx, &pb.GetCourseRequest{CourseId: holdCourseID})
+		if err != nil || resp.Course.SeatsAvailable != 0 || !resp.Course.IsFull {
+			t.Fatalf("Expected the held seat to be unavailable, got %+v (err %v)", resp.GetCourse(), err)
+		}
+		other, err := client.GetCourseDetail(ctx, &pb.GetCourseDetailRequest{CourseId: holdCourseID, StudentId: "DETAIL-NONE"})
+		if err != nil || other.Course.SeatsAvailable != 0 {
+			t.Errorf("Expected another student to see no seats, got %+v (err %v)", other.GetCourse(), err)
+		}
+		list, err := client.ListCourses(ctx, &pb.ListCoursesRequest{Live: true, Filters: &pb.CourseFilter{Semester: "Fall 2045"}})
+		if err != nil || len(list.Courses) != 1 || list.Courses[0].SeatsAvailable != 0 || list.Courses[0].SeatsHeld != 1 {
+			t.Errorf("Expected the catalog to count the held seat, got %+v (err %v)", list.GetCourses(), err)
+		}
+
+		// The holder sees the seat as theirs
+		detail, err := client.GetCourseDetail(ctx, &pb.GetCourseDetailRequest{CourseId: holdCourseID, StudentId: holderID})
+		if err != nil || detail.Course.SeatsAvailable != 1 || detail.Course.IsFull {
+			t.Errorf("Expected the holder to see 1 seat, got %+v (err %v)", detail.GetCourse(), err)
+		}
+	})
+
+	// --- 2b. Batch Get Courses ---
+	t.Run("Batch Get Courses", func(t *testing.T) {
+		resp, err := client.BatchGetCourses(ctx, &pb.BatchGetCoursesRequest{CourseIds: []string{testCourseID, "CS-TEST-MISSING"}})
+		if err != nil {
+			t.Fatalf("BatchGetCourses failed: %v", err)
+		}
+		if len(resp.Courses) != 1 || resp.Courses[0].Id != testCourseID {
+			t.Errorf("Expected only %s, got %v", testCourseID, resp.Courses)
+		}
+		if len(resp.MissingIds) != 1 || resp.MissingIds[0] != "CS-TEST-MISSING" {
+			t.Errorf("Expected CS-TEST-MISSING reported missing, got %v", resp.MissingIds)
+		}
 	})
 
 	// --- 3. Get Availability ---
@@ -115,6 +232,11 @@ func TestCourseService_Integration(t *testing.T) {
 		if !resp.Available || resp.SeatsRemaining != 30 {
 			t.Error("Incorrect availability calculation")
 		}
+
+		_, err = client.GetCourseAvailability(ctx, &pb.GetCourseAvailabilityRequest{CourseId: "CS-TEST-MISSING"})
+		if status.Code(err) != codes.NotFound || shared.ErrorCodeOf(err) != shared.ErrCodeCourseNotFound {
+			t.Errorf("Expected %s for an unknown course, got %v", shared.ErrCodeCourseNotFound, err)
+		}
 	})
 
 	// --- 4. Check Prerequisites (No prereqs case) ---
@@ -130,4 +252,695 @@ func TestCourseService_Integration(t *testing.T) {
 			t.Error("Should meet prereqs for course with no prereqs")
 		}
 	})
+
+	// --- 5. Full-Text Search ---
+	t.Run("Full-Text Search", func(t *testing.T) {
+		svc := NewCourseService(db)
+		if err := svc.EnsureIndexes(ctx); err != nil {
+			t.Fatalf("EnsureIndexes failed: %v", err)
+		}
+
+		textCourseID := "CS-TEST-480"
+		db.Collection("courses").DeleteOne(ctx, map[string]interface{}{"_id": textCourseID})
+		defer db.Collection("courses").DeleteOne(ctx, map[string]interface{}{"_id": textCourseID})
+		db.Collection("courses").InsertOne(ctx, shared.Course{
+			ID: textCourseID, Code: "CS-480", Title: "Special Topics",
+			Description: "An introduction to machine learning models",
+			Units:       3, Capacity: 30, IsOpen: true, Semester: "TestSem",
+		})
+
+		resp, err := client.ListCourses(ctx, &pb.ListCoursesRequest{
+			Filters: &pb.CourseFilter{Semester: "TestSem", TextSearch: "machine learning"},
+		})
+		if err != nil {
+			t.Fatalf("ListCourses failed: %v", err)
+		}
+		if len(resp.Courses) == 0 || resp.Courses[0].Id != textCourseID {
+			t.Fatal("Expected description match to be ranked first")
+		}
+		if resp.Courses[0].Score <= 0 {
+			t.Error("Expected a positive relevance score")
+		}
+	})
+	// --- 6. Course Materials ---
+	t.Run("Course Materials", func(t *testing.T) {
+		facultyID := "course_test_faculty_001"
+		otherFacultyID := "course_test_faculty_002"
+		materialsCourseID := "CS-TEST-MAT"
+		usersCol := db.Collection("users")
+		for _, u := range []shared.User{
+			{ID: facultyID, Email: "course_fac1@test.com", Role: shared.RoleFaculty, Name: "Course Faculty", IsActive: true},
+			{ID: otherFacultyID, Email: "course_fac2@test.com", Role: shared.RoleFaculty, Name: "Other Faculty", IsActive: true},
+		} {
+			usersCol.DeleteOne(ctx, map[string]interface{}{"_id": u.ID})
+			defer usersCol.DeleteOne(ctx, map[string]interface{}{"_id": u.ID})
+			usersCol.InsertOne(ctx, u)
+		}
+		db.Collection("courses").DeleteOne(ctx, map[string]interface{}{"_id": materialsCourseID})
+		defer db.Collection("courses").DeleteOne(ctx, map[string]interface{}{"_id": materialsCourseID})
+		db.Collection("courses").InsertOne(ctx, shared.Course{
+			ID: materialsCourseID, Code: "CS-MAT", Title: "Materials Course",
+			Units: 3, Capacity: 30, IsOpen: true, Semester: "TestSem", FacultyID: facultyID,
+		})
+
+		addResp, err := client.AddCourseMaterial(ctx, &pb.AddCourseMaterialRequest{
+			CourseId: materialsCourseID, UserId: facultyID, Title: "Syllabus", Url: "https://example.com/syllabus.pdf",
+		})
+		if err != nil {
+			t.Fatalf("AddCourseMaterial failed: %v", err)
+		}
+
+		// Scheme whitelist
+		_, err = client.AddCourseMaterial(ctx, &pb.AddCourseMaterialRequest{
+			CourseId: materialsCourseID, UserId: facultyID, Title: "Bad", Url: "javascript:alert(1)",
+		})
+		if status.Code(err) != codes.InvalidArgument {
+			t.Errorf("Expected InvalidArgument for non-http URL, got %v", err)
+		}
+
+		// Only the assigned faculty (or an admin)
+		_, err = client.AddCourseMaterial(ctx, &pb.AddCourseMaterialRequest{
+			CourseId: materialsCourseID, UserId: otherFacultyID, Title: "Notes", Url: "https://example.com/notes",
+		})
+		if status.Code(err) != codes.PermissionDenied {
+			t.Errorf("Expected PermissionDenied for unassigned faculty, got %v", err)
+		}
+
+		getResp, err := client.GetCourse(ctx, &pb.GetCourseRequest{CourseId: materialsCourseID})
+		if err != nil || len(getResp.Course.Materials) != 1 || getResp.Course.Materials[0].Url != "https://example.com/syllabus.pdf" {
+			t.Fatalf("Expected material in GetCourse, got %v (err %v)", getResp.GetCourse().GetMaterials(), err)
+		}
+
+		_, err = client.RemoveCourseMaterial(ctx, &pb.RemoveCourseMaterialRequest{
+			CourseId: materialsCourseID, UserId: facultyID, MaterialId: addResp.Material.Id,
+		})
+		if err != nil {
+			t.Fatalf("RemoveCourseMaterial failed: %v", err)
+		}
+		_, err = client.RemoveCourseMaterial(ctx, &pb.RemoveCourseMaterialRequest{
+			CourseId: materialsCourseID, UserId: facultyID, MaterialId: addResp.Material.Id,
+		})
+		if status.Code(err) != codes.NotFound {
+			t.Errorf("Expected NotFound for removed material, got %v", err)
+		}
+	})
+
+	t.Run("Course Reviews", func(t *testing.T) {
+		facultyID := "course_test_review_faculty"
+		adminID := "course_test_review_admin"
+		studentID := "course_test_review_student"
+		reviewCourseID := "CS-TEST-REV"
+		gradedEnrollment := "ENR-COURSE-REV-1"
+		ungradedEnrollment := "ENR-COURSE-REV-2"
+
+		usersCol := db.Collection("users")
+		for _, u := range []shared.User{
+			{ID: facultyID, Email: "course_rev_fac@test.com", Role: shared.RoleFaculty, Name: "Review Faculty", IsActive: true},
+			{ID: adminID, Email: "course_rev_admin@test.com", Role: shared.RoleAdmin, Name: "Review Admin", IsActive: true},
+		} {
+			usersCol.DeleteOne(ctx, map[string]interface{}{"_id": u.ID})
+			defer usersCol.DeleteOne(ctx, map[string]interface{}{"_id": u.ID})
+			usersCol.InsertOne(ctx, u)
+		}
+		db.Collection("courses").DeleteOne(ctx, map[string]interface{}{"_id": reviewCourseID})
+		defer db.Collection("courses").DeleteOne(ctx, map[string]interface{}{"_id": reviewCourseID})
+		db.Collection("courses").InsertOne(ctx, shared.Course{
+			ID: reviewCourseID, Code: "CS-REV", Title: "Reviewed Course",
+			Units: 3, Capacity: 30, IsOpen: true, Semester: "TestSem", FacultyID: facultyID,
+		})
+		enrollmentIDs := []string{gradedEnrollment, ungradedEnrollment}
+		byEnrollment := map[string]interface{}{"enrollment_id": map[string]interface{}{"$in": enrollmentIDs}}
+		cleanup := func() {
+			db.Collection("enrollments").DeleteMany(ctx, map[string]interface{}{"_id": map[string]interface{}{"$in": enrollmentIDs}})
+			db.Collection("grades").DeleteMany(ctx, byEnrollment)
+			db.Collection("course_reviews").DeleteMany(ctx, map[string]interface{}{"course_id": reviewCourseID})
+		}
+		cleanup()
+		defer cleanup()
+		db.Collection("enrollments").InsertOne(ctx, shared.Enrollment{ID: gradedEnrollment, StudentID: studentID, CourseID: reviewCourseID, Status: shared.StatusEnrolled})
+		db.Collection("enrollments").InsertOne(ctx, shared.Enrollment{ID: ungradedEnrollment, StudentID: studentID, CourseID: reviewCourseID, Status: shared.StatusEnrolled})
+		db.Collection("grades").InsertOne(ctx, shared.Grade{EnrollmentID: gradedEnrollment, Grade: shared.GradeA, Published: true})
+		db.Collection("grades").InsertOne(ctx, shared.Grade{EnrollmentID: ungradedEnrollment, Grade: shared.GradeB, Published: false})
+
+		ratings := &pb.ReviewRatings{Content: 4, Instruction: 5, Organization: 3, Overall: 4}
+
+		// Ratings are range-checked before anything is looked up
+		_, err := client.SubmitReview(ctx, &pb.SubmitReviewRequest{
+			CourseId: reviewCourseID, StudentId: studentID, EnrollmentId: gradedEnrollment,
+			Ratings: &pb.ReviewRatings{Content: 6, Instruction: 5, Organization: 3, Overall: 4},
+		})
+		if status.Code(err) != codes.InvalidArgument {
+			t.Errorf("Expected InvalidArgument for an out-of-range rating, got %v", err)
+		}
+
+		// Not until the grade is published
+		_, err = client.SubmitReview(ctx, &pb.SubmitReviewRequest{
+			CourseId: reviewCourseID, StudentId: studentID, EnrollmentId: ungradedEnrollment, Ratings: ratings,
+		})
+		if status.Code(err) != codes.FailedPrecondition {
+			t.Errorf("Expected FailedPrecondition for an unpublished grade, got %v", err)
+		}
+
+		// Only the enrolled student
+		_, err = client.SubmitReview(ctx, &pb.SubmitReviewRequest{
+			CourseId: reviewCourseID, StudentId: "someone_else", EnrollmentId: gradedEnrollment, Ratings: ratings,
+		})
+		if status.Code(err) != codes.PermissionDenied {
+			t.Errorf("Expected PermissionDenied for another student's enrollment, got %v", err)
+		}
+
+		// The comment limit counts characters, not bytes
+		_, err = client.SubmitReview(ctx, &pb.SubmitReviewRequest{
+			CourseId: reviewCourseID, StudentId: studentID, EnrollmentId: gradedEnrollment,
+			Ratings: ratings, Comment: strings.Repeat("a", shared.MaxReviewCommentLength+1),
+		})
+		if shared.ErrorCodeOf(err) != shared.ErrCodeInvalidField {
+			t.Errorf("Expected INVALID_FIELD for an overlong comment, got %v", err)
+		}
+		comment := "Great labs. " + strings.Repeat("é", shared.MaxReviewCommentLength-len("Great labs. "))
+
+		resp, err := client.SubmitReview(ctx, &pb.SubmitReviewRequest{
+			CourseId: reviewCourseID, StudentId: studentID, EnrollmentId: gradedEnrollment,
+			Ratings: ratings, Comment: "  " + comment + "  ",
+		})
+		if err != nil || !resp.Success {
+			t.Fatalf("SubmitReview failed: %v", err)
+		}
+
+		// One review per enrollment
+		_, err = client.SubmitReview(ctx, &pb.SubmitReviewRequest{
+			CourseId: reviewCourseID, StudentId: studentID, EnrollmentId: gradedEnrollment, Ratings: ratings,
+		})
+		if shared.ErrorCodeOf(err) != shared.ErrCodeAlreadyReviewed {
+			t.Errorf("Expected ALREADY_REVIEWED for a second review, got %v", err)
+		}
+
+		// The stored review does not identify the student
+		var stored map[string]interface{}
+		db.Collection("course_reviews").FindOne(ctx, map[string]interface{}{"_id": resp.ReviewId}).Decode(&stored)
+		if _, ok := stored["student_id"]; ok {
+			t.Errorf("Expected the review to be stored without the student, got %v", stored)
+		}
+
+		// Faculty see averages only
+		summary, err := client.GetCourseReviewSummary(ctx, &pb.GetCourseReviewSummaryRequest{CourseId: reviewCourseID, UserId: facultyID})
+		if err != nil {
+			t.Fatalf("GetCourseReviewSummary failed: %v", err)
+		}
+		if summary.ReviewCount != 1 || summary.Averages.Instruction != 5 || summary.Averages.Overall != 4 {
+			t.Errorf("Unexpected summary: %+v", summary)
+		}
+		if len(summary.Comments) != 0 {
+			t.Errorf("Expected no comments for faculty, got %v", summary.Comments)
+		}
+
+		// Admins also see the comments
+		summary, err = client.GetCourseReviewSummary(ctx, &pb.GetCourseReviewSummaryRequest{CourseId: reviewCourseID, UserId: adminID})
+		if err != nil || len(summary.Comments) != 1 || summary.Comments[0].Comment != comment {
+			t.Errorf("Expected the trimmed comment for admins, got %v (err %v)", summary.GetComments(), err)
+		}
+
+		_, err = client.GetCourseReviewSummary(ctx, &pb.GetCourseReviewSummaryRequest{CourseId: reviewCourseID, UserId: studentID})
+		if status.Code(err) != codes.PermissionDenied {
+			t.Errorf("Expected PermissionDenied for a student, got %v", err)
+		}
+	})
+
+	// --- 7. Prerequisite Met Via Transfer Credit ---
+	t.Run("Prerequisite Via Transfer", func(t *testing.T) {
+		prereqCourseID := "CS-TEST-PRE"
+		studentID := "course_test_transfer_student"
+		db.Collection("courses").DeleteOne(ctx, map[string]interface{}{"_id": prereqCourseID})
+		defer db.Collection("courses").DeleteOne(ctx, map[string]interface{}{"_id": prereqCourseID})
+		db.Collection("courses").InsertOne(ctx, shared.Course{
+			ID: prereqCourseID, Code: "CS-PRE", Title: "Prerequisite Course",
+			Units: 3, Capacity: 30, IsOpen: true, Semester: "TestSem",
+		})
+		prereqFilter := map[string]interface{}{"course_id": testCourseID, "prereq_id": prereqCourseID}
+		db.Collection("prerequisites").DeleteMany(ctx, prereqFilter)
+		defer db.Collection("prerequisites").DeleteMany(ctx, prereqFilter)
+		db.Collection("prerequisites").InsertOne(ctx, shared.Prerequisite{CourseID: testCourseID, PrereqID: prereqCourseID})
+
+		resp, err := client.CheckPrerequisites(ctx, &pb.CheckPrerequisitesRequest{StudentId: studentID, CourseId: testCourseID})
+		if err != nil {
+			t.Fatalf("CheckPrerequisites failed: %v", err)
+		}
+		if resp.AllMet {
+			t.Fatal("Expected prerequisite to be unmet without completion or transfer credit")
+		}
+
+		creditFilter := map[string]interface{}{"student_id": studentID}
+		db.Collection("transfer_credits").DeleteMany(ctx, creditFilter)
+		defer db.Collection("transfer_credits").DeleteMany(ctx, creditFilter)
+		db.Collection("transfer_credits").InsertOne(ctx, shared.TransferCredit{
+			ID: "TRF-COURSE-TEST", StudentID: studentID, CourseCode: "CS-PRE",
+			EquivalentUnits: 3, SourceInstitution: "Other University",
+		})
+
+		resp, err = client.CheckPrerequisites(ctx, &pb.CheckPrerequisitesRequest{StudentId: studentID, CourseId: testCourseID})
+		if err != nil {
+			t.Fatalf("CheckPrerequisites failed: %v", err)
+		}
+		if !resp.AllMet || len(resp.Prerequisites) != 1 || resp.Prerequisites[0].MetVia != "transfer" {
+			t.Errorf("Expected prerequisite met via transfer, got %+v", resp.Prerequisites)
+		}
+	})
+
+	t.Run("Get Prerequisites", func(t *testing.T) {
+		prereqCourseID, deletedID := "CS-TEST-LISTPRE", "CS-TEST-LISTPRE-GONE"
+		db.Collection("courses").DeleteOne(ctx, map[string]interface{}{"_id": prereqCourseID})
+		defer db.Collection("courses").DeleteOne(ctx, map[string]interface{}{"_id": prereqCourseID})
+		db.Collection("courses").InsertOne(ctx, shared.Course{
+			ID: prereqCourseID, Code: "CS-LISTPRE", Title: "Listed Prerequisite",
+			Units: 3, Capacity: 30, IsOpen: true, Semester: "TestSem",
+		})
+		prereqFilter := map[string]interface{}{"course_id": testCourseID}
+		db.Collection("prerequisites").DeleteMany(ctx, prereqFilter)
+		defer db.Collection("prerequisites").DeleteMany(ctx, prereqFilter)
+		db.Collection("prerequisites").InsertOne(ctx, shared.Prerequisite{CourseID: testCourseID, PrereqID: prereqCourseID})
+		db.Collection("prerequisites").InsertOne(ctx, shared.Prerequisite{CourseID: testCourseID, PrereqID: deletedID})
+
+		resp, err := client.GetPrerequisites(ctx, &pb.GetPrerequisitesRequest{CourseId: testCourseID})
+		if err != nil {
+			t.Fatalf("GetPrerequisites failed: %v", err)
+		}
+		if len(resp.Prerequisites) != 2 {
+			t.Fatalf("Expected 2 prerequisites, got %+v", resp.Prerequisites)
+		}
+		// The deleted course has no code, so it sorts first
+		if resp.Prerequisites[0].CourseId != deletedID || resp.Prerequisites[0].CourseCode != "" {
+			t.Errorf("Expected unresolved prerequisite first, got %+v", resp.Prerequisites[0])
+		}
+		if p := resp.Prerequisites[1]; p.CourseCode != "CS-LISTPRE" || p.Title != "Listed Prerequisite" {
+			t.Errorf("Expected prerequisite resolved to code and title, got %+v", p)
+		}
+
+		_, err = client.GetPrerequisites(ctx, &pb.GetPrerequisitesRequest{CourseId: "CS-TEST-NO-SUCH"})
+		if shared.ErrorCodeOf(err) != shared.ErrCodeCourseNotFound {
+			t.Errorf("Expected COURSE_NOT_FOUND for unknown course, got %v", err)
+		}
+	})
+
+	t.Run("Eligible Courses", func(t *testing.T) {
+		introID, advancedID := "CS-TEST-ELIG-1", "CS-TEST-ELIG-2"
+		studentID := "course_test_eligible_student"
+		for _, c := range []shared.Course{
+			{ID: introID, Code: "CS-ELIG1", Department: "ELIG", Title: "Eligibility Intro", Units: 3, Capacity: 30, IsOpen: true, Semester: "TestSem"},
+			{ID: advancedID, Code: "CS-ELIG2", Department: "ELIG", Title: "Eligibility Advanced", Units: 3, Capacity: 30, IsOpen: true, Semester: "TestSem"},
+		} {
+			db.Collection("courses").DeleteOne(ctx, map[string]interface{}{"_id": c.ID})
+			defer db.Collection("courses").DeleteOne(ctx, map[string]interface{}{"_id": c.ID})
+			db.Collection("courses").InsertOne(ctx, c)
+		}
+		prereqFilter := map[string]interface{}{"course_id": advancedID}
+		db.Collection("prerequisites").DeleteMany(ctx, prereqFilter)
+		defer db.Collection("prerequisites").DeleteMany(ctx, prereqFilter)
+		db.Collection("prerequisites").InsertOne(ctx, shared.Prerequisite{CourseID: advancedID, PrereqID: introID})
+		enrollmentFilter := map[string]interface{}{"student_id": studentID}
+		db.Collection("enrollments").DeleteMany(ctx, enrollmentFilter)
+		defer db.Collection("enrollments").DeleteMany(ctx, enrollmentFilter)
+
+		req := &pb.GetEligibleCoursesRequest{StudentId: studentID, Semester: "TestSem", Department: "elig"}
+		resp, err := client.GetEligibleCourses(ctx, req)
+		if err != nil {
+			t.Fatalf("GetEligibleCourses failed: %v", err)
+		}
+		if len(resp.Courses) != 2 || resp.EligibleCount != 1 {
+			t.Fatalf("Expected 2 courses with 1 eligible, got %+v", resp)
+		}
+		if resp.Courses[0].Course.Id != introID || !resp.Courses[0].Eligible {
+			t.Errorf("Expected %s listed first as eligible, got %+v", introID, resp.Courses[0])
+		}
+		if resp.Courses[1].Eligible || resp.Courses[1].Reason != "missing prerequisites: CS-ELIG1" {
+			t.Errorf("Expected %s blocked by its prerequisite, got %+v", advancedID, resp.Courses[1])
+		}
+
+		// Taking the prerequisite hides it and leaves the advanced course almost eligible
+		db.Collection("enrollments").InsertOne(ctx, shared.Enrollment{
+			ID: "ENR-COURSE-ELIG", StudentID: studentID, CourseID: introID, Status: shared.StatusEnrolled,
+		})
+		resp, err = client.GetEligibleCourses(ctx, req)
+		if err != nil {
+			t.Fatalf("GetEligibleCourses failed: %v", err)
+		}
+		if len(resp.Courses) != 1 || resp.Courses[0].Course.Id != advancedID {
+			t.Fatalf("Expected only %s, got %+v", advancedID, resp.Courses)
+		}
+		if resp.Courses[0].Eligible || !resp.Courses[0].Prerequisites[0].InProgress {
+			t.Errorf("Expected in-progress prerequisite, got %+v", resp.Courses[0])
+		}
+
+		if _, err := client.GetEligibleCourses(ctx, &pb.GetEligibleCoursesRequest{StudentId: studentID}); status.Code(err) != codes.InvalidArgument {
+			t.Errorf("Expected InvalidArgument without semester, got %v", err)
+		}
+	})
+
+	t.Run("Department Backfill", func(t *testing.T) {
+		// A year-prefixed code that the old code-prefix match could not filter
+		legacy := shared.Course{ID: "CS-TEST-DEPT-1", Code: "2031-DEPTX-101", Title: "Legacy Code", Units: 3, Capacity: 30, IsOpen: true, Semester: "TestSem"}
+		db.Collection("courses").DeleteOne(ctx, map[string]interface{}{"_id": legacy.ID})
+		defer db.Collection("courses").DeleteOne(ctx, map[string]interface{}{"_id": legacy.ID})
+		db.Collection("courses").InsertOne(ctx, legacy)
+		defer db.Collection("departments").DeleteMany(ctx, map[string]interface{}{"_id": map[string]interface{}{"$in": []string{"DEPTX", "ELIG"}}})
+
+		if _, err := NewCourseService(db).BackfillDepartments(ctx); err != nil {
+			t.Fatalf("BackfillDepartments failed: %v", err)
+		}
+		resp, err := client.ListCourses(ctx, &pb.ListCoursesRequest{
+			Filters: &pb.CourseFilter{Semester: "TestSem", Department: "deptx"},
+		})
+		if err != nil {
+			t.Fatalf("ListCourses failed: %v", err)
+		}
+		if len(resp.Courses) != 1 || resp.Courses[0].Id != legacy.ID || resp.Courses[0].Department != "DEPTX" {
+			t.Errorf("Expected only %s in department DEPTX, got %+v", legacy.ID, resp.Courses)
+		}
+
+		// The backfilled department is listed for the filter, named after its code
+		departments, err := client.ListDepartments(ctx, &pb.ListDepartmentsRequest{})
+		if err != nil {
+			t.Fatalf("ListDepartments failed: %v", err)
+		}
+		found := false
+		for _, d := range departments.Departments {
+			found = found || d.Code == "DEPTX" && d.Name == "DEPTX"
+		}
+		if !found {
+			t.Errorf("Expected DEPTX to be registered by the backfill, got %+v", departments.Departments)
+		}
+	})
+
+	t.Run("Meeting Time Filters", func(t *testing.T) {
+		slotCourses := []shared.Course{
+			{ID: "CS-TEST-SLOT-1", Code: "CS-SLOT1", Title: "Tue/Thu Afternoon", Schedule: "TTH 14:00-15:30"},
+			{ID: "CS-TEST-SLOT-2", Code: "CS-SLOT2", Title: "Tuesday Morning", Schedule: "T 9:00-10:30"},
+			{ID: "CS-TEST-SLOT-3", Code: "CS-SLOT3", Title: "MWF Afternoon", Schedule: "MWF 13:00-14:00"},
+			{ID: "CS-TEST-SLOT-4", Code: "CS-SLOT4", Title: "Online", Schedule: ""},
+			{ID: "CS-TEST-SLOT-5", Code: "CS-SLOT5", Title: "Thursday Late", Schedule: "TH 16:00-19:00"},
+		}
+		for _, c := range slotCourses {
+			c.Units, c.Capacity, c.IsOpen, c.Semester = 3, 30, true, "SlotSem"
+			if c.ID != "CS-TEST-SLOT-5" { // stored before slots existed; left to the backfill
+				c.ScheduleSlot = shared.NewScheduleSlot(c.Schedule)
+			}
+			db.Collection("courses").DeleteOne(ctx, map[string]interface{}{"_id": c.ID})
+			defer db.Collection("courses").DeleteOne(ctx, map[string]interface{}{"_id": c.ID})
+			db.Collection("courses").InsertOne(ctx, c)
+		}
+		if _, err := NewCourseService(db).BackfillScheduleSlots(ctx); err != nil {
+			t.Fatalf("BackfillScheduleSlots failed: %v", err)
+		}
+
+		list := func(filters *pb.CourseFilter) []string {
+			filters.Semester = "SlotSem"
+			resp, err := client.ListCourses(ctx, &pb.ListCoursesRequest{Filters: filters})
+			if err != nil {
+				t.Fatalf("ListCourses(%v) failed: %v", filters, err)
+			}
+			var ids []string
+			for _, c := range resp.Courses {
+				ids = append(ids, strings.TrimPrefix(c.Id, "CS-TEST-SLOT-"))
+			}
+			return ids
+		}
+
+		// Every meeting day must be requested; unscheduled courses never match
+		if got := strings.Join(list(&pb.CourseFilter{Days: []string{"TTH"}}), ","); got != "1,2,5" {
+			t.Errorf("Expected courses 1,2,5 on Tue/Thu only, got %s", got)
+		}
+		if got := strings.Join(list(&pb.CourseFilter{Days: []string{"T"}}), ","); got != "2" {
+			t.Errorf("Expected only the Tuesday-only course, got %s", got)
+		}
+
+		// The whole meeting must fall within the window
+		afternoon := &pb.TimeWindow{Start: "12:00", End: "18:00"}
+		if got := strings.Join(list(&pb.CourseFilter{TimeWindow: afternoon}), ","); got != "1,3" {
+			t.Errorf("Expected courses 1,3 within 12:00-18:00, got %s", got)
+		}
+		if got := strings.Join(list(&pb.CourseFilter{Days: []string{"T", "TH"}, TimeWindow: afternoon}), ","); got != "1" {
+			t.Errorf("Expected only course 1 on Tue/Thu afternoons, got %s", got)
+		}
+		if got := strings.Join(list(&pb.CourseFilter{TimeWindow: &pb.TimeWindow{Start: "16:00"}}), ","); got != "5" {
+			t.Errorf("Expected the backfilled evening course with an open-ended window, got %s", got)
+		}
+
+		_, err := client.ListCourses(ctx, &pb.ListCoursesRequest{Filters: &pb.CourseFilter{Days: []string{"X"}}})
+		if status.Code(err) != codes.InvalidArgument {
+			t.Errorf("Expected InvalidArgument for an unknown day, got %v", err)
+		}
+		_, err = client.ListCourses(ctx, &pb.ListCoursesRequest{Filters: &pb.CourseFilter{TimeWindow: &pb.TimeWindow{Start: "18:00", End: "12:00"}}})
+		if status.Code(err) != codes.InvalidArgument {
+			t.Errorf("Expected InvalidArgument for a reversed window, got %v", err)
+		}
+	})
+
+	t.Run("List Courses Sorted", func(t *testing.T) {
+		// Each course leads on a different field, and course 4 is overfilled so its
+		// seats_available clamps to 0
+		sortCourses := []shared.Course{
+			{ID: "CS-TEST-SORT-1", Code: "SORT-A", Title: "Delta", Units: 2, Capacity: 40, Enrolled: 10},
+			{ID: "CS-TEST-SORT-2", Code: "SORT-B", Title: "Alpha", Units: 5, Capacity: 20, Enrolled: 15},
+			{ID: "CS-TEST-SORT-3", Code: "SORT-C", Title: "Charlie", Units: 1, Capacity: 30, Enrolled: 28},
+			{ID: "CS-TEST-SORT-4", Code: "SORT-D", Title: "Bravo", Units: 3, Capacity: 10, Enrolled: 12},
+		}
+		for _, c := range sortCourses {
+			c.IsOpen, c.Semester = true, "SortSem"
+			db.Collection("courses").DeleteOne(ctx, map[string]interface{}{"_id": c.ID})
+			defer db.Collection("courses").DeleteOne(ctx, map[string]interface{}{"_id": c.ID})
+			db.Collection("courses").InsertOne(ctx, c)
+		}
+
+		list := func(sortBy, sortOrder string) string {
+			resp, err := client.ListCourses(ctx, &pb.ListCoursesRequest{
+				Filters: &pb.CourseFilter{Semester: "SortSem"}, SortBy: sortBy, SortOrder: sortOrder,
+			})
+			if err != nil {
+				t.Fatalf("ListCourses(%s %s) failed: %v", sortBy, sortOrder, err)
+			}
+			var ids []string
+			for _, c := range resp.Courses {
+				ids = append(ids, strings.TrimPrefix(c.Id, "CS-TEST-SORT-"))
+			}
+			return strings.Join(ids, ",")
+		}
+
+		cases := []struct {
+			sortBy, asc, desc string
+		}{
+			{"", "1,2,3,4", "4,3,2,1"},
+			{"code", "1,2,3,4", "4,3,2,1"},
+			{"title", "2,4,3,1", "1,3,4,2"},
+			{"units", "3,1,4,2", "2,4,1,3"},
+			{"seats_available", "4,3,2,1", "1,2,3,4"}, // 0, 2, 5, 30
+			{"enrolled", "1,4,2,3", "3,2,4,1"},
+		}
+		for _, tc := range cases {
+			if got := list(tc.sortBy, ""); got != tc.asc {
+				t.Errorf("Expected %q ascending by default as %s, got %s", tc.sortBy, tc.asc, got)
+			}
+			if got := list(tc.sortBy, "asc"); got != tc.asc {
+				t.Errorf("Expected %q asc as %s, got %s", tc.sortBy, tc.asc, got)
+			}
+			if got := list(tc.sortBy, "desc"); got != tc.desc {
+				t.Errorf("Expected %q desc as %s, got %s", tc.sortBy, tc.desc, got)
+			}
+		}
+
+		for field, req := range map[string]*pb.ListCoursesRequest{
+			"sort_by":    {SortBy: "capacity"},
+			"sort_order": {SortBy: "title", SortOrder: "sideways"},
+		} {
+			_, err := client.ListCourses(ctx, req)
+			if status.Code(err) != codes.InvalidArgument || shared.ErrorCodeOf(err) != shared.ErrCodeInvalidField || shared.ErrorParamsOf(err)["field"] != field {
+				t.Errorf("Expected INVALID_FIELD on %s, got %v", field, err)
+			}
+		}
+	})
+
+	t.Run("Catalog Cache", func(t *testing.T) {
+		semester := "CacheSem"
+		courseID := "CS-CACHE-101"
+		db.Collection("courses").DeleteOne(ctx, map[string]interface{}{"_id": courseID})
+		defer db.Collection("courses").DeleteOne(ctx, map[string]interface{}{"_id": courseID})
+		db.Collection("courses").InsertOne(ctx, shared.Course{
+			ID: courseID, Code: "CS-CACHE", Title: "Cached Course", Units: 3, Capacity: 30, IsOpen: true, Semester: semester,
+		})
+
+		svc := NewCourseService(db)
+		enrolled := func(live bool) int32 {
+			resp, err := svc.ListCourses(ctx, &pb.ListCoursesRequest{Filters: &pb.CourseFilter{Semester: semester}, Live: live})
+			if err != nil || len(resp.Courses) != 1 {
+				t.Fatalf("ListCourses failed: %v %v", resp, err)
+			}
+			return resp.Courses[0].Enrolled
+		}
+
+		enrolled(false)
+		db.Collection("courses").UpdateOne(ctx, map[string]interface{}{"_id": courseID},
+			map[string]interface{}{"$set": map[string]interface{}{"enrolled": 5}})
+
+		// Seat counts lag until the entry expires, except for live (admin) requests
+		if got := enrolled(false); got != 0 {
+			t.Errorf("Expected the cached page with 0 enrolled, got %d", got)
+		}
+		if got := enrolled(true); got != 5 {
+			t.Errorf("Expected a live request to see 5 enrolled, got %d", got)
+		}
+
+		// A course change from the admin service drops cached pages at once
+		if err := shared.BumpCatalogVersion(ctx, db.Collection("system_config")); err != nil {
+			t.Fatalf("BumpCatalogVersion failed: %v", err)
+		}
+		if got := enrolled(false); got != 5 {
+			t.Errorf("Expected a fresh page after the version bump, got %d enrolled", got)
+		}
+
+		// The stale read hit; the first read and the one after the bump missed. Live reads are not counted.
+		// Only admins read the counters
+		adminID, studentID := "course_test_cache_admin", "course_test_cache_student"
+		for _, u := range []shared.User{
+			{ID: adminID, Email: "course_cache_admin@test.com", Role: shared.RoleAdmin, Name: "Cache Admin", IsActive: true},
+			{ID: studentID, Email: "course_cache_student@test.com", Role: shared.RoleStudent, Name: "Cache Student", IsActive: true},
+		} {
+			db.Collection("users").DeleteOne(ctx, map[string]interface{}{"_id": u.ID})
+			defer db.Collection("users").DeleteOne(ctx, map[string]interface{}{"_id": u.ID})
+			db.Collection("users").InsertOne(ctx, u)
+		}
+		if _, err := svc.GetCatalogCacheStats(ctx, &pb.GetCatalogCacheStatsRequest{UserId: studentID}); status.Code(err) != codes.PermissionDenied {
+			t.Errorf("Expected PermissionDenied for a student, got %v", err)
+		}
+
+		stats, err := svc.GetCatalogCacheStats(ctx, &pb.GetCatalogCacheStatsRequest{UserId: adminID})
+		if err != nil || stats.Hits != 1 || stats.Misses != 2 || stats.Entries != 1 {
+			t.Errorf("Expected 1 hit, 2 misses and 1 entry, got %+v (%v)", stats, err)
+		}
+		if stats.GetHitRate() < 0.33 || stats.GetHitRate() > 0.34 {
+			t.Errorf("Expected a 1/3 hit rate, got %v", stats.GetHitRate())
+		}
+	})
+}
+
+// TestCatalogCache checks that a cached catalog page is served for at most the TTL
+// and never across a catalog version change
+func TestCatalogCache(t *testing.T) {
+	ttl := 10 * time.Second
+	cache := newCatalogCache(ttl)
+	start := time.Now()
+
+	key := func(filters *pb.CourseFilter) string {
+		return catalogKey(&pb.ListCoursesRequest{Filters: filters})
+	}
+
+	fall := key(&pb.CourseFilter{Semester: "Fall 2024", Department: "CS"})
+	if fall != key(&pb.CourseFilter{Department: "CS", Semester: "Fall 2024"}) {
+		t.Error("Expected equal filters to share a key")
+	}
+	if !strings.HasPrefix(fall, "Fall 2024|") {
+		t.Errorf("Expected the key to lead with the semester, got %q", fall)
+	}
+	if fall == key(&pb.CourseFilter{Semester: "Fall 2024", Department: "MATH"}) {
+		t.Error("Expected different filters to get different keys")
+	}
+	if fall == catalogKey(&pb.ListCoursesRequest{Filters: &pb.CourseFilter{Semester: "Fall 2024", Department: "CS"}, SortBy: "title"}) {
+		t.Error("Expected a different sort to get a different key")
+	}
+
+	page := &pb.ListCoursesResponse{TotalCount: 1}
+	cache.put(fall, "v1", page, start)
+
+	if got, ok := cache.get(fall, "v1", start.Add(ttl-time.Millisecond)); !ok || got != page {
+		t.Error("Expected the page served just before the TTL")
+	}
+	if _, ok := cache.get(fall, "v1", start.Add(ttl)); ok {
+		t.Error("Expected the page dropped once the TTL has passed")
+	}
+	if _, ok := cache.get(fall, "v2", start); ok {
+		t.Error("Expected the page dropped after a catalog version change")
+	}
+	if _, ok := cache.get(key(&pb.CourseFilter{Semester: "Spring 2025"}), "v1", start); ok {
+		t.Error("Expected a miss for filters never cached")
+	}
+
+	if hits, misses := cache.hits.Load(), cache.misses.Load(); hits != 1 || misses != 3 {
+		t.Errorf("Expected 1 hit and 3 misses, got %d and %d", hits, misses)
+	}
+}
+
+// TestBatchGetCourses_SingleQuery verifies many IDs are fetched with one find on courses
+// and one on prerequisites
+func TestBatchGetCourses_SingleQuery(t *testing.T) {
+	if err := godotenv.Load("../../cmd/course/.env"); err != nil {
+		log.Println("No .env file found")
+	}
+	cfg, _ := shared.LoadServiceConfig("course-service")
+	ctx := context.Background()
+
+	// Count find commands issued against the courses and prerequisites collections
+	var mu sync.Mutex
+	courseFinds, prereqFinds := 0, 0
+	monitor := &event.CommandMonitor{
+		Started: func(_ context.Context, e *event.CommandStartedEvent) {
+			if e.CommandName != "find" {
+				return
+			}
+			mu.Lock()
+			switch e.Command.Lookup("find").StringValue() {
+			case "courses":
+				courseFinds++
+			case "prerequisites":
+				prereqFinds++
+			}
+			mu.Unlock()
+		},
+	}
+	client, err := mongo.Connect(ctx, options.Client().ApplyURI(cfg.MongoDB.URI).SetMonitor(monitor))
+	if err != nil {
+		t.Fatalf("Failed to connect: %v", err)
+	}
+	defer client.Disconnect(ctx)
+	db := client.Database(cfg.MongoDB.Database)
+
+	var ids []string
+	for i := 0; i < 10; i++ {
+		id := fmt.Sprintf("CS-BATCH-%02d", i)
+		ids = append(ids, id)
+		db.Collection("courses").DeleteOne(ctx, map[string]interface{}{"_id": id})
+		defer db.Collection("courses").DeleteOne(ctx, map[string]interface{}{"_id": id})
+		db.Collection("courses").InsertOne(ctx, shared.Course{
+			ID: id, Code: fmt.Sprintf("CS-B%02d", i), Title: "Batch Course",
+			Units: 3, Capacity: 30, IsOpen: true, Semester: "TestSem",
+		})
+	}
+	link := map[string]interface{}{"course_id": ids[1], "prereq_id": ids[0]}
+	db.Collection("prerequisites").DeleteMany(ctx, link)
+	defer db.Collection("prerequisites").DeleteMany(ctx, link)
+	db.Collection("prerequisites").InsertOne(ctx, shared.Prerequisite{CourseID: ids[1], PrereqID: ids[0]})
+	requested := append(append([]string{}, ids...), "CS-BATCH-MISSING-1", "CS-BATCH-MISSING-2")
+
+	mu.Lock()
+	courseFinds, prereqFinds = 0, 0
+	mu.Unlock()
+
+	resp, err := NewCourseService(db).BatchGetCourses(ctx, &pb.BatchGetCoursesRequest{CourseIds: requested})
+	if err != nil {
+		t.Fatalf("BatchGetCourses failed: %v", err)
+	}
+
+	mu.Lock()
+	finds, prereqs := courseFinds, prereqFinds
+	mu.Unlock()
+	if finds != 1 || prereqs != 1 {
+		t.Errorf("Expected 1 find on courses and 1 on prerequisites, got %d and %d", finds, prereqs)
+	}
+	for _, c := range resp.Courses {
+		if want := c.Id == ids[1]; want != (len(c.Prerequisites) == 1 && c.Prerequisites[0] == ids[0]) {
+			t.Errorf("Unexpected prerequisites for %s: %v", c.Id, c.Prerequisites)
+		}
+	}
+	if len(resp.Courses) != len(ids) {
+		t.Errorf("Expected %d courses, got %d", len(ids), len(resp.Courses))
+	}
+	if len(resp.MissingIds) != 2 || resp.MissingIds[0] != "CS-BATCH-MISSING-1" || resp.MissingIds[1] != "CS-BATCH-MISSING-2" {
+		t.Errorf("Expected both missing IDs reported in request order, got %v", resp.MissingIds)
+	}
 }
@@ -0,0 +1,8 @@
+// Package e2e holds end-to-end tests that drive the real services through their
+// gRPC APIs against a throwaway MongoDB (see internal/testutil). They are built
+// only with the e2e tag:
+//
+//	go test -tags e2e ./internal/e2e/
+//
+// and are skipped when no MongoDB can be found or started.
+package e2e
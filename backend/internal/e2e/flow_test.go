@@ -0,0 +1,139 @@
+//go:build e2e
+
+package e2e
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	pb_course "stdiscm_p4/backend/internal/pb/course"
+	pb_enrollment "stdiscm_p4/backend/internal/pb/enrollment"
+	pb_grade "stdiscm_p4/backend/internal/pb/grade"
+	"stdiscm_p4/backend/internal/shared"
+	"stdiscm_p4/backend/internal/testutil"
+)
+
+// TestStudentTermFlow follows one student through a term: log in, build a cart,
+// enroll, get graded by the faculty, and see the published grade in their GPA
+func TestStudentTermFlow(t *testing.T) {
+	env := testutil.NewEnv(t)
+	env.SeedCatalog(t)
+	ctx := context.Background()
+
+	// 1. Log in with the email, then with the student number
+	login := env.Login(t, "student@example.com", testutil.Password)
+	if login.User.Id != testutil.StudentID1 {
+		t.Fatalf("Expected to log in as %s, got %s", testutil.StudentID1, login.User.Id)
+	}
+	env.Login(t, "202400001", testutil.Password)
+
+	// 2. Cart: CS-201 (prerequisite met last semester) and HIS-101
+	for _, courseID := range []string{testutil.CS201ID, testutil.HIS101ID} {
+		resp, err := env.Enrollment.AddToCart(ctx, &pb_enrollment.AddToCartRequest{StudentId: testutil.StudentID1, CourseId: courseID})
+		if err != nil || !resp.Success {
+			t.Fatalf("AddToCart(%s) failed: %v %v", courseID, resp, err)
+		}
+	}
+	cart, err := env.Enrollment.GetCart(ctx, &pb_enrollment.GetCartRequest{StudentId: testutil.StudentID1})
+	if err != nil || len(cart.Cart.Items) != 2 {
+		t.Fatalf("Expected 2 courses in the cart, got %v %v", cart, err)
+	}
+
+	// 3. Enroll
+	enroll, err := env.Enrollment.EnrollAll(ctx, &pb_enrollment.EnrollAllRequest{StudentId: testutil.StudentID1})
+	if err != nil || !enroll.Success {
+		t.Fatalf("EnrollAll failed: %v %v", enroll, err)
+	}
+	if len(enroll.Enrollments) != 2 || enroll.Receipt == nil {
+		t.Errorf("Expected 2 enrollments and a receipt, got %+v", enroll)
+	}
+	course, err := env.Course.GetCourse(ctx, &pb_course.GetCourseRequest{CourseId: testutil.CS201ID})
+	if err != nil || course.Course.Enrolled != 1 {
+		t.Errorf("Expected CS-201 to count 1 enrolled, got %v %v", course, err)
+	}
+	cart, err = env.Enrollment.GetCart(ctx, &pb_enrollment.GetCartRequest{StudentId: testutil.StudentID1})
+	if err == nil && cart.Cart != nil && len(cart.Cart.Items) != 0 {
+		t.Errorf("Expected the cart to be emptied by enrollment, got %d items", len(cart.Cart.Items))
+	}
+
+	// 4. The faculty uploads a grade; it stays out of the GPA until published
+	env.Login(t, "faculty@example.com", testutil.Password)
+	upload := env.UploadGrades(t, testutil.CS201ID, testutil.FacultyID1, map[string]string{testutil.StudentID1: shared.GradeB})
+	if !upload.Success || upload.Successful != 1 {
+		t.Fatalf("UploadGrades failed: %+v", upload)
+	}
+	gpa, err := env.Grade.CalculateGPA(ctx, &pb_grade.CalculateGPARequest{StudentId: testutil.StudentID1})
+	if err != nil || !gpa.Success || gpa.GpaInfo.Cgpa != 4.0 {
+		t.Fatalf("Expected a 4.0 CGPA from last semester's A only, got %v %v", gpa, err)
+	}
+
+	// 5. Publish, and the B counts: (4.0*3 + 3.0*3) / 6
+	publish, err := env.Grade.PublishGrades(ctx, &pb_grade.PublishGradesRequest{CourseId: testutil.CS201ID, FacultyId: testutil.FacultyID1})
+	if err != nil || !publish.Success || publish.GradesPublished != 1 {
+		t.Fatalf("PublishGrades failed: %v %v", publish, err)
+	}
+	gpa, err = env.Grade.CalculateGPA(ctx, &pb_grade.CalculateGPARequest{StudentId: testutil.StudentID1})
+	if err != nil || !gpa.Success {
+		t.Fatalf("CalculateGPA failed: %v %v", gpa, err)
+	}
+	if math.Abs(gpa.GpaInfo.Cgpa-3.5) > 0.001 || gpa.GpaInfo.TotalUnitsEarned != 6 {
+		t.Errorf("Expected a 3.5 CGPA over 6 units, got %+v", gpa.GpaInfo)
+	}
+
+	grades, err := env.Grade.GetStudentGrades(ctx, &pb_grade.GetStudentGradesRequest{StudentId: testutil.StudentID1, Semester: testutil.CurrentSemester})
+	if err != nil || len(grades.Grades) != 1 || grades.Grades[0].CourseId != testutil.CS201ID || !grades.Grades[0].Published {
+		t.Errorf("Expected the published CS-201 grade this semester, got %v %v", grades, err)
+	}
+}
+
+// TestLastSeatFlow has two students race for a course's last seat through the
+// whole cart and enroll path; exactly one gets it
+func TestLastSeatFlow(t *testing.T) {
+	env := testutil.NewEnv(t)
+	env.SeedCatalog(t)
+	ctx := context.Background()
+
+	seminar := testutil.Course("SEM401_Fall24", "CS-401", testutil.CurrentSemester, testutil.FacultyID1, "S 9:00-12:00")
+	seminar.Capacity = 1
+	env.AddCourse(t, seminar)
+
+	students := []string{testutil.StudentID2, testutil.StudentID3}
+	for _, id := range students {
+		resp, err := env.Enrollment.AddToCart(ctx, &pb_enrollment.AddToCartRequest{StudentId: id, CourseId: seminar.ID})
+		if err != nil || !resp.Success {
+			t.Fatalf("AddToCart for %s failed: %v %v", id, resp, err)
+		}
+	}
+
+	results := make(chan *pb_enrollment.EnrollAllResponse, len(students))
+	for _, id := range students {
+		go func(studentID string) {
+			resp, err := env.Enrollment.EnrollAll(ctx, &pb_enrollment.EnrollAllRequest{StudentId: studentID})
+			if err != nil {
+				t.Errorf("EnrollAll for %s returned an error: %v", studentID, err)
+			}
+			results <- resp
+		}(id)
+	}
+
+	enrolled := 0
+	for range students {
+		resp := <-results
+		switch {
+		case resp == nil:
+		case resp.Success:
+			enrolled++
+		case resp.ErrorCode != string(shared.ErrCodeCourseFull):
+			t.Errorf("Expected the loser to see COURSE_FULL, got %s (%s)", resp.ErrorCode, resp.Message)
+		}
+	}
+	if enrolled != 1 {
+		t.Errorf("Expected exactly one enrollment, got %d", enrolled)
+	}
+
+	course, err := env.Course.GetCourse(ctx, &pb_course.GetCourseRequest{CourseId: seminar.ID})
+	if err != nil || course.Course.Enrolled != 1 {
+		t.Errorf("Expected the seminar to count 1 enrolled, got %v %v", course, err)
+	}
+}
@@ -0,0 +1,141 @@
+package enrollment
+
+import (
+	"context"
+	"log"
+	"strconv"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "stdiscm_p4/backend/internal/pb/enrollment"
+	"stdiscm_p4/backend/internal/shared"
+)
+
+// GetDropHistory lists a student's dropped enrollments, newest first, with who made
+// each drop. Only self-service drops count against max_drops_per_semester.
+func (s *EnrollmentService) GetDropHistory(ctx context.Context, req *pb.GetDropHistoryRequest) (*pb.GetDropHistoryResponse, error) {
+	if req.StudentId == "" {
+		return nil, status.Error(codes.InvalidArgument, "student_id required")
+	}
+	if _, err := s.verifyStudent(ctx, req.StudentId); err != nil {
+		return nil, err
+	}
+
+	queryCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	filter := bson.M{"student_id": req.StudentId, "status": shared.StatusDropped}
+	if req.Semester != "" {
+		// Enrollments carry no semester; narrow to the semester's courses
+		courseIDs, err := s.coursesCol.Distinct(queryCtx, "_id", bson.M{"semester": req.Semester})
+		if err != nil {
+			return nil, status.Error(codes.Internal, "db error")
+		}
+		filter["course_id"] = bson.M{"$in": courseIDs}
+	}
+
+	opts := options.Find().SetSort(bson.D{{Key: "dropped_at", Value: -1}, {Key: "_id", Value: 1}})
+	cursor, err := s.enrollmentsCol.Find(queryCtx, filter, opts)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "db error")
+	}
+	var docs []shared.Enrollment
+	if err := cursor.All(queryCtx, &docs); err != nil {
+		return nil, status.Error(codes.Internal, "db error")
+	}
+
+	courseIDs := make([]string, 0, len(docs))
+	for _, doc := range docs {
+		courseIDs = append(courseIDs, doc.CourseID)
+	}
+	courses := s.batchGetCourses(queryCtx, courseIDs)
+
+	drops := make([]*pb.DropRecord, 0, len(docs))
+	for _, doc := range docs {
+		record := &pb.DropRecord{
+			EnrollmentId: doc.ID,
+			CourseId:     doc.CourseID,
+			DroppedAt:    shared.ToProtoTime(doc.DroppedAt),
+			Source:       shared.DropSource(doc.DropReason),
+		}
+		if c, ok := courses[doc.CourseID]; ok {
+			record.CourseCode, record.CourseTitle, record.Semester = c.Code, c.Title, c.Semester
+		}
+		drops = append(drops, record)
+	}
+
+	return &pb.GetDropHistoryResponse{
+		Success:             true,
+		Message:             "drop history retrieved",
+		Drops:               drops,
+		MaxDropsPerSemester: shared.GetMaxDrops(queryCtx, s.systemConfigCol),
+	}, nil
+}
+
+// countSelfDrops counts the courses a student dropped themselves in a semester
+func (s *EnrollmentService) countSelfDrops(ctx context.Context, studentID, semester string) (int32, error) {
+	courseIDs, err := s.coursesCol.Distinct(ctx, "_id", bson.M{"semester": semester})
+	if err != nil {
+		return 0, err
+	}
+	n, err := s.enrollmentsCol.CountDocuments(ctx, shared.SelfDropFilter(studentID, courseIDs))
+	return int32(n), err
+}
+
+// checkDropLimit rejects a drop from a course once the student has used all
+// maxDrops drops of its semester. Run it in the drop transaction, under the
+// student's enrollment lock, so two drops cannot both take the last one.
+func (s *EnrollmentService) checkDropLimit(ctx context.Context, studentID, courseID string, maxDrops int32) error {
+	if maxDrops <= 0 {
+		return nil
+	}
+
+	var course shared.Course
+	opts := options.FindOne().SetProjection(bson.M{"semester": 1})
+	if err := s.coursesCol.FindOne(ctx, bson.M{"_id": courseID}, opts).Decode(&course); err != nil {
+		if err == mongo.ErrNoDocuments {
+			// A deleted course has no semester to count against
+			log.Printf("Warning: drop limit not checked for missing course %s", courseID)
+			return nil
+		}
+		return err
+	}
+
+	used, err := s.countSelfDrops(ctx, studentID, course.Semester)
+	if err != nil {
+		return err
+	}
+	if used >= maxDrops {
+		return shared.ErrDropLimitReached.Newf("you have used all %d drops allowed for %s", maxDrops, course.Semester).
+			WithParam("semester", course.Semester).
+			WithParam("max_drops", strconv.Itoa(int(maxDrops)))
+	}
+	return nil
+}
+
+// remainingDrops reports the drop limit and how many self-service drops a student
+// has left in semester, or in the current semester when it is empty. Both are 0
+// when drops are not limited.
+func (s *EnrollmentService) remainingDrops(ctx context.Context, studentID, semester string) (maxDrops, remaining int32, dropsSemester string, err error) {
+	maxDrops = shared.GetMaxDrops(ctx, s.systemConfigCol)
+	if maxDrops == 0 {
+		return 0, 0, "", nil
+	}
+	if semester == "" {
+		semester = s.getCurrentSemester(ctx)
+	}
+	if semester == "" {
+		return maxDrops, maxDrops, "", nil
+	}
+
+	used, err := s.countSelfDrops(ctx, studentID, semester)
+	if err != nil {
+		return 0, 0, "", err
+	}
+	return maxDrops, max(maxDrops-used, 0), semester, nil
+}
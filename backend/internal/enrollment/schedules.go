@@ -0,0 +1,285 @@
+package enrollment
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "stdiscm_p4/backend/internal/pb/enrollment"
+	"stdiscm_p4/backend/internal/shared"
+)
+
+// Bounds on the schedule search. Past maxSuggestCourses codes or
+// maxSuggestSections sections per code the extra ones are left out, and the search
+// stops after maxSuggestSteps section picks; each returns a partial result.
+const (
+	maxSuggestCourses     = 8
+	maxSuggestSections    = 10
+	maxSuggestSteps       = 100000
+	defaultSuggestResults = 5
+	maxSuggestResults     = 20
+)
+
+// sectionOption is one open section a schedule can use; slot is nil when the
+// section is unscheduled, so it never conflicts and adds no gaps
+type sectionOption struct {
+	section *pb.ScheduleSection
+	slot    *shared.ScheduleSlot
+}
+
+// SuggestSchedules finds combinations of open sections, one per requested course
+// code, that do not overlap, ranked by the fewest idle minutes between classes.
+// Nothing is added to the cart; the student picks a schedule and adds its sections.
+func (s *EnrollmentService) SuggestSchedules(ctx context.Context, req *pb.SuggestSchedulesRequest) (*pb.SuggestSchedulesResponse, error) {
+	if req.StudentId == "" {
+		return nil, status.Error(codes.InvalidArgument, "student_id required")
+	}
+	if _, err := s.verifyStudent(ctx, req.StudentId); err != nil {
+		return nil, err
+	}
+
+	var courseCodes []string
+	seen := make(map[string]bool)
+	for _, code := range req.CourseCodes {
+		code = strings.ToUpper(strings.TrimSpace(code))
+		if code != "" && !seen[code] {
+			seen[code] = true
+			courseCodes = append(courseCodes, code)
+		}
+	}
+	if len(courseCodes) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "course_codes required")
+	}
+
+	semester := req.Semester
+	if semester == "" {
+		semester = s.getCurrentSemester(ctx)
+	}
+	if semester == "" {
+		return nil, status.Error(codes.InvalidArgument, "semester required: no current semester is configured")
+	}
+
+	limit := int(req.MaxResults)
+	if limit <= 0 {
+		limit = defaultSuggestResults
+	}
+	if limit > maxSuggestResults {
+		limit = maxSuggestResults
+	}
+
+	resp := &pb.SuggestSchedulesResponse{Success: true, Semester: semester}
+	if len(courseCodes) > maxSuggestCourses {
+		resp.SkippedCodes = courseCodes[maxSuggestCourses:]
+		courseCodes = courseCodes[:maxSuggestCourses]
+		resp.Partial = true
+	}
+
+	sections, err := s.openSections(ctx, req.StudentId, courseCodes, semester)
+	if err != nil {
+		log.Printf("Error loading sections for schedule suggestions: %v", err)
+		return nil, status.Error(codes.Internal, "failed to load course sections")
+	}
+
+	var groups [][]sectionOption
+	for _, code := range courseCodes {
+		candidates := sections[code]
+		if len(candidates) == 0 {
+			resp.UnavailableCodes = append(resp.UnavailableCodes, code)
+			continue
+		}
+		if len(candidates) > maxSuggestSections {
+			candidates = candidates[:maxSuggestSections]
+			resp.Partial = true
+		}
+		groups = append(groups, candidates)
+	}
+
+	if len(groups) > 0 {
+		schedules, capped := searchSchedules(groups, limit)
+		resp.Partial = resp.Partial || capped
+		for _, picks := range schedules {
+			resp.Schedules = append(resp.Schedules, toSuggestedSchedule(picks))
+		}
+	}
+
+	switch {
+	case len(groups) == 0:
+		resp.Message = "no open sections found for the requested courses"
+	case len(resp.Schedules) == 0:
+		resp.Message = "no conflict-free schedule found"
+	default:
+		resp.Message = fmt.Sprintf("found %d schedule(s)", len(resp.Schedules))
+	}
+	return resp, nil
+}
+
+// openSections loads the sections of each course code in a semester that AddToCart
+// would accept for the student, most seats first: open now, within the course's own
+// enrollment window, and with a seat that is neither taken nor held in another cart
+func (s *EnrollmentService) openSections(ctx context.Context, studentID string, courseCodes []string, semester string) (map[string][]sectionOption, error) {
+	queryCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	closed, err := shared.IsSemesterClosed(queryCtx, s.closuresCol, semester)
+	if err != nil || closed {
+		return map[string][]sectionOption{}, err
+	}
+
+	filter := bson.M{
+		"code":      bson.M{"$in": courseCodes},
+		"semester":  semester,
+		"is_open":   true,
+		"cancelled": bson.M{"$ne": true},
+		"$expr":     bson.M{"$lt": bson.A{"$enrolled", "$capacity"}},
+	}
+	opts := options.Find().SetSort(bson.D{{Key: "code", Value: 1}, {Key: "_id", Value: 1}})
+	cursor, err := s.coursesCol.Find(queryCtx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	var courses []shared.Course
+	if err := cursor.All(queryCtx, &courses); err != nil {
+		return nil, err
+	}
+
+	// Seats held in other carts count as taken only while seat holds are on
+	held := map[string]int32{}
+	if shared.GetSeatHoldDuration(queryCtx, s.systemConfigCol) > 0 {
+		courseIDs := make([]string, 0, len(courses))
+		for _, c := range courses {
+			courseIDs = append(courseIDs, c.ID)
+		}
+		if held, err = shared.CountSeatHoldsByCourse(queryCtx, s.seatHoldsCol, courseIDs, studentID, time.Now()); err != nil {
+			return nil, err
+		}
+	}
+
+	now := time.Now()
+	sections := make(map[string][]sectionOption)
+	for _, c := range courses {
+		seats := shared.SeatsAvailable(c.Capacity, c.Enrolled+held[c.ID])
+		if !c.IsOpenAt(now) || seats <= 0 {
+			continue
+		}
+		info := shared.NewScheduleInfo(c.Schedule)
+		sections[c.Code] = append(sections[c.Code], sectionOption{
+			section: &pb.ScheduleSection{
+				CourseId:    c.ID,
+				CourseCode:  c.Code,
+				CourseTitle: c.Title,
+				Units:       c.Units,
+				ScheduleInfo: &pb.ScheduleInfo{
+					Days:      info.Days,
+					StartTime: info.StartTime,
+					EndTime:   info.EndTime,
+				},
+				SeatsAvailable: seats,
+			},
+			slot: shared.NewScheduleSlot(c.Schedule),
+		})
+	}
+	for _, candidates := range sections {
+		sort.SliceStable(candidates, func(i, j int) bool {
+			return candidates[i].section.SeatsAvailable > candidates[j].section.SeatsAvailable
+		})
+	}
+	return sections, nil
+}
+
+// searchSchedules picks one section from each group so that no two overlap and
+// returns up to limit schedules with the fewest gap minutes; ties keep the order
+// found, which favors sections listed first. capped reports that the search
+// stopped after maxSuggestSteps picks.
+func searchSchedules(groups [][]sectionOption, limit int) (schedules [][]sectionOption, capped bool) {
+	var gaps []int32
+	picked := make([]sectionOption, 0, len(groups))
+	steps := 0
+
+	var search func(depth int) bool
+	search = func(depth int) bool {
+		if depth == len(groups) {
+			gap := gapMinutes(picked)
+			i := sort.Search(len(gaps), func(i int) bool { return gaps[i] > gap })
+			if i < limit {
+				schedules = slices.Insert(schedules, i, slices.Clone(picked))
+				gaps = slices.Insert(gaps, i, gap)
+				if len(schedules) > limit {
+					schedules, gaps = schedules[:limit], gaps[:limit]
+				}
+			}
+			return true
+		}
+
+		for _, option := range groups[depth] {
+			if steps++; steps > maxSuggestSteps {
+				return false
+			}
+			if conflictsWithAny(option, picked) {
+				continue
+			}
+			picked = append(picked, option)
+			ok := search(depth + 1)
+			picked = picked[:len(picked)-1]
+			if !ok {
+				return false
+			}
+		}
+		return true
+	}
+
+	return schedules, !search(0)
+}
+
+// conflictsWithAny reports whether option meets at the same time as a picked section
+func conflictsWithAny(option sectionOption, picked []sectionOption) bool {
+	a := option.section.ScheduleInfo
+	for _, p := range picked {
+		b := p.section.ScheduleInfo
+		if shared.DaysOverlap(a.Days, b.Days) && shared.TimesOverlap(a.StartTime, a.EndTime, b.StartTime, b.EndTime) {
+			return true
+		}
+	}
+	return false
+}
+
+// gapMinutes sums the idle time between consecutive classes on each day of the week
+func gapMinutes(picks []sectionOption) int32 {
+	byDay := make(map[string][]*shared.ScheduleSlot)
+	for _, p := range picks {
+		if p.slot == nil {
+			continue
+		}
+		for _, day := range p.slot.Days {
+			byDay[day] = append(byDay[day], p.slot)
+		}
+	}
+
+	var total int32
+	for _, slots := range byDay {
+		sort.Slice(slots, func(i, j int) bool { return slots[i].StartMinute < slots[j].StartMinute })
+		for i := 1; i < len(slots); i++ {
+			if gap := slots[i].StartMinute - slots[i-1].EndMinute; gap > 0 {
+				total += gap
+			}
+		}
+	}
+	return total
+}
+
+func toSuggestedSchedule(picks []sectionOption) *pb.SuggestedSchedule {
+	schedule := &pb.SuggestedSchedule{GapMinutes: gapMinutes(picks)}
+	for _, p := range picks {
+		schedule.Sections = append(schedule.Sections, p.section)
+		schedule.TotalUnits += p.section.Units
+	}
+	return schedule
+}
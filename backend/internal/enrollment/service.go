@@ -4,6 +4,9 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"math"
+	"strings"
+	"sync"
 	"time"
 
 	"go.mongodb.org/mongo-driver/bson"
@@ -11,7 +14,6 @@ import (
 	"go.mongodb.org/mongo-driver/mongo/options" // Added for UpdateOptions
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
-	"google.golang.org/protobuf/types/known/timestamppb"
 
 	pb_course "stdiscm_p4/backend/internal/pb/course"
 	pb "stdiscm_p4/backend/internal/pb/enrollment"
@@ -21,23 +23,75 @@ import (
 // EnrollmentService implements the gRPC EnrollmentService
 type EnrollmentService struct {
 	pb.UnimplementedEnrollmentServiceServer
-	client         *mongo.Client
-	db             *mongo.Database
-	cartsCol       *mongo.Collection
-	enrollmentsCol *mongo.Collection
-	coursesCol     *mongo.Collection
-	courseClient   pb_course.CourseServiceClient
+	client          *mongo.Client
+	db              *mongo.Database
+	cartsCol        *mongo.Collection
+	enrollmentsCol  *mongo.Collection
+	coursesCol      *mongo.Collection
+	gradesCol       *mongo.Collection
+	systemConfigCol *mongo.Collection
+	receiptsCol     *mongo.Collection
+	usersCol        *mongo.Collection
+	auditLogsCol    *mongo.Collection
+	holdsCol        *mongo.Collection
+	enrollLocksCol  *mongo.Collection
+	eventsCol       *mongo.Collection
+	seatHoldsCol    *mongo.Collection
+	closuresCol     *mongo.Collection
+	courseClient    pb_course.CourseServiceClient
+
+	// Short-lived student lookups, shared by the nested calls of one request
+	studentsMu sync.Mutex
+	students   map[string]cachedStudent
+
+	// Cart limits from system config, reread once limitsExpireAt passes
+	limitsMu       sync.Mutex
+	limits         shared.CartLimits
+	limitsExpireAt time.Time
+}
+
+// studentCacheTTL bounds how long a student lookup is reused
+const studentCacheTTL = 5 * time.Second
+
+// cartLimitsTTL bounds how long a change to the cart limit config can take to apply
+var cartLimitsTTL = 30 * time.Second
+
+// enrollLockTTL bounds how long a crashed request can block the student's next
+// enrollment operation; the lock is normally released as soon as the call returns
+const enrollLockTTL = 30 * time.Second
+
+// Page sizes for GetStudentEnrollments
+const (
+	defaultEnrollmentsPageSize = 50
+	maxEnrollmentsPageSize     = 200
+)
+
+// cachedStudent is a student lookup result; user is nil when no user matched
+type cachedStudent struct {
+	user      *shared.User
+	expiresAt time.Time
 }
 
 // NewEnrollmentService creates a new EnrollmentService instance
 func NewEnrollmentService(client *mongo.Client, db *mongo.Database, courseClient pb_course.CourseServiceClient) *EnrollmentService {
 	return &EnrollmentService{
-		client:         client,
-		db:             db,
-		cartsCol:       db.Collection("carts"),
-		enrollmentsCol: db.Collection("enrollments"),
-		coursesCol:     db.Collection("courses"),
-		courseClient:   courseClient,
+		client:          client,
+		db:              db,
+		cartsCol:        db.Collection("carts"),
+		enrollmentsCol:  db.Collection("enrollments"),
+		coursesCol:      db.Collection("courses"),
+		gradesCol:       db.Collection("grades"),
+		systemConfigCol: db.Collection("system_config"),
+		receiptsCol:     db.Collection("enrollment_receipts"),
+		usersCol:        db.Collection("users"),
+		auditLogsCol:    db.Collection("audit_logs"),
+		holdsCol:        db.Collection("holds"),
+		enrollLocksCol:  db.Collection("enroll_locks"),
+		eventsCol:       db.Collection("enrollment_events"),
+		seatHoldsCol:    db.Collection("seat_holds"),
+		closuresCol:     db.Collection("semester_closures"),
+		courseClient:    courseClient,
+		students:        make(map[string]cachedStudent),
 	}
 }
 
@@ -46,50 +100,82 @@ func (s *EnrollmentService) AddToCart(ctx context.Context, req *pb.AddToCartRequ
 	if req == nil || req.StudentId == "" || req.CourseId == "" {
 		return nil, status.Error(codes.InvalidArgument, "student_id and course_id are required")
 	}
+	if _, err := s.verifyStudent(ctx, req.StudentId); err != nil {
+		return nil, err
+	}
+	// Carts can be built while previewing the upcoming semester
+	if err := s.requirePhase(ctx, shared.EnrollmentWindow.CanAddToCart, "add to cart"); err != nil {
+		return nil, err
+	}
 
 	// 1. Check if course exists and is open (via Course Service)
 	courseResp, err := s.courseClient.GetCourse(ctx, &pb_course.GetCourseRequest{CourseId: req.CourseId})
 	if err != nil || !courseResp.Success {
-		return nil, status.Errorf(codes.NotFound, "course not found or unavailable")
+		return nil, shared.ErrCourseNotFound.Newf("course not found or unavailable").WithParam("course_id", req.CourseId)
+	}
+	// Both the open flag and the course's own window must allow enrollment
+	c := courseResp.Course
+	if c.Cancelled {
+		return nil, shared.ErrCourseCancelled.Newf("course %s has been cancelled", c.Code).WithParam("course_id", req.CourseId)
 	}
-	if !courseResp.Course.IsOpen {
-		return nil, status.Errorf(codes.FailedPrecondition, "course is closed for enrollment")
+	if reason := shared.CourseClosedReason(c.IsOpen, shared.FromProtoTime(c.EnrollOpenAt), shared.FromProtoTime(c.EnrollCloseAt), time.Now()); reason != "" {
+		return nil, shared.ErrCourseClosed.Newf("%s", reason).WithParam("course_id", req.CourseId)
+	}
+	if err := s.checkSemesterOpen(ctx, c.Semester, req.CourseId); err != nil {
+		return nil, err
+	}
+	if reason := s.checkCourseRestrictions(ctx, req.StudentId, courseResp.Course); reason != "" {
+		return nil, shared.ErrCourseRestricted.Newf("%s: %s", courseResp.Course.Code, reason).WithParam("course_id", req.CourseId)
 	}
 
-	// 2. Get or Create Cart
-	var cart shared.Cart
-	err = s.cartsCol.FindOne(ctx, bson.M{"student_id": req.StudentId}).Decode(&cart)
-	if err == mongo.ErrNoDocuments {
+	// 2. Get or Create Cart (an expired cart starts over)
+	cart, _, err := s.loadCart(ctx, req.StudentId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to retrieve cart")
+	}
+	if cart == nil {
 		// Initialize new cart
-		cart = shared.Cart{
+		cart = &shared.Cart{
 			StudentID: req.StudentId,
 			CourseIDs: []string{},
 		}
-	} else if err != nil {
-		return nil, status.Error(codes.Internal, "failed to retrieve cart")
 	}
 
-	// 3. Validation: Check max courses
-	if cart.IsCartFull() {
-		return nil, status.Errorf(codes.FailedPrecondition, "cart is full (max %d courses)", shared.MaxCoursesInCart)
+	// 3. Validation: Check max courses (admin-configurable)
+	maxCourses := s.getCartLimits(ctx).MaxCourses
+	if cart.IsCartFull(maxCourses) {
+		return nil, shared.Errorf(codes.FailedPrecondition, shared.ErrCodeCartFull, "cart is full (max %d courses)", maxCourses)
 	}
 
 	// 4. Validation: Check duplicates
-	if !cart.CanAddCourse(req.CourseId) {
-		return nil, status.Errorf(codes.AlreadyExists, "course already in cart")
+	if !cart.CanAddCourse(req.CourseId, maxCourses) {
+		return nil, shared.NewError(codes.AlreadyExists, shared.ErrCodeAlreadyInCart, "course already in cart")
 	}
 
-	// 5. Update Cart
+	// 5. Update Cart, holding a seat in a nearly full course while seat holds are on.
+	// Both writes share a transaction so a failed cart write leaves no hold behind.
 	update := bson.M{
 		"$addToSet": bson.M{"course_ids": req.CourseId},
 		"$set":      bson.M{"updated_at": time.Now()},
+		"$unset":    bson.M{"expired_at": "", "expired_reason": "", "validation_results": ""},
 	}
 
 	// FIX: Use options.Update() instead of shared.BuildFindOptions
 	opts := options.Update().SetUpsert(true)
 
-	_, err = s.cartsCol.UpdateOne(ctx, bson.M{"student_id": req.StudentId}, update, opts)
+	err = shared.WithTransaction(ctx, s.client, func(sessCtx mongo.SessionContext) error {
+		if err := s.holdSeat(sessCtx, req.StudentId, req.CourseId); err != nil {
+			return err
+		}
+		if _, err := s.cartsCol.UpdateOne(sessCtx, bson.M{"student_id": req.StudentId}, update, opts); err != nil {
+			return status.Error(codes.Internal, "failed to update cart")
+		}
+		return nil
+	})
 	if err != nil {
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
 		return nil, status.Error(codes.Internal, "failed to update cart")
 	}
 
@@ -113,16 +199,25 @@ func (s *EnrollmentService) RemoveFromCart(ctx context.Context, req *pb.RemoveFr
 		return nil, status.Error(codes.InvalidArgument, "invalid arguments")
 	}
 
-	_, err := s.cartsCol.UpdateOne(ctx,
-		bson.M{"student_id": req.StudentId},
-		bson.M{
-			"$pull": bson.M{"course_ids": req.CourseId},
-			"$set":  bson.M{"updated_at": time.Now()},
-		},
-	)
+	// Touching an expired cart would bump updated_at and revive its stale courses
+	cart, _, err := s.loadCart(ctx, req.StudentId)
 	if err != nil {
-		return nil, status.Error(codes.Internal, "failed to remove from cart")
+		return nil, status.Error(codes.Internal, "failed to retrieve cart")
+	}
+	if cart != nil {
+		_, err = s.cartsCol.UpdateOne(ctx,
+			bson.M{"student_id": req.StudentId},
+			bson.M{
+				"$pull":  bson.M{"course_ids": req.CourseId},
+				"$set":   bson.M{"updated_at": time.Now()},
+				"$unset": bson.M{"validation_results": ""},
+			},
+		)
+		if err != nil {
+			return nil, status.Error(codes.Internal, "failed to remove from cart")
+		}
 	}
+	s.releaseSeatHolds(ctx, bson.M{"student_id": req.StudentId, "course_id": req.CourseId})
 
 	// FIX: Wrap the GetCart response into RemoveFromCartResponse
 	getCartResp, err := s.GetCart(ctx, &pb.GetCartRequest{StudentId: req.StudentId})
@@ -142,38 +237,62 @@ func (s *EnrollmentService) GetCart(ctx context.Context, req *pb.GetCartRequest)
 	if req.StudentId == "" {
 		return nil, status.Error(codes.InvalidArgument, "student_id required")
 	}
+	if _, err := s.verifyStudent(ctx, req.StudentId); err != nil {
+		return nil, err
+	}
+
+	// Active holds are surfaced as a blocking warning, even on an empty cart
+	holds, err := s.activeHolds(ctx, req.StudentId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to load holds")
+	}
+	activeHolds := holdSummaries(holds)
+	limits := s.getCartLimits(ctx)
 
 	// Fetch Cart
-	var cartModel shared.Cart
-	err := s.cartsCol.FindOne(ctx, bson.M{"student_id": req.StudentId}).Decode(&cartModel)
-	if err == mongo.ErrNoDocuments {
+	cartModel, expiredReason, err := s.loadCart(ctx, req.StudentId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "db error")
+	}
+	if cartModel == nil {
+		message := "cart is empty"
+		if expiredReason != "" {
+			message = cartExpiredMessage(expiredReason)
+		}
 		return &pb.GetCartResponse{
-			Success: true,
-			Cart:    &pb.Cart{StudentId: req.StudentId, Items: []*pb.CartItem{}},
-			Message: "cart is empty",
+			Success:    true,
+			Cart:       &pb.Cart{StudentId: req.StudentId, Items: []*pb.CartItem{}, ActiveHolds: activeHolds},
+			Message:    message,
+			MaxCourses: int32(limits.MaxCourses),
+			MaxUnits:   int32(limits.MaxUnits),
 		}, nil
-	} else if err != nil {
-		return nil, status.Error(codes.Internal, "db error")
 	}
 
 	// Hydrate Cart Items using Course Service
 	// FIX: Initialize as empty slice to avoid null in JSON
 	cartItems := []*pb.CartItem{}
+	var validatedItems []shared.ValidatedCartItem
 	var totalUnits int32
-	var courseIDs []string
+	var restrictionViolations []string
 
+	// One Course Service call for the whole cart
+	courses := s.batchGetCourses(ctx, cartModel.CourseIDs)
 	for _, cid := range cartModel.CourseIDs {
-		// Call Course Service
-		cResp, err := s.courseClient.GetCourse(ctx, &pb_course.GetCourseRequest{CourseId: cid})
-		if err != nil || !cResp.Success {
+		course, ok := courses[cid]
+		if !ok {
 			log.Printf("Warning: Course %s in cart not found", cid)
 			continue
 		}
 
-		course := cResp.Course
-		courseIDs = append(courseIDs, cid)
+		validatedItems = append(validatedItems, shared.ValidatedCartItem{
+			CourseID: course.Id, CourseCode: course.Code, CourseTitle: course.Title, Units: course.Units,
+		})
 		totalUnits += course.Units
 
+		if reason := s.checkCourseRestrictions(ctx, req.StudentId, course); reason != "" {
+			restrictionViolations = append(restrictionViolations, fmt.Sprintf("%s: %s", course.Code, reason))
+		}
+
 		// Parse schedule for frontend display
 		days, start, end := shared.ParseSchedule(course.Schedule)
 
@@ -194,29 +313,116 @@ func (s *EnrollmentService) GetCart(ctx context.Context, req *pb.GetCartRequest)
 	conflicts := s.checkScheduleConflictsInternal(cartItems)
 	hasConflicts := len(conflicts) > 0
 
-	// Check missing prereqs for ALL items in cart
-	var missingPrereqs []string
+	// Check missing prereqs for ALL items in cart. During advance registration a
+	// prerequisite the student is taking right now counts for next semester's courses.
+	currentSemester := s.getCurrentSemester(ctx)
+	var missingPrereqs, conditionalCourses []string
 	for _, item := range cartItems {
 		pResp, err := s.courseClient.CheckPrerequisites(ctx, &pb_course.CheckPrerequisitesRequest{
-			StudentId: req.StudentId,
-			CourseId:  item.CourseId,
+			StudentId:       req.StudentId,
+			CourseId:        item.CourseId,
+			AllowInProgress: shared.IsFutureSemester(courses[item.CourseId].Semester, currentSemester),
 		})
-		if err == nil && !pResp.AllMet {
+		if err != nil {
+			continue
+		}
+		if !pResp.AllMet {
 			missingPrereqs = append(missingPrereqs, item.CourseId)
+		} else if pResp.Provisional {
+			conditionalCourses = append(conditionalCourses, item.CourseId)
 		}
 	}
 
+	// Falling short of the full-time load is only a notice; it never blocks enrollment
+	var warnings []string
+	semesters := make([]string, 0, len(courses))
+	for _, course := range courses {
+		semesters = append(semesters, course.Semester)
+	}
+	if activeUnits, err := s.getActiveUnits(ctx, req.StudentId, semesters); err == nil {
+		warnings = s.loadWarnings(ctx, activeUnits+totalUnits)
+	} else {
+		log.Printf("Warning: failed to compute enrolled units for %s: %v", req.StudentId, err)
+	}
+
+	s.saveCartValidation(ctx, cartModel, &shared.CartValidation{
+		TotalUnits:            totalUnits,
+		HasConflicts:          hasConflicts,
+		MissingPrerequisites:  missingPrereqs,
+		RestrictionViolations: restrictionViolations,
+		ConditionalCourses:    conditionalCourses,
+		Items:                 validatedItems,
+		CartUpdatedAt:         cartModel.UpdatedAt,
+		ComputedAt:            time.Now(),
+	})
+
 	return &pb.GetCartResponse{
 		Success: true,
 		Cart: &pb.Cart{
-			StudentId:            req.StudentId,
-			Items:                cartItems,
-			TotalUnits:           totalUnits,
-			HasConflicts:         hasConflicts,
-			MissingPrerequisites: missingPrereqs,
-			UpdatedAt:            timestamppb.New(cartModel.UpdatedAt),
+			StudentId:             req.StudentId,
+			Items:                 cartItems,
+			TotalUnits:            totalUnits,
+			HasConflicts:          hasConflicts,
+			MissingPrerequisites:  missingPrereqs,
+			UpdatedAt:             shared.ToProtoTime(cartModel.UpdatedAt),
+			RestrictionViolations: restrictionViolations,
+			ActiveHolds:           activeHolds,
+			ConditionalCourses:    conditionalCourses,
+			Warnings:              warnings,
 		},
-		Message: "cart retrieved",
+		Message:    "cart retrieved",
+		MaxCourses: int32(limits.MaxCourses),
+		MaxUnits:   int32(limits.MaxUnits),
+	}, nil
+}
+
+// GetCartSummary returns the item count and total units of the cart for a badge.
+// Units come from one query on the courses collection rather than hydrating every
+// item through the Course Service; courses that no longer exist are skipped, as in GetCart.
+func (s *EnrollmentService) GetCartSummary(ctx context.Context, req *pb.GetCartSummaryRequest) (*pb.GetCartSummaryResponse, error) {
+	if req.StudentId == "" {
+		return nil, status.Error(codes.InvalidArgument, "student_id required")
+	}
+	if _, err := s.verifyStudent(ctx, req.StudentId); err != nil {
+		return nil, err
+	}
+
+	cartModel, expiredReason, err := s.loadCart(ctx, req.StudentId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "db error")
+	}
+	if cartModel == nil || len(cartModel.CourseIDs) == 0 {
+		message := "cart is empty"
+		if expiredReason != "" {
+			message = cartExpiredMessage(expiredReason)
+		}
+		return &pb.GetCartSummaryResponse{Success: true, Message: message}, nil
+	}
+
+	cursor, err := s.coursesCol.Find(ctx,
+		bson.M{"_id": bson.M{"$in": cartModel.CourseIDs}},
+		options.Find().SetProjection(bson.M{"units": 1}),
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to load cart courses")
+	}
+	var courses []struct {
+		Units int32 `bson:"units"`
+	}
+	if err := cursor.All(ctx, &courses); err != nil {
+		return nil, status.Error(codes.Internal, "failed to decode cart courses")
+	}
+
+	var totalUnits int32
+	for _, c := range courses {
+		totalUnits += c.Units
+	}
+
+	return &pb.GetCartSummaryResponse{
+		Success:    true,
+		ItemCount:  int32(len(courses)),
+		TotalUnits: totalUnits,
+		Message:    "cart summary retrieved",
 	}, nil
 }
 
@@ -226,6 +432,7 @@ func (s *EnrollmentService) ClearCart(ctx context.Context, req *pb.ClearCartRequ
 	if err != nil {
 		return nil, status.Error(codes.Internal, "failed to clear cart")
 	}
+	s.releaseSeatHolds(ctx, bson.M{"student_id": req.StudentId})
 	return &pb.ClearCartResponse{Success: true, Message: "cart cleared"}, nil
 }
 
@@ -234,42 +441,121 @@ func (s *EnrollmentService) EnrollAll(ctx context.Context, req *pb.EnrollAllRequ
 	if req.StudentId == "" {
 		return nil, status.Error(codes.InvalidArgument, "student_id required")
 	}
+	if _, err := s.verifyStudent(ctx, req.StudentId); err != nil {
+		return nil, err
+	}
+	if err := s.requirePhase(ctx, shared.EnrollmentWindow.CanEnroll, "enroll"); err != nil {
+		return nil, err
+	}
 
-	// 1. Get Cart
-	getCartResp, err := s.GetCart(ctx, &pb.GetCartRequest{StudentId: req.StudentId})
+	// A double-submit must not read the same cart and interleave transactions
+	release, err := s.acquireEnrollLock(ctx, req.StudentId, "enroll_all")
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	// 1. Get Cart, reusing the validation of a recent GetCart
+	cart, err := s.validatedCart(ctx, req.StudentId)
 	if err != nil {
 		return nil, err
 	}
-	cart := getCartResp.Cart
 	if len(cart.Items) == 0 {
-		return nil, status.Error(codes.FailedPrecondition, "cart is empty")
+		return nil, shared.NewError(codes.FailedPrecondition, shared.ErrCodeCartEmpty, "cart is empty")
 	}
 
 	// 2. Pre-Transaction Validation
+	if len(cart.ActiveHolds) > 0 {
+		return nil, shared.Errorf(codes.FailedPrecondition, shared.ErrCodeEnrollmentHold,
+			"enrollment blocked by %s", strings.Join(cart.ActiveHolds, "; "))
+	}
 	// FIX: Access fields directly on the Protobuf Cart struct, not ValidationResults
 	if cart.HasConflicts {
-		return nil, status.Error(codes.FailedPrecondition, "schedule conflicts detected in cart")
+		return nil, shared.NewError(codes.FailedPrecondition, shared.ErrCodeScheduleConflict, "schedule conflicts detected in cart")
 	}
 	if len(cart.MissingPrerequisites) > 0 {
-		return nil, status.Error(codes.FailedPrecondition, "prerequisites not met for some courses")
+		return nil, shared.ErrPrereqNotMet.Newf("prerequisites not met for some courses")
+	}
+	if len(cart.RestrictionViolations) > 0 {
+		return nil, shared.Errorf(codes.FailedPrecondition, shared.ErrCodeCourseRestricted,
+			"course restrictions not met: %s", strings.Join(cart.RestrictionViolations, "; "))
 	}
-	if cart.TotalUnits > shared.MaxUnitsPerSemester {
-		return nil, status.Error(codes.FailedPrecondition, "max units exceeded")
+
+	// Units already carried this semester count against the cap as well
+	var courseIDs []string
+	for _, item := range cart.Items {
+		courseIDs = append(courseIDs, item.CourseId)
+	}
+	semesters, err := s.getCourseSemesters(ctx, courseIDs)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to resolve cart semesters")
+	}
+	currentUnits, err := s.getActiveUnits(ctx, req.StudentId, semesters)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to compute enrolled units")
+	}
+	if maxUnits := int32(s.getCartLimits(ctx).MaxUnits); currentUnits+cart.TotalUnits > maxUnits {
+		return nil, shared.Errorf(codes.FailedPrecondition, shared.ErrCodeUnitLimitExceeded,
+			"max units exceeded: %d enrolled + %d in cart = %d (max %d)",
+			currentUnits, cart.TotalUnits, currentUnits+cart.TotalUnits, maxUnits)
 	}
 
+	// Enrollments relying on in-progress prerequisites are flagged for later review
+	conditional := make(map[string]bool, len(cart.ConditionalCourses))
+	for _, id := range cart.ConditionalCourses {
+		conditional[id] = true
+	}
+
+	// Restrictions are re-checked against the stored course inside the transaction
+	major, yearLevel := s.getStudentProfile(ctx, req.StudentId)
+	warnPercent := shared.GetCapacityWarningPercent(ctx, s.systemConfigCol)
+	holdsOn := shared.GetSeatHoldDuration(ctx, s.systemConfigCol) > 0
+
 	// 3. Execute Transaction
 	// We use the shared.WithTransaction helper
+	var receipt shared.EnrollmentReceipt
+	var nearlyFull []shared.Course
 	err = shared.WithTransaction(ctx, s.client, func(sessCtx mongo.SessionContext) error {
+		// Rebuilt on every attempt since the driver may retry the transaction
+		receipt = shared.EnrollmentReceipt{
+			ID:        shared.GenerateReceiptID(),
+			StudentID: req.StudentId,
+			CreatedAt: time.Now(),
+		}
+		nearlyFull = nil
+
 		for _, item := range cart.Items {
 			// A. Check capacity directly on DB (ensure atomic read)
 			var courseDoc shared.Course
 			err := s.coursesCol.FindOne(sessCtx, bson.M{"_id": item.CourseId}).Decode(&courseDoc)
 			if err != nil {
-				return fmt.Errorf("course %s not found during enrollment", item.CourseId)
+				return shared.ErrCourseNotFound.Newf("course %s not found during enrollment", item.CourseId).WithParam("course_id", item.CourseId)
 			}
 
-			if !courseDoc.IsOpen || courseDoc.GetSeatsAvailable() <= 0 {
-				return fmt.Errorf("course %s is full or closed", item.CourseCode)
+			if courseDoc.Cancelled {
+				return shared.ErrCourseCancelled.Newf("course %s has been cancelled", item.CourseCode).WithParam("course_id", item.CourseId)
+			}
+			if reason := courseDoc.ClosedReason(time.Now()); reason != "" {
+				return shared.ErrCourseClosed.Newf("%s: %s", item.CourseCode, reason).WithParam("course_id", item.CourseId)
+			}
+			if err := s.checkSemesterOpen(sessCtx, courseDoc.Semester, item.CourseId); err != nil {
+				return err
+			}
+			if courseDoc.GetSeatsAvailable() <= 0 {
+				return shared.ErrCourseFull.Newf("course %s is full", item.CourseCode).WithParam("course_id", item.CourseId)
+			}
+			// Seats held in other carts are taken as well; the student's own hold is theirs to use
+			if holdsOn {
+				held, err := shared.CountSeatHolds(sessCtx, s.seatHoldsCol, item.CourseId, req.StudentId, time.Now())
+				if err != nil {
+					return err
+				}
+				if shared.SeatsAvailable(courseDoc.Capacity, courseDoc.Enrolled+held) <= 0 {
+					return shared.ErrCourseFull.Newf("course %s is full: the remaining seats are held in other carts", item.CourseCode).WithParam("course_id", item.CourseId)
+				}
+			}
+			if reason := courseDoc.RestrictionViolation(major, yearLevel); reason != "" {
+				return shared.ErrCourseRestricted.Newf("%s: %s", item.CourseCode, reason).WithParam("course_id", item.CourseId)
 			}
 
 			// B. Check if already enrolled
@@ -279,21 +565,18 @@ func (s *EnrollmentService) EnrollAll(ctx context.Context, req *pb.EnrollAllRequ
 				"status":     shared.StatusEnrolled,
 			})
 			if count > 0 {
-				return fmt.Errorf("already enrolled in %s", item.CourseCode)
+				return shared.ErrAlreadyEnrolled.Newf("already enrolled in %s", item.CourseCode).WithParam("course_id", item.CourseId)
 			}
 
 			// C. Create Enrollment Record
 			enrollment := shared.Enrollment{
-				ID:         shared.GenerateEnrollmentID(),
-				StudentID:  req.StudentId,
-				CourseID:   item.CourseId,
-				Status:     shared.StatusEnrolled,
-				EnrolledAt: time.Now(),
-				ScheduleInfo: shared.ScheduleInfo{
-					Days:      item.ScheduleInfo.Days,
-					StartTime: item.ScheduleInfo.StartTime,
-					EndTime:   item.ScheduleInfo.EndTime,
-				},
+				ID:           shared.GenerateEnrollmentID(),
+				StudentID:    req.StudentId,
+				CourseID:     item.CourseId,
+				Status:       shared.StatusEnrolled,
+				EnrolledAt:   time.Now(),
+				ScheduleInfo: shared.NewScheduleInfo(courseDoc.Schedule),
+				Conditional:  conditional[item.CourseId],
 			}
 			_, err = s.enrollmentsCol.InsertOne(sessCtx, enrollment)
 			if err != nil {
@@ -308,24 +591,60 @@ func (s *EnrollmentService) EnrollAll(ctx context.Context, req *pb.EnrollAllRequ
 			if err != nil {
 				return err
 			}
+			if err := shared.RecordEnrollmentEvent(sessCtx, s.client, s.eventsCol, item.CourseId, 1, shared.EventSourceSelf); err != nil {
+				return err
+			}
+
+			// Warn only when this seat crosses the threshold, not on every later enrollment
+			wasNearlyFull := courseDoc.IsNearlyFull(warnPercent)
+			courseDoc.Enrolled++
+			if !wasNearlyFull && courseDoc.IsNearlyFull(warnPercent) {
+				nearlyFull = append(nearlyFull, courseDoc)
+			}
+
+			receipt.Courses = append(receipt.Courses, shared.ReceiptCourse{
+				EnrollmentID: enrollment.ID,
+				CourseID:     item.CourseId,
+				CourseCode:   item.CourseCode,
+				CourseTitle:  item.CourseTitle,
+				Units:        item.Units,
+			})
+			receipt.TotalUnits += item.Units
 		}
 
-		// E. Clear Cart on success
+		// E. Record the confirmation receipt alongside the enrollments
+		if _, err := s.receiptsCol.InsertOne(sessCtx, receipt); err != nil {
+			return err
+		}
+
+		// F. Clear Cart on success; its seat holds have turned into enrollments
+		if _, err := s.seatHoldsCol.DeleteMany(sessCtx, bson.M{"student_id": req.StudentId}); err != nil {
+			return err
+		}
 		_, err = s.cartsCol.DeleteOne(sessCtx, bson.M{"student_id": req.StudentId})
 		return err
 	})
 
 	if err != nil {
-		// Return failure
-		// FIX: Access MissingPrerequisites directly for the error message
+		// Report the course that aborted the transaction when the error names one
+		failed := cart.MissingPrerequisites
+		if courseID := shared.ErrorParamsOf(err)["course_id"]; courseID != "" {
+			failed = []string{courseID}
+		}
 		return &pb.EnrollAllResponse{
 			Success:       false,
-			Message:       fmt.Sprintf("Enrollment failed: %v", err),
-			FailedCourses: cart.MissingPrerequisites,
+			Message:       fmt.Sprintf("Enrollment failed: %s", status.Convert(err).Message()),
+			FailedCourses: failed,
+			ErrorCode:     string(shared.ErrorCodeOf(err)),
 		}, nil
 	}
 
-	// 4. Retrieve newly created enrollments for response
+	// 4. Notify about courses this enrollment pushed past the warning threshold
+	for i := range nearlyFull {
+		s.warnNearlyFull(ctx, &nearlyFull[i], warnPercent, req.StudentId)
+	}
+
+	// 5. Retrieve newly created enrollments for response
 	enrollmentsResp, _ := s.GetStudentEnrollments(ctx, &pb.GetStudentEnrollmentsRequest{
 		StudentId: req.StudentId,
 		Status:    shared.StatusEnrolled,
@@ -335,36 +654,79 @@ func (s *EnrollmentService) EnrollAll(ctx context.Context, req *pb.EnrollAllRequ
 		Success:     true,
 		Message:     "successfully enrolled in all courses",
 		Enrollments: enrollmentsResp.Enrollments,
+		Receipt:     receiptToProto(&receipt),
+		Warnings:    s.loadWarnings(ctx, currentUnits+receipt.TotalUnits),
 	}, nil
 }
 
-// DropCourse drops a student from a course
+// GetEnrollmentReceipt returns a stored enrollment confirmation by reference ID
+func (s *EnrollmentService) GetEnrollmentReceipt(ctx context.Context, req *pb.GetEnrollmentReceiptRequest) (*pb.GetEnrollmentReceiptResponse, error) {
+	if req.ReferenceId == "" {
+		return nil, status.Error(codes.InvalidArgument, "reference_id is required")
+	}
+
+	queryCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	filter := bson.M{"_id": req.ReferenceId}
+	if req.StudentId != "" {
+		filter["student_id"] = req.StudentId
+	}
+
+	var receipt shared.EnrollmentReceipt
+	if err := s.receiptsCol.FindOne(queryCtx, filter).Decode(&receipt); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, status.Errorf(codes.NotFound, "receipt not found: %s", req.ReferenceId)
+		}
+		return nil, status.Error(codes.Internal, "failed to retrieve receipt")
+	}
+
+	return &pb.GetEnrollmentReceiptResponse{
+		Success: true,
+		Receipt: receiptToProto(&receipt),
+		Message: "receipt retrieved",
+	}, nil
+}
+
+// DropCourse drops a student from a course and removes it from their cart. Once
+// the student has made max_drops_per_semester drops in the course's semester the
+// drop is refused with DROP_LIMIT_REACHED; admins can still force the drop.
 func (s *EnrollmentService) DropCourse(ctx context.Context, req *pb.DropCourseRequest) (*pb.DropCourseResponse, error) {
 	if req.StudentId == "" || req.CourseId == "" {
 		return nil, status.Error(codes.InvalidArgument, "invalid args")
 	}
+	if _, err := s.verifyStudent(ctx, req.StudentId); err != nil {
+		return nil, err
+	}
+	if err := s.requirePhase(ctx, shared.EnrollmentWindow.CanDrop, "drop"); err != nil {
+		return nil, err
+	}
+
+	release, err := s.acquireEnrollLock(ctx, req.StudentId, "drop_course")
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	maxDrops := shared.GetMaxDrops(ctx, s.systemConfigCol)
 
 	// Transactional Drop
-	err := shared.WithTransaction(ctx, s.client, func(sessCtx mongo.SessionContext) error {
-		// 1. Update Enrollment Status
-		res, err := s.enrollmentsCol.UpdateOne(sessCtx,
-			bson.M{
-				"student_id": req.StudentId,
-				"course_id":  req.CourseId,
-				"status":     shared.StatusEnrolled,
-			},
-			bson.M{
-				"$set": bson.M{
-					"status":     shared.StatusDropped,
-					"dropped_at": time.Now(),
-				},
-			},
-		)
+	err = shared.WithTransaction(ctx, s.client, func(sessCtx mongo.SessionContext) error {
+		// 1. Update Enrollment Status; completed enrollments cannot be dropped
+		enrollment, err := shared.FindCurrentEnrollment(sessCtx, s.enrollmentsCol, req.StudentId, req.CourseId)
+		if err == mongo.ErrNoDocuments {
+			return shared.ErrNotEnrolled.Newf("enrollment not found or already dropped")
+		}
 		if err != nil {
 			return err
 		}
-		if res.MatchedCount == 0 {
-			return fmt.Errorf("enrollment not found or already dropped")
+		if err := s.checkDropLimit(sessCtx, req.StudentId, req.CourseId, maxDrops); err != nil {
+			return err
+		}
+		if err := shared.TransitionEnrollment(sessCtx, s.enrollmentsCol, enrollment, shared.StatusDropped,
+			bson.M{"$set": bson.M{"dropped_at": time.Now()}},
+		); err != nil {
+			return err
 		}
 
 		// 2. Increment Seat (Free up space)
@@ -372,27 +734,119 @@ func (s *EnrollmentService) DropCourse(ctx context.Context, req *pb.DropCourseRe
 			bson.M{"_id": req.CourseId},
 			bson.M{"$inc": bson.M{"enrolled": -1}},
 		)
+		if err != nil {
+			return err
+		}
+		if err := shared.RecordEnrollmentEvent(sessCtx, s.client, s.eventsCol, req.CourseId, -1, shared.EventSourceSelf); err != nil {
+			return err
+		}
+
+		// 3. Leave no stale cart entry for the dropped course
+		_, err = s.cartsCol.UpdateOne(sessCtx,
+			bson.M{"student_id": req.StudentId, "course_ids": req.CourseId},
+			bson.M{
+				"$pull":  bson.M{"course_ids": req.CourseId},
+				"$set":   bson.M{"updated_at": time.Now()},
+				"$unset": bson.M{"validation_results": ""},
+			},
+		)
 		return err
 	})
 
 	if err != nil {
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
 		return nil, status.Errorf(codes.Internal, "failed to drop course: %v", err)
 	}
 
 	return &pb.DropCourseResponse{Success: true, Message: "course dropped"}, nil
 }
 
-// CheckConflicts checks for schedule conflicts (public RPC)
+// GetEnrollmentStatus reports the current enrollment phase and what students may do in it
+func (s *EnrollmentService) GetEnrollmentStatus(ctx context.Context, req *pb.GetEnrollmentStatusRequest) (*pb.GetEnrollmentStatusResponse, error) {
+	window := shared.LoadEnrollmentWindow(ctx, s.systemConfigCol)
+	now := time.Now()
+	phase := window.Phase(now)
+
+	var message string
+	switch phase {
+	case shared.PhasePreview:
+		message = "enrollment has not opened yet; carts can be prepared"
+	case shared.PhaseOpen:
+		message = "enrollment is open"
+	default:
+		message = "enrollment is closed"
+	}
+
+	return &pb.GetEnrollmentStatusResponse{
+		Phase:           phase,
+		EnrollmentStart: shared.ToProtoTime(window.Start),
+		EnrollmentEnd:   shared.ToProtoTime(window.End),
+		DropDeadline:    shared.ToProtoTime(window.DropDeadline()),
+		CanAddToCart:    window.CanAddToCart(now),
+		CanEnroll:       window.CanEnroll(now),
+		CanDrop:         window.CanDrop(now),
+		Message:         message,
+	}, nil
+}
+
+// CheckConflicts checks for schedule conflicts (public RPC). With include_enrolled
+// the requested courses are also checked against the student's active enrollments;
+// clashes among those enrollments alone are not reported.
 func (s *EnrollmentService) CheckConflicts(ctx context.Context, req *pb.CheckConflictsRequest) (*pb.CheckConflictsResponse, error) {
-	// 1. Fetch details for all requested courses
+	var enrolledIDs []string
+	if req.IncludeEnrolled {
+		if req.StudentId == "" {
+			return nil, status.Error(codes.InvalidArgument, "student_id required with include_enrolled")
+		}
+		ids, err := s.enrollmentsCol.Distinct(ctx, "course_id", bson.M{"student_id": req.StudentId, "status": shared.StatusEnrolled})
+		if err != nil {
+			return nil, status.Error(codes.Internal, "failed to load enrollments")
+		}
+		for _, v := range ids {
+			if id, ok := v.(string); ok {
+				enrolledIDs = append(enrolledIDs, id)
+			}
+		}
+	}
+
+	// 1. Fetch details for all requested and enrolled courses
 	var cartItems []*pb.CartItem
+	courses := s.batchGetCourses(ctx, append(append([]string{}, req.CourseIds...), enrolledIDs...))
+
+	// Enrollments only matter in the semesters of the requested courses
+	semesters := make(map[string]bool)
+	requested := make(map[string]bool)
 	for _, cid := range req.CourseIds {
-		cResp, err := s.courseClient.GetCourse(ctx, &pb_course.GetCourseRequest{CourseId: cid})
-		if err == nil && cResp.Success {
-			days, start, end := shared.ParseSchedule(cResp.Course.Schedule)
+		requested[cid] = true
+		if course, ok := courses[cid]; ok {
+			semesters[course.Semester] = true
+		}
+	}
+	// A course listed twice, or requested while already enrolled, is checked once
+	// so it is not reported as conflicting with itself
+	var ids []string
+	seen := make(map[string]bool)
+	for _, cid := range req.CourseIds {
+		if !seen[cid] {
+			seen[cid] = true
+			ids = append(ids, cid)
+		}
+	}
+	for _, cid := range enrolledIDs {
+		if course, ok := courses[cid]; ok && semesters[course.Semester] && !seen[cid] {
+			seen[cid] = true
+			ids = append(ids, cid)
+		}
+	}
+
+	for _, cid := range ids {
+		if course, ok := courses[cid]; ok {
+			days, start, end := shared.ParseSchedule(course.Schedule)
 			cartItems = append(cartItems, &pb.CartItem{
-				CourseId:   cResp.Course.Id,
-				CourseCode: cResp.Course.Code,
+				CourseId:   course.Id,
+				CourseCode: course.Code,
 				ScheduleInfo: &pb.ScheduleInfo{
 					Days:      days,
 					StartTime: start,
@@ -403,7 +857,12 @@ func (s *EnrollmentService) CheckConflicts(ctx context.Context, req *pb.CheckCon
 	}
 
 	// 2. Check Logic
-	conflicts := s.checkScheduleConflictsInternal(cartItems)
+	var conflicts []*pb.Conflict
+	for _, c := range s.checkScheduleConflictsInternal(cartItems) {
+		if requested[c.Course1Id] || requested[c.Course2Id] {
+			conflicts = append(conflicts, c)
+		}
+	}
 	return &pb.CheckConflictsResponse{
 		HasConflicts: len(conflicts) > 0,
 		Conflicts:    conflicts,
@@ -411,41 +870,79 @@ func (s *EnrollmentService) CheckConflicts(ctx context.Context, req *pb.CheckCon
 	}, nil
 }
 
-// GetStudentEnrollments returns a list of enrollments
+// GetStudentEnrollments returns a list of enrollments, with the drops the student
+// has left in the requested (or current) semester
 func (s *EnrollmentService) GetStudentEnrollments(ctx context.Context, req *pb.GetStudentEnrollmentsRequest) (*pb.GetStudentEnrollmentsResponse, error) {
+	if req.StudentId == "" {
+		return nil, status.Error(codes.InvalidArgument, "student_id required")
+	}
+	if _, err := s.verifyStudent(ctx, req.StudentId); err != nil {
+		return nil, err
+	}
+
+	page, pageSize := shared.NormalizePage(req.Page, req.PageSize, defaultEnrollmentsPageSize, maxEnrollmentsPageSize)
+
+	queryCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
 	filter := bson.M{"student_id": req.StudentId}
 	if req.Status != "" {
 		filter["status"] = req.Status
 	}
+	if req.Semester != "" {
+		// Enrollments carry no semester; narrow to the semester's courses
+		courseIDs, err := s.coursesCol.Distinct(queryCtx, "_id", bson.M{"semester": req.Semester})
+		if err != nil {
+			return nil, status.Error(codes.Internal, "db error")
+		}
+		filter["course_id"] = bson.M{"$in": courseIDs}
+	}
 
-	cursor, err := s.enrollmentsCol.Find(ctx, filter)
+	opts := options.Find().SetSort(bson.D{{Key: "enrolled_at", Value: -1}, {Key: "_id", Value: 1}})
+	docs, pageInfo, err := shared.Paginate[shared.Enrollment](queryCtx, s.enrollmentsCol, filter, opts, page, pageSize)
 	if err != nil {
 		return nil, status.Error(codes.Internal, "db error")
 	}
-	defer cursor.Close(ctx)
-
-	var enrollments []*pb.Enrollment
-	var totalUnits int32
 
-	for cursor.Next(ctx) {
-		var doc shared.Enrollment
-		if err := cursor.Decode(&doc); err != nil {
-			continue
+	// Active enrollments count toward total units even when on another page
+	var activeCourseIDs []string
+	if req.Status == "" || req.Status == shared.StatusEnrolled {
+		activeFilter := bson.M{}
+		for k, v := range filter {
+			activeFilter[k] = v
+		}
+		activeFilter["status"] = shared.StatusEnrolled
+		values, err := s.enrollmentsCol.Distinct(queryCtx, "course_id", activeFilter)
+		if err != nil {
+			return nil, status.Error(codes.Internal, "db error")
 		}
+		for _, v := range values {
+			if id, ok := v.(string); ok {
+				activeCourseIDs = append(activeCourseIDs, id)
+			}
+		}
+	}
 
-		// Hydrate with Course Details
-		cResp, err := s.courseClient.GetCourse(ctx, &pb_course.GetCourseRequest{CourseId: doc.CourseID})
-		var code, title string
-		var units int32
+	// Hydrate course details with one batch lookup instead of a call per enrollment
+	courseIDs := append([]string{}, activeCourseIDs...)
+	for _, doc := range docs {
+		courseIDs = append(courseIDs, doc.CourseID)
+	}
+	courses := s.batchGetCourses(queryCtx, courseIDs)
 
-		if err == nil && cResp.Success {
-			code = cResp.Course.Code
-			title = cResp.Course.Title
-			units = cResp.Course.Units
+	var totalUnits int32
+	for _, id := range activeCourseIDs {
+		if c, ok := courses[id]; ok {
+			totalUnits += c.Units
 		}
+	}
 
-		if doc.Status == shared.StatusEnrolled {
-			totalUnits += units
+	enrollments := make([]*pb.Enrollment, 0, len(docs))
+	for _, doc := range docs {
+		var code, title string
+		var units int32
+		if c, ok := courses[doc.CourseID]; ok {
+			code, title, units = c.Code, c.Title, c.Units
 		}
 
 		enrollments = append(enrollments, &pb.Enrollment{
@@ -456,8 +953,8 @@ func (s *EnrollmentService) GetStudentEnrollments(ctx context.Context, req *pb.G
 			CourseTitle: title,
 			Units:       units,
 			Status:      doc.Status,
-			EnrolledAt:  timestamppb.New(doc.EnrolledAt),
-			DroppedAt:   timestamppb.New(doc.DroppedAt),
+			EnrolledAt:  shared.ToProtoTime(doc.EnrolledAt),
+			DroppedAt:   shared.ToProtoTime(doc.DroppedAt),
 			ScheduleInfo: &pb.ScheduleInfo{
 				Days:      doc.ScheduleInfo.Days,
 				StartTime: doc.ScheduleInfo.StartTime,
@@ -466,9 +963,20 @@ func (s *EnrollmentService) GetStudentEnrollments(ctx context.Context, req *pb.G
 		})
 	}
 
+	maxDrops, remaining, dropsSemester, err := s.remainingDrops(queryCtx, req.StudentId, req.Semester)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "db error")
+	}
+
 	return &pb.GetStudentEnrollmentsResponse{
-		Enrollments: enrollments,
-		TotalUnits:  totalUnits,
+		Enrollments:         enrollments,
+		TotalUnits:          totalUnits,
+		TotalCount:          int32(pageInfo.Total),
+		Page:                pageInfo.Page,
+		PageSize:            pageInfo.PageSize,
+		MaxDropsPerSemester: maxDrops,
+		RemainingDrops:      remaining,
+		DropsSemester:       dropsSemester,
 	}, nil
 }
 
@@ -476,6 +984,35 @@ func (s *EnrollmentService) GetStudentEnrollments(ctx context.Context, req *pb.G
 // Internal Helper Functions
 // ============================================================================
 
+// batchGetCourses fetches course details for the given IDs in a single course
+// service call, keyed by course ID. Lookup failures yield an empty map so
+// callers fall back to blank course fields.
+func (s *EnrollmentService) batchGetCourses(ctx context.Context, courseIDs []string) map[string]*pb_course.Course {
+	courses := make(map[string]*pb_course.Course, len(courseIDs))
+	if len(courseIDs) == 0 {
+		return courses
+	}
+
+	seen := make(map[string]bool, len(courseIDs))
+	unique := make([]string, 0, len(courseIDs))
+	for _, id := range courseIDs {
+		if !seen[id] {
+			seen[id] = true
+			unique = append(unique, id)
+		}
+	}
+
+	resp, err := s.courseClient.BatchGetCourses(ctx, &pb_course.BatchGetCoursesRequest{CourseIds: unique})
+	if err != nil {
+		log.Printf("Error batch fetching courses: %v", err)
+		return courses
+	}
+	for _, c := range resp.Courses {
+		courses[c.Id] = c
+	}
+	return courses
+}
+
 func (s *EnrollmentService) checkScheduleConflictsInternal(items []*pb.CartItem) []*pb.Conflict {
 	var conflicts []*pb.Conflict
 
@@ -520,3 +1057,586 @@ func (s *EnrollmentService) checkScheduleConflictsInternal(items []*pb.CartItem)
 	}
 	return conflicts
 }
+
+// getCourseSemesters returns the distinct semesters of the given courses
+func (s *EnrollmentService) getCourseSemesters(ctx context.Context, courseIDs []string) ([]string, error) {
+	if len(courseIDs) == 0 {
+		return []string{}, nil
+	}
+
+	values, err := s.coursesCol.Distinct(ctx, "semester", bson.M{"_id": bson.M{"$in": courseIDs}})
+	if err != nil {
+		return nil, err
+	}
+
+	semesters := make([]string, 0, len(values))
+	for _, v := range values {
+		if sem, err := shared.GetString(v); err == nil {
+			semesters = append(semesters, sem)
+		}
+	}
+	return semesters, nil
+}
+
+// loadWarnings returns the non-blocking notices for a semester load of units, currently
+// only a load below the configured min_full_time_units
+func (s *EnrollmentService) loadWarnings(ctx context.Context, units int32) []string {
+	if warning := shared.FullTimeLoadWarning(units, shared.GetMinFullTimeUnits(ctx, s.systemConfigCol)); warning != "" {
+		return []string{warning}
+	}
+	return nil
+}
+
+// getActiveUnits sums the units of a student's active enrollments in the given semesters.
+// Dropped enrollments and enrollments already graded W (withdrawn) are not counted.
+func (s *EnrollmentService) getActiveUnits(ctx context.Context, studentID string, semesters []string) (int32, error) {
+	cursor, err := s.enrollmentsCol.Find(ctx, bson.M{
+		"student_id": studentID,
+		"status":     shared.StatusEnrolled,
+	})
+	if err != nil {
+		return 0, err
+	}
+	defer cursor.Close(ctx)
+
+	var enrollments []shared.Enrollment
+	if err := cursor.All(ctx, &enrollments); err != nil {
+		return 0, err
+	}
+	if len(enrollments) == 0 {
+		return 0, nil
+	}
+
+	enrollmentIDs := make([]string, 0, len(enrollments))
+	for _, e := range enrollments {
+		enrollmentIDs = append(enrollmentIDs, e.ID)
+	}
+
+	// Exclude withdrawn enrollments
+	withdrawn := make(map[string]bool)
+	wCursor, err := s.gradesCol.Find(ctx, bson.M{
+		"enrollment_id": bson.M{"$in": enrollmentIDs},
+		"grade":         shared.GradeW,
+	})
+	if err != nil {
+		return 0, err
+	}
+	defer wCursor.Close(ctx)
+	for wCursor.Next(ctx) {
+		var g shared.Grade
+		if err := wCursor.Decode(&g); err == nil {
+			withdrawn[g.EnrollmentID] = true
+		}
+	}
+
+	var courseIDs []string
+	for _, e := range enrollments {
+		if !withdrawn[e.ID] {
+			courseIDs = append(courseIDs, e.CourseID)
+		}
+	}
+	if len(courseIDs) == 0 {
+		return 0, nil
+	}
+
+	courseFilter := bson.M{"_id": bson.M{"$in": courseIDs}}
+	if len(semesters) > 0 {
+		courseFilter["semester"] = bson.M{"$in": semesters}
+	}
+
+	cCursor, err := s.coursesCol.Find(ctx, courseFilter)
+	if err != nil {
+		return 0, err
+	}
+	defer cCursor.Close(ctx)
+
+	var total int32
+	for cCursor.Next(ctx) {
+		var c shared.Course
+		if err := cCursor.Decode(&c); err == nil {
+			total += c.Units
+		}
+	}
+	return total, nil
+}
+
+// checkCourseRestrictions returns the reason the student may not take the course, or "".
+// The student's profile is only looked up when the course is restricted.
+func (s *EnrollmentService) checkCourseRestrictions(ctx context.Context, studentID string, course *pb_course.Course) string {
+	restrictions := shared.Course{AllowedMajors: course.AllowedMajors, MinYearLevel: course.MinYearLevel}
+	if !restrictions.HasRestrictions() {
+		return ""
+	}
+	major, yearLevel := s.getStudentProfile(ctx, studentID)
+	return restrictions.RestrictionViolation(major, yearLevel)
+}
+
+// getStudentProfile returns the student's major and year level
+// (empty values when the user document cannot be found)
+func (s *EnrollmentService) getStudentProfile(ctx context.Context, studentID string) (string, int32) {
+	user, err := s.lookupStudent(ctx, studentID)
+	if err != nil {
+		log.Printf("Warning: failed to load profile for student %s: %v", studentID, err)
+		return "", 0
+	}
+	if user == nil {
+		return "", 0
+	}
+	return user.Major, user.YearLevel
+}
+
+// verifyStudent ensures studentID belongs to an existing, active student
+func (s *EnrollmentService) verifyStudent(ctx context.Context, studentID string) (*shared.User, error) {
+	user, err := s.lookupStudent(ctx, studentID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to verify student")
+	}
+	if user == nil {
+		return nil, shared.NewError(codes.NotFound, shared.ErrCodeStudentNotFound, "student not found")
+	}
+	if user.Role != shared.RoleStudent {
+		return nil, status.Error(codes.PermissionDenied, "user is not a student")
+	}
+	if !user.IsActive {
+		return nil, shared.NewError(codes.PermissionDenied, shared.ErrCodeAccountInactive, "student account is inactive")
+	}
+	return user, nil
+}
+
+// checkSemesterOpen rejects enrolling in a course of a closed semester; an admin
+// reopening such a course does not bring the semester back
+func (s *EnrollmentService) checkSemesterOpen(ctx context.Context, semester, courseID string) error {
+	closed, err := shared.IsSemesterClosed(ctx, s.closuresCol, semester)
+	if err != nil {
+		log.Printf("Error checking whether semester %s is closed: %v", semester, err)
+		return status.Error(codes.Internal, "failed to check semester status")
+	}
+	if closed {
+		return shared.ErrSemesterClosed.Newf("semester %s has been closed", semester).
+			WithParam("semester", semester).
+			WithParam("course_id", courseID)
+	}
+	return nil
+}
+
+// lookupStudent loads the user for a student number (or user ID), reusing
+// results younger than studentCacheTTL. A nil user means no match.
+func (s *EnrollmentService) lookupStudent(ctx context.Context, studentID string) (*shared.User, error) {
+	now := time.Now()
+
+	s.studentsMu.Lock()
+	entry, ok := s.students[studentID]
+	s.studentsMu.Unlock()
+	if ok && now.Before(entry.expiresAt) {
+		return entry.user, nil
+	}
+
+	queryCtx, cancel := context.WithTimeout(ctx, 3*time.Second)
+	defer cancel()
+
+	var user *shared.User
+	var doc shared.User
+	filter := bson.M{"$or": []bson.M{{"student_id": studentID}, {"_id": studentID}}}
+	err := s.usersCol.FindOne(queryCtx, filter).Decode(&doc)
+	switch {
+	case err == nil:
+		user = &doc
+	case err != mongo.ErrNoDocuments:
+		return nil, err
+	}
+
+	s.studentsMu.Lock()
+	for id, e := range s.students {
+		if now.After(e.expiresAt) {
+			delete(s.students, id)
+		}
+	}
+	s.students[studentID] = cachedStudent{user: user, expiresAt: now.Add(studentCacheTTL)}
+	s.studentsMu.Unlock()
+
+	return user, nil
+}
+
+// requirePhase rejects a student action the current enrollment phase does not allow
+func (s *EnrollmentService) requirePhase(ctx context.Context, allowed func(shared.EnrollmentWindow, time.Time) bool, action string) error {
+	window := shared.LoadEnrollmentWindow(ctx, s.systemConfigCol)
+	now := time.Now()
+	if allowed(window, now) {
+		return nil
+	}
+
+	if window.Phase(now) == shared.PhasePreview {
+		return shared.ErrEnrollmentClosed.Newf("cannot %s: enrollment opens %s", action, window.Start.Format(time.RFC3339)).
+			WithParam("opens_at", window.Start.Format(time.RFC3339))
+	}
+	return shared.ErrEnrollmentClosed.Newf("cannot %s: enrollment is closed", action)
+}
+
+// activeHolds returns the student's holds that currently block enrollment
+func (s *EnrollmentService) activeHolds(ctx context.Context, studentID string) ([]shared.Hold, error) {
+	cursor, err := s.holdsCol.Find(ctx, bson.M{"student_id": studentID, "active": true},
+		options.Find().SetSort(bson.M{"placed_at": 1}))
+	if err != nil {
+		return nil, err
+	}
+	var holds []shared.Hold
+	if err := cursor.All(ctx, &holds); err != nil {
+		return nil, err
+	}
+	return holds, nil
+}
+
+// holdSummaries describes each hold for the cart warning and enrollment error
+func holdSummaries(holds []shared.Hold) []string {
+	var summaries []string
+	for i := range holds {
+		summaries = append(summaries, holds[i].Summary())
+	}
+	return summaries
+}
+
+// loadCart returns the student's cart, or nil when there is none. An expired cart is
+// deleted on the spot and reported through the returned reason instead.
+func (s *EnrollmentService) loadCart(ctx context.Context, studentID string) (*shared.Cart, string, error) {
+	var cart shared.Cart
+	err := s.cartsCol.FindOne(ctx, bson.M{"student_id": studentID}).Decode(&cart)
+	if err == mongo.ErrNoDocuments {
+		return nil, "", nil
+	} else if err != nil {
+		return nil, "", err
+	}
+
+	maxIdle := shared.GetCartExpiry(ctx, s.systemConfigCol)
+	window := shared.LoadEnrollmentWindow(ctx, s.systemConfigCol)
+	reason := cart.ExpiryReason(time.Now(), maxIdle, window)
+	if reason == "" {
+		return &cart, "", nil
+	}
+
+	// Matching updated_at leaves the cart alone if it was touched since we read it
+	res, err := s.cartsCol.DeleteOne(ctx, bson.M{"student_id": studentID, "updated_at": cart.UpdatedAt})
+	if err != nil {
+		log.Printf("Warning: failed to delete expired cart for %s: %v", studentID, err)
+	} else if res.DeletedCount > 0 {
+		s.releaseSeatHolds(ctx, bson.M{"student_id": studentID})
+	}
+	return nil, reason, nil
+}
+
+// holdSeat places, or renews, the student's seat hold on a course being added to
+// their cart when seat holds are on and the seat brings the course to the capacity
+// warning threshold. With every remaining seat enrolled or held in another cart the
+// course counts as full. Two carts racing for the last seat may both get a hold;
+// EnrollAll still checks seats inside its transaction.
+func (s *EnrollmentService) holdSeat(ctx context.Context, studentID, courseID string) error {
+	ttl := shared.GetSeatHoldDuration(ctx, s.systemConfigCol)
+	if ttl == 0 {
+		return nil
+	}
+
+	var course shared.Course
+	if err := s.coursesCol.FindOne(ctx, bson.M{"_id": courseID}).Decode(&course); err != nil {
+		return shared.ErrCourseNotFound.Newf("course not found or unavailable").WithParam("course_id", courseID)
+	}
+	now := time.Now()
+	held, err := shared.CountSeatHolds(ctx, s.seatHoldsCol, courseID, studentID, now)
+	if err != nil {
+		return status.Error(codes.Internal, "failed to count seat holds")
+	}
+	if shared.SeatsAvailable(course.Capacity, course.Enrolled+held) == 0 {
+		return shared.ErrCourseFull.Newf("course %s is full: the remaining seats are held in other carts", course.Code).WithParam("course_id", courseID)
+	}
+	if !course.NeedsSeatHold(held, shared.GetCapacityWarningPercent(ctx, s.systemConfigCol)) {
+		return nil
+	}
+
+	_, err = s.seatHoldsCol.UpdateOne(ctx,
+		bson.M{"course_id": courseID, "student_id": studentID},
+		bson.M{
+			"$set":         bson.M{"expires_at": now.Add(ttl)},
+			"$setOnInsert": bson.M{"_id": shared.GenerateSeatHoldID(), "created_at": now},
+		},
+		options.Update().SetUpsert(true),
+	)
+	if err != nil && !mongo.IsDuplicateKeyError(err) {
+		return status.Error(codes.Internal, "failed to hold seat")
+	}
+	return nil
+}
+
+// releaseSeatHolds deletes the seat holds matching filter, returning their seats.
+// Failures are logged; a hold left behind still lapses at its expires_at.
+func (s *EnrollmentService) releaseSeatHolds(ctx context.Context, filter bson.M) {
+	if _, err := s.seatHoldsCol.DeleteMany(ctx, filter); err != nil {
+		log.Printf("Warning: failed to release seat holds %v: %v", filter, err)
+	}
+}
+
+// saveCartValidation stores GetCart's results on the cart. The write only matches
+// while the cart is unchanged since it was read, so results for an older course
+// list are never stored; failures are logged since the results can be recomputed.
+func (s *EnrollmentService) saveCartValidation(ctx context.Context, cart *shared.Cart, validation *shared.CartValidation) {
+	_, err := s.cartsCol.UpdateOne(ctx,
+		bson.M{"student_id": cart.StudentID, "updated_at": cart.UpdatedAt},
+		bson.M{"$set": bson.M{"validation_results": validation}},
+	)
+	if err != nil {
+		log.Printf("Warning: failed to store cart validation for %s: %v", cart.StudentID, err)
+	}
+}
+
+// validatedCart returns the cart for EnrollAll. Validation results GetCart stored
+// within the cart_validation_ttl_seconds bound are reused, saving the Course Service
+// calls; otherwise the cart is validated again through GetCart. Holds are always
+// read fresh.
+func (s *EnrollmentService) validatedCart(ctx context.Context, studentID string) (*pb.Cart, error) {
+	cartModel, _, err := s.loadCart(ctx, studentID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to retrieve cart")
+	}
+	var validation *shared.CartValidation
+	if cartModel != nil {
+		validation = cartModel.FreshValidation(time.Now(), shared.GetCartValidationTTL(ctx, s.systemConfigCol))
+	}
+	if validation == nil {
+		resp, err := s.GetCart(ctx, &pb.GetCartRequest{StudentId: studentID})
+		if err != nil {
+			return nil, err
+		}
+		return resp.Cart, nil
+	}
+
+	holds, err := s.activeHolds(ctx, studentID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to load holds")
+	}
+	items := make([]*pb.CartItem, 0, len(validation.Items))
+	for _, item := range validation.Items {
+		items = append(items, &pb.CartItem{
+			CourseId: item.CourseID, CourseCode: item.CourseCode, CourseTitle: item.CourseTitle, Units: item.Units,
+		})
+	}
+	return &pb.Cart{
+		StudentId:             studentID,
+		Items:                 items,
+		TotalUnits:            validation.TotalUnits,
+		HasConflicts:          validation.HasConflicts,
+		MissingPrerequisites:  validation.MissingPrerequisites,
+		UpdatedAt:             shared.ToProtoTime(cartModel.UpdatedAt),
+		RestrictionViolations: validation.RestrictionViolations,
+		ActiveHolds:           holdSummaries(holds),
+		ConditionalCourses:    validation.ConditionalCourses,
+	}, nil
+}
+
+// cartExpiredMessage explains to the student why their cart came back empty
+func cartExpiredMessage(reason string) string {
+	if reason == shared.CartExpiredPeriodEnded {
+		return "cart expired: the enrollment period it was built for has ended"
+	}
+	return "cart expired after a period of inactivity"
+}
+
+// ExpireStaleCarts empties carts that have expired, keeping a marker document so
+// the student's next GetCart can explain what happened. Markers nobody has read
+// within another expiry period are deleted. It returns how many carts were expired.
+func (s *EnrollmentService) ExpireStaleCarts(ctx context.Context) (int64, error) {
+	queryCtx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	now := time.Now()
+	maxIdle := shared.GetCartExpiry(queryCtx, s.systemConfigCol)
+	window := shared.LoadEnrollmentWindow(queryCtx, s.systemConfigCol)
+
+	expire := func(filter bson.M, reason string) (int64, error) {
+		filter["expired_at"] = bson.M{"$exists": false}
+		res, err := s.cartsCol.UpdateMany(queryCtx, filter, bson.M{
+			"$set":   bson.M{"course_ids": []string{}, "expired_at": now, "expired_reason": reason},
+			"$unset": bson.M{"validation_results": ""},
+		})
+		if err != nil {
+			return 0, err
+		}
+		return res.ModifiedCount, nil
+	}
+
+	var expired int64
+	// Carts built for a period that has ended
+	if !window.End.IsZero() && now.After(window.End) {
+		n, err := expire(bson.M{"updated_at": bson.M{"$lt": window.End}}, shared.CartExpiredPeriodEnded)
+		if err != nil {
+			return 0, fmt.Errorf("failed to expire carts from the ended enrollment period: %w", err)
+		}
+		expired += n
+	}
+	n, err := expire(bson.M{"updated_at": bson.M{"$lt": now.Add(-maxIdle)}}, shared.CartExpiredInactive)
+	if err != nil {
+		return expired, fmt.Errorf("failed to expire inactive carts: %w", err)
+	}
+	expired += n
+
+	if _, err := s.cartsCol.DeleteMany(queryCtx, bson.M{"expired_at": bson.M{"$lt": now.Add(-maxIdle)}}); err != nil {
+		return expired, fmt.Errorf("failed to delete expired carts: %w", err)
+	}
+	return expired, nil
+}
+
+// RunCartCleanup calls ExpireStaleCarts every interval until ctx is cancelled
+func (s *EnrollmentService) RunCartCleanup(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if n, err := s.ExpireStaleCarts(ctx); err != nil {
+				log.Printf("Warning: cart cleanup failed: %v", err)
+			} else if n > 0 {
+				log.Printf("Expired %d stale carts", n)
+			}
+		}
+	}
+}
+
+// acquireEnrollLock takes the per-student lock held for the duration of an
+// enrollment operation, so a concurrent EnrollAll or DropCourse for the same student
+// fails fast with ErrOperationInProgress. The returned func releases the lock.
+func (s *EnrollmentService) acquireEnrollLock(ctx context.Context, studentID, operation string) (func(), error) {
+	lockCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	now := time.Now()
+	token := shared.GenerateID("LOCK")
+
+	// Matches only an expired lock (the TTL monitor may lag by a minute); a live
+	// lock makes the upsert collide on _id instead
+	_, err := s.enrollLocksCol.ReplaceOne(lockCtx,
+		bson.M{"_id": studentID, "expires_at": bson.M{"$lte": now}},
+		bson.M{
+			"_id":         studentID,
+			"token":       token,
+			"operation":   operation,
+			"acquired_at": now,
+			"expires_at":  now.Add(enrollLockTTL),
+		},
+		options.Replace().SetUpsert(true),
+	)
+	if mongo.IsDuplicateKeyError(err) {
+		return nil, shared.ErrOperationInProgress.Newf("another enrollment operation is in progress, please wait").
+			WithParam("student_id", studentID)
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to acquire enrollment lock")
+	}
+
+	release := func() {
+		// Not tied to the request context, so a cancelled call still frees the lock.
+		// The token keeps us from deleting a lock another call took over after expiry.
+		releaseCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if _, err := s.enrollLocksCol.DeleteOne(releaseCtx, bson.M{"_id": studentID, "token": token}); err != nil {
+			log.Printf("Warning: failed to release enrollment lock for %s: %v", studentID, err)
+		}
+	}
+	return release, nil
+}
+
+// EnsureIndexes creates the TTL index that clears enrollment locks left behind
+// by a crashed request, and the seat hold indexes (one hold per student and course,
+// expired holds removed)
+func (s *EnrollmentService) EnsureIndexes(ctx context.Context) error {
+	indexCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	_, err := s.enrollLocksCol.Indexes().CreateOne(indexCtx, mongo.IndexModel{
+		Keys:    bson.D{{Key: "expires_at", Value: 1}},
+		Options: options.Index().SetName("enroll_lock_ttl").SetExpireAfterSeconds(0),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create enrollment lock index: %w", err)
+	}
+
+	_, err = s.seatHoldsCol.Indexes().CreateMany(indexCtx, []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "course_id", Value: 1}, {Key: "student_id", Value: 1}},
+			Options: options.Index().SetName("seat_hold_course_student").SetUnique(true),
+		},
+		{
+			Keys:    bson.D{{Key: "expires_at", Value: 1}},
+			Options: options.Index().SetName("seat_hold_ttl").SetExpireAfterSeconds(0),
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create seat hold indexes: %w", err)
+	}
+	return nil
+}
+
+// getCartLimits returns the admin-configured cart and unit limits, rereading
+// system config at most once per cartLimitsTTL
+func (s *EnrollmentService) getCartLimits(ctx context.Context) shared.CartLimits {
+	now := time.Now()
+
+	s.limitsMu.Lock()
+	defer s.limitsMu.Unlock()
+	if now.Before(s.limitsExpireAt) {
+		return s.limits
+	}
+
+	s.limits = shared.LoadCartLimits(ctx, s.systemConfigCol)
+	s.limitsExpireAt = now.Add(cartLimitsTTL)
+	return s.limits
+}
+
+// getCurrentSemester reads the current_semester system config ("" when unset)
+func (s *EnrollmentService) getCurrentSemester(ctx context.Context) string {
+	var cfg shared.SystemConfig
+	if err := s.systemConfigCol.FindOne(ctx, bson.M{"key": shared.ConfigCurrentSemester}).Decode(&cfg); err != nil {
+		if err != mongo.ErrNoDocuments {
+			log.Printf("Warning: failed to read %s config: %v", shared.ConfigCurrentSemester, err)
+		}
+		return ""
+	}
+	return strings.TrimSpace(cfg.Value)
+}
+
+// warnNearlyFull records a capacity warning for a course that just reached the
+// warning threshold so admins can open another section
+func (s *EnrollmentService) warnNearlyFull(ctx context.Context, c *shared.Course, percent int32, studentID string) {
+	fillRate := math.Round(c.FillRate()*10) / 10
+	log.Printf("Capacity warning: course %s (%s) is %.1f%% full (%d/%d, threshold %d%%)",
+		c.Code, c.ID, fillRate, c.Enrolled, c.Capacity, percent)
+
+	shared.LogAuditEvent(ctx, s.auditLogsCol, "system", shared.ActionCapacityWarn, c.ID, map[string]interface{}{
+		"course_code":  c.Code,
+		"semester":     c.Semester,
+		"enrolled":     c.Enrolled,
+		"capacity":     c.Capacity,
+		"fill_rate":    fillRate,
+		"threshold":    percent,
+		"triggered_by": studentID,
+	})
+}
+
+// receiptToProto maps a stored receipt to the Protobuf message
+func receiptToProto(r *shared.EnrollmentReceipt) *pb.EnrollmentReceipt {
+	courses := make([]*pb.ReceiptCourse, 0, len(r.Courses))
+	for _, c := range r.Courses {
+		courses = append(courses, &pb.ReceiptCourse{
+			EnrollmentId: c.EnrollmentID,
+			CourseId:     c.CourseID,
+			CourseCode:   c.CourseCode,
+			CourseTitle:  c.CourseTitle,
+			Units:        c.Units,
+		})
+	}
+
+	return &pb.EnrollmentReceipt{
+		ReferenceId: r.ID,
+		StudentId:   r.StudentID,
+		Courses:     courses,
+		TotalUnits:  r.TotalUnits,
+		CreatedAt:   shared.ToProtoTime(r.CreatedAt),
+	}
+}
@@ -2,13 +2,20 @@ package enrollment
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"net"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/joho/godotenv"
+	"go.mongodb.org/mongo-driver/mongo/options"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
 	"google.golang.org/grpc/test/bufconn"
 
 	pb_course "stdiscm_p4/backend/internal/pb/course"
@@ -87,6 +94,9 @@ func TestEnrollmentService_Integration(t *testing.T) {
 	// OR you copy the `NewCourseService` logic into this test file.
 	// Since I cannot change your package structure here, I will assume the setup logic works.
 
+	// Config changes below must apply immediately
+	cartLimitsTTL = 0
+
 	courseSrv, enrollSrv, courseConn := initInfrastructure()
 	defer courseSrv.Stop()
 	defer enrollSrv.Stop()
@@ -107,6 +117,16 @@ func TestEnrollmentService_Integration(t *testing.T) {
 
 	testStudentID := "student-enroll-001"
 	testCourseID := "CS-ENROLL-101"
+	testUserIDs := []string{"user-enroll-001", "user-enroll-002"}
+
+	// Students must exist and be active for cart/enrollment operations
+	db.Collection("users").DeleteMany(ctx, map[string]interface{}{"_id": map[string]interface{}{"$in": testUserIDs}})
+	for i, uid := range testUserIDs {
+		db.Collection("users").InsertOne(ctx, shared.User{
+			ID: uid, Email: fmt.Sprintf("enroll%d@test.com", i+1), Role: shared.RoleStudent, Name: "Enroll Student",
+			StudentID: fmt.Sprintf("student-enroll-%03d", i+1), IsActive: true,
+		})
+	}
 
 	// Inject Course Data (Needs to be open and exist)
 	db.Collection("courses").InsertOne(ctx, shared.Course{
@@ -117,11 +137,14 @@ func TestEnrollmentService_Integration(t *testing.T) {
 	// Clean Carts
 	db.Collection("carts").DeleteOne(ctx, map[string]interface{}{"student_id": testStudentID})
 	db.Collection("enrollments").DeleteMany(ctx, map[string]interface{}{"student_id": testStudentID})
+	db.Collection("enrollment_events").DeleteMany(ctx, map[string]interface{}{"course_id": testCourseID})
 
 	defer func() {
 		db.Collection("courses").DeleteOne(ctx, map[string]interface{}{"_id": testCourseID})
+		db.Collection("enrollment_events").DeleteMany(ctx, map[string]interface{}{"course_id": testCourseID})
 		db.Collection("carts").DeleteOne(ctx, map[string]interface{}{"student_id": testStudentID})
 		db.Collection("enrollments").DeleteMany(ctx, map[string]interface{}{"student_id": testStudentID})
+		db.Collection("users").DeleteMany(ctx, map[string]interface{}{"_id": map[string]interface{}{"$in": testUserIDs}})
 	}()
 
 	// --- 1. Add To Cart ---
@@ -141,6 +164,19 @@ func TestEnrollmentService_Integration(t *testing.T) {
 		if len(resp.Cart.Items) != 1 || resp.Cart.Items[0].CourseId != testCourseID {
 			t.Error("Cart does not contain expected course")
 		}
+
+		summary, err := client.GetCartSummary(ctx, &pb_enroll.GetCartSummaryRequest{StudentId: testStudentID})
+		if err != nil {
+			t.Fatalf("GetCartSummary failed: %v", err)
+		}
+		if summary.ItemCount != 1 || summary.TotalUnits != 3 {
+			t.Errorf("Expected 1 item and 3 units, got %d and %d", summary.ItemCount, summary.TotalUnits)
+		}
+
+		empty, err := client.GetCartSummary(ctx, &pb_enroll.GetCartSummaryRequest{StudentId: "student-enroll-002"})
+		if err != nil || empty.ItemCount != 0 || empty.TotalUnits != 0 {
+			t.Errorf("Expected an empty summary for a student without a cart, got %v (err %v)", empty, err)
+		}
 	})
 
 	// --- 2. Enroll All ---
@@ -157,6 +193,28 @@ func TestEnrollmentService_Integration(t *testing.T) {
 		if len(resp.Enrollments) != 1 {
 			t.Error("Expected 1 enrollment record")
 		}
+		defer db.Collection("enrollment_receipts").DeleteMany(ctx, map[string]interface{}{"student_id": testStudentID})
+
+		// A receipt is returned and can be fetched later by its reference ID
+		if resp.Receipt == nil || resp.Receipt.ReferenceId == "" || len(resp.Receipt.Courses) != 1 {
+			t.Fatalf("Expected receipt with 1 course, got %v", resp.Receipt)
+		}
+		getResp, err := client.GetEnrollmentReceipt(ctx, &pb_enroll.GetEnrollmentReceiptRequest{
+			ReferenceId: resp.Receipt.ReferenceId, StudentId: testStudentID,
+		})
+		if err != nil {
+			t.Fatalf("GetEnrollmentReceipt failed: %v", err)
+		}
+		if getResp.Receipt.TotalUnits != resp.Receipt.TotalUnits {
+			t.Errorf("Stored receipt mismatch: %v", getResp.Receipt)
+		}
+
+		_, err = client.GetEnrollmentReceipt(ctx, &pb_enroll.GetEnrollmentReceiptRequest{
+			ReferenceId: resp.Receipt.ReferenceId, StudentId: "someone-else",
+		})
+		if status.Code(err) != codes.NotFound {
+			t.Errorf("Expected NotFound for another student's receipt, got %v", err)
+		}
 	})
 
 	// --- 3. Get Enrollments ---
@@ -169,12 +227,38 @@ func TestEnrollmentService_Integration(t *testing.T) {
 		}
 
 		if len(resp.Enrollments) == 0 {
-			t.Error("Schedule should not be empty")
+			t.Fatal("Schedule should not be empty")
+		}
+		if resp.Enrollments[0].CourseCode != "CSE101" || resp.TotalUnits != 3 || resp.TotalCount != 1 {
+			t.Errorf("Expected hydrated CSE101 with 3 units, got %v (units %d, total %d)", resp.Enrollments[0], resp.TotalUnits, resp.TotalCount)
+		}
+
+		// Past the last page: no rows, but totals still cover every page
+		resp, err = client.GetStudentEnrollments(ctx, &pb_enroll.GetStudentEnrollmentsRequest{
+			StudentId: testStudentID, Page: 2, PageSize: 1,
+		})
+		if err != nil {
+			t.Fatalf("GetStudentEnrollments (page 2) failed: %v", err)
+		}
+		if len(resp.Enrollments) != 0 || resp.TotalCount != 1 || resp.TotalUnits != 3 {
+			t.Errorf("Expected empty page 2 with totals intact, got %d rows (total %d, units %d)", len(resp.Enrollments), resp.TotalCount, resp.TotalUnits)
+		}
+
+		resp, err = client.GetStudentEnrollments(ctx, &pb_enroll.GetStudentEnrollmentsRequest{
+			StudentId: testStudentID, Semester: "NoSuchSemester",
+		})
+		if err != nil || resp.TotalCount != 0 {
+			t.Errorf("Expected no enrollments for an unknown semester, got %v (err %v)", resp, err)
 		}
 	})
 
 	// --- 4. Drop Course ---
 	t.Run("Drop Course", func(t *testing.T) {
+		// A cart entry for the same course must not outlive the drop
+		if _, err := client.AddToCart(ctx, &pb_enroll.AddToCartRequest{StudentId: testStudentID, CourseId: testCourseID}); err != nil {
+			t.Fatalf("AddToCart failed: %v", err)
+		}
+
 		resp, err := client.DropCourse(ctx, &pb_enroll.DropCourseRequest{
 			StudentId: testStudentID,
 			CourseId:  testCourseID,
@@ -182,5 +266,1176 @@ func TestEnrollmentService_Integration(t *testing.T) {
 		if err != nil || !resp.Success {
 			t.Errorf("Drop failed: %v", err)
 		}
+
+		var cart shared.Cart
+		db.Collection("carts").FindOne(ctx, map[string]interface{}{"student_id": testStudentID}).Decode(&cart)
+		for _, id := range cart.CourseIDs {
+			if id == testCourseID {
+				t.Errorf("Expected %s to be removed from the cart on drop, got %v", testCourseID, cart.CourseIDs)
+			}
+		}
+
+		// Enroll All and this drop each left a trend event
+		for _, delta := range []int32{1, -1} {
+			count, _ := db.Collection("enrollment_events").CountDocuments(ctx, map[string]interface{}{
+				"course_id": testCourseID, "delta": delta, "source": shared.EventSourceSelf,
+			})
+			if count != 1 {
+				t.Errorf("Expected one %+d enrollment event, got %d", delta, count)
+			}
+		}
+	})
+
+	// --- 5. Unit Cap Includes Current Enrollments ---
+	t.Run("Enroll All Over Unit Cap", func(t *testing.T) {
+		heavyCourseID := "CS-ENROLL-HEAVY"
+		extraCourseID := "CS-ENROLL-EXTRA"
+		db.Collection("courses").InsertOne(ctx, shared.Course{
+			ID: heavyCourseID, Code: "CSE190", Title: "Heavy Load",
+			Units: 16, Capacity: 50, IsOpen: true, Semester: "Cap Test",
+			Schedule: "TTH 8:00-9:00",
+		})
+		db.Collection("courses").InsertOne(ctx, shared.Course{
+			ID: extraCourseID, Code: "CSE191", Title: "Extra Load",
+			Units: 3, Capacity: 50, IsOpen: true, Semester: "Cap Test",
+			Schedule: "MWF 15:00-16:00",
+		})
+		defer db.Collection("courses").DeleteMany(ctx, map[string]interface{}{
+			"_id": map[string]interface{}{"$in": []string{heavyCourseID, extraCourseID}},
+		})
+
+		// Student already carries 16 units this semester
+		heavyEnrollmentID := shared.GenerateEnrollmentID()
+		db.Collection("enrollments").InsertOne(ctx, shared.Enrollment{
+			ID: heavyEnrollmentID, StudentID: testStudentID, CourseID: heavyCourseID,
+			Status: shared.StatusEnrolled,
+		})
+		defer func() {
+			db.Collection("enrollments").DeleteOne(ctx, map[string]interface{}{"_id": heavyEnrollmentID})
+			db.Collection("carts").UpdateOne(ctx, map[string]interface{}{"student_id": testStudentID},
+				map[string]interface{}{"$pull": map[string]interface{}{"course_ids": extraCourseID}})
+		}()
+
+		if _, err := client.AddToCart(ctx, &pb_enroll.AddToCartRequest{
+			StudentId: testStudentID,
+			CourseId:  extraCourseID,
+		}); err != nil {
+			t.Fatalf("AddToCart failed: %v", err)
+		}
+
+		_, err := client.EnrollAll(ctx, &pb_enroll.EnrollAllRequest{StudentId: testStudentID})
+		if status.Code(err) != codes.FailedPrecondition {
+			t.Errorf("Expected FailedPrecondition for 16 enrolled + 3 cart units, got %v", err)
+		}
+	})
+
+	// --- 6. Configurable Cart Size ---
+	t.Run("Add To Cart Over Configured Max", func(t *testing.T) {
+		cartStudentID := "student-enroll-002"
+		courseIDs := []string{"CS-CART-1", "CS-CART-2", "CS-CART-3", "CS-CART-4"}
+		for i, cid := range courseIDs {
+			db.Collection("courses").InsertOne(ctx, shared.Course{
+				ID: cid, Code: fmt.Sprintf("CSC%d", i+1), Title: "Cart Test",
+				Units: 1, Capacity: 50, IsOpen: true,
+				Schedule: fmt.Sprintf("MWF %d:00-%d:00", 8+i, 9+i),
+			})
+		}
+		db.Collection("carts").DeleteOne(ctx, map[string]interface{}{"student_id": cartStudentID})
+
+		// Lower the caps, restoring any existing values afterwards
+		configCol := db.Collection("system_config")
+		setLimit := func(key, value string) func() {
+			var original shared.SystemConfig
+			hadOriginal := configCol.FindOne(ctx, map[string]interface{}{"key": key}).Decode(&original) == nil
+			configCol.UpdateOne(ctx,
+				map[string]interface{}{"key": key},
+				map[string]interface{}{"$set": map[string]interface{}{"value": value}},
+				options.Update().SetUpsert(true))
+			return func() {
+				if hadOriginal {
+					configCol.ReplaceOne(ctx, map[string]interface{}{"key": key}, original)
+				} else {
+					configCol.DeleteOne(ctx, map[string]interface{}{"key": key})
+				}
+			}
+		}
+		restoreCourses := setLimit(shared.ConfigMaxCourses, "3")
+		restoreUnits := setLimit(shared.ConfigMaxUnits, "2")
+
+		defer func() {
+			restoreCourses()
+			restoreUnits()
+			db.Collection("courses").DeleteMany(ctx, map[string]interface{}{
+				"_id": map[string]interface{}{"$in": courseIDs},
+			})
+			db.Collection("carts").DeleteOne(ctx, map[string]interface{}{"student_id": cartStudentID})
+		}()
+
+		for _, cid := range courseIDs[:3] {
+			if _, err := client.AddToCart(ctx, &pb_enroll.AddToCartRequest{
+				StudentId: cartStudentID,
+				CourseId:  cid,
+			}); err != nil {
+				t.Fatalf("AddToCart %s failed: %v", cid, err)
+			}
+		}
+
+		_, err := client.AddToCart(ctx, &pb_enroll.AddToCartRequest{
+			StudentId: cartStudentID,
+			CourseId:  courseIDs[3],
+		})
+		if status.Code(err) != codes.FailedPrecondition {
+			t.Fatalf("Expected FailedPrecondition for 4th course, got %v", err)
+		}
+		if !strings.Contains(status.Convert(err).Message(), "max 3 courses") {
+			t.Errorf("Expected effective limit in message, got %q", status.Convert(err).Message())
+		}
+
+		// GetCart reports the effective limits for "3 of 3 courses"
+		cartResp, err := client.GetCart(ctx, &pb_enroll.GetCartRequest{StudentId: cartStudentID})
+		if err != nil {
+			t.Fatalf("GetCart failed: %v", err)
+		}
+		if cartResp.MaxCourses != 3 || cartResp.MaxUnits != 2 {
+			t.Errorf("Expected limits 3 courses / 2 units, got %d / %d", cartResp.MaxCourses, cartResp.MaxUnits)
+		}
+
+		// The 3 units in the cart exceed the configured 2-unit cap
+		_, err = client.EnrollAll(ctx, &pb_enroll.EnrollAllRequest{StudentId: cartStudentID})
+		if shared.ErrorCodeOf(err) != shared.ErrCodeUnitLimitExceeded {
+			t.Fatalf("Expected UNIT_LIMIT_EXCEEDED, got %v", err)
+		}
+		if !strings.Contains(status.Convert(err).Message(), "(max 2)") {
+			t.Errorf("Expected configured unit limit in message, got %q", status.Convert(err).Message())
+		}
+	})
+
+	// --- 7. Major / Year Level Restrictions ---
+	t.Run("Course Restrictions", func(t *testing.T) {
+		restrictedStudentID := "student-enroll-restrict"
+		restrictedUserID := "user-enroll-restrict"
+		majorCourseID := "CS-ENROLL-MAJOR"
+		yearCourseID := "CS-ENROLL-YEAR"
+
+		db.Collection("users").DeleteOne(ctx, map[string]interface{}{"_id": restrictedUserID})
+		db.Collection("users").InsertOne(ctx, shared.User{
+			ID: restrictedUserID, Email: "restrict@test.com", Role: shared.RoleStudent, Name: "Restricted Student",
+			StudentID: restrictedStudentID, Major: "Mathematics", YearLevel: 2, IsActive: true,
+		})
+		db.Collection("courses").InsertOne(ctx, shared.Course{
+			ID: majorCourseID, Code: "CSR1", Title: "Majors Only", Units: 3, Capacity: 50, IsOpen: true,
+			Schedule: "TTH 9:00-10:30", AllowedMajors: []string{"Computer Science"},
+		})
+		db.Collection("courses").InsertOne(ctx, shared.Course{
+			ID: yearCourseID, Code: "CSR2", Title: "Upperclass Only", Units: 3, Capacity: 50, IsOpen: true,
+			Schedule: "TTH 13:00-14:30", MinYearLevel: 3,
+		})
+		defer func() {
+			db.Collection("users").DeleteOne(ctx, map[string]interface{}{"_id": restrictedUserID})
+			db.Collection("courses").DeleteMany(ctx, map[string]interface{}{
+				"_id": map[string]interface{}{"$in": []string{majorCourseID, yearCourseID}},
+			})
+			db.Collection("carts").DeleteOne(ctx, map[string]interface{}{"student_id": restrictedStudentID})
+		}()
+
+		_, err := client.AddToCart(ctx, &pb_enroll.AddToCartRequest{StudentId: restrictedStudentID, CourseId: majorCourseID})
+		if status.Code(err) != codes.FailedPrecondition || !strings.Contains(status.Convert(err).Message(), "restricted to majors: Computer Science") {
+			t.Errorf("Expected major restriction failure, got %v", err)
+		}
+
+		_, err = client.AddToCart(ctx, &pb_enroll.AddToCartRequest{StudentId: restrictedStudentID, CourseId: yearCourseID})
+		if status.Code(err) != codes.FailedPrecondition || !strings.Contains(status.Convert(err).Message(), "requires year level 3+") {
+			t.Errorf("Expected year level restriction failure, got %v", err)
+		}
+		if code := shared.ErrorCodeOf(err); code != shared.ErrCodeCourseRestricted {
+			t.Errorf("Expected COURSE_RESTRICTED code, got %s", code)
+		}
+
+		// A restriction added after the course was carted shows up as a cart violation
+		db.Collection("courses").UpdateOne(ctx,
+			map[string]interface{}{"_id": yearCourseID},
+			map[string]interface{}{"$set": map[string]interface{}{"min_year_level": 0}})
+		if _, err := client.AddToCart(ctx, &pb_enroll.AddToCartRequest{StudentId: restrictedStudentID, CourseId: yearCourseID}); err != nil {
+			t.Fatalf("AddToCart for unrestricted course failed: %v", err)
+		}
+		db.Collection("courses").UpdateOne(ctx,
+			map[string]interface{}{"_id": yearCourseID},
+			map[string]interface{}{"$set": map[string]interface{}{"min_year_level": 4}})
+
+		cartResp, err := client.GetCart(ctx, &pb_enroll.GetCartRequest{StudentId: restrictedStudentID})
+		if err != nil {
+			t.Fatalf("GetCart failed: %v", err)
+		}
+		if len(cartResp.Cart.RestrictionViolations) != 1 {
+			t.Errorf("Expected 1 restriction violation, got %v", cartResp.Cart.RestrictionViolations)
+		}
+
+		_, err = client.EnrollAll(ctx, &pb_enroll.EnrollAllRequest{StudentId: restrictedStudentID})
+		if shared.ErrorCodeOf(err) != shared.ErrCodeCourseRestricted {
+			t.Errorf("Expected EnrollAll to fail with COURSE_RESTRICTED, got %v", err)
+		}
+	})
+
+	// --- 8. Unknown / Inactive Students ---
+	t.Run("Student Verification", func(t *testing.T) {
+		_, err := client.AddToCart(ctx, &pb_enroll.AddToCartRequest{StudentId: "student-does-not-exist", CourseId: testCourseID})
+		if status.Code(err) != codes.NotFound || shared.ErrorCodeOf(err) != shared.ErrCodeStudentNotFound {
+			t.Errorf("Expected NotFound/STUDENT_NOT_FOUND for unknown student, got %v", err)
+		}
+		var count int64
+		count, _ = db.Collection("carts").CountDocuments(ctx, map[string]interface{}{"student_id": "student-does-not-exist"})
+		if count != 0 {
+			t.Errorf("Expected no cart to be created for unknown student, found %d", count)
+		}
+
+		inactiveUserID := "user-enroll-inactive"
+		inactiveStudentID := "student-enroll-inactive"
+		db.Collection("users").DeleteOne(ctx, map[string]interface{}{"_id": inactiveUserID})
+		db.Collection("users").InsertOne(ctx, shared.User{
+			ID: inactiveUserID, Email: "inactive-enroll@test.com", Role: shared.RoleStudent, Name: "Inactive Student",
+			StudentID: inactiveStudentID, IsActive: false,
+		})
+		defer db.Collection("users").DeleteOne(ctx, map[string]interface{}{"_id": inactiveUserID})
+
+		_, err = client.EnrollAll(ctx, &pb_enroll.EnrollAllRequest{StudentId: inactiveStudentID})
+		if status.Code(err) != codes.PermissionDenied || shared.ErrorCodeOf(err) != shared.ErrCodeAccountInactive {
+			t.Errorf("Expected PermissionDenied/ACCOUNT_INACTIVE for inactive student, got %v", err)
+		}
+
+		_, err = client.GetStudentEnrollments(ctx, &pb_enroll.GetStudentEnrollmentsRequest{StudentId: inactiveStudentID})
+		if status.Code(err) != codes.PermissionDenied {
+			t.Errorf("Expected PermissionDenied listing enrollments of inactive student, got %v", err)
+		}
+	})
+
+	// --- 9. Academic Holds ---
+	t.Run("Enrollment Hold", func(t *testing.T) {
+		holdStudentID := "student-enroll-002"
+		holdCourseID := "CS-ENROLL-HOLD"
+		holdID := shared.GenerateHoldID()
+		db.Collection("courses").InsertOne(ctx, shared.Course{
+			ID: holdCourseID, Code: "CSE196", Title: "Hold Test",
+			Units: 1, Capacity: 10, IsOpen: true,
+			Schedule: "S 13:00-14:00",
+		})
+		db.Collection("carts").DeleteOne(ctx, map[string]interface{}{"student_id": holdStudentID})
+		db.Collection("holds").InsertOne(ctx, shared.Hold{
+			ID: holdID, StudentID: holdStudentID, Type: shared.HoldFinancial, Reason: "unpaid tuition",
+			Active: true, PlacedBy: "admin", PlacedAt: time.Now(),
+		})
+		defer func() {
+			db.Collection("courses").DeleteOne(ctx, map[string]interface{}{"_id": holdCourseID})
+			db.Collection("carts").DeleteOne(ctx, map[string]interface{}{"student_id": holdStudentID})
+			db.Collection("enrollments").DeleteMany(ctx, map[string]interface{}{"course_id": holdCourseID})
+			db.Collection("enrollment_receipts").DeleteMany(ctx, map[string]interface{}{"student_id": holdStudentID})
+			db.Collection("holds").DeleteOne(ctx, map[string]interface{}{"_id": holdID})
+		}()
+
+		// The cart can still be built, but shows the hold
+		_, err := client.AddToCart(ctx, &pb_enroll.AddToCartRequest{StudentId: holdStudentID, CourseId: holdCourseID})
+		if err != nil {
+			t.Fatalf("AddToCart failed: %v", err)
+		}
+		cartResp, err := client.GetCart(ctx, &pb_enroll.GetCartRequest{StudentId: holdStudentID})
+		if err != nil || len(cartResp.Cart.ActiveHolds) != 1 || cartResp.Cart.ActiveHolds[0] != "financial hold" {
+			t.Errorf("Expected cart to surface the financial hold, got %v (err %v)", cartResp, err)
+		}
+
+		_, err = client.EnrollAll(ctx, &pb_enroll.EnrollAllRequest{StudentId: holdStudentID})
+		if status.Code(err) != codes.FailedPrecondition || shared.ErrorCodeOf(err) != shared.ErrCodeEnrollmentHold {
+			t.Fatalf("Expected FailedPrecondition/ENROLLMENT_HOLD, got %v", err)
+		}
+		if !strings.Contains(status.Convert(err).Message(), "financial hold") {
+			t.Errorf("Expected the error to name the hold type, got %q", status.Convert(err).Message())
+		}
+		if strings.Contains(status.Convert(err).Message(), "unpaid tuition") {
+			t.Errorf("Expected the admin's reason to stay out of the error, got %q", status.Convert(err).Message())
+		}
+
+		// Clearing the hold lets the same cart enroll
+		db.Collection("holds").UpdateOne(ctx,
+			map[string]interface{}{"_id": holdID},
+			map[string]interface{}{"$set": map[string]interface{}{"active": false, "cleared_at": time.Now()}},
+		)
+		resp, err := client.EnrollAll(ctx, &pb_enroll.EnrollAllRequest{StudentId: holdStudentID})
+		if err != nil || !resp.Success {
+			t.Errorf("Expected EnrollAll to succeed after the hold is cleared, got %v (err %v)", resp, err)
+		}
 	})
+
+	// --- 10. Enrollment Phases ---
+	t.Run("Enrollment Phases", func(t *testing.T) {
+		phaseStudentID := "student-enroll-002"
+		phaseCourseID := "CS-ENROLL-PHASE"
+		db.Collection("courses").InsertOne(ctx, shared.Course{
+			ID: phaseCourseID, Code: "CSE197", Title: "Phase Test",
+			Units: 1, Capacity: 10, IsOpen: true,
+			Schedule: "S 15:00-16:00",
+		})
+		db.Collection("carts").DeleteOne(ctx, map[string]interface{}{"student_id": phaseStudentID})
+
+		configCol := db.Collection("system_config")
+		setConfig := func(key, value string) {
+			configCol.UpdateOne(ctx,
+				map[string]interface{}{"key": key},
+				map[string]interface{}{"$set": map[string]interface{}{"value": value}},
+				options.Update().SetUpsert(true),
+			)
+		}
+		defer func() {
+			db.Collection("courses").DeleteOne(ctx, map[string]interface{}{"_id": phaseCourseID})
+			db.Collection("carts").DeleteOne(ctx, map[string]interface{}{"student_id": phaseStudentID})
+			configCol.DeleteMany(ctx, map[string]interface{}{"key": map[string]interface{}{
+				"$in": []string{shared.ConfigEnrollmentStart, shared.ConfigEnrollmentEnd, shared.ConfigDropGrace},
+			}})
+		}()
+
+		// Preview: carts can be built, but not enrolled
+		setConfig(shared.ConfigEnrollmentStart, time.Now().Add(24*time.Hour).Format(time.RFC3339))
+		statusResp, err := client.GetEnrollmentStatus(ctx, &pb_enroll.GetEnrollmentStatusRequest{})
+		if err != nil || statusResp.Phase != shared.PhasePreview || !statusResp.CanAddToCart || statusResp.CanEnroll {
+			t.Fatalf("Expected preview phase allowing carts only, got %v (err %v)", statusResp, err)
+		}
+		if _, err := client.AddToCart(ctx, &pb_enroll.AddToCartRequest{StudentId: phaseStudentID, CourseId: phaseCourseID}); err != nil {
+			t.Errorf("Expected AddToCart during preview, got %v", err)
+		}
+		_, err = client.EnrollAll(ctx, &pb_enroll.EnrollAllRequest{StudentId: phaseStudentID})
+		if shared.ErrorCodeOf(err) != shared.ErrCodeEnrollmentClosed {
+			t.Errorf("Expected ENROLLMENT_CLOSED for EnrollAll during preview, got %v", err)
+		}
+
+		// Closed, but within the drop grace period
+		setConfig(shared.ConfigEnrollmentStart, time.Now().Add(-72*time.Hour).Format(time.RFC3339))
+		setConfig(shared.ConfigEnrollmentEnd, time.Now().Add(-time.Hour).Format(time.RFC3339))
+		setConfig(shared.ConfigDropGrace, "24")
+		statusResp, _ = client.GetEnrollmentStatus(ctx, &pb_enroll.GetEnrollmentStatusRequest{})
+		if statusResp.Phase != shared.PhaseClosed || statusResp.CanAddToCart || !statusResp.CanDrop {
+			t.Errorf("Expected closed phase with drops still allowed, got %v", statusResp)
+		}
+		_, err = client.AddToCart(ctx, &pb_enroll.AddToCartRequest{StudentId: phaseStudentID, CourseId: phaseCourseID})
+		if shared.ErrorCodeOf(err) != shared.ErrCodeEnrollmentClosed {
+			t.Errorf("Expected ENROLLMENT_CLOSED for AddToCart after the window, got %v", err)
+		}
+		_, err = client.DropCourse(ctx, &pb_enroll.DropCourseRequest{StudentId: phaseStudentID, CourseId: phaseCourseID})
+		if shared.ErrorCodeOf(err) != shared.ErrCodeNotEnrolled {
+			t.Errorf("Expected drop to reach the enrollment lookup within the grace period, got %v", err)
+		}
+
+		// Past the grace period drops are refused too
+		setConfig(shared.ConfigDropGrace, "0")
+		_, err = client.DropCourse(ctx, &pb_enroll.DropCourseRequest{StudentId: phaseStudentID, CourseId: phaseCourseID})
+		if shared.ErrorCodeOf(err) != shared.ErrCodeEnrollmentClosed {
+			t.Errorf("Expected ENROLLMENT_CLOSED for DropCourse after the grace period, got %v", err)
+		}
+	})
+
+	// --- 11. Capacity Warning Threshold ---
+	t.Run("Capacity Warning", func(t *testing.T) {
+		warnStudentID := "student-enroll-002"
+		warnCourseID := "CS-ENROLL-WARN"
+		db.Collection("courses").InsertOne(ctx, shared.Course{
+			ID: warnCourseID, Code: "CSE195", Title: "Nearly Full",
+			Units: 1, Capacity: 10, Enrolled: 8, IsOpen: true,
+			Schedule: "S 8:00-9:00",
+		})
+		db.Collection("carts").DeleteOne(ctx, map[string]interface{}{"student_id": warnStudentID})
+		db.Collection("system_config").UpdateOne(ctx,
+			map[string]interface{}{"key": shared.ConfigCapacityWarning},
+			map[string]interface{}{"$set": map[string]interface{}{"value": "90%"}},
+			options.Update().SetUpsert(true),
+		)
+		defer func() {
+			db.Collection("courses").DeleteOne(ctx, map[string]interface{}{"_id": warnCourseID})
+			db.Collection("carts").DeleteOne(ctx, map[string]interface{}{"student_id": warnStudentID})
+			db.Collection("enrollments").DeleteMany(ctx, map[string]interface{}{"course_id": warnCourseID})
+			db.Collection("enrollment_receipts").DeleteMany(ctx, map[string]interface{}{"student_id": warnStudentID})
+			db.Collection("audit_logs").DeleteMany(ctx, map[string]interface{}{"resource": warnCourseID})
+			db.Collection("system_config").DeleteOne(ctx, map[string]interface{}{"key": shared.ConfigCapacityWarning})
+		}()
+
+		if _, err := client.AddToCart(ctx, &pb_enroll.AddToCartRequest{StudentId: warnStudentID, CourseId: warnCourseID}); err != nil {
+			t.Fatalf("AddToCart failed: %v", err)
+		}
+		resp, err := client.EnrollAll(ctx, &pb_enroll.EnrollAllRequest{StudentId: warnStudentID})
+		if err != nil || !resp.Success {
+			t.Fatalf("EnrollAll failed: %v %v", err, resp)
+		}
+
+		// 9/10 seats crosses the 90% threshold exactly once
+		var entry shared.AuditLog
+		err = db.Collection("audit_logs").FindOne(ctx, map[string]interface{}{
+			"action": shared.ActionCapacityWarn, "resource": warnCourseID,
+		}).Decode(&entry)
+		if err != nil {
+			t.Fatalf("Expected a capacity warning audit event: %v", err)
+		}
+		if enrolled, _ := entry.Details["enrolled"].(int32); enrolled != 9 {
+			t.Errorf("Expected warning at 9 enrolled, got %v", entry.Details["enrolled"])
+		}
+		if rate, _ := entry.Details["fill_rate"].(float64); rate != 90 {
+			t.Errorf("Expected fill rate 90, got %v", entry.Details["fill_rate"])
+		}
+	})
+
+	// --- 12. Advance Registration With In-Progress Prerequisites ---
+	t.Run("In-Progress Prerequisites", func(t *testing.T) {
+		advStudentID := "student-enroll-002"
+		currentCourseID := "CS-ENROLL-NOW"
+		futureCourseID := "CS-ENROLL-NEXT"
+		currentEnrollmentID := shared.GenerateEnrollmentID()
+		db.Collection("courses").InsertMany(ctx, []interface{}{
+			shared.Course{ID: currentCourseID, Code: "CSE198", Title: "Taking Now", Units: 1, Capacity: 10, IsOpen: true, Schedule: "S 17:00-18:00", Semester: "Fall 2030"},
+			shared.Course{ID: futureCourseID, Code: "CSE199", Title: "Next Term", Units: 1, Capacity: 10, IsOpen: true, Schedule: "S 17:00-18:00", Semester: "Spring 2031"},
+		})
+		db.Collection("prerequisites").InsertOne(ctx, shared.Prerequisite{CourseID: futureCourseID, PrereqID: currentCourseID})
+		db.Collection("enrollments").InsertOne(ctx, shared.Enrollment{
+			ID: currentEnrollmentID, StudentID: advStudentID, CourseID: currentCourseID,
+			Status: shared.StatusEnrolled, EnrolledAt: time.Now(),
+		})
+		db.Collection("carts").DeleteOne(ctx, map[string]interface{}{"student_id": advStudentID})
+		configCol := db.Collection("system_config")
+		defer func() {
+			db.Collection("courses").DeleteMany(ctx, map[string]interface{}{"_id": map[string]interface{}{"$in": []string{currentCourseID, futureCourseID}}})
+			db.Collection("prerequisites").DeleteMany(ctx, map[string]interface{}{"course_id": futureCourseID})
+			db.Collection("carts").DeleteOne(ctx, map[string]interface{}{"student_id": advStudentID})
+			db.Collection("enrollments").DeleteMany(ctx, map[string]interface{}{"course_id": map[string]interface{}{"$in": []string{currentCourseID, futureCourseID}}})
+			db.Collection("enrollment_receipts").DeleteMany(ctx, map[string]interface{}{"student_id": advStudentID})
+			configCol.DeleteOne(ctx, map[string]interface{}{"key": shared.ConfigCurrentSemester})
+		}()
+
+		if _, err := client.AddToCart(ctx, &pb_enroll.AddToCartRequest{StudentId: advStudentID, CourseId: futureCourseID}); err != nil {
+			t.Fatalf("AddToCart failed: %v", err)
+		}
+
+		// Without a current semester the in-progress course does not count
+		cartResp, err := client.GetCart(ctx, &pb_enroll.GetCartRequest{StudentId: advStudentID})
+		if err != nil || len(cartResp.Cart.MissingPrerequisites) != 1 {
+			t.Fatalf("Expected the prerequisite to be missing, got %v (err %v)", cartResp, err)
+		}
+
+		configCol.UpdateOne(ctx,
+			map[string]interface{}{"key": shared.ConfigCurrentSemester},
+			map[string]interface{}{"$set": map[string]interface{}{"value": "Fall 2030"}},
+			options.Update().SetUpsert(true),
+		)
+		cartResp, err = client.GetCart(ctx, &pb_enroll.GetCartRequest{StudentId: advStudentID})
+		if err != nil || len(cartResp.Cart.MissingPrerequisites) != 0 {
+			t.Fatalf("Expected the in-progress prerequisite to count, got %v (err %v)", cartResp, err)
+		}
+		if len(cartResp.Cart.ConditionalCourses) != 1 || cartResp.Cart.ConditionalCourses[0] != futureCourseID {
+			t.Errorf("Expected %s to be conditional, got %v", futureCourseID, cartResp.Cart.ConditionalCourses)
+		}
+
+		resp, err := client.EnrollAll(ctx, &pb_enroll.EnrollAllRequest{StudentId: advStudentID})
+		if err != nil || !resp.Success {
+			t.Fatalf("EnrollAll failed: %v %v", err, resp)
+		}
+		var enrollment shared.Enrollment
+		err = db.Collection("enrollments").FindOne(ctx, map[string]interface{}{
+			"student_id": advStudentID, "course_id": futureCourseID,
+		}).Decode(&enrollment)
+		if err != nil || !enrollment.Conditional {
+			t.Errorf("Expected a conditional enrollment, got %+v (err %v)", enrollment, err)
+		}
+	})
+	// --- 13. Concurrent Enroll All For One Student ---
+	t.Run("Concurrent Enroll All", func(t *testing.T) {
+		lockStudentID := "student-enroll-002"
+		lockCourseID := "CS-ENROLL-LOCK"
+		db.Collection("courses").InsertOne(ctx, shared.Course{
+			ID: lockCourseID, Code: "CSE197", Title: "Double Submit", Units: 1, Capacity: 10, IsOpen: true,
+			Schedule: "S 8:00-9:00",
+		})
+		db.Collection("carts").DeleteOne(ctx, map[string]interface{}{"student_id": lockStudentID})
+		locksCol := db.Collection("enroll_locks")
+		defer func() {
+			db.Collection("courses").DeleteOne(ctx, map[string]interface{}{"_id": lockCourseID})
+			db.Collection("carts").DeleteOne(ctx, map[string]interface{}{"student_id": lockStudentID})
+			db.Collection("enrollments").DeleteMany(ctx, map[string]interface{}{"course_id": lockCourseID})
+			db.Collection("enrollment_receipts").DeleteMany(ctx, map[string]interface{}{"student_id": lockStudentID})
+			locksCol.DeleteOne(ctx, map[string]interface{}{"_id": lockStudentID})
+		}()
+
+		if _, err := client.AddToCart(ctx, &pb_enroll.AddToCartRequest{StudentId: lockStudentID, CourseId: lockCourseID}); err != nil {
+			t.Fatalf("AddToCart failed: %v", err)
+		}
+
+		// A live lock held by another request rejects the call outright
+		locksCol.InsertOne(ctx, map[string]interface{}{
+			"_id": lockStudentID, "token": "other", "operation": "enroll_all", "expires_at": time.Now().Add(time.Minute),
+		})
+		_, err := client.EnrollAll(ctx, &pb_enroll.EnrollAllRequest{StudentId: lockStudentID})
+		if status.Code(err) != codes.Aborted || shared.ErrorCodeOf(err) != shared.ErrCodeOperationInProgress {
+			t.Fatalf("Expected OPERATION_IN_PROGRESS while locked, got %v", err)
+		}
+		locksCol.DeleteOne(ctx, map[string]interface{}{"_id": lockStudentID})
+
+		// Simulated double-submit: exactly one call enrolls, the rest are turned away
+		const attempts = 5
+		var wg sync.WaitGroup
+		var mu sync.Mutex
+		succeeded := 0
+		for i := 0; i < attempts; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				resp, err := client.EnrollAll(ctx, &pb_enroll.EnrollAllRequest{StudentId: lockStudentID})
+				mu.Lock()
+				defer mu.Unlock()
+				switch {
+				case err == nil && resp.Success:
+					succeeded++
+				case shared.ErrorCodeOf(err) == shared.ErrCodeOperationInProgress,
+					shared.ErrorCodeOf(err) == shared.ErrCodeCartEmpty: // arrived after the winner cleared the cart
+				default:
+					t.Errorf("Unexpected EnrollAll outcome: %v (err %v)", resp, err)
+				}
+			}()
+		}
+		wg.Wait()
+		if succeeded != 1 {
+			t.Errorf("Expected exactly 1 successful EnrollAll, got %d", succeeded)
+		}
+
+		count, _ := db.Collection("enrollments").CountDocuments(ctx, map[string]interface{}{
+			"student_id": lockStudentID, "course_id": lockCourseID, "status": shared.StatusEnrolled,
+		})
+		var course shared.Course
+		db.Collection("courses").FindOne(ctx, map[string]interface{}{"_id": lockCourseID}).Decode(&course)
+		if count != 1 || course.Enrolled != 1 {
+			t.Errorf("Expected 1 enrollment and 1 seat taken, got %d enrollments and %d enrolled", count, course.Enrolled)
+		}
+
+		// Every call released its lock on the way out
+		if n, _ := locksCol.CountDocuments(ctx, map[string]interface{}{"_id": lockStudentID}); n != 0 {
+			t.Errorf("Expected the enrollment lock to be released, found %d", n)
+		}
+	})
+	// --- 14. Cart Expiry ---
+	t.Run("Cart Expiry", func(t *testing.T) {
+		staleStudentID := "student-enroll-002"
+		cartsCol := db.Collection("carts")
+		staleCart := shared.Cart{
+			StudentID: staleStudentID, CourseIDs: []string{testCourseID},
+			UpdatedAt: time.Now().Add(-20 * 24 * time.Hour),
+		}
+		cartsCol.DeleteOne(ctx, map[string]interface{}{"student_id": staleStudentID})
+		defer cartsCol.DeleteOne(ctx, map[string]interface{}{"student_id": staleStudentID})
+
+		// Lazy path: the first view reports the expiry and removes the cart
+		cartsCol.InsertOne(ctx, staleCart)
+		resp, err := client.GetCart(ctx, &pb_enroll.GetCartRequest{StudentId: staleStudentID})
+		if err != nil || len(resp.Cart.Items) != 0 || !strings.Contains(resp.Message, "expired") {
+			t.Fatalf("Expected an empty expired cart, got %v (err %v)", resp, err)
+		}
+		if n, _ := cartsCol.CountDocuments(ctx, map[string]interface{}{"student_id": staleStudentID}); n != 0 {
+			t.Errorf("Expected the expired cart to be deleted, found %d", n)
+		}
+		resp, _ = client.GetCart(ctx, &pb_enroll.GetCartRequest{StudentId: staleStudentID})
+		if resp == nil || resp.Message != "cart is empty" {
+			t.Errorf("Expected the expiry notice only once, got %v", resp)
+		}
+
+		// Sweep path: the cart is emptied and the notice survives until the next view
+		cartsCol.InsertOne(ctx, staleCart)
+		sweeper := NewEnrollmentService(nil, db, nil)
+		if n, err := sweeper.ExpireStaleCarts(ctx); err != nil || n < 1 {
+			t.Fatalf("Expected the sweep to expire the cart, got %d (err %v)", n, err)
+		}
+		var swept shared.Cart
+		cartsCol.FindOne(ctx, map[string]interface{}{"student_id": staleStudentID}).Decode(&swept)
+		if len(swept.CourseIDs) != 0 || swept.ExpiredReason != shared.CartExpiredInactive {
+			t.Errorf("Expected an emptied cart marked inactive, got %+v", swept)
+		}
+		resp, err = client.GetCart(ctx, &pb_enroll.GetCartRequest{StudentId: staleStudentID})
+		if err != nil || !strings.Contains(resp.Message, "inactivity") {
+			t.Errorf("Expected the inactivity notice after the sweep, got %v (err %v)", resp, err)
+		}
+	})
+
+	// --- 15. Per-Course Enrollment Window ---
+	t.Run("Course Enrollment Window", func(t *testing.T) {
+		windowStudentID := "student-enroll-002"
+		windowCourseID := "CS-ENROLL-WINDOW"
+		db.Collection("courses").InsertOne(ctx, shared.Course{
+			ID: windowCourseID, Code: "CSE189", Title: "Opens Tomorrow",
+			Units: 1, Capacity: 10, IsOpen: true, Schedule: "S 19:00-20:00",
+			EnrollOpenAt: time.Now().Add(24 * time.Hour),
+		})
+		db.Collection("carts").DeleteOne(ctx, map[string]interface{}{"student_id": windowStudentID})
+		defer func() {
+			db.Collection("courses").DeleteOne(ctx, map[string]interface{}{"_id": windowCourseID})
+			db.Collection("carts").DeleteOne(ctx, map[string]interface{}{"student_id": windowStudentID})
+			db.Collection("enrollments").DeleteMany(ctx, map[string]interface{}{"course_id": windowCourseID})
+			db.Collection("enrollment_receipts").DeleteMany(ctx, map[string]interface{}{"student_id": windowStudentID})
+		}()
+		setOpenAt := func(at time.Time) {
+			db.Collection("courses").UpdateOne(ctx,
+				map[string]interface{}{"_id": windowCourseID},
+				map[string]interface{}{"$set": map[string]interface{}{"enroll_open_at": at}},
+			)
+		}
+
+		// is_open alone is not enough before the course's own window starts
+		_, err := client.AddToCart(ctx, &pb_enroll.AddToCartRequest{StudentId: windowStudentID, CourseId: windowCourseID})
+		if shared.ErrorCodeOf(err) != shared.ErrCodeCourseClosed || !strings.Contains(status.Convert(err).Message(), "opens for enrollment") {
+			t.Fatalf("Expected COURSE_CLOSED until the window opens, got %v", err)
+		}
+
+		// Once open the course can be added; EnrollAll re-checks the window
+		setOpenAt(time.Now().Add(-time.Hour))
+		if _, err := client.AddToCart(ctx, &pb_enroll.AddToCartRequest{StudentId: windowStudentID, CourseId: windowCourseID}); err != nil {
+			t.Fatalf("Expected AddToCart to succeed within the window, got %v", err)
+		}
+		setOpenAt(time.Now().Add(24 * time.Hour))
+		if _, err := client.EnrollAll(ctx, &pb_enroll.EnrollAllRequest{StudentId: windowStudentID}); shared.ErrorCodeOf(err) != shared.ErrCodeCourseClosed {
+			t.Errorf("Expected EnrollAll to reject a course whose window hasn't opened, got %v", err)
+		}
+	})
+
+	t.Run("Closed Semester", func(t *testing.T) {
+		closedStudentID := "student-enroll-002"
+		closedCourseID := "CS-ENROLL-CLOSEDSEM"
+		closedSemester := "Closed Sem Test"
+		db.Collection("courses").InsertOne(ctx, shared.Course{
+			ID: closedCourseID, Code: "CSE188", Title: "Reopened After Closing",
+			Units: 1, Capacity: 10, IsOpen: true, Schedule: "S 17:00-18:00", Semester: closedSemester,
+		})
+		db.Collection("carts").DeleteOne(ctx, map[string]interface{}{"student_id": closedStudentID})
+		closeSemester := func() {
+			db.Collection("semester_closures").InsertOne(ctx, shared.SemesterClosure{Semester: closedSemester, ClosedAt: time.Now()})
+		}
+		reopenSemester := func() {
+			db.Collection("semester_closures").DeleteOne(ctx, map[string]interface{}{"_id": closedSemester})
+		}
+		defer func() {
+			reopenSemester()
+			db.Collection("courses").DeleteOne(ctx, map[string]interface{}{"_id": closedCourseID})
+			db.Collection("carts").DeleteOne(ctx, map[string]interface{}{"student_id": closedStudentID})
+			db.Collection("enrollments").DeleteMany(ctx, map[string]interface{}{"course_id": closedCourseID})
+			db.Collection("enrollment_receipts").DeleteMany(ctx, map[string]interface{}{"student_id": closedStudentID})
+		}()
+
+		// The course is open again, but its semester has been closed
+		closeSemester()
+		_, err := client.AddToCart(ctx, &pb_enroll.AddToCartRequest{StudentId: closedStudentID, CourseId: closedCourseID})
+		if shared.ErrorCodeOf(err) != shared.ErrCodeSemesterClosed {
+			t.Fatalf("Expected SEMESTER_CLOSED from AddToCart, got %v", err)
+		}
+
+		// A cart built before the closure cannot be enrolled afterwards
+		reopenSemester()
+		if _, err := client.AddToCart(ctx, &pb_enroll.AddToCartRequest{StudentId: closedStudentID, CourseId: closedCourseID}); err != nil {
+			t.Fatalf("Expected AddToCart to succeed before closing, got %v", err)
+		}
+		closeSemester()
+		if _, err := client.EnrollAll(ctx, &pb_enroll.EnrollAllRequest{StudentId: closedStudentID}); shared.ErrorCodeOf(err) != shared.ErrCodeSemesterClosed {
+			t.Errorf("Expected SEMESTER_CLOSED from EnrollAll, got %v", err)
+		}
+		if n, _ := db.Collection("enrollments").CountDocuments(ctx, map[string]interface{}{"course_id": closedCourseID}); n != 0 {
+			t.Errorf("Expected no enrollment in a closed semester, got %d", n)
+		}
+	})
+
+	// --- 16. Completed Enrollments Are Final ---
+	t.Run("Drop Completed Enrollment", func(t *testing.T) {
+		doneStudentID := "student-enroll-002"
+		doneCourseID := "CS-ENROLL-DONE"
+		db.Collection("enrollments").InsertOne(ctx, shared.Enrollment{
+			ID: "enr-done-001", StudentID: doneStudentID, CourseID: doneCourseID,
+			Status: shared.StatusCompleted, EnrolledAt: time.Now().Add(-120 * 24 * time.Hour),
+		})
+		defer db.Collection("enrollments").DeleteOne(ctx, map[string]interface{}{"_id": "enr-done-001"})
+
+		_, err := client.DropCourse(ctx, &pb_enroll.DropCourseRequest{StudentId: doneStudentID, CourseId: doneCourseID})
+		if shared.ErrorCodeOf(err) != shared.ErrCodeInvalidStatusTransition {
+			t.Fatalf("Expected INVALID_STATUS_TRANSITION when dropping a completed course, got %v", err)
+		}
+		var stored shared.Enrollment
+		db.Collection("enrollments").FindOne(ctx, map[string]interface{}{"_id": "enr-done-001"}).Decode(&stored)
+		if stored.Status != shared.StatusCompleted {
+			t.Errorf("Expected the enrollment to stay completed, got %s", stored.Status)
+		}
+	})
+
+	// --- 17. Stored Cart Validation ---
+	t.Run("Cart Validation Reuse", func(t *testing.T) {
+		reuseStudentID := "student-enroll-002"
+		reuseCourseID := "CS-ENROLL-REUSE"
+		db.Collection("courses").InsertOne(ctx, shared.Course{
+			ID: reuseCourseID, Code: "CSE190", Title: "Validated Once",
+			Units: 1, Capacity: 10, IsOpen: true, Schedule: "S 20:00-21:00",
+		})
+		db.Collection("carts").DeleteOne(ctx, map[string]interface{}{"student_id": reuseStudentID})
+		defer func() {
+			db.Collection("courses").DeleteOne(ctx, map[string]interface{}{"_id": reuseCourseID})
+			db.Collection("carts").DeleteOne(ctx, map[string]interface{}{"student_id": reuseStudentID})
+			db.Collection("enrollments").DeleteMany(ctx, map[string]interface{}{"course_id": reuseCourseID})
+			db.Collection("enrollment_receipts").DeleteMany(ctx, map[string]interface{}{"student_id": reuseStudentID})
+		}()
+		storedCart := func() (c shared.Cart) {
+			db.Collection("carts").FindOne(ctx, map[string]interface{}{"student_id": reuseStudentID}).Decode(&c)
+			return c
+		}
+
+		// AddToCart returns a freshly validated cart and stores the results with it
+		if _, err := client.AddToCart(ctx, &pb_enroll.AddToCartRequest{StudentId: reuseStudentID, CourseId: reuseCourseID}); err != nil {
+			t.Fatalf("AddToCart failed: %v", err)
+		}
+		cart := storedCart()
+		v := cart.ValidationResults
+		if v == nil || !v.CartUpdatedAt.Equal(cart.UpdatedAt) || len(v.Items) != 1 || v.Items[0].CourseCode != "CSE190" || v.TotalUnits != 1 {
+			t.Fatalf("Expected stored validation for the current cart, got %+v", v)
+		}
+
+		// A fresh result is trusted as is: a planted conflict blocks EnrollAll
+		setValidation := func(fields map[string]interface{}) {
+			set := map[string]interface{}{}
+			for k, val := range fields {
+				set["validation_results."+k] = val
+			}
+			db.Collection("carts").UpdateOne(ctx, map[string]interface{}{"student_id": reuseStudentID}, map[string]interface{}{"$set": set})
+		}
+		setValidation(map[string]interface{}{"has_conflicts": true})
+		resp, err := client.EnrollAll(ctx, &pb_enroll.EnrollAllRequest{StudentId: reuseStudentID})
+		if shared.ErrorCodeOf(err) != shared.ErrCodeScheduleConflict {
+			t.Fatalf("Expected the stored validation to be reused, got %v (err %v)", resp, err)
+		}
+
+		// Once older than the staleness bound it is recomputed
+		setValidation(map[string]interface{}{"computed_at": time.Now().Add(-time.Hour)})
+		resp, err = client.EnrollAll(ctx, &pb_enroll.EnrollAllRequest{StudentId: reuseStudentID})
+		if err != nil || !resp.Success {
+			t.Fatalf("Expected EnrollAll to revalidate a stale result and succeed, got %v (err %v)", resp, err)
+		}
+
+		// Changing the course list drops the stored results
+		db.Collection("carts").InsertOne(ctx, shared.Cart{
+			StudentID: reuseStudentID, CourseIDs: []string{reuseCourseID, testCourseID}, UpdatedAt: time.Now(),
+			ValidationResults: &shared.CartValidation{ComputedAt: time.Now()},
+		})
+		client.RemoveFromCart(ctx, &pb_enroll.RemoveFromCartRequest{StudentId: reuseStudentID, CourseId: testCourseID})
+		if cart := storedCart(); cart.ValidationResults == nil || len(cart.ValidationResults.Items) != 1 || !cart.ValidationResults.CartUpdatedAt.Equal(cart.UpdatedAt) {
+			t.Errorf("Expected RemoveFromCart to replace the stored validation, got %+v", cart.ValidationResults)
+		}
+	})
+
+	t.Run("Full-Time Load Warning", func(t *testing.T) {
+		loadStudentID := "student-enroll-002"
+		loadCourseIDs := []string{"CS-ENROLL-LOAD1", "CS-ENROLL-LOAD2", "CS-ENROLL-LOAD3"}
+		db.Collection("courses").InsertMany(ctx, []interface{}{
+			shared.Course{ID: loadCourseIDs[0], Code: "CSE181", Title: "Load One", Units: 3, Capacity: 10, IsOpen: true, Schedule: "S 6:00-7:00", Semester: "Fall 2040"},
+			shared.Course{ID: loadCourseIDs[1], Code: "CSE182", Title: "Load Two", Units: 3, Capacity: 10, IsOpen: true, Schedule: "S 7:00-8:00", Semester: "Fall 2040"},
+			shared.Course{ID: loadCourseIDs[2], Code: "CSE183", Title: "Load Three", Units: 3, Capacity: 10, IsOpen: true, Schedule: "S 21:00-22:00", Semester: "Fall 2040"},
+		})
+		db.Collection("carts").DeleteOne(ctx, map[string]interface{}{"student_id": loadStudentID})
+		db.Collection("system_config").UpdateOne(ctx,
+			map[string]interface{}{"key": shared.ConfigMinFullTimeUnits},
+			map[string]interface{}{"$set": map[string]interface{}{"value": "12"}},
+			options.Update().SetUpsert(true),
+		)
+		defer func() {
+			db.Collection("courses").DeleteMany(ctx, map[string]interface{}{"_id": map[string]interface{}{"$in": loadCourseIDs}})
+			db.Collection("carts").DeleteOne(ctx, map[string]interface{}{"student_id": loadStudentID})
+			db.Collection("enrollments").DeleteMany(ctx, map[string]interface{}{"course_id": map[string]interface{}{"$in": loadCourseIDs}})
+			db.Collection("enrollment_receipts").DeleteMany(ctx, map[string]interface{}{"student_id": loadStudentID})
+			db.Collection("system_config").DeleteOne(ctx, map[string]interface{}{"key": shared.ConfigMinFullTimeUnits})
+		}()
+
+		for _, id := range loadCourseIDs {
+			if _, err := client.AddToCart(ctx, &pb_enroll.AddToCartRequest{StudentId: loadStudentID, CourseId: id}); err != nil {
+				t.Fatalf("AddToCart %s failed: %v", id, err)
+			}
+		}
+		want := shared.FullTimeLoadWarning(9, 12)
+		cartResp, err := client.GetCart(ctx, &pb_enroll.GetCartRequest{StudentId: loadStudentID})
+		if err != nil || len(cartResp.Cart.Warnings) != 1 || cartResp.Cart.Warnings[0] != want {
+			t.Fatalf("Expected the cart to warn about a 9-unit load, got %v (err %v)", cartResp, err)
+		}
+
+		// The warning is informational: the 9 units still enroll
+		resp, err := client.EnrollAll(ctx, &pb_enroll.EnrollAllRequest{StudentId: loadStudentID})
+		if err != nil || !resp.Success {
+			t.Fatalf("Expected EnrollAll to succeed below the full-time load, got %v (err %v)", resp, err)
+		}
+		if len(resp.Warnings) != 1 || resp.Warnings[0] != want {
+			t.Errorf("Expected EnrollAll to repeat the full-time warning, got %v", resp.Warnings)
+		}
+		if count, _ := db.Collection("enrollments").CountDocuments(ctx, map[string]interface{}{
+			"student_id": loadStudentID, "course_id": map[string]interface{}{"$in": loadCourseIDs},
+		}); count != 3 {
+			t.Errorf("Expected 3 enrollments, got %d", count)
+		}
+	})
+
+	t.Run("Seat Holds", func(t *testing.T) {
+		holderID, otherID := "student-enroll-001", "student-enroll-002"
+		holdCourseID := "CS-ENROLL-HOLD"
+		courseClient := pb_course.NewCourseServiceClient(courseConn)
+		db.Collection("courses").InsertOne(ctx, shared.Course{
+			ID: holdCourseID, Code: "CSE186", Title: "Last Seat",
+			Units: 1, Capacity: 2, Enrolled: 1, IsOpen: true, Schedule: "S 5:00-6:00",
+		})
+		db.Collection("carts").DeleteMany(ctx, map[string]interface{}{"student_id": map[string]interface{}{"$in": []string{holderID, otherID}}})
+		configCol := db.Collection("system_config")
+		configCol.UpdateOne(ctx,
+			map[string]interface{}{"key": shared.ConfigSeatHoldsEnabled},
+			map[string]interface{}{"$set": map[string]interface{}{"value": "true"}},
+			options.Update().SetUpsert(true),
+		)
+		defer func() {
+			db.Collection("courses").DeleteOne(ctx, map[string]interface{}{"_id": holdCourseID})
+			db.Collection("carts").DeleteMany(ctx, map[string]interface{}{"student_id": map[string]interface{}{"$in": []string{holderID, otherID}}})
+			db.Collection("seat_holds").DeleteMany(ctx, map[string]interface{}{"course_id": holdCourseID})
+			configCol.DeleteOne(ctx, map[string]interface{}{"key": shared.ConfigSeatHoldsEnabled})
+		}()
+		seatsAvailable := func() int32 {
+			resp, err := courseClient.GetCourse(ctx, &pb_course.GetCourseRequest{CourseId: holdCourseID})
+			if err != nil {
+				t.Fatalf("GetCourse failed: %v", err)
+			}
+			return resp.Course.SeatsAvailable
+		}
+
+		// The last seat reaches the 90% threshold, so adding it places a hold
+		if _, err := client.AddToCart(ctx, &pb_enroll.AddToCartRequest{StudentId: holderID, CourseId: holdCourseID}); err != nil {
+			t.Fatalf("AddToCart failed: %v", err)
+		}
+		if seats := seatsAvailable(); seats != 0 {
+			t.Errorf("Expected the held seat to be unavailable, got %d seats", seats)
+		}
+		_, err := client.AddToCart(ctx, &pb_enroll.AddToCartRequest{StudentId: otherID, CourseId: holdCourseID})
+		if shared.ErrorCodeOf(err) != shared.ErrCodeCourseFull {
+			t.Errorf("Expected COURSE_FULL while the last seat is held, got %v", err)
+		}
+
+		// An expired hold returns its seat even before the TTL monitor removes it
+		db.Collection("seat_holds").UpdateMany(ctx,
+			map[string]interface{}{"course_id": holdCourseID},
+			map[string]interface{}{"$set": map[string]interface{}{"expires_at": time.Now().Add(-time.Minute)}},
+		)
+		if seats := seatsAvailable(); seats != 1 {
+			t.Errorf("Expected the expired hold to return the seat, got %d seats", seats)
+		}
+
+		// Removing the course from the cart releases a live hold as well
+		client.RemoveFromCart(ctx, &pb_enroll.RemoveFromCartRequest{StudentId: holderID, CourseId: holdCourseID})
+		if _, err := client.AddToCart(ctx, &pb_enroll.AddToCartRequest{StudentId: otherID, CourseId: holdCourseID}); err != nil {
+			t.Fatalf("AddToCart after the hold lapsed failed: %v", err)
+		}
+		client.RemoveFromCart(ctx, &pb_enroll.RemoveFromCartRequest{StudentId: otherID, CourseId: holdCourseID})
+		if seats := seatsAvailable(); seats != 1 {
+			t.Errorf("Expected RemoveFromCart to release the hold, got %d seats", seats)
+		}
+
+		// With seat holds off nothing is held
+		configCol.UpdateOne(ctx,
+			map[string]interface{}{"key": shared.ConfigSeatHoldsEnabled},
+			map[string]interface{}{"$set": map[string]interface{}{"value": "false"}},
+		)
+		if _, err := client.AddToCart(ctx, &pb_enroll.AddToCartRequest{StudentId: holderID, CourseId: holdCourseID}); err != nil {
+			t.Fatalf("AddToCart with seat holds off failed: %v", err)
+		}
+		if n, _ := db.Collection("seat_holds").CountDocuments(ctx, map[string]interface{}{"course_id": holdCourseID}); n != 0 {
+			t.Errorf("Expected no seat holds while the mode is off, got %d", n)
+		}
+	})
+
+	t.Run("Check Conflicts With Enrollments", func(t *testing.T) {
+		// Enrolled: EXIST (clashes with NEW), ALSO (clashes with EXIST only) and
+		// OTHER (clashes with NEW but in another semester)
+		conflictCourses := []interface{}{
+			shared.Course{ID: "CONF-EXIST", Code: "CONF-1", Units: 3, Capacity: 10, IsOpen: true, Semester: "Fall 2042", Schedule: "MWF 8:00-9:00"},
+			shared.Course{ID: "CONF-ALSO", Code: "CONF-2", Units: 3, Capacity: 10, IsOpen: true, Semester: "Fall 2042", Schedule: "M 8:30-10:00"},
+			shared.Course{ID: "CONF-OTHER", Code: "CONF-3", Units: 3, Capacity: 10, IsOpen: true, Semester: "Spring 2043", Schedule: "W 9:00-10:00"},
+			shared.Course{ID: "CONF-NEW", Code: "CONF-4", Units: 3, Capacity: 10, IsOpen: true, Semester: "Fall 2042", Schedule: "WF 8:30-9:30"},
+		}
+		conflictIDs := []string{"CONF-EXIST", "CONF-ALSO", "CONF-OTHER", "CONF-NEW"}
+		db.Collection("courses").DeleteMany(ctx, map[string]interface{}{"_id": map[string]interface{}{"$in": conflictIDs}})
+		db.Collection("courses").InsertMany(ctx, conflictCourses)
+		for _, id := range conflictIDs[:3] {
+			db.Collection("enrollments").InsertOne(ctx, shared.Enrollment{
+				ID: "ENR-" + id, StudentID: testStudentID, CourseID: id, Status: shared.StatusEnrolled, EnrolledAt: time.Now(),
+			})
+		}
+		defer func() {
+			db.Collection("courses").DeleteMany(ctx, map[string]interface{}{"_id": map[string]interface{}{"$in": conflictIDs}})
+			db.Collection("enrollments").DeleteMany(ctx, map[string]interface{}{"course_id": map[string]interface{}{"$in": conflictIDs}})
+		}()
+
+		// On its own the new course has nothing to clash with
+		resp, err := client.CheckConflicts(ctx, &pb_enroll.CheckConflictsRequest{StudentId: testStudentID, CourseIds: []string{"CONF-NEW"}})
+		if err != nil || resp.HasConflicts {
+			t.Fatalf("Expected no conflicts without include_enrolled, got %v %v", resp, err)
+		}
+
+		resp, err = client.CheckConflicts(ctx, &pb_enroll.CheckConflictsRequest{
+			StudentId: testStudentID, CourseIds: []string{"CONF-NEW"}, IncludeEnrolled: true,
+		})
+		if err != nil {
+			t.Fatalf("CheckConflicts failed: %v", err)
+		}
+		if !resp.HasConflicts || len(resp.Conflicts) != 1 {
+			t.Fatalf("Expected one conflict with the existing enrollment, got %+v", resp.Conflicts)
+		}
+		if c := resp.Conflicts[0]; c.ConflictType != "schedule" || c.Course1Id != "CONF-NEW" || c.Course2Id != "CONF-EXIST" {
+			t.Errorf("Expected CONF-NEW to clash with CONF-EXIST, got %+v", c)
+		}
+
+		// A requested course the student is already enrolled in does not clash with itself
+		resp, err = client.CheckConflicts(ctx, &pb_enroll.CheckConflictsRequest{
+			StudentId: testStudentID, CourseIds: []string{"CONF-EXIST"}, IncludeEnrolled: true,
+		})
+		if err != nil {
+			t.Fatalf("CheckConflicts failed: %v", err)
+		}
+		if len(resp.Conflicts) != 1 || resp.Conflicts[0].Course1Id != "CONF-EXIST" || resp.Conflicts[0].Course2Id != "CONF-ALSO" {
+			t.Errorf("Expected only CONF-EXIST clashing with CONF-ALSO, got %+v", resp.Conflicts)
+		}
+
+		_, err = client.CheckConflicts(ctx, &pb_enroll.CheckConflictsRequest{CourseIds: []string{"CONF-NEW"}, IncludeEnrolled: true})
+		if status.Code(err) != codes.InvalidArgument {
+			t.Errorf("Expected InvalidArgument without a student_id, got %v", err)
+		}
+	})
+
+	t.Run("Suggest Schedules", func(t *testing.T) {
+		semester := "Fall 2041"
+		suggestCourses := []interface{}{
+			shared.Course{ID: "SUG-A1", Code: "SUG-A", Title: "Suggest A", Units: 3, Capacity: 30, Schedule: "MWF 9:00-10:00"},
+			shared.Course{ID: "SUG-A2", Code: "SUG-A", Title: "Suggest A", Units: 3, Capacity: 20, Schedule: "TTH 9:00-10:30"},
+			shared.Course{ID: "SUG-B1", Code: "SUG-B", Title: "Suggest B", Units: 2, Capacity: 30, Schedule: "MWF 9:30-10:30"},
+			shared.Course{ID: "SUG-B2", Code: "SUG-B", Title: "Suggest B", Units: 2, Capacity: 20, Schedule: "MWF 13:00-14:00"},
+			shared.Course{ID: "SUG-C1", Code: "SUG-C", Title: "Full C", Units: 3, Capacity: 10, Enrolled: 10, Schedule: "TTH 13:00-14:00"},
+			shared.Course{ID: "SUG-C2", Code: "SUG-C", Title: "Closed C", Units: 3, Capacity: 10, Schedule: "TTH 15:00-16:00"},
+			shared.Course{ID: "SUG-C3", Code: "SUG-C", Title: "Opens Later C", Units: 3, Capacity: 10, Schedule: "TTH 16:00-17:00", EnrollOpenAt: time.Now().Add(24 * time.Hour)},
+			shared.Course{ID: "SUG-C4", Code: "SUG-C", Title: "Held C", Units: 3, Capacity: 2, Enrolled: 1, Schedule: "TTH 17:00-18:00"},
+		}
+		var suggestIDs []string
+		for i, c := range suggestCourses {
+			course := c.(shared.Course)
+			course.Semester, course.IsOpen = semester, course.ID != "SUG-C2"
+			suggestCourses[i] = course
+			suggestIDs = append(suggestIDs, course.ID)
+		}
+		db.Collection("courses").DeleteMany(ctx, map[string]interface{}{"_id": map[string]interface{}{"$in": suggestIDs}})
+		db.Collection("courses").InsertMany(ctx, suggestCourses)
+		// The last seat of SUG-C4 is held in another student's cart
+		configCol := db.Collection("system_config")
+		configCol.UpdateOne(ctx,
+			map[string]interface{}{"key": shared.ConfigSeatHoldsEnabled},
+			map[string]interface{}{"$set": map[string]interface{}{"value": "true"}},
+			options.Update().SetUpsert(true),
+		)
+		db.Collection("seat_holds").InsertOne(ctx, shared.SeatHold{ID: "HOLD-SUG-C4", CourseID: "SUG-C4", StudentID: "student-sug-other", ExpiresAt: time.Now().Add(time.Hour)})
+		defer func() {
+			db.Collection("courses").DeleteMany(ctx, map[string]interface{}{"_id": map[string]interface{}{"$in": suggestIDs}})
+			db.Collection("seat_holds").DeleteMany(ctx, map[string]interface{}{"course_id": "SUG-C4"})
+			configCol.DeleteOne(ctx, map[string]interface{}{"key": shared.ConfigSeatHoldsEnabled})
+		}()
+
+		resp, err := client.SuggestSchedules(ctx, &pb_enroll.SuggestSchedulesRequest{
+			StudentId: testStudentID, Semester: semester, CourseCodes: []string{"sug-a", "SUG-B", "SUG-C", "SUG-A"},
+		})
+		if err != nil || !resp.Success {
+			t.Fatalf("SuggestSchedules failed: %v %v", resp, err)
+		}
+		if len(resp.UnavailableCodes) != 1 || resp.UnavailableCodes[0] != "SUG-C" || resp.Partial {
+			t.Errorf("Expected only SUG-C unavailable (full, closed, not yet open or held) and a full search, got %v partial=%v", resp.UnavailableCodes, resp.Partial)
+		}
+
+		// A1 and B1 overlap; A2 pairs with either B without gaps, A1+B2 leaves 3 hours idle on MWF
+		var got []string
+		for _, schedule := range resp.Schedules {
+			var ids []string
+			for _, section := range schedule.Sections {
+				ids = append(ids, section.CourseId)
+			}
+			got = append(got, fmt.Sprintf("%s:%d", strings.Join(ids, "+"), schedule.GapMinutes))
+		}
+		if want := "SUG-A2+SUG-B1:0,SUG-A2+SUG-B2:0,SUG-A1+SUG-B2:540"; strings.Join(got, ",") != want {
+			t.Errorf("Expected schedules %s, got %s", want, strings.Join(got, ","))
+		}
+		if len(resp.Schedules) > 0 && resp.Schedules[0].TotalUnits != 5 {
+			t.Errorf("Expected 5 units per schedule, got %d", resp.Schedules[0].TotalUnits)
+		}
+
+		resp, err = client.SuggestSchedules(ctx, &pb_enroll.SuggestSchedulesRequest{
+			StudentId: testStudentID, Semester: semester, CourseCodes: []string{"SUG-A", "SUG-B"}, MaxResults: 1,
+		})
+		if err != nil || len(resp.Schedules) != 1 || resp.Schedules[0].GapMinutes != 0 {
+			t.Errorf("Expected only the best schedule with max_results 1, got %v %v", resp, err)
+		}
+
+		// The student's own hold leaves the seat theirs to take
+		db.Collection("seat_holds").UpdateOne(ctx,
+			map[string]interface{}{"_id": "HOLD-SUG-C4"},
+			map[string]interface{}{"$set": map[string]interface{}{"student_id": testStudentID}},
+		)
+		resp, err = client.SuggestSchedules(ctx, &pb_enroll.SuggestSchedulesRequest{StudentId: testStudentID, Semester: semester, CourseCodes: []string{"SUG-C"}})
+		if err != nil || len(resp.Schedules) != 1 || resp.Schedules[0].Sections[0].CourseId != "SUG-C4" || resp.Schedules[0].Sections[0].SeatsAvailable != 1 {
+			t.Errorf("Expected SUG-C4 with 1 seat for the student holding it, got %v %v", resp, err)
+		}
+
+		// Suggestions never touch the cart
+		if count, _ := db.Collection("carts").CountDocuments(ctx, map[string]interface{}{"course_ids": map[string]interface{}{"$in": suggestIDs}}); count != 0 {
+			t.Errorf("Expected no cart to hold a suggested section, got %d", count)
+		}
+
+		_, err = client.SuggestSchedules(ctx, &pb_enroll.SuggestSchedulesRequest{StudentId: testStudentID, Semester: semester})
+		if status.Code(err) != codes.InvalidArgument {
+			t.Errorf("Expected InvalidArgument without course codes, got %v", err)
+		}
+	})
+
+	t.Run("Drop Limit", func(t *testing.T) {
+		semester := "Fall 2044"
+		dropCourses := []interface{}{
+			shared.Course{ID: "DROP-1", Code: "DROP-1", Units: 3, Capacity: 10, Enrolled: 1, IsOpen: true, Semester: semester, Schedule: "M 8:00-9:00"},
+			shared.Course{ID: "DROP-2", Code: "DROP-2", Units: 3, Capacity: 10, Enrolled: 1, IsOpen: true, Semester: semester, Schedule: "T 8:00-9:00"},
+			shared.Course{ID: "DROP-3", Code: "DROP-3", Units: 3, Capacity: 10, Enrolled: 1, IsOpen: true, Semester: semester, Schedule: "W 8:00-9:00"},
+			shared.Course{ID: "DROP-FORCED", Code: "DROP-4", Units: 3, Capacity: 10, IsOpen: true, Semester: semester, Schedule: "TH 8:00-9:00"},
+			shared.Course{ID: "DROP-NEXT", Code: "DROP-5", Units: 3, Capacity: 10, Enrolled: 1, IsOpen: true, Semester: "Spring 2045", Schedule: "F 8:00-9:00"},
+		}
+		dropIDs := []string{"DROP-1", "DROP-2", "DROP-3", "DROP-FORCED", "DROP-NEXT"}
+		db.Collection("courses").DeleteMany(ctx, map[string]interface{}{"_id": map[string]interface{}{"$in": dropIDs}})
+		db.Collection("courses").InsertMany(ctx, dropCourses)
+		for _, id := range []string{"DROP-1", "DROP-2", "DROP-3", "DROP-NEXT"} {
+			db.Collection("enrollments").InsertOne(ctx, shared.Enrollment{
+				ID: "ENR-" + id, StudentID: testStudentID, CourseID: id, Status: shared.StatusEnrolled, EnrolledAt: time.Now(),
+			})
+		}
+		// An admin already forced one drop this semester; it does not count
+		db.Collection("enrollments").InsertOne(ctx, shared.Enrollment{
+			ID: "ENR-DROP-FORCED", StudentID: testStudentID, CourseID: "DROP-FORCED", Status: shared.StatusDropped,
+			EnrolledAt: time.Now().Add(-time.Hour), DroppedAt: time.Now().Add(-time.Minute), DropReason: shared.DropReasonAdminForced,
+		})
+
+		configCol := db.Collection("system_config")
+		configCol.UpdateOne(ctx,
+			map[string]interface{}{"key": shared.ConfigMaxDrops},
+			map[string]interface{}{"$set": map[string]interface{}{"value": "2"}},
+			options.Update().SetUpsert(true))
+		defer func() {
+			configCol.DeleteOne(ctx, map[string]interface{}{"key": shared.ConfigMaxDrops})
+			db.Collection("courses").DeleteMany(ctx, map[string]interface{}{"_id": map[string]interface{}{"$in": dropIDs}})
+			db.Collection("enrollments").DeleteMany(ctx, map[string]interface{}{"course_id": map[string]interface{}{"$in": dropIDs}})
+			db.Collection("enrollment_events").DeleteMany(ctx, map[string]interface{}{"course_id": map[string]interface{}{"$in": dropIDs}})
+		}()
+
+		remaining := func(want int32) {
+			t.Helper()
+			resp, err := client.GetStudentEnrollments(ctx, &pb_enroll.GetStudentEnrollmentsRequest{StudentId: testStudentID, Semester: semester})
+			if err != nil {
+				t.Fatalf("GetStudentEnrollments failed: %v", err)
+			}
+			if resp.MaxDropsPerSemester != 2 || resp.RemainingDrops != want || resp.DropsSemester != semester {
+				t.Errorf("Expected %d of 2 drops left in %s, got %d of %d in %q",
+					want, semester, resp.RemainingDrops, resp.MaxDropsPerSemester, resp.DropsSemester)
+			}
+		}
+		remaining(2)
+
+		// Dropping up to exactly the limit is allowed
+		for i, id := range []string{"DROP-1", "DROP-2"} {
+			resp, err := client.DropCourse(ctx, &pb_enroll.DropCourseRequest{StudentId: testStudentID, CourseId: id})
+			if err != nil || !resp.Success {
+				t.Fatalf("Drop %d of 2 failed: %v", i+1, err)
+			}
+			remaining(int32(1 - i))
+		}
+
+		// One more is refused and leaves the enrollment in place
+		_, err := client.DropCourse(ctx, &pb_enroll.DropCourseRequest{StudentId: testStudentID, CourseId: "DROP-3"})
+		if shared.ErrorCodeOf(err) != shared.ErrCodeDropLimitReached || status.Code(err) != codes.FailedPrecondition {
+			t.Fatalf("Expected DROP_LIMIT_REACHED past the limit, got %v", err)
+		}
+		if params := shared.ErrorParamsOf(err); params["semester"] != semester || params["max_drops"] != "2" {
+			t.Errorf("Expected semester and max_drops params, got %v", params)
+		}
+		var kept shared.Enrollment
+		db.Collection("enrollments").FindOne(ctx, map[string]interface{}{"_id": "ENR-DROP-3"}).Decode(&kept)
+		if kept.Status != shared.StatusEnrolled {
+			t.Errorf("Expected the refused drop to keep the enrollment, got %q", kept.Status)
+		}
+
+		// The limit is per semester
+		if resp, err := client.DropCourse(ctx, &pb_enroll.DropCourseRequest{StudentId: testStudentID, CourseId: "DROP-NEXT"}); err != nil || !resp.Success {
+			t.Errorf("Expected a drop in another semester to be allowed, got %v", err)
+		}
+
+		history, err := client.GetDropHistory(ctx, &pb_enroll.GetDropHistoryRequest{StudentId: testStudentID, Semester: semester})
+		if err != nil {
+			t.Fatalf("GetDropHistory failed: %v", err)
+		}
+		sources := map[string]string{}
+		for _, d := range history.Drops {
+			sources[d.CourseId] = d.Source
+			if d.Semester != semester || d.DroppedAt == nil {
+				t.Errorf("Expected %s with a drop time, got %+v", semester, d)
+			}
+		}
+		want := map[string]string{"DROP-1": shared.DropSourceSelf, "DROP-2": shared.DropSourceSelf, "DROP-FORCED": shared.DropReasonAdminForced}
+		if len(sources) != len(want) || len(history.Drops) != len(want) {
+			t.Fatalf("Expected drops %v, got %v", want, sources)
+		}
+		for id, source := range want {
+			if sources[id] != source {
+				t.Errorf("Expected %s to be a %s drop, got %q", id, source, sources[id])
+			}
+		}
+		if history.MaxDropsPerSemester != 2 {
+			t.Errorf("Expected max_drops_per_semester 2, got %d", history.MaxDropsPerSemester)
+		}
+	})
+}
+
+// TestSearchSchedules checks the ranking and the cap on the schedule search
+func TestSearchSchedules(t *testing.T) {
+	option := func(id, schedule string) sectionOption {
+		info := shared.NewScheduleInfo(schedule)
+		return sectionOption{
+			section: &pb_enroll.ScheduleSection{
+				CourseId:     id,
+				ScheduleInfo: &pb_enroll.ScheduleInfo{Days: info.Days, StartTime: info.StartTime, EndTime: info.EndTime},
+			},
+			slot: shared.NewScheduleSlot(schedule),
+		}
+	}
+
+	groups := [][]sectionOption{
+		{option("A1", "MWF 8:00-9:00"), option("A2", "MWF 11:00-12:00")},
+		{option("B1", "MWF 12:00-13:00"), option("B2", "MWF 11:30-12:30")},
+		{option("C1", "")}, // unscheduled: never conflicts, adds no gaps
+	}
+	schedules, capped := searchSchedules(groups, 5)
+	if capped {
+		t.Error("Expected a small search to finish")
+	}
+	var got []string
+	for _, picks := range schedules {
+		var ids []string
+		for _, p := range picks {
+			ids = append(ids, p.section.CourseId)
+		}
+		got = append(got, fmt.Sprintf("%s:%d", strings.Join(ids, "+"), gapMinutes(picks)))
+	}
+	// A2+B2 overlap; A2+B1 is back to back; A1 leaves 3h or 2.5h idle on three days
+	if want := "A2+B1+C1:0,A1+B2+C1:450,A1+B1+C1:540"; strings.Join(got, ",") != want {
+		t.Errorf("Expected %s, got %s", want, strings.Join(got, ","))
+	}
+
+	// Every combination of 8 courses x 10 sections fits, far more than the search may try
+	var wide [][]sectionOption
+	for i := 0; i < maxSuggestCourses; i++ {
+		var sections []sectionOption
+		for j := 0; j < maxSuggestSections; j++ {
+			sections = append(sections, option(fmt.Sprintf("C%d-%d", i, j), ""))
+		}
+		wide = append(wide, sections)
+	}
+	schedules, capped = searchSchedules(wide, 3)
+	if !capped || len(schedules) != 3 {
+		t.Errorf("Expected the capped search to stop with 3 schedules, got %d (capped %v)", len(schedules), capped)
+	}
 }
@@ -2,23 +2,33 @@ package handlers
 
 import (
 	"context"
-	"encoding/json"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"log"
+	"mime"
 	"net/http"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/go-chi/chi/v5"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	// Gateway utility package
 
 	"stdiscm_p4/backend/internal/gateway/util"
 	pb_admin "stdiscm_p4/backend/internal/pb/admin" // The Admin Service gRPC contract
 	pb_auth "stdiscm_p4/backend/internal/pb/auth"   // For context user role checks
+	pb_course "stdiscm_p4/backend/internal/pb/course"
+	"stdiscm_p4/backend/internal/shared"
 )
 
 // AdminHandler holds the gRPC client for the Admin Service.
+// CourseClient lists a course's prerequisites after the admin changes them.
 type AdminHandler struct {
-	AdminClient pb_admin.AdminServiceClient
+	AdminClient  pb_admin.AdminServiceClient
+	CourseClient pb_course.CourseServiceClient
 }
 
 // -- Request Structs (Mirroring JSON bodies in REST API Doc) --
@@ -33,6 +43,26 @@ type RESTCreateCourseRequest struct {
 	Capacity    int32  `json:"capacity"`
 	FacultyID   string `json:"faculty_id"`
 	Semester    string `json:"semester"`
+	Department  string `json:"department"`  // optional; parsed from the code when empty
+	Unscheduled bool   `json:"unscheduled"` // async/online; schedule must be empty
+
+	AllowedMajors []string `json:"allowed_majors"`
+	MinYearLevel  int32    `json:"min_year_level"`
+
+	// Optional per-course enrollment window (RFC 3339 or YYYY-MM-DD)
+	EnrollOpenAt  string `json:"enroll_open_at"`
+	EnrollCloseAt string `json:"enroll_close_at"`
+}
+
+// RESTImportCoursesRequest mirrors the JSON input for POST /admin/courses/import
+type RESTImportCoursesRequest struct {
+	Courses []RESTImportCourse `json:"courses"`
+}
+
+// RESTImportCourse is one course row; prerequisites are course codes
+type RESTImportCourse struct {
+	RESTCreateCourseRequest
+	Prerequisites []string `json:"prerequisites"`
 }
 
 type RESTUpdateCourseRequest struct {
@@ -44,12 +74,71 @@ type RESTUpdateCourseRequest struct {
 	Capacity    int32  `json:"capacity"`
 	FacultyID   string `json:"faculty_id"`
 	IsOpen      bool   `json:"is_open"`
+	Department  string `json:"department"`
+	Unscheduled bool   `json:"unscheduled"` // clears the schedule
+
+	// When present, replaces the course restrictions as a whole (empty values clear them)
+	Restrictions *RESTCourseRestrictions `json:"restrictions"`
+	// When present, replaces the course's enrollment window (empty values clear a bound)
+	EnrollWindow *RESTEnrollWindow `json:"enroll_window"`
+}
+
+// RESTCourseRestrictions limits who may enroll in a course
+type RESTCourseRestrictions struct {
+	AllowedMajors []string `json:"allowed_majors"`
+	MinYearLevel  int32    `json:"min_year_level"`
+}
+
+// RESTEnrollWindow bounds when a course accepts enrollment
+type RESTEnrollWindow struct {
+	OpenAt  string `json:"open_at"`
+	CloseAt string `json:"close_at"`
+}
+
+// adminCourseView is the admin counterpart of courseView: seat fields are always rendered
+type adminCourseView struct {
+	*pb_admin.Course
+	SeatsAvailable int32 `json:"seats_available"`
+	IsFull         bool  `json:"is_full"`
+	WaitlistCount  int32 `json:"waitlist_count"`
+}
+
+func toAdminCourseView(c *pb_admin.Course) *adminCourseView {
+	if c == nil {
+		return nil
+	}
+	return &adminCourseView{
+		Course:         c,
+		SeatsAvailable: c.SeatsAvailable,
+		IsFull:         c.IsFull,
+		WaitlistCount:  c.WaitlistCount,
+	}
 }
 
 type RESTAssignFacultyRequest struct {
 	FacultyID string `json:"faculty_id"`
 }
 
+type RESTAddPrerequisiteRequest struct {
+	PrereqID string `json:"prereq_id"`
+}
+
+type RESTValidateScheduleRequest struct {
+	Schedule  string `json:"schedule"`
+	Room      string `json:"room"`
+	FacultyID string `json:"faculty_id"`
+	Semester  string `json:"semester"`
+}
+
+type RESTCreateDepartmentRequest struct {
+	Code string `json:"code"`
+	Name string `json:"name"`
+}
+
+type RESTUpdateDepartmentRequest struct {
+	Name string `json:"name"`
+}
+
 type RESTCreateUserRequest struct {
 	Email      string `json:"email"`
 	Role       string `json:"role"`
@@ -65,9 +154,23 @@ type RESTToggleUserStatusRequest struct {
 	Activate bool `json:"activate"`
 }
 
+type RESTAddTransferCreditRequest struct {
+	StudentID         string `json:"student_id"`
+	CourseCode        string `json:"course_code"`
+	EquivalentUnits   int32  `json:"equivalent_units"`
+	SourceInstitution string `json:"source_institution"`
+}
+
+type RESTPlaceHoldRequest struct {
+	StudentID string `json:"student_id"`
+	Type      string `json:"type"`
+	Reason    string `json:"reason"`
+}
+
 type RESTSetEnrollmentPeriodRequest struct {
-	StartDate string `json:"start_date"`
-	EndDate   string `json:"end_date"`
+	StartDate    string `json:"start_date"`
+	EndDate      string `json:"end_date"`
+	AllowPastEnd bool   `json:"allow_past_end"`
 }
 
 type RESTToggleEnrollmentRequest struct {
@@ -81,10 +184,30 @@ type RESTOverrideEnrollmentRequest struct {
 	// Action is determined by the endpoint (/enroll or /drop)
 }
 
+type RESTRestoreEnrollmentRequest struct {
+	EnrollmentID string `json:"enrollment_id"`
+	Force        bool   `json:"force"`
+	Reason       string `json:"reason"`
+}
+
 type RESTUpdateSystemConfigRequest struct {
 	Value string `json:"value"`
 }
 
+type RESTCloseSemesterRequest struct {
+	Semester string `json:"semester"`
+}
+
+type RESTPublishAllGradesRequest struct {
+	Semester       string `json:"semester"`
+	Confirm        bool   `json:"confirm"`
+	NotifyStudents bool   `json:"notify_students"`
+}
+
+type RESTCancelCourseRequest struct {
+	Reason string `json:"reason"`
+}
+
 // -- Helpers --
 
 func getAdminFromContext(r *http.Request) (*pb_auth.User, bool) {
@@ -95,6 +218,92 @@ func getAdminFromContext(r *http.Request) (*pb_auth.User, bool) {
 	return user, user.Role == "admin"
 }
 
+// maxCourseImportBytes bounds the size of a course import upload
+const maxCourseImportBytes = 5 << 20
+
+// parseCourseCSV reads course rows from a CSV file whose first row names the columns
+func parseCourseCSV(r io.Reader) ([]RESTImportCourse, error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("CSV header row is required")
+	}
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	for _, required := range []string{"code", "title", "semester"} {
+		if _, ok := columns[required]; !ok {
+			return nil, fmt.Errorf("CSV is missing the %q column", required)
+		}
+	}
+
+	var courses []RESTImportCourse
+	for line := 2; ; line++ {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid CSV: %v", err)
+		}
+
+		field := func(name string) string {
+			if i, ok := columns[name]; ok && i < len(record) {
+				return strings.TrimSpace(record[i])
+			}
+			return ""
+		}
+		number := func(name string) (int32, error) {
+			v := field(name)
+			if v == "" {
+				return 0, nil
+			}
+			n, err := strconv.ParseInt(v, 10, 32)
+			if err != nil {
+				return 0, fmt.Errorf("line %d: %s must be a number", line, name)
+			}
+			return int32(n), nil
+		}
+
+		var c RESTImportCourse
+		c.Code, c.Title, c.Description = field("code"), field("title"), field("description")
+		c.Schedule, c.Room, c.FacultyID, c.Semester = field("schedule"), field("room"), field("faculty_id"), field("semester")
+		c.Department = field("department")
+		c.AllowedMajors = splitList(field("allowed_majors"))
+		c.Prerequisites = splitList(field("prerequisites"))
+		if c.Units, err = number("units"); err != nil {
+			return nil, err
+		}
+		if c.Capacity, err = number("capacity"); err != nil {
+			return nil, err
+		}
+		if c.MinYearLevel, err = number("min_year_level"); err != nil {
+			return nil, err
+		}
+		if v := field("unscheduled"); v != "" {
+			if c.Unscheduled, err = strconv.ParseBool(v); err != nil {
+				return nil, fmt.Errorf("line %d: unscheduled must be true or false", line)
+			}
+		}
+		courses = append(courses, c)
+	}
+	return courses, nil
+}
+
+// splitList splits a semicolon-separated CSV cell, dropping empty entries
+func splitList(cell string) []string {
+	var items []string
+	for _, item := range strings.Split(cell, ";") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
 // -- Handlers --
 
 // GetSystemStats handles GET /admin/stats
@@ -106,8 +315,7 @@ func (h *AdminHandler) GetSystemStats(w http.ResponseWriter, r *http.Request) {
 
 	grpcReq := &pb_admin.GetSystemStatsRequest{}
 
-	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
-	defer cancel()
+	ctx := r.Context()
 
 	grpcResp, err := h.AdminClient.GetSystemStats(ctx, grpcReq)
 	if err != nil {
@@ -115,23 +323,38 @@ func (h *AdminHandler) GetSystemStats(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// Rendered through shared.SystemStats so closed enrollment and zero counts are not omitted
+	st := grpcResp.GetStats()
+	stats := shared.SystemStats{
+		TotalStudents:    st.GetTotalStudents(),
+		TotalFaculty:     st.GetTotalFaculty(),
+		TotalCourses:     st.GetTotalCourses(),
+		OpenCourses:      st.GetOpenCourses(),
+		TotalEnrollments: st.GetTotalEnrollments(),
+		EnrollmentOpen:   st.GetEnrollmentOpen(),
+		CurrentSemester:  st.GetCurrentSemester(),
+		EnrollmentsToday: st.GetEnrollmentsToday(),
+		DropsToday:       st.GetDropsToday(),
+		AverageFillRate:  st.GetAverageFillRate(),
+	}
+
 	// FIX: Added "success": true to prevent utility wrapper from nesting "stats" under "data"
 	util.WriteJSON(w, http.StatusOK, map[string]interface{}{
 		"success": true,
-		"stats":   grpcResp.Stats,
+		"stats":   stats,
 	})
 }
 
 // CreateCourse handles POST /admin/courses
 func (h *AdminHandler) CreateCourse(w http.ResponseWriter, r *http.Request) {
-	if _, isAdmin := getAdminFromContext(r); !isAdmin {
+	adminUser, isAdmin := getAdminFromContext(r)
+	if !isAdmin {
 		util.WriteJSONError(w, http.StatusForbidden, "Access denied: Admin only")
 		return
 	}
 
 	var reqBody RESTCreateCourseRequest
-	if err := json.NewDecoder(r.Body).Decode(&reqBody); err != nil {
-		util.WriteJSONError(w, http.StatusBadRequest, "Invalid request body")
+	if !util.DecodeJSON(w, r, &reqBody) {
 		return
 	}
 
@@ -145,10 +368,17 @@ func (h *AdminHandler) CreateCourse(w http.ResponseWriter, r *http.Request) {
 		Capacity:    reqBody.Capacity,
 		FacultyId:   reqBody.FacultyID,
 		Semester:    reqBody.Semester,
+		Department:  reqBody.Department,
+		Unscheduled: reqBody.Unscheduled,
+
+		AllowedMajors: reqBody.AllowedMajors,
+		MinYearLevel:  reqBody.MinYearLevel,
+		EnrollOpenAt:  reqBody.EnrollOpenAt,
+		EnrollCloseAt: reqBody.EnrollCloseAt,
+		AdminId:       adminUser.Id,
 	}
 
-	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
-	defer cancel()
+	ctx := r.Context()
 
 	grpcResp, err := h.AdminClient.CreateCourse(ctx, grpcReq)
 	if err != nil {
@@ -165,14 +395,88 @@ func (h *AdminHandler) CreateCourse(w http.ResponseWriter, r *http.Request) {
 	util.WriteJSON(w, http.StatusCreated, map[string]interface{}{
 		"success":   grpcResp.Success,
 		"course_id": grpcResp.CourseId,
-		"course":    grpcResp.Course,
+		"course":    toAdminCourseView(grpcResp.Course),
 		"message":   grpcResp.Message,
 	})
 }
 
+// ImportCourses handles POST /admin/courses/import
+// Accepts a JSON body ({"courses": [...]}) or, with Content-Type text/csv, a CSV
+// file with a header row. List columns (allowed_majors, prerequisites) are
+// separated by semicolons; a missing department column means parse it from the code.
+func (h *AdminHandler) ImportCourses(w http.ResponseWriter, r *http.Request) {
+	adminUser, isAdmin := getAdminFromContext(r)
+	if !isAdmin {
+		util.WriteJSONError(w, http.StatusForbidden, "Access denied: Admin only")
+		return
+	}
+
+	var courses []RESTImportCourse
+	if mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type")); mediaType == "text/csv" {
+		parsed, err := parseCourseCSV(http.MaxBytesReader(w, r.Body, maxCourseImportBytes))
+		if err != nil {
+			util.WriteJSONError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		courses = parsed
+	} else {
+		var reqBody RESTImportCoursesRequest
+		if !util.DecodeJSONLimit(w, r, &reqBody, maxCourseImportBytes) {
+			return
+		}
+		courses = reqBody.Courses
+	}
+
+	if len(courses) == 0 {
+		util.WriteJSONError(w, http.StatusBadRequest, "No courses provided")
+		return
+	}
+
+	grpcReq := &pb_admin.BulkCreateCoursesRequest{AdminId: adminUser.Id}
+	for _, c := range courses {
+		grpcReq.Courses = append(grpcReq.Courses, &pb_admin.CourseDefinition{
+			Code:        c.Code,
+			Title:       c.Title,
+			Description: c.Description,
+			Units:       c.Units,
+			Schedule:    c.Schedule,
+			Room:        c.Room,
+			Capacity:    c.Capacity,
+			FacultyId:   c.FacultyID,
+			Semester:    c.Semester,
+			Department:  c.Department,
+			Unscheduled: c.Unscheduled,
+
+			AllowedMajors:     c.AllowedMajors,
+			MinYearLevel:      c.MinYearLevel,
+			PrerequisiteCodes: c.Prerequisites,
+			EnrollOpenAt:      c.EnrollOpenAt,
+			EnrollCloseAt:     c.EnrollCloseAt,
+		})
+	}
+
+	ctx := r.Context()
+
+	grpcResp, err := h.AdminClient.BulkCreateCourses(ctx, grpcReq)
+	if err != nil {
+		util.HandleGRPCError(w, err)
+		return
+	}
+
+	// Per-row failures are reported in results; the import itself succeeded
+	util.WriteJSON(w, http.StatusOK, map[string]interface{}{
+		"success":       grpcResp.Success,
+		"message":       grpcResp.Message,
+		"created_count": grpcResp.CreatedCount,
+		"failed_count":  grpcResp.FailedCount,
+		"results":       grpcResp.Results,
+	})
+}
+
 // UpdateCourse handles PUT /admin/courses/:id
 func (h *AdminHandler) UpdateCourse(w http.ResponseWriter, r *http.Request) {
-	if _, isAdmin := getAdminFromContext(r); !isAdmin {
+	adminUser, isAdmin := getAdminFromContext(r)
+	if !isAdmin {
 		util.WriteJSONError(w, http.StatusForbidden, "Access denied: Admin only")
 		return
 	}
@@ -184,8 +488,7 @@ func (h *AdminHandler) UpdateCourse(w http.ResponseWriter, r *http.Request) {
 	}
 
 	var reqBody RESTUpdateCourseRequest
-	if err := json.NewDecoder(r.Body).Decode(&reqBody); err != nil {
-		util.WriteJSONError(w, http.StatusBadRequest, "Invalid request body")
+	if !util.DecodeJSON(w, r, &reqBody) {
 		return
 	}
 
@@ -199,10 +502,22 @@ func (h *AdminHandler) UpdateCourse(w http.ResponseWriter, r *http.Request) {
 		Capacity:    reqBody.Capacity,
 		FacultyId:   reqBody.FacultyID,
 		IsOpen:      reqBody.IsOpen,
+		Department:  reqBody.Department,
+		Unscheduled: reqBody.Unscheduled,
+		AdminId:     adminUser.Id,
+	}
+	if reqBody.Restrictions != nil {
+		grpcReq.UpdateRestrictions = true
+		grpcReq.AllowedMajors = reqBody.Restrictions.AllowedMajors
+		grpcReq.MinYearLevel = reqBody.Restrictions.MinYearLevel
+	}
+	if reqBody.EnrollWindow != nil {
+		grpcReq.UpdateEnrollWindow = true
+		grpcReq.EnrollOpenAt = reqBody.EnrollWindow.OpenAt
+		grpcReq.EnrollCloseAt = reqBody.EnrollWindow.CloseAt
 	}
 
-	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
-	defer cancel()
+	ctx := r.Context()
 
 	grpcResp, err := h.AdminClient.UpdateCourse(ctx, grpcReq)
 	if err != nil {
@@ -210,19 +525,15 @@ func (h *AdminHandler) UpdateCourse(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// FIX: Check business logic success
+	// Missing courses arrive as NotFound errors; this is a rejected change
 	if !grpcResp.Success {
-		code := http.StatusBadRequest
-		if grpcResp.Message == "course not found" {
-			code = http.StatusNotFound
-		}
-		util.WriteJSONError(w, code, grpcResp.Message)
+		util.WriteJSONError(w, http.StatusBadRequest, grpcResp.Message)
 		return
 	}
 
 	util.WriteJSON(w, http.StatusOK, map[string]interface{}{
 		"success": grpcResp.Success,
-		"course":  grpcResp.Course,
+		"course":  toAdminCourseView(grpcResp.Course),
 		"message": grpcResp.Message,
 	})
 }
@@ -240,8 +551,7 @@ func (h *AdminHandler) DeleteCourse(w http.ResponseWriter, r *http.Request) {
 		CourseId: courseID,
 	}
 
-	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
-	defer cancel()
+	ctx := r.Context()
 
 	grpcResp, err := h.AdminClient.DeleteCourse(ctx, grpcReq)
 	if err != nil {
@@ -249,13 +559,9 @@ func (h *AdminHandler) DeleteCourse(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// FIX: Check business logic success
+	// Missing courses arrive as NotFound errors; this is a rejected change
 	if !grpcResp.Success {
-		code := http.StatusBadRequest
-		if grpcResp.Message == "course not found" {
-			code = http.StatusNotFound
-		}
-		util.WriteJSONError(w, code, grpcResp.Message)
+		util.WriteJSONError(w, http.StatusBadRequest, grpcResp.Message)
 		return
 	}
 
@@ -265,6 +571,37 @@ func (h *AdminHandler) DeleteCourse(w http.ResponseWriter, r *http.Request) {
 	})
 }
 
+// CancelCourse handles POST /admin/courses/{id}/cancel
+func (h *AdminHandler) CancelCourse(w http.ResponseWriter, r *http.Request) {
+	adminUser, isAdmin := getAdminFromContext(r)
+	if !isAdmin {
+		util.WriteJSONError(w, http.StatusForbidden, "Access denied: Admin only")
+		return
+	}
+
+	var reqBody RESTCancelCourseRequest
+	if !util.DecodeJSON(w, r, &reqBody) {
+		return
+	}
+
+	grpcResp, err := h.AdminClient.CancelCourse(r.Context(), &pb_admin.CancelCourseRequest{
+		CourseId: chi.URLParam(r, "id"),
+		AdminId:  adminUser.Id,
+		Reason:   reqBody.Reason,
+	})
+	if err != nil {
+		util.HandleGRPCError(w, err)
+		return
+	}
+
+	util.WriteJSON(w, http.StatusOK, map[string]interface{}{
+		"success":           grpcResp.Success,
+		"message":           grpcResp.Message,
+		"students_affected": grpcResp.StudentsAffected,
+		"carts_updated":     grpcResp.CartsUpdated,
+	})
+}
+
 // AssignFaculty handles POST /admin/courses/:id/assign-faculty
 func (h *AdminHandler) AssignFaculty(w http.ResponseWriter, r *http.Request) {
 	if _, isAdmin := getAdminFromContext(r); !isAdmin {
@@ -274,8 +611,7 @@ func (h *AdminHandler) AssignFaculty(w http.ResponseWriter, r *http.Request) {
 
 	courseID := chi.URLParam(r, "id")
 	var reqBody RESTAssignFacultyRequest
-	if err := json.NewDecoder(r.Body).Decode(&reqBody); err != nil {
-		util.WriteJSONError(w, http.StatusBadRequest, "Invalid request body")
+	if !util.DecodeJSON(w, r, &reqBody) {
 		return
 	}
 
@@ -284,8 +620,7 @@ func (h *AdminHandler) AssignFaculty(w http.ResponseWriter, r *http.Request) {
 		FacultyId: reqBody.FacultyID,
 	}
 
-	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
-	defer cancel()
+	ctx := r.Context()
 
 	grpcResp, err := h.AdminClient.AssignFaculty(ctx, grpcReq)
 	if err != nil {
@@ -305,235 +640,613 @@ func (h *AdminHandler) AssignFaculty(w http.ResponseWriter, r *http.Request) {
 	})
 }
 
-// CreateUser handles POST /admin/users
-func (h *AdminHandler) CreateUser(w http.ResponseWriter, r *http.Request) {
-	if _, isAdmin := getAdminFromContext(r); !isAdmin {
+// AddPrerequisite handles POST /admin/courses/{id}/prerequisites
+func (h *AdminHandler) AddPrerequisite(w http.ResponseWriter, r *http.Request) {
+	adminUser, isAdmin := getAdminFromContext(r)
+	if !isAdmin {
 		util.WriteJSONError(w, http.StatusForbidden, "Access denied: Admin only")
 		return
 	}
 
-	var reqBody RESTCreateUserRequest
-	if err := json.NewDecoder(r.Body).Decode(&reqBody); err != nil {
-		util.WriteJSONError(w, http.StatusBadRequest, "Invalid request body")
+	var reqBody RESTAddPrerequisiteRequest
+	if !util.DecodeJSON(w, r, &reqBody) {
 		return
 	}
 
-	grpcReq := &pb_admin.CreateUserRequest{
-		Email:      reqBody.Email,
-		Role:       reqBody.Role,
-		Name:       reqBody.Name,
-		StudentId:  reqBody.StudentID,
-		FacultyId:  reqBody.FacultyID,
-		Department: reqBody.Department,
-		Major:      reqBody.Major,
-		YearLevel:  reqBody.YearLevel,
+	grpcReq := &pb_admin.AddPrerequisiteRequest{
+		CourseId: chi.URLParam(r, "id"),
+		PrereqId: reqBody.PrereqID,
+		AdminId:  adminUser.Id,
 	}
 
-	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
-	defer cancel()
+	ctx := r.Context()
 
-	grpcResp, err := h.AdminClient.CreateUser(ctx, grpcReq)
+	grpcResp, err := h.AdminClient.AddPrerequisite(ctx, grpcReq)
 	if err != nil {
 		util.HandleGRPCError(w, err)
 		return
 	}
 
-	// FIX: Check business logic success
-	if !grpcResp.Success {
-		util.WriteJSONError(w, http.StatusBadRequest, grpcResp.Message)
-		return
+	response := map[string]interface{}{
+		"success": grpcResp.Success,
+		"message": grpcResp.Message,
 	}
-
-	util.WriteJSON(w, http.StatusCreated, map[string]interface{}{
-		"success":          grpcResp.Success,
-		"user_id":          grpcResp.UserId,
-		"initial_password": grpcResp.InitialPassword,
-		"message":          grpcResp.Message,
-		"user":             grpcResp.User,
-	})
+	h.addPrerequisites(ctx, response, grpcReq.CourseId)
+	util.WriteJSON(w, http.StatusOK, response)
 }
 
-// ListUsers handles GET /admin/users
-func (h *AdminHandler) ListUsers(w http.ResponseWriter, r *http.Request) {
-	if _, isAdmin := getAdminFromContext(r); !isAdmin {
+// RemovePrerequisite handles DELETE /admin/courses/{id}/prerequisites/{prereq_id}
+func (h *AdminHandler) RemovePrerequisite(w http.ResponseWriter, r *http.Request) {
+	adminUser, isAdmin := getAdminFromContext(r)
+	if !isAdmin {
 		util.WriteJSONError(w, http.StatusForbidden, "Access denied: Admin only")
 		return
 	}
 
-	role := r.URL.Query().Get("role")
-	activeOnlyStr := r.URL.Query().Get("active_only")
-	activeOnly := false
-	if activeOnlyStr != "" {
-		if v, err := strconv.ParseBool(activeOnlyStr); err == nil {
-			activeOnly = v
-		}
-	}
-
-	grpcReq := &pb_admin.ListUsersRequest{
-		Role:       role,
-		ActiveOnly: activeOnly,
+	grpcReq := &pb_admin.RemovePrerequisiteRequest{
+		CourseId: chi.URLParam(r, "id"),
+		PrereqId: chi.URLParam(r, "prereq_id"),
+		AdminId:  adminUser.Id,
 	}
 
-	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
-	defer cancel()
+	ctx := r.Context()
 
-	grpcResp, err := h.AdminClient.ListUsers(ctx, grpcReq)
+	grpcResp, err := h.AdminClient.RemovePrerequisite(ctx, grpcReq)
 	if err != nil {
 		util.HandleGRPCError(w, err)
 		return
 	}
 
-	util.WriteJSON(w, http.StatusOK, map[string]interface{}{
-		"users":       grpcResp.Users,
-		"total_count": grpcResp.TotalCount,
-	})
+	response := map[string]interface{}{
+		"success": grpcResp.Success,
+		"message": grpcResp.Message,
+	}
+	h.addPrerequisites(ctx, response, grpcReq.CourseId)
+	util.WriteJSON(w, http.StatusOK, response)
 }
 
-// ResetPassword handles POST /admin/users/:id/reset-password
-func (h *AdminHandler) ResetPassword(w http.ResponseWriter, r *http.Request) {
+// addPrerequisites sets the course's prerequisites after a change, as listed by the
+// Course Service. The change is already saved, so a failed lookup only leaves them out.
+func (h *AdminHandler) addPrerequisites(ctx context.Context, response map[string]interface{}, courseID string) {
+	prereqResp, err := h.CourseClient.GetPrerequisites(ctx, &pb_course.GetPrerequisitesRequest{CourseId: courseID})
+	if err != nil {
+		log.Printf("Warning: could not list prerequisites of %s: %v", courseID, err)
+		return
+	}
+	response["prerequisites"] = prereqResp.Prerequisites
+}
+
+// ValidateCourseSchedule handles POST /admin/courses/validate-schedule
+func (h *AdminHandler) ValidateCourseSchedule(w http.ResponseWriter, r *http.Request) {
 	if _, isAdmin := getAdminFromContext(r); !isAdmin {
 		util.WriteJSONError(w, http.StatusForbidden, "Access denied: Admin only")
 		return
 	}
 
-	userID := chi.URLParam(r, "id")
+	var reqBody RESTValidateScheduleRequest
+	if !util.DecodeJSON(w, r, &reqBody) {
+		return
+	}
 
-	grpcReq := &pb_admin.ResetPasswordRequest{
-		UserId: userID,
+	grpcReq := &pb_admin.ValidateCourseScheduleRequest{
+		Schedule:  reqBody.Schedule,
+		Room:      reqBody.Room,
+		FacultyId: reqBody.FacultyID,
+		Semester:  reqBody.Semester,
 	}
 
-	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
-	defer cancel()
+	ctx := r.Context()
 
-	grpcResp, err := h.AdminClient.ResetPassword(ctx, grpcReq)
+	grpcResp, err := h.AdminClient.ValidateCourseSchedule(ctx, grpcReq)
 	if err != nil {
 		util.HandleGRPCError(w, err)
 		return
 	}
 
-	if !grpcResp.Success {
-		util.WriteJSONError(w, http.StatusNotFound, grpcResp.Message)
-		return
+	conflicts := grpcResp.Conflicts
+	if conflicts == nil {
+		conflicts = []*pb_admin.ScheduleConflict{}
 	}
 
+	// A schedule that fails validation is still a successful preview
 	util.WriteJSON(w, http.StatusOK, map[string]interface{}{
-		"success":      grpcResp.Success,
-		"new_password": grpcResp.NewPassword,
-		"message":      grpcResp.Message,
+		"success":     true,
+		"valid":       grpcResp.Valid,
+		"parse_error": grpcResp.ParseError,
+		"days":        grpcResp.Days,
+		"start_time":  grpcResp.StartTime,
+		"end_time":    grpcResp.EndTime,
+		"conflicts":   conflicts,
+		"message":     grpcResp.Message,
 	})
 }
 
-// ToggleUserStatus handles PATCH /admin/users/:id/status
-func (h *AdminHandler) ToggleUserStatus(w http.ResponseWriter, r *http.Request) {
-	if _, isAdmin := getAdminFromContext(r); !isAdmin {
+// CreateDepartment handles POST /admin/departments
+func (h *AdminHandler) CreateDepartment(w http.ResponseWriter, r *http.Request) {
+	adminUser, isAdmin := getAdminFromContext(r)
+	if !isAdmin {
 		util.WriteJSONError(w, http.StatusForbidden, "Access denied: Admin only")
 		return
 	}
 
-	userID := chi.URLParam(r, "id")
-	var reqBody RESTToggleUserStatusRequest
-	if err := json.NewDecoder(r.Body).Decode(&reqBody); err != nil {
-		util.WriteJSONError(w, http.StatusBadRequest, "Invalid request body")
+	var reqBody RESTCreateDepartmentRequest
+	if !util.DecodeJSON(w, r, &reqBody) {
 		return
 	}
 
-	grpcReq := &pb_admin.ToggleUserStatusRequest{
-		UserId:   userID,
-		Activate: reqBody.Activate,
+	grpcReq := &pb_admin.CreateDepartmentRequest{
+		Code:    reqBody.Code,
+		Name:    reqBody.Name,
+		AdminId: adminUser.Id,
 	}
 
-	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
-	defer cancel()
+	ctx := r.Context()
 
-	grpcResp, err := h.AdminClient.ToggleUserStatus(ctx, grpcReq)
+	grpcResp, err := h.AdminClient.CreateDepartment(ctx, grpcReq)
 	if err != nil {
 		util.HandleGRPCError(w, err)
 		return
 	}
-
 	if !grpcResp.Success {
 		util.WriteJSONError(w, http.StatusBadRequest, grpcResp.Message)
 		return
 	}
 
-	util.WriteJSON(w, http.StatusOK, map[string]interface{}{
-		"success": grpcResp.Success,
-		"message": grpcResp.Message,
+	util.WriteJSON(w, http.StatusCreated, map[string]interface{}{
+		"success":    grpcResp.Success,
+		"message":    grpcResp.Message,
+		"department": grpcResp.Department,
 	})
 }
 
-// SetEnrollmentPeriod handles POST /admin/enrollment/period
-func (h *AdminHandler) SetEnrollmentPeriod(w http.ResponseWriter, r *http.Request) {
-	if _, isAdmin := getAdminFromContext(r); !isAdmin {
+// UpdateDepartment handles PUT /admin/departments/{code}
+func (h *AdminHandler) UpdateDepartment(w http.ResponseWriter, r *http.Request) {
+	adminUser, isAdmin := getAdminFromContext(r)
+	if !isAdmin {
 		util.WriteJSONError(w, http.StatusForbidden, "Access denied: Admin only")
 		return
 	}
 
-	var reqBody RESTSetEnrollmentPeriodRequest
-	if err := json.NewDecoder(r.Body).Decode(&reqBody); err != nil {
-		util.WriteJSONError(w, http.StatusBadRequest, "Invalid request body")
+	var reqBody RESTUpdateDepartmentRequest
+	if !util.DecodeJSON(w, r, &reqBody) {
 		return
 	}
 
-	grpcReq := &pb_admin.SetEnrollmentPeriodRequest{
-		StartDate: reqBody.StartDate,
-		EndDate:   reqBody.EndDate,
+	grpcReq := &pb_admin.UpdateDepartmentRequest{
+		Code:    chi.URLParam(r, "code"),
+		Name:    reqBody.Name,
+		AdminId: adminUser.Id,
 	}
 
-	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
-	defer cancel()
+	ctx := r.Context()
 
-	grpcResp, err := h.AdminClient.SetEnrollmentPeriod(ctx, grpcReq)
+	grpcResp, err := h.AdminClient.UpdateDepartment(ctx, grpcReq)
 	if err != nil {
 		util.HandleGRPCError(w, err)
 		return
 	}
+	if !grpcResp.Success {
+		util.WriteJSONError(w, http.StatusBadRequest, grpcResp.Message)
+		return
+	}
 
 	util.WriteJSON(w, http.StatusOK, map[string]interface{}{
-		"success": grpcResp.Success,
-		"message": grpcResp.Message,
+		"success":         grpcResp.Success,
+		"message":         grpcResp.Message,
+		"department":      grpcResp.Department,
+		"faculty_updated": grpcResp.FacultyUpdated,
 	})
 }
 
-// ToggleEnrollment handles POST /admin/enrollment/toggle
-func (h *AdminHandler) ToggleEnrollment(w http.ResponseWriter, r *http.Request) {
-	if _, isAdmin := getAdminFromContext(r); !isAdmin {
+// DeleteDepartment handles DELETE /admin/departments/{code}
+func (h *AdminHandler) DeleteDepartment(w http.ResponseWriter, r *http.Request) {
+	adminUser, isAdmin := getAdminFromContext(r)
+	if !isAdmin {
 		util.WriteJSONError(w, http.StatusForbidden, "Access denied: Admin only")
 		return
 	}
 
-	var reqBody RESTToggleEnrollmentRequest
-	if err := json.NewDecoder(r.Body).Decode(&reqBody); err != nil {
-		util.WriteJSONError(w, http.StatusBadRequest, "Invalid request body")
-		return
-	}
-
-	grpcReq := &pb_admin.ToggleEnrollmentRequest{
-		Enable: reqBody.Enable,
+	grpcReq := &pb_admin.DeleteDepartmentRequest{
+		Code:    chi.URLParam(r, "code"),
+		AdminId: adminUser.Id,
 	}
 
-	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
-	defer cancel()
+	ctx := r.Context()
 
-	grpcResp, err := h.AdminClient.ToggleEnrollment(ctx, grpcReq)
+	grpcResp, err := h.AdminClient.DeleteDepartment(ctx, grpcReq)
 	if err != nil {
 		util.HandleGRPCError(w, err)
 		return
 	}
 
 	util.WriteJSON(w, http.StatusOK, map[string]interface{}{
-		"success":         grpcResp.Success,
-		"enrollment_open": grpcResp.EnrollmentOpen,
-		"message":         grpcResp.Message,
+		"success": grpcResp.Success,
+		"message": grpcResp.Message,
 	})
 }
 
-// OverrideEnrollment handles POST /admin/override/enroll and /admin/override/drop
-func (h *AdminHandler) OverrideEnroll(w http.ResponseWriter, r *http.Request) {
-	h.handleOverride(w, r, "force_enroll")
-}
-
-func (h *AdminHandler) OverrideDrop(w http.ResponseWriter, r *http.Request) {
+// CreateUser handles POST /admin/users
+func (h *AdminHandler) CreateUser(w http.ResponseWriter, r *http.Request) {
+	adminUser, isAdmin := getAdminFromContext(r)
+	if !isAdmin {
+		util.WriteJSONError(w, http.StatusForbidden, "Access denied: Admin only")
+		return
+	}
+
+	var reqBody RESTCreateUserRequest
+	if !util.DecodeJSON(w, r, &reqBody) {
+		return
+	}
+
+	grpcReq := &pb_admin.CreateUserRequest{
+		Email:      reqBody.Email,
+		Role:       reqBody.Role,
+		Name:       reqBody.Name,
+		StudentId:  reqBody.StudentID,
+		FacultyId:  reqBody.FacultyID,
+		Department: reqBody.Department,
+		Major:      reqBody.Major,
+		YearLevel:  reqBody.YearLevel,
+		AdminId:    adminUser.Id,
+	}
+
+	ctx := r.Context()
+
+	grpcResp, err := h.AdminClient.CreateUser(ctx, grpcReq)
+	if err != nil {
+		util.HandleGRPCError(w, err)
+		return
+	}
+
+	// FIX: Check business logic success
+	if !grpcResp.Success {
+		util.WriteJSONError(w, http.StatusBadRequest, grpcResp.Message)
+		return
+	}
+
+	util.WriteJSON(w, http.StatusCreated, map[string]interface{}{
+		"success":          grpcResp.Success,
+		"user_id":          grpcResp.UserId,
+		"initial_password": grpcResp.InitialPassword,
+		"message":          grpcResp.Message,
+		"user":             grpcResp.User,
+	})
+}
+
+// ListUsers handles GET /admin/users
+func (h *AdminHandler) ListUsers(w http.ResponseWriter, r *http.Request) {
+	if _, isAdmin := getAdminFromContext(r); !isAdmin {
+		util.WriteJSONError(w, http.StatusForbidden, "Access denied: Admin only")
+		return
+	}
+
+	role := r.URL.Query().Get("role")
+	activeOnlyStr := r.URL.Query().Get("active_only")
+	activeOnly := false
+	if activeOnlyStr != "" {
+		if v, err := strconv.ParseBool(activeOnlyStr); err == nil {
+			activeOnly = v
+		}
+	}
+
+	grpcReq := &pb_admin.ListUsersRequest{
+		Role:       role,
+		ActiveOnly: activeOnly,
+	}
+
+	ctx := r.Context()
+
+	grpcResp, err := h.AdminClient.ListUsers(ctx, grpcReq)
+	if err != nil {
+		util.HandleGRPCError(w, err)
+		return
+	}
+
+	util.WriteJSON(w, http.StatusOK, map[string]interface{}{
+		"users":       grpcResp.Users,
+		"total_count": grpcResp.TotalCount,
+	})
+}
+
+// GetUserDetail handles GET /admin/users/:id
+func (h *AdminHandler) GetUserDetail(w http.ResponseWriter, r *http.Request) {
+	if _, isAdmin := getAdminFromContext(r); !isAdmin {
+		util.WriteJSONError(w, http.StatusForbidden, "Access denied: Admin only")
+		return
+	}
+
+	grpcResp, err := h.AdminClient.GetUserDetail(r.Context(), &pb_admin.GetUserDetailRequest{
+		UserId: chi.URLParam(r, "id"),
+	})
+	if err != nil {
+		util.HandleGRPCError(w, err)
+		return
+	}
+
+	util.WriteJSON(w, http.StatusOK, map[string]interface{}{
+		"success":          grpcResp.Success,
+		"message":          grpcResp.Message,
+		"user":             grpcResp.User,
+		"semester":         grpcResp.Semester,
+		"active_sessions":  grpcResp.ActiveSessions,
+		"enrollments":      grpcResp.Enrollments,
+		"cgpa":             grpcResp.Cgpa,
+		"assigned_courses": grpcResp.AssignedCourses,
+	})
+}
+
+// ResetPassword handles POST /admin/users/:id/reset-password
+func (h *AdminHandler) ResetPassword(w http.ResponseWriter, r *http.Request) {
+	if _, isAdmin := getAdminFromContext(r); !isAdmin {
+		util.WriteJSONError(w, http.StatusForbidden, "Access denied: Admin only")
+		return
+	}
+
+	userID := chi.URLParam(r, "id")
+
+	grpcReq := &pb_admin.ResetPasswordRequest{
+		UserId: userID,
+	}
+
+	ctx := r.Context()
+
+	grpcResp, err := h.AdminClient.ResetPassword(ctx, grpcReq)
+	if err != nil {
+		util.HandleGRPCError(w, err)
+		return
+	}
+
+	if !grpcResp.Success {
+		util.WriteJSONError(w, http.StatusBadRequest, grpcResp.Message)
+		return
+	}
+
+	util.WriteJSON(w, http.StatusOK, map[string]interface{}{
+		"success":      grpcResp.Success,
+		"new_password": grpcResp.NewPassword,
+		"message":      grpcResp.Message,
+	})
+}
+
+// ToggleUserStatus handles PATCH /admin/users/:id/status
+func (h *AdminHandler) ToggleUserStatus(w http.ResponseWriter, r *http.Request) {
+	if _, isAdmin := getAdminFromContext(r); !isAdmin {
+		util.WriteJSONError(w, http.StatusForbidden, "Access denied: Admin only")
+		return
+	}
+
+	userID := chi.URLParam(r, "id")
+	var reqBody RESTToggleUserStatusRequest
+	if !util.DecodeJSON(w, r, &reqBody) {
+		return
+	}
+
+	grpcReq := &pb_admin.ToggleUserStatusRequest{
+		UserId:   userID,
+		Activate: reqBody.Activate,
+	}
+
+	ctx := r.Context()
+
+	grpcResp, err := h.AdminClient.ToggleUserStatus(ctx, grpcReq)
+	if err != nil {
+		util.HandleGRPCError(w, err)
+		return
+	}
+
+	if !grpcResp.Success {
+		util.WriteJSONError(w, http.StatusBadRequest, grpcResp.Message)
+		return
+	}
+
+	util.WriteJSON(w, http.StatusOK, map[string]interface{}{
+		"success": grpcResp.Success,
+		"message": grpcResp.Message,
+	})
+}
+
+// AddTransferCredit handles POST /admin/transfer-credits
+func (h *AdminHandler) AddTransferCredit(w http.ResponseWriter, r *http.Request) {
+	adminUser, isAdmin := getAdminFromContext(r)
+	if !isAdmin {
+		util.WriteJSONError(w, http.StatusForbidden, "Access denied: Admin only")
+		return
+	}
+
+	var reqBody RESTAddTransferCreditRequest
+	if !util.DecodeJSON(w, r, &reqBody) {
+		return
+	}
+
+	grpcReq := &pb_admin.AddTransferCreditRequest{
+		StudentId:         reqBody.StudentID,
+		CourseCode:        reqBody.CourseCode,
+		EquivalentUnits:   reqBody.EquivalentUnits,
+		SourceInstitution: reqBody.SourceInstitution,
+		AdminId:           adminUser.Id,
+	}
+
+	ctx := r.Context()
+
+	grpcResp, err := h.AdminClient.AddTransferCredit(ctx, grpcReq)
+	if err != nil {
+		util.HandleGRPCError(w, err)
+		return
+	}
+
+	util.WriteJSON(w, http.StatusCreated, map[string]interface{}{
+		"success":         grpcResp.Success,
+		"message":         grpcResp.Message,
+		"transfer_credit": grpcResp.TransferCredit,
+	})
+}
+
+// PlaceHold handles POST /admin/holds
+func (h *AdminHandler) PlaceHold(w http.ResponseWriter, r *http.Request) {
+	adminUser, isAdmin := getAdminFromContext(r)
+	if !isAdmin {
+		util.WriteJSONError(w, http.StatusForbidden, "Access denied: Admin only")
+		return
+	}
+
+	var reqBody RESTPlaceHoldRequest
+	if !util.DecodeJSON(w, r, &reqBody) {
+		return
+	}
+
+	grpcReq := &pb_admin.PlaceHoldRequest{
+		StudentId: reqBody.StudentID,
+		Type:      reqBody.Type,
+		Reason:    reqBody.Reason,
+		AdminId:   adminUser.Id,
+	}
+
+	ctx := r.Context()
+
+	grpcResp, err := h.AdminClient.PlaceHold(ctx, grpcReq)
+	if err != nil {
+		util.HandleGRPCError(w, err)
+		return
+	}
+
+	util.WriteJSON(w, http.StatusCreated, map[string]interface{}{
+		"success": grpcResp.Success,
+		"message": grpcResp.Message,
+		"hold":    grpcResp.Hold,
+	})
+}
+
+// ClearHold handles POST /admin/holds/{id}/clear
+func (h *AdminHandler) ClearHold(w http.ResponseWriter, r *http.Request) {
+	adminUser, isAdmin := getAdminFromContext(r)
+	if !isAdmin {
+		util.WriteJSONError(w, http.StatusForbidden, "Access denied: Admin only")
+		return
+	}
+
+	grpcReq := &pb_admin.ClearHoldRequest{
+		HoldId:  chi.URLParam(r, "id"),
+		AdminId: adminUser.Id,
+	}
+
+	ctx := r.Context()
+
+	grpcResp, err := h.AdminClient.ClearHold(ctx, grpcReq)
+	if err != nil {
+		util.HandleGRPCError(w, err)
+		return
+	}
+
+	util.WriteJSON(w, http.StatusOK, map[string]interface{}{
+		"success": grpcResp.Success,
+		"message": grpcResp.Message,
+		"hold":    grpcResp.Hold,
+	})
+}
+
+// GetStudentHolds handles GET /admin/users/{id}/holds?include_cleared=true
+func (h *AdminHandler) GetStudentHolds(w http.ResponseWriter, r *http.Request) {
+	if _, isAdmin := getAdminFromContext(r); !isAdmin {
+		util.WriteJSONError(w, http.StatusForbidden, "Access denied: Admin only")
+		return
+	}
+
+	grpcReq := &pb_admin.GetStudentHoldsRequest{
+		StudentId:      chi.URLParam(r, "id"),
+		IncludeCleared: r.URL.Query().Get("include_cleared") == "true",
+	}
+
+	ctx := r.Context()
+
+	grpcResp, err := h.AdminClient.GetStudentHolds(ctx, grpcReq)
+	if err != nil {
+		util.HandleGRPCError(w, err)
+		return
+	}
+
+	util.WriteJSON(w, http.StatusOK, map[string]interface{}{
+		"success": grpcResp.Success,
+		"holds":   grpcResp.Holds,
+	})
+}
+
+// SetEnrollmentPeriod handles POST /admin/enrollment/period
+func (h *AdminHandler) SetEnrollmentPeriod(w http.ResponseWriter, r *http.Request) {
+	adminUser, isAdmin := getAdminFromContext(r)
+	if !isAdmin {
+		util.WriteJSONError(w, http.StatusForbidden, "Access denied: Admin only")
+		return
+	}
+
+	var reqBody RESTSetEnrollmentPeriodRequest
+	if !util.DecodeJSON(w, r, &reqBody) {
+		return
+	}
+
+	grpcReq := &pb_admin.SetEnrollmentPeriodRequest{
+		StartDate:    reqBody.StartDate,
+		EndDate:      reqBody.EndDate,
+		AllowPastEnd: reqBody.AllowPastEnd,
+		AdminId:      adminUser.Id,
+	}
+
+	ctx := r.Context()
+
+	grpcResp, err := h.AdminClient.SetEnrollmentPeriod(ctx, grpcReq)
+	if err != nil {
+		util.HandleGRPCError(w, err)
+		return
+	}
+
+	response := map[string]interface{}{
+		"success": grpcResp.Success,
+		"message": grpcResp.Message,
+	}
+	if grpcResp.Warning != "" {
+		response["warning"] = grpcResp.Warning
+	}
+	util.WriteJSON(w, http.StatusOK, response)
+}
+
+// ToggleEnrollment handles POST /admin/enrollment/toggle
+func (h *AdminHandler) ToggleEnrollment(w http.ResponseWriter, r *http.Request) {
+	adminUser, isAdmin := getAdminFromContext(r)
+	if !isAdmin {
+		util.WriteJSONError(w, http.StatusForbidden, "Access denied: Admin only")
+		return
+	}
+
+	var reqBody RESTToggleEnrollmentRequest
+	if !util.DecodeJSON(w, r, &reqBody) {
+		return
+	}
+
+	grpcReq := &pb_admin.ToggleEnrollmentRequest{
+		Enable:  reqBody.Enable,
+		AdminId: adminUser.Id,
+	}
+
+	ctx := r.Context()
+
+	grpcResp, err := h.AdminClient.ToggleEnrollment(ctx, grpcReq)
+	if err != nil {
+		util.HandleGRPCError(w, err)
+		return
+	}
+
+	util.WriteJSON(w, http.StatusOK, map[string]interface{}{
+		"success":         grpcResp.Success,
+		"enrollment_open": grpcResp.EnrollmentOpen,
+		"message":         grpcResp.Message,
+	})
+}
+
+// OverrideEnrollment handles POST /admin/override/enroll and /admin/override/drop
+func (h *AdminHandler) OverrideEnroll(w http.ResponseWriter, r *http.Request) {
+	h.handleOverride(w, r, "force_enroll")
+}
+
+func (h *AdminHandler) OverrideDrop(w http.ResponseWriter, r *http.Request) {
 	h.handleOverride(w, r, "force_drop")
 }
 
@@ -545,8 +1258,7 @@ func (h *AdminHandler) handleOverride(w http.ResponseWriter, r *http.Request, ac
 	}
 
 	var reqBody RESTOverrideEnrollmentRequest
-	if err := json.NewDecoder(r.Body).Decode(&reqBody); err != nil {
-		util.WriteJSONError(w, http.StatusBadRequest, "Invalid request body")
+	if !util.DecodeJSON(w, r, &reqBody) {
 		return
 	}
 
@@ -558,8 +1270,7 @@ func (h *AdminHandler) handleOverride(w http.ResponseWriter, r *http.Request, ac
 		AdminId:   adminUser.Id, // Securely taken from authenticated user context
 	}
 
-	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
-	defer cancel()
+	ctx := r.Context()
 
 	grpcResp, err := h.AdminClient.OverrideEnrollment(ctx, grpcReq)
 	if err != nil {
@@ -573,8 +1284,422 @@ func (h *AdminHandler) handleOverride(w http.ResponseWriter, r *http.Request, ac
 	}
 
 	util.WriteJSON(w, http.StatusOK, map[string]interface{}{
-		"success": grpcResp.Success,
-		"message": grpcResp.Message,
+		"success":       grpcResp.Success,
+		"message":       grpcResp.Message,
+		"enrolled":      grpcResp.Enrolled,
+		"capacity":      grpcResp.Capacity,
+		"course_closed": grpcResp.CourseClosed,
+	})
+}
+
+// RestoreEnrollment handles POST /admin/override/restore
+func (h *AdminHandler) RestoreEnrollment(w http.ResponseWriter, r *http.Request) {
+	adminUser, isAdmin := getAdminFromContext(r)
+	if !isAdmin {
+		util.WriteJSONError(w, http.StatusForbidden, "Access denied: Admin only")
+		return
+	}
+
+	var reqBody RESTRestoreEnrollmentRequest
+	if !util.DecodeJSON(w, r, &reqBody) {
+		return
+	}
+
+	grpcReq := &pb_admin.RestoreEnrollmentRequest{
+		EnrollmentId: reqBody.EnrollmentID,
+		Force:        reqBody.Force,
+		Reason:       reqBody.Reason,
+		AdminId:      adminUser.Id,
+	}
+
+	ctx := r.Context()
+
+	grpcResp, err := h.AdminClient.RestoreEnrollment(ctx, grpcReq)
+	if err != nil {
+		util.HandleGRPCError(w, err)
+		return
+	}
+
+	statusCode := http.StatusOK
+	if !grpcResp.Success {
+		statusCode = http.StatusConflict
+	}
+	util.WriteJSON(w, statusCode, map[string]interface{}{
+		"success":       grpcResp.Success,
+		"message":       grpcResp.Message,
+		"outcome":       grpcResp.Outcome,
+		"enrollment_id": grpcResp.EnrollmentId,
+		"enrolled":      grpcResp.Enrolled,
+		"capacity":      grpcResp.Capacity,
+	})
+}
+
+// RecalculateEnrollmentCounts handles POST /admin/enrollment/recalculate?semester=
+func (h *AdminHandler) RecalculateEnrollmentCounts(w http.ResponseWriter, r *http.Request) {
+	adminUser, isAdmin := getAdminFromContext(r)
+	if !isAdmin {
+		util.WriteJSONError(w, http.StatusForbidden, "Access denied: Admin only")
+		return
+	}
+
+	grpcReq := &pb_admin.RecalculateEnrollmentCountsRequest{
+		Semester: r.URL.Query().Get("semester"),
+		AdminId:  adminUser.Id,
+	}
+
+	ctx := r.Context()
+
+	grpcResp, err := h.AdminClient.RecalculateEnrollmentCounts(ctx, grpcReq)
+	if err != nil {
+		util.HandleGRPCError(w, err)
+		return
+	}
+
+	util.WriteJSON(w, http.StatusOK, map[string]interface{}{
+		"success":         grpcResp.Success,
+		"message":         grpcResp.Message,
+		"courses_checked": grpcResp.CoursesChecked,
+		"corrections":     grpcResp.Corrections,
+	})
+}
+
+// CloseSemester handles POST /admin/semesters/close
+func (h *AdminHandler) CloseSemester(w http.ResponseWriter, r *http.Request) {
+	adminUser, isAdmin := getAdminFromContext(r)
+	if !isAdmin {
+		util.WriteJSONError(w, http.StatusForbidden, "Access denied: Admin only")
+		return
+	}
+
+	var reqBody RESTCloseSemesterRequest
+	if !util.DecodeJSON(w, r, &reqBody) {
+		return
+	}
+
+	grpcResp, err := h.AdminClient.CloseSemester(r.Context(), &pb_admin.CloseSemesterRequest{
+		Semester: reqBody.Semester,
+		AdminId:  adminUser.Id,
+	})
+	if err != nil {
+		util.HandleGRPCError(w, err)
+		return
+	}
+
+	util.WriteJSON(w, http.StatusOK, map[string]interface{}{
+		"success":               grpcResp.Success,
+		"message":               grpcResp.Message,
+		"courses_closed":        grpcResp.CoursesClosed,
+		"enrollments_completed": grpcResp.EnrollmentsCompleted,
+	})
+}
+
+// PublishAllGrades handles POST /admin/semesters/publish-grades
+func (h *AdminHandler) PublishAllGrades(w http.ResponseWriter, r *http.Request) {
+	adminUser, isAdmin := getAdminFromContext(r)
+	if !isAdmin {
+		util.WriteJSONError(w, http.StatusForbidden, "Access denied: Admin only")
+		return
+	}
+
+	var reqBody RESTPublishAllGradesRequest
+	if !util.DecodeJSON(w, r, &reqBody) {
+		return
+	}
+
+	grpcResp, err := h.AdminClient.PublishAllGrades(r.Context(), &pb_admin.PublishAllGradesRequest{
+		Semester:       reqBody.Semester,
+		AdminId:        adminUser.Id,
+		Confirm:        reqBody.Confirm,
+		NotifyStudents: reqBody.NotifyStudents,
+	})
+	if err != nil {
+		util.HandleGRPCError(w, err)
+		return
+	}
+
+	util.WriteJSON(w, http.StatusOK, map[string]interface{}{
+		"success":           grpcResp.Success,
+		"message":           grpcResp.Message,
+		"grades_published":  grpcResp.GradesPublished,
+		"courses_affected":  grpcResp.CoursesAffected,
+		"students_notified": grpcResp.StudentsNotified,
+	})
+}
+
+// GetNearlyFullCourses handles GET /admin/courses/nearly-full?semester=&threshold=
+func (h *AdminHandler) GetNearlyFullCourses(w http.ResponseWriter, r *http.Request) {
+	if _, isAdmin := getAdminFromContext(r); !isAdmin {
+		util.WriteJSONError(w, http.StatusForbidden, "Access denied: Admin only")
+		return
+	}
+
+	grpcReq := &pb_admin.GetNearlyFullCoursesRequest{
+		Semester: r.URL.Query().Get("semester"),
+	}
+	if raw := r.URL.Query().Get("threshold"); raw != "" {
+		threshold, err := strconv.Atoi(strings.TrimSuffix(raw, "%"))
+		if err != nil || threshold < 1 || threshold > 100 {
+			util.WriteJSONError(w, http.StatusBadRequest, "threshold must be a percentage between 1 and 100")
+			return
+		}
+		grpcReq.Threshold = int32(threshold)
+	}
+
+	ctx := r.Context()
+
+	grpcResp, err := h.AdminClient.GetNearlyFullCourses(ctx, grpcReq)
+	if err != nil {
+		util.HandleGRPCError(w, err)
+		return
+	}
+
+	util.WriteJSON(w, http.StatusOK, map[string]interface{}{
+		"success":   grpcResp.Success,
+		"message":   grpcResp.Message,
+		"threshold": grpcResp.Threshold,
+		"courses":   grpcResp.Courses,
+	})
+}
+
+// GetCourseFillTimeline handles GET /admin/courses/{id}/fill-timeline
+// Returns the enrolled count over time for a course's fill-rate chart.
+func (h *AdminHandler) GetCourseFillTimeline(w http.ResponseWriter, r *http.Request) {
+	if _, isAdmin := getAdminFromContext(r); !isAdmin {
+		util.WriteJSONError(w, http.StatusForbidden, "Access denied: Admin only")
+		return
+	}
+
+	courseID := chi.URLParam(r, "id")
+	if courseID == "" {
+		util.WriteJSONError(w, http.StatusBadRequest, "Course ID is required")
+		return
+	}
+
+	ctx := r.Context()
+
+	grpcResp, err := h.AdminClient.GetCourseFillTimeline(ctx, &pb_admin.GetCourseFillTimelineRequest{CourseId: courseID})
+	if err != nil {
+		util.HandleGRPCError(w, err)
+		return
+	}
+
+	util.WriteJSON(w, http.StatusOK, map[string]interface{}{
+		"success":     grpcResp.Success,
+		"message":     grpcResp.Message,
+		"course_id":   grpcResp.CourseId,
+		"course_code": grpcResp.CourseCode,
+		"capacity":    grpcResp.Capacity,
+		"points":      grpcResp.Points,
+	})
+}
+
+// TrendBucketView renders an enrollment trend bucket with its counts always present;
+// filled-in empty buckets would otherwise lose every field but start
+type TrendBucketView struct {
+	Start      time.Time `json:"start"`
+	Enrolls    int32     `json:"enrolls"`
+	Drops      int32     `json:"drops"`
+	Net        int32     `json:"net"`
+	Cumulative int32     `json:"cumulative"`
+}
+
+// parseTrendTime reads an optional from/to query value as RFC 3339 or YYYY-MM-DD (UTC)
+func parseTrendTime(value string) (*timestamppb.Timestamp, error) {
+	if value == "" {
+		return nil, nil
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		if t, err = time.Parse("2006-01-02", value); err != nil {
+			return nil, err
+		}
+	}
+	return timestamppb.New(t), nil
+}
+
+// GetEnrollmentTrend handles GET /admin/reports/enrollment-trend?course_id=|department=&interval=&from=&to=
+func (h *AdminHandler) GetEnrollmentTrend(w http.ResponseWriter, r *http.Request) {
+	if _, isAdmin := getAdminFromContext(r); !isAdmin {
+		util.WriteJSONError(w, http.StatusForbidden, "Access denied: Admin only")
+		return
+	}
+
+	query := r.URL.Query()
+	from, err := parseTrendTime(query.Get("from"))
+	if err != nil {
+		util.WriteJSONError(w, http.StatusBadRequest, "from must be RFC 3339 or YYYY-MM-DD")
+		return
+	}
+	to, err := parseTrendTime(query.Get("to"))
+	if err != nil {
+		util.WriteJSONError(w, http.StatusBadRequest, "to must be RFC 3339 or YYYY-MM-DD")
+		return
+	}
+
+	grpcReq := &pb_admin.GetEnrollmentTrendRequest{
+		CourseId:   query.Get("course_id"),
+		Department: query.Get("department"),
+		Interval:   query.Get("interval"),
+		From:       from,
+		To:         to,
+	}
+
+	ctx := r.Context()
+
+	grpcResp, err := h.AdminClient.GetEnrollmentTrend(ctx, grpcReq)
+	if err != nil {
+		util.HandleGRPCError(w, err)
+		return
+	}
+
+	buckets := make([]TrendBucketView, 0, len(grpcResp.Buckets))
+	for _, b := range grpcResp.Buckets {
+		buckets = append(buckets, TrendBucketView{
+			Start:      b.GetStart().AsTime(),
+			Enrolls:    b.GetEnrolls(),
+			Drops:      b.GetDrops(),
+			Net:        b.GetNet(),
+			Cumulative: b.GetCumulative(),
+		})
+	}
+
+	util.WriteJSON(w, http.StatusOK, map[string]interface{}{
+		"success":  grpcResp.Success,
+		"message":  grpcResp.Message,
+		"interval": grpcResp.Interval,
+		"buckets":  buckets,
+	})
+}
+
+// GetFacultyLoadReport handles GET /admin/reports/faculty-load?semester=&max_units=&include_unassigned=
+func (h *AdminHandler) GetFacultyLoadReport(w http.ResponseWriter, r *http.Request) {
+	grpcResp, ok := h.fetchFacultyLoadReport(w, r)
+	if !ok {
+		return
+	}
+
+	util.WriteJSON(w, http.StatusOK, map[string]interface{}{
+		"success":   grpcResp.Success,
+		"message":   grpcResp.Message,
+		"semester":  grpcResp.Semester,
+		"max_units": grpcResp.MaxUnits,
+		"faculty":   grpcResp.Faculty,
+	})
+}
+
+// ExportFacultyLoadReport handles GET /admin/reports/faculty-load.csv?semester=&max_units=&include_unassigned=
+func (h *AdminHandler) ExportFacultyLoadReport(w http.ResponseWriter, r *http.Request) {
+	grpcResp, ok := h.fetchFacultyLoadReport(w, r)
+	if !ok {
+		return
+	}
+
+	filename := fmt.Sprintf("faculty_load_%s.csv", strings.ReplaceAll(grpcResp.Semester, " ", "_"))
+	w.Header().Set("Content-Type", "text/csv; charset=utf-8")
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filename))
+	w.WriteHeader(http.StatusOK)
+
+	rows := [][]string{{"faculty_id", "name", "email", "courses", "units", "enrolled", "overloaded", "contact_hours", "course_codes"}}
+	for _, f := range grpcResp.Faculty {
+		rows = append(rows, []string{
+			f.FacultyId,
+			f.FacultyName,
+			f.Email,
+			strconv.Itoa(int(f.CourseCount)),
+			strconv.Itoa(int(f.TotalUnits)),
+			strconv.Itoa(int(f.TotalEnrolled)),
+			strconv.FormatBool(f.Overloaded),
+			strconv.FormatFloat(f.ContactHours, 'f', -1, 64),
+			strings.Join(f.CourseCodes, ";"),
+		})
+	}
+	if err := csv.NewWriter(w).WriteAll(rows); err != nil {
+		// Headers are already sent; the client sees a truncated file
+		log.Printf("Faculty load export for %s aborted: %v", grpcResp.Semester, err)
+	}
+}
+
+// fetchFacultyLoadReport runs the faculty load query shared by the JSON and CSV
+// endpoints, writing the error response itself when it fails
+func (h *AdminHandler) fetchFacultyLoadReport(w http.ResponseWriter, r *http.Request) (*pb_admin.GetFacultyLoadReportResponse, bool) {
+	if _, isAdmin := getAdminFromContext(r); !isAdmin {
+		util.WriteJSONError(w, http.StatusForbidden, "Access denied: Admin only")
+		return nil, false
+	}
+
+	grpcReq := &pb_admin.GetFacultyLoadReportRequest{
+		Semester: r.URL.Query().Get("semester"),
+	}
+	if grpcReq.Semester == "" {
+		util.WriteJSONError(w, http.StatusBadRequest, "semester is required")
+		return nil, false
+	}
+	if raw := r.URL.Query().Get("max_units"); raw != "" {
+		maxUnits, err := strconv.Atoi(raw)
+		if err != nil || maxUnits < 1 {
+			util.WriteJSONError(w, http.StatusBadRequest, "max_units must be a positive number")
+			return nil, false
+		}
+		grpcReq.MaxUnits = int32(maxUnits)
+	}
+	if raw := r.URL.Query().Get("include_unassigned"); raw != "" {
+		include, err := strconv.ParseBool(raw)
+		if err != nil {
+			util.WriteJSONError(w, http.StatusBadRequest, "include_unassigned must be true or false")
+			return nil, false
+		}
+		grpcReq.IncludeUnassigned = include
+	}
+
+	grpcResp, err := h.AdminClient.GetFacultyLoadReport(r.Context(), grpcReq)
+	if err != nil {
+		util.HandleGRPCError(w, err)
+		return nil, false
+	}
+	return grpcResp, true
+}
+
+// GetResourceHistory handles GET /admin/audit/history?resource=
+// Query Params: page, page_size (optional)
+func (h *AdminHandler) GetResourceHistory(w http.ResponseWriter, r *http.Request) {
+	if _, isAdmin := getAdminFromContext(r); !isAdmin {
+		util.WriteJSONError(w, http.StatusForbidden, "Access denied: Admin only")
+		return
+	}
+
+	resource := r.URL.Query().Get("resource")
+	if resource == "" {
+		util.WriteJSONError(w, http.StatusBadRequest, "resource query parameter is required")
+		return
+	}
+
+	grpcReq := &pb_admin.GetResourceHistoryRequest{Resource: resource}
+	var err error
+	if grpcReq.Page, err = positiveQueryInt(r, "page"); err != nil {
+		util.WriteJSONError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	if grpcReq.PageSize, err = positiveQueryInt(r, "page_size"); err != nil {
+		util.WriteJSONError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	ctx := r.Context()
+
+	grpcResp, err := h.AdminClient.GetResourceHistory(ctx, grpcReq)
+	if err != nil {
+		util.HandleGRPCError(w, err)
+		return
+	}
+
+	util.WriteJSON(w, http.StatusOK, map[string]interface{}{
+		"success":     grpcResp.Success,
+		"message":     grpcResp.Message,
+		"resource":    grpcResp.Resource,
+		"events":      grpcResp.Events,
+		"total_count": grpcResp.TotalCount,
+		"page":        grpcResp.Page,
+		"page_size":   grpcResp.PageSize,
 	})
 }
 
@@ -591,8 +1716,7 @@ func (h *AdminHandler) GetSystemConfig(w http.ResponseWriter, r *http.Request) {
 		Key: key,
 	}
 
-	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
-	defer cancel()
+	ctx := r.Context()
 
 	grpcResp, err := h.AdminClient.GetSystemConfig(ctx, grpcReq)
 	if err != nil {
@@ -616,8 +1740,7 @@ func (h *AdminHandler) UpdateSystemConfig(w http.ResponseWriter, r *http.Request
 	key := chi.URLParam(r, "key")
 
 	var reqBody RESTUpdateSystemConfigRequest
-	if err := json.NewDecoder(r.Body).Decode(&reqBody); err != nil {
-		util.WriteJSONError(w, http.StatusBadRequest, "Invalid request body")
+	if !util.DecodeJSON(w, r, &reqBody) {
 		return
 	}
 
@@ -627,8 +1750,7 @@ func (h *AdminHandler) UpdateSystemConfig(w http.ResponseWriter, r *http.Request
 		AdminId: adminUser.Id,
 	}
 
-	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
-	defer cancel()
+	ctx := r.Context()
 
 	grpcResp, err := h.AdminClient.UpdateSystemConfig(ctx, grpcReq)
 	if err != nil {
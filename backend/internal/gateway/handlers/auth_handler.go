@@ -2,24 +2,36 @@ package handlers
 
 import (
 	"context"
-	"encoding/json"
 	"errors"
 	"fmt"
-	"io"
 	"net/http"
-	"strings"
 	"time"
 
+	"github.com/go-chi/chi/v5"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 
 	"stdiscm_p4/backend/internal/gateway/util" // Assuming a utility package for JSON response handling
 	pb "stdiscm_p4/backend/internal/pb/auth"   // Assuming the gRPC generated package
+	"stdiscm_p4/backend/internal/shared"
+)
+
+// Token delivery modes for Login
+const (
+	TokenDeliveryHeader = "header" // token in the JSON body only (default)
+	TokenDeliveryCookie = "cookie" // token in an HttpOnly cookie only
+	TokenDeliveryBoth   = "both"   // token in both the JSON body and the cookie
 )
 
 // AuthHandler holds the gRPC client for the Auth Service.
 type AuthHandler struct {
 	AuthClient pb.AuthServiceClient
+
+	// TokenDelivery selects how Login hands out the token (see TokenDelivery* modes)
+	TokenDelivery string
+	// CookieSecure marks the token cookie Secure (disable only for plain-HTTP development)
+	CookieSecure bool
 }
 
 // RESTLoginRequest mirrors the expected JSON input for /auth/login
@@ -34,23 +46,55 @@ type RESTChangePasswordRequest struct {
 	NewPassword string `json:"new_password"`
 }
 
-// Helper function to extract token from Authorization header (Bearer <token>)
-func extractToken(r *http.Request) (string, error) {
-	authHeader := r.Header.Get("Authorization")
-	if authHeader == "" {
-		return "", errors.New("authorization header missing")
-	}
+// RESTUpdateProfileRequest mirrors the expected JSON input for PATCH /me.
+// Only self-editable fields are accepted; the user ID always comes from the token.
+type RESTUpdateProfileRequest struct {
+	DisplayName  string `json:"display_name"`
+	ContactEmail string `json:"contact_email"`
+	Phone        string `json:"phone"`
+}
+
+// usesCookie reports whether the token is delivered as a cookie
+func (h *AuthHandler) usesCookie() bool {
+	return h.TokenDelivery == TokenDeliveryCookie || h.TokenDelivery == TokenDeliveryBoth
+}
 
-	parts := strings.Split(authHeader, " ")
-	if len(parts) != 2 || strings.ToLower(parts[0]) != "bearer" {
-		return "", errors.New("invalid authorization header format")
+// setTokenCookie writes the session token as a Secure/HttpOnly/SameSite cookie.
+// A zero expiry clears the cookie.
+func (h *AuthHandler) setTokenCookie(w http.ResponseWriter, token string, expiresAt time.Time) {
+	cookie := &http.Cookie{
+		Name:     util.TokenCookieName,
+		Value:    token,
+		Path:     "/",
+		HttpOnly: true,
+		Secure:   h.CookieSecure,
+		SameSite: http.SameSiteStrictMode,
+	}
+	if expiresAt.IsZero() {
+		cookie.MaxAge = -1
+	} else {
+		cookie.Expires = expiresAt
+		cookie.MaxAge = int(time.Until(expiresAt).Seconds())
 	}
+	http.SetCookie(w, cookie)
+}
 
-	return parts[1], nil
+// withCallerToken forwards the caller's token so the Auth Service can authorize admin-only RPCs
+func withCallerToken(ctx context.Context, r *http.Request) context.Context {
+	token, err := util.ExtractToken(r)
+	if err != nil {
+		return ctx
+	}
+	return metadata.AppendToOutgoingContext(ctx, shared.MetadataAuthorization, "Bearer "+token)
 }
 
 // handleGRPCError translates gRPC status errors to appropriate HTTP responses.
 func handleGRPCError(w http.ResponseWriter, err error) {
+	if errors.Is(err, context.DeadlineExceeded) {
+		util.WriteTimeoutError(w)
+		return
+	}
+
 	st, ok := status.FromError(err)
 	if !ok {
 		// Not a gRPC error, treat as internal server error
@@ -58,34 +102,33 @@ func handleGRPCError(w http.ResponseWriter, err error) {
 		return
 	}
 
+	code := shared.ErrorCodeOf(err)
+
 	// Map gRPC codes to HTTP status codes
 	switch st.Code() {
 	case codes.InvalidArgument:
-		util.WriteJSONError(w, http.StatusBadRequest, st.Message())
+		util.WriteJSONErrorCode(w, http.StatusBadRequest, code, st.Message())
 	case codes.Unauthenticated:
-		util.WriteJSONError(w, http.StatusUnauthorized, st.Message())
+		util.WriteJSONErrorCode(w, http.StatusUnauthorized, code, st.Message())
 	case codes.PermissionDenied:
-		util.WriteJSONError(w, http.StatusForbidden, st.Message())
+		util.WriteJSONErrorCode(w, http.StatusForbidden, code, st.Message())
 	case codes.NotFound:
-		util.WriteJSONError(w, http.StatusNotFound, st.Message())
+		util.WriteJSONErrorCode(w, http.StatusNotFound, code, st.Message())
 	case codes.Unavailable:
 		// Important for distributed systems: Service is down or unreachable
-		util.WriteJSONError(w, http.StatusServiceUnavailable, fmt.Sprintf("Service Unavailable: %s", st.Message()))
+		util.WriteJSONErrorCode(w, http.StatusServiceUnavailable, code, fmt.Sprintf("Service Unavailable: %s", st.Message()))
+	case codes.DeadlineExceeded:
+		util.WriteTimeoutError(w)
 	default:
 		// Catch-all for internal or unknown gRPC errors
-		util.WriteJSONError(w, http.StatusInternalServerError, fmt.Sprintf("Backend error: %s", st.Message()))
+		util.WriteJSONErrorCode(w, http.StatusInternalServerError, code, fmt.Sprintf("Backend error: %s", st.Message()))
 	}
 }
 
 // Login handles POST /auth/login
 func (h *AuthHandler) Login(w http.ResponseWriter, r *http.Request) {
 	var reqBody RESTLoginRequest
-	if err := json.NewDecoder(r.Body).Decode(&reqBody); err != nil {
-		if errors.Is(err, io.EOF) {
-			util.WriteJSONError(w, http.StatusBadRequest, "Request body is empty")
-			return
-		}
-		util.WriteJSONError(w, http.StatusBadRequest, "Invalid request payload")
+	if !util.DecodeJSON(w, r, &reqBody) {
 		return
 	}
 
@@ -101,9 +144,11 @@ func (h *AuthHandler) Login(w http.ResponseWriter, r *http.Request) {
 		Password:   reqBody.Password,
 	}
 
-	// Use a context with a timeout for the gRPC call (e.g., 10 seconds)
-	ctx, cancel := context.WithTimeout(r.Context(), 10*time.Second)
-	defer cancel()
+	// The route deadline is already on the request context
+	ctx := r.Context()
+
+	// Forward the client IP so the session records where the login came from
+	ctx = metadata.AppendToOutgoingContext(ctx, shared.MetadataClientIP, util.ClientIP(r))
 
 	// Call the backend service
 	grpcResp, err := h.AuthClient.Login(ctx, grpcReq)
@@ -121,9 +166,21 @@ func (h *AuthHandler) Login(w http.ResponseWriter, r *http.Request) {
 
 	// Map gRPC response to HTTP response format
 	response := map[string]interface{}{
-		"success": true,
-		"token":   grpcResp.Token,
-		"user":    grpcResp.User, // Protobuf fields convert cleanly to JSON
+		"success":            true,
+		"user":               grpcResp.User, // Protobuf fields convert cleanly to JSON
+		"expires_at":         grpcResp.ExpiresAt.AsTime(),
+		"expires_in_seconds": grpcResp.ExpiresInSeconds,
+	}
+	if grpcResp.MustChangePassword {
+		response["must_change_password"] = true
+	}
+
+	// Deliver the token per the configured mode (header-only by default)
+	if h.TokenDelivery != TokenDeliveryCookie {
+		response["token"] = grpcResp.Token
+	}
+	if h.usesCookie() {
+		h.setTokenCookie(w, grpcResp.Token, grpcResp.ExpiresAt.AsTime())
 	}
 
 	util.WriteJSON(w, http.StatusOK, response)
@@ -131,8 +188,13 @@ func (h *AuthHandler) Login(w http.ResponseWriter, r *http.Request) {
 
 // Logout handles POST /auth/logout
 func (h *AuthHandler) Logout(w http.ResponseWriter, r *http.Request) {
-	// Logout requires extracting the token from the header
-	token, err := extractToken(r)
+	// Always drop the token cookie, even if the session is already gone
+	if h.usesCookie() {
+		h.setTokenCookie(w, "", time.Time{})
+	}
+
+	// Logout requires extracting the token from the header (or cookie)
+	token, err := util.ExtractToken(r)
 	if err != nil {
 		// If token is missing or invalid format, we can still treat it as a successful "logout"
 		// or return unauthorized if we want stricter adherence, but for logout, successful removal
@@ -149,8 +211,7 @@ func (h *AuthHandler) Logout(w http.ResponseWriter, r *http.Request) {
 		Token: token,
 	}
 
-	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
-	defer cancel()
+	ctx := r.Context()
 
 	// Call the backend service
 	grpcResp, err := h.AuthClient.Logout(ctx, grpcReq)
@@ -170,7 +231,7 @@ func (h *AuthHandler) Logout(w http.ResponseWriter, r *http.Request) {
 
 // ValidateToken handles GET /auth/validate
 func (h *AuthHandler) ValidateToken(w http.ResponseWriter, r *http.Request) {
-	token, err := extractToken(r)
+	token, err := util.ExtractToken(r)
 	if err != nil {
 		// If token is missing, fail validation immediately
 		util.WriteJSON(w, http.StatusUnauthorized, map[string]interface{}{
@@ -185,8 +246,7 @@ func (h *AuthHandler) ValidateToken(w http.ResponseWriter, r *http.Request) {
 		Token: token,
 	}
 
-	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
-	defer cancel()
+	ctx := r.Context()
 
 	// Call the backend service
 	grpcResp, err := h.AuthClient.ValidateToken(ctx, grpcReq)
@@ -228,12 +288,7 @@ func (h *AuthHandler) ChangePassword(w http.ResponseWriter, r *http.Request) {
 
 	// 2. Decode Request Body
 	var reqBody RESTChangePasswordRequest
-	if err := json.NewDecoder(r.Body).Decode(&reqBody); err != nil {
-		if errors.Is(err, io.EOF) {
-			util.WriteJSONError(w, http.StatusBadRequest, "Request body is empty")
-			return
-		}
-		util.WriteJSONError(w, http.StatusBadRequest, "Invalid request payload")
+	if !util.DecodeJSON(w, r, &reqBody) {
 		return
 	}
 
@@ -254,8 +309,7 @@ func (h *AuthHandler) ChangePassword(w http.ResponseWriter, r *http.Request) {
 		NewPassword: reqBody.NewPassword,
 	}
 
-	ctx, cancel := context.WithTimeout(r.Context(), 10*time.Second)
-	defer cancel()
+	ctx := r.Context()
 
 	grpcResp, err := h.AuthClient.ChangePassword(ctx, grpcReq)
 	if err != nil {
@@ -276,3 +330,145 @@ func (h *AuthHandler) ChangePassword(w http.ResponseWriter, r *http.Request) {
 		"message": grpcResp.Message,
 	})
 }
+
+// GetProfile handles GET /me (also GET /profile)
+// Returns the logged-in user's own profile.
+func (h *AuthHandler) GetProfile(w http.ResponseWriter, r *http.Request) {
+	// 1. Authentication: Retrieve User from Context (AuthMiddleware)
+	user, ok := r.Context().Value("user").(*pb.User)
+	if !ok || user == nil {
+		util.WriteJSONError(w, http.StatusUnauthorized, "Unauthorized: User context missing")
+		return
+	}
+
+	// 2. Call gRPC
+	ctx := r.Context()
+
+	grpcResp, err := h.AuthClient.GetProfile(ctx, &pb.GetProfileRequest{UserId: user.Id})
+	if err != nil {
+		handleGRPCError(w, err)
+		return
+	}
+
+	// 3. Success Response
+	util.WriteJSON(w, http.StatusOK, map[string]interface{}{
+		"success": true,
+		"user":    grpcResp.User,
+	})
+}
+
+// UpdateProfile handles PATCH /me (also PUT /profile)
+// Updates the logged-in user's self-editable fields (display name, contact email, phone).
+func (h *AuthHandler) UpdateProfile(w http.ResponseWriter, r *http.Request) {
+	// 1. Authentication: Retrieve User from Context (AuthMiddleware)
+	// The target user is always the caller, never an ID from the body
+	user, ok := r.Context().Value("user").(*pb.User)
+	if !ok || user == nil {
+		util.WriteJSONError(w, http.StatusUnauthorized, "Unauthorized: User context missing")
+		return
+	}
+
+	// 2. Decode Request Body
+	var reqBody RESTUpdateProfileRequest
+	if !util.DecodeJSON(w, r, &reqBody) {
+		return
+	}
+
+	// 3. Prepare and Call gRPC
+	grpcReq := &pb.UpdateProfileRequest{
+		UserId:       user.Id,
+		DisplayName:  reqBody.DisplayName,
+		ContactEmail: reqBody.ContactEmail,
+		Phone:        reqBody.Phone,
+	}
+
+	ctx := r.Context()
+
+	grpcResp, err := h.AuthClient.UpdateProfile(ctx, grpcReq)
+	if err != nil {
+		handleGRPCError(w, err)
+		return
+	}
+
+	// 4. Success Response
+	util.WriteJSON(w, http.StatusOK, map[string]interface{}{
+		"success": true,
+		"user":    grpcResp.User,
+		"message": grpcResp.Message,
+	})
+}
+
+// ListUserSessions handles GET /admin/users/:id/sessions
+func (h *AuthHandler) ListUserSessions(w http.ResponseWriter, r *http.Request) {
+	if _, isAdmin := getAdminFromContext(r); !isAdmin {
+		util.WriteJSONError(w, http.StatusForbidden, "Access denied: Admin only")
+		return
+	}
+
+	userID := chi.URLParam(r, "id")
+
+	ctx := r.Context()
+
+	grpcResp, err := h.AuthClient.ListUserSessions(withCallerToken(ctx, r), &pb.ListUserSessionsRequest{UserId: userID})
+	if err != nil {
+		handleGRPCError(w, err)
+		return
+	}
+
+	util.WriteJSON(w, http.StatusOK, map[string]interface{}{
+		"success":  true,
+		"sessions": grpcResp.Sessions,
+		"message":  grpcResp.Message,
+	})
+}
+
+// TerminateUserSessions handles DELETE /admin/users/:id/sessions
+// Logs the user out of every device.
+func (h *AuthHandler) TerminateUserSessions(w http.ResponseWriter, r *http.Request) {
+	if _, isAdmin := getAdminFromContext(r); !isAdmin {
+		util.WriteJSONError(w, http.StatusForbidden, "Access denied: Admin only")
+		return
+	}
+
+	userID := chi.URLParam(r, "id")
+
+	ctx := r.Context()
+
+	grpcResp, err := h.AuthClient.TerminateAllSessions(withCallerToken(ctx, r), &pb.TerminateAllSessionsRequest{UserId: userID})
+	if err != nil {
+		handleGRPCError(w, err)
+		return
+	}
+
+	util.WriteJSON(w, http.StatusOK, map[string]interface{}{
+		"success":          true,
+		"terminated_count": grpcResp.TerminatedCount,
+		"message":          grpcResp.Message,
+	})
+}
+
+// TerminateUserSession handles DELETE /admin/users/:id/sessions/:session_id
+func (h *AuthHandler) TerminateUserSession(w http.ResponseWriter, r *http.Request) {
+	if _, isAdmin := getAdminFromContext(r); !isAdmin {
+		util.WriteJSONError(w, http.StatusForbidden, "Access denied: Admin only")
+		return
+	}
+
+	grpcReq := &pb.TerminateSessionRequest{
+		SessionId: chi.URLParam(r, "session_id"),
+		UserId:    chi.URLParam(r, "id"),
+	}
+
+	ctx := r.Context()
+
+	grpcResp, err := h.AuthClient.TerminateSession(withCallerToken(ctx, r), grpcReq)
+	if err != nil {
+		handleGRPCError(w, err)
+		return
+	}
+
+	util.WriteJSON(w, http.StatusOK, map[string]interface{}{
+		"success": true,
+		"message": grpcResp.Message,
+	})
+}
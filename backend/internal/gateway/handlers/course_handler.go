@@ -1,10 +1,9 @@
 package handlers
 
 import (
-	"context"
 	"net/http"
 	"strconv"
-	"time"
+	"strings"
 
 	"github.com/go-chi/chi/v5"
 
@@ -17,8 +16,75 @@ type CourseHandler struct {
 	CourseClient pb_course.CourseServiceClient
 }
 
+// RESTAddCourseMaterialRequest mirrors the JSON input for POST /faculty/courses/:id/materials
+type RESTAddCourseMaterialRequest struct {
+	Title string `json:"title"`
+	URL   string `json:"url"`
+}
+
+// RESTSubmitReviewRequest mirrors the JSON input for POST /courses/:id/reviews
+type RESTSubmitReviewRequest struct {
+	EnrollmentID string            `json:"enrollment_id"`
+	Ratings      RESTReviewRatings `json:"ratings"`
+	Comment      string            `json:"comment"`
+}
+
+// RESTReviewRatings holds the 1-5 rating for each review dimension
+type RESTReviewRatings struct {
+	Content      int32 `json:"content"`
+	Instruction  int32 `json:"instruction"`
+	Organization int32 `json:"organization"`
+	Overall      int32 `json:"overall"`
+}
+
+// reviewAveragesView keeps zero averages in the output (the generated tags omit them)
+type reviewAveragesView struct {
+	Content      float64 `json:"content"`
+	Instruction  float64 `json:"instruction"`
+	Organization float64 `json:"organization"`
+	Overall      float64 `json:"overall"`
+}
+
+// courseView renders a course with its seat fields always present. The generated
+// JSON tags use omitempty, which would drop seats_available=0 and is_full=false.
+type courseView struct {
+	*pb_course.Course
+	SeatsAvailable int32 `json:"seats_available"`
+	IsFull         bool  `json:"is_full"`
+	WaitlistCount  int32 `json:"waitlist_count"`
+	Enrollable     bool  `json:"enrollable"`
+}
+
+func toCourseView(c *pb_course.Course) *courseView {
+	if c == nil {
+		return nil
+	}
+	return &courseView{
+		Course:         c,
+		SeatsAvailable: c.SeatsAvailable,
+		IsFull:         c.IsFull,
+		WaitlistCount:  c.WaitlistCount,
+		Enrollable:     c.Enrollable,
+	}
+}
+
+func toCourseViews(courses []*pb_course.Course) []*courseView {
+	views := make([]*courseView, 0, len(courses))
+	for _, c := range courses {
+		views = append(views, toCourseView(c))
+	}
+	return views
+}
+
+// eligibleCourseView applies courseView to the course nested in an eligibility entry
+type eligibleCourseView struct {
+	*pb_course.EligibleCourse
+	Course *courseView `json:"course,omitempty"`
+}
+
 // ListCourses handles GET /courses
-// Query Params: department, search, open_only (bool), semester
+// Query Params: department, search, open_only (bool), include_cancelled (bool), semester, q + fulltext (bool),
+// days (e.g. TTH or T,TH), time_window (HH:MM-HH:MM; either side may be empty)
 func (h *CourseHandler) ListCourses(w http.ResponseWriter, r *http.Request) {
 	// 1. Extract Query Parameters
 	query := r.URL.Query()
@@ -35,19 +101,49 @@ func (h *CourseHandler) ListCourses(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
+	includeCancelled, _ := strconv.ParseBool(query.Get("include_cancelled"))
+
+	// Full-text search over title and description, ranked by relevance
+	textSearch := ""
+	if fullText, err := strconv.ParseBool(query.Get("fulltext")); err == nil && fullText {
+		textSearch = query.Get("q")
+	}
+
+	// Meeting time filters; the course service validates the days and times
+	var timeWindow *pb_course.TimeWindow
+	if window := query.Get("time_window"); window != "" {
+		start, end, ok := strings.Cut(window, "-")
+		if !ok {
+			util.WriteJSONError(w, http.StatusBadRequest, "time_window must be HH:MM-HH:MM")
+			return
+		}
+		timeWindow = &pb_course.TimeWindow{Start: strings.TrimSpace(start), End: strings.TrimSpace(end)}
+	}
+
 	// 2. Prepare gRPC Request
 	grpcReq := &pb_course.ListCoursesRequest{
 		Filters: &pb_course.CourseFilter{
-			Department:  department,
-			SearchQuery: searchQuery,
-			OpenOnly:    openOnly,
-			Semester:    semester,
+			Department:       department,
+			SearchQuery:      searchQuery,
+			OpenOnly:         openOnly,
+			Semester:         semester,
+			TextSearch:       textSearch,
+			Days:             query["days"],
+			TimeWindow:       timeWindow,
+			IncludeCancelled: includeCancelled,
 		},
+		// Validated against the allowed fields by the course service
+		SortBy:    query.Get("sort_by"),
+		SortOrder: query.Get("sort_order"),
+	}
+
+	// Admins see their course edits at once; everyone else may get a cached page
+	if user := getUserFromContext(r); user != nil && user.Role == "admin" {
+		grpcReq.Live = true
 	}
 
 	// 3. Call gRPC Service
-	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
-	defer cancel()
+	ctx := r.Context()
 
 	grpcResp, err := h.CourseClient.ListCourses(ctx, grpcReq)
 	if err != nil {
@@ -59,7 +155,7 @@ func (h *CourseHandler) ListCourses(w http.ResponseWriter, r *http.Request) {
 	// FIX: Added "success": true to prevent utility wrapper from nesting data
 	response := map[string]interface{}{
 		"success":     true,
-		"courses":     grpcResp.Courses,
+		"courses":     toCourseViews(grpcResp.Courses),
 		"total_count": grpcResp.TotalCount,
 	}
 
@@ -67,7 +163,8 @@ func (h *CourseHandler) ListCourses(w http.ResponseWriter, r *http.Request) {
 }
 
 // GetCourse handles GET /courses/:id
-// Gets detailed information for a specific course.
+// Gets detailed information for a specific course. A logged-in student also sees
+// whether it is in their cart, enrolled or completed.
 func (h *CourseHandler) GetCourse(w http.ResponseWriter, r *http.Request) {
 	// 1. Extract Path Variable
 	courseID := chi.URLParam(r, "id")
@@ -77,15 +174,17 @@ func (h *CourseHandler) GetCourse(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// 2. Prepare gRPC Request
-	grpcReq := &pb_course.GetCourseRequest{
+	grpcReq := &pb_course.GetCourseDetailRequest{
 		CourseId: courseID,
 	}
+	if studentID, err := getStudentID(r); err == nil {
+		grpcReq.StudentId = studentID
+	}
 
 	// 3. Call gRPC Service
-	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
-	defer cancel()
+	ctx := r.Context()
 
-	grpcResp, err := h.CourseClient.GetCourse(ctx, grpcReq)
+	grpcResp, err := h.CourseClient.GetCourseDetail(ctx, grpcReq)
 	if err != nil {
 		util.HandleGRPCError(w, err)
 		return
@@ -100,7 +199,12 @@ func (h *CourseHandler) GetCourse(w http.ResponseWriter, r *http.Request) {
 	// 5. Map and Respond
 	response := map[string]interface{}{
 		"success": true,
-		"course":  grpcResp.Course,
+		"course":  toCourseView(grpcResp.Course),
+	}
+	if st := grpcResp.StudentStatus; st != nil {
+		response["in_cart"] = st.InCart
+		response["enrolled"] = st.Enrolled
+		response["completed"] = st.Completed
 	}
 
 	util.WriteJSON(w, http.StatusOK, response)
@@ -119,8 +223,7 @@ func (h *CourseHandler) GetCourseAvailability(w http.ResponseWriter, r *http.Req
 		CourseId: courseID,
 	}
 
-	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
-	defer cancel()
+	ctx := r.Context()
 
 	grpcResp, err := h.CourseClient.GetCourseAvailability(ctx, grpcReq)
 	if err != nil {
@@ -142,8 +245,25 @@ func (h *CourseHandler) GetCourseAvailability(w http.ResponseWriter, r *http.Req
 	util.WriteJSON(w, http.StatusOK, response)
 }
 
+// ListDepartments handles GET /departments
+// Lists the departments the course department filter accepts.
+func (h *CourseHandler) ListDepartments(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	grpcResp, err := h.CourseClient.ListDepartments(ctx, &pb_course.ListDepartmentsRequest{})
+	if err != nil {
+		util.HandleGRPCError(w, err)
+		return
+	}
+
+	util.WriteJSON(w, http.StatusOK, map[string]interface{}{
+		"success":     true,
+		"departments": grpcResp.Departments,
+	})
+}
+
 // CheckPrerequisites handles GET /courses/:id/prerequisites
-// Query Params: student_id
+// Query Params: student_id, allow_in_progress (optional)
 func (h *CourseHandler) CheckPrerequisites(w http.ResponseWriter, r *http.Request) {
 	courseID := chi.URLParam(r, "id")
 	if courseID == "" {
@@ -162,9 +282,11 @@ func (h *CourseHandler) CheckPrerequisites(w http.ResponseWriter, r *http.Reques
 		StudentId: studentID,
 		CourseId:  courseID,
 	}
+	if allow, err := strconv.ParseBool(r.URL.Query().Get("allow_in_progress")); err == nil {
+		grpcReq.AllowInProgress = allow
+	}
 
-	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
-	defer cancel()
+	ctx := r.Context()
 
 	grpcResp, err := h.CourseClient.CheckPrerequisites(ctx, grpcReq)
 	if err != nil {
@@ -176,8 +298,262 @@ func (h *CourseHandler) CheckPrerequisites(w http.ResponseWriter, r *http.Reques
 	response := map[string]interface{}{
 		"success":       true,
 		"all_met":       grpcResp.AllMet,
+		"provisional":   grpcResp.Provisional,
+		"prerequisites": grpcResp.Prerequisites,
+	}
+
+	util.WriteJSON(w, http.StatusOK, response)
+}
+
+// GetPrerequisites handles GET /courses/:id/prerequisite-courses
+// Lists the course's prerequisites for catalog display, without checking any student's record.
+func (h *CourseHandler) GetPrerequisites(w http.ResponseWriter, r *http.Request) {
+	courseID := chi.URLParam(r, "id")
+	if courseID == "" {
+		util.WriteJSONError(w, http.StatusBadRequest, "Course ID is required")
+		return
+	}
+
+	grpcResp, err := h.CourseClient.GetPrerequisites(r.Context(), &pb_course.GetPrerequisitesRequest{CourseId: courseID})
+	if err != nil {
+		util.HandleGRPCError(w, err)
+		return
+	}
+
+	response := map[string]interface{}{
+		"success":       true,
+		"course_id":     grpcResp.CourseId,
 		"prerequisites": grpcResp.Prerequisites,
 	}
 
 	util.WriteJSON(w, http.StatusOK, response)
 }
+
+// GetEligibleCourses handles GET /courses/eligible
+// Lists open courses the logged-in student can take, with a reason for those they cannot yet.
+// Query Params: semester (required), department (optional)
+func (h *CourseHandler) GetEligibleCourses(w http.ResponseWriter, r *http.Request) {
+	studentID, err := getStudentID(r)
+	if err != nil {
+		util.WriteJSONError(w, http.StatusForbidden, "Access denied: Only students can check course eligibility")
+		return
+	}
+
+	semester := r.URL.Query().Get("semester")
+	if semester == "" {
+		util.WriteJSONError(w, http.StatusBadRequest, "semester query parameter is required")
+		return
+	}
+
+	grpcReq := &pb_course.GetEligibleCoursesRequest{
+		StudentId:  studentID,
+		Semester:   semester,
+		Department: r.URL.Query().Get("department"),
+	}
+
+	ctx := r.Context()
+
+	grpcResp, err := h.CourseClient.GetEligibleCourses(ctx, grpcReq)
+	if err != nil {
+		util.HandleGRPCError(w, err)
+		return
+	}
+
+	courses := make([]*eligibleCourseView, 0, len(grpcResp.Courses))
+	for _, ec := range grpcResp.Courses {
+		courses = append(courses, &eligibleCourseView{EligibleCourse: ec, Course: toCourseView(ec.Course)})
+	}
+
+	response := map[string]interface{}{
+		"success":        true,
+		"courses":        courses,
+		"eligible_count": grpcResp.EligibleCount,
+	}
+
+	util.WriteJSON(w, http.StatusOK, response)
+}
+
+// AddCourseMaterial handles POST /faculty/courses/:id/materials
+// Attaches a syllabus or resource link (assigned faculty or admin only).
+func (h *CourseHandler) AddCourseMaterial(w http.ResponseWriter, r *http.Request) {
+	// 1. Authorization: faculty or admin (the service checks the course assignment)
+	user := getUserFromContext(r)
+	if user == nil || (user.Role != "faculty" && user.Role != "admin") {
+		util.WriteJSONError(w, http.StatusForbidden, "Access denied: Only faculty or admins can manage course materials")
+		return
+	}
+
+	courseID := chi.URLParam(r, "id")
+	if courseID == "" {
+		util.WriteJSONError(w, http.StatusBadRequest, "Course ID is required")
+		return
+	}
+
+	// 2. Decode Request Body
+	var reqBody RESTAddCourseMaterialRequest
+	if !util.DecodeJSON(w, r, &reqBody) {
+		return
+	}
+
+	// 3. Call gRPC Service
+	grpcReq := &pb_course.AddCourseMaterialRequest{
+		CourseId: courseID,
+		UserId:   user.Id,
+		Title:    reqBody.Title,
+		Url:      reqBody.URL,
+	}
+
+	ctx := r.Context()
+
+	grpcResp, err := h.CourseClient.AddCourseMaterial(ctx, grpcReq)
+	if err != nil {
+		util.HandleGRPCError(w, err)
+		return
+	}
+
+	util.WriteJSON(w, http.StatusCreated, map[string]interface{}{
+		"success":  true,
+		"material": grpcResp.Material,
+		"message":  grpcResp.Message,
+	})
+}
+
+// RemoveCourseMaterial handles DELETE /faculty/courses/:id/materials/:material_id
+func (h *CourseHandler) RemoveCourseMaterial(w http.ResponseWriter, r *http.Request) {
+	user := getUserFromContext(r)
+	if user == nil || (user.Role != "faculty" && user.Role != "admin") {
+		util.WriteJSONError(w, http.StatusForbidden, "Access denied: Only faculty or admins can manage course materials")
+		return
+	}
+
+	grpcReq := &pb_course.RemoveCourseMaterialRequest{
+		CourseId:   chi.URLParam(r, "id"),
+		UserId:     user.Id,
+		MaterialId: chi.URLParam(r, "material_id"),
+	}
+
+	ctx := r.Context()
+
+	grpcResp, err := h.CourseClient.RemoveCourseMaterial(ctx, grpcReq)
+	if err != nil {
+		util.HandleGRPCError(w, err)
+		return
+	}
+
+	util.WriteJSON(w, http.StatusOK, map[string]interface{}{
+		"success": true,
+		"message": grpcResp.Message,
+	})
+}
+
+// SubmitReview handles POST /courses/:id/reviews
+// Lets the logged-in student evaluate a course once their grade is published.
+func (h *CourseHandler) SubmitReview(w http.ResponseWriter, r *http.Request) {
+	user := getUserFromContext(r)
+	if user == nil || user.Role != "student" {
+		util.WriteJSONError(w, http.StatusForbidden, "Access denied: Only students can review courses")
+		return
+	}
+
+	var reqBody RESTSubmitReviewRequest
+	if !util.DecodeJSON(w, r, &reqBody) {
+		return
+	}
+	if reqBody.EnrollmentID == "" {
+		util.WriteJSONError(w, http.StatusBadRequest, "enrollment_id is required")
+		return
+	}
+
+	ctx := r.Context()
+
+	grpcResp, err := h.CourseClient.SubmitReview(ctx, &pb_course.SubmitReviewRequest{
+		CourseId:     chi.URLParam(r, "id"),
+		StudentId:    user.StudentId, // Trusting the token's student ID
+		EnrollmentId: reqBody.EnrollmentID,
+		Ratings: &pb_course.ReviewRatings{
+			Content:      reqBody.Ratings.Content,
+			Instruction:  reqBody.Ratings.Instruction,
+			Organization: reqBody.Ratings.Organization,
+			Overall:      reqBody.Ratings.Overall,
+		},
+		Comment: reqBody.Comment,
+	})
+	if err != nil {
+		util.HandleGRPCError(w, err)
+		return
+	}
+
+	util.WriteJSON(w, http.StatusCreated, map[string]interface{}{
+		"success":   grpcResp.Success,
+		"review_id": grpcResp.ReviewId,
+		"message":   grpcResp.Message,
+	})
+}
+
+// GetCourseReviewSummary handles GET /faculty/courses/:id/reviews/summary
+// Faculty see averages for the courses they teach; admins also see the comments.
+// Query Params: semester (optional)
+func (h *CourseHandler) GetCourseReviewSummary(w http.ResponseWriter, r *http.Request) {
+	user := getUserFromContext(r)
+	if user == nil || (user.Role != "faculty" && user.Role != "admin") {
+		util.WriteJSONError(w, http.StatusForbidden, "Access denied: Only faculty or admins can view course reviews")
+		return
+	}
+
+	ctx := r.Context()
+
+	grpcResp, err := h.CourseClient.GetCourseReviewSummary(ctx, &pb_course.GetCourseReviewSummaryRequest{
+		CourseId: chi.URLParam(r, "id"),
+		UserId:   user.Id,
+		Semester: r.URL.Query().Get("semester"),
+	})
+	if err != nil {
+		util.HandleGRPCError(w, err)
+		return
+	}
+
+	averages := grpcResp.GetAverages()
+	response := map[string]interface{}{
+		"course_id":    grpcResp.CourseId,
+		"review_count": grpcResp.ReviewCount,
+		"averages": reviewAveragesView{
+			Content:      averages.GetContent(),
+			Instruction:  averages.GetInstruction(),
+			Organization: averages.GetOrganization(),
+			Overall:      averages.GetOverall(),
+		},
+	}
+	if user.Role == "admin" {
+		comments := grpcResp.Comments
+		if comments == nil {
+			comments = []*pb_course.ReviewComment{}
+		}
+		response["comments"] = comments
+	}
+
+	util.WriteJSON(w, http.StatusOK, response)
+}
+
+// GetCatalogCacheStats handles GET /admin/catalog-cache
+// Reports the course service's catalog cache counters since it started.
+func (h *CourseHandler) GetCatalogCacheStats(w http.ResponseWriter, r *http.Request) {
+	adminUser, isAdmin := getAdminFromContext(r)
+	if !isAdmin {
+		util.WriteJSONError(w, http.StatusForbidden, "Access denied: Admin only")
+		return
+	}
+
+	grpcResp, err := h.CourseClient.GetCatalogCacheStats(r.Context(), &pb_course.GetCatalogCacheStatsRequest{UserId: adminUser.Id})
+	if err != nil {
+		util.HandleGRPCError(w, err)
+		return
+	}
+
+	util.WriteJSON(w, http.StatusOK, map[string]interface{}{
+		"success":  true,
+		"hits":     grpcResp.Hits,
+		"misses":   grpcResp.Misses,
+		"hit_rate": grpcResp.HitRate,
+		"entries":  grpcResp.Entries,
+	})
+}
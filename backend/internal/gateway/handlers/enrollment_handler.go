@@ -1,10 +1,9 @@
 package handlers
 
 import (
-	"context"
-	"encoding/json"
+	"fmt"
 	"net/http"
-	"time"
+	"strconv"
 
 	"github.com/go-chi/chi/v5"
 
@@ -28,6 +27,13 @@ type RESTDropCourseRequest struct {
 	CourseID string `json:"course_id"`
 }
 
+// RESTSuggestSchedulesRequest mirrors the JSON input for POST /enrollment/suggest-schedules
+type RESTSuggestSchedulesRequest struct {
+	CourseCodes []string `json:"course_codes"`
+	Semester    string   `json:"semester,omitempty"`
+	MaxResults  int32    `json:"max_results,omitempty"`
+}
+
 // Helper to get student_id from context
 func getStudentID(r *http.Request) (string, error) {
 	user, ok := r.Context().Value("user").(*pb_auth.User)
@@ -52,8 +58,7 @@ func (h *EnrollmentHandler) GetCart(w http.ResponseWriter, r *http.Request) {
 		StudentId: studentID,
 	}
 
-	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
-	defer cancel()
+	ctx := r.Context()
 
 	grpcResp, err := h.EnrollmentClient.GetCart(ctx, grpcReq)
 	if err != nil {
@@ -62,13 +67,41 @@ func (h *EnrollmentHandler) GetCart(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// According to REST doc, respond with { success: true, cart: {...} }
+	// The effective limits let the page show "4 of 6 courses"
 	response := map[string]interface{}{
-		"success": true,
-		"cart":    grpcResp.Cart,
+		"success":     true,
+		"cart":        grpcResp.Cart,
+		"max_courses": grpcResp.MaxCourses,
+		"max_units":   grpcResp.MaxUnits,
 	}
 	util.WriteJSON(w, http.StatusOK, response)
 }
 
+// GetCartSummary handles GET /cart/summary, the counts behind the cart badge
+func (h *EnrollmentHandler) GetCartSummary(w http.ResponseWriter, r *http.Request) {
+	studentID, err := getStudentID(r)
+	if err != nil {
+		util.WriteJSONError(w, http.StatusForbidden, "Access denied: Only students have shopping carts")
+		return
+	}
+
+	ctx := r.Context()
+
+	grpcResp, err := h.EnrollmentClient.GetCartSummary(ctx, &pb_enrollment.GetCartSummaryRequest{StudentId: studentID})
+	if err != nil {
+		util.HandleGRPCError(w, err)
+		return
+	}
+
+	// Counts are written explicitly so an empty cart reports zeros rather than omitting them
+	util.WriteJSON(w, http.StatusOK, map[string]interface{}{
+		"success":     true,
+		"item_count":  grpcResp.ItemCount,
+		"total_units": grpcResp.TotalUnits,
+		"message":     grpcResp.Message,
+	})
+}
+
 // AddToCart handles POST /cart/add
 func (h *EnrollmentHandler) AddToCart(w http.ResponseWriter, r *http.Request) {
 	studentID, err := getStudentID(r)
@@ -78,8 +111,7 @@ func (h *EnrollmentHandler) AddToCart(w http.ResponseWriter, r *http.Request) {
 	}
 
 	var reqBody RESTAddToCartRequest
-	if err := json.NewDecoder(r.Body).Decode(&reqBody); err != nil {
-		util.WriteJSONError(w, http.StatusBadRequest, "Invalid request body")
+	if !util.DecodeJSON(w, r, &reqBody) {
 		return
 	}
 
@@ -93,8 +125,7 @@ func (h *EnrollmentHandler) AddToCart(w http.ResponseWriter, r *http.Request) {
 		CourseId:  reqBody.CourseID,
 	}
 
-	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
-	defer cancel()
+	ctx := r.Context()
 
 	grpcResp, err := h.EnrollmentClient.AddToCart(ctx, grpcReq)
 	if err != nil {
@@ -135,8 +166,7 @@ func (h *EnrollmentHandler) RemoveFromCart(w http.ResponseWriter, r *http.Reques
 		CourseId:  courseID,
 	}
 
-	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
-	defer cancel()
+	ctx := r.Context()
 
 	grpcResp, err := h.EnrollmentClient.RemoveFromCart(ctx, grpcReq)
 	if err != nil {
@@ -164,8 +194,7 @@ func (h *EnrollmentHandler) ClearCart(w http.ResponseWriter, r *http.Request) {
 		StudentId: studentID,
 	}
 
-	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
-	defer cancel()
+	ctx := r.Context()
 
 	grpcResp, err := h.EnrollmentClient.ClearCart(ctx, grpcReq)
 	if err != nil {
@@ -193,8 +222,7 @@ func (h *EnrollmentHandler) EnrollAll(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Enrollment might take slightly longer due to transactional checks
-	ctx, cancel := context.WithTimeout(r.Context(), 10*time.Second)
-	defer cancel()
+	ctx := r.Context()
 
 	grpcResp, err := h.EnrollmentClient.EnrollAll(ctx, grpcReq)
 	if err != nil {
@@ -206,6 +234,7 @@ func (h *EnrollmentHandler) EnrollAll(w http.ResponseWriter, r *http.Request) {
 		// This could contain partial failures or a total rollback
 		response := map[string]interface{}{
 			"success":        false,
+			"code":           grpcResp.ErrorCode,
 			"message":        grpcResp.Message,
 			"failed_courses": grpcResp.FailedCourses,
 		}
@@ -218,10 +247,67 @@ func (h *EnrollmentHandler) EnrollAll(w http.ResponseWriter, r *http.Request) {
 		"message":        grpcResp.Message,
 		"enrollments":    grpcResp.Enrollments,
 		"failed_courses": grpcResp.FailedCourses,
+		"receipt":        grpcResp.Receipt,
+		"warnings":       grpcResp.Warnings,
 	}
 	util.WriteJSON(w, http.StatusOK, response)
 }
 
+// GetEnrollmentReceipt handles GET /enrollment/receipts/:reference_id
+// Students can fetch their own receipts; admins (registrar) can fetch any.
+func (h *EnrollmentHandler) GetEnrollmentReceipt(w http.ResponseWriter, r *http.Request) {
+	user, ok := r.Context().Value("user").(*pb_auth.User)
+	if !ok || user == nil || (user.Role != "student" && user.Role != "admin") {
+		util.WriteJSONError(w, http.StatusForbidden, "Access denied: Only students and admins can view receipts")
+		return
+	}
+
+	grpcReq := &pb_enrollment.GetEnrollmentReceiptRequest{
+		ReferenceId: chi.URLParam(r, "reference_id"),
+	}
+	// Scope students to their own receipts (others' look like not found)
+	if user.Role == "student" {
+		grpcReq.StudentId = user.StudentId
+	}
+
+	ctx := r.Context()
+
+	grpcResp, err := h.EnrollmentClient.GetEnrollmentReceipt(ctx, grpcReq)
+	if err != nil {
+		util.HandleGRPCError(w, err)
+		return
+	}
+
+	util.WriteJSON(w, http.StatusOK, map[string]interface{}{
+		"success": true,
+		"receipt": grpcResp.Receipt,
+	})
+}
+
+// GetEnrollmentStatus handles GET /enrollment/status
+// Any signed-in user can see the current phase (preview, open, closed) and what it allows.
+func (h *EnrollmentHandler) GetEnrollmentStatus(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	grpcResp, err := h.EnrollmentClient.GetEnrollmentStatus(ctx, &pb_enrollment.GetEnrollmentStatusRequest{})
+	if err != nil {
+		util.HandleGRPCError(w, err)
+		return
+	}
+
+	util.WriteJSON(w, http.StatusOK, map[string]interface{}{
+		"success":          true,
+		"phase":            grpcResp.Phase,
+		"enrollment_start": grpcResp.EnrollmentStart,
+		"enrollment_end":   grpcResp.EnrollmentEnd,
+		"drop_deadline":    grpcResp.DropDeadline,
+		"can_add_to_cart":  grpcResp.CanAddToCart,
+		"can_enroll":       grpcResp.CanEnroll,
+		"can_drop":         grpcResp.CanDrop,
+		"message":          grpcResp.Message,
+	})
+}
+
 // DropCourse handles POST /enrollment/drop
 func (h *EnrollmentHandler) DropCourse(w http.ResponseWriter, r *http.Request) {
 	studentID, err := getStudentID(r)
@@ -231,8 +317,7 @@ func (h *EnrollmentHandler) DropCourse(w http.ResponseWriter, r *http.Request) {
 	}
 
 	var reqBody RESTDropCourseRequest
-	if err := json.NewDecoder(r.Body).Decode(&reqBody); err != nil {
-		util.WriteJSONError(w, http.StatusBadRequest, "Invalid request body")
+	if !util.DecodeJSON(w, r, &reqBody) {
 		return
 	}
 
@@ -246,8 +331,7 @@ func (h *EnrollmentHandler) DropCourse(w http.ResponseWriter, r *http.Request) {
 		CourseId:  reqBody.CourseID,
 	}
 
-	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
-	defer cancel()
+	ctx := r.Context()
 
 	grpcResp, err := h.EnrollmentClient.DropCourse(ctx, grpcReq)
 	if err != nil {
@@ -268,6 +352,7 @@ func (h *EnrollmentHandler) DropCourse(w http.ResponseWriter, r *http.Request) {
 }
 
 // GetStudentEnrollments handles GET /enrollment/schedule
+// Query Params: semester, status, page, page_size (all optional)
 func (h *EnrollmentHandler) GetStudentEnrollments(w http.ResponseWriter, r *http.Request) {
 	studentID, err := getStudentID(r)
 	if err != nil {
@@ -284,9 +369,16 @@ func (h *EnrollmentHandler) GetStudentEnrollments(w http.ResponseWriter, r *http
 		Semester:  semester,
 		Status:    status,
 	}
+	if grpcReq.Page, err = positiveQueryInt(r, "page"); err != nil {
+		util.WriteJSONError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	if grpcReq.PageSize, err = positiveQueryInt(r, "page_size"); err != nil {
+		util.WriteJSONError(w, http.StatusBadRequest, err.Error())
+		return
+	}
 
-	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
-	defer cancel()
+	ctx := r.Context()
 
 	grpcResp, err := h.EnrollmentClient.GetStudentEnrollments(ctx, grpcReq)
 	if err != nil {
@@ -299,6 +391,99 @@ func (h *EnrollmentHandler) GetStudentEnrollments(w http.ResponseWriter, r *http
 		"success":     true,
 		"enrollments": grpcResp.Enrollments,
 		"total_units": grpcResp.TotalUnits,
+		"total_count": grpcResp.TotalCount,
+		"page":        grpcResp.Page,
+		"page_size":   grpcResp.PageSize,
+
+		"max_drops_per_semester": grpcResp.MaxDropsPerSemester,
+		"remaining_drops":        grpcResp.RemainingDrops,
+		"drops_semester":         grpcResp.DropsSemester,
+	}
+	util.WriteJSON(w, http.StatusOK, response)
+}
+
+// GetDropHistory handles GET /enrollment/drops
+// Query Params: semester (optional)
+func (h *EnrollmentHandler) GetDropHistory(w http.ResponseWriter, r *http.Request) {
+	studentID, err := getStudentID(r)
+	if err != nil {
+		util.WriteJSONError(w, http.StatusForbidden, "Access denied")
+		return
+	}
+
+	grpcReq := &pb_enrollment.GetDropHistoryRequest{
+		StudentId: studentID,
+		Semester:  r.URL.Query().Get("semester"),
+	}
+
+	grpcResp, err := h.EnrollmentClient.GetDropHistory(r.Context(), grpcReq)
+	if err != nil {
+		util.HandleGRPCError(w, err)
+		return
+	}
+
+	response := map[string]interface{}{
+		"success":                true,
+		"message":                grpcResp.Message,
+		"drops":                  grpcResp.Drops,
+		"max_drops_per_semester": grpcResp.MaxDropsPerSemester,
+	}
+	util.WriteJSON(w, http.StatusOK, response)
+}
+
+// SuggestSchedules handles POST /enrollment/suggest-schedules
+// Results are suggestions only; the student still adds the sections to the cart
+func (h *EnrollmentHandler) SuggestSchedules(w http.ResponseWriter, r *http.Request) {
+	studentID, err := getStudentID(r)
+	if err != nil {
+		util.WriteJSONError(w, http.StatusForbidden, "Access denied")
+		return
+	}
+
+	var reqBody RESTSuggestSchedulesRequest
+	if !util.DecodeJSON(w, r, &reqBody) {
+		return
+	}
+
+	if len(reqBody.CourseCodes) == 0 {
+		util.WriteJSONError(w, http.StatusBadRequest, "course_codes is required")
+		return
+	}
+
+	grpcReq := &pb_enrollment.SuggestSchedulesRequest{
+		StudentId:   studentID,
+		CourseCodes: reqBody.CourseCodes,
+		Semester:    reqBody.Semester,
+		MaxResults:  reqBody.MaxResults,
+	}
+
+	grpcResp, err := h.EnrollmentClient.SuggestSchedules(r.Context(), grpcReq)
+	if err != nil {
+		util.HandleGRPCError(w, err)
+		return
+	}
+
+	response := map[string]interface{}{
+		"success":           true,
+		"message":           grpcResp.Message,
+		"semester":          grpcResp.Semester,
+		"schedules":         grpcResp.Schedules,
+		"unavailable_codes": grpcResp.UnavailableCodes,
+		"skipped_codes":     grpcResp.SkippedCodes,
+		"partial":           grpcResp.Partial,
 	}
 	util.WriteJSON(w, http.StatusOK, response)
 }
+
+// positiveQueryInt reads an optional positive integer query parameter (0 when absent)
+func positiveQueryInt(r *http.Request, param string) (int32, error) {
+	v := r.URL.Query().Get(param)
+	if v == "" {
+		return 0, nil
+	}
+	n, err := strconv.ParseInt(v, 10, 32)
+	if err != nil || n < 1 {
+		return 0, fmt.Errorf("%s must be a positive integer", param)
+	}
+	return int32(n), nil
+}
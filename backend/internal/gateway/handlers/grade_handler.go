@@ -1,12 +1,17 @@
 package handlers
 
 import (
-	"context"
+	"encoding/csv"
 	"encoding/json"
+	"fmt"
+	"log"
 	"net/http"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/go-chi/chi/v5"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"stdiscm_p4/backend/internal/gateway/util"      // Gateway utility package
 	pb_auth "stdiscm_p4/backend/internal/pb/auth"   // For context user role checks
@@ -28,6 +33,35 @@ type RESTGradeEntry struct {
 	Grade     string `json:"grade"`
 }
 
+// RESTSimulateGPARequest mirrors the JSON input for POST /student/gpa/simulate
+type RESTSimulateGPARequest struct {
+	Grades []RESTHypotheticalGrade `json:"grades"`
+}
+
+type RESTHypotheticalGrade struct {
+	CourseID string `json:"course_id"`
+	Grade    string `json:"grade"`
+}
+
+// RESTSubmitGradeAppealRequest mirrors the JSON input for POST /grades/appeals
+type RESTSubmitGradeAppealRequest struct {
+	EnrollmentID string `json:"enrollment_id"`
+	Reason       string `json:"reason"`
+}
+
+// RESTResolveGradeAppealRequest mirrors the JSON input for POST /grades/appeals/:id/resolve
+type RESTResolveGradeAppealRequest struct {
+	Decision string `json:"decision"`  // "approved" or "denied"
+	NewGrade string `json:"new_grade"` // required when approved
+	Comment  string `json:"comment"`
+}
+
+// RESTSaveGradeDraftRequest mirrors the JSON input for PUT /faculty/courses/:id/grade-draft
+type RESTSaveGradeDraftRequest struct {
+	Grades  map[string]string `json:"grades"`  // student_id -> grade; "" removes the student
+	Replace bool              `json:"replace"` // replace the whole draft instead of merging
+}
+
 // helper to get user from context
 func getUserFromContext(r *http.Request) *pb_auth.User {
 	user, ok := r.Context().Value("user").(*pb_auth.User)
@@ -37,9 +71,9 @@ func getUserFromContext(r *http.Request) *pb_auth.User {
 	return user
 }
 
-// GetStudentGrades handles GET /grades
-// Retrieves grades for the logged-in student.
-// Query Params: semester (optional)
+// GetStudentGrades handles GET /grades and GET /student/grades
+// Retrieves grades for the logged-in student; the student ID always comes from the token.
+// Query Params: semester, page, page_size (all optional)
 func (h *GradeHandler) GetStudentGrades(w http.ResponseWriter, r *http.Request) {
 	// 1. Authorization: Verify user is a student
 	user := getUserFromContext(r)
@@ -48,18 +82,53 @@ func (h *GradeHandler) GetStudentGrades(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
+	h.writeStudentGrades(w, r, user.StudentId) // Trusting the token's student ID
+}
+
+// GetStudentGradesByID handles GET /students/:id/grades
+// Lets faculty and admins look up any student's grades. Students are refused even
+// for their own ID and use /student/grades instead, so changing the ID in the URL
+// can never expose another student's record.
+// Query Params: semester, page, page_size (all optional)
+func (h *GradeHandler) GetStudentGradesByID(w http.ResponseWriter, r *http.Request) {
+	user := getUserFromContext(r)
+	if user == nil || (user.Role != "faculty" && user.Role != "admin") {
+		util.WriteJSONError(w, http.StatusForbidden, "Access denied: Only faculty or admins can view a student's grades by ID")
+		return
+	}
+
+	studentID := chi.URLParam(r, "id")
+	if studentID == "" {
+		util.WriteJSONError(w, http.StatusBadRequest, "Student ID is required")
+		return
+	}
+
+	h.writeStudentGrades(w, r, studentID)
+}
+
+// writeStudentGrades fetches one page of a student's grades; callers have already
+// decided the caller may see them
+func (h *GradeHandler) writeStudentGrades(w http.ResponseWriter, r *http.Request, studentID string) {
 	// 2. Extract Query Parameters
 	semester := r.URL.Query().Get("semester")
 
 	// 3. Prepare gRPC Request
 	grpcReq := &pb_grade.GetStudentGradesRequest{
-		StudentId: user.StudentId, // Trusting the token's student ID
+		StudentId: studentID,
 		Semester:  semester,
 	}
+	var err error
+	if grpcReq.Page, err = positiveQueryInt(r, "page"); err != nil {
+		util.WriteJSONError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	if grpcReq.PageSize, err = positiveQueryInt(r, "page_size"); err != nil {
+		util.WriteJSONError(w, http.StatusBadRequest, err.Error())
+		return
+	}
 
 	// 4. Call gRPC Service
-	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
-	defer cancel()
+	ctx := r.Context()
 
 	grpcResp, err := h.GradeClient.GetStudentGrades(ctx, grpcReq)
 	if err != nil {
@@ -69,9 +138,12 @@ func (h *GradeHandler) GetStudentGrades(w http.ResponseWriter, r *http.Request)
 
 	// 5. Map and Respond
 	response := map[string]interface{}{
-		"success":  true,
-		"grades":   grpcResp.Grades,
-		"gpa_info": grpcResp.GpaInfo,
+		"success":     true,
+		"grades":      grpcResp.Grades,
+		"gpa_info":    grpcResp.GpaInfo,
+		"total_count": grpcResp.TotalCount,
+		"page":        grpcResp.Page,
+		"page_size":   grpcResp.PageSize,
 	}
 
 	util.WriteJSON(w, http.StatusOK, response)
@@ -98,8 +170,7 @@ func (h *GradeHandler) CalculateGPA(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// 4. Call gRPC Service
-	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
-	defer cancel()
+	ctx := r.Context()
 
 	grpcResp, err := h.GradeClient.CalculateGPA(ctx, grpcReq)
 	if err != nil {
@@ -117,6 +188,69 @@ func (h *GradeHandler) CalculateGPA(w http.ResponseWriter, r *http.Request) {
 	util.WriteJSON(w, http.StatusOK, response)
 }
 
+// SimulateGPA handles POST /student/gpa/simulate
+// Projects the student's GPA for hypothetical grades; nothing is saved.
+func (h *GradeHandler) SimulateGPA(w http.ResponseWriter, r *http.Request) {
+	user := getUserFromContext(r)
+	if user == nil || user.Role != "student" {
+		util.WriteJSONError(w, http.StatusForbidden, "Access denied: Only students can simulate their GPA")
+		return
+	}
+
+	var reqBody RESTSimulateGPARequest
+	if !util.DecodeJSON(w, r, &reqBody) {
+		return
+	}
+	if len(reqBody.Grades) == 0 {
+		util.WriteJSONError(w, http.StatusBadRequest, "grades must list at least one course")
+		return
+	}
+
+	grpcReq := &pb_grade.SimulateGPARequest{StudentId: user.StudentId}
+	for _, g := range reqBody.Grades {
+		grpcReq.Grades = append(grpcReq.Grades, &pb_grade.HypotheticalGrade{CourseId: g.CourseID, Grade: g.Grade})
+	}
+
+	grpcResp, err := h.GradeClient.SimulateGPA(r.Context(), grpcReq)
+	if err != nil {
+		util.HandleGRPCError(w, err)
+		return
+	}
+
+	util.WriteJSON(w, http.StatusOK, map[string]interface{}{
+		"success":  grpcResp.Success,
+		"gpa_info": grpcResp.GpaInfo,
+		"term":     grpcResp.Term,
+		"message":  grpcResp.Message,
+	})
+}
+
+// GetStudentSemesters handles GET /grades/semesters
+// Lists the semesters (oldest first) in which the student has published grades.
+func (h *GradeHandler) GetStudentSemesters(w http.ResponseWriter, r *http.Request) {
+	// 1. Authorization: Verify user is a student
+	user := getUserFromContext(r)
+	if user == nil || user.Role != "student" {
+		util.WriteJSONError(w, http.StatusForbidden, "Access denied: Only students can view their semesters")
+		return
+	}
+
+	// 2. Call gRPC Service
+	grpcResp, err := h.GradeClient.GetStudentSemesters(r.Context(), &pb_grade.GetStudentSemestersRequest{
+		StudentId: user.StudentId,
+	})
+	if err != nil {
+		util.HandleGRPCError(w, err)
+		return
+	}
+
+	// 3. Map and Respond
+	util.WriteJSON(w, http.StatusOK, map[string]interface{}{
+		"success":   true,
+		"semesters": grpcResp.Semesters,
+	})
+}
+
 // GetClassRoster handles GET /grades/roster/:course_id
 // Retrieves the class roster for a specific course (Faculty only).
 func (h *GradeHandler) GetClassRoster(w http.ResponseWriter, r *http.Request) {
@@ -140,8 +274,7 @@ func (h *GradeHandler) GetClassRoster(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// 4. Call gRPC Service
-	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
-	defer cancel()
+	ctx := r.Context()
 
 	grpcResp, err := h.GradeClient.GetClassRoster(ctx, grpcReq)
 	if err != nil {
@@ -157,13 +290,89 @@ func (h *GradeHandler) GetClassRoster(w http.ResponseWriter, r *http.Request) {
 		"course_title":   grpcResp.CourseTitle,
 		"students":       grpcResp.Students,
 		"total_students": grpcResp.TotalStudents,
+		"withdrawn":      grpcResp.Withdrawn,
+		"summary":        grpcResp.Summary,
 	}
 
 	util.WriteJSON(w, http.StatusOK, response)
 }
 
+// rosterCSVFlushEvery is how many CSV rows are written between flushes to the client
+const rosterCSVFlushEvery = 100
+
+// ExportClassRoster handles GET /faculty/courses/:id/roster.csv
+// Streams the class roster as a CSV download (Faculty teaching the course only).
+func (h *GradeHandler) ExportClassRoster(w http.ResponseWriter, r *http.Request) {
+	// 1. Authorization: Verify user is faculty
+	user := getUserFromContext(r)
+	if user == nil || user.Role != "faculty" {
+		util.WriteJSONError(w, http.StatusForbidden, "Access denied: Only faculty can export rosters")
+		return
+	}
+
+	// 2. Extract Path Variable
+	courseID := chi.URLParam(r, "id")
+	if courseID == "" {
+		util.WriteJSONError(w, http.StatusBadRequest, "course_id is required")
+		return
+	}
+
+	// 3. Call gRPC Service (FacultyId makes the service reject faculty who don't teach the course)
+	ctx := r.Context()
+
+	grpcResp, err := h.GradeClient.GetClassRoster(ctx, &pb_grade.GetClassRosterRequest{
+		CourseId:  courseID,
+		FacultyId: user.Id,
+	})
+	if err != nil {
+		util.HandleGRPCError(w, err)
+		return
+	}
+
+	// 4. Stream CSV rows, flushing periodically so large rosters are not buffered
+	filename := fmt.Sprintf("%s_roster.csv", grpcResp.CourseCode)
+	w.Header().Set("Content-Type", "text/csv; charset=utf-8")
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filename))
+	w.WriteHeader(http.StatusOK)
+
+	flusher, _ := w.(http.Flusher)
+	writer := csv.NewWriter(w)
+	flush := func() {
+		writer.Flush()
+		if flusher != nil {
+			flusher.Flush()
+		}
+	}
+
+	if err := writer.Write([]string{"student_id", "name", "email", "major", "year_level", "current_grade"}); err != nil {
+		log.Printf("Roster export for %s aborted: %v", courseID, err)
+		return
+	}
+
+	for i, s := range grpcResp.Students {
+		row := []string{
+			s.StudentId,
+			s.StudentName,
+			s.Email,
+			s.Major,
+			strconv.Itoa(int(s.YearLevel)),
+			s.Grade,
+		}
+		if err := writer.Write(row); err != nil {
+			// Headers are already sent; the client sees a truncated file
+			log.Printf("Roster export for %s aborted: %v", courseID, err)
+			return
+		}
+		if (i+1)%rosterCSVFlushEvery == 0 {
+			flush()
+		}
+	}
+	flush()
+}
+
 // GetCourseGrades handles GET /grades/course/:course_id
-// Retrieves all grades uploaded for a specific course (Faculty only).
+// Retrieves grades uploaded for a specific course (Faculty only).
+// Query Params: page, page_size, published ("true" or "false") (all optional)
 func (h *GradeHandler) GetCourseGrades(w http.ResponseWriter, r *http.Request) {
 	// 1. Authorization: Verify user is faculty
 	user := getUserFromContext(r)
@@ -184,14 +393,24 @@ func (h *GradeHandler) GetCourseGrades(w http.ResponseWriter, r *http.Request) {
 	grpcReq := &pb_grade.GetCourseGradesRequest{
 		CourseId:  courseID,
 		FacultyId: user.Id,
+		Published: r.URL.Query().Get("published"),
+	}
+	var err error
+	if grpcReq.Page, err = positiveQueryInt(r, "page"); err != nil {
+		util.WriteJSONError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	if grpcReq.PageSize, err = positiveQueryInt(r, "page_size"); err != nil {
+		util.WriteJSONError(w, http.StatusBadRequest, err.Error())
+		return
 	}
 
 	// 4. Call gRPC Service
-	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
-	defer cancel()
+	ctx := r.Context()
 
 	grpcResp, err := h.GradeClient.GetCourseGrades(ctx, grpcReq)
 	if err != nil {
+		// PermissionDenied (not this course's faculty) -> 403, NotFound -> 404
 		util.HandleGRPCError(w, err)
 		return
 	}
@@ -202,6 +421,9 @@ func (h *GradeHandler) GetCourseGrades(w http.ResponseWriter, r *http.Request) {
 		"grades":        grpcResp.Grades,
 		"total_grades":  grpcResp.TotalGrades,
 		"all_published": grpcResp.AllPublished,
+		"total_count":   grpcResp.TotalCount,
+		"page":          grpcResp.Page,
+		"page_size":     grpcResp.PageSize,
 	}
 
 	util.WriteJSON(w, http.StatusOK, response)
@@ -225,8 +447,7 @@ func (h *GradeHandler) UploadGrades(w http.ResponseWriter, r *http.Request) {
 	}
 
 	var reqBody RESTUploadGradesRequest
-	if err := json.NewDecoder(r.Body).Decode(&reqBody); err != nil {
-		util.WriteJSONError(w, http.StatusBadRequest, "Invalid request body")
+	if !util.DecodeJSON(w, r, &reqBody) {
 		return
 	}
 
@@ -236,8 +457,7 @@ func (h *GradeHandler) UploadGrades(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// 3. Initiate Stream
-	ctx, cancel := context.WithTimeout(r.Context(), 30*time.Second) // Longer timeout for bulk uploads
-	defer cancel()
+	ctx := r.Context()
 
 	stream, err := h.GradeClient.UploadGrades(ctx)
 	if err != nil {
@@ -258,8 +478,10 @@ func (h *GradeHandler) UploadGrades(w http.ResponseWriter, r *http.Request) {
 		IsLast: false,
 	}
 
+	// A failed Send only reports io.EOF; the stream status carries the real cause
 	if err := stream.Send(metaReq); err != nil {
-		util.WriteJSONError(w, http.StatusInternalServerError, "Failed to stream metadata: "+err.Error())
+		_, err = stream.CloseAndRecv()
+		util.HandleGRPCError(w, err)
 		return
 	}
 
@@ -278,7 +500,8 @@ func (h *GradeHandler) UploadGrades(w http.ResponseWriter, r *http.Request) {
 		}
 
 		if err := stream.Send(req); err != nil {
-			util.WriteJSONError(w, http.StatusInternalServerError, "Failed to stream grade entry: "+err.Error())
+			_, err = stream.CloseAndRecv()
+			util.HandleGRPCError(w, err)
 			return
 		}
 	}
@@ -328,8 +551,7 @@ func (h *GradeHandler) PublishGrades(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// 4. Call gRPC Service
-	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
-	defer cancel()
+	ctx := r.Context()
 
 	grpcResp, err := h.GradeClient.PublishGrades(ctx, grpcReq)
 	if err != nil {
@@ -352,3 +574,494 @@ func (h *GradeHandler) PublishGrades(w http.ResponseWriter, r *http.Request) {
 
 	util.WriteJSON(w, http.StatusOK, response)
 }
+
+// GetGradeDraft handles GET /faculty/courses/:id/grade-draft
+// Returns the faculty member's autosaved grades for a course.
+func (h *GradeHandler) GetGradeDraft(w http.ResponseWriter, r *http.Request) {
+	user := getUserFromContext(r)
+	if user == nil || user.Role != "faculty" {
+		util.WriteJSONError(w, http.StatusForbidden, "Access denied: Only faculty can view grade drafts")
+		return
+	}
+
+	courseID := chi.URLParam(r, "id")
+	if courseID == "" {
+		util.WriteJSONError(w, http.StatusBadRequest, "course_id is required")
+		return
+	}
+
+	ctx := r.Context()
+
+	grpcResp, err := h.GradeClient.GetGradeDraft(ctx, &pb_grade.GetGradeDraftRequest{
+		CourseId:  courseID,
+		FacultyId: user.Id,
+	})
+	if err != nil {
+		util.HandleGRPCError(w, err)
+		return
+	}
+
+	response := map[string]interface{}{
+		"success": true,
+		"found":   grpcResp.Found,
+		"draft":   grpcResp.Draft,
+	}
+
+	util.WriteJSON(w, http.StatusOK, response)
+}
+
+// SaveGradeDraft handles PUT /faculty/courses/:id/grade-draft
+// Autosaves grades without validating them; they are checked when the draft is finalized.
+func (h *GradeHandler) SaveGradeDraft(w http.ResponseWriter, r *http.Request) {
+	user := getUserFromContext(r)
+	if user == nil || user.Role != "faculty" {
+		util.WriteJSONError(w, http.StatusForbidden, "Access denied: Only faculty can save grade drafts")
+		return
+	}
+
+	courseID := chi.URLParam(r, "id")
+	if courseID == "" {
+		util.WriteJSONError(w, http.StatusBadRequest, "course_id is required")
+		return
+	}
+
+	var reqBody RESTSaveGradeDraftRequest
+	if !util.DecodeJSON(w, r, &reqBody) {
+		return
+	}
+
+	ctx := r.Context()
+
+	grpcResp, err := h.GradeClient.SaveGradeDraft(ctx, &pb_grade.SaveGradeDraftRequest{
+		CourseId:  courseID,
+		FacultyId: user.Id,
+		Grades:    reqBody.Grades,
+		Replace:   reqBody.Replace,
+	})
+	if err != nil {
+		util.HandleGRPCError(w, err)
+		return
+	}
+
+	response := map[string]interface{}{
+		"success": grpcResp.Success,
+		"message": grpcResp.Message,
+		"draft":   grpcResp.Draft,
+	}
+
+	util.WriteJSON(w, http.StatusOK, response)
+}
+
+// FinalizeGradeDraft handles POST /faculty/courses/:id/grade-draft/finalize
+// Uploads the draft grades, reporting the ones that failed validation.
+func (h *GradeHandler) FinalizeGradeDraft(w http.ResponseWriter, r *http.Request) {
+	user := getUserFromContext(r)
+	if user == nil || user.Role != "faculty" {
+		util.WriteJSONError(w, http.StatusForbidden, "Access denied: Only faculty can finalize grade drafts")
+		return
+	}
+
+	courseID := chi.URLParam(r, "id")
+	if courseID == "" {
+		util.WriteJSONError(w, http.StatusBadRequest, "course_id is required")
+		return
+	}
+
+	ctx := r.Context()
+
+	grpcResp, err := h.GradeClient.FinalizeDraft(ctx, &pb_grade.FinalizeDraftRequest{
+		CourseId:  courseID,
+		FacultyId: user.Id,
+	})
+	if err != nil {
+		util.HandleGRPCError(w, err)
+		return
+	}
+
+	// Partial failures are still a processed request, like UploadGrades
+	response := map[string]interface{}{
+		"success":         grpcResp.Success,
+		"message":         grpcResp.Message,
+		"total_processed": grpcResp.TotalProcessed,
+		"successful":      grpcResp.Successful,
+		"failed":          grpcResp.Failed,
+		"errors":          grpcResp.Errors,
+	}
+
+	util.WriteJSON(w, http.StatusOK, response)
+}
+
+// SubmitGradeAppeal handles POST /grades/appeals and POST /student/grades/:enrollment_id/appeal
+// Lets the logged-in student contest one of their published grades. The enrollment
+// in the path, when present, takes precedence over the body.
+func (h *GradeHandler) SubmitGradeAppeal(w http.ResponseWriter, r *http.Request) {
+	user := getUserFromContext(r)
+	if user == nil || user.Role != "student" {
+		util.WriteJSONError(w, http.StatusForbidden, "Access denied: Only students can appeal grades")
+		return
+	}
+
+	var reqBody RESTSubmitGradeAppealRequest
+	if !util.DecodeJSON(w, r, &reqBody) {
+		return
+	}
+	if id := chi.URLParam(r, "enrollment_id"); id != "" {
+		reqBody.EnrollmentID = id
+	}
+	if reqBody.EnrollmentID == "" || reqBody.Reason == "" {
+		util.WriteJSONError(w, http.StatusBadRequest, "enrollment_id and reason are required")
+		return
+	}
+
+	ctx := r.Context()
+
+	grpcResp, err := h.GradeClient.SubmitGradeAppeal(ctx, &pb_grade.SubmitGradeAppealRequest{
+		EnrollmentId: reqBody.EnrollmentID,
+		StudentId:    user.StudentId, // Trusting the token's student ID
+		Reason:       reqBody.Reason,
+	})
+	if err != nil {
+		util.HandleGRPCError(w, err)
+		return
+	}
+
+	response := map[string]interface{}{
+		"success": grpcResp.Success,
+		"message": grpcResp.Message,
+		"appeal":  grpcResp.Appeal,
+	}
+
+	util.WriteJSON(w, http.StatusCreated, response)
+}
+
+// ListGradeAppeals handles GET /grades/appeals
+// Students see their own appeals; faculty see appeals for the courses they teach.
+// Query Params: status (optional), course_id (optional, faculty only)
+func (h *GradeHandler) ListGradeAppeals(w http.ResponseWriter, r *http.Request) {
+	user := getUserFromContext(r)
+	if user == nil || (user.Role != "student" && user.Role != "faculty") {
+		util.WriteJSONError(w, http.StatusForbidden, "Access denied: Only students and faculty can view grade appeals")
+		return
+	}
+
+	grpcReq := &pb_grade.ListGradeAppealsRequest{Status: r.URL.Query().Get("status")}
+	if user.Role == "student" {
+		grpcReq.StudentId = user.StudentId
+	} else {
+		grpcReq.FacultyId = user.Id
+		grpcReq.CourseId = r.URL.Query().Get("course_id")
+	}
+
+	ctx := r.Context()
+
+	grpcResp, err := h.GradeClient.ListGradeAppeals(ctx, grpcReq)
+	if err != nil {
+		util.HandleGRPCError(w, err)
+		return
+	}
+
+	response := map[string]interface{}{
+		"success":     true,
+		"appeals":     grpcResp.Appeals,
+		"total_count": grpcResp.TotalCount,
+	}
+
+	util.WriteJSON(w, http.StatusOK, response)
+}
+
+// ListFacultyAppeals handles GET /faculty/appeals
+// The faculty-only view of ListGradeAppeals: appeals on the caller's courses.
+// Query Params: status (optional), course_id (optional)
+func (h *GradeHandler) ListFacultyAppeals(w http.ResponseWriter, r *http.Request) {
+	user := getUserFromContext(r)
+	if user == nil || user.Role != "faculty" {
+		util.WriteJSONError(w, http.StatusForbidden, "Access denied: Only faculty can view appeals on their courses")
+		return
+	}
+	h.ListGradeAppeals(w, r)
+}
+
+// ResolveGradeAppeal handles POST /grades/appeals/:id/resolve and POST /faculty/appeals/:id/resolve
+// Approving changes the grade; the student is notified of either decision.
+func (h *GradeHandler) ResolveGradeAppeal(w http.ResponseWriter, r *http.Request) {
+	user := getUserFromContext(r)
+	if user == nil || user.Role != "faculty" {
+		util.WriteJSONError(w, http.StatusForbidden, "Access denied: Only faculty can resolve grade appeals")
+		return
+	}
+
+	appealID := chi.URLParam(r, "id")
+	if appealID == "" {
+		util.WriteJSONError(w, http.StatusBadRequest, "appeal id is required")
+		return
+	}
+
+	var reqBody RESTResolveGradeAppealRequest
+	if !util.DecodeJSON(w, r, &reqBody) {
+		return
+	}
+
+	ctx := r.Context()
+
+	grpcResp, err := h.GradeClient.ResolveGradeAppeal(ctx, &pb_grade.ResolveGradeAppealRequest{
+		AppealId:  appealID,
+		FacultyId: user.Id,
+		Decision:  reqBody.Decision,
+		NewGrade:  reqBody.NewGrade,
+		Comment:   reqBody.Comment,
+	})
+	if err != nil {
+		util.HandleGRPCError(w, err)
+		return
+	}
+
+	response := map[string]interface{}{
+		"success": grpcResp.Success,
+		"message": grpcResp.Message,
+		"appeal":  grpcResp.Appeal,
+	}
+
+	util.WriteJSON(w, http.StatusOK, response)
+}
+
+// gradeStatsView renders pb_grade.GradeStats without omitempty, so a report's
+// zero averages and rates are still present in the JSON
+type gradeStatsView struct {
+	TotalGrades  int32            `json:"total_grades"`
+	Distribution map[string]int32 `json:"distribution"`
+	AverageGPA   float64          `json:"average_gpa"`
+	Passed       int32            `json:"passed"`
+	Failed       int32            `json:"failed"`
+	PassRate     float64          `json:"pass_rate"`
+	FailRate     float64          `json:"fail_rate"`
+}
+
+func toGradeStatsView(s *pb_grade.GradeStats) gradeStatsView {
+	return gradeStatsView{
+		TotalGrades:  s.GetTotalGrades(),
+		Distribution: s.GetDistribution(),
+		AverageGPA:   s.GetAverageGpa(),
+		Passed:       s.GetPassed(),
+		Failed:       s.GetFailed(),
+		PassRate:     s.GetPassRate(),
+		FailRate:     s.GetFailRate(),
+	}
+}
+
+// GetSemesterGradeReport handles GET /admin/reports/grades?semester=
+// Published grade distributions, GPA and pass rates per department and course.
+func (h *GradeHandler) GetSemesterGradeReport(w http.ResponseWriter, r *http.Request) {
+	if _, isAdmin := getAdminFromContext(r); !isAdmin {
+		util.WriteJSONError(w, http.StatusForbidden, "Access denied: Admin only")
+		return
+	}
+
+	semester := r.URL.Query().Get("semester")
+	if semester == "" {
+		util.WriteJSONError(w, http.StatusBadRequest, "semester is required")
+		return
+	}
+
+	grpcResp, err := h.GradeClient.GetSemesterGradeReport(r.Context(), &pb_grade.GetSemesterGradeReportRequest{Semester: semester})
+	if err != nil {
+		util.HandleGRPCError(w, err)
+		return
+	}
+
+	departments := make([]map[string]interface{}, 0, len(grpcResp.Departments))
+	for _, d := range grpcResp.Departments {
+		departments = append(departments, map[string]interface{}{
+			"department":   d.Department,
+			"course_count": d.CourseCount,
+			"stats":        toGradeStatsView(d.Stats),
+		})
+	}
+	courses := make([]map[string]interface{}, 0, len(grpcResp.Courses))
+	for _, c := range grpcResp.Courses {
+		courses = append(courses, map[string]interface{}{
+			"course_id":    c.CourseId,
+			"course_code":  c.CourseCode,
+			"course_title": c.CourseTitle,
+			"department":   c.Department,
+			"stats":        toGradeStatsView(c.Stats),
+		})
+	}
+
+	util.WriteJSON(w, http.StatusOK, map[string]interface{}{
+		"success":     true,
+		"semester":    grpcResp.Semester,
+		"overall":     toGradeStatsView(grpcResp.Overall),
+		"departments": departments,
+		"courses":     courses,
+	})
+}
+
+// gradeExportHeader is the CSV header of a grade export, in gradeExportRow order
+var gradeExportHeader = []string{
+	"enrollment_id", "student_id", "student_name", "course_id", "course_code", "course_title",
+	"units", "semester", "grade", "published", "published_at", "uploaded_by", "uploaded_at",
+}
+
+// gradeExportRow is one grade of the registrar extract; times are RFC 3339 in UTC
+type gradeExportRow struct {
+	EnrollmentID string `json:"enrollment_id"`
+	StudentID    string `json:"student_id"`
+	StudentName  string `json:"student_name"`
+	CourseID     string `json:"course_id"`
+	CourseCode   string `json:"course_code"`
+	CourseTitle  string `json:"course_title"`
+	Units        int32  `json:"units"`
+	Semester     string `json:"semester"`
+	Grade        string `json:"grade"`
+	Published    bool   `json:"published"`
+	PublishedAt  string `json:"published_at,omitempty"`
+	UploadedBy   string `json:"uploaded_by"`
+	UploadedAt   string `json:"uploaded_at,omitempty"`
+}
+
+// exportTime formats a grade timestamp for the extract, or "" when it is unset
+func exportTime(ts *timestamppb.Timestamp) string {
+	if ts == nil {
+		return ""
+	}
+	return ts.AsTime().UTC().Format(time.RFC3339)
+}
+
+func toGradeExportRow(g *pb_grade.Grade) gradeExportRow {
+	return gradeExportRow{
+		EnrollmentID: g.EnrollmentId,
+		StudentID:    g.StudentId,
+		StudentName:  g.StudentName,
+		CourseID:     g.CourseId,
+		CourseCode:   g.CourseCode,
+		CourseTitle:  g.CourseTitle,
+		Units:        g.Units,
+		Semester:     g.Semester,
+		Grade:        g.Grade,
+		Published:    g.Published,
+		PublishedAt:  exportTime(g.PublishedAt),
+		UploadedBy:   g.UploadedBy,
+		UploadedAt:   exportTime(g.UploadedAt),
+	}
+}
+
+func (r gradeExportRow) csvRecord() []string {
+	return []string{
+		r.EnrollmentID, r.StudentID, r.StudentName, r.CourseID, r.CourseCode, r.CourseTitle,
+		strconv.Itoa(int(r.Units)), r.Semester, r.Grade, strconv.FormatBool(r.Published),
+		r.PublishedAt, r.UploadedBy, r.UploadedAt,
+	}
+}
+
+// ExportGrades handles GET /admin/exports/grades?semester=&published_only=&format=
+// Streams the registrar extract as CSV (default) or NDJSON. Each chunk from the Grade
+// Service is written and flushed before the next is read, so a large semester is never
+// buffered. The row count is sent in the X-Total-Rows trailer, and NDJSON also ends
+// with a summary line; a response without them was cut short.
+func (h *GradeHandler) ExportGrades(w http.ResponseWriter, r *http.Request) {
+	adminUser, isAdmin := getAdminFromContext(r)
+	if !isAdmin {
+		util.WriteJSONError(w, http.StatusForbidden, "Access denied: Admin only")
+		return
+	}
+
+	query := r.URL.Query()
+	semester := query.Get("semester")
+	if semester == "" {
+		util.WriteJSONError(w, http.StatusBadRequest, "semester is required")
+		return
+	}
+	// The registrar extract is published grades unless asked otherwise
+	publishedOnly := true
+	if raw := query.Get("published_only"); raw != "" {
+		var err error
+		if publishedOnly, err = strconv.ParseBool(raw); err != nil {
+			util.WriteJSONError(w, http.StatusBadRequest, "published_only must be true or false")
+			return
+		}
+	}
+	format := query.Get("format")
+	if format == "" {
+		format = "csv"
+	}
+	if format != "csv" && format != "ndjson" {
+		util.WriteJSONError(w, http.StatusBadRequest, "format must be csv or ndjson")
+		return
+	}
+
+	stream, err := h.GradeClient.ExportGrades(r.Context(), &pb_grade.ExportGradesRequest{
+		Semester:      semester,
+		PublishedOnly: publishedOnly,
+		AdminId:       adminUser.Id,
+	})
+	if err != nil {
+		util.HandleGRPCError(w, err)
+		return
+	}
+	// Service errors arrive with the first message, while a proper status can still be sent
+	chunk, err := stream.Recv()
+	if err != nil {
+		util.HandleGRPCError(w, err)
+		return
+	}
+
+	filename := fmt.Sprintf("grades_%s.%s", strings.ReplaceAll(semester, " ", "_"), format)
+	if format == "csv" {
+		w.Header().Set("Content-Type", "text/csv; charset=utf-8")
+	} else {
+		w.Header().Set("Content-Type", "application/x-ndjson")
+	}
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filename))
+	w.Header().Set("Trailer", "X-Total-Rows")
+	w.WriteHeader(http.StatusOK)
+
+	flusher, _ := w.(http.Flusher)
+	csvWriter := csv.NewWriter(w)
+	encoder := json.NewEncoder(w)
+	writeChunk := func(grades []*pb_grade.Grade) error {
+		for _, g := range grades {
+			row := toGradeExportRow(g)
+			var err error
+			if format == "csv" {
+				err = csvWriter.Write(row.csvRecord())
+			} else {
+				err = encoder.Encode(row)
+			}
+			if err != nil {
+				return err
+			}
+		}
+		csvWriter.Flush()
+		if err := csvWriter.Error(); err != nil {
+			return err
+		}
+		if flusher != nil {
+			flusher.Flush()
+		}
+		return nil
+	}
+
+	if format == "csv" {
+		csvWriter.Write(gradeExportHeader)
+	}
+	for {
+		if err := writeChunk(chunk.Grades); err != nil {
+			// Headers are already sent; the missing trailer marks the file as incomplete
+			log.Printf("Grade export for %s aborted: %v", semester, err)
+			return
+		}
+		if summary := chunk.Summary; summary != nil {
+			if format == "ndjson" {
+				encoder.Encode(map[string]interface{}{
+					"summary": map[string]interface{}{"semester": summary.Semester, "total_rows": summary.TotalRows},
+				})
+			}
+			w.Header().Set("X-Total-Rows", strconv.Itoa(int(summary.TotalRows)))
+			return
+		}
+		if chunk, err = stream.Recv(); err != nil {
+			log.Printf("Grade export for %s aborted: %v", semester, err)
+			return
+		}
+	}
+}
@@ -0,0 +1,381 @@
+package gateway
+
+import (
+	"bytes"
+	"container/list"
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"sync"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"google.golang.org/grpc/metadata"
+
+	"stdiscm_p4/backend/internal/gateway/util"
+	pb_auth "stdiscm_p4/backend/internal/pb/auth"
+	"stdiscm_p4/backend/internal/shared"
+)
+
+const (
+	// IdempotencyKeyHeader is sent by clients on retryable mutations
+	IdempotencyKeyHeader = "Idempotency-Key"
+	// IdempotentReplayHeader marks a response served from the store instead of the handler
+	IdempotentReplayHeader = "Idempotent-Replayed"
+
+	maxIdempotencyKeyLength = 255
+	// Larger bodies (e.g. grade uploads) are passed through without idempotency
+	maxIdempotentBodyBytes = 1 << 20
+	// Bounds each read or write of the Mongo fallback
+	idempotencyMongoTimeout = 2 * time.Second
+)
+
+// idempotencyState is the outcome of claiming a key
+type idempotencyState int
+
+const (
+	idempotencyNew        idempotencyState = iota // first use; the handler runs
+	idempotencyReplay                             // completed earlier; replay the stored response
+	idempotencyInProgress                         // the first request is still running
+	idempotencyMismatch                           // the key was used for a different request body
+)
+
+// idempotencyEntry is one key's stored response
+type idempotencyEntry struct {
+	scope       string
+	fingerprint [sha256.Size]byte
+	done        bool
+	status      int
+	header      http.Header
+	body        []byte
+	expires     time.Time
+	elem        *list.Element
+}
+
+// idempotencyRecord is one key as stored in the Mongo fallback
+type idempotencyRecord struct {
+	Scope       string      `bson:"_id"`
+	Fingerprint []byte      `bson:"fingerprint"`
+	Done        bool        `bson:"done"`
+	Status      int         `bson:"status,omitempty"`
+	Header      http.Header `bson:"header,omitempty"`
+	Body        []byte      `bson:"body,omitempty"`
+	ExpiresAt   time.Time   `bson:"expires_at"`
+}
+
+// IdempotencyStore keeps the responses of mutating requests by Idempotency-Key
+// so a retried request is answered from the first attempt instead of running
+// again. Entries live in memory for ttl; beyond maxEntries the least recently
+// used are evicted first. Keys are scoped per user, method and path.
+//
+// With a Mongo collection attached (WithMongo), keys missing from memory are
+// claimed and looked up there, so retries are recognized across gateway
+// instances, after eviction and after a restart. If Mongo is unreachable the
+// store carries on with memory alone.
+type IdempotencyStore struct {
+	mu         sync.Mutex
+	ttl        time.Duration
+	maxEntries int
+	entries    map[string]*idempotencyEntry
+	lru        *list.List // front = most recently used scope
+	now        func() time.Time
+	col        *mongo.Collection
+}
+
+// NewIdempotencyStore creates an empty in-memory store. maxEntries below 1 is raised to 1.
+func NewIdempotencyStore(ttl time.Duration, maxEntries int) *IdempotencyStore {
+	if maxEntries < 1 {
+		maxEntries = 1
+	}
+	return &IdempotencyStore{
+		ttl:        ttl,
+		maxEntries: maxEntries,
+		entries:    make(map[string]*idempotencyEntry),
+		lru:        list.New(),
+		now:        time.Now,
+	}
+}
+
+// WithMongo attaches col as the fallback behind the in-memory entries
+func (s *IdempotencyStore) WithMongo(col *mongo.Collection) *IdempotencyStore {
+	s.col = col
+	return s
+}
+
+// EnsureIndexes creates the TTL index that removes expired keys from the Mongo fallback
+func (s *IdempotencyStore) EnsureIndexes(ctx context.Context) error {
+	if s.col == nil {
+		return nil
+	}
+	indexCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	_, err := s.col.Indexes().CreateOne(indexCtx, mongo.IndexModel{
+		Keys:    bson.D{{Key: "expires_at", Value: 1}},
+		Options: options.Index().SetName("idempotency_key_ttl").SetExpireAfterSeconds(0),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create idempotency key index: %w", err)
+	}
+	return nil
+}
+
+// claim looks up scope, registering it as in progress when it is new or expired.
+// The returned entry is a stored response only for idempotencyReplay.
+func (s *IdempotencyStore) claim(ctx context.Context, scope string, fingerprint [sha256.Size]byte) (idempotencyState, *idempotencyEntry) {
+	s.mu.Lock()
+	now := s.now()
+	if e, ok := s.entries[scope]; ok {
+		if now.Before(e.expires) {
+			s.lru.MoveToFront(e.elem)
+			s.mu.Unlock()
+			switch {
+			case e.fingerprint != fingerprint:
+				return idempotencyMismatch, nil
+			case !e.done:
+				return idempotencyInProgress, nil
+			}
+			return idempotencyReplay, e
+		}
+		s.remove(e)
+	}
+	// Hold the key in memory first so a concurrent duplicate on this instance
+	// sees it as in progress while the fallback is consulted
+	e := &idempotencyEntry{scope: scope, fingerprint: fingerprint, expires: now.Add(s.ttl)}
+	s.add(e)
+	s.mu.Unlock()
+
+	if s.col == nil {
+		return idempotencyNew, nil
+	}
+	state, stored := s.claimInMongo(ctx, e, now)
+	if state != idempotencyNew {
+		s.mu.Lock()
+		if s.entries[scope] == e {
+			s.remove(e)
+		}
+		if state == idempotencyReplay {
+			s.add(stored)
+		}
+		s.mu.Unlock()
+	}
+	return state, stored
+}
+
+// claimInMongo registers e in the fallback unless a live record for its scope is
+// already there, in which case that record decides the state. Mongo errors are
+// logged and treated as a new key so requests keep flowing.
+func (s *IdempotencyStore) claimInMongo(ctx context.Context, e *idempotencyEntry, now time.Time) (idempotencyState, *idempotencyEntry) {
+	queryCtx, cancel := context.WithTimeout(ctx, idempotencyMongoTimeout)
+	defer cancel()
+
+	// The upsert replaces an expired record or inserts a new one; a live record
+	// makes it collide on _id
+	record := idempotencyRecord{Scope: e.scope, Fingerprint: e.fingerprint[:], ExpiresAt: e.expires}
+	_, err := s.col.ReplaceOne(queryCtx,
+		bson.M{"_id": e.scope, "expires_at": bson.M{"$lte": now}},
+		record,
+		options.Replace().SetUpsert(true),
+	)
+	if err == nil {
+		return idempotencyNew, nil
+	}
+	if !mongo.IsDuplicateKeyError(err) {
+		log.Printf("WARN: Idempotency key fallback unavailable, using memory only: %v", err)
+		return idempotencyNew, nil
+	}
+
+	var stored idempotencyRecord
+	if err := s.col.FindOne(queryCtx, bson.M{"_id": e.scope}).Decode(&stored); err != nil {
+		if err == mongo.ErrNoDocuments {
+			// Removed between the two calls; another request has just finished with it
+			return idempotencyInProgress, nil
+		}
+		log.Printf("WARN: Idempotency key fallback unavailable, using memory only: %v", err)
+		return idempotencyNew, nil
+	}
+	switch {
+	case !bytes.Equal(stored.Fingerprint, e.fingerprint[:]):
+		return idempotencyMismatch, nil
+	case !stored.Done:
+		return idempotencyInProgress, nil
+	}
+	return idempotencyReplay, &idempotencyEntry{
+		scope:       stored.Scope,
+		fingerprint: e.fingerprint,
+		done:        true,
+		status:      stored.Status,
+		header:      stored.Header,
+		body:        stored.Body,
+		expires:     stored.ExpiresAt,
+	}
+}
+
+// add puts e in memory as the most recently used entry, evicting beyond
+// maxEntries; the caller holds the lock
+func (s *IdempotencyStore) add(e *idempotencyEntry) {
+	if old, ok := s.entries[e.scope]; ok {
+		s.remove(old)
+	}
+	e.elem = s.lru.PushFront(e)
+	s.entries[e.scope] = e
+	for s.lru.Len() > s.maxEntries {
+		s.remove(s.lru.Back().Value.(*idempotencyEntry))
+	}
+}
+
+// complete stores the response for a claimed scope
+func (s *IdempotencyStore) complete(scope string, status int, header http.Header, body []byte) {
+	s.mu.Lock()
+	expires := s.now().Add(s.ttl)
+	if e, ok := s.entries[scope]; ok && !e.done {
+		e.done, e.status, e.header, e.body = true, status, header, body
+		e.expires = expires
+	}
+	s.mu.Unlock()
+
+	if s.col == nil {
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), idempotencyMongoTimeout)
+	defer cancel()
+	_, err := s.col.UpdateOne(ctx, bson.M{"_id": scope, "done": false}, bson.M{"$set": bson.M{
+		"done": true, "status": status, "header": header, "body": body, "expires_at": expires,
+	}})
+	if err != nil {
+		log.Printf("WARN: Failed to store idempotent response: %v", err)
+	}
+}
+
+// release forgets a claimed scope whose request failed so it may be retried
+func (s *IdempotencyStore) release(scope string) {
+	s.mu.Lock()
+	if e, ok := s.entries[scope]; ok && !e.done {
+		s.remove(e)
+	}
+	s.mu.Unlock()
+
+	if s.col == nil {
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), idempotencyMongoTimeout)
+	defer cancel()
+	if _, err := s.col.DeleteOne(ctx, bson.M{"_id": scope, "done": false}); err != nil {
+		log.Printf("WARN: Failed to release idempotency key: %v", err)
+	}
+}
+
+// remove drops an entry; the caller holds the lock
+func (s *IdempotencyStore) remove(e *idempotencyEntry) {
+	s.lru.Remove(e.elem)
+	delete(s.entries, e.scope)
+}
+
+// responseRecorder passes a response through while keeping a copy for the store
+type responseRecorder struct {
+	http.ResponseWriter
+	status int
+	body   bytes.Buffer
+}
+
+func (rec *responseRecorder) WriteHeader(status int) {
+	if rec.status == 0 {
+		rec.status = status
+	}
+	rec.ResponseWriter.WriteHeader(status)
+}
+
+func (rec *responseRecorder) Write(b []byte) (int, error) {
+	if rec.status == 0 {
+		rec.status = http.StatusOK
+	}
+	rec.body.Write(b)
+	return rec.ResponseWriter.Write(b)
+}
+
+// IdempotencyMiddleware replays the stored response of an authenticated mutation
+// retried with the same Idempotency-Key. It must run after AuthMiddleware. The key
+// is forwarded to the services as gRPC metadata so audit entries can carry it.
+// Server errors are not stored, so a request that failed there may be retried.
+func IdempotencyMiddleware(store *IdempotencyStore) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			key := r.Header.Get(IdempotencyKeyHeader)
+			if key == "" || !isMutation(r.Method) {
+				next.ServeHTTP(w, r)
+				return
+			}
+			if len(key) > maxIdempotencyKeyLength {
+				util.WriteJSONError(w, http.StatusBadRequest, "Idempotency-Key must be at most 255 characters")
+				return
+			}
+			user, _ := r.Context().Value("user").(*pb_auth.User)
+			if user == nil {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			// Buffer the body to fingerprint it; oversized bodies skip idempotency
+			body, err := io.ReadAll(io.LimitReader(r.Body, maxIdempotentBodyBytes+1))
+			if err != nil {
+				util.WriteJSONError(w, http.StatusBadRequest, "Failed to read request body")
+				return
+			}
+			r.Body = io.NopCloser(io.MultiReader(bytes.NewReader(body), r.Body))
+			if len(body) > maxIdempotentBodyBytes {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			scope := user.Id + " " + r.Method + " " + r.URL.Path + " " + key
+			state, stored := store.claim(r.Context(), scope, sha256.Sum256(body))
+			switch state {
+			case idempotencyReplay:
+				for name, values := range stored.header {
+					w.Header()[name] = values
+				}
+				w.Header().Set(IdempotentReplayHeader, "true")
+				w.WriteHeader(stored.status)
+				w.Write(stored.body)
+				return
+			case idempotencyInProgress:
+				util.WriteJSONErrorCode(w, http.StatusConflict, shared.ErrCodeOperationInProgress, "A request with this Idempotency-Key is still in progress")
+				return
+			case idempotencyMismatch:
+				util.WriteJSONErrorCode(w, http.StatusUnprocessableEntity, shared.ErrCodeIdempotencyKeyReused, "Idempotency-Key was already used for a different request")
+				return
+			}
+
+			rec := &responseRecorder{ResponseWriter: w}
+			completed := false
+			defer func() {
+				if !completed {
+					store.release(scope)
+				}
+			}()
+
+			ctx := metadata.AppendToOutgoingContext(r.Context(), shared.MetadataIdempotencyKey, key)
+			next.ServeHTTP(rec, r.WithContext(ctx))
+
+			if rec.status == 0 || rec.status >= http.StatusInternalServerError {
+				return
+			}
+			store.complete(scope, rec.status, w.Header().Clone(), rec.body.Bytes())
+			completed = true
+		})
+	}
+}
+
+// isMutation reports whether requests with the method change state
+func isMutation(method string) bool {
+	switch method {
+	case http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete:
+		return true
+	}
+	return false
+}
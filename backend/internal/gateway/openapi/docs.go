@@ -0,0 +1,36 @@
+package openapi
+
+import (
+	"encoding/json"
+	"log"
+	"net/http"
+)
+
+// docsPage renders the spec with Redoc, loaded from its CDN
+const docsPage = `<!DOCTYPE html>
+<html>
+  <head>
+    <title>College Enrollment Gateway API</title>
+    <meta charset="utf-8"/>
+    <meta name="viewport" content="width=device-width, initial-scale=1">
+  </head>
+  <body>
+    <redoc spec-url="` + BasePath + `/openapi.json"></redoc>
+    <script src="https://cdn.redoc.ly/redoc/latest/bundles/redoc.standalone.js"></script>
+  </body>
+</html>
+`
+
+// ServeSpec handles GET /openapi.json
+func ServeSpec(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(Spec()); err != nil {
+		log.Printf("Error writing OpenAPI document: %v", err)
+	}
+}
+
+// ServeDocs handles GET /docs
+func ServeDocs(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.Write([]byte(docsPage))
+}
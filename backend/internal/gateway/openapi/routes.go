@@ -0,0 +1,715 @@
+package openapi
+
+import (
+	"net/http"
+	"time"
+
+	"stdiscm_p4/backend/internal/gateway/handlers"
+	pb_admin "stdiscm_p4/backend/internal/pb/admin"
+	pb_auth "stdiscm_p4/backend/internal/pb/auth"
+	pb_course "stdiscm_p4/backend/internal/pb/course"
+	pb_enrollment "stdiscm_p4/backend/internal/pb/enrollment"
+	pb_grade "stdiscm_p4/backend/internal/pb/grade"
+	"stdiscm_p4/backend/internal/shared"
+)
+
+var tags = []Tag{
+	{Name: "auth", Description: "Login, sessions and the caller's profile"},
+	{Name: "courses", Description: "Course catalog, prerequisites and course materials"},
+	{Name: "enrollment", Description: "Student cart, enrollment and schedule"},
+	{Name: "grades", Description: "Grades, GPA, rosters, drafts and appeals"},
+	{Name: "admin", Description: "Administration: courses, users, holds, overrides and reports"},
+	{Name: "docs", Description: "This document"},
+}
+
+func query(name, typ, description string) Parameter {
+	return Parameter{Name: name, In: "query", Description: description, Schema: &Schema{Type: typ}}
+}
+
+var (
+	semesterQuery = query("semester", "string", "Semester name")
+	pageQuery     = query("page", "integer", "1-based page number")
+	pageSizeQuery = query("page_size", "integer", "Items per page")
+)
+
+// Routes is the documented route table; every route registered by the gateway must appear here
+func Routes() []Route {
+	var routes []Route
+	routes = append(routes, authRoutes()...)
+	routes = append(routes, courseRoutes()...)
+	routes = append(routes, enrollmentRoutes()...)
+	routes = append(routes, gradeRoutes()...)
+	routes = append(routes, adminRoutes()...)
+	routes = append(routes, docsRoutes()...)
+	return routes
+}
+
+func authRoutes() []Route {
+	profile := pick(&pb_auth.GetProfileResponse{}, "user")
+	return []Route{
+		{
+			Method: http.MethodPost, Path: "/auth/login", Tag: "auth", Public: true,
+			Summary: "Log in with an email or ID; the token is returned in the body or set as a cookie",
+			Body:    handlers.RESTLoginRequest{},
+			Response: pick(&pb_auth.LoginResponse{}, "user", "token", "expires_in_seconds").
+				With("expires_at", time.Time{}).
+				With("must_change_password", true),
+		},
+		{
+			Method: http.MethodPost, Path: "/auth/logout", Tag: "auth", Public: true,
+			Summary:  "End the caller's session",
+			Response: pick(&pb_auth.LogoutResponse{}, "success", "message"),
+		},
+		{
+			Method: http.MethodGet, Path: "/auth/validate", Tag: "auth",
+			Summary:  "Check the caller's token",
+			Wrapped:  true,
+			Response: pick(&pb_auth.ValidateTokenResponse{}, "valid", "user", "message"),
+		},
+		{
+			Method: http.MethodPost, Path: "/auth/change-password", Tag: "auth",
+			Summary:  "Change the caller's password",
+			Body:     handlers.RESTChangePasswordRequest{},
+			Response: pick(&pb_auth.ChangePasswordResponse{}, "message"),
+		},
+		{Method: http.MethodGet, Path: "/me", Tag: "auth", Summary: "Get the caller's profile", Response: profile},
+		{Method: http.MethodGet, Path: "/profile", Tag: "auth", Summary: "Get the caller's profile (alias of GET /me)", Response: profile},
+		{
+			Method: http.MethodPatch, Path: "/me", Tag: "auth",
+			Summary:  "Update the caller's self-editable profile fields",
+			Body:     handlers.RESTUpdateProfileRequest{},
+			Response: pick(&pb_auth.UpdateProfileResponse{}, "user", "message"),
+		},
+		{
+			Method: http.MethodPut, Path: "/profile", Tag: "auth",
+			Summary:  "Update the caller's profile (alias of PATCH /me)",
+			Body:     handlers.RESTUpdateProfileRequest{},
+			Response: pick(&pb_auth.UpdateProfileResponse{}, "user", "message"),
+		},
+		{
+			Method: http.MethodGet, Path: "/admin/users/{id}/sessions", Tag: "admin",
+			Summary:  "List a user's active sessions",
+			Response: pick(&pb_auth.ListUserSessionsResponse{}, "sessions", "message"),
+		},
+		{
+			Method: http.MethodDelete, Path: "/admin/users/{id}/sessions", Tag: "admin",
+			Summary:  "Terminate all of a user's sessions",
+			Response: pick(&pb_auth.TerminateAllSessionsResponse{}, "terminated_count", "message"),
+		},
+		{
+			Method: http.MethodDelete, Path: "/admin/users/{id}/sessions/{session_id}", Tag: "admin",
+			Summary:  "Terminate one session",
+			Response: pick(&pb_auth.TerminateSessionResponse{}, "message"),
+		},
+	}
+}
+
+func courseRoutes() []Route {
+	return []Route{
+		{
+			Method: http.MethodGet, Path: "/courses", Tag: "courses", Public: true,
+			Summary: "List and search courses",
+			Query: []Parameter{
+				query("department", "string", "Course department, e.g. CS"),
+				query("search", "string", "Substring match on code or title"),
+				semesterQuery,
+				query("open_only", "boolean", "Only courses open for enrollment"),
+				query("include_cancelled", "boolean", "Also list cancelled courses"),
+				query("fulltext", "boolean", "Rank by full-text relevance using q"),
+				query("q", "string", "Full-text query, used with fulltext=true"),
+				query("days", "string", "Only courses meeting on these days, e.g. TTH or T,TH"),
+				query("time_window", "string", "Only courses meeting within HH:MM-HH:MM, e.g. 12:00-18:00"),
+				query("sort_by", "string", "code (default), title, units, seats_available or enrolled"),
+				query("sort_order", "string", "asc (default) or desc"),
+			},
+			Response: pick(&pb_course.ListCoursesResponse{}, "courses", "total_count"),
+		},
+		{
+			Method: http.MethodGet, Path: "/courses/{id}", Tag: "courses", Public: true,
+			Summary: "Get a course; a logged-in student also gets in_cart, enrolled and completed",
+			Response: pick(&pb_course.GetCourseDetailResponse{}, "course").
+				With("in_cart", false).With("enrolled", false).With("completed", false),
+		},
+		{
+			Method: http.MethodGet, Path: "/courses/{id}/availability", Tag: "courses", Public: true,
+			Summary:  "Get a course's seat availability",
+			Response: pick(&pb_course.GetCourseAvailabilityResponse{}, "available", "capacity", "enrolled", "seats_remaining", "is_open"),
+		},
+		{
+			Method: http.MethodGet, Path: "/courses/{id}/prerequisite-courses", Tag: "courses", Public: true,
+			Summary:  "List a course's prerequisites",
+			Response: pick(&pb_course.GetPrerequisitesResponse{}, "course_id", "prerequisites"),
+		},
+		{
+			Method: http.MethodGet, Path: "/departments", Tag: "courses", Public: true,
+			Summary:  "List departments for the course department filter",
+			Response: pick(&pb_course.ListDepartmentsResponse{}, "departments"),
+		},
+		{
+			Method: http.MethodGet, Path: "/courses/{id}/prerequisites", Tag: "courses",
+			Summary: "Check whether a student meets a course's prerequisites",
+			Query: []Parameter{
+				{Name: "student_id", In: "query", Required: true, Schema: &Schema{Type: "string"}},
+				query("allow_in_progress", "boolean", "Count prerequisites currently being taken"),
+			},
+			Response: pick(&pb_course.CheckPrerequisitesResponse{}, "all_met", "provisional", "prerequisites"),
+		},
+		{
+			Method: http.MethodGet, Path: "/courses/eligible", Tag: "courses",
+			Summary:  "List courses the calling student is eligible to take",
+			Query:    []Parameter{semesterQuery, query("department", "string", "Course department, e.g. CS")},
+			Response: pick(&pb_course.GetEligibleCoursesResponse{}, "courses", "eligible_count"),
+		},
+		{
+			Method: http.MethodPost, Path: "/faculty/courses/{id}/materials", Tag: "courses",
+			Summary:  "Attach a material link to a course the caller teaches",
+			Body:     handlers.RESTAddCourseMaterialRequest{},
+			Status:   http.StatusCreated,
+			Response: pick(&pb_course.AddCourseMaterialResponse{}, "material", "message"),
+		},
+		{
+			Method: http.MethodDelete, Path: "/faculty/courses/{id}/materials/{material_id}", Tag: "courses",
+			Summary:  "Remove a course material",
+			Response: pick(&pb_course.RemoveCourseMaterialResponse{}, "message"),
+		},
+		{
+			Method: http.MethodPost, Path: "/courses/{id}/reviews", Tag: "courses",
+			Summary:  "Review a course the calling student has a published grade in",
+			Body:     handlers.RESTSubmitReviewRequest{},
+			Status:   http.StatusCreated,
+			Response: pick(&pb_course.SubmitReviewResponse{}, "success", "review_id", "message"),
+		},
+		{
+			Method: http.MethodGet, Path: "/faculty/courses/{id}/reviews/summary", Tag: "courses",
+			Summary:  "Average review ratings for a course; comments are included for admins only",
+			Query:    []Parameter{semesterQuery},
+			Response: pick(&pb_course.GetCourseReviewSummaryResponse{}, "course_id", "review_count", "averages", "comments"),
+		},
+	}
+}
+
+func enrollmentRoutes() []Route {
+	return []Route{
+		{
+			Method: http.MethodGet, Path: "/cart/", Tag: "enrollment",
+			Summary:  "Get the calling student's cart",
+			Response: pick(&pb_enrollment.GetCartResponse{}, "cart", "max_courses", "max_units"),
+		},
+		{
+			Method: http.MethodGet, Path: "/cart/summary", Tag: "enrollment",
+			Summary:  "Item count and total units of the cart, for a badge",
+			Response: pick(&pb_enrollment.GetCartSummaryResponse{}, "item_count", "total_units", "message"),
+		},
+		{
+			Method: http.MethodPost, Path: "/cart/add", Tag: "enrollment",
+			Summary:  "Add a course to the cart",
+			Body:     handlers.RESTAddToCartRequest{},
+			Response: pick(&pb_enrollment.AddToCartResponse{}, "message", "cart"),
+		},
+		{
+			Method: http.MethodDelete, Path: "/cart/remove/{course_id}", Tag: "enrollment",
+			Summary:  "Remove a course from the cart",
+			Response: pick(&pb_enrollment.RemoveFromCartResponse{}, "message", "cart"),
+		},
+		{
+			Method: http.MethodDelete, Path: "/cart/clear", Tag: "enrollment",
+			Summary:  "Empty the cart",
+			Response: pick(&pb_enrollment.ClearCartResponse{}, "message"),
+		},
+		{
+			Method: http.MethodPost, Path: "/enrollment/enroll-all", Tag: "enrollment",
+			Summary:  "Enroll in every course in the cart",
+			Response: pick(&pb_enrollment.EnrollAllResponse{}, "message", "enrollments", "failed_courses", "receipt", "warnings"),
+			Conflict: pick(&pb_enrollment.EnrollAllResponse{}, "message", "failed_courses").With("code", ""),
+		},
+		{
+			Method: http.MethodPost, Path: "/enrollment/drop", Tag: "enrollment",
+			Summary:  "Drop an enrolled course",
+			Body:     handlers.RESTDropCourseRequest{},
+			Response: pick(&pb_enrollment.DropCourseResponse{}, "message"),
+		},
+		{
+			Method: http.MethodGet, Path: "/enrollment/schedule", Tag: "enrollment",
+			Summary: "List the calling student's enrollments",
+			Query: []Parameter{
+				semesterQuery,
+				query("status", "string", "enrolled, dropped or completed"),
+				pageQuery,
+				pageSizeQuery,
+			},
+			Response: pick(&pb_enrollment.GetStudentEnrollmentsResponse{},
+				"enrollments", "total_units", "total_count", "page", "page_size",
+				"max_drops_per_semester", "remaining_drops", "drops_semester"),
+		},
+		{
+			Method: http.MethodGet, Path: "/enrollment/drops", Tag: "enrollment",
+			Summary:  "List the calling student's drops and who made each",
+			Query:    []Parameter{semesterQuery},
+			Response: pick(&pb_enrollment.GetDropHistoryResponse{}, "message", "drops", "max_drops_per_semester"),
+		},
+		{
+			Method: http.MethodGet, Path: "/enrollment/receipts/{reference_id}", Tag: "enrollment",
+			Summary:  "Get an enrollment receipt",
+			Response: pick(&pb_enrollment.GetEnrollmentReceiptResponse{}, "receipt"),
+		},
+		{
+			Method: http.MethodGet, Path: "/enrollment/status", Tag: "enrollment",
+			Summary: "Get the current enrollment phase and what the caller may do",
+			Response: pick(&pb_enrollment.GetEnrollmentStatusResponse{},
+				"phase", "enrollment_start", "enrollment_end", "drop_deadline",
+				"can_add_to_cart", "can_enroll", "can_drop", "message"),
+		},
+		{
+			Method: http.MethodPost, Path: "/enrollment/suggest-schedules", Tag: "enrollment",
+			Summary: "Suggest conflict-free section combinations for a list of course codes (nothing is added to the cart)",
+			Body:    handlers.RESTSuggestSchedulesRequest{},
+			Response: pick(&pb_enrollment.SuggestSchedulesResponse{},
+				"message", "semester", "schedules", "unavailable_codes", "skipped_codes", "partial"),
+		},
+	}
+}
+
+func gradeRoutes() []Route {
+	finalize := pick(&pb_grade.FinalizeDraftResponse{}, "success", "message", "total_processed", "successful", "failed", "errors")
+	return []Route{
+		{
+			Method: http.MethodGet, Path: "/grades/", Tag: "grades",
+			Summary:  "List the calling student's published grades",
+			Query:    []Parameter{semesterQuery, pageQuery, pageSizeQuery},
+			Response: pick(&pb_grade.GetStudentGradesResponse{}, "grades", "gpa_info", "total_count", "page", "page_size"),
+		},
+		{
+			Method: http.MethodGet, Path: "/student/grades", Tag: "grades",
+			Summary:  "List the calling student's published grades (the student comes from the token)",
+			Query:    []Parameter{semesterQuery, pageQuery, pageSizeQuery},
+			Response: pick(&pb_grade.GetStudentGradesResponse{}, "grades", "gpa_info", "total_count", "page", "page_size"),
+		},
+		{
+			Method: http.MethodGet, Path: "/students/{id}/grades", Tag: "grades",
+			Summary:  "List a student's published grades by student ID (faculty and admins only)",
+			Query:    []Parameter{semesterQuery, pageQuery, pageSizeQuery},
+			Response: pick(&pb_grade.GetStudentGradesResponse{}, "grades", "gpa_info", "total_count", "page", "page_size"),
+		},
+		{
+			Method: http.MethodGet, Path: "/grades/gpa", Tag: "grades",
+			Summary:  "Calculate the calling student's GPA",
+			Query:    []Parameter{semesterQuery},
+			Response: pick(&pb_grade.CalculateGPAResponse{}, "success", "gpa_info", "message"),
+		},
+		{
+			Method: http.MethodGet, Path: "/grades/semesters", Tag: "grades",
+			Summary:  "List the semesters the calling student has grades in",
+			Response: pick(&pb_grade.GetStudentSemestersResponse{}, "semesters"),
+		},
+		{
+			Method: http.MethodGet, Path: "/grades/roster/{course_id}", Tag: "grades",
+			Summary:  "Get the class roster of a course the caller teaches",
+			Response: pick(&pb_grade.GetClassRosterResponse{}, "course_id", "course_code", "course_title", "students", "total_students", "withdrawn", "summary"),
+		},
+		{
+			Method: http.MethodGet, Path: "/grades/course/{course_id}", Tag: "grades",
+			Summary: "List the grades of a course the caller teaches",
+			Query: []Parameter{
+				query("published", "string", "true or false to filter by publication"),
+				pageQuery,
+				pageSizeQuery,
+			},
+			Response: pick(&pb_grade.GetCourseGradesResponse{}, "grades", "total_grades", "all_published", "total_count", "page", "page_size"),
+		},
+		{
+			Method: http.MethodPost, Path: "/grades/upload/{course_id}", Tag: "grades",
+			Summary:  "Upload grade entries for a course",
+			Body:     handlers.RESTUploadGradesRequest{},
+			Response: pick(&pb_grade.UploadGradesResponse{}, "success", "total_processed", "successful", "failed", "errors", "message"),
+		},
+		{
+			Method: http.MethodPost, Path: "/grades/publish/{course_id}", Tag: "grades",
+			Summary:  "Publish a course's grades",
+			Response: pick(&pb_grade.PublishGradesResponse{}, "success", "grades_published", "message"),
+		},
+		{
+			Method: http.MethodPost, Path: "/grades/appeals", Tag: "grades",
+			Summary:  "Appeal a published grade",
+			Body:     handlers.RESTSubmitGradeAppealRequest{},
+			Status:   http.StatusCreated,
+			Response: pick(&pb_grade.SubmitGradeAppealResponse{}, "success", "message", "appeal"),
+		},
+		{
+			Method: http.MethodGet, Path: "/grades/appeals", Tag: "grades",
+			Summary: "List the caller's appeals (students) or appeals on their courses (faculty)",
+			Query: []Parameter{
+				query("status", "string", "open, approved or denied"),
+				query("course_id", "string", "Faculty only: restrict to one course"),
+			},
+			Response: pick(&pb_grade.ListGradeAppealsResponse{}, "appeals", "total_count"),
+		},
+		{
+			Method: http.MethodPost, Path: "/grades/appeals/{id}/resolve", Tag: "grades",
+			Summary:  "Approve or deny a grade appeal",
+			Body:     handlers.RESTResolveGradeAppealRequest{},
+			Response: pick(&pb_grade.ResolveGradeAppealResponse{}, "success", "message", "appeal"),
+		},
+		{
+			Method: http.MethodPost, Path: "/student/grades/{enrollment_id}/appeal", Tag: "grades",
+			Summary: "Appeal the published grade of one of the caller's enrollments, within the appeal window",
+			Body: &Schema{
+				Type:       "object",
+				Properties: map[string]*Schema{"reason": {Type: "string"}},
+				Required:   []string{"reason"},
+			},
+			Status:   http.StatusCreated,
+			Response: pick(&pb_grade.SubmitGradeAppealResponse{}, "success", "message", "appeal"),
+		},
+		{
+			Method: http.MethodGet, Path: "/faculty/appeals", Tag: "grades",
+			Summary: "List appeals on the courses the calling faculty member teaches",
+			Query: []Parameter{
+				query("status", "string", "open, approved or denied"),
+				query("course_id", "string", "Restrict to one course"),
+			},
+			Response: pick(&pb_grade.ListGradeAppealsResponse{}, "appeals", "total_count"),
+		},
+		{
+			Method: http.MethodPost, Path: "/faculty/appeals/{id}/resolve", Tag: "grades",
+			Summary:  "Approve (with a new grade) or deny a grade appeal",
+			Body:     handlers.RESTResolveGradeAppealRequest{},
+			Response: pick(&pb_grade.ResolveGradeAppealResponse{}, "success", "message", "appeal"),
+		},
+		{
+			Method: http.MethodPost, Path: "/student/gpa/simulate", Tag: "grades",
+			Summary:  "Preview the GPA with hypothetical grades; nothing is saved",
+			Body:     handlers.RESTSimulateGPARequest{},
+			Response: pick(&pb_grade.SimulateGPAResponse{}, "success", "gpa_info", "term", "message"),
+		},
+		{
+			Method: http.MethodGet, Path: "/faculty/courses/{id}/roster.csv", Tag: "grades",
+			Summary:  "Download a class roster as CSV",
+			Produces: "text/csv",
+		},
+		{
+			Method: http.MethodGet, Path: "/faculty/courses/{id}/grade-draft", Tag: "grades",
+			Summary:  "Get the caller's saved grade draft for a course",
+			Response: pick(&pb_grade.GetGradeDraftResponse{}, "found", "draft"),
+		},
+		{
+			Method: http.MethodPut, Path: "/faculty/courses/{id}/grade-draft", Tag: "grades",
+			Summary:  "Save or merge a grade draft",
+			Body:     handlers.RESTSaveGradeDraftRequest{},
+			Response: pick(&pb_grade.SaveGradeDraftResponse{}, "success", "message", "draft"),
+		},
+		{
+			Method: http.MethodPost, Path: "/faculty/courses/{id}/grade-draft/finalize", Tag: "grades",
+			Summary:  "Submit a grade draft as grades",
+			Response: finalize,
+		},
+		{
+			Method: http.MethodGet, Path: "/admin/reports/grades", Tag: "admin",
+			Summary:  "Grade distribution report for a semester",
+			Query:    []Parameter{semesterQuery},
+			Response: pick(&pb_grade.GetSemesterGradeReportResponse{}, "semester", "overall", "departments", "courses"),
+		},
+		{
+			Method: http.MethodGet, Path: "/admin/exports/grades", Tag: "admin",
+			Summary: "Stream a semester's grades as CSV or NDJSON for the registrar",
+			Query: []Parameter{
+				semesterQuery,
+				query("published_only", "boolean", "Only published grades (default true)"),
+				query("format", "string", "csv (default) or ndjson"),
+			},
+			Produces: "text/csv",
+		},
+	}
+}
+
+func adminRoutes() []Route {
+	facultyLoadQuery := []Parameter{
+		semesterQuery,
+		query("max_units", "integer", "Units above which faculty are flagged as overloaded"),
+		query("include_unassigned", "boolean", "Also list active faculty with no courses this semester"),
+	}
+	override := pick(&pb_admin.OverrideEnrollmentResponse{}, "success", "message", "enrolled", "capacity", "course_closed")
+	restore := pick(&pb_admin.RestoreEnrollmentResponse{}, "success", "message", "outcome", "enrollment_id", "enrolled", "capacity")
+	return []Route{
+		// Reports, repairs and imports
+		{
+			Method: http.MethodPost, Path: "/admin/enrollment/recalculate", Tag: "admin",
+			Summary:  "Recount enrolled seats and fix drifted course counters",
+			Query:    []Parameter{semesterQuery},
+			Response: pick(&pb_admin.RecalculateEnrollmentCountsResponse{}, "success", "message", "courses_checked", "corrections"),
+		},
+		{
+			Method: http.MethodPost, Path: "/admin/semesters/close", Tag: "admin",
+			Summary:  "Close a semester, completing its active enrollments (once per semester)",
+			Body:     handlers.RESTCloseSemesterRequest{},
+			Response: pick(&pb_admin.CloseSemesterResponse{}, "success", "message", "courses_closed", "enrollments_completed"),
+		},
+		{
+			Method: http.MethodPost, Path: "/admin/semesters/publish-grades", Tag: "admin",
+			Summary:  "Publish every unpublished grade of a semester (requires confirm)",
+			Body:     handlers.RESTPublishAllGradesRequest{},
+			Response: pick(&pb_admin.PublishAllGradesResponse{}, "success", "message", "grades_published", "courses_affected", "students_notified"),
+		},
+		{
+			Method: http.MethodPost, Path: "/admin/courses/{id}/cancel", Tag: "admin",
+			Summary:  "Cancel a course, dropping and notifying its enrolled students",
+			Body:     handlers.RESTCancelCourseRequest{},
+			Response: pick(&pb_admin.CancelCourseResponse{}, "success", "message", "students_affected", "carts_updated"),
+		},
+		{
+			Method: http.MethodGet, Path: "/admin/courses/{id}/fill-timeline", Tag: "admin",
+			Summary:  "How a course's seats filled over time",
+			Response: pick(&pb_admin.GetCourseFillTimelineResponse{}, "success", "message", "course_id", "course_code", "capacity", "points"),
+		},
+		{
+			Method: http.MethodGet, Path: "/admin/reports/faculty-load", Tag: "admin",
+			Summary:  "Teaching load per faculty member",
+			Query:    facultyLoadQuery,
+			Response: pick(&pb_admin.GetFacultyLoadReportResponse{}, "success", "message", "semester", "max_units", "faculty"),
+		},
+		{
+			Method: http.MethodGet, Path: "/admin/reports/faculty-load.csv", Tag: "admin",
+			Summary:  "Teaching load per faculty member as CSV",
+			Query:    facultyLoadQuery,
+			Produces: "text/csv",
+		},
+		{
+			Method: http.MethodGet, Path: "/admin/reports/enrollment-trend", Tag: "admin",
+			Summary: "Enrollments and drops per time bucket",
+			Query: []Parameter{
+				query("course_id", "string", "Restrict to one course"),
+				query("department", "string", "Restrict to a course department, e.g. CS"),
+				query("interval", "string", "hour or day"),
+				query("from", "string", "RFC 3339 or YYYY-MM-DD (UTC)"),
+				query("to", "string", "RFC 3339 or YYYY-MM-DD (UTC)"),
+			},
+			Response: pick(&pb_admin.GetEnrollmentTrendResponse{}, "success", "message", "interval").
+				With("buckets", []handlers.TrendBucketView{}),
+		},
+		{
+			Method: http.MethodPost, Path: "/admin/courses/import", Tag: "admin",
+			Summary:  "Create courses in bulk from JSON or CSV",
+			Body:     handlers.RESTImportCoursesRequest{},
+			CSV:      true,
+			Response: pick(&pb_admin.BulkCreateCoursesResponse{}, "success", "message", "created_count", "failed_count", "results"),
+		},
+
+		// System
+		{
+			Method: http.MethodGet, Path: "/admin/stats", Tag: "admin",
+			Summary:  "System-wide counts",
+			Response: Fields{"stats": shared.SystemStats{}},
+		},
+		{
+			Method: http.MethodGet, Path: "/admin/catalog-cache", Tag: "admin",
+			Summary:  "Course catalog cache hits and misses since the course service started",
+			Response: pick(&pb_course.GetCatalogCacheStatsResponse{}, "hits", "misses", "hit_rate", "entries"),
+		},
+		{
+			Method: http.MethodGet, Path: "/admin/config", Tag: "admin",
+			Summary:  "Get system configuration",
+			Query:    []Parameter{query("key", "string", "Return a single key")},
+			Wrapped:  true,
+			Response: pick(&pb_admin.GetSystemConfigResponse{}, "configs"),
+		},
+		{
+			Method: http.MethodPut, Path: "/admin/config/{key}", Tag: "admin",
+			Summary:  "Set a system configuration value",
+			Body:     handlers.RESTUpdateSystemConfigRequest{},
+			Response: pick(&pb_admin.UpdateSystemConfigResponse{}, "success", "message"),
+		},
+
+		// Courses
+		{
+			Method: http.MethodPost, Path: "/admin/courses", Tag: "admin",
+			Summary:  "Create a course",
+			Body:     handlers.RESTCreateCourseRequest{},
+			Status:   http.StatusCreated,
+			Response: pick(&pb_admin.CreateCourseResponse{}, "success", "course_id", "course", "message"),
+		},
+		{
+			Method: http.MethodPost, Path: "/admin/courses/validate-schedule", Tag: "admin",
+			Summary: "Preview schedule parsing and room/faculty conflicts",
+			Body:    handlers.RESTValidateScheduleRequest{},
+			Response: pick(&pb_admin.ValidateCourseScheduleResponse{},
+				"valid", "parse_error", "days", "start_time", "end_time", "conflicts", "message"),
+		},
+		{
+			Method: http.MethodGet, Path: "/admin/courses/nearly-full", Tag: "admin",
+			Summary:  "Courses at or above a fill threshold",
+			Query:    []Parameter{semesterQuery, query("threshold", "integer", "Fill percentage between 1 and 100")},
+			Response: pick(&pb_admin.GetNearlyFullCoursesResponse{}, "success", "message", "threshold", "courses"),
+		},
+		{
+			Method: http.MethodPut, Path: "/admin/courses/{id}", Tag: "admin",
+			Summary:  "Update a course",
+			Body:     handlers.RESTUpdateCourseRequest{},
+			Response: pick(&pb_admin.UpdateCourseResponse{}, "success", "course", "message"),
+		},
+		{
+			Method: http.MethodDelete, Path: "/admin/courses/{id}", Tag: "admin",
+			Summary:  "Delete a course",
+			Response: pick(&pb_admin.DeleteCourseResponse{}, "success", "message"),
+		},
+		{
+			Method: http.MethodPost, Path: "/admin/courses/{id}/assign-faculty", Tag: "admin",
+			Summary:  "Assign a course's faculty",
+			Body:     handlers.RESTAssignFacultyRequest{},
+			Response: pick(&pb_admin.AssignFacultyResponse{}, "success", "message"),
+		},
+		{
+			Method: http.MethodPost, Path: "/admin/courses/{id}/prerequisites", Tag: "admin",
+			Summary: "Add a prerequisite to a course",
+			Body:    handlers.RESTAddPrerequisiteRequest{},
+			Response: pick(&pb_admin.AddPrerequisiteResponse{}, "success", "message").
+				With("prerequisites", []*pb_course.Prerequisite{}),
+		},
+		{
+			Method: http.MethodDelete, Path: "/admin/courses/{id}/prerequisites/{prereq_id}", Tag: "admin",
+			Summary: "Remove a prerequisite from a course",
+			Response: pick(&pb_admin.RemovePrerequisiteResponse{}, "success", "message").
+				With("prerequisites", []*pb_course.Prerequisite{}),
+		},
+
+		// Departments
+		{
+			Method: http.MethodPost, Path: "/admin/departments", Tag: "admin",
+			Summary:  "Create a department",
+			Body:     handlers.RESTCreateDepartmentRequest{},
+			Status:   http.StatusCreated,
+			Response: pick(&pb_admin.CreateDepartmentResponse{}, "success", "message", "department"),
+		},
+		{
+			Method: http.MethodPut, Path: "/admin/departments/{code}", Tag: "admin",
+			Summary:  "Rename a department and its faculty's department",
+			Body:     handlers.RESTUpdateDepartmentRequest{},
+			Response: pick(&pb_admin.UpdateDepartmentResponse{}, "success", "message", "department", "faculty_updated"),
+		},
+		{
+			Method: http.MethodDelete, Path: "/admin/departments/{code}", Tag: "admin",
+			Summary:  "Delete a department with no courses or faculty",
+			Response: pick(&pb_admin.DeleteDepartmentResponse{}, "success", "message"),
+		},
+
+		// Users
+		{
+			Method: http.MethodPost, Path: "/admin/users", Tag: "admin",
+			Summary:  "Create a student, faculty or admin account",
+			Body:     handlers.RESTCreateUserRequest{},
+			Status:   http.StatusCreated,
+			Response: pick(&pb_admin.CreateUserResponse{}, "success", "user_id", "initial_password", "message", "user"),
+		},
+		{
+			Method: http.MethodGet, Path: "/admin/users", Tag: "admin",
+			Summary:  "List users",
+			Query:    []Parameter{query("role", "string", "student, faculty or admin"), query("active_only", "boolean", "")},
+			Wrapped:  true,
+			Response: pick(&pb_admin.ListUsersResponse{}, "users", "total_count"),
+		},
+		{
+			Method: http.MethodGet, Path: "/admin/users/{id}", Tag: "admin",
+			Summary:  "Get a user with their sessions and, for students, enrollments and CGPA or, for faculty, courses this semester",
+			Response: pick(&pb_admin.GetUserDetailResponse{}, "success", "message", "user", "semester", "active_sessions", "enrollments", "cgpa", "assigned_courses"),
+		},
+		{
+			Method: http.MethodPost, Path: "/admin/users/{id}/reset-password", Tag: "admin",
+			Summary:  "Issue a new one-time password",
+			Response: pick(&pb_admin.ResetPasswordResponse{}, "success", "new_password", "message"),
+		},
+		{
+			Method: http.MethodPatch, Path: "/admin/users/{id}/status", Tag: "admin",
+			Summary:  "Activate or deactivate a user",
+			Body:     handlers.RESTToggleUserStatusRequest{},
+			Response: pick(&pb_admin.ToggleUserStatusResponse{}, "success", "message"),
+		},
+		{
+			Method: http.MethodPost, Path: "/admin/transfer-credits", Tag: "admin",
+			Summary:  "Record a transfer credit for a student",
+			Body:     handlers.RESTAddTransferCreditRequest{},
+			Status:   http.StatusCreated,
+			Response: pick(&pb_admin.AddTransferCreditResponse{}, "success", "message", "transfer_credit"),
+		},
+
+		// Holds
+		{
+			Method: http.MethodGet, Path: "/admin/users/{id}/holds", Tag: "admin",
+			Summary:  "List a student's holds",
+			Query:    []Parameter{query("include_cleared", "boolean", "Include cleared holds")},
+			Response: pick(&pb_admin.GetStudentHoldsResponse{}, "success", "holds"),
+		},
+		{
+			Method: http.MethodPost, Path: "/admin/holds", Tag: "admin",
+			Summary:  "Place a hold on a student",
+			Body:     handlers.RESTPlaceHoldRequest{},
+			Status:   http.StatusCreated,
+			Response: pick(&pb_admin.PlaceHoldResponse{}, "success", "message", "hold"),
+		},
+		{
+			Method: http.MethodPost, Path: "/admin/holds/{id}/clear", Tag: "admin",
+			Summary:  "Clear a hold",
+			Response: pick(&pb_admin.ClearHoldResponse{}, "success", "message", "hold"),
+		},
+
+		// Enrollment configuration
+		{
+			Method: http.MethodPost, Path: "/admin/enrollment/period", Tag: "admin",
+			Summary:  "Set the enrollment period",
+			Body:     handlers.RESTSetEnrollmentPeriodRequest{},
+			Response: pick(&pb_admin.SetEnrollmentPeriodResponse{}, "success", "message", "warning"),
+		},
+		{
+			Method: http.MethodPost, Path: "/admin/enrollment/toggle", Tag: "admin",
+			Summary:  "Open or close enrollment",
+			Body:     handlers.RESTToggleEnrollmentRequest{},
+			Response: pick(&pb_admin.ToggleEnrollmentResponse{}, "success", "enrollment_open", "message"),
+		},
+
+		// Overrides
+		{
+			Method: http.MethodPost, Path: "/admin/override/enroll", Tag: "admin",
+			Summary:  "Force-enroll a student, bypassing capacity and cart rules",
+			Body:     handlers.RESTOverrideEnrollmentRequest{},
+			Response: override,
+		},
+		{
+			Method: http.MethodPost, Path: "/admin/override/drop", Tag: "admin",
+			Summary:  "Force-drop a student",
+			Body:     handlers.RESTOverrideEnrollmentRequest{},
+			Response: override,
+		},
+		{
+			Method: http.MethodPost, Path: "/admin/override/restore", Tag: "admin",
+			Summary:  "Restore a dropped enrollment",
+			Body:     handlers.RESTRestoreEnrollmentRequest{},
+			Response: restore,
+			Conflict: restore,
+		},
+
+		// Audit
+		{
+			Method: http.MethodGet, Path: "/admin/audit/history", Tag: "admin",
+			Summary: "Audit events recorded against a resource",
+			Query: []Parameter{
+				{Name: "resource", In: "query", Required: true, Schema: &Schema{Type: "string"}},
+				pageQuery,
+				pageSizeQuery,
+			},
+			Response: pick(&pb_admin.GetResourceHistoryResponse{},
+				"success", "message", "resource", "events", "total_count", "page", "page_size"),
+		},
+	}
+}
+
+func docsRoutes() []Route {
+	return []Route{
+		{
+			Method: http.MethodGet, Path: "/openapi.json", Tag: "docs", Public: true,
+			Summary:  "This OpenAPI document",
+			Produces: "application/json",
+		},
+		{
+			Method: http.MethodGet, Path: "/docs", Tag: "docs", Public: true,
+			Summary:  "Browsable API reference (not served in production)",
+			Produces: "text/html",
+		},
+	}
+}
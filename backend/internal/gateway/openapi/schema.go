@@ -0,0 +1,198 @@
+package openapi
+
+import (
+	"fmt"
+	"path"
+	"reflect"
+	"strings"
+	"time"
+)
+
+// Schema is the subset of the OpenAPI 3 schema object the gateway needs
+type Schema struct {
+	Ref                  string             `json:"$ref,omitempty"`
+	Type                 string             `json:"type,omitempty"`
+	Format               string             `json:"format,omitempty"`
+	Description          string             `json:"description,omitempty"`
+	Properties           map[string]*Schema `json:"properties,omitempty"`
+	Required             []string           `json:"required,omitempty"`
+	Items                *Schema            `json:"items,omitempty"`
+	AdditionalProperties *Schema            `json:"additionalProperties,omitempty"`
+	Enum                 []interface{}      `json:"enum,omitempty"`
+}
+
+// Fields describes the top-level properties of a JSON object by example:
+// each value is a Go value (or reflect.Type) whose JSON encoding the property has.
+type Fields map[string]interface{}
+
+// With returns a copy of f with name added or replaced
+func (f Fields) With(name string, value interface{}) Fields {
+	out := make(Fields, len(f)+1)
+	for k, v := range f {
+		out[k] = v
+	}
+	out[name] = value
+	return out
+}
+
+// pick copies the named properties of a message, looked up by JSON name, so
+// response maps that forward gRPC fields stay typed by the message itself.
+// An unknown name panics; the spec test catches it when a message changes.
+func pick(message interface{}, names ...string) Fields {
+	t := reflect.TypeOf(message)
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	byName := make(map[string]reflect.Type)
+	collectFields(t, func(name string, ft reflect.Type) {
+		if _, ok := byName[name]; !ok {
+			byName[name] = ft
+		}
+	})
+
+	out := make(Fields, len(names))
+	for _, name := range names {
+		ft, ok := byName[name]
+		if !ok {
+			panic(fmt.Sprintf("openapi: %s has no JSON field %q", t, name))
+		}
+		out[name] = ft
+	}
+	return out
+}
+
+var timeType = reflect.TypeOf(time.Time{})
+
+// schemaRegistry turns Go types into schemas, registering named structs as components
+type schemaRegistry struct {
+	components map[string]*Schema
+	names      map[reflect.Type]string
+}
+
+func newSchemaRegistry() *schemaRegistry {
+	return &schemaRegistry{
+		components: make(map[string]*Schema),
+		names:      make(map[reflect.Type]string),
+	}
+}
+
+// of returns the schema for a Go value, a reflect.Type, or a ready-made *Schema
+func (reg *schemaRegistry) of(v interface{}) *Schema {
+	switch v := v.(type) {
+	case *Schema:
+		return v
+	case reflect.Type:
+		return reg.typeSchema(v)
+	default:
+		return reg.typeSchema(reflect.TypeOf(v))
+	}
+}
+
+// object builds an inline object schema from Fields
+func (reg *schemaRegistry) object(fields Fields) *Schema {
+	s := &Schema{Type: "object", Properties: make(map[string]*Schema, len(fields))}
+	for name, v := range fields {
+		s.Properties[name] = reg.of(v)
+	}
+	return s
+}
+
+func (reg *schemaRegistry) typeSchema(t reflect.Type) *Schema {
+	if t == nil {
+		return &Schema{}
+	}
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t == timeType {
+		return &Schema{Type: "string", Format: "date-time"}
+	}
+
+	switch t.Kind() {
+	case reflect.Bool:
+		return &Schema{Type: "boolean"}
+	case reflect.Int8, reflect.Int16, reflect.Int32, reflect.Uint8, reflect.Uint16, reflect.Uint32:
+		return &Schema{Type: "integer", Format: "int32"}
+	case reflect.Int, reflect.Int64, reflect.Uint, reflect.Uint64:
+		return &Schema{Type: "integer", Format: "int64"}
+	case reflect.Float32:
+		return &Schema{Type: "number", Format: "float"}
+	case reflect.Float64:
+		return &Schema{Type: "number", Format: "double"}
+	case reflect.String:
+		return &Schema{Type: "string"}
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return &Schema{Type: "string", Format: "byte"}
+		}
+		return &Schema{Type: "array", Items: reg.typeSchema(t.Elem())}
+	case reflect.Map:
+		return &Schema{Type: "object", AdditionalProperties: reg.typeSchema(t.Elem())}
+	case reflect.Struct:
+		return reg.structSchema(t)
+	default:
+		// Interfaces (e.g. protobuf oneofs) may hold any value
+		return &Schema{}
+	}
+}
+
+// structSchema references named structs as components and inlines anonymous ones
+func (reg *schemaRegistry) structSchema(t reflect.Type) *Schema {
+	if t.Name() == "" {
+		return reg.structBody(t)
+	}
+	name, ok := reg.names[t]
+	if !ok {
+		name = path.Base(t.PkgPath()) + "." + t.Name()
+		reg.names[t] = name
+		// Registered before the body is built so recursive types terminate
+		reg.components[name] = nil
+		reg.components[name] = reg.structBody(t)
+	}
+	return &Schema{Ref: "#/components/schemas/" + name}
+}
+
+func (reg *schemaRegistry) structBody(t reflect.Type) *Schema {
+	s := &Schema{Type: "object", Properties: make(map[string]*Schema)}
+	collectFields(t, func(name string, ft reflect.Type) {
+		if _, ok := s.Properties[name]; !ok {
+			s.Properties[name] = reg.typeSchema(ft)
+		}
+	})
+	return s
+}
+
+// collectFields reports the JSON-visible fields of a struct as encoding/json sees
+// them, outer fields first so they shadow those promoted from embedded structs.
+func collectFields(t reflect.Type, visit func(name string, ft reflect.Type)) {
+	var embedded []reflect.Type
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		tag := f.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name := strings.Split(tag, ",")[0]
+
+		if f.Anonymous && name == "" {
+			ft := f.Type
+			for ft.Kind() == reflect.Ptr {
+				ft = ft.Elem()
+			}
+			if ft.Kind() == reflect.Struct {
+				embedded = append(embedded, ft)
+				continue
+			}
+		}
+		if !f.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+		visit(name, f.Type)
+	}
+	for _, et := range embedded {
+		collectFields(et, visit)
+	}
+}
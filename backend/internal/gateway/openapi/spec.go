@@ -0,0 +1,280 @@
+// Package openapi describes the gateway's REST API as an OpenAPI 3 document.
+//
+// Request bodies are reflected from the handlers' REST* structs and responses
+// from the gRPC messages the handlers forward, so the shapes cannot drift from
+// the code; the route table itself is checked against the chi router in tests.
+package openapi
+
+import (
+	"net/http"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"stdiscm_p4/backend/internal/gateway/util"
+)
+
+// BasePath is the prefix every documented route is mounted under
+const BasePath = "/api"
+
+// Document is the subset of the OpenAPI 3 document object the gateway needs
+type Document struct {
+	OpenAPI    string                           `json:"openapi"`
+	Info       Info                             `json:"info"`
+	Servers    []Server                         `json:"servers"`
+	Tags       []Tag                            `json:"tags"`
+	Paths      map[string]map[string]*Operation `json:"paths"`
+	Components Components                       `json:"components"`
+}
+
+type Info struct {
+	Title       string `json:"title"`
+	Version     string `json:"version"`
+	Description string `json:"description,omitempty"`
+}
+
+type Server struct {
+	URL string `json:"url"`
+}
+
+type Tag struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+}
+
+type Operation struct {
+	Tags        []string              `json:"tags"`
+	Summary     string                `json:"summary"`
+	OperationID string                `json:"operationId"`
+	Security    []map[string][]string `json:"security,omitempty"`
+	Parameters  []Parameter           `json:"parameters,omitempty"`
+	RequestBody *RequestBody          `json:"requestBody,omitempty"`
+	Responses   map[string]*Response  `json:"responses"`
+}
+
+type Parameter struct {
+	Name        string  `json:"name"`
+	In          string  `json:"in"`
+	Description string  `json:"description,omitempty"`
+	Required    bool    `json:"required,omitempty"`
+	Schema      *Schema `json:"schema"`
+}
+
+type RequestBody struct {
+	Required bool                 `json:"required"`
+	Content  map[string]MediaType `json:"content"`
+}
+
+type Response struct {
+	Ref         string               `json:"$ref,omitempty"`
+	Description string               `json:"description,omitempty"`
+	Content     map[string]MediaType `json:"content,omitempty"`
+}
+
+type MediaType struct {
+	Schema *Schema `json:"schema"`
+}
+
+type Components struct {
+	Schemas         map[string]*Schema         `json:"schemas"`
+	Responses       map[string]*Response       `json:"responses"`
+	SecuritySchemes map[string]*SecurityScheme `json:"securitySchemes"`
+}
+
+type SecurityScheme struct {
+	Type         string `json:"type"`
+	Scheme       string `json:"scheme,omitempty"`
+	BearerFormat string `json:"bearerFormat,omitempty"`
+	In           string `json:"in,omitempty"`
+	Name         string `json:"name,omitempty"`
+	Description  string `json:"description,omitempty"`
+}
+
+// Route documents one gateway endpoint
+type Route struct {
+	Method  string
+	Path    string // chi pattern relative to BasePath
+	Tag     string
+	Summary string
+	Public  bool // no token required
+
+	Query []Parameter
+	Body  interface{} // REST request struct; nil when the route takes no body
+	CSV   bool        // the body may also be sent as text/csv
+
+	Status   int    // success status; defaults to 200
+	Response Fields // success properties besides "success"
+	Wrapped  bool   // the payload is nested under "data" by util.WriteJSON
+	Produces string // non-JSON success media type, e.g. text/csv
+
+	// Conflict documents a 409 that carries a payload instead of the error envelope
+	Conflict Fields
+}
+
+var pathParam = regexp.MustCompile(`\{([^}]+)\}`)
+
+// Build assembles the document from the route table
+func Build() *Document {
+	reg := newSchemaRegistry()
+	errorSchema := reg.of(util.JSONError{})
+
+	doc := &Document{
+		OpenAPI: "3.0.3",
+		Info: Info{
+			Title:   "College Enrollment Gateway API",
+			Version: "1.0.0",
+			Description: "REST API served by the gateway. Successful responses carry \"success\": true; " +
+				"failures use the error envelope, whose \"code\" is a stable machine-readable identifier.",
+		},
+		Servers: []Server{{URL: BasePath}},
+		Tags:    tags,
+		Paths:   make(map[string]map[string]*Operation),
+		Components: Components{
+			Responses: map[string]*Response{
+				"Error": {
+					Description: "Error envelope",
+					Content:     map[string]MediaType{"application/json": {Schema: errorSchema}},
+				},
+			},
+			SecuritySchemes: map[string]*SecurityScheme{
+				"bearerAuth": {Type: "http", Scheme: "bearer", BearerFormat: "JWT"},
+				"cookieAuth": {
+					Type: "apiKey", In: "cookie", Name: util.TokenCookieName,
+					Description: "Used instead of the header when AUTH_TOKEN_DELIVERY=cookie",
+				},
+			},
+		},
+	}
+
+	for _, route := range Routes() {
+		item, ok := doc.Paths[route.Path]
+		if !ok {
+			item = make(map[string]*Operation)
+			doc.Paths[route.Path] = item
+		}
+		item[strings.ToLower(route.Method)] = route.operation(reg)
+	}
+
+	doc.Components.Schemas = make(map[string]*Schema, len(reg.components))
+	for name, s := range reg.components {
+		doc.Components.Schemas[name] = s
+	}
+	return doc
+}
+
+func (route Route) operation(reg *schemaRegistry) *Operation {
+	op := &Operation{
+		Tags:        []string{route.Tag},
+		Summary:     route.Summary,
+		OperationID: operationID(route.Method, route.Path),
+		Responses:   make(map[string]*Response),
+	}
+	if !route.Public {
+		op.Security = []map[string][]string{{"bearerAuth": {}}, {"cookieAuth": {}}}
+	}
+
+	for _, m := range pathParam.FindAllStringSubmatch(route.Path, -1) {
+		op.Parameters = append(op.Parameters, Parameter{
+			Name: m[1], In: "path", Required: true, Schema: &Schema{Type: "string"},
+		})
+	}
+	op.Parameters = append(op.Parameters, route.Query...)
+
+	if route.Body != nil {
+		content := map[string]MediaType{"application/json": {Schema: reg.of(route.Body)}}
+		if route.CSV {
+			content["text/csv"] = MediaType{Schema: &Schema{Type: "string", Description: "CSV with a header row"}}
+		}
+		op.RequestBody = &RequestBody{Required: true, Content: content}
+	}
+
+	status := route.Status
+	if status == 0 {
+		status = http.StatusOK
+	}
+	op.Responses[strconv.Itoa(status)] = route.success(reg)
+	if route.Conflict != nil {
+		op.Responses["409"] = &Response{
+			Description: "Rejected; the payload describes why",
+			Content:     map[string]MediaType{"application/json": {Schema: envelope(reg, route.Conflict)}},
+		}
+	}
+	if !route.Public {
+		op.Responses["401"] = &Response{Ref: "#/components/responses/Error"}
+	}
+	op.Responses["4XX"] = &Response{Ref: "#/components/responses/Error"}
+	op.Responses["5XX"] = &Response{Ref: "#/components/responses/Error"}
+	return op
+}
+
+func (route Route) success(reg *schemaRegistry) *Response {
+	if route.Produces != "" {
+		schema := &Schema{Type: "string"}
+		if route.Produces == "application/json" {
+			schema = &Schema{Type: "object"}
+		}
+		return &Response{
+			Description: "Success",
+			Content:     map[string]MediaType{route.Produces: {Schema: schema}},
+		}
+	}
+
+	var schema *Schema
+	if route.Wrapped {
+		schema = &Schema{Type: "object", Properties: map[string]*Schema{
+			"success": {Type: "boolean"},
+			"data":    reg.object(route.Response),
+		}}
+	} else {
+		schema = envelope(reg, route.Response)
+	}
+	return &Response{
+		Description: "Success",
+		Content:     map[string]MediaType{"application/json": {Schema: schema}},
+	}
+}
+
+// envelope is a response object with the "success" flag beside its fields
+func envelope(reg *schemaRegistry, fields Fields) *Schema {
+	s := reg.object(fields)
+	if _, ok := s.Properties["success"]; !ok {
+		s.Properties["success"] = &Schema{Type: "boolean"}
+	}
+	return s
+}
+
+// operationID derives a stable identifier such as get_courses_id_availability
+func operationID(method, pattern string) string {
+	parts := []string{strings.ToLower(method)}
+	for _, seg := range strings.FieldsFunc(pattern, func(r rune) bool {
+		return r == '/' || r == '{' || r == '}' || r == '-' || r == '.'
+	}) {
+		parts = append(parts, seg)
+	}
+	return strings.Join(parts, "_")
+}
+
+var (
+	specOnce sync.Once
+	specDoc  *Document
+)
+
+// Spec returns the document, built once per process
+func Spec() *Document {
+	specOnce.Do(func() { specDoc = Build() })
+	return specDoc
+}
+
+// Operations lists every documented "METHOD /pattern" pair, sorted
+func (d *Document) Operations() []string {
+	var ops []string
+	for p, item := range d.Paths {
+		for method := range item {
+			ops = append(ops, strings.ToUpper(method)+" "+p)
+		}
+	}
+	sort.Strings(ops)
+	return ops
+}
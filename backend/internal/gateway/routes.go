@@ -2,6 +2,7 @@ package gateway
 
 import (
 	"context"
+	"fmt"
 	"net/http"
 	"time"
 
@@ -10,12 +11,15 @@ import (
 	"github.com/go-chi/cors"
 
 	"stdiscm_p4/backend/internal/gateway/handlers"
+	"stdiscm_p4/backend/internal/gateway/openapi"
 	"stdiscm_p4/backend/internal/gateway/util"
 	pb_auth "stdiscm_p4/backend/internal/pb/auth"
+	"stdiscm_p4/backend/internal/shared"
 )
 
 // SetupRoutes configures the Chi router, middleware, and route handlers.
-func SetupRoutes(clients *ServiceClients) *chi.Mux {
+// It fails on invalid configuration; the caller decides how to exit.
+func SetupRoutes(clients *ServiceClients) (*chi.Mux, error) {
 	r := chi.NewRouter()
 
 	// 1. Global Middleware
@@ -23,111 +27,289 @@ func SetupRoutes(clients *ServiceClients) *chi.Mux {
 	r.Use(middleware.Recoverer)
 	r.Use(middleware.RequestID)
 	r.Use(middleware.RealIP)
-	r.Use(middleware.Timeout(60 * time.Second))
 
 	// CORS Configuration (Allow React Frontend)
 	r.Use(cors.Handler(cors.Options{
 		AllowedOrigins:   []string{"http://localhost:3000", "http://localhost:5173"}, // React default ports
 		AllowedMethods:   []string{"GET", "POST", "PUT", "DELETE", "OPTIONS", "PATCH"},
-		AllowedHeaders:   []string{"Accept", "Authorization", "Content-Type", "X-CSRF-Token"},
-		ExposedHeaders:   []string{"Link"},
+		AllowedHeaders:   []string{"Accept", "Authorization", "Content-Type", "X-CSRF-Token", IdempotencyKeyHeader},
+		ExposedHeaders:   []string{"Link", IdempotentReplayHeader},
 		AllowCredentials: true,
 		MaxAge:           300,
 	}))
 
 	// 2. Initialize Handlers
-	authHandler := &handlers.AuthHandler{AuthClient: clients.AuthClient}
+	authHandler := &handlers.AuthHandler{
+		AuthClient:    clients.AuthClient,
+		TokenDelivery: GetEnv("AUTH_TOKEN_DELIVERY", handlers.TokenDeliveryHeader),
+		CookieSecure:  GetEnv("AUTH_COOKIE_SECURE", "true") != "false",
+	}
 	courseHandler := &handlers.CourseHandler{CourseClient: clients.CourseClient}
 	enrollmentHandler := &handlers.EnrollmentHandler{EnrollmentClient: clients.EnrollmentClient}
 	gradeHandler := &handlers.GradeHandler{GradeClient: clients.GradeClient}
-	adminHandler := &handlers.AdminHandler{AdminClient: clients.AdminClient}
+	adminHandler := &handlers.AdminHandler{AdminClient: clients.AdminClient, CourseClient: clients.CourseClient}
+
+	// 3. Per-route-group deadlines, applied to the request context.
+	// Every route opts into one of these; there is no global deadline, which would cap uploads.
+	timeouts := shared.LoadGatewayTimeouts()
+	if err := timeouts.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid gateway timeouts: %w", err)
+	}
+	defaultTimeout := RequestTimeout(timeouts.Default)
+	authTimeout := RequestTimeout(timeouts.Auth)
+	mutationTimeout := RequestTimeout(timeouts.EnrollmentMutations)
+	reportTimeout := RequestTimeout(timeouts.Reports)
+	uploadTimeout := RequestTimeout(timeouts.Uploads)
+	exportTimeout := RequestTimeout(timeouts.Exports)
 
-	// 3. Define Routes (grouped by prefix)
+	// Retried mutations carrying an Idempotency-Key are answered from this store
+	idempotency := NewIdempotencyStore(
+		shared.GetDurationEnv("GATEWAY_IDEMPOTENCY_TTL", 10*time.Minute),
+		shared.GetIntEnv("GATEWAY_IDEMPOTENCY_MAX_KEYS", 10000),
+	)
+	if clients.IdempotencyKeys != nil {
+		if err := idempotency.WithMongo(clients.IdempotencyKeys).EnsureIndexes(context.Background()); err != nil {
+			return nil, err
+		}
+	}
+
+	// Public routes that personalize their response for a signed-in caller
+	optionalAuth := OptionalAuthMiddleware(clients.AuthClient, timeouts.Auth)
+
+	// 4. Define Routes (grouped by prefix)
 	r.Route("/api", func(r chi.Router) {
 
 		// --- Public Routes ---
 
+		// API Reference (the browsable page is withheld from production)
+		r.Get("/openapi.json", openapi.ServeSpec)
+		if GetEnv("ENVIRONMENT", "development") != "production" {
+			r.Get("/docs", openapi.ServeDocs)
+		}
+
 		// Auth
-		r.Post("/auth/login", authHandler.Login)
-		r.Post("/auth/logout", authHandler.Logout) // Logout handles its own token extraction, safe to be public-ish
+		r.With(authTimeout).Post("/auth/login", authHandler.Login)
+		r.With(authTimeout).Post("/auth/logout", authHandler.Logout) // Logout handles its own token extraction, safe to be public-ish
 
 		// Course Catalog (Publicly viewable)
-		r.Get("/courses", courseHandler.ListCourses)
-		r.Get("/courses/{id}", courseHandler.GetCourse)
-		r.Get("/courses/{id}/availability", courseHandler.GetCourseAvailability)
+		r.Group(func(r chi.Router) {
+			r.Use(defaultTimeout)
+			r.With(optionalAuth).Get("/courses", courseHandler.ListCourses)
+			r.With(optionalAuth).Get("/courses/{id}", courseHandler.GetCourse)
+			r.Get("/courses/{id}/availability", courseHandler.GetCourseAvailability)
+			r.Get("/courses/{id}/prerequisite-courses", courseHandler.GetPrerequisites)
+			r.Get("/departments", courseHandler.ListDepartments)
+		})
 
 		// --- Protected Routes (Require Valid Token) ---
 		r.Group(func(r chi.Router) {
 			// Inject Auth Middleware
-			r.Use(AuthMiddleware(clients.AuthClient))
+			r.Use(AuthMiddleware(clients.AuthClient, timeouts.Auth))
+			r.Use(IdempotencyMiddleware(idempotency))
 
-			// Auth (Authenticated Only)
-			r.Get("/auth/validate", authHandler.ValidateToken)
-			r.Post("/auth/change-password", authHandler.ChangePassword)
+			// Auth & Profile (Self only, user ID taken from token)
+			r.Group(func(r chi.Router) {
+				r.Use(authTimeout)
+				r.Get("/auth/validate", authHandler.ValidateToken)
+				r.Post("/auth/change-password", authHandler.ChangePassword)
+				r.Get("/me", authHandler.GetProfile)
+				r.Patch("/me", authHandler.UpdateProfile)
+				r.Get("/profile", authHandler.GetProfile)
+				r.Put("/profile", authHandler.UpdateProfile)
+			})
 
-			// Course Prerequisites (Requires Student ID from token)
-			r.Get("/courses/{id}/prerequisites", courseHandler.CheckPrerequisites)
+			// Course Prerequisites & Reviews (Require Student ID from token)
+			r.With(defaultTimeout).Get("/courses/{id}/prerequisites", courseHandler.CheckPrerequisites)
+			r.With(reportTimeout).Get("/courses/eligible", courseHandler.GetEligibleCourses)
+			r.With(mutationTimeout).Post("/courses/{id}/reviews", courseHandler.SubmitReview)
 
 			// Enrollment (Student Only)
 			r.Route("/cart", func(r chi.Router) {
-				r.Get("/", enrollmentHandler.GetCart)
-				r.Post("/add", enrollmentHandler.AddToCart)
-				r.Delete("/remove/{course_id}", enrollmentHandler.RemoveFromCart)
-				r.Delete("/clear", enrollmentHandler.ClearCart)
+				r.With(defaultTimeout).Get("/", enrollmentHandler.GetCart)
+				r.With(defaultTimeout).Get("/summary", enrollmentHandler.GetCartSummary)
+				r.With(mutationTimeout).Post("/add", enrollmentHandler.AddToCart)
+				r.With(mutationTimeout).Delete("/remove/{course_id}", enrollmentHandler.RemoveFromCart)
+				r.With(mutationTimeout).Delete("/clear", enrollmentHandler.ClearCart)
 			})
 			r.Route("/enrollment", func(r chi.Router) {
-				r.Post("/enroll-all", enrollmentHandler.EnrollAll)
-				r.Post("/drop", enrollmentHandler.DropCourse)
-				r.Get("/schedule", enrollmentHandler.GetStudentEnrollments)
+				r.With(mutationTimeout).Post("/enroll-all", enrollmentHandler.EnrollAll)
+				r.With(mutationTimeout).Post("/drop", enrollmentHandler.DropCourse)
+				r.With(defaultTimeout).Get("/schedule", enrollmentHandler.GetStudentEnrollments)
+				r.With(defaultTimeout).Get("/drops", enrollmentHandler.GetDropHistory)
+				r.With(defaultTimeout).Get("/receipts/{reference_id}", enrollmentHandler.GetEnrollmentReceipt)
+				r.With(defaultTimeout).Get("/status", enrollmentHandler.GetEnrollmentStatus)
+				r.With(reportTimeout).Post("/suggest-schedules", enrollmentHandler.SuggestSchedules)
 			})
 
 			// Grade Management
 			r.Route("/grades", func(r chi.Router) {
 				// Student
-				r.Get("/", gradeHandler.GetStudentGrades)
-				r.Get("/gpa", gradeHandler.CalculateGPA)
+				r.With(defaultTimeout).Get("/", gradeHandler.GetStudentGrades)
+				r.With(defaultTimeout).Get("/gpa", gradeHandler.CalculateGPA)
+				r.With(defaultTimeout).Get("/semesters", gradeHandler.GetStudentSemesters)
 
 				// Faculty
-				r.Get("/roster/{course_id}", gradeHandler.GetClassRoster)
-				r.Get("/course/{course_id}", gradeHandler.GetCourseGrades)
-				r.Post("/upload/{course_id}", gradeHandler.UploadGrades)
-				r.Post("/publish/{course_id}", gradeHandler.PublishGrades)
+				r.With(reportTimeout).Get("/roster/{course_id}", gradeHandler.GetClassRoster)
+				r.With(defaultTimeout).Get("/course/{course_id}", gradeHandler.GetCourseGrades)
+				r.With(uploadTimeout).Post("/upload/{course_id}", gradeHandler.UploadGrades)
+				r.With(defaultTimeout).Post("/publish/{course_id}", gradeHandler.PublishGrades)
+
+				// Appeals (students submit, course faculty resolve)
+				r.With(mutationTimeout).Post("/appeals", gradeHandler.SubmitGradeAppeal)
+				r.With(defaultTimeout).Get("/appeals", gradeHandler.ListGradeAppeals)
+				r.With(mutationTimeout).Post("/appeals/{id}/resolve", gradeHandler.ResolveGradeAppeal)
 			})
 
+			// Student GPA what-if (nothing is saved)
+			r.With(defaultTimeout).Post("/student/gpa/simulate", gradeHandler.SimulateGPA)
+
+			// Student grades: self-access via the token, lookup by ID for faculty and admins
+			r.With(defaultTimeout).Get("/student/grades", gradeHandler.GetStudentGrades)
+			r.With(mutationTimeout).Post("/student/grades/{enrollment_id}/appeal", gradeHandler.SubmitGradeAppeal)
+			r.With(defaultTimeout).Get("/students/{id}/grades", gradeHandler.GetStudentGradesByID)
+
+			// Faculty Course Tools
+			r.With(reportTimeout).Get("/faculty/courses/{id}/roster.csv", gradeHandler.ExportClassRoster)
+			r.With(defaultTimeout).Get("/faculty/courses/{id}/grade-draft", gradeHandler.GetGradeDraft)
+			r.With(defaultTimeout).Put("/faculty/courses/{id}/grade-draft", gradeHandler.SaveGradeDraft)
+			r.With(uploadTimeout).Post("/faculty/courses/{id}/grade-draft/finalize", gradeHandler.FinalizeGradeDraft)
+			r.With(defaultTimeout).Post("/faculty/courses/{id}/materials", courseHandler.AddCourseMaterial)
+			r.With(defaultTimeout).Delete("/faculty/courses/{id}/materials/{material_id}", courseHandler.RemoveCourseMaterial)
+			r.With(defaultTimeout).Get("/faculty/courses/{id}/reviews/summary", courseHandler.GetCourseReviewSummary)
+			r.With(defaultTimeout).Get("/faculty/appeals", gradeHandler.ListFacultyAppeals)
+			r.With(mutationTimeout).Post("/faculty/appeals/{id}/resolve", gradeHandler.ResolveGradeAppeal)
+
 			// Admin Management
 			r.Route("/admin", func(r chi.Router) {
-				r.Get("/stats", adminHandler.GetSystemStats)
-				r.Get("/config", adminHandler.GetSystemConfig)
-				r.Put("/config/{key}", adminHandler.UpdateSystemConfig)
-
-				// Courses
-				r.Post("/courses", adminHandler.CreateCourse)
-				r.Put("/courses/{id}", adminHandler.UpdateCourse)
-				r.Delete("/courses/{id}", adminHandler.DeleteCourse)
-				r.Post("/courses/{id}/assign-faculty", adminHandler.AssignFaculty)
-
-				// Users
-				r.Post("/users", adminHandler.CreateUser)
-				r.Get("/users", adminHandler.ListUsers)
-				r.Post("/users/{id}/reset-password", adminHandler.ResetPassword)
-				r.Patch("/users/{id}/status", adminHandler.ToggleUserStatus)
-
-				// Enrollment Config
-				r.Post("/enrollment/period", adminHandler.SetEnrollmentPeriod)
-				r.Post("/enrollment/toggle", adminHandler.ToggleEnrollment)
-
-				// Overrides
-				r.Post("/override/enroll", adminHandler.OverrideEnroll)
-				r.Post("/override/drop", adminHandler.OverrideDrop)
+				// Reports, Repairs & Imports
+				r.With(reportTimeout).Post("/enrollment/recalculate", adminHandler.RecalculateEnrollmentCounts)
+				r.With(reportTimeout).Post("/semesters/close", adminHandler.CloseSemester)
+				r.With(reportTimeout).Post("/semesters/publish-grades", adminHandler.PublishAllGrades)
+				r.With(reportTimeout).Post("/courses/{id}/cancel", adminHandler.CancelCourse)
+				r.With(reportTimeout).Get("/courses/{id}/fill-timeline", adminHandler.GetCourseFillTimeline)
+				r.With(reportTimeout).Get("/reports/faculty-load", adminHandler.GetFacultyLoadReport)
+				r.With(reportTimeout).Get("/reports/faculty-load.csv", adminHandler.ExportFacultyLoadReport)
+				r.With(reportTimeout).Get("/reports/grades", gradeHandler.GetSemesterGradeReport)
+				r.With(exportTimeout).Get("/exports/grades", gradeHandler.ExportGrades)
+				r.With(reportTimeout).Get("/reports/enrollment-trend", adminHandler.GetEnrollmentTrend)
+				r.With(uploadTimeout).Post("/courses/import", adminHandler.ImportCourses)
+
+				// Sessions (served by the Auth Service)
+				r.Group(func(r chi.Router) {
+					r.Use(authTimeout)
+					r.Get("/users/{id}/sessions", authHandler.ListUserSessions)
+					r.Delete("/users/{id}/sessions", authHandler.TerminateUserSessions)
+					r.Delete("/users/{id}/sessions/{session_id}", authHandler.TerminateUserSession)
+				})
+
+				r.Group(func(r chi.Router) {
+					r.Use(defaultTimeout)
+					r.Get("/stats", adminHandler.GetSystemStats)
+					r.Get("/catalog-cache", courseHandler.GetCatalogCacheStats)
+					r.Get("/config", adminHandler.GetSystemConfig)
+					r.Put("/config/{key}", adminHandler.UpdateSystemConfig)
+
+					// Courses
+					r.Post("/courses", adminHandler.CreateCourse)
+					r.Post("/courses/validate-schedule", adminHandler.ValidateCourseSchedule)
+					r.Get("/courses/nearly-full", adminHandler.GetNearlyFullCourses)
+					r.Put("/courses/{id}", adminHandler.UpdateCourse)
+					r.Delete("/courses/{id}", adminHandler.DeleteCourse)
+					r.Post("/courses/{id}/assign-faculty", adminHandler.AssignFaculty)
+					r.Post("/courses/{id}/prerequisites", adminHandler.AddPrerequisite)
+					r.Delete("/courses/{id}/prerequisites/{prereq_id}", adminHandler.RemovePrerequisite)
+
+					// Departments
+					r.Post("/departments", adminHandler.CreateDepartment)
+					r.Put("/departments/{code}", adminHandler.UpdateDepartment)
+					r.Delete("/departments/{code}", adminHandler.DeleteDepartment)
+
+					// Users
+					r.Post("/users", adminHandler.CreateUser)
+					r.Get("/users", adminHandler.ListUsers)
+					r.Get("/users/{id}", adminHandler.GetUserDetail)
+					r.Post("/users/{id}/reset-password", adminHandler.ResetPassword)
+					r.Patch("/users/{id}/status", adminHandler.ToggleUserStatus)
+					r.Post("/transfer-credits", adminHandler.AddTransferCredit)
+
+					// Holds
+					r.Get("/users/{id}/holds", adminHandler.GetStudentHolds)
+					r.Post("/holds", adminHandler.PlaceHold)
+					r.Post("/holds/{id}/clear", adminHandler.ClearHold)
+
+					// Enrollment Config
+					r.Post("/enrollment/period", adminHandler.SetEnrollmentPeriod)
+					r.Post("/enrollment/toggle", adminHandler.ToggleEnrollment)
+
+					// Overrides
+					r.Post("/override/enroll", adminHandler.OverrideEnroll)
+					r.Post("/override/drop", adminHandler.OverrideDrop)
+					r.Post("/override/restore", adminHandler.RestoreEnrollment)
+
+					// Audit
+					r.Get("/audit/history", adminHandler.GetResourceHistory)
+				})
 			})
 		})
 	})
 
-	return r
+	return r, nil
+}
+
+// RequestTimeout bounds the request context with the given deadline so every
+// downstream gRPC call made by the handler inherits (and propagates) it.
+func RequestTimeout(timeout time.Duration) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx, cancel := context.WithTimeout(r.Context(), timeout)
+			defer cancel()
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+// passwordChangePaths are the only protected routes open to a user who must
+// still replace an admin-issued password.
+var passwordChangePaths = map[string]bool{
+	"/api/auth/change-password": true,
+	"/api/auth/validate":        true,
+}
+
+// OptionalAuthMiddleware puts the caller in the context like AuthMiddleware when the
+// request carries a valid token and lets it through anonymously otherwise, so a
+// public route can tell signed-in users apart without requiring a login.
+// Users flagged to change their password are refused as AuthMiddleware does.
+func OptionalAuthMiddleware(authClient pb_auth.AuthServiceClient, timeout time.Duration) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			tokenStr, err := util.ExtractToken(r)
+			if err != nil {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			ctx, cancel := context.WithTimeout(r.Context(), timeout)
+			validateResp, err := authClient.ValidateToken(ctx, &pb_auth.ValidateTokenRequest{Token: tokenStr})
+			cancel()
+			if err != nil || !validateResp.Valid {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			if validateResp.MustChangePassword {
+				util.WriteJSONErrorCode(w, http.StatusForbidden, shared.ErrCodePasswordChangeRequired, "Password change required")
+				return
+			}
+
+			next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), "user", validateResp.User)))
+		})
+	}
 }
 
 // AuthMiddleware creates a middleware that validates JWT tokens via the Auth Service.
-func AuthMiddleware(authClient pb_auth.AuthServiceClient) func(http.Handler) http.Handler {
+// The validation call is bounded by timeout, independently of the route's own deadline.
+// Users flagged to change their password are held to passwordChangePaths.
+func AuthMiddleware(authClient pb_auth.AuthServiceClient, timeout time.Duration) func(http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			// 1. Extract Token
@@ -138,7 +320,7 @@ func AuthMiddleware(authClient pb_auth.AuthServiceClient) func(http.Handler) htt
 			}
 
 			// 2. Validate via gRPC
-			ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+			ctx, cancel := context.WithTimeout(r.Context(), timeout)
 			defer cancel()
 
 			validateReq := &pb_auth.ValidateTokenRequest{Token: tokenStr}
@@ -155,6 +337,11 @@ func AuthMiddleware(authClient pb_auth.AuthServiceClient) func(http.Handler) htt
 				return
 			}
 
+			if validateResp.MustChangePassword && !passwordChangePaths[r.URL.Path] {
+				util.WriteJSONErrorCode(w, http.StatusForbidden, shared.ErrCodePasswordChangeRequired, "Password change required")
+				return
+			}
+
 			// 3. Inject User into Context
 			// The handlers can now access user details via r.Context().Value("user")
 			ctxWithUser := context.WithValue(r.Context(), "user", validateResp.User)
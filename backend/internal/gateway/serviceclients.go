@@ -6,14 +6,16 @@ import (
 	"os"
 	"time"
 
+	"go.mongodb.org/mongo-driver/mongo"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/credentials"
 
 	pb_admin "stdiscm_p4/backend/internal/pb/admin"
 	pb_auth "stdiscm_p4/backend/internal/pb/auth"
 	pb_course "stdiscm_p4/backend/internal/pb/course"
 	pb_enrollment "stdiscm_p4/backend/internal/pb/enrollment"
 	pb_grade "stdiscm_p4/backend/internal/pb/grade"
+	"stdiscm_p4/backend/internal/shared"
 )
 
 // ServiceClients holds all gRPC clients for the backend services.
@@ -25,13 +27,17 @@ type ServiceClients struct {
 	GradeClient      pb_grade.GradeServiceClient
 	AdminClient      pb_admin.AdminServiceClient
 
+	// IdempotencyKeys backs the Idempotency-Key store when set; nil keeps keys in memory only
+	IdempotencyKeys *mongo.Collection
+
 	// Keep connections to close them later when the gateway shuts down
-	conns []*grpc.ClientConn
+	conns       []*grpc.ClientConn
+	mongoClient *mongo.Client
 }
 
 // MustConnectGRPC establishes a connection to a gRPC server or panics.
-// We use insecure credentials here as per the architecture (internal node communication).
-func MustConnectGRPC(addr string) *grpc.ClientConn {
+// Transport credentials come from shared.ClientCredentials (TLS, mTLS or plaintext).
+func MustConnectGRPC(addr string, creds credentials.TransportCredentials) *grpc.ClientConn {
 	log.Printf("INFO: Connecting to gRPC service at %s...", addr)
 
 	// We use WithBlock() to ensure the connection is established before proceeding.
@@ -40,7 +46,7 @@ func MustConnectGRPC(addr string) *grpc.ClientConn {
 	defer cancel()
 
 	conn, err := grpc.DialContext(ctx, addr,
-		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithTransportCredentials(creds),
 		grpc.WithBlock(),
 	)
 
@@ -62,21 +68,40 @@ func NewServiceClients() *ServiceClients {
 	gradeAddr := GetEnv("GRADE_SERVICE_ADDR", "localhost:50054")
 	adminAddr := GetEnv("ADMIN_SERVICE_ADDR", "localhost:50055")
 
-	// 2. Establish Connections
-	authConn := MustConnectGRPC(authAddr)
-	courseConn := MustConnectGRPC(courseAddr)
-	enrollmentConn := MustConnectGRPC(enrollmentAddr)
-	gradeConn := MustConnectGRPC(gradeAddr)
-	adminConn := MustConnectGRPC(adminAddr)
+	// 2. Resolve transport security (see shared.TLSConfig for the env vars)
+	creds, err := shared.ClientCredentials(shared.LoadTLSConfig())
+	if err != nil {
+		log.Fatalf("FATAL: Failed to configure gRPC transport security: %v", err)
+	}
+
+	// 3. Establish Connections
+	authConn := MustConnectGRPC(authAddr, creds)
+	courseConn := MustConnectGRPC(courseAddr, creds)
+	enrollmentConn := MustConnectGRPC(enrollmentAddr, creds)
+	gradeConn := MustConnectGRPC(gradeAddr, creds)
+	adminConn := MustConnectGRPC(adminAddr, creds)
 
-	// 3. Create Clients and return the struct
+	// 4. Optional MongoDB for idempotency keys shared between gateway instances
+	var mongoClient *mongo.Client
+	var idempotencyKeys *mongo.Collection
+	if uri := GetEnv("GATEWAY_IDEMPOTENCY_MONGO_URI", ""); uri != "" {
+		client, db, err := shared.ConnectMongoDB(shared.DefaultMongoConfig(uri, GetEnv("MONGO_DB_NAME", "ProblemSet4")))
+		if err != nil {
+			log.Fatalf("FATAL: Failed to connect to the idempotency key store: %v", err)
+		}
+		mongoClient, idempotencyKeys = client, db.Collection("idempotency_keys")
+	}
+
+	// 5. Create Clients and return the struct
 	return &ServiceClients{
 		AuthClient:       pb_auth.NewAuthServiceClient(authConn),
 		CourseClient:     pb_course.NewCourseServiceClient(courseConn),
 		EnrollmentClient: pb_enrollment.NewEnrollmentServiceClient(enrollmentConn),
 		GradeClient:      pb_grade.NewGradeServiceClient(gradeConn),
 		AdminClient:      pb_admin.NewAdminServiceClient(adminConn),
+		IdempotencyKeys:  idempotencyKeys,
 		conns:            []*grpc.ClientConn{authConn, courseConn, enrollmentConn, gradeConn, adminConn},
+		mongoClient:      mongoClient,
 	}
 }
 
@@ -88,6 +113,9 @@ func (sc *ServiceClients) Close() {
 			log.Printf("WARN: Error closing gRPC connection: %v", err)
 		}
 	}
+	if err := shared.DisconnectMongoDB(sc.mongoClient); err != nil {
+		log.Printf("WARN: %v", err)
+	}
 }
 
 // Helper to read env vars with a default fallback
@@ -6,10 +6,14 @@ import (
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 
+	"go.mongodb.org/mongo-driver/bson"
+
 	pb_admin "stdiscm_p4/backend/internal/pb/admin"
 	pb_auth "stdiscm_p4/backend/internal/pb/auth"
+	"stdiscm_p4/backend/internal/shared"
 )
 
 func TestGateway_Admin(t *testing.T) {
@@ -17,14 +21,13 @@ func TestGateway_Admin(t *testing.T) {
 	ctx := context.Background()
 
 	// --- Setup: Create Admin User & Get Token ---
-	uResp, _ := env.AdminClient.CreateUser(ctx, &pb_admin.CreateUserRequest{
+	uResp, _ := createTestUser(ctx, env, &pb_admin.CreateUserRequest{
 		Email: "admin@test.com", Role: "admin", Name: "Gateway Admin",
 	})
 	lResp, _ := env.AuthClient.Login(ctx, &pb_auth.LoginRequest{
 		Identifier: "admin@test.com", Password: uResp.InitialPassword,
 	})
 	adminToken := lResp.Token
-	adminID := lResp.User.Id
 
 	// --- Test 1: Get System Stats (GET /api/admin/stats) ---
 	t.Run("Get System Stats", func(t *testing.T) {
@@ -39,8 +42,15 @@ func TestGateway_Admin(t *testing.T) {
 		// Basic body check
 		var resp map[string]interface{}
 		json.Unmarshal(rr.Body.Bytes(), &resp)
-		if _, ok := resp["stats"]; !ok {
-			t.Error("Response missing 'stats' object")
+		stats, ok := resp["stats"].(map[string]interface{})
+		if !ok {
+			t.Fatal("Response missing 'stats' object")
+		}
+		// Closed enrollment and zero counts are still reported
+		for _, field := range []string{"enrollment_open", "current_semester", "enrollments_today", "drops_today", "average_fill_rate"} {
+			if _, ok := stats[field]; !ok {
+				t.Errorf("Stats missing %q: %v", field, stats)
+			}
 		}
 	})
 
@@ -100,7 +110,7 @@ func TestGateway_Admin(t *testing.T) {
 	// --- Test 2c: Assign Faculty (POST /api/admin/courses/:id/assign-faculty) ---
 	t.Run("Assign Faculty", func(t *testing.T) {
 		// Create Faculty first to assign
-		facResp, _ := env.AdminClient.CreateUser(ctx, &pb_admin.CreateUserRequest{
+		facResp, _ := createTestUser(ctx, env, &pb_admin.CreateUserRequest{
 			Email: "faculty@test.com", Role: "faculty", Name: "Test Faculty", FacultyId: "FAC-NEW",
 		})
 
@@ -209,21 +219,26 @@ func TestGateway_Admin(t *testing.T) {
 
 	// --- Test 4b: Set Enrollment Period (POST /api/admin/enrollment/period) ---
 	t.Run("Set Enrollment Period", func(t *testing.T) {
-		body := map[string]string{
-			"start_date": "2024-01-01T00:00:00Z",
-			"end_date":   "2024-02-01T00:00:00Z",
+		setPeriod := func(start, end string) *httptest.ResponseRecorder {
+			jsonBody, _ := json.Marshal(map[string]string{"start_date": start, "end_date": end})
+			req, _ := http.NewRequest("POST", "/api/admin/enrollment/period", bytes.NewBuffer(jsonBody))
+			req.Header.Set("Authorization", "Bearer "+adminToken)
+			req.Header.Set("Content-Type", "application/json")
+
+			rr := httptest.NewRecorder()
+			env.Router.ServeHTTP(rr, req)
+			return rr
 		}
-		jsonBody, _ := json.Marshal(body)
-		req, _ := http.NewRequest("POST", "/api/admin/enrollment/period", bytes.NewBuffer(jsonBody))
-		req.Header.Set("Authorization", "Bearer "+adminToken)
-		req.Header.Set("Content-Type", "application/json")
 
-		rr := httptest.NewRecorder()
-		env.Router.ServeHTTP(rr, req)
-
-		if rr.Code != http.StatusOK {
+		if rr := setPeriod("2031-01-01T00:00:00Z", "2031-02-01T00:00:00Z"); rr.Code != http.StatusOK {
 			t.Errorf("Expected 200 OK, got %d", rr.Code)
 		}
+		if rr := setPeriod("2031-02-01T00:00:00Z", "2031-01-01T00:00:00Z"); rr.Code != http.StatusBadRequest {
+			t.Errorf("Expected 400 for a reversed range, got %d. Body: %s", rr.Code, rr.Body.String())
+		}
+		if rr := setPeriod("not-a-date", "2031-02-01T00:00:00Z"); rr.Code != http.StatusBadRequest {
+			t.Errorf("Expected 400 for a malformed date, got %d. Body: %s", rr.Code, rr.Body.String())
+		}
 	})
 
 	// --- Test 5: Overrides (POST /api/admin/override/enroll) ---
@@ -235,7 +250,6 @@ func TestGateway_Admin(t *testing.T) {
 			"student_id": createdUserID,
 			"course_id":  createdCourseID,
 			"reason":     "Testing override",
-			"admin_id":   adminID,
 		}
 		jsonBody, _ := json.Marshal(body)
 		req, _ := http.NewRequest("POST", "/api/admin/override/enroll", bytes.NewBuffer(jsonBody))
@@ -256,7 +270,6 @@ func TestGateway_Admin(t *testing.T) {
 			"student_id": createdUserID,
 			"course_id":  createdCourseID,
 			"reason":     "Testing override drop",
-			"admin_id":   adminID,
 		}
 		jsonBody, _ := json.Marshal(body)
 		req, _ := http.NewRequest("POST", "/api/admin/override/drop", bytes.NewBuffer(jsonBody))
@@ -300,6 +313,250 @@ func TestGateway_Admin(t *testing.T) {
 		}
 	})
 
+	// --- Test: User Sessions (GET/DELETE /api/admin/users/:id/sessions) ---
+	t.Run("User Sessions", func(t *testing.T) {
+		sResp, _ := createTestUser(ctx, env, &pb_admin.CreateUserRequest{
+			Email: "session_user@test.com", Role: "student", Name: "Session User", StudentId: "202100077",
+		})
+		sessionUserID := sResp.User.Id
+
+		// Login through the gateway so the client IP is forwarded
+		loginBody, _ := json.Marshal(map[string]string{"identifier": "session_user@test.com", "password": sResp.InitialPassword})
+		loginReq, _ := http.NewRequest("POST", "/api/auth/login", bytes.NewBuffer(loginBody))
+		loginReq.Header.Set("Content-Type", "application/json")
+		loginReq.Header.Set("X-Real-IP", "198.51.100.23")
+		loginRR := httptest.NewRecorder()
+		env.Router.ServeHTTP(loginRR, loginReq)
+		if loginRR.Code != http.StatusOK {
+			t.Fatalf("Login failed: %d %s", loginRR.Code, loginRR.Body.String())
+		}
+
+		req, _ := http.NewRequest("GET", "/api/admin/users/"+sessionUserID+"/sessions", nil)
+		req.Header.Set("Authorization", "Bearer "+adminToken)
+		rr := httptest.NewRecorder()
+		env.Router.ServeHTTP(rr, req)
+
+		if rr.Code != http.StatusOK {
+			t.Fatalf("Expected 200 OK, got %d. Body: %s", rr.Code, rr.Body.String())
+		}
+		var listResp map[string]interface{}
+		json.Unmarshal(rr.Body.Bytes(), &listResp)
+		sessions, _ := listResp["sessions"].([]interface{})
+		if len(sessions) != 1 {
+			t.Fatalf("Expected 1 session, got %v", listResp["sessions"])
+		}
+		if sess := sessions[0].(map[string]interface{}); sess["ip_address"] != "198.51.100.23" || sess["active"] != true {
+			t.Errorf("Unexpected session: %v", sess)
+		}
+
+		req, _ = http.NewRequest("DELETE", "/api/admin/users/"+sessionUserID+"/sessions", nil)
+		req.Header.Set("Authorization", "Bearer "+adminToken)
+		rr = httptest.NewRecorder()
+		env.Router.ServeHTTP(rr, req)
+
+		if rr.Code != http.StatusOK {
+			t.Errorf("Expected 200 OK, got %d. Body: %s", rr.Code, rr.Body.String())
+		}
+		var termResp map[string]interface{}
+		json.Unmarshal(rr.Body.Bytes(), &termResp)
+		if termResp["terminated_count"] != float64(1) {
+			t.Errorf("Expected 1 terminated session, got %v", termResp["terminated_count"])
+		}
+	})
+
+	// --- Test: Faculty Load Report (GET /api/admin/reports/faculty-load[.csv]) ---
+	t.Run("Faculty Load Report", func(t *testing.T) {
+		req, _ := http.NewRequest("GET", "/api/admin/reports/faculty-load?semester=TestSem", nil)
+		req.Header.Set("Authorization", "Bearer "+adminToken)
+		rr := httptest.NewRecorder()
+		env.Router.ServeHTTP(rr, req)
+
+		if rr.Code != http.StatusOK {
+			t.Fatalf("Expected 200 OK, got %d. Body: %s", rr.Code, rr.Body.String())
+		}
+		var resp map[string]interface{}
+		json.Unmarshal(rr.Body.Bytes(), &resp)
+		if _, ok := resp["max_units"]; !ok {
+			t.Error("Response missing 'max_units'")
+		}
+
+		req, _ = http.NewRequest("GET", "/api/admin/reports/faculty-load.csv?semester=TestSem", nil)
+		req.Header.Set("Authorization", "Bearer "+adminToken)
+		rr = httptest.NewRecorder()
+		env.Router.ServeHTTP(rr, req)
+
+		if rr.Code != http.StatusOK || !strings.HasPrefix(rr.Header().Get("Content-Type"), "text/csv") {
+			t.Fatalf("Expected a CSV download, got %d (%s)", rr.Code, rr.Header().Get("Content-Type"))
+		}
+		if !strings.HasPrefix(rr.Body.String(), "faculty_id,name,email,courses,units,enrolled,overloaded,contact_hours") {
+			t.Errorf("Unexpected CSV header: %q", rr.Body.String())
+		}
+
+		// The semester is required
+		req, _ = http.NewRequest("GET", "/api/admin/reports/faculty-load.csv", nil)
+		req.Header.Set("Authorization", "Bearer "+adminToken)
+		rr = httptest.NewRecorder()
+		env.Router.ServeHTTP(rr, req)
+		if rr.Code != http.StatusBadRequest {
+			t.Errorf("Expected 400 without a semester, got %d", rr.Code)
+		}
+	})
+
+	// --- Test: Semester Grade Report (GET /api/admin/reports/grades) ---
+	t.Run("Semester Grade Report", func(t *testing.T) {
+		req, _ := http.NewRequest("GET", "/api/admin/reports/grades?semester=TestSem", nil)
+		req.Header.Set("Authorization", "Bearer "+adminToken)
+		rr := httptest.NewRecorder()
+		env.Router.ServeHTTP(rr, req)
+
+		if rr.Code != http.StatusOK {
+			t.Fatalf("Expected 200 OK, got %d. Body: %s", rr.Code, rr.Body.String())
+		}
+		var resp map[string]interface{}
+		json.Unmarshal(rr.Body.Bytes(), &resp)
+		overall, ok := resp["overall"].(map[string]interface{})
+		if !ok {
+			t.Fatalf("Response missing 'overall': %v", resp)
+		}
+		// Zero rates are still reported
+		if _, ok := overall["pass_rate"]; !ok {
+			t.Errorf("Expected pass_rate in overall stats, got %v", overall)
+		}
+
+		req, _ = http.NewRequest("GET", "/api/admin/reports/grades", nil)
+		req.Header.Set("Authorization", "Bearer "+adminToken)
+		rr = httptest.NewRecorder()
+		env.Router.ServeHTTP(rr, req)
+		if rr.Code != http.StatusBadRequest {
+			t.Errorf("Expected 400 without a semester, got %d", rr.Code)
+		}
+	})
+
+	// --- Test: Grade Export (GET /api/admin/exports/grades) ---
+	t.Run("Grade Export", func(t *testing.T) {
+		get := func(query string) *httptest.ResponseRecorder {
+			req, _ := http.NewRequest("GET", "/api/admin/exports/grades"+query, nil)
+			req.Header.Set("Authorization", "Bearer "+adminToken)
+			rr := httptest.NewRecorder()
+			env.Router.ServeHTTP(rr, req)
+			return rr
+		}
+
+		rr := get("?semester=TestSem")
+		if rr.Code != http.StatusOK || !strings.HasPrefix(rr.Header().Get("Content-Type"), "text/csv") {
+			t.Fatalf("Expected a CSV download, got %d (%s)", rr.Code, rr.Header().Get("Content-Type"))
+		}
+		if !strings.HasPrefix(rr.Body.String(), "enrollment_id,student_id,student_name,course_id,course_code") {
+			t.Errorf("Unexpected CSV header: %q", rr.Body.String())
+		}
+		if rr.Result().Trailer.Get("X-Total-Rows") == "" {
+			t.Error("Expected the row count in the X-Total-Rows trailer")
+		}
+
+		rr = get("?semester=TestSem&format=ndjson&published_only=false")
+		lines := strings.Split(strings.TrimSpace(rr.Body.String()), "\n")
+		if rr.Code != http.StatusOK || !strings.HasPrefix(lines[len(lines)-1], `{"summary":`) {
+			t.Errorf("Expected NDJSON ending in a summary line, got %d: %q", rr.Code, rr.Body.String())
+		}
+
+		for _, query := range []string{"", "?semester=TestSem&format=xml", "?semester=TestSem&published_only=maybe"} {
+			if rr := get(query); rr.Code != http.StatusBadRequest {
+				t.Errorf("Expected 400 for %q, got %d", query, rr.Code)
+			}
+		}
+	})
+
+	// --- Test: Enrollment Trend (GET /api/admin/reports/enrollment-trend) ---
+	t.Run("Enrollment Trend", func(t *testing.T) {
+		req, _ := http.NewRequest("GET", "/api/admin/reports/enrollment-trend?course_id="+createdCourseID+"&interval=hour&from=2030-01-01", nil)
+		req.Header.Set("Authorization", "Bearer "+adminToken)
+		rr := httptest.NewRecorder()
+		env.Router.ServeHTTP(rr, req)
+
+		if rr.Code != http.StatusOK {
+			t.Fatalf("Expected 200 OK, got %d. Body: %s", rr.Code, rr.Body.String())
+		}
+		var resp map[string]interface{}
+		json.Unmarshal(rr.Body.Bytes(), &resp)
+		if _, ok := resp["buckets"].([]interface{}); !ok || resp["interval"] != "hour" {
+			t.Errorf("Expected an hourly bucket list, got %v", resp)
+		}
+
+		req, _ = http.NewRequest("GET", "/api/admin/reports/enrollment-trend?course_id="+createdCourseID+"&from=yesterday", nil)
+		req.Header.Set("Authorization", "Bearer "+adminToken)
+		rr = httptest.NewRecorder()
+		env.Router.ServeHTTP(rr, req)
+		if rr.Code != http.StatusBadRequest {
+			t.Errorf("Expected 400 for an unparseable from, got %d", rr.Code)
+		}
+	})
+
+	// --- Test: Manage Prerequisites (POST/DELETE /api/admin/courses/:id/prerequisites) ---
+	t.Run("Manage Prerequisites", func(t *testing.T) {
+		prereqID := "GATEWAY-PRQ-100"
+		env.DB.Collection("courses").InsertOne(ctx, shared.Course{
+			ID: prereqID, Code: prereqID, Title: "Gateway Prerequisite", Units: 3, Capacity: 20, Semester: "TestSem",
+		})
+		defer env.DB.Collection("courses").DeleteOne(ctx, bson.M{"_id": prereqID})
+		defer env.DB.Collection("prerequisites").DeleteMany(ctx, bson.M{"course_id": bson.M{"$in": []string{createdCourseID, prereqID}}})
+
+		addLink := func(courseID, prereq string) *httptest.ResponseRecorder {
+			body, _ := json.Marshal(map[string]string{"prereq_id": prereq})
+			req, _ := http.NewRequest("POST", "/api/admin/courses/"+courseID+"/prerequisites", bytes.NewBuffer(body))
+			req.Header.Set("Authorization", "Bearer "+adminToken)
+			rr := httptest.NewRecorder()
+			env.Router.ServeHTTP(rr, req)
+			return rr
+		}
+
+		rr := addLink(createdCourseID, prereqID)
+		if rr.Code != http.StatusOK {
+			t.Fatalf("Expected 200 OK, got %d. Body: %s", rr.Code, rr.Body.String())
+		}
+		var resp map[string]interface{}
+		json.Unmarshal(rr.Body.Bytes(), &resp)
+		if prereqs, _ := resp["prerequisites"].([]interface{}); len(prereqs) != 1 {
+			t.Errorf("Expected 1 prerequisite, got %v", resp["prerequisites"])
+		}
+
+		rr = addLink(prereqID, createdCourseID)
+		if rr.Code != http.StatusConflict {
+			t.Errorf("Expected 409 for a cycle, got %d", rr.Code)
+		}
+		var errResp map[string]interface{}
+		json.Unmarshal(rr.Body.Bytes(), &errResp)
+		if errResp["code"] != "PREREQUISITE_CYCLE" {
+			t.Errorf("Expected code PREREQUISITE_CYCLE, got %v", errResp["code"])
+		}
+
+		req, _ := http.NewRequest("DELETE", "/api/admin/courses/"+createdCourseID+"/prerequisites/"+prereqID, nil)
+		req.Header.Set("Authorization", "Bearer "+adminToken)
+		rr = httptest.NewRecorder()
+		env.Router.ServeHTTP(rr, req)
+		if rr.Code != http.StatusOK {
+			t.Errorf("Expected 200 OK removing the prerequisite, got %d. Body: %s", rr.Code, rr.Body.String())
+		}
+	})
+
+	// --- Test: Missing entities map to 404 ---
+	t.Run("Missing Entities Return 404", func(t *testing.T) {
+		cases := []struct{ method, path, body string }{
+			{"PUT", "/api/admin/courses/NO-SUCH-COURSE", `{"title":"Ghost"}`},
+			{"DELETE", "/api/admin/courses/NO-SUCH-COURSE", ""},
+			{"POST", "/api/admin/users/NO-SUCH-USER/reset-password", ""},
+			{"PATCH", "/api/admin/users/NO-SUCH-USER/status", `{"activate":true}`},
+		}
+		for _, c := range cases {
+			req, _ := http.NewRequest(c.method, c.path, bytes.NewBufferString(c.body))
+			req.Header.Set("Authorization", "Bearer "+adminToken)
+			rr := httptest.NewRecorder()
+			env.Router.ServeHTTP(rr, req)
+			if rr.Code != http.StatusNotFound {
+				t.Errorf("%s %s: expected 404, got %d. Body: %s", c.method, c.path, rr.Code, rr.Body.String())
+			}
+		}
+	})
+
 	// --- Cleanup: Delete Course ---
 	t.Run("Delete Course", func(t *testing.T) {
 		req, _ := http.NewRequest("DELETE", "/api/admin/courses/"+createdCourseID, nil)
@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 
 	pb_admin "stdiscm_p4/backend/internal/pb/admin"
@@ -16,7 +17,7 @@ func TestGateway_Auth(t *testing.T) {
 	ctx := context.Background()
 
 	// Setup: Create user via Admin Service directly
-	uResp, _ := env.AdminClient.CreateUser(ctx, &pb_admin.CreateUserRequest{
+	uResp, _ := createTestUser(ctx, env, &pb_admin.CreateUserRequest{
 		Email: "auth_user@test.com", Role: "student", Name: "Auth User", StudentId: "AUTH001",
 	})
 	userPass := uResp.InitialPassword
@@ -46,6 +47,12 @@ func TestGateway_Auth(t *testing.T) {
 		} else {
 			t.Error("Token missing in response")
 		}
+		if expiresIn, _ := resp["expires_in_seconds"].(float64); expiresIn <= 0 {
+			t.Error("expires_in_seconds missing in response")
+		}
+		if len(rr.Result().Cookies()) != 0 {
+			t.Error("Header mode should not set a token cookie")
+		}
 	})
 
 	// --- Test 2: Validate Token (GET /api/auth/validate) -> gRPC ValidateToken ---
@@ -61,7 +68,59 @@ func TestGateway_Auth(t *testing.T) {
 		}
 	})
 
-	// --- Test 3: Change Password (POST /api/auth/change-password) -> gRPC ChangePassword ---
+	// --- Test 3: Update Profile (PATCH /api/me) -> gRPC UpdateProfile ---
+	t.Run("Update Profile", func(t *testing.T) {
+		// user_id, role and admin-managed fields are not part of the body and are rejected
+		rejected, _ := json.Marshal(map[string]string{"display_name": "Authy", "role": "admin"})
+		req, _ := http.NewRequest("PATCH", "/api/me", bytes.NewBuffer(rejected))
+		req.Header.Set("Authorization", "Bearer "+authToken)
+		req.Header.Set("Content-Type", "application/json")
+
+		rr := httptest.NewRecorder()
+		env.Router.ServeHTTP(rr, req)
+
+		if rr.Code != http.StatusBadRequest || !strings.Contains(rr.Body.String(), "UNKNOWN_FIELD") {
+			t.Fatalf("Expected 400 UNKNOWN_FIELD for role in body, got %d. Body: %s", rr.Code, rr.Body.String())
+		}
+
+		body := map[string]string{
+			"display_name": "Authy",
+			"phone":        "0917-555-0101",
+		}
+		jsonBody, _ := json.Marshal(body)
+		req, _ = http.NewRequest("PATCH", "/api/me", bytes.NewBuffer(jsonBody))
+		req.Header.Set("Authorization", "Bearer "+authToken)
+		req.Header.Set("Content-Type", "application/json")
+
+		rr = httptest.NewRecorder()
+		env.Router.ServeHTTP(rr, req)
+
+		if rr.Code != http.StatusOK {
+			t.Fatalf("Expected 200 OK, got %d. Body: %s", rr.Code, rr.Body.String())
+		}
+
+		var resp map[string]interface{}
+		json.Unmarshal(rr.Body.Bytes(), &resp)
+		user, _ := resp["user"].(map[string]interface{})
+		if user["email"] != "auth_user@test.com" || user["display_name"] != "Authy" || user["role"] != "student" || user["major"] == "Hacking" {
+			t.Errorf("Unexpected profile after update: %v", user)
+		}
+	})
+
+	// --- Test 4: Get Profile (GET /api/me) -> gRPC GetProfile ---
+	t.Run("Get Profile", func(t *testing.T) {
+		req, _ := http.NewRequest("GET", "/api/me", nil)
+		req.Header.Set("Authorization", "Bearer "+authToken)
+
+		rr := httptest.NewRecorder()
+		env.Router.ServeHTTP(rr, req)
+
+		if rr.Code != http.StatusOK {
+			t.Errorf("Expected 200 OK, got %d", rr.Code)
+		}
+	})
+
+	// --- Test 5: Change Password (POST /api/auth/change-password) -> gRPC ChangePassword ---
 	t.Run("Change Password", func(t *testing.T) {
 		body := map[string]string{
 			"old_password": userPass,
@@ -80,7 +139,7 @@ func TestGateway_Auth(t *testing.T) {
 		}
 	})
 
-	// --- Test 4: Logout (POST /api/auth/logout) -> gRPC Logout ---
+	// --- Test 6: Logout (POST /api/auth/logout) -> gRPC Logout ---
 	t.Run("Logout", func(t *testing.T) {
 		// Login again to get a fresh token if needed, or reuse existing if valid.
 		// We reuse authToken since it should still be valid.
@@ -95,3 +154,158 @@ func TestGateway_Auth(t *testing.T) {
 		}
 	})
 }
+
+func TestGateway_AuthCookie(t *testing.T) {
+	t.Setenv("AUTH_TOKEN_DELIVERY", "cookie")
+	env := setupGatewayTestEnv(t)
+	ctx := context.Background()
+
+	uResp, _ := createTestUser(ctx, env, &pb_admin.CreateUserRequest{
+		Email: "cookie_user@test.com", Role: "student", Name: "Cookie User", StudentId: "AUTH002",
+	})
+
+	// --- Test 1: Login sets an HttpOnly cookie instead of returning the token ---
+	var tokenCookie *http.Cookie
+	t.Run("Login Sets Cookie", func(t *testing.T) {
+		body := map[string]string{
+			"identifier": "cookie_user@test.com",
+			"password":   uResp.InitialPassword,
+		}
+		jsonBody, _ := json.Marshal(body)
+		req, _ := http.NewRequest("POST", "/api/auth/login", bytes.NewBuffer(jsonBody))
+		req.Header.Set("Content-Type", "application/json")
+
+		rr := httptest.NewRecorder()
+		env.Router.ServeHTTP(rr, req)
+
+		if rr.Code != http.StatusOK {
+			t.Fatalf("Expected 200 OK, got %d. Body: %s", rr.Code, rr.Body.String())
+		}
+
+		var resp map[string]interface{}
+		json.Unmarshal(rr.Body.Bytes(), &resp)
+		if _, ok := resp["token"]; ok {
+			t.Error("Cookie mode should not return the token in the body")
+		}
+
+		for _, c := range rr.Result().Cookies() {
+			if c.Name == "auth_token" {
+				tokenCookie = c
+			}
+		}
+		if tokenCookie == nil || !tokenCookie.HttpOnly || !tokenCookie.Secure || tokenCookie.SameSite != http.SameSiteStrictMode {
+			t.Fatalf("Expected Secure/HttpOnly/SameSite token cookie, got %v", tokenCookie)
+		}
+	})
+
+	// --- Test 2: Protected routes accept the cookie ---
+	t.Run("Cookie Authenticates", func(t *testing.T) {
+		req, _ := http.NewRequest("GET", "/api/profile", nil)
+		req.AddCookie(tokenCookie)
+
+		rr := httptest.NewRecorder()
+		env.Router.ServeHTTP(rr, req)
+
+		if rr.Code != http.StatusOK {
+			t.Errorf("Expected 200 OK, got %d. Body: %s", rr.Code, rr.Body.String())
+		}
+	})
+
+	// --- Test 3: Logout clears the cookie ---
+	t.Run("Logout Clears Cookie", func(t *testing.T) {
+		req, _ := http.NewRequest("POST", "/api/auth/logout", nil)
+		req.AddCookie(tokenCookie)
+
+		rr := httptest.NewRecorder()
+		env.Router.ServeHTTP(rr, req)
+
+		cleared := false
+		for _, c := range rr.Result().Cookies() {
+			if c.Name == "auth_token" && c.MaxAge < 0 {
+				cleared = true
+			}
+		}
+		if !cleared {
+			t.Error("Expected logout to clear the token cookie")
+		}
+	})
+}
+
+func TestGateway_AuthPasswordChangeRequired(t *testing.T) {
+	env := setupGatewayTestEnv(t)
+	ctx := context.Background()
+
+	// Accounts created by an admin start with an issued password
+	uResp, _ := env.AdminClient.CreateUser(ctx, &pb_admin.CreateUserRequest{
+		Email: "issued_user@test.com", Role: "student", Name: "Issued User", StudentId: "AUTH003",
+	})
+
+	login := func(password string) map[string]interface{} {
+		jsonBody, _ := json.Marshal(map[string]string{"identifier": "issued_user@test.com", "password": password})
+		req, _ := http.NewRequest("POST", "/api/auth/login", bytes.NewBuffer(jsonBody))
+		req.Header.Set("Content-Type", "application/json")
+		rr := httptest.NewRecorder()
+		env.Router.ServeHTTP(rr, req)
+		if rr.Code != http.StatusOK {
+			t.Fatalf("Expected 200 OK on login, got %d. Body: %s", rr.Code, rr.Body.String())
+		}
+		var resp map[string]interface{}
+		json.Unmarshal(rr.Body.Bytes(), &resp)
+		return resp
+	}
+
+	resp := login(uResp.InitialPassword)
+	if resp["must_change_password"] != true {
+		t.Errorf("Expected must_change_password on login, got %v", resp)
+	}
+	token, _ := resp["token"].(string)
+
+	t.Run("Other Routes Blocked", func(t *testing.T) {
+		req, _ := http.NewRequest("GET", "/api/me", nil)
+		req.Header.Set("Authorization", "Bearer "+token)
+		rr := httptest.NewRecorder()
+		env.Router.ServeHTTP(rr, req)
+
+		if rr.Code != http.StatusForbidden {
+			t.Fatalf("Expected 403 Forbidden, got %d. Body: %s", rr.Code, rr.Body.String())
+		}
+		var errResp map[string]interface{}
+		json.Unmarshal(rr.Body.Bytes(), &errResp)
+		if errResp["code"] != "PASSWORD_CHANGE_REQUIRED" {
+			t.Errorf("Expected code PASSWORD_CHANGE_REQUIRED, got %v", errResp["code"])
+		}
+	})
+
+	t.Run("Change Password Allowed", func(t *testing.T) {
+		jsonBody, _ := json.Marshal(map[string]string{
+			"old_password": uResp.InitialPassword,
+			"new_password": "chosenPassword123",
+		})
+		req, _ := http.NewRequest("POST", "/api/auth/change-password", bytes.NewBuffer(jsonBody))
+		req.Header.Set("Authorization", "Bearer "+token)
+		req.Header.Set("Content-Type", "application/json")
+		rr := httptest.NewRecorder()
+		env.Router.ServeHTTP(rr, req)
+
+		if rr.Code != http.StatusOK {
+			t.Fatalf("Expected 200 OK, got %d. Body: %s", rr.Code, rr.Body.String())
+		}
+	})
+
+	t.Run("Routes Open After Change", func(t *testing.T) {
+		resp := login("chosenPassword123")
+		if _, ok := resp["must_change_password"]; ok {
+			t.Errorf("Expected no must_change_password after change, got %v", resp)
+		}
+		token, _ := resp["token"].(string)
+
+		req, _ := http.NewRequest("GET", "/api/me", nil)
+		req.Header.Set("Authorization", "Bearer "+token)
+		rr := httptest.NewRecorder()
+		env.Router.ServeHTTP(rr, req)
+
+		if rr.Code != http.StatusOK {
+			t.Errorf("Expected 200 OK, got %d. Body: %s", rr.Code, rr.Body.String())
+		}
+	})
+}
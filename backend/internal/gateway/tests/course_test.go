@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 
 	pb_admin "stdiscm_p4/backend/internal/pb/admin"
@@ -44,7 +45,7 @@ func TestGateway_Course(t *testing.T) {
 	}
 
 	// Setup: Create Student and get token for protected routes
-	uResp, _ := env.AdminClient.CreateUser(ctx, &pb_admin.CreateUserRequest{
+	uResp, _ := createTestUser(ctx, env, &pb_admin.CreateUserRequest{
 		Email: "course_student@test.com", Role: "student", Name: "Course Student", StudentId: "CS001",
 	})
 	lResp, _ := env.AuthClient.Login(ctx, &pb_auth.LoginRequest{
@@ -68,8 +69,10 @@ func TestGateway_Course(t *testing.T) {
 		// Verify data structure
 		courses, ok := resp["courses"].([]interface{})
 		if !ok || len(courses) == 0 {
-			t.Error("Expected courses list in response")
+			t.Fatal("Expected courses list in response")
 		}
+		course, _ := courses[0].(map[string]interface{})
+		assertSeatFields(t, course, 50, false)
 	})
 
 	// --- Test 2: Get Course (Public) (GET /api/courses/:id) ---
@@ -83,9 +86,11 @@ func TestGateway_Course(t *testing.T) {
 		}
 		var resp map[string]interface{}
 		json.Unmarshal(rr.Body.Bytes(), &resp)
-		if _, ok := resp["course"]; !ok {
-			t.Error("Response missing 'course' object")
+		course, ok := resp["course"].(map[string]interface{})
+		if !ok {
+			t.Fatal("Response missing 'course' object")
 		}
+		assertSeatFields(t, course, 50, false)
 	})
 
 	// --- Test 3: Get Course Availability (Public) (GET /api/courses/:id/availability) ---
@@ -112,4 +117,68 @@ func TestGateway_Course(t *testing.T) {
 			t.Errorf("Expected 200 OK, got %d. Body: %s", rr.Code, rr.Body.String())
 		}
 	})
+
+	// --- Test 5: Course Reviews (POST /api/courses/:id/reviews, GET /api/faculty/courses/:id/reviews/summary) ---
+	t.Run("Course Reviews", func(t *testing.T) {
+		body := `{"enrollment_id":"ENR-DOES-NOT-EXIST","ratings":{"content":4,"instruction":4,"organization":4,"overall":4}}`
+		req, _ := http.NewRequest("POST", "/api/courses/"+testCourseID+"/reviews", strings.NewReader(body))
+		req.Header.Set("Authorization", "Bearer "+studentToken)
+		rr := httptest.NewRecorder()
+		env.Router.ServeHTTP(rr, req)
+		if rr.Code != http.StatusNotFound {
+			t.Errorf("Expected 404 for an unknown enrollment, got %d. Body: %s", rr.Code, rr.Body.String())
+		}
+
+		// Summaries are for faculty and admins only
+		req, _ = http.NewRequest("GET", "/api/faculty/courses/"+testCourseID+"/reviews/summary", nil)
+		req.Header.Set("Authorization", "Bearer "+studentToken)
+		rr = httptest.NewRecorder()
+		env.Router.ServeHTTP(rr, req)
+		if rr.Code != http.StatusForbidden {
+			t.Errorf("Expected 403 for a student, got %d", rr.Code)
+		}
+	})
+
+	// --- Test 6: List Prerequisites (Public) (GET /api/courses/:id/prerequisite-courses) ---
+	t.Run("List Prerequisites", func(t *testing.T) {
+		req, _ := http.NewRequest("GET", "/api/courses/"+testCourseID+"/prerequisite-courses", nil)
+		rr := httptest.NewRecorder()
+		env.Router.ServeHTTP(rr, req)
+
+		if rr.Code != http.StatusOK {
+			t.Fatalf("Expected 200 OK, got %d. Body: %s", rr.Code, rr.Body.String())
+		}
+		var resp map[string]interface{}
+		json.Unmarshal(rr.Body.Bytes(), &resp)
+		if resp["course_id"] != testCourseID {
+			t.Errorf("Expected course_id %s, got %v", testCourseID, resp["course_id"])
+		}
+
+		req, _ = http.NewRequest("GET", "/api/courses/NO-SUCH-COURSE/prerequisite-courses", nil)
+		rr = httptest.NewRecorder()
+		env.Router.ServeHTTP(rr, req)
+		if rr.Code != http.StatusNotFound {
+			t.Errorf("Expected 404 for unknown course, got %d", rr.Code)
+		}
+	})
+}
+
+// assertSeatFields checks the seat contract the frontend relies on: the fields are
+// always present (even when zero) and match what the server computed.
+func assertSeatFields(t *testing.T, course map[string]interface{}, wantSeats float64, wantFull bool) {
+	t.Helper()
+	for _, key := range []string{"seats_available", "is_full", "waitlist_count"} {
+		if _, ok := course[key]; !ok {
+			t.Errorf("Course JSON missing %q: %v", key, course)
+		}
+	}
+	if seats, _ := course["seats_available"].(float64); seats != wantSeats {
+		t.Errorf("Expected seats_available %v, got %v", wantSeats, course["seats_available"])
+	}
+	if full, _ := course["is_full"].(bool); full != wantFull {
+		t.Errorf("Expected is_full %v, got %v", wantFull, course["is_full"])
+	}
+	if waitlist, _ := course["waitlist_count"].(float64); waitlist != 0 {
+		t.Errorf("Expected waitlist_count 0, got %v", course["waitlist_count"])
+	}
 }
@@ -0,0 +1,76 @@
+package tests
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"stdiscm_p4/backend/internal/gateway/util"
+	"stdiscm_p4/backend/internal/shared"
+)
+
+// TestGateway_DecodeJSON checks that each kind of bad request body is rejected
+// with 400 and a code telling it apart
+func TestGateway_DecodeJSON(t *testing.T) {
+	type body struct {
+		Name  string `json:"name"`
+		Units int32  `json:"units"`
+	}
+
+	cases := []struct {
+		name  string
+		body  string
+		limit int64
+		code  shared.ErrorCode
+		field string
+	}{
+		{"Empty", "", util.MaxJSONBodyBytes, shared.ErrCodeEmptyBody, ""},
+		{"Malformed", `{"name": "CS101",}`, util.MaxJSONBodyBytes, shared.ErrCodeMalformedJSON, ""},
+		{"Truncated", `{"name": "CS1`, util.MaxJSONBodyBytes, shared.ErrCodeMalformedJSON, ""},
+		{"Trailing Data", `{"name": "CS101"} {"name": "CS102"}`, util.MaxJSONBodyBytes, shared.ErrCodeMalformedJSON, ""},
+		{"Unknown Field", `{"name": "CS101", "role": "admin"}`, util.MaxJSONBodyBytes, shared.ErrCodeUnknownField, "role"},
+		{"Wrong Type", `{"name": "CS101", "units": "three"}`, util.MaxJSONBodyBytes, shared.ErrCodeInvalidField, "units"},
+		{"Too Large", `{"name": "` + strings.Repeat("x", 64) + `"}`, 32, shared.ErrCodeBodyTooLarge, ""},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest("POST", "/", strings.NewReader(tc.body))
+			rr := httptest.NewRecorder()
+
+			var dst body
+			if util.DecodeJSONLimit(rr, req, &dst, tc.limit) {
+				t.Fatalf("Expected body %q to be rejected", tc.body)
+			}
+			if rr.Code != http.StatusBadRequest {
+				t.Fatalf("Expected 400, got %d", rr.Code)
+			}
+
+			var resp util.JSONError
+			if err := json.Unmarshal(rr.Body.Bytes(), &resp); err != nil {
+				t.Fatalf("Failed to decode error body: %v", err)
+			}
+			if resp.Code != string(tc.code) {
+				t.Errorf("Expected code %s, got %s (%s)", tc.code, resp.Code, resp.Message)
+			}
+			if resp.Params["field"] != tc.field {
+				t.Errorf("Expected field param %q, got %q", tc.field, resp.Params["field"])
+			}
+		})
+	}
+
+	t.Run("Valid", func(t *testing.T) {
+		req := httptest.NewRequest("POST", "/", strings.NewReader(`{"name": "CS101", "units": 3}`))
+		rr := httptest.NewRecorder()
+
+		var dst body
+		if !util.DecodeJSON(rr, req, &dst) {
+			t.Fatalf("Expected valid body to decode, got %d: %s", rr.Code, rr.Body.String())
+		}
+		if dst.Name != "CS101" || dst.Units != 3 {
+			t.Errorf("Unexpected decoded body: %+v", dst)
+		}
+	})
+}
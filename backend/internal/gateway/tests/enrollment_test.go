@@ -17,7 +17,7 @@ func TestGateway_Enrollment(t *testing.T) {
 	ctx := context.Background()
 
 	// 1. Create Student
-	uResp, _ := env.AdminClient.CreateUser(ctx, &pb_admin.CreateUserRequest{
+	uResp, _ := createTestUser(ctx, env, &pb_admin.CreateUserRequest{
 		Email: "student_enroll@test.com", Role: "student", Name: "Enroll Student", StudentId: "202100001",
 	})
 
@@ -52,6 +52,28 @@ func TestGateway_Enrollment(t *testing.T) {
 		}
 	})
 
+	// --- Test 1b: Duplicate Add returns a machine-readable error code ---
+	t.Run("Add To Cart Duplicate Code", func(t *testing.T) {
+		body := map[string]string{"course_id": cResp.CourseId}
+		jsonBody, _ := json.Marshal(body)
+		req, _ := http.NewRequest("POST", "/api/cart/add", bytes.NewBuffer(jsonBody))
+		req.Header.Set("Authorization", "Bearer "+token)
+		req.Header.Set("Content-Type", "application/json")
+
+		rr := httptest.NewRecorder()
+		env.Router.ServeHTTP(rr, req)
+
+		if rr.Code != http.StatusConflict {
+			t.Errorf("Expected 409, got %d. Msg: %s", rr.Code, rr.Body.String())
+		}
+
+		var resp map[string]interface{}
+		json.Unmarshal(rr.Body.Bytes(), &resp)
+		if resp["code"] != "ALREADY_IN_CART" {
+			t.Errorf("Expected code ALREADY_IN_CART, got %v", resp["code"])
+		}
+	})
+
 	// --- Test 2: Get Cart (GET /api/cart) ---
 	t.Run("Get Cart", func(t *testing.T) {
 		req, _ := http.NewRequest("GET", "/api/cart", nil)
@@ -77,6 +99,25 @@ func TestGateway_Enrollment(t *testing.T) {
 		}
 	})
 
+	// --- Test 2b: Cart Summary (GET /api/cart/summary) ---
+	t.Run("Cart Summary", func(t *testing.T) {
+		req, _ := http.NewRequest("GET", "/api/cart/summary", nil)
+		req.Header.Set("Authorization", "Bearer "+token)
+
+		rr := httptest.NewRecorder()
+		env.Router.ServeHTTP(rr, req)
+
+		if rr.Code != http.StatusOK {
+			t.Fatalf("Expected 200, got %d. Body: %s", rr.Code, rr.Body.String())
+		}
+
+		var resp map[string]interface{}
+		json.Unmarshal(rr.Body.Bytes(), &resp)
+		if resp["item_count"] != float64(1) || resp["total_units"] != float64(3) {
+			t.Errorf("Expected 1 item and 3 units, got %v and %v", resp["item_count"], resp["total_units"])
+		}
+	})
+
 	// --- Test 3: Remove From Cart (DELETE /api/cart/remove/:course_id) ---
 	t.Run("Remove From Cart", func(t *testing.T) {
 		req, _ := http.NewRequest("DELETE", "/api/cart/remove/"+cResp.CourseId, nil)
@@ -170,7 +211,16 @@ func TestGateway_Enrollment(t *testing.T) {
 		json.Unmarshal(rr.Body.Bytes(), &resp)
 		enrollments, ok := resp["enrollments"].([]interface{})
 		if !ok || len(enrollments) != 1 {
-			t.Error("Expected 1 enrolled course")
+			t.Fatal("Expected 1 enrolled course")
+		}
+
+		// Active enrollments omit dropped_at instead of sending an epoch date
+		enrollment, _ := enrollments[0].(map[string]interface{})
+		if _, present := enrollment["dropped_at"]; present {
+			t.Errorf("Expected no dropped_at for an active enrollment, got %v", enrollment["dropped_at"])
+		}
+		if _, present := enrollment["enrolled_at"]; !present {
+			t.Error("Expected enrolled_at to be set")
 		}
 	})
 
@@ -189,5 +239,21 @@ func TestGateway_Enrollment(t *testing.T) {
 		if rr.Code != http.StatusOK {
 			t.Errorf("Expected 200, got %d. Msg: %s", rr.Code, rr.Body.String())
 		}
+
+		// The dropped enrollment now carries its dropped_at
+		req, _ = http.NewRequest("GET", "/api/enrollment/schedule?status=dropped", nil)
+		req.Header.Set("Authorization", "Bearer "+token)
+		rr = httptest.NewRecorder()
+		env.Router.ServeHTTP(rr, req)
+
+		var resp map[string]interface{}
+		json.Unmarshal(rr.Body.Bytes(), &resp)
+		enrollments, _ := resp["enrollments"].([]interface{})
+		if len(enrollments) != 1 {
+			t.Fatalf("Expected 1 dropped enrollment, got %s", rr.Body.String())
+		}
+		if enrollment, _ := enrollments[0].(map[string]interface{}); enrollment["dropped_at"] == nil {
+			t.Error("Expected dropped_at on a dropped enrollment")
+		}
 	})
 }
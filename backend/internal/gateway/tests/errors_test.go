@@ -0,0 +1,70 @@
+package tests
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"stdiscm_p4/backend/internal/gateway/util"
+	"stdiscm_p4/backend/internal/shared"
+)
+
+// TestGateway_DomainErrorMapping checks each domain error end to end:
+// domain error -> gRPC code -> HTTP status and JSON code/params
+func TestGateway_DomainErrorMapping(t *testing.T) {
+	cases := []struct {
+		err      *shared.DomainError
+		grpcCode codes.Code
+		httpCode int
+	}{
+		{shared.ErrCourseNotFound, codes.NotFound, http.StatusNotFound},
+		{shared.ErrCourseClosed, codes.FailedPrecondition, http.StatusConflict},
+		{shared.ErrCourseFull, codes.FailedPrecondition, http.StatusConflict},
+		{shared.ErrCourseRestricted, codes.FailedPrecondition, http.StatusConflict},
+		{shared.ErrAlreadyEnrolled, codes.AlreadyExists, http.StatusConflict},
+		{shared.ErrNotEnrolled, codes.NotFound, http.StatusNotFound},
+		{shared.ErrPrereqNotMet, codes.FailedPrecondition, http.StatusConflict},
+		{shared.ErrEnrollmentClosed, codes.FailedPrecondition, http.StatusConflict},
+		{shared.ErrNotCourseFaculty, codes.PermissionDenied, http.StatusForbidden},
+		{shared.ErrInvalidGrade, codes.InvalidArgument, http.StatusBadRequest},
+		{shared.ErrAppealAlreadyOpen, codes.AlreadyExists, http.StatusConflict},
+		{shared.ErrAlreadyReviewed, codes.AlreadyExists, http.StatusConflict},
+		{shared.ErrAppealResolved, codes.FailedPrecondition, http.StatusConflict},
+		{shared.ErrAppealWindowClosed, codes.FailedPrecondition, http.StatusConflict},
+		{shared.ErrConcurrentModification, codes.Aborted, http.StatusConflict},
+		{shared.ErrOperationInProgress, codes.Aborted, http.StatusConflict},
+	}
+
+	for _, tc := range cases {
+		t.Run(string(tc.err.Code), func(t *testing.T) {
+			// Simulate the wire: the gateway only ever sees the decoded status
+			st := status.Convert(tc.err.WithParam("course_id", "c1"))
+			wire := status.FromProto(st.Proto()).Err()
+			if status.Code(wire) != tc.grpcCode {
+				t.Fatalf("Expected gRPC code %v, got %v", tc.grpcCode, status.Code(wire))
+			}
+			if !errors.Is(tc.err.Newf("specific"), tc.err) {
+				t.Error("Expected derived error to match its sentinel")
+			}
+
+			rr := httptest.NewRecorder()
+			util.HandleGRPCError(rr, wire)
+			if rr.Code != tc.httpCode {
+				t.Fatalf("Expected HTTP %d, got %d", tc.httpCode, rr.Code)
+			}
+
+			var body util.JSONError
+			if err := json.NewDecoder(rr.Body).Decode(&body); err != nil {
+				t.Fatalf("Failed to decode body: %v", err)
+			}
+			if body.Code != string(tc.err.Code) || body.Message != tc.err.Message || body.Params["course_id"] != "c1" {
+				t.Errorf("Unexpected body: %+v", body)
+			}
+		})
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 
 	pb_admin "stdiscm_p4/backend/internal/pb/admin"
@@ -17,7 +18,7 @@ func TestGateway_Grade(t *testing.T) {
 	ctx := context.Background()
 
 	// 1. Create Student
-	sResp, _ := env.AdminClient.CreateUser(ctx, &pb_admin.CreateUserRequest{
+	sResp, _ := createTestUser(ctx, env, &pb_admin.CreateUserRequest{
 		Email: "student_grade@test.com", Role: "student", Name: "Grade Student", StudentId: "202100002",
 	})
 	// Login Student
@@ -27,7 +28,7 @@ func TestGateway_Grade(t *testing.T) {
 	studentToken := lsResp.Token
 
 	// 2. Create Faculty
-	fResp, _ := env.AdminClient.CreateUser(ctx, &pb_admin.CreateUserRequest{
+	fResp, _ := createTestUser(ctx, env, &pb_admin.CreateUserRequest{
 		Email: "faculty_grade@test.com", Role: "faculty", Name: "Grade Faculty", FacultyId: "FAC002",
 	})
 	// Login Faculty
@@ -36,6 +37,15 @@ func TestGateway_Grade(t *testing.T) {
 	})
 	facultyToken := lfResp.Token
 
+	// Second faculty member who does not teach the course
+	ofResp, _ := createTestUser(ctx, env, &pb_admin.CreateUserRequest{
+		Email: "faculty_grade_other@test.com", Role: "faculty", Name: "Other Faculty", FacultyId: "FAC003",
+	})
+	lofResp, _ := env.AuthClient.Login(ctx, &pb_auth.LoginRequest{
+		Identifier: "faculty_grade_other@test.com", Password: ofResp.InitialPassword,
+	})
+	otherFacultyToken := lofResp.Token
+
 	// 3. Create and Assign Course
 	cResp, err := env.AdminClient.CreateCourse(ctx, &pb_admin.CreateCourseRequest{
 		Code:      "GRADE-101",
@@ -67,6 +77,63 @@ func TestGateway_Grade(t *testing.T) {
 		}
 	})
 
+	// --- Self-access: the student always comes from the token ---
+	t.Run("Student Grades Self Access", func(t *testing.T) {
+		req, _ := http.NewRequest("GET", "/api/student/grades", nil)
+		req.Header.Set("Authorization", "Bearer "+studentToken)
+		rr := httptest.NewRecorder()
+		env.Router.ServeHTTP(rr, req)
+		if rr.Code != http.StatusOK {
+			t.Errorf("Expected 200 for the student's own grades, got %d", rr.Code)
+		}
+
+		// A student token cannot read another student's grades by ID (nor their own)
+		for _, id := range []string{"202100099", "202100002"} {
+			req, _ = http.NewRequest("GET", "/api/students/"+id+"/grades", nil)
+			req.Header.Set("Authorization", "Bearer "+studentToken)
+			rr = httptest.NewRecorder()
+			env.Router.ServeHTTP(rr, req)
+			if rr.Code != http.StatusForbidden {
+				t.Errorf("Expected 403 for a student reading /students/%s/grades, got %d", id, rr.Code)
+			}
+		}
+
+		req, _ = http.NewRequest("GET", "/api/students/202100002/grades", nil)
+		req.Header.Set("Authorization", "Bearer "+facultyToken)
+		rr = httptest.NewRecorder()
+		env.Router.ServeHTTP(rr, req)
+		if rr.Code != http.StatusOK {
+			t.Errorf("Expected 200 for faculty, got %d. Body: %s", rr.Code, rr.Body.String())
+		}
+	})
+
+	// --- Appeal routes: /student/grades/{enrollment_id}/appeal and /faculty/appeals ---
+	t.Run("Appeal Routes", func(t *testing.T) {
+		req, _ := http.NewRequest("POST", "/api/student/grades/ENR-DOES-NOT-EXIST/appeal", strings.NewReader(`{"reason":"Miscounted"}`))
+		req.Header.Set("Authorization", "Bearer "+studentToken)
+		rr := httptest.NewRecorder()
+		env.Router.ServeHTTP(rr, req)
+		if rr.Code != http.StatusNotFound {
+			t.Errorf("Expected 404 for an unknown enrollment, got %d. Body: %s", rr.Code, rr.Body.String())
+		}
+
+		req, _ = http.NewRequest("GET", "/api/faculty/appeals", nil)
+		req.Header.Set("Authorization", "Bearer "+studentToken)
+		rr = httptest.NewRecorder()
+		env.Router.ServeHTTP(rr, req)
+		if rr.Code != http.StatusForbidden {
+			t.Errorf("Expected 403 for a student listing faculty appeals, got %d", rr.Code)
+		}
+
+		req, _ = http.NewRequest("GET", "/api/faculty/appeals?status=open", nil)
+		req.Header.Set("Authorization", "Bearer "+facultyToken)
+		rr = httptest.NewRecorder()
+		env.Router.ServeHTTP(rr, req)
+		if rr.Code != http.StatusOK {
+			t.Errorf("Expected 200 for faculty, got %d. Body: %s", rr.Code, rr.Body.String())
+		}
+	})
+
 	// --- Test 2: Calculate GPA (Student) (GET /api/grades/gpa) ---
 	t.Run("Calculate GPA", func(t *testing.T) {
 		req, _ := http.NewRequest("GET", "/api/grades/gpa", nil)
@@ -145,4 +212,64 @@ func TestGateway_Grade(t *testing.T) {
 			t.Errorf("Expected 200, got %d", rr.Code)
 		}
 	})
+
+	// --- Test 7: Get Course Grades (Other Faculty) -> 403 ---
+	t.Run("Get Course Grades Forbidden", func(t *testing.T) {
+		req, _ := http.NewRequest("GET", "/api/grades/course/"+courseID, nil)
+		req.Header.Set("Authorization", "Bearer "+otherFacultyToken)
+
+		rr := httptest.NewRecorder()
+		env.Router.ServeHTTP(rr, req)
+
+		if rr.Code != http.StatusForbidden {
+			t.Errorf("Expected 403, got %d", rr.Code)
+		}
+	})
+
+	// --- Test 8: Get Class Roster (Missing Course) -> 404 ---
+	t.Run("Get Class Roster Not Found", func(t *testing.T) {
+		req, _ := http.NewRequest("GET", "/api/grades/roster/GRADE-MISSING", nil)
+		req.Header.Set("Authorization", "Bearer "+facultyToken)
+
+		rr := httptest.NewRecorder()
+		env.Router.ServeHTTP(rr, req)
+
+		if rr.Code != http.StatusNotFound {
+			t.Errorf("Expected 404, got %d", rr.Code)
+		}
+	})
+	// --- Test 9: Export Roster CSV (GET /api/faculty/courses/:id/roster.csv) ---
+	t.Run("Export Roster CSV", func(t *testing.T) {
+		req, _ := http.NewRequest("GET", "/api/faculty/courses/"+courseID+"/roster.csv", nil)
+		req.Header.Set("Authorization", "Bearer "+facultyToken)
+
+		rr := httptest.NewRecorder()
+		env.Router.ServeHTTP(rr, req)
+
+		if rr.Code != http.StatusOK {
+			t.Fatalf("Expected 200, got %d. Body: %s", rr.Code, rr.Body.String())
+		}
+		if ct := rr.Header().Get("Content-Type"); !strings.HasPrefix(ct, "text/csv") {
+			t.Errorf("Expected text/csv, got %q", ct)
+		}
+		if cd := rr.Header().Get("Content-Disposition"); !strings.Contains(cd, "GRADE-101_roster.csv") {
+			t.Errorf("Unexpected Content-Disposition: %q", cd)
+		}
+		if !strings.HasPrefix(rr.Body.String(), "student_id,name,email,major,year_level,current_grade") {
+			t.Errorf("Missing CSV header row: %q", rr.Body.String())
+		}
+	})
+
+	// --- Test 10: Export Roster CSV (Other Faculty) -> 403 ---
+	t.Run("Export Roster CSV Forbidden", func(t *testing.T) {
+		req, _ := http.NewRequest("GET", "/api/faculty/courses/"+courseID+"/roster.csv", nil)
+		req.Header.Set("Authorization", "Bearer "+otherFacultyToken)
+
+		rr := httptest.NewRecorder()
+		env.Router.ServeHTTP(rr, req)
+
+		if rr.Code != http.StatusForbidden {
+			t.Errorf("Expected 403, got %d", rr.Code)
+		}
+	})
 }
@@ -0,0 +1,224 @@
+package tests
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/metadata"
+
+	"stdiscm_p4/backend/internal/gateway"
+	"stdiscm_p4/backend/internal/gateway/util"
+	pb_auth "stdiscm_p4/backend/internal/pb/auth"
+	"stdiscm_p4/backend/internal/shared"
+)
+
+func TestGateway_IdempotencyKey(t *testing.T) {
+	var calls int32
+	var forwarded atomic.Value
+	started, release := make(chan struct{}), make(chan struct{})
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&calls, 1)
+		if md, ok := metadata.FromOutgoingContext(r.Context()); ok && len(md.Get(shared.MetadataIdempotencyKey)) > 0 {
+			forwarded.Store(md.Get(shared.MetadataIdempotencyKey)[0])
+		}
+		switch r.URL.Path {
+		case "/slow":
+			close(started)
+			<-release
+		case "/fail":
+			util.WriteJSONError(w, http.StatusServiceUnavailable, "backend down")
+			return
+		}
+		util.WriteJSON(w, http.StatusCreated, map[string]interface{}{"success": true, "call": n})
+	})
+
+	store := gateway.NewIdempotencyStore(time.Minute, 100)
+	server := gateway.IdempotencyMiddleware(store)(handler)
+	send := func(userID, method, path, key, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, path, strings.NewReader(body))
+		if key != "" {
+			req.Header.Set(gateway.IdempotencyKeyHeader, key)
+		}
+		req = req.WithContext(context.WithValue(req.Context(), "user", &pb_auth.User{Id: userID}))
+		rec := httptest.NewRecorder()
+		server.ServeHTTP(rec, req)
+		return rec
+	}
+
+	t.Run("Replays A Retried Request", func(t *testing.T) {
+		first := send("u1", http.MethodPost, "/enroll-all", "k1", `{}`)
+		retry := send("u1", http.MethodPost, "/enroll-all", "k1", `{}`)
+		if calls != 1 {
+			t.Fatalf("Expected the handler to run once, ran %d times", calls)
+		}
+		if key, _ := forwarded.Load().(string); key != "k1" {
+			t.Errorf("Expected the key to be forwarded as gRPC metadata, got %q", key)
+		}
+		if retry.Code != http.StatusCreated || retry.Body.String() != first.Body.String() {
+			t.Errorf("Expected the first response to be replayed, got %d %s", retry.Code, retry.Body)
+		}
+		if retry.Header().Get(gateway.IdempotentReplayHeader) != "true" || first.Header().Get(gateway.IdempotentReplayHeader) != "" {
+			t.Error("Expected only the replayed response to be marked")
+		}
+	})
+
+	t.Run("Keys Are Scoped Per User And Endpoint", func(t *testing.T) {
+		before := calls
+		send("u2", http.MethodPost, "/enroll-all", "k1", `{}`)
+		send("u1", http.MethodPost, "/drop", "k1", `{}`)
+		if calls != before+2 {
+			t.Errorf("Expected another user and another endpoint to run, got %d new calls", calls-before)
+		}
+	})
+
+	t.Run("Rejects A Reused Key With A Different Body", func(t *testing.T) {
+		send("u1", http.MethodPost, "/cart/add", "k2", `{"course_id":"A"}`)
+		rec := send("u1", http.MethodPost, "/cart/add", "k2", `{"course_id":"B"}`)
+		if rec.Code != http.StatusUnprocessableEntity || !strings.Contains(rec.Body.String(), string(shared.ErrCodeIdempotencyKeyReused)) {
+			t.Errorf("Expected 422 %s, got %d %s", shared.ErrCodeIdempotencyKeyReused, rec.Code, rec.Body)
+		}
+	})
+
+	t.Run("Server Errors Are Not Stored", func(t *testing.T) {
+		before := calls
+		send("u1", http.MethodPost, "/fail", "k3", `{}`)
+		send("u1", http.MethodPost, "/fail", "k3", `{}`)
+		if calls != before+2 {
+			t.Errorf("Expected a failed request to run again on retry, got %d new calls", calls-before)
+		}
+	})
+
+	t.Run("Without A Key Or On Reads Every Request Runs", func(t *testing.T) {
+		before := calls
+		send("u1", http.MethodPost, "/enroll-all", "", `{}`)
+		send("u1", http.MethodPost, "/enroll-all", "", `{}`)
+		send("u1", http.MethodGet, "/schedule", "k4", "")
+		send("u1", http.MethodGet, "/schedule", "k4", "")
+		if calls != before+4 {
+			t.Errorf("Expected 4 new calls, got %d", calls-before)
+		}
+	})
+
+	t.Run("Concurrent Duplicate Is Rejected While In Progress", func(t *testing.T) {
+		done := make(chan *httptest.ResponseRecorder)
+		go func() { done <- send("u1", http.MethodPost, "/slow", "k5", `{}`) }()
+		<-started
+		rec := send("u1", http.MethodPost, "/slow", "k5", `{}`)
+		close(release)
+		if first := <-done; first.Code != http.StatusCreated {
+			t.Errorf("Expected the original request to complete, got %d", first.Code)
+		}
+		if rec.Code != http.StatusConflict || !strings.Contains(rec.Body.String(), string(shared.ErrCodeOperationInProgress)) {
+			t.Errorf("Expected 409 %s for the duplicate, got %d %s", shared.ErrCodeOperationInProgress, rec.Code, rec.Body)
+		}
+	})
+
+	t.Run("Keys Expire And Are Evicted", func(t *testing.T) {
+		var runs int32
+		counting := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&runs, 1)
+			w.WriteHeader(http.StatusOK)
+		})
+		small := gateway.IdempotencyMiddleware(gateway.NewIdempotencyStore(50*time.Millisecond, 1))(counting)
+		do := func(key string) {
+			req := httptest.NewRequest(http.MethodPost, "/drop", nil)
+			req.Header.Set(gateway.IdempotencyKeyHeader, key)
+			req = req.WithContext(context.WithValue(req.Context(), "user", &pb_auth.User{Id: "u1"}))
+			small.ServeHTTP(httptest.NewRecorder(), req)
+		}
+
+		do("a")
+		do("b") // evicts "a"
+		do("a")
+		if runs != 3 {
+			t.Errorf("Expected the evicted key to run again, got %d runs", runs)
+		}
+		time.Sleep(60 * time.Millisecond)
+		do("a")
+		if runs != 4 {
+			t.Errorf("Expected the expired key to run again, got %d runs", runs)
+		}
+	})
+
+	t.Run("Max Keys Below One Is Raised", func(t *testing.T) {
+		clamped := gateway.IdempotencyMiddleware(gateway.NewIdempotencyStore(time.Minute, -5))(handler)
+		req := httptest.NewRequest(http.MethodPost, "/drop", strings.NewReader(`{}`))
+		req.Header.Set(gateway.IdempotencyKeyHeader, "k6")
+		req = req.WithContext(context.WithValue(req.Context(), "user", &pb_auth.User{Id: "u1"}))
+		rec := httptest.NewRecorder()
+		clamped.ServeHTTP(rec, req)
+		if rec.Code != http.StatusCreated {
+			t.Errorf("Expected the request to run with a one-key store, got %d", rec.Code)
+		}
+	})
+}
+
+func TestGateway_IdempotencyMongoFallback(t *testing.T) {
+	client, db, err := shared.ConnectMongoDB(shared.DefaultMongoConfig(shared.GetEnv("MONGO_URI", "mongodb://localhost:27017"), "P4DB"))
+	if err != nil {
+		t.Fatalf("Failed to connect to MongoDB: %v", err)
+	}
+	defer shared.DisconnectMongoDB(client)
+	col := db.Collection("idempotency_keys_test")
+	col.Drop(context.Background())
+	defer col.Drop(context.Background())
+
+	var calls int32
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&calls, 1)
+		if r.URL.Path == "/fail" {
+			util.WriteJSONError(w, http.StatusServiceUnavailable, "backend down")
+			return
+		}
+		util.WriteJSON(w, http.StatusCreated, map[string]interface{}{"call": n})
+	})
+
+	// Two gateway instances sharing one collection
+	first := gateway.NewIdempotencyStore(time.Minute, 100).WithMongo(col)
+	if err := first.EnsureIndexes(context.Background()); err != nil {
+		t.Fatalf("EnsureIndexes failed: %v", err)
+	}
+	instanceA := gateway.IdempotencyMiddleware(first)(handler)
+	instanceB := gateway.IdempotencyMiddleware(gateway.NewIdempotencyStore(time.Minute, 100).WithMongo(col))(handler)
+	send := func(server http.Handler, path, key, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, path, strings.NewReader(body))
+		req.Header.Set(gateway.IdempotencyKeyHeader, key)
+		req = req.WithContext(context.WithValue(req.Context(), "user", &pb_auth.User{Id: "u1"}))
+		rec := httptest.NewRecorder()
+		server.ServeHTTP(rec, req)
+		return rec
+	}
+
+	t.Run("Replays Across Instances", func(t *testing.T) {
+		original := send(instanceA, "/enroll-all", "m1", `{}`)
+		retry := send(instanceB, "/enroll-all", "m1", `{}`)
+		if calls != 1 {
+			t.Fatalf("Expected the handler to run once, ran %d times", calls)
+		}
+		if retry.Code != http.StatusCreated || retry.Body.String() != original.Body.String() || retry.Header().Get(gateway.IdempotentReplayHeader) != "true" {
+			t.Errorf("Expected instance B to replay instance A's response, got %d %s", retry.Code, retry.Body)
+		}
+	})
+
+	t.Run("Rejects A Reused Key With A Different Body", func(t *testing.T) {
+		send(instanceA, "/cart/add", "m2", `{"course_id":"A"}`)
+		rec := send(instanceB, "/cart/add", "m2", `{"course_id":"B"}`)
+		if rec.Code != http.StatusUnprocessableEntity {
+			t.Errorf("Expected 422 from the other instance, got %d %s", rec.Code, rec.Body)
+		}
+	})
+
+	t.Run("Server Errors Are Released", func(t *testing.T) {
+		before := calls
+		send(instanceA, "/fail", "m3", `{}`)
+		send(instanceB, "/fail", "m3", `{}`)
+		if calls != before+2 {
+			t.Errorf("Expected the failed request to run again on the other instance, got %d new calls", calls-before)
+		}
+	})
+}
@@ -0,0 +1,126 @@
+package tests
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+
+	"stdiscm_p4/backend/internal/gateway"
+	"stdiscm_p4/backend/internal/gateway/openapi"
+)
+
+// TestGateway_OpenAPICoversRoutes fails when a registered route is missing from
+// the OpenAPI route table, or the table documents a route that no longer exists.
+func TestGateway_OpenAPICoversRoutes(t *testing.T) {
+	t.Setenv("ENVIRONMENT", "development")
+	router, err := gateway.SetupRoutes(&gateway.ServiceClients{})
+	if err != nil {
+		t.Fatalf("SetupRoutes failed: %v", err)
+	}
+
+	registered := make(map[string]bool)
+	err = chi.Walk(router, func(method, route string, _ http.Handler, _ ...func(http.Handler) http.Handler) error {
+		registered[method+" "+strings.TrimPrefix(route, openapi.BasePath)] = true
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Walking the router failed: %v", err)
+	}
+
+	documented := make(map[string]bool)
+	for _, op := range openapi.Build().Operations() {
+		documented[op] = true
+		if !registered[op] {
+			t.Errorf("Documented route %s is not registered", op)
+		}
+	}
+	for op := range registered {
+		if !documented[op] {
+			t.Errorf("Route %s is missing from the OpenAPI spec", op)
+		}
+	}
+}
+
+var schemaRef = regexp.MustCompile(`"\$ref":"#/components/(schemas|responses)/([^"]+)"`)
+
+func TestGateway_OpenAPIDocument(t *testing.T) {
+	t.Setenv("ENVIRONMENT", "development")
+	router, err := gateway.SetupRoutes(&gateway.ServiceClients{})
+	if err != nil {
+		t.Fatalf("SetupRoutes failed: %v", err)
+	}
+
+	req, _ := http.NewRequest("GET", "/api/openapi.json", nil)
+	rr := httptest.NewRecorder()
+	router.ServeHTTP(rr, req)
+	if rr.Code != http.StatusOK {
+		t.Fatalf("Expected 200 OK, got %d", rr.Code)
+	}
+
+	var doc openapi.Document
+	if err := json.Unmarshal(rr.Body.Bytes(), &doc); err != nil {
+		t.Fatalf("Spec is not valid JSON: %v", err)
+	}
+	if doc.OpenAPI != "3.0.3" {
+		t.Errorf("Expected openapi 3.0.3, got %q", doc.OpenAPI)
+	}
+
+	// Every reference must resolve within the document
+	for _, m := range schemaRef.FindAllStringSubmatch(rr.Body.String(), -1) {
+		var ok bool
+		if m[1] == "schemas" {
+			_, ok = doc.Components.Schemas[m[2]]
+		} else {
+			_, ok = doc.Components.Responses[m[2]]
+		}
+		if !ok {
+			t.Errorf("Unresolved reference to %s/%s", m[1], m[2])
+		}
+	}
+
+	// Request bodies come from the handler structs, errors from the shared envelope
+	login := doc.Paths["/auth/login"]["post"]
+	if login == nil || login.RequestBody == nil {
+		t.Fatal("Expected POST /auth/login to document a request body")
+	}
+	if ref := login.RequestBody.Content["application/json"].Schema.Ref; ref != "#/components/schemas/handlers.RESTLoginRequest" {
+		t.Errorf("Expected the login body to reference RESTLoginRequest, got %q", ref)
+	}
+	if _, ok := doc.Components.Schemas["util.JSONError"].Properties["code"]; !ok {
+		t.Error("Expected the error envelope to document code")
+	}
+
+	req, _ = http.NewRequest("GET", "/api/docs", nil)
+	rr = httptest.NewRecorder()
+	router.ServeHTTP(rr, req)
+	if rr.Code != http.StatusOK || !strings.Contains(rr.Body.String(), "/api/openapi.json") {
+		t.Errorf("Expected the docs page outside production, got %d", rr.Code)
+	}
+}
+
+func TestGateway_OpenAPIDocsHiddenInProduction(t *testing.T) {
+	t.Setenv("ENVIRONMENT", "production")
+	router, err := gateway.SetupRoutes(&gateway.ServiceClients{})
+	if err != nil {
+		t.Fatalf("SetupRoutes failed: %v", err)
+	}
+
+	req, _ := http.NewRequest("GET", "/api/docs", nil)
+	rr := httptest.NewRecorder()
+	router.ServeHTTP(rr, req)
+	if rr.Code != http.StatusNotFound {
+		t.Errorf("Expected 404 for the docs page in production, got %d", rr.Code)
+	}
+
+	req, _ = http.NewRequest("GET", "/api/openapi.json", nil)
+	rr = httptest.NewRecorder()
+	router.ServeHTTP(rr, req)
+	if rr.Code != http.StatusOK {
+		t.Errorf("Expected the spec to stay available in production, got %d", rr.Code)
+	}
+}
@@ -0,0 +1,94 @@
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+
+	"stdiscm_p4/backend/internal/gateway"
+	"stdiscm_p4/backend/internal/gateway/util"
+	pb_auth "stdiscm_p4/backend/internal/pb/auth"
+	"stdiscm_p4/backend/internal/shared"
+)
+
+// countingAuthClient answers ValidateToken from a fixed table and counts the calls
+type countingAuthClient struct {
+	pb_auth.AuthServiceClient
+	responses map[string]*pb_auth.ValidateTokenResponse
+	calls     int
+}
+
+func (c *countingAuthClient) ValidateToken(ctx context.Context, req *pb_auth.ValidateTokenRequest, _ ...grpc.CallOption) (*pb_auth.ValidateTokenResponse, error) {
+	c.calls++
+	if resp, ok := c.responses[req.Token]; ok {
+		return resp, nil
+	}
+	return &pb_auth.ValidateTokenResponse{Valid: false}, nil
+}
+
+func TestGateway_OptionalAuth(t *testing.T) {
+	authClient := &countingAuthClient{responses: map[string]*pb_auth.ValidateTokenResponse{
+		"admin-token":  {Valid: true, User: &pb_auth.User{Id: "opt-admin", Role: "admin"}},
+		"issued-token": {Valid: true, User: &pb_auth.User{Id: "opt-issued", Role: "student"}, MustChangePassword: true},
+	}}
+
+	// The handler echoes the caller it was given, if any
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		userID := ""
+		if user, ok := r.Context().Value("user").(*pb_auth.User); ok {
+			userID = user.Id
+		}
+		util.WriteJSON(w, http.StatusOK, userID)
+	})
+	server := gateway.OptionalAuthMiddleware(authClient, time.Second)(handler)
+
+	send := func(h http.Handler, token string) (int, map[string]interface{}) {
+		req := httptest.NewRequest(http.MethodGet, "/courses", nil)
+		if token != "" {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, req)
+		var body map[string]interface{}
+		json.Unmarshal(rec.Body.Bytes(), &body)
+		return rec.Code, body
+	}
+
+	t.Run("Anonymous Without A Token", func(t *testing.T) {
+		code, body := send(server, "")
+		if code != http.StatusOK || body["data"] != "" || authClient.calls != 0 {
+			t.Errorf("Expected an anonymous pass-through with no validation, got %d %v after %d calls", code, body, authClient.calls)
+		}
+	})
+
+	t.Run("Anonymous With An Invalid Token", func(t *testing.T) {
+		code, body := send(server, "bogus")
+		if code != http.StatusOK || body["data"] != "" {
+			t.Errorf("Expected an anonymous pass-through, got %d %v", code, body)
+		}
+	})
+
+	t.Run("Signed In With A Valid Token", func(t *testing.T) {
+		before := authClient.calls
+		for i := 0; i < 2; i++ {
+			if code, body := send(server, "admin-token"); code != http.StatusOK || body["data"] != "opt-admin" {
+				t.Fatalf("Expected the admin in the context, got %d %v", code, body)
+			}
+		}
+		if authClient.calls != before+2 {
+			t.Errorf("Expected every request to be validated, got %d calls for 2 requests", authClient.calls-before)
+		}
+	})
+
+	t.Run("Password Change Required", func(t *testing.T) {
+		code, body := send(server, "issued-token")
+		if code != http.StatusForbidden || body["code"] != string(shared.ErrCodePasswordChangeRequired) {
+			t.Errorf("Expected 403 %s, got %d %v", shared.ErrCodePasswordChangeRequired, code, body)
+		}
+	})
+}
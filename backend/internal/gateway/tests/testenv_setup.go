@@ -9,6 +9,8 @@ import (
 	"time"
 
 	"github.com/joho/godotenv"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/test/bufconn"
@@ -43,6 +45,7 @@ type TestEnv struct {
 	EnrollmentClient pb_enroll.EnrollmentServiceClient
 	GradeClient      pb_grade.GradeServiceClient
 	AdminClient      pb_admin.AdminServiceClient
+	DB               *mongo.Database
 }
 
 // setupGatewayTestEnv spins up the entire backend stack in-memory
@@ -88,9 +91,11 @@ func setupGatewayTestEnv(t *testing.T) *TestEnv {
 	go func() { sCourse.Serve(lCourse) }()
 
 	// Auth Service
-	sAuth, lAuth := createService()
 	authCfg := &shared.ServiceConfig{Security: shared.SecurityConfig{JWTSecret: "test-secret", JWTExpirationHours: 1, BCryptCost: 4}}
-	pb_auth.RegisterAuthServiceServer(sAuth, auth_svc.NewAuthService(db, authCfg))
+	authSvc := auth_svc.NewAuthService(db, authCfg)
+	lAuth := bufconn.Listen(bufSize)
+	sAuth := grpc.NewServer(grpc.UnaryInterceptor(authSvc.UnaryInterceptor()))
+	pb_auth.RegisterAuthServiceServer(sAuth, authSvc)
 	go func() { sAuth.Serve(lAuth) }()
 
 	// Enrollment Service (Needs Course Client!)
@@ -131,10 +136,14 @@ func setupGatewayTestEnv(t *testing.T) *TestEnv {
 		EnrollmentClient: pb_enroll.NewEnrollmentServiceClient(connEnroll),
 		GradeClient:      pb_grade.NewGradeServiceClient(connGrade),
 		AdminClient:      pb_admin.NewAdminServiceClient(connAdmin),
+		IdempotencyKeys:  db.Collection("idempotency_keys"),
 	}
 
 	// --- 4. Initialize Gateway Router ---
-	router := gateway.SetupRoutes(serviceClients)
+	router, err := gateway.SetupRoutes(serviceClients)
+	if err != nil {
+		t.Fatalf("Failed to set up gateway routes: %v", err)
+	}
 
 	return &TestEnv{
 		Router:           router,
@@ -143,5 +152,17 @@ func setupGatewayTestEnv(t *testing.T) *TestEnv {
 		CourseClient:     serviceClients.CourseClient,
 		EnrollmentClient: serviceClients.EnrollmentClient,
 		GradeClient:      serviceClients.GradeClient,
+		DB:               db,
+	}
+}
+
+// createTestUser creates a user via the Admin Service and clears the first-login
+// password change flag, so fixtures can use the issued password on any route.
+func createTestUser(ctx context.Context, env *TestEnv, req *pb_admin.CreateUserRequest) (*pb_admin.CreateUserResponse, error) {
+	resp, err := env.AdminClient.CreateUser(ctx, req)
+	if err != nil || !resp.Success {
+		return resp, err
 	}
+	_, err = env.DB.Collection("users").UpdateOne(ctx, bson.M{"_id": resp.UserId}, bson.M{"$unset": bson.M{"must_change_password": ""}})
+	return resp, err
 }
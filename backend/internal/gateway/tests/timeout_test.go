@@ -0,0 +1,98 @@
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/test/bufconn"
+
+	"stdiscm_p4/backend/internal/gateway"
+	"stdiscm_p4/backend/internal/gateway/util"
+	"stdiscm_p4/backend/internal/shared"
+)
+
+// slowHealthServer answers health checks only after a fixed delay
+type slowHealthServer struct {
+	grpc_health_v1.UnimplementedHealthServer
+	delay time.Duration
+}
+
+func (s *slowHealthServer) Check(ctx context.Context, _ *grpc_health_v1.HealthCheckRequest) (*grpc_health_v1.HealthCheckResponse, error) {
+	select {
+	case <-time.After(s.delay):
+		return &grpc_health_v1.HealthCheckResponse{Status: grpc_health_v1.HealthCheckResponse_SERVING}, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+func TestGateway_RequestTimeout(t *testing.T) {
+	lis := bufconn.Listen(bufSize)
+	server := grpc.NewServer()
+	grpc_health_v1.RegisterHealthServer(server, &slowHealthServer{delay: 500 * time.Millisecond})
+	go server.Serve(lis)
+	defer server.Stop()
+
+	conn, err := grpc.NewClient("passthrough://bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, s string) (net.Conn, error) { return lis.Dial() }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatalf("Failed to dial: %v", err)
+	}
+	defer conn.Close()
+	client := grpc_health_v1.NewHealthClient(conn)
+
+	// Handler relies solely on the route deadline carried by the request context
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		if _, err := client.Check(r.Context(), &grpc_health_v1.HealthCheckRequest{}); err != nil {
+			util.HandleGRPCError(w, err)
+			return
+		}
+		util.WriteJSON(w, http.StatusOK, map[string]interface{}{"success": true})
+	}
+
+	r := chi.NewRouter()
+	r.With(gateway.RequestTimeout(50*time.Millisecond)).Get("/fast-deadline", handler)
+	r.With(gateway.RequestTimeout(2*time.Second)).Get("/slow-deadline", handler)
+
+	t.Run("Deadline Exceeded Maps To 504", func(t *testing.T) {
+		rr := httptest.NewRecorder()
+		r.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/fast-deadline", nil))
+
+		if rr.Code != http.StatusGatewayTimeout {
+			t.Fatalf("Expected 504, got %d: %s", rr.Code, rr.Body.String())
+		}
+		var body util.JSONError
+		json.Unmarshal(rr.Body.Bytes(), &body)
+		if body.Code != string(shared.ErrCodeTimeout) {
+			t.Errorf("Expected code %s, got %q", shared.ErrCodeTimeout, body.Code)
+		}
+	})
+
+	t.Run("Longer Route Deadline Succeeds", func(t *testing.T) {
+		rr := httptest.NewRecorder()
+		r.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/slow-deadline", nil))
+
+		if rr.Code != http.StatusOK {
+			t.Errorf("Expected 200, got %d: %s", rr.Code, rr.Body.String())
+		}
+	})
+}
+
+// TestGateway_InvalidTimeouts checks that SetupRoutes reports a bad timeout
+// setting instead of exiting
+func TestGateway_InvalidTimeouts(t *testing.T) {
+	t.Setenv("GATEWAY_TIMEOUT_DEFAULT", "-1s")
+	if _, err := gateway.SetupRoutes(&gateway.ServiceClients{}); err == nil {
+		t.Error("Expected an error for a negative default timeout")
+	}
+}
@@ -0,0 +1,65 @@
+package util
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"stdiscm_p4/backend/internal/shared"
+)
+
+// MaxJSONBodyBytes bounds a JSON request body read by DecodeJSON
+const MaxJSONBodyBytes = 1 << 20
+
+// DecodeJSON reads a JSON request body of at most MaxJSONBodyBytes into dst,
+// rejecting fields dst does not declare and anything after the first value.
+// On failure it writes a 400 response and returns false; the error code tells
+// an empty, oversized or malformed body apart from an unknown or mistyped field.
+func DecodeJSON(w http.ResponseWriter, r *http.Request, dst interface{}) bool {
+	return DecodeJSONLimit(w, r, dst, MaxJSONBodyBytes)
+}
+
+// DecodeJSONLimit is DecodeJSON with a caller-chosen size limit
+func DecodeJSONLimit(w http.ResponseWriter, r *http.Request, dst interface{}, limit int64) bool {
+	dec := json.NewDecoder(http.MaxBytesReader(w, r.Body, limit))
+	dec.DisallowUnknownFields()
+
+	err := dec.Decode(dst)
+	if err == nil && dec.More() {
+		err = errors.New("unexpected data after the JSON body")
+	}
+	if err == nil {
+		return true
+	}
+
+	var (
+		maxBytesErr  *http.MaxBytesError
+		syntaxErr    *json.SyntaxError
+		typeErr      *json.UnmarshalTypeError
+		code         shared.ErrorCode
+		message      string
+		params       map[string]string
+		unknownField = "json: unknown field "
+	)
+	switch {
+	case errors.As(err, &maxBytesErr):
+		code, message = shared.ErrCodeBodyTooLarge, fmt.Sprintf("Request body must not exceed %d bytes", maxBytesErr.Limit)
+	case errors.Is(err, io.EOF):
+		code, message = shared.ErrCodeEmptyBody, "Request body is empty"
+	case strings.HasPrefix(err.Error(), unknownField):
+		field := strings.Trim(strings.TrimPrefix(err.Error(), unknownField), `"`)
+		code, message, params = shared.ErrCodeUnknownField, fmt.Sprintf("Unknown field %q in request body", field), map[string]string{"field": field}
+	case errors.As(err, &typeErr) && typeErr.Field != "":
+		code, message, params = shared.ErrCodeInvalidField, fmt.Sprintf("Field %q must be of type %s", typeErr.Field, typeErr.Type), map[string]string{"field": typeErr.Field}
+	case errors.As(err, &syntaxErr):
+		code, message = shared.ErrCodeMalformedJSON, fmt.Sprintf("Malformed JSON at byte %d", syntaxErr.Offset)
+	default:
+		// Truncated bodies, a non-object top-level value and trailing data
+		code, message = shared.ErrCodeMalformedJSON, "Malformed JSON request body"
+	}
+	writeJSONError(w, http.StatusBadRequest, code, message, params)
+	return false
+}
@@ -1,12 +1,16 @@
 package util
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"log"
+	"net"
 	"net/http"
 	"strings"
 
+	"stdiscm_p4/backend/internal/shared"
+
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -20,8 +24,10 @@ type JSONResponse struct {
 
 // JSONError structure for error responses
 type JSONError struct {
-	Success bool   `json:"success"`
-	Message string `json:"message"`
+	Success bool              `json:"success"`
+	Code    string            `json:"code,omitempty"`
+	Message string            `json:"message"`
+	Params  map[string]string `json:"params,omitempty"` // domain error parameters, e.g. course_id
 }
 
 // WriteJSON is a helper to write JSON responses
@@ -40,7 +46,7 @@ func WriteJSON(w http.ResponseWriter, status int, payload interface{}) {
 		response = JSONResponse{Success: true, Data: payload}
 	} else {
 		// Fallback for errors if WriteJSONError wasn't used
-		response = JSONError{Success: false, Message: "Unknown error"}
+		response = JSONError{Success: false, Code: string(errorCodeForStatus(status)), Message: "Unknown error"}
 	}
 
 	if err := json.NewEncoder(w).Encode(response); err != nil {
@@ -48,15 +54,28 @@ func WriteJSON(w http.ResponseWriter, status int, payload interface{}) {
 	}
 }
 
-// WriteJSONError is a helper to write standardized error JSON responses
+// WriteJSONError is a helper to write standardized error JSON responses.
+// The machine-readable code is derived from the HTTP status.
 func WriteJSONError(w http.ResponseWriter, status int, message string) {
-	log.Printf("HTTP Error %d: %s", status, message)
+	WriteJSONErrorCode(w, status, errorCodeForStatus(status), message)
+}
+
+// WriteJSONErrorCode writes a standardized error JSON response with an explicit error code
+func WriteJSONErrorCode(w http.ResponseWriter, status int, code shared.ErrorCode, message string) {
+	writeJSONError(w, status, code, message, nil)
+}
+
+// writeJSONError writes a standardized error JSON response including domain error parameters
+func writeJSONError(w http.ResponseWriter, status int, code shared.ErrorCode, message string, params map[string]string) {
+	log.Printf("HTTP Error %d (%s): %s", status, code, message)
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(status)
 
 	errorResponse := JSONError{
 		Success: false,
+		Code:    string(code),
 		Message: message,
+		Params:  params,
 	}
 
 	if err := json.NewEncoder(w).Encode(errorResponse); err != nil {
@@ -68,6 +87,12 @@ func WriteJSONError(w http.ResponseWriter, status int, message string) {
 // This is the core fault tolerance error mapping logic for the Gateway.
 // It relies on WriteJSONError being defined in the same package.
 func HandleGRPCError(w http.ResponseWriter, err error) {
+	// The route deadline can expire before the call reaches the wire
+	if errors.Is(err, context.DeadlineExceeded) {
+		WriteTimeoutError(w)
+		return
+	}
+
 	st, ok := status.FromError(err)
 	if !ok {
 		// Not a gRPC error, treat as internal server error
@@ -75,33 +100,91 @@ func HandleGRPCError(w http.ResponseWriter, err error) {
 		return
 	}
 
-	// Map gRPC codes to HTTP status codes
+	// Domain code and parameters attached by the service, or a code derived from the gRPC code
+	code := shared.ErrorCodeOf(err)
+
 	switch st.Code() {
+	case codes.Unavailable:
+		// Important for distributed systems: Service is down or unreachable
+		WriteJSONErrorCode(w, http.StatusServiceUnavailable, code, "Service Unavailable: The backend service is unreachable.")
+	case codes.DeadlineExceeded:
+		WriteTimeoutError(w)
+	default:
+		writeJSONError(w, HTTPStatusFromGRPC(st.Code()), code, st.Message(), shared.ErrorParamsOf(err))
+	}
+}
+
+// HTTPStatusFromGRPC maps a gRPC status code to the HTTP status the gateway responds with
+func HTTPStatusFromGRPC(c codes.Code) int {
+	switch c {
 	case codes.InvalidArgument:
-		WriteJSONError(w, http.StatusBadRequest, st.Message())
+		return http.StatusBadRequest
 	case codes.Unauthenticated:
-		WriteJSONError(w, http.StatusUnauthorized, st.Message())
+		return http.StatusUnauthorized
 	case codes.PermissionDenied:
-		WriteJSONError(w, http.StatusForbidden, st.Message())
+		return http.StatusForbidden
 	case codes.NotFound:
-		WriteJSONError(w, http.StatusNotFound, st.Message())
-	case codes.AlreadyExists:
-		WriteJSONError(w, http.StatusConflict, st.Message())
+		return http.StatusNotFound
+	case codes.AlreadyExists, codes.FailedPrecondition, codes.Aborted:
+		return http.StatusConflict
 	case codes.Unavailable:
-		// Important for distributed systems: Service is down or unreachable
-		WriteJSONError(w, http.StatusServiceUnavailable, "Service Unavailable: The backend service is unreachable.")
+		return http.StatusServiceUnavailable
 	case codes.DeadlineExceeded:
-		WriteJSONError(w, http.StatusGatewayTimeout, "Service Timeout: The backend service took too long to respond.")
+		return http.StatusGatewayTimeout
 	default:
 		// Catch-all for internal or unknown gRPC errors
-		WriteJSONError(w, http.StatusInternalServerError, st.Message())
+		return http.StatusInternalServerError
 	}
 }
 
-// ExtractToken extracts the token from the Authorization header (Bearer <token>)
+// WriteTimeoutError writes the 504 response for a downstream call that outlived its deadline
+func WriteTimeoutError(w http.ResponseWriter) {
+	WriteJSONErrorCode(w, http.StatusGatewayTimeout, shared.ErrCodeTimeout, "Service Timeout: The backend service took too long to respond.")
+}
+
+// errorCodeForStatus derives a generic error code for errors raised by the gateway itself
+func errorCodeForStatus(status int) shared.ErrorCode {
+	switch status {
+	case http.StatusBadRequest:
+		return shared.ErrCodeInvalidArgument
+	case http.StatusUnauthorized:
+		return shared.ErrCodeUnauthenticated
+	case http.StatusForbidden:
+		return shared.ErrCodePermissionDenied
+	case http.StatusNotFound:
+		return shared.ErrCodeNotFound
+	case http.StatusConflict:
+		return shared.ErrCodeAlreadyExists
+	case http.StatusServiceUnavailable:
+		return shared.ErrCodeServiceUnavailable
+	case http.StatusGatewayTimeout:
+		return shared.ErrCodeTimeout
+	default:
+		return shared.ErrCodeInternal
+	}
+}
+
+// ClientIP returns the client's IP address. The RealIP middleware has already
+// replaced RemoteAddr with X-Real-IP / X-Forwarded-For when present.
+func ClientIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
+
+// TokenCookieName is the HttpOnly cookie used when the gateway delivers tokens as cookies
+const TokenCookieName = "auth_token"
+
+// ExtractToken extracts the token from the Authorization header (Bearer <token>),
+// falling back to the auth cookie when no header is sent
 func ExtractToken(r *http.Request) (string, error) {
 	authHeader := r.Header.Get("Authorization")
 	if authHeader == "" {
+		if cookie, err := r.Cookie(TokenCookieName); err == nil && cookie.Value != "" {
+			return cookie.Value, nil
+		}
 		return "", errors.New("authorization header missing")
 	}
 
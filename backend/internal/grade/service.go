@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"sort"
+	"strconv"
 	"strings"
 	"time"
 
@@ -12,34 +14,62 @@ import (
 	"go.mongodb.org/mongo-driver/mongo/options"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
-	"google.golang.org/protobuf/types/known/timestamppb"
 
 	pb "stdiscm_p4/backend/internal/pb/grade"
 	"stdiscm_p4/backend/internal/shared"
 )
 
+// Page sizes for GetCourseGrades
+const (
+	defaultCourseGradesPageSize = 100
+	maxCourseGradesPageSize     = 500
+)
+
+// maxDraftGrades caps the grades accepted by a single SaveGradeDraft call
+const maxDraftGrades = 500
+
+// exportGradesChunkSize is how many grades each ExportGrades stream message carries
+const exportGradesChunkSize = 500
+
+// maxSimulatedGrades caps the hypothetical grades in a single SimulateGPA call
+const maxSimulatedGrades = 50
+
 // GradeService implements the gRPC GradeService
 type GradeService struct {
 	pb.UnimplementedGradeServiceServer
-	db             *mongo.Database
-	gradesCol      *mongo.Collection
-	enrollmentsCol *mongo.Collection
-	coursesCol     *mongo.Collection
-	usersCol       *mongo.Collection
+	db                 *mongo.Database
+	gradesCol          *mongo.Collection
+	enrollmentsCol     *mongo.Collection
+	coursesCol         *mongo.Collection
+	usersCol           *mongo.Collection
+	transferCreditsCol *mongo.Collection
+	systemConfigCol    *mongo.Collection
+	gradeDraftsCol     *mongo.Collection
+	gradeAppealsCol    *mongo.Collection
+	notificationsCol   *mongo.Collection
+	auditLogsCol       *mongo.Collection
+	eventsCol          *mongo.Collection
 }
 
 // NewGradeService creates a new GradeService instance
 func NewGradeService(db *mongo.Database) *GradeService {
 	return &GradeService{
-		db:             db,
-		gradesCol:      db.Collection("grades"),
-		enrollmentsCol: db.Collection("enrollments"),
-		coursesCol:     db.Collection("courses"),
-		usersCol:       db.Collection("users"),
+		db:                 db,
+		gradesCol:          db.Collection("grades"),
+		enrollmentsCol:     db.Collection("enrollments"),
+		coursesCol:         db.Collection("courses"),
+		usersCol:           db.Collection("users"),
+		transferCreditsCol: db.Collection("transfer_credits"),
+		systemConfigCol:    db.Collection("system_config"),
+		gradeDraftsCol:     db.Collection("grade_drafts"),
+		gradeAppealsCol:    db.Collection("grade_appeals"),
+		notificationsCol:   db.Collection("notifications"),
+		auditLogsCol:       db.Collection("audit_logs"),
+		eventsCol:          db.Collection("enrollment_events"),
 	}
 }
 
-// GetStudentGrades retrieves all grades for a student
+// GetStudentGrades retrieves a page of a student's published grades, newest semester first
 func (s *GradeService) GetStudentGrades(ctx context.Context, req *pb.GetStudentGradesRequest) (*pb.GetStudentGradesResponse, error) {
 	if req == nil || req.StudentId == "" {
 		return nil, status.Error(codes.InvalidArgument, "student_id is required")
@@ -76,24 +106,23 @@ func (s *GradeService) GetStudentGrades(ctx context.Context, req *pb.GetStudentG
 		filter["semester"] = req.Semester
 	}
 
-	findOptions := options.Find().
-		SetSort(bson.D{{Key: "semester", Value: -1}, {Key: "course_code", Value: 1}}).
-		SetLimit(100)
+	pageSize := req.PageSize
+	if pageSize < 1 {
+		pageSize = s.getGradesPageSize(queryCtx)
+	}
+	page, pageSize := shared.NormalizePage(req.Page, pageSize, shared.DefaultGradesPageSize, shared.MaxGradesPageSize)
 
-	cursor, err := s.gradesCol.Find(queryCtx, filter, findOptions)
+	// term_key orders semesters chronologically; the label itself only sorts lexically
+	findOptions := options.Find().
+		SetSort(bson.D{{Key: "term_key", Value: -1}, {Key: "course_code", Value: 1}, {Key: "_id", Value: 1}})
+	docs, pageInfo, err := shared.Paginate[bson.M](queryCtx, s.gradesCol, filter, findOptions, page, pageSize)
 	if err != nil {
 		log.Printf("Error querying grades: %v", err)
 		return nil, status.Error(codes.Internal, "failed to retrieve grades")
 	}
-	defer cursor.Close(queryCtx)
 
 	var grades []*pb.Grade
-	for cursor.Next(queryCtx) {
-		var doc bson.M
-		if err := cursor.Decode(&doc); err != nil {
-			continue
-		}
-
+	for _, doc := range docs {
 		grade, err := s.documentToGrade(doc)
 		if err != nil {
 			continue
@@ -109,11 +138,57 @@ func (s *GradeService) GetStudentGrades(ctx context.Context, req *pb.GetStudentG
 	}
 
 	return &pb.GetStudentGradesResponse{
-		Grades:  grades,
-		GpaInfo: gpaInfo,
+		Grades:     grades,
+		GpaInfo:    gpaInfo,
+		TotalCount: int32(pageInfo.Total),
+		Page:       pageInfo.Page,
+		PageSize:   pageInfo.PageSize,
 	}, nil
 }
 
+// GetStudentSemesters lists the semesters in which a student has published grades,
+// oldest first, so clients can offer them as GetStudentGrades filters
+func (s *GradeService) GetStudentSemesters(ctx context.Context, req *pb.GetStudentSemestersRequest) (*pb.GetStudentSemestersResponse, error) {
+	if req == nil || req.StudentId == "" {
+		return nil, status.Error(codes.InvalidArgument, "student_id is required")
+	}
+
+	queryCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	// Only published grades are visible to students, matching GetStudentGrades
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{"student_id": req.StudentId, "published": true, "semester": bson.M{"$nin": bson.A{"", nil}}}}},
+		{{Key: "$group", Value: bson.M{"_id": "$semester", "count": bson.M{"$sum": 1}}}},
+	}
+
+	cursor, err := s.gradesCol.Aggregate(queryCtx, pipeline)
+	if err != nil {
+		log.Printf("Error aggregating semesters for %s: %v", req.StudentId, err)
+		return nil, status.Error(codes.Internal, "failed to retrieve semesters")
+	}
+	defer cursor.Close(queryCtx)
+
+	var results []struct {
+		Semester string `bson:"_id"`
+		Count    int32  `bson:"count"`
+	}
+	if err := cursor.All(queryCtx, &results); err != nil {
+		return nil, status.Error(codes.Internal, "failed to decode semesters")
+	}
+
+	sort.Slice(results, func(i, j int) bool {
+		return shared.CompareSemesters(results[i].Semester, results[j].Semester) < 0
+	})
+
+	semesters := make([]*pb.StudentSemester, 0, len(results))
+	for _, r := range results {
+		semesters = append(semesters, &pb.StudentSemester{Semester: r.Semester, GradeCount: r.Count})
+	}
+
+	return &pb.GetStudentSemestersResponse{Semesters: semesters}, nil
+}
+
 // CalculateGPA calculates GPA for a student
 func (s *GradeService) CalculateGPA(ctx context.Context, req *pb.CalculateGPARequest) (*pb.CalculateGPAResponse, error) {
 	if req == nil || req.StudentId == "" {
@@ -127,11 +202,7 @@ func (s *GradeService) CalculateGPA(ctx context.Context, req *pb.CalculateGPAReq
 	err := s.usersCol.FindOne(queryCtx, bson.M{"_id": req.StudentId}).Decode(&student)
 	if err != nil {
 		if err == mongo.ErrNoDocuments {
-			return &pb.CalculateGPAResponse{
-				Success: false,
-				GpaInfo: &pb.GPACalculation{},
-				Message: fmt.Sprintf("student not found: %s", req.StudentId),
-			}, nil
+			return nil, shared.NewError(codes.NotFound, shared.ErrCodeStudentNotFound, "student not found")
 		}
 		return nil, status.Error(codes.Internal, "failed to retrieve student information")
 	}
@@ -152,6 +223,109 @@ func (s *GradeService) CalculateGPA(ctx context.Context, req *pb.CalculateGPAReq
 	}, nil
 }
 
+// SimulateGPA projects a student's GPA if they received the given grades. Each
+// hypothetical grade replaces any published grade for its course; units and
+// semester come from the course. Nothing is persisted.
+func (s *GradeService) SimulateGPA(ctx context.Context, req *pb.SimulateGPARequest) (*pb.SimulateGPAResponse, error) {
+	if req == nil || req.StudentId == "" {
+		return nil, status.Error(codes.InvalidArgument, "student_id is required")
+	}
+	if len(req.Grades) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "at least one hypothetical grade is required")
+	}
+	if len(req.Grades) > maxSimulatedGrades {
+		return nil, status.Errorf(codes.InvalidArgument, "at most %d hypothetical grades are allowed", maxSimulatedGrades)
+	}
+
+	hypothetical := make(map[string]string, len(req.Grades))
+	courseIDs := make([]string, 0, len(req.Grades))
+	for _, g := range req.Grades {
+		if g.CourseId == "" {
+			return nil, status.Error(codes.InvalidArgument, "course_id is required for each grade")
+		}
+		if _, dup := hypothetical[g.CourseId]; dup {
+			return nil, status.Errorf(codes.InvalidArgument, "duplicate course: %s", g.CourseId)
+		}
+		grade := strings.ToUpper(strings.TrimSpace(g.Grade))
+		if !shared.IsValidGrade(grade) {
+			return nil, shared.ErrInvalidGrade.WithParam("grade", g.Grade)
+		}
+		hypothetical[g.CourseId] = grade
+		courseIDs = append(courseIDs, g.CourseId)
+	}
+
+	queryCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	var student shared.User
+	err := s.usersCol.FindOne(queryCtx, bson.M{"_id": req.StudentId}).Decode(&student)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, shared.NewError(codes.NotFound, shared.ErrCodeStudentNotFound, "student not found")
+		}
+		return nil, status.Error(codes.Internal, "failed to retrieve student information")
+	}
+	if student.Role != shared.RoleStudent {
+		return nil, status.Error(codes.PermissionDenied, "user is not a student")
+	}
+
+	cursor, err := s.coursesCol.Find(queryCtx, bson.M{"_id": bson.M{"$in": courseIDs}})
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to retrieve courses")
+	}
+	var courses []shared.Course
+	if err := cursor.All(queryCtx, &courses); err != nil {
+		return nil, status.Error(codes.Internal, "failed to decode courses")
+	}
+	courseByID := make(map[string]shared.Course, len(courses))
+	for _, c := range courses {
+		courseByID[c.ID] = c
+	}
+
+	published, err := s.publishedGPARecords(queryCtx, req.StudentId, "")
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to retrieve grades")
+	}
+	records := make([]shared.GPARecord, 0, len(published)+len(courseIDs))
+	for _, r := range published {
+		if _, replaced := hypothetical[r.CourseID]; !replaced {
+			records = append(records, r)
+		}
+	}
+
+	var term string
+	for _, id := range courseIDs {
+		course, ok := courseByID[id]
+		if !ok {
+			return nil, shared.ErrCourseNotFound.WithParam("course_id", id)
+		}
+		records = append(records, shared.GPARecord{CourseID: id, Grade: hypothetical[id], Units: course.Units, Semester: course.Semester})
+		if term == "" || shared.CompareSemesters(course.Semester, term) > 0 {
+			term = course.Semester
+		}
+	}
+
+	calc := computeGPA(records)
+	calc.TermGpa = 0
+	for _, sem := range calc.SemesterBreakdown {
+		if sem.Semester == term {
+			calc.TermGpa = sem.Gpa
+		}
+	}
+	transferUnits, err := s.sumTransferUnits(queryCtx, req.StudentId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to calculate GPA")
+	}
+	calc.TotalUnitsEarned += transferUnits
+
+	return &pb.SimulateGPAResponse{
+		Success: true,
+		GpaInfo: calc,
+		Term:    term,
+		Message: "projected GPA calculated; no grades were saved",
+	}, nil
+}
+
 // GetClassRoster retrieves all students enrolled in a course
 func (s *GradeService) GetClassRoster(ctx context.Context, req *pb.GetClassRosterRequest) (*pb.GetClassRosterResponse, error) {
 	if req == nil || req.CourseId == "" {
@@ -165,15 +339,20 @@ func (s *GradeService) GetClassRoster(ctx context.Context, req *pb.GetClassRoste
 	err := s.coursesCol.FindOne(queryCtx, bson.M{"_id": req.CourseId}).Decode(&course)
 	if err != nil {
 		if err == mongo.ErrNoDocuments {
-			return &pb.GetClassRosterResponse{}, nil
+			return nil, shared.Errorf(codes.NotFound, shared.ErrCodeCourseNotFound, "course not found: %s", req.CourseId)
 		}
 		return nil, status.Error(codes.Internal, "failed to retrieve course information")
 	}
 
-	// Find enrolled students
+	// Restrict to the assigned faculty when the caller is identified
+	if req.FacultyId != "" && course.FacultyID != req.FacultyId {
+		return nil, shared.NewError(codes.PermissionDenied, shared.ErrCodeNotCourseFaculty, "faculty is not assigned to this course")
+	}
+
+	// Find enrolled students, and those withdrawn by a W grade to list separately
 	filter := bson.M{
 		"course_id": req.CourseId,
-		"status":    shared.StatusEnrolled,
+		"status":    bson.M{"$in": []string{shared.StatusEnrolled, shared.StatusWithdrawn}},
 	}
 	findOptions := options.Find().SetSort(bson.D{{Key: "student_id", Value: 1}})
 
@@ -181,20 +360,55 @@ func (s *GradeService) GetClassRoster(ctx context.Context, req *pb.GetClassRoste
 	if err != nil {
 		return nil, status.Error(codes.Internal, "failed to retrieve enrollments")
 	}
-	defer cursor.Close(queryCtx)
+	var enrollments []shared.Enrollment
+	if err := cursor.All(queryCtx, &enrollments); err != nil {
+		return nil, status.Error(codes.Internal, "failed to retrieve enrollments")
+	}
 
-	var students []*pb.StudentRosterEntry
-	for cursor.Next(queryCtx) {
-		var enrollment shared.Enrollment
-		if err := cursor.Decode(&enrollment); err != nil {
+	// Load every student and grade up front: two queries however large the class
+	studentIDs := make([]string, 0, len(enrollments))
+	enrollmentIDs := make([]string, 0, len(enrollments))
+	for _, e := range enrollments {
+		studentIDs = append(studentIDs, e.StudentID)
+		enrollmentIDs = append(enrollmentIDs, e.ID)
+	}
+	users, err := shared.BatchGetUsers(queryCtx, s.usersCol, studentIDs)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to retrieve students")
+	}
+	grades, err := s.gradesByEnrollment(queryCtx, enrollmentIDs)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to retrieve grades")
+	}
+
+	var students, withdrawn []*pb.StudentRosterEntry
+	summary := &pb.RosterGradeSummary{}
+	for _, enrollment := range enrollments {
+		user, ok := users[enrollment.StudentID]
+		if !ok {
 			continue
 		}
 
-		studentEntry, err := s.getStudentRosterEntry(queryCtx, enrollment.StudentID, enrollment.ID)
-		if err != nil {
+		grade, graded := grades[enrollment.ID]
+		studentEntry := &pb.StudentRosterEntry{
+			StudentId: user.ID, StudentName: user.Name, Email: user.Email,
+			Major: user.Major, YearLevel: user.YearLevel, Grade: grade.Grade,
+		}
+		if enrollment.Status == shared.StatusWithdrawn {
+			withdrawn = append(withdrawn, studentEntry)
 			continue
 		}
+
 		students = append(students, studentEntry)
+		switch {
+		case !graded:
+			summary.Ungraded++
+		case grade.Published:
+			summary.Graded++
+			summary.Published++
+		default:
+			summary.Graded++
+		}
 	}
 
 	return &pb.GetClassRosterResponse{
@@ -203,6 +417,8 @@ func (s *GradeService) GetClassRoster(ctx context.Context, req *pb.GetClassRoste
 		CourseTitle:   course.Title,
 		Students:      students,
 		TotalStudents: int32(len(students)),
+		Withdrawn:     withdrawn,
+		Summary:       summary,
 	}, nil
 }
 
@@ -234,7 +450,7 @@ func (s *GradeService) UploadGrades(stream pb.GradeService_UploadGradesServer) e
 			facultyID = req.GetMetadata().GetFacultyId()
 
 			if err := s.validateFacultyForCourse(stream.Context(), courseID, facultyID); err != nil {
-				return status.Errorf(codes.PermissionDenied, "faculty validation failed: %v", err)
+				return err
 			}
 			receivedMetadata = true
 			continue
@@ -321,248 +537,1085 @@ func (s *GradeService) PublishGrades(ctx context.Context, req *pb.PublishGradesR
 	}, nil
 }
 
-// GetCourseGrades retrieves all grades for a course (faculty only)
+// GetCourseGrades retrieves a page of grades for a course, optionally by publish state (faculty only)
 func (s *GradeService) GetCourseGrades(ctx context.Context, req *pb.GetCourseGradesRequest) (*pb.GetCourseGradesResponse, error) {
 	if req == nil || req.CourseId == "" || req.FacultyId == "" {
 		return nil, status.Error(codes.InvalidArgument, "invalid arguments")
 	}
 
+	var published bool
+	switch req.Published {
+	case "", "false":
+	case "true":
+		published = true
+	default:
+		return nil, status.Error(codes.InvalidArgument, "published must be \"true\" or \"false\"")
+	}
+
+	page, pageSize := shared.NormalizePage(req.Page, req.PageSize, defaultCourseGradesPageSize, maxCourseGradesPageSize)
+
 	queryCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
 	defer cancel()
 
 	if err := s.validateFacultyForCourse(queryCtx, req.CourseId, req.FacultyId); err != nil {
-		return &pb.GetCourseGradesResponse{}, nil
+		return nil, err
 	}
 
-	filter := bson.M{"course_id": req.CourseId}
-	cursor, err := s.gradesCol.Find(queryCtx, filter)
+	// Totals cover the whole course so the page and filter don't skew them
+	totalGrades, err := s.gradesCol.CountDocuments(queryCtx, bson.M{"course_id": req.CourseId})
+	if err != nil {
+		return nil, status.Error(codes.Internal, "db error")
+	}
+	unpublished, err := s.gradesCol.CountDocuments(queryCtx, bson.M{
+		"course_id": req.CourseId,
+		"published": bson.M{"$ne": true},
+	})
 	if err != nil {
 		return nil, status.Error(codes.Internal, "db error")
 	}
-	defer cursor.Close(queryCtx)
-
-	var grades []*pb.Grade
-	allPublished := true
-	count := 0
 
-	for cursor.Next(queryCtx) {
-		var doc bson.M
-		if err := cursor.Decode(&doc); err != nil {
-			continue
+	filter := bson.M{"course_id": req.CourseId}
+	if req.Published != "" {
+		// Grades missing the flag count as unpublished
+		if published {
+			filter["published"] = true
+		} else {
+			filter["published"] = bson.M{"$ne": true}
 		}
+	}
+
+	opts := options.Find().SetSort(bson.D{{Key: "student_id", Value: 1}, {Key: "_id", Value: 1}})
+	docs, pageInfo, err := shared.Paginate[bson.M](queryCtx, s.gradesCol, filter, opts, page, pageSize)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "db error")
+	}
 
+	var grades []*pb.Grade
+	for _, doc := range docs {
 		grade, err := s.documentToGrade(doc)
 		if err != nil {
 			continue
 		}
 
 		grades = append(grades, grade)
-		count++
-
-		if pub, _ := shared.GetBool(doc["published"]); !pub {
-			allPublished = false
-		}
 	}
 
 	return &pb.GetCourseGradesResponse{
 		Grades:       grades,
-		TotalGrades:  int32(count),
-		AllPublished: allPublished && count > 0,
+		TotalGrades:  int32(totalGrades),
+		AllPublished: unpublished == 0 && totalGrades > 0,
+		TotalCount:   int32(pageInfo.Total),
+		Page:         pageInfo.Page,
+		PageSize:     pageInfo.PageSize,
 	}, nil
 }
 
-// ============================================================================
-// Helper Functions
-// ============================================================================
-
-func (s *GradeService) documentToGrade(doc bson.M) (*pb.Grade, error) {
-	grade := &pb.Grade{}
-
-	if id, _ := shared.GetString(doc["enrollment_id"]); id != "" {
-		grade.EnrollmentId = id
-	} else {
-		return nil, fmt.Errorf("missing enrollment_id")
-	}
-	if sid, _ := shared.GetString(doc["student_id"]); sid != "" {
-		grade.StudentId = sid
-	}
-	if sname, _ := shared.GetString(doc["student_name"]); sname != "" {
-		grade.StudentName = sname
-	}
-	if cid, _ := shared.GetString(doc["course_id"]); cid != "" {
-		grade.CourseId = cid
-	}
-	if ccode, _ := shared.GetString(doc["course_code"]); ccode != "" {
-		grade.CourseCode = ccode
-	}
-	if ctitle, _ := shared.GetString(doc["course_title"]); ctitle != "" {
-		grade.CourseTitle = ctitle
-	}
-
-	if u, err := shared.GetInt32(doc["units"]); err == nil {
-		grade.Units = u
-	}
-	if g, _ := shared.GetString(doc["grade"]); g != "" {
-		grade.Grade = strings.ToUpper(g)
-	}
-	if sem, _ := shared.GetString(doc["semester"]); sem != "" {
-		grade.Semester = sem
+// GetDeansList lists students whose published grades for a semester meet the honors thresholds
+func (s *GradeService) GetDeansList(ctx context.Context, req *pb.GetDeansListRequest) (*pb.GetDeansListResponse, error) {
+	if req == nil || req.Semester == "" {
+		return nil, status.Error(codes.InvalidArgument, "semester is required")
 	}
-	if upBy, _ := shared.GetString(doc["uploaded_by"]); upBy != "" {
-		grade.UploadedBy = upBy
+	if req.MinGpa < 0 || req.MinGpa > 4.0 {
+		return nil, status.Error(codes.InvalidArgument, "min_gpa must be between 0 and 4.0")
 	}
-	if reason, _ := shared.GetString(doc["override_reason"]); reason != "" {
-		grade.OverrideReason = reason
+	if req.MinUnits < 0 {
+		return nil, status.Error(codes.InvalidArgument, "min_units cannot be negative")
 	}
 
-	if upAt, err := shared.GetTime(doc["uploaded_at"]); err == nil {
-		grade.UploadedAt = timestamppb.New(upAt)
+	// Unset thresholds fall back to the standard dean's list criteria
+	minGPA := req.MinGpa
+	if minGPA == 0 {
+		minGPA = shared.DeansListMinGPA
 	}
-	if pubAt, err := shared.GetTime(doc["published_at"]); err == nil {
-		grade.PublishedAt = timestamppb.New(pubAt)
-	}
-	if pub, err := shared.GetBool(doc["published"]); err == nil {
-		grade.Published = pub
+	minUnits := req.MinUnits
+	if minUnits == 0 {
+		minUnits = shared.DeansListMinUnits
 	}
 
-	return grade, nil
-}
+	queryCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
 
-func (s *GradeService) calculateStudentGPA(ctx context.Context, studentID, semester string) (*pb.GPACalculation, error) {
+	// Same grade set as calculateStudentGPA: published, excluding I and W
 	filter := bson.M{
-		"student_id": studentID,
-		"published":  true,
-		"grade":      bson.M{"$nin": []string{shared.GradeI, shared.GradeW}},
-	}
-	if semester != "" {
-		filter["semester"] = semester
+		"semester":  req.Semester,
+		"published": true,
+		"grade":     bson.M{"$nin": []string{shared.GradeI, shared.GradeW}},
 	}
 
-	cursor, err := s.gradesCol.Find(ctx, filter)
+	cursor, err := s.gradesCol.Find(queryCtx, filter)
 	if err != nil {
-		return nil, err
+		log.Printf("Error querying grades for dean's list: %v", err)
+		return nil, status.Error(codes.Internal, "failed to retrieve grades")
 	}
-	defer cursor.Close(ctx)
+	defer cursor.Close(queryCtx)
 
-	var overallPoints, overallUnits float64
-	semesterMap := make(map[string]*struct {
+	type studentTotals struct {
 		points, units float64
 		count         int
-	})
+	}
+	totals := make(map[string]*studentTotals)
 
-	for cursor.Next(ctx) {
+	for cursor.Next(queryCtx) {
 		var g struct {
-			Grade    string `bson:"grade"`
-			Units    int32  `bson:"units"`
-			Semester string `bson:"semester"`
+			StudentID string `bson:"student_id"`
+			Grade     string `bson:"grade"`
+			Units     int32  `bson:"units"`
 		}
-
 		if err := cursor.Decode(&g); err != nil {
 			continue
 		}
 
-		points := shared.GetGradePoints(g.Grade)
-		units := float64(g.Units)
-
-		overallPoints += points * units
-		overallUnits += units
-
-		if _, exists := semesterMap[g.Semester]; !exists {
-			semesterMap[g.Semester] = &struct {
-				points, units float64
-				count         int
-			}{}
+		t, exists := totals[g.StudentID]
+		if !exists {
+			t = &studentTotals{}
+			totals[g.StudentID] = t
 		}
-		sm := semesterMap[g.Semester]
-		sm.points += points * units
-		sm.units += units
-		sm.count++
+		units := float64(g.Units)
+		t.points += shared.GetGradePoints(g.Grade) * units
+		t.units += units
+		t.count++
 	}
 
-	calc := &pb.GPACalculation{
-		TotalUnitsAttempted: int32(overallUnits),
-		TotalUnitsEarned:    int32(overallUnits),
-	}
-	if overallUnits > 0 {
-		calc.TermGpa = overallPoints / overallUnits
-		calc.Cgpa = overallPoints / overallUnits
+	if err := cursor.Err(); err != nil {
+		log.Printf("Cursor error: %v", err)
+		return nil, status.Error(codes.Internal, "error iterating grades")
 	}
 
-	for sem, data := range semesterMap {
-		sgpa := 0.0
-		if data.units > 0 {
-			sgpa = data.points / data.units
+	var students []*pb.DeansListEntry
+	var studentIDs []string
+	for studentID, t := range totals {
+		if t.units == 0 || t.units < float64(minUnits) {
+			continue
 		}
-		calc.SemesterBreakdown = append(calc.SemesterBreakdown, &pb.SemesterGPA{
-			Semester: sem, Gpa: sgpa, Units: int32(data.units), CoursesCount: int32(data.count),
+		gpa := t.points / t.units
+		if gpa < minGPA {
+			continue
+		}
+		students = append(students, &pb.DeansListEntry{
+			StudentId:    studentID,
+			Gpa:          gpa,
+			TotalUnits:   int32(t.units),
+			CoursesCount: int32(t.count),
 		})
+		studentIDs = append(studentIDs, studentID)
 	}
 
-	return calc, nil
-}
+	// Attach name and major from the users collection
+	if len(studentIDs) > 0 {
+		userCursor, err := s.usersCol.Find(queryCtx, bson.M{"_id": bson.M{"$in": studentIDs}})
+		if err != nil {
+			log.Printf("Error querying students for dean's list: %v", err)
+			return nil, status.Error(codes.Internal, "failed to retrieve student information")
+		}
+		defer userCursor.Close(queryCtx)
 
-func (s *GradeService) getStudentRosterEntry(ctx context.Context, studentID, enrollmentID string) (*pb.StudentRosterEntry, error) {
-	var user shared.User
-	if err := s.usersCol.FindOne(ctx, bson.M{"_id": studentID}).Decode(&user); err != nil {
-		return nil, err
-	}
+		users := make(map[string]shared.User)
+		for userCursor.Next(queryCtx) {
+			var user shared.User
+			if err := userCursor.Decode(&user); err != nil {
+				continue
+			}
+			users[user.ID] = user
+		}
 
-	var gradeDoc struct {
-		Grade string `bson:"grade"`
-	}
-	grade := ""
-	if err := s.gradesCol.FindOne(ctx, bson.M{"enrollment_id": enrollmentID}).Decode(&gradeDoc); err == nil {
-		grade = gradeDoc.Grade
+		for _, entry := range students {
+			if user, ok := users[entry.StudentId]; ok {
+				entry.StudentName = user.Name
+				entry.Major = user.Major
+				entry.YearLevel = user.YearLevel
+			}
+		}
 	}
 
-	return &pb.StudentRosterEntry{
-		StudentId: studentID, StudentName: user.Name, Email: user.Email,
-		Major: user.Major, YearLevel: user.YearLevel, Grade: grade,
+	sort.Slice(students, func(i, j int) bool {
+		if students[i].Gpa != students[j].Gpa {
+			return students[i].Gpa > students[j].Gpa
+		}
+		if students[i].TotalUnits != students[j].TotalUnits {
+			return students[i].TotalUnits > students[j].TotalUnits
+		}
+		return students[i].StudentId < students[j].StudentId
+	})
+
+	return &pb.GetDeansListResponse{
+		Semester:      req.Semester,
+		MinGpa:        minGPA,
+		MinUnits:      minUnits,
+		Students:      students,
+		TotalStudents: int32(len(students)),
 	}, nil
 }
 
-func (s *GradeService) validateFacultyForCourse(ctx context.Context, courseID, facultyID string) error {
-	var faculty shared.User
-	if err := s.usersCol.FindOne(ctx, bson.M{"_id": facultyID}).Decode(&faculty); err != nil {
-		return fmt.Errorf("faculty not found")
-	}
-	if faculty.Role != shared.RoleFaculty {
-		return fmt.Errorf("user not faculty")
-	}
+// reportGrades are the letter grades every GradeStats distribution lists, zero or not
+var reportGrades = []string{shared.GradeA, shared.GradeB, shared.GradeC, shared.GradeD, shared.GradeF, shared.GradeI, shared.GradeW}
 
-	var course shared.Course
-	if err := s.coursesCol.FindOne(ctx, bson.M{"_id": courseID}).Decode(&course); err != nil {
-		return fmt.Errorf("course not found")
-	}
-	if course.FacultyID != facultyID {
-		return fmt.Errorf("faculty mismatch")
-	}
-	return nil
+// gradeTotals are the sums produced for one group by gradeStatsStages
+type gradeTotals struct {
+	Total    int32            `bson:"total"`
+	Points   float64          `bson:"points"`    // grade points times units
+	GPAUnits int32            `bson:"gpa_units"` // units of grades counted in GPA
+	Counts   map[string]int32 `bson:"counts"`
 }
 
-func (s *GradeService) uploadSingleGrade(ctx context.Context, courseID, facultyID string, entry *pb.GradeEntry) error {
-	grade := strings.ToUpper(entry.Grade)
-	if !shared.IsValidGrade(grade) {
-		return fmt.Errorf("invalid grade")
+// GetSemesterGradeReport aggregates a semester's published grades into overall,
+// per-department and per-course statistics. Courses are grouped by their faculty
+// member's department (e.g. "Computer Science"), not the course department code.
+func (s *GradeService) GetSemesterGradeReport(ctx context.Context, req *pb.GetSemesterGradeReportRequest) (*pb.GetSemesterGradeReportResponse, error) {
+	if req == nil || req.Semester == "" {
+		return nil, status.Error(codes.InvalidArgument, "semester is required")
 	}
 
-	var enrollment shared.Enrollment
-	err := s.enrollmentsCol.FindOne(ctx, bson.M{
-		"student_id": entry.StudentId, "course_id": courseID,
-	}).Decode(&enrollment)
+	queryCtx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
 
-	if err != nil {
-		return fmt.Errorf("student not enrolled")
+	// Collapse grades to one row per course and letter grade before the lookups,
+	// then roll those rows up three ways
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{"semester": req.Semester, "published": true}}},
+		{{Key: "$group", Value: bson.M{
+			"_id":          bson.M{"course_id": "$course_id", "grade": "$grade"},
+			"count":        bson.M{"$sum": 1},
+			"units":        bson.M{"$sum": "$units"},
+			"course_code":  bson.M{"$first": "$course_code"},
+			"course_title": bson.M{"$first": "$course_title"},
+		}}},
+		{{Key: "$lookup", Value: bson.M{
+			"from":         "courses",
+			"localField":   "_id.course_id",
+			"foreignField": "_id",
+			"as":           "course",
+		}}},
+		{{Key: "$lookup", Value: bson.M{
+			"from":         "users",
+			"localField":   "course.faculty_id",
+			"foreignField": "_id",
+			"as":           "faculty",
+		}}},
+		{{Key: "$set", Value: bson.M{
+			"department": bson.M{"$ifNull": bson.A{bson.M{"$arrayElemAt": bson.A{"$faculty.department", 0}}, ""}},
+		}}},
+		{{Key: "$facet", Value: bson.M{
+			"courses": append(gradeStatsStages(bson.M{
+				"course_id":    "$_id.course_id",
+				"course_code":  "$course_code",
+				"course_title": "$course_title",
+				"department":   "$department",
+			}), bson.D{{Key: "$sort", Value: bson.D{{Key: "_id.course_code", Value: 1}, {Key: "_id.course_id", Value: 1}}}}),
+			"departments": append(gradeStatsStages("$department"), bson.D{{Key: "$sort", Value: bson.M{"_id": 1}}}),
+			"overall":     gradeStatsStages(nil),
+		}}},
 	}
 
-	var course shared.Course
-	if err := s.coursesCol.FindOne(ctx, bson.M{"_id": courseID}).Decode(&course); err != nil {
-		return fmt.Errorf("course details not found")
+	cursor, err := s.gradesCol.Aggregate(queryCtx, pipeline)
+	if err != nil {
+		log.Printf("Error aggregating semester grade report: %v", err)
+		return nil, status.Error(codes.Internal, "failed to aggregate grades")
+	}
+	var results []struct {
+		Courses []struct {
+			Key struct {
+				CourseID    string `bson:"course_id"`
+				CourseCode  string `bson:"course_code"`
+				CourseTitle string `bson:"course_title"`
+				Department  string `bson:"department"`
+			} `bson:"_id"`
+			gradeTotals `bson:",inline"`
+		} `bson:"courses"`
+		Departments []struct {
+			Department  string `bson:"_id"`
+			gradeTotals `bson:",inline"`
+		} `bson:"departments"`
+		Overall []gradeTotals `bson:"overall"`
+	}
+	if err := cursor.All(queryCtx, &results); err != nil || len(results) != 1 {
+		log.Printf("Error decoding semester grade report: %v", err)
+		return nil, status.Error(codes.Internal, "failed to decode grade report")
 	}
+	result := results[0]
 
-	var student shared.User
-	if err := s.usersCol.FindOne(ctx, bson.M{"_id": entry.StudentId}).Decode(&student); err != nil {
-		return fmt.Errorf("student details not found")
+	resp := &pb.GetSemesterGradeReportResponse{
+		Semester:    req.Semester,
+		Overall:     gradeStats(gradeTotals{}),
+		Departments: make([]*pb.DepartmentGradeReport, 0, len(result.Departments)),
+		Courses:     make([]*pb.CourseGradeReport, 0, len(result.Courses)),
+	}
+	if len(result.Overall) > 0 {
+		resp.Overall = gradeStats(result.Overall[0])
+	}
+
+	courseCounts := make(map[string]int32)
+	for _, c := range result.Courses {
+		courseCounts[c.Key.Department]++
+		resp.Courses = append(resp.Courses, &pb.CourseGradeReport{
+			CourseId:    c.Key.CourseID,
+			CourseCode:  c.Key.CourseCode,
+			CourseTitle: c.Key.CourseTitle,
+			Department:  c.Key.Department,
+			Stats:       gradeStats(c.gradeTotals),
+		})
+	}
+	for _, d := range result.Departments {
+		resp.Departments = append(resp.Departments, &pb.DepartmentGradeReport{
+			Department:  d.Department,
+			CourseCount: courseCounts[d.Department],
+			Stats:       gradeStats(d.gradeTotals),
+		})
+	}
+
+	return resp, nil
+}
+
+// ExportGrades streams every grade of a semester for the registrar extract. Grades
+// are read through a cursor and sent exportGradesChunkSize at a time, so memory use
+// does not grow with the semester; the last message carries the row count.
+// Only admins may export.
+func (s *GradeService) ExportGrades(req *pb.ExportGradesRequest, stream pb.GradeService_ExportGradesServer) error {
+	if req == nil || req.Semester == "" {
+		return status.Error(codes.InvalidArgument, "semester is required")
+	}
+	if req.AdminId == "" {
+		return status.Error(codes.InvalidArgument, "admin_id is required")
+	}
+	ctx := stream.Context()
+
+	lookupCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	var admin shared.User
+	err := s.usersCol.FindOne(lookupCtx, bson.M{"_id": req.AdminId}).Decode(&admin)
+	cancel()
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return status.Error(codes.PermissionDenied, "user not found")
+		}
+		return status.Error(codes.Internal, "failed to retrieve user")
+	}
+	if admin.Role != shared.RoleAdmin {
+		return status.Error(codes.PermissionDenied, "only admins can export grades")
+	}
+
+	filter := bson.M{"semester": req.Semester}
+	if req.PublishedOnly {
+		filter["published"] = true
+	}
+	opts := options.Find().
+		SetSort(bson.D{{Key: "course_code", Value: 1}, {Key: "student_id", Value: 1}, {Key: "_id", Value: 1}}).
+		SetBatchSize(exportGradesChunkSize)
+	cursor, err := s.gradesCol.Find(ctx, filter, opts)
+	if err != nil {
+		log.Printf("Error querying grades for export: %v", err)
+		return status.Error(codes.Internal, "failed to export grades")
+	}
+	defer cursor.Close(ctx)
+
+	var total int32
+	chunk := make([]*pb.Grade, 0, exportGradesChunkSize)
+	for cursor.Next(ctx) {
+		var doc bson.M
+		if err := cursor.Decode(&doc); err != nil {
+			continue
+		}
+		grade, err := s.documentToGrade(doc)
+		if err != nil {
+			continue
+		}
+		chunk = append(chunk, grade)
+
+		if len(chunk) == exportGradesChunkSize {
+			if err := stream.Send(&pb.ExportGradesChunk{Grades: chunk}); err != nil {
+				return err
+			}
+			total += int32(len(chunk))
+			chunk = make([]*pb.Grade, 0, exportGradesChunkSize)
+		}
+	}
+	if err := cursor.Err(); err != nil {
+		log.Printf("Grade export for %s failed after %d rows: %v", req.Semester, total, err)
+		return status.Error(codes.Internal, "failed to export grades")
+	}
+
+	total += int32(len(chunk))
+	return stream.Send(&pb.ExportGradesChunk{
+		Grades:  chunk,
+		Summary: &pb.ExportGradesSummary{Semester: req.Semester, TotalRows: total},
+	})
+}
+
+// gradeStatsStages groups (course, grade) rows by key into gradeTotals. Rows are
+// first merged per key and grade, so counts holds one entry per letter grade.
+func gradeStatsStages(key interface{}) []bson.D {
+	return []bson.D{
+		{{Key: "$group", Value: bson.M{
+			"_id":   bson.M{"key": key, "grade": "$_id.grade"},
+			"count": bson.M{"$sum": "$count"},
+			"units": bson.M{"$sum": "$units"},
+		}}},
+		{{Key: "$group", Value: bson.M{
+			"_id":    "$_id.key",
+			"total":  bson.M{"$sum": "$count"},
+			"points": bson.M{"$sum": bson.M{"$multiply": bson.A{gradePointsExpr("$_id.grade"), "$units"}}},
+			"gpa_units": bson.M{"$sum": bson.M{"$cond": bson.A{
+				bson.M{"$in": bson.A{"$_id.grade", bson.A{shared.GradeI, shared.GradeW}}}, 0, "$units",
+			}}},
+			"counts": bson.M{"$push": bson.M{"k": "$_id.grade", "v": "$count"}},
+		}}},
+		{{Key: "$set", Value: bson.M{"counts": bson.M{"$arrayToObject": "$counts"}}}},
+	}
+}
+
+// gradePointsExpr is shared.GetGradePoints as an aggregation expression over field
+func gradePointsExpr(field string) bson.M {
+	branches := bson.A{}
+	for _, grade := range reportGrades {
+		branches = append(branches, bson.M{
+			"case": bson.M{"$eq": bson.A{field, grade}},
+			"then": shared.GetGradePoints(grade),
+		})
+	}
+	return bson.M{"$switch": bson.M{"branches": branches, "default": 0.0}}
+}
+
+// gradeStats derives GPA and pass/fail rates from aggregated totals. Pass and fail
+// rates are over A-F only; I and W are neither.
+func gradeStats(t gradeTotals) *pb.GradeStats {
+	stats := &pb.GradeStats{
+		TotalGrades:  t.Total,
+		Distribution: make(map[string]int32, len(reportGrades)),
+	}
+	for _, grade := range reportGrades {
+		stats.Distribution[grade] = 0
+	}
+	for grade, n := range t.Counts {
+		stats.Distribution[grade] = n
+		if shared.IsPassingGrade(grade) {
+			stats.Passed += n
+		} else if grade == shared.GradeF {
+			stats.Failed += n
+		}
+	}
+	if t.GPAUnits > 0 {
+		stats.AverageGpa = t.Points / float64(t.GPAUnits)
+	}
+	if graded := stats.Passed + stats.Failed; graded > 0 {
+		stats.PassRate = float64(stats.Passed) / float64(graded)
+		stats.FailRate = float64(stats.Failed) / float64(graded)
+	}
+	return stats
+}
+
+// SaveGradeDraft autosaves grades a faculty member is entering for a course.
+// Grades are stored exactly as entered; validation happens in FinalizeDraft.
+func (s *GradeService) SaveGradeDraft(ctx context.Context, req *pb.SaveGradeDraftRequest) (*pb.SaveGradeDraftResponse, error) {
+	if req == nil || req.CourseId == "" || req.FacultyId == "" {
+		return nil, status.Error(codes.InvalidArgument, "course_id and faculty_id are required")
+	}
+	if len(req.Grades) > maxDraftGrades {
+		return nil, status.Errorf(codes.InvalidArgument, "at most %d grades per draft", maxDraftGrades)
+	}
+	for studentID := range req.Grades {
+		if !validDraftKey(studentID) {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid student_id %q", studentID)
+		}
+	}
+
+	queryCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	if err := s.validateFacultyForCourse(queryCtx, req.CourseId, req.FacultyId); err != nil {
+		return nil, err
+	}
+
+	// Merge by default so autosave can send only the grades that changed
+	now := time.Now()
+	set := bson.M{"updated_at": now, "expires_at": s.gradeDraftExpiry(queryCtx, now)}
+	unset := bson.M{}
+	if req.Replace {
+		grades := map[string]string{}
+		for studentID, grade := range req.Grades {
+			if grade != "" {
+				grades[studentID] = grade
+			}
+		}
+		set["grades"] = grades
+	} else {
+		for studentID, grade := range req.Grades {
+			if grade == "" {
+				unset["grades."+studentID] = ""
+			} else {
+				set["grades."+studentID] = grade
+			}
+		}
+	}
+	update := bson.M{"$set": set}
+	if len(unset) > 0 {
+		update["$unset"] = unset
+	}
+
+	var draft shared.GradeDraft
+	opts := options.FindOneAndUpdate().SetUpsert(true).SetReturnDocument(options.After)
+	err := s.gradeDraftsCol.FindOneAndUpdate(queryCtx, bson.M{"course_id": req.CourseId, "faculty_id": req.FacultyId}, update, opts).Decode(&draft)
+	if err != nil {
+		log.Printf("Error saving grade draft for %s: %v", req.CourseId, err)
+		return nil, status.Error(codes.Internal, "failed to save grade draft")
+	}
+
+	return &pb.SaveGradeDraftResponse{
+		Success: true,
+		Message: fmt.Sprintf("draft saved with %d grades", len(draft.Grades)),
+		Draft:   gradeDraftToProto(&draft),
+	}, nil
+}
+
+// GetGradeDraft returns the faculty member's unexpired draft for a course, if any
+func (s *GradeService) GetGradeDraft(ctx context.Context, req *pb.GetGradeDraftRequest) (*pb.GetGradeDraftResponse, error) {
+	if req == nil || req.CourseId == "" || req.FacultyId == "" {
+		return nil, status.Error(codes.InvalidArgument, "course_id and faculty_id are required")
+	}
+
+	queryCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	if err := s.validateFacultyForCourse(queryCtx, req.CourseId, req.FacultyId); err != nil {
+		return nil, err
+	}
+
+	draft, err := s.findGradeDraft(queryCtx, req.CourseId, req.FacultyId)
+	if err != nil {
+		log.Printf("Error loading grade draft for %s: %v", req.CourseId, err)
+		return nil, status.Error(codes.Internal, "failed to load grade draft")
+	}
+	if draft == nil {
+		return &pb.GetGradeDraftResponse{Found: false}, nil
+	}
+	return &pb.GetGradeDraftResponse{Found: true, Draft: gradeDraftToProto(draft)}, nil
+}
+
+// FinalizeDraft uploads every draft grade through the same validation as UploadGrades.
+// Uploaded grades leave the draft; failed ones stay so the faculty member can fix them.
+func (s *GradeService) FinalizeDraft(ctx context.Context, req *pb.FinalizeDraftRequest) (*pb.FinalizeDraftResponse, error) {
+	if req == nil || req.CourseId == "" || req.FacultyId == "" {
+		return nil, status.Error(codes.InvalidArgument, "course_id and faculty_id are required")
+	}
+
+	queryCtx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	if err := s.validateFacultyForCourse(queryCtx, req.CourseId, req.FacultyId); err != nil {
+		return nil, err
+	}
+
+	draft, err := s.findGradeDraft(queryCtx, req.CourseId, req.FacultyId)
+	if err != nil {
+		log.Printf("Error loading grade draft for %s: %v", req.CourseId, err)
+		return nil, status.Error(codes.Internal, "failed to load grade draft")
+	}
+	if draft == nil || len(draft.Grades) == 0 {
+		return nil, status.Error(codes.FailedPrecondition, "no grade draft to finalize")
+	}
+
+	// Process in student order so results are stable
+	studentIDs := make([]string, 0, len(draft.Grades))
+	for studentID := range draft.Grades {
+		studentIDs = append(studentIDs, studentID)
+	}
+	sort.Strings(studentIDs)
+
+	resp := &pb.FinalizeDraftResponse{Errors: []*pb.GradeEntryError{}}
+	uploaded := bson.M{}
+	for _, studentID := range studentIDs {
+		grade := draft.Grades[studentID]
+		resp.TotalProcessed++
+
+		entry := &pb.GradeEntry{StudentId: studentID, Grade: grade}
+		if err := s.uploadSingleGrade(queryCtx, req.CourseId, req.FacultyId, entry); err != nil {
+			resp.Failed++
+			resp.Errors = append(resp.Errors, &pb.GradeEntryError{
+				StudentId: studentID,
+				Grade:     grade,
+				Error:     status.Convert(err).Message(),
+				ErrorCode: string(shared.ErrorCodeOf(err)),
+			})
+			continue
+		}
+		resp.Successful++
+		uploaded["grades."+studentID] = ""
+	}
+
+	// Drop the uploaded grades; edits saved meanwhile for failed students are kept
+	filter := bson.M{"course_id": req.CourseId, "faculty_id": req.FacultyId}
+	if resp.Failed == 0 {
+		_, err = s.gradeDraftsCol.DeleteOne(queryCtx, filter)
+	} else if len(uploaded) > 0 {
+		_, err = s.gradeDraftsCol.UpdateOne(queryCtx, filter, bson.M{"$unset": uploaded, "$set": bson.M{"updated_at": time.Now()}})
+	}
+	if err != nil {
+		log.Printf("Warning: failed to clear finalized grades from draft for %s: %v", req.CourseId, err)
+	}
+
+	resp.Success = resp.Failed == 0
+	resp.Message = fmt.Sprintf("Finalized %d of %d draft grades", resp.Successful, resp.TotalProcessed)
+	return resp, nil
+}
+
+// SubmitGradeAppeal opens an appeal of a published grade. Only the student who owns
+// the enrollment may appeal, only within the appeal window after publication
+// (grade_appeal_window_days), and only one appeal per enrollment may be open.
+func (s *GradeService) SubmitGradeAppeal(ctx context.Context, req *pb.SubmitGradeAppealRequest) (*pb.SubmitGradeAppealResponse, error) {
+	if req == nil || req.EnrollmentId == "" || req.StudentId == "" {
+		return nil, status.Error(codes.InvalidArgument, "enrollment_id and student_id are required")
+	}
+	reason := strings.TrimSpace(req.Reason)
+	if reason == "" {
+		return nil, status.Error(codes.InvalidArgument, "reason is required")
+	}
+	if err := shared.ValidateText("reason", reason, shared.MaxAppealReasonLength, true); err != nil {
+		return nil, err
+	}
+
+	queryCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	// 1. The enrollment must belong to the appealing student
+	var enrollment shared.Enrollment
+	if err := s.enrollmentsCol.FindOne(queryCtx, bson.M{"_id": req.EnrollmentId}).Decode(&enrollment); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, shared.ErrNotEnrolled.Newf("enrollment not found")
+		}
+		return nil, status.Error(codes.Internal, "db error")
+	}
+	if enrollment.StudentID != req.StudentId {
+		return nil, status.Error(codes.PermissionDenied, "only the enrolled student can appeal this grade")
+	}
+
+	// 2. Only published grades can be appealed
+	var grade shared.Grade
+	if err := s.gradesCol.FindOne(queryCtx, bson.M{"enrollment_id": enrollment.ID, "published": true}).Decode(&grade); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, status.Error(codes.FailedPrecondition, "no published grade to appeal")
+		}
+		return nil, status.Error(codes.Internal, "db error")
+	}
+	if !grade.PublishedAt.IsZero() {
+		deadline := grade.PublishedAt.Add(shared.GetAppealWindow(queryCtx, s.systemConfigCol))
+		if time.Now().After(deadline) {
+			return nil, shared.ErrAppealWindowClosed.Newf("appeals for this grade closed on %s", deadline.Format("2006-01-02")).
+				WithParam("deadline", deadline.Format(time.RFC3339))
+		}
+	}
+
+	var course shared.Course
+	if err := s.coursesCol.FindOne(queryCtx, bson.M{"_id": enrollment.CourseID}).Decode(&course); err != nil {
+		return nil, shared.ErrCourseNotFound.WithParam("course_id", enrollment.CourseID)
+	}
+
+	// 3. One open appeal per enrollment (also enforced by a partial unique index)
+	open, err := s.gradeAppealsCol.CountDocuments(queryCtx, bson.M{"enrollment_id": enrollment.ID, "status": shared.AppealOpen})
+	if err != nil {
+		return nil, status.Error(codes.Internal, "db error")
+	}
+	if open > 0 {
+		return nil, shared.ErrAppealAlreadyOpen.WithParam("enrollment_id", enrollment.ID)
+	}
+
+	appeal := shared.GradeAppeal{
+		ID:            shared.GenerateGradeAppealID(),
+		EnrollmentID:  enrollment.ID,
+		StudentID:     req.StudentId,
+		CourseID:      course.ID,
+		CourseCode:    course.Code,
+		FacultyID:     course.FacultyID,
+		OriginalGrade: grade.Grade,
+		Reason:        reason,
+		Status:        shared.AppealOpen,
+		SubmittedAt:   time.Now(),
+	}
+	if _, err := s.gradeAppealsCol.InsertOne(queryCtx, appeal); err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return nil, shared.ErrAppealAlreadyOpen.WithParam("enrollment_id", enrollment.ID)
+		}
+		log.Printf("Error saving grade appeal for %s: %v", enrollment.ID, err)
+		return nil, status.Error(codes.Internal, "failed to submit appeal")
+	}
+
+	shared.LogAuditEvent(queryCtx, s.auditLogsCol, req.StudentId, shared.ActionAppealSubmit, appeal.ID, map[string]interface{}{
+		"enrollment_id":  appeal.EnrollmentID,
+		"course_id":      appeal.CourseID,
+		"original_grade": appeal.OriginalGrade,
+	})
+
+	return &pb.SubmitGradeAppealResponse{
+		Success: true,
+		Message: "appeal submitted",
+		Appeal:  gradeAppealToProto(&appeal),
+	}, nil
+}
+
+// ListGradeAppeals lists appeals for a faculty member, course or student, newest first
+func (s *GradeService) ListGradeAppeals(ctx context.Context, req *pb.ListGradeAppealsRequest) (*pb.ListGradeAppealsResponse, error) {
+	if req == nil || (req.FacultyId == "" && req.CourseId == "" && req.StudentId == "") {
+		return nil, status.Error(codes.InvalidArgument, "faculty_id, course_id or student_id is required")
+	}
+
+	filter := bson.M{}
+	if req.FacultyId != "" {
+		filter["faculty_id"] = req.FacultyId
+	}
+	if req.CourseId != "" {
+		filter["course_id"] = req.CourseId
+	}
+	if req.StudentId != "" {
+		filter["student_id"] = req.StudentId
+	}
+	switch req.Status {
+	case "":
+	case shared.AppealOpen, shared.AppealApproved, shared.AppealDenied:
+		filter["status"] = req.Status
+	default:
+		return nil, status.Error(codes.InvalidArgument, "status must be open, approved or denied")
+	}
+
+	queryCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	opts := options.Find().SetSort(bson.D{{Key: "submitted_at", Value: -1}, {Key: "_id", Value: -1}})
+	cursor, err := s.gradeAppealsCol.Find(queryCtx, filter, opts)
+	if err != nil {
+		log.Printf("Error querying grade appeals: %v", err)
+		return nil, status.Error(codes.Internal, "failed to retrieve appeals")
+	}
+	var appeals []shared.GradeAppeal
+	if err := cursor.All(queryCtx, &appeals); err != nil {
+		log.Printf("Error decoding grade appeals: %v", err)
+		return nil, status.Error(codes.Internal, "failed to retrieve appeals")
+	}
+
+	resp := &pb.ListGradeAppealsResponse{
+		Appeals:    make([]*pb.GradeAppeal, 0, len(appeals)),
+		TotalCount: int32(len(appeals)),
+	}
+	for i := range appeals {
+		resp.Appeals = append(resp.Appeals, gradeAppealToProto(&appeals[i]))
+	}
+	return resp, nil
+}
+
+// ResolveGradeAppeal records the course faculty's decision on an open appeal. An approval
+// changes the grade through changeGrade, keeping its history, and the student gets a
+// notification in the same transaction.
+func (s *GradeService) ResolveGradeAppeal(ctx context.Context, req *pb.ResolveGradeAppealRequest) (*pb.ResolveGradeAppealResponse, error) {
+	if req == nil || req.AppealId == "" || req.FacultyId == "" {
+		return nil, status.Error(codes.InvalidArgument, "appeal_id and faculty_id are required")
+	}
+	newGrade := strings.ToUpper(strings.TrimSpace(req.NewGrade))
+	switch req.Decision {
+	case shared.AppealApproved:
+		if !shared.IsValidGrade(newGrade) {
+			return nil, shared.ErrInvalidGrade.Newf("approving an appeal requires a valid new_grade").WithParam("grade", req.NewGrade)
+		}
+	case shared.AppealDenied:
+		if newGrade != "" {
+			return nil, status.Error(codes.InvalidArgument, "new_grade is only allowed when approving")
+		}
+	default:
+		return nil, status.Error(codes.InvalidArgument, "decision must be approved or denied")
+	}
+	comment := strings.TrimSpace(req.Comment)
+	if err := shared.ValidateText("comment", comment, shared.MaxAppealCommentLength, true); err != nil {
+		return nil, err
+	}
+
+	queryCtx, cancel := context.WithTimeout(ctx, 15*time.Second)
+	defer cancel()
+
+	var appeal shared.GradeAppeal
+	if err := s.gradeAppealsCol.FindOne(queryCtx, bson.M{"_id": req.AppealId}).Decode(&appeal); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, status.Error(codes.NotFound, "appeal not found")
+		}
+		return nil, status.Error(codes.Internal, "db error")
+	}
+	if err := s.validateFacultyForCourse(queryCtx, appeal.CourseID, req.FacultyId); err != nil {
+		return nil, err
+	}
+	if appeal.Status != shared.AppealOpen {
+		return nil, shared.ErrAppealResolved.WithParam("appeal_id", appeal.ID)
+	}
+
+	now := time.Now()
+	err := shared.WithTransaction(queryCtx, s.db.Client(), func(sessCtx mongo.SessionContext) error {
+		res, err := s.gradeAppealsCol.UpdateOne(sessCtx,
+			bson.M{"_id": appeal.ID, "status": shared.AppealOpen},
+			bson.M{"$set": bson.M{
+				"status":      req.Decision,
+				"new_grade":   newGrade,
+				"comment":     comment,
+				"resolved_by": req.FacultyId,
+				"resolved_at": now,
+			}},
+		)
+		if err != nil {
+			return err
+		}
+		if res.MatchedCount == 0 {
+			return shared.ErrAppealResolved.WithParam("appeal_id", appeal.ID)
+		}
+
+		message := fmt.Sprintf("Your grade appeal for %s was denied", appeal.CourseCode)
+		if req.Decision == shared.AppealApproved {
+			if err := s.changeGrade(sessCtx, appeal.EnrollmentID, newGrade, req.FacultyId, "grade appeal "+appeal.ID); err != nil {
+				return err
+			}
+			message = fmt.Sprintf("Your grade appeal for %s was approved: your grade is now %s", appeal.CourseCode, newGrade)
+		}
+		_, err = s.notificationsCol.InsertOne(sessCtx, shared.Notification{
+			ID:        shared.GenerateNotificationID(),
+			UserID:    appeal.StudentID,
+			Type:      shared.NotificationAppealResolved,
+			Message:   message,
+			CourseID:  appeal.CourseID,
+			CreatedAt: now,
+		})
+		return err
+	})
+	if err != nil {
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+		log.Printf("Error resolving grade appeal %s: %v", appeal.ID, err)
+		return nil, status.Error(codes.Internal, "failed to resolve appeal")
+	}
+
+	appeal.Status = req.Decision
+	appeal.NewGrade = newGrade
+	appeal.Comment = comment
+	appeal.ResolvedBy = req.FacultyId
+	appeal.ResolvedAt = now
+	s.auditAppealResolved(queryCtx, &appeal)
+
+	return &pb.ResolveGradeAppealResponse{
+		Success: true,
+		Message: "appeal " + req.Decision,
+		Appeal:  gradeAppealToProto(&appeal),
+	}, nil
+}
+
+// ============================================================================
+// Helper Functions
+// ============================================================================
+
+func (s *GradeService) documentToGrade(doc bson.M) (*pb.Grade, error) {
+	grade := &pb.Grade{}
+
+	if id, _ := shared.GetString(doc["enrollment_id"]); id != "" {
+		grade.EnrollmentId = id
+	} else {
+		return nil, fmt.Errorf("missing enrollment_id")
+	}
+	if sid, _ := shared.GetString(doc["student_id"]); sid != "" {
+		grade.StudentId = sid
+	}
+	if sname, _ := shared.GetString(doc["student_name"]); sname != "" {
+		grade.StudentName = sname
+	}
+	if cid, _ := shared.GetString(doc["course_id"]); cid != "" {
+		grade.CourseId = cid
+	}
+	if ccode, _ := shared.GetString(doc["course_code"]); ccode != "" {
+		grade.CourseCode = ccode
+	}
+	if ctitle, _ := shared.GetString(doc["course_title"]); ctitle != "" {
+		grade.CourseTitle = ctitle
+	}
+
+	if u, err := shared.GetInt32(doc["units"]); err == nil {
+		grade.Units = u
+	}
+	if g, _ := shared.GetString(doc["grade"]); g != "" {
+		grade.Grade = strings.ToUpper(g)
+	}
+	if sem, _ := shared.GetString(doc["semester"]); sem != "" {
+		grade.Semester = sem
+	}
+	if upBy, _ := shared.GetString(doc["uploaded_by"]); upBy != "" {
+		grade.UploadedBy = upBy
+	}
+	if reason, _ := shared.GetString(doc["override_reason"]); reason != "" {
+		grade.OverrideReason = reason
+	}
+
+	// Missing or zero times are left nil
+	upAt, _ := shared.GetTime(doc["uploaded_at"])
+	grade.UploadedAt = shared.ToProtoTime(upAt)
+	pubAt, _ := shared.GetTime(doc["published_at"])
+	grade.PublishedAt = shared.ToProtoTime(pubAt)
+	if pub, err := shared.GetBool(doc["published"]); err == nil {
+		grade.Published = pub
+	}
+
+	return grade, nil
+}
+
+func (s *GradeService) calculateStudentGPA(ctx context.Context, studentID, semester string) (*pb.GPACalculation, error) {
+	records, err := s.publishedGPARecords(ctx, studentID, semester)
+	if err != nil {
+		return nil, err
+	}
+	calc := computeGPA(records)
+
+	// Transfer credits count toward units earned overall, never toward CGPA
+	if semester == "" {
+		transferUnits, err := s.sumTransferUnits(ctx, studentID)
+		if err != nil {
+			return nil, err
+		}
+		calc.TotalUnitsEarned += transferUnits
+	}
+
+	return calc, nil
+}
+
+// publishedGPARecords loads a student's published GPA-counted grades, optionally for one semester
+func (s *GradeService) publishedGPARecords(ctx context.Context, studentID, semester string) ([]shared.GPARecord, error) {
+	filter := bson.M{
+		"student_id": studentID,
+		"published":  true,
+		"grade":      bson.M{"$nin": []string{shared.GradeI, shared.GradeW}},
+	}
+	if semester != "" {
+		filter["semester"] = semester
+	}
+
+	cursor, err := s.gradesCol.Find(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var records []shared.GPARecord
+	for cursor.Next(ctx) {
+		var r shared.GPARecord
+		if err := cursor.Decode(&r); err != nil {
+			continue
+		}
+		records = append(records, r)
+	}
+	return records, nil
+}
+
+// computeGPA converts shared.ComputeGPA, the arithmetic behind CalculateGPA and
+// SimulateGPA, into its protobuf form
+func computeGPA(records []shared.GPARecord) *pb.GPACalculation {
+	info := shared.ComputeGPA(records)
+	calc := &pb.GPACalculation{
+		TermGpa:             info.TermGPA,
+		Cgpa:                info.CGPA,
+		TotalUnitsAttempted: info.TotalUnitsAttempted,
+		TotalUnitsEarned:    info.TotalUnitsEarned,
+	}
+	for _, sem := range info.SemesterBreakdown {
+		calc.SemesterBreakdown = append(calc.SemesterBreakdown, &pb.SemesterGPA{
+			Semester: sem.Semester, Gpa: sem.GPA, Units: sem.Units, CoursesCount: sem.CoursesCount,
+		})
+	}
+	return calc
+}
+
+// sumTransferUnits totals the equivalent units of a student's transfer credits
+func (s *GradeService) sumTransferUnits(ctx context.Context, studentID string) (int32, error) {
+	cursor, err := s.transferCreditsCol.Find(ctx, bson.M{"student_id": studentID})
+	if err != nil {
+		return 0, err
+	}
+	defer cursor.Close(ctx)
+
+	var total int32
+	for cursor.Next(ctx) {
+		var credit shared.TransferCredit
+		if err := cursor.Decode(&credit); err != nil {
+			continue
+		}
+		total += credit.EquivalentUnits
+	}
+	return total, nil
+}
+
+// gradesByEnrollment returns the recorded grade of each enrollment that has one,
+// with only its letter and published flag loaded
+func (s *GradeService) gradesByEnrollment(ctx context.Context, enrollmentIDs []string) (map[string]shared.Grade, error) {
+	grades := make(map[string]shared.Grade, len(enrollmentIDs))
+	if len(enrollmentIDs) == 0 {
+		return grades, nil
+	}
+	cursor, err := s.gradesCol.Find(ctx,
+		bson.M{"enrollment_id": bson.M{"$in": enrollmentIDs}},
+		options.Find().SetProjection(bson.M{"enrollment_id": 1, "grade": 1, "published": 1}),
+	)
+	if err != nil {
+		return nil, err
+	}
+	var docs []shared.Grade
+	if err := cursor.All(ctx, &docs); err != nil {
+		return nil, err
+	}
+	for _, d := range docs {
+		grades[d.EnrollmentID] = d
+	}
+	return grades, nil
+}
+
+// validateFacultyForCourse checks that facultyID teaches courseID, returning
+// shared.ErrCourseNotFound or shared.ErrNotCourseFaculty otherwise. Lookup
+// failures other than a missing document are Internal.
+func (s *GradeService) validateFacultyForCourse(ctx context.Context, courseID, facultyID string) error {
+	notFaculty := func(reason string) error {
+		return shared.ErrNotCourseFaculty.Newf("faculty validation failed: %s", reason).
+			WithParam("faculty_id", facultyID).
+			WithParam("course_id", courseID)
+	}
+
+	var faculty shared.User
+	if err := s.usersCol.FindOne(ctx, bson.M{"_id": facultyID}).Decode(&faculty); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return notFaculty("faculty not found")
+		}
+		log.Printf("Error finding faculty %s: %v", facultyID, err)
+		return status.Error(codes.Internal, "failed to retrieve faculty information")
+	}
+	if faculty.Role != shared.RoleFaculty {
+		return notFaculty("user not faculty")
+	}
+
+	var course shared.Course
+	if err := s.coursesCol.FindOne(ctx, bson.M{"_id": courseID}).Decode(&course); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return shared.ErrCourseNotFound.Newf("course not found: %s", courseID).WithParam("course_id", courseID)
+		}
+		log.Printf("Error finding course %s: %v", courseID, err)
+		return status.Error(codes.Internal, "failed to retrieve course information")
+	}
+	if course.FacultyID != facultyID {
+		return notFaculty("faculty mismatch")
+	}
+	return nil
+}
+
+func (s *GradeService) uploadSingleGrade(ctx context.Context, courseID, facultyID string, entry *pb.GradeEntry) error {
+	grade := strings.ToUpper(entry.Grade)
+	if !shared.IsValidGrade(grade) {
+		return shared.ErrInvalidGrade.WithParam("grade", entry.Grade)
+	}
+
+	enrollment, err := s.findGradableEnrollment(ctx, entry.StudentId, courseID)
+	if err != nil {
+		return err
+	}
+
+	var course shared.Course
+	if err := s.coursesCol.FindOne(ctx, bson.M{"_id": courseID}).Decode(&course); err != nil {
+		return fmt.Errorf("course details not found")
+	}
+
+	var student shared.User
+	if err := s.usersCol.FindOne(ctx, bson.M{"_id": entry.StudentId}).Decode(&student); err != nil {
+		return fmt.Errorf("student details not found")
 	}
 
 	// [FIX] Explicitly set published: false to ensure consistency
@@ -585,10 +1638,340 @@ func (s *GradeService) uploadSingleGrade(ctx context.Context, courseID, facultyI
 			"course_title":  course.Title,
 			"units":         course.Units,
 			"semester":      course.Semester,
+			"term_key":      shared.SemesterSortKey(course.Semester),
 			"enrollment_id": enrollment.ID,
 		},
 	}
 	opts := options.Update().SetUpsert(true)
-	_, err = s.gradesCol.UpdateOne(ctx, bson.M{"enrollment_id": enrollment.ID}, update, opts)
-	return err
+	return shared.WithTransaction(ctx, s.db.Client(), func(sessCtx mongo.SessionContext) error {
+		if _, err := s.gradesCol.UpdateOne(sessCtx, bson.M{"enrollment_id": enrollment.ID}, update, opts); err != nil {
+			return err
+		}
+		return s.syncWithdrawal(sessCtx, enrollment, grade)
+	})
+}
+
+// findGradableEnrollment returns the student's enrollment in the course that may
+// receive a grade: a completed or withdrawn one, or an enrolled one while
+// grade_enrolled_students allows it. A dropped enrollment cannot be graded; a
+// student who withdrew or dropped and re-enrolled is graded on the newest enrolled
+// or completed record rather than the withdrawn one.
+func (s *GradeService) findGradableEnrollment(ctx context.Context, studentID, courseID string) (*shared.Enrollment, error) {
+	opts := options.Find().SetSort(bson.D{{Key: "enrolled_at", Value: -1}, {Key: "_id", Value: -1}})
+	cursor, err := s.enrollmentsCol.Find(ctx, bson.M{"student_id": studentID, "course_id": courseID}, opts)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to load enrollment")
+	}
+	var enrollments []shared.Enrollment
+	if err := cursor.All(ctx, &enrollments); err != nil {
+		return nil, status.Error(codes.Internal, "failed to load enrollment")
+	}
+
+	var current *shared.Enrollment
+	for i := range enrollments {
+		e := &enrollments[i]
+		if e.Status == shared.StatusEnrolled || e.Status == shared.StatusCompleted {
+			current = e
+			break
+		}
+		if e.Status == shared.StatusWithdrawn && current == nil {
+			current = e
+		}
+	}
+	switch {
+	case current == nil && len(enrollments) > 0:
+		return nil, shared.ErrNotEnrolled.Newf("student %s dropped this course and cannot be graded", studentID).
+			WithParam("student_id", studentID).WithParam("status", shared.StatusDropped)
+	case current == nil:
+		return nil, shared.ErrNotEnrolled.Newf("student %s is not enrolled in this course", studentID).
+			WithParam("student_id", studentID)
+	case current.Status == shared.StatusEnrolled && !s.canGradeEnrolled(ctx):
+		return nil, shared.Errorf(codes.FailedPrecondition, shared.ErrCodeFailedPrecondition,
+			"student %s has not completed this course yet; grades open once the semester is closed", studentID)
+	}
+	return current, nil
+}
+
+// canGradeEnrolled reads the grade_enrolled_students system config ("true" by default)
+func (s *GradeService) canGradeEnrolled(ctx context.Context) bool {
+	var cfg shared.SystemConfig
+	if err := s.systemConfigCol.FindOne(ctx, bson.M{"key": shared.ConfigGradeEnrolled}).Decode(&cfg); err != nil {
+		if err != mongo.ErrNoDocuments {
+			log.Printf("Warning: failed to read %s config: %v", shared.ConfigGradeEnrolled, err)
+		}
+		return true
+	}
+	allowed, err := strconv.ParseBool(strings.TrimSpace(cfg.Value))
+	if err != nil {
+		log.Printf("Warning: invalid %s config value %q, using default", shared.ConfigGradeEnrolled, cfg.Value)
+		return true
+	}
+	return allowed
+}
+
+// syncWithdrawal keeps the enrollment status in step with its grade: a W withdraws
+// the enrollment and any other grade on a withdrawn one restores it to completed
+func (s *GradeService) syncWithdrawal(ctx context.Context, enrollment *shared.Enrollment, grade string) error {
+	withdrawn := enrollment.Status == shared.StatusWithdrawn
+	switch {
+	case grade == shared.GradeW && !withdrawn:
+		// Before the drop deadline a withdrawal frees the seat like a drop; after it
+		// the seat stays counted for the rest of the term. Completed enrollments
+		// no longer hold a seat either way.
+		now := time.Now()
+		wasEnrolled := enrollment.Status == shared.StatusEnrolled
+		held := wasEnrolled && !shared.LoadEnrollmentWindow(ctx, s.systemConfigCol).CanDrop(now)
+		set := bson.M{"withdrawn_at": now}
+		if held {
+			set["seat_held"] = true
+		}
+		if err := shared.TransitionEnrollment(ctx, s.enrollmentsCol, enrollment, shared.StatusWithdrawn, bson.M{"$set": set}); err != nil {
+			return err
+		}
+		if wasEnrolled && !held {
+			return s.releaseSeat(ctx, enrollment.CourseID)
+		}
+	case grade != shared.GradeW && withdrawn:
+		held := enrollment.SeatHeld
+		if err := shared.TransitionEnrollment(ctx, s.enrollmentsCol, enrollment, shared.StatusCompleted,
+			bson.M{"$unset": bson.M{"withdrawn_at": "", "seat_held": ""}},
+		); err != nil {
+			return err
+		}
+		if held {
+			return s.releaseSeat(ctx, enrollment.CourseID)
+		}
+	}
+	return nil
+}
+
+// releaseSeat returns one seat to a course and records it for enrollment trends
+func (s *GradeService) releaseSeat(ctx context.Context, courseID string) error {
+	_, err := s.coursesCol.UpdateOne(ctx,
+		bson.M{"_id": courseID, "enrolled": bson.M{"$gt": 0}},
+		bson.M{"$inc": bson.M{"enrolled": -1}},
+	)
+	if err != nil {
+		return err
+	}
+	return shared.RecordEnrollmentEvent(ctx, s.db.Client(), s.eventsCol, courseID, -1, shared.EventSourceGrade)
+}
+
+// changeGrade replaces a recorded grade, keeping its published state, syncs a W with the
+// enrollment status and records the change under the enrollment ID in the audit log,
+// which serves as the grade's history
+func (s *GradeService) changeGrade(ctx context.Context, enrollmentID, newGrade, modifiedBy, reason string) error {
+	var current shared.Grade
+	if err := s.gradesCol.FindOne(ctx, bson.M{"enrollment_id": enrollmentID}).Decode(&current); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return status.Error(codes.NotFound, "grade not found")
+		}
+		return err
+	}
+	if current.Grade == newGrade {
+		return status.Errorf(codes.InvalidArgument, "grade is already %s", newGrade)
+	}
+
+	_, err := s.gradesCol.UpdateOne(ctx, bson.M{"enrollment_id": enrollmentID}, bson.M{"$set": bson.M{
+		"grade":            newGrade,
+		"override_reason":  reason,
+		"last_modified_by": modifiedBy,
+		"last_modified_at": time.Now(),
+	}})
+	if err != nil {
+		return err
+	}
+
+	var enrollment shared.Enrollment
+	if err := s.enrollmentsCol.FindOne(ctx, bson.M{"_id": enrollmentID}).Decode(&enrollment); err != nil {
+		return err
+	}
+	if err := s.syncWithdrawal(ctx, &enrollment, newGrade); err != nil {
+		return err
+	}
+
+	shared.LogAuditEvent(ctx, s.auditLogsCol, modifiedBy, shared.ActionGradeChange, enrollmentID, map[string]interface{}{
+		"old_grade": current.Grade,
+		"new_grade": newGrade,
+		"reason":    reason,
+	})
+	return nil
+}
+
+// auditAppealResolved records the decision on an appeal in the audit trail
+func (s *GradeService) auditAppealResolved(ctx context.Context, appeal *shared.GradeAppeal) {
+	shared.LogAuditEvent(ctx, s.auditLogsCol, appeal.ResolvedBy, shared.ActionAppealResolve, appeal.ID, map[string]interface{}{
+		"student_id":     appeal.StudentID,
+		"enrollment_id":  appeal.EnrollmentID,
+		"decision":       appeal.Status,
+		"original_grade": appeal.OriginalGrade,
+		"new_grade":      appeal.NewGrade,
+		"comment":        appeal.Comment,
+	})
+}
+
+// gradeAppealToProto maps a stored appeal to the Protobuf message
+func gradeAppealToProto(a *shared.GradeAppeal) *pb.GradeAppeal {
+	return &pb.GradeAppeal{
+		Id:            a.ID,
+		EnrollmentId:  a.EnrollmentID,
+		StudentId:     a.StudentID,
+		CourseId:      a.CourseID,
+		CourseCode:    a.CourseCode,
+		FacultyId:     a.FacultyID,
+		OriginalGrade: a.OriginalGrade,
+		Reason:        a.Reason,
+		Status:        a.Status,
+		NewGrade:      a.NewGrade,
+		Comment:       a.Comment,
+		SubmittedAt:   shared.ToProtoTime(a.SubmittedAt),
+		ResolvedBy:    a.ResolvedBy,
+		ResolvedAt:    shared.ToProtoTime(a.ResolvedAt),
+	}
+}
+
+// findGradeDraft loads an unexpired draft, returning nil when there is none.
+// The TTL monitor runs periodically, so expired drafts may briefly linger.
+func (s *GradeService) findGradeDraft(ctx context.Context, courseID, facultyID string) (*shared.GradeDraft, error) {
+	var draft shared.GradeDraft
+	err := s.gradeDraftsCol.FindOne(ctx, bson.M{
+		"course_id":  courseID,
+		"faculty_id": facultyID,
+		"expires_at": bson.M{"$gt": time.Now()},
+	}).Decode(&draft)
+	if err == mongo.ErrNoDocuments {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &draft, nil
+}
+
+// gradeDraftExpiry returns when a draft saved at now expires: the grade upload deadline
+// while it is still ahead, otherwise shared.DefaultGradeDraftDays later
+func (s *GradeService) gradeDraftExpiry(ctx context.Context, now time.Time) time.Time {
+	if deadline := shared.LoadGradeDeadline(ctx, s.systemConfigCol); deadline.After(now) {
+		return deadline
+	}
+	return now.AddDate(0, 0, shared.DefaultGradeDraftDays)
+}
+
+// validDraftKey reports whether a student ID can be stored as a key of the draft's grades map
+func validDraftKey(studentID string) bool {
+	return studentID != "" && !strings.HasPrefix(studentID, "$") && !strings.Contains(studentID, ".")
+}
+
+// gradeDraftToProto maps a stored draft to the Protobuf message
+func gradeDraftToProto(d *shared.GradeDraft) *pb.GradeDraft {
+	grades := d.Grades
+	if grades == nil {
+		grades = map[string]string{}
+	}
+	return &pb.GradeDraft{
+		CourseId:  d.CourseID,
+		FacultyId: d.FacultyID,
+		Grades:    grades,
+		UpdatedAt: shared.ToProtoTime(d.UpdatedAt),
+		ExpiresAt: shared.ToProtoTime(d.ExpiresAt),
+	}
+}
+
+// getGradesPageSize reads the grades_page_size system config,
+// falling back to shared.DefaultGradesPageSize when it is unset or invalid
+func (s *GradeService) getGradesPageSize(ctx context.Context) int32 {
+	var cfg shared.SystemConfig
+	if err := s.systemConfigCol.FindOne(ctx, bson.M{"key": shared.ConfigGradesPageSize}).Decode(&cfg); err != nil {
+		if err != mongo.ErrNoDocuments {
+			log.Printf("Warning: failed to read %s config: %v", shared.ConfigGradesPageSize, err)
+		}
+		return shared.DefaultGradesPageSize
+	}
+
+	pageSize, err := strconv.Atoi(cfg.Value)
+	if err != nil || pageSize < 1 || pageSize > shared.MaxGradesPageSize {
+		log.Printf("Warning: invalid %s config value %q, using default", shared.ConfigGradesPageSize, cfg.Value)
+		return shared.DefaultGradesPageSize
+	}
+	return int32(pageSize)
+}
+
+// BackfillTermKeys sets term_key on grades stored before it was written at upload
+// time, so GetStudentGrades orders them chronologically. It returns how many were updated.
+func (s *GradeService) BackfillTermKeys(ctx context.Context) (int64, error) {
+	backfillCtx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	missing := bson.M{"term_key": bson.M{"$exists": false}}
+	semesters, err := s.gradesCol.Distinct(backfillCtx, "semester", missing)
+	if err != nil {
+		return 0, fmt.Errorf("failed to find grades without term keys: %w", err)
+	}
+
+	var updated int64
+	for _, v := range semesters {
+		semester, _ := v.(string)
+		res, err := s.gradesCol.UpdateMany(backfillCtx,
+			bson.M{"semester": v, "term_key": bson.M{"$exists": false}},
+			bson.M{"$set": bson.M{"term_key": shared.SemesterSortKey(semester)}},
+		)
+		if err != nil {
+			return updated, fmt.Errorf("failed to backfill term keys for %q: %w", semester, err)
+		}
+		updated += res.ModifiedCount
+	}
+	return updated, nil
+}
+
+// EnsureIndexes creates the grade_drafts indexes (one draft per course and faculty
+// member, and a TTL index that deletes drafts once expires_at passes) and the
+// grade_appeals indexes (one open appeal per enrollment, and faculty listing order)
+func (s *GradeService) EnsureIndexes(ctx context.Context) error {
+	indexCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	_, err := s.gradesCol.Indexes().CreateMany(indexCtx, []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "semester", Value: 1}, {Key: "published", Value: 1}},
+			Options: options.Index().SetName("grade_semester_published"),
+		},
+		{
+			// Lets ExportGrades walk a semester in order without a blocking sort
+			Keys:    bson.D{{Key: "semester", Value: 1}, {Key: "course_code", Value: 1}, {Key: "student_id", Value: 1}},
+			Options: options.Index().SetName("grade_semester_export"),
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create grade semester indexes: %w", err)
+	}
+
+	_, err = s.gradeDraftsCol.Indexes().CreateMany(indexCtx, []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "course_id", Value: 1}, {Key: "faculty_id", Value: 1}},
+			Options: options.Index().SetName("grade_draft_owner").SetUnique(true),
+		},
+		{
+			Keys:    bson.D{{Key: "expires_at", Value: 1}},
+			Options: options.Index().SetName("grade_draft_ttl").SetExpireAfterSeconds(0),
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create grade draft indexes: %w", err)
+	}
+
+	_, err = s.gradeAppealsCol.Indexes().CreateMany(indexCtx, []mongo.IndexModel{
+		{
+			Keys: bson.D{{Key: "enrollment_id", Value: 1}},
+			Options: options.Index().SetName("grade_appeal_open").SetUnique(true).
+				SetPartialFilterExpression(bson.M{"status": shared.AppealOpen}),
+		},
+		{
+			Keys:    bson.D{{Key: "faculty_id", Value: 1}, {Key: "submitted_at", Value: -1}},
+			Options: options.Index().SetName("grade_appeal_faculty"),
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create grade appeal indexes: %w", err)
+	}
+	return nil
 }
@@ -2,14 +2,24 @@ package grade
 
 import (
 	"context"
+	"fmt"
+	"io"
 	"log"
 	"net"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/joho/godotenv"
 	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/event"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
 	"google.golang.org/grpc/test/bufconn"
 
 	pb "stdiscm_p4/backend/internal/pb/grade"
@@ -66,13 +76,14 @@ func TestGradeService_Integration(t *testing.T) {
 	testStudentID1 := "student-grade-001"
 	testStudentID2 := "student-grade-002"
 	testFacultyID := "faculty-grade-001"
+	otherFacultyID := "faculty-grade-002"
 	enrollmentID1 := "ENR-TEST-001"
 	enrollmentID2 := "ENR-TEST-002"
 
 	// Cleanup Helper
 	cleanup := func() {
 		db.Collection("courses").DeleteOne(ctx, bson.M{"_id": testCourseID})
-		db.Collection("users").DeleteMany(ctx, bson.M{"_id": bson.M{"$in": []string{testFacultyID, testStudentID1, testStudentID2}}})
+		db.Collection("users").DeleteMany(ctx, bson.M{"_id": bson.M{"$in": []string{testFacultyID, otherFacultyID, testStudentID1, testStudentID2}}})
 		db.Collection("enrollments").DeleteMany(ctx, bson.M{"_id": bson.M{"$in": []string{enrollmentID1, enrollmentID2}}})
 		db.Collection("grades").DeleteMany(ctx, bson.M{"course_id": testCourseID})
 	}
@@ -92,6 +103,7 @@ func TestGradeService_Integration(t *testing.T) {
 
 	_, err = db.Collection("users").InsertMany(ctx, []interface{}{
 		shared.User{ID: testFacultyID, Role: "faculty", Name: "Prof Test", IsActive: true},
+		shared.User{ID: otherFacultyID, Role: "faculty", Name: "Prof Other", IsActive: true},
 		shared.User{ID: testStudentID1, Role: "student", Name: "Student One", IsActive: true},
 		shared.User{ID: testStudentID2, Role: "student", Name: "Student Two", IsActive: true},
 	})
@@ -261,6 +273,43 @@ func TestGradeService_Integration(t *testing.T) {
 		}
 	})
 
+	t.Run("Simulate GPA", func(t *testing.T) {
+		// Student 1's published A in CSG101 is replaced by a hypothetical C
+		resp, err := client.SimulateGPA(ctx, &pb.SimulateGPARequest{
+			StudentId: testStudentID1,
+			Grades:    []*pb.HypotheticalGrade{{CourseId: testCourseID, Grade: "c"}},
+		})
+		if err != nil {
+			t.Fatalf("SimulateGPA failed: %v", err)
+		}
+		if !resp.Success || resp.Term != "TestSem" || resp.GpaInfo.TermGpa != 2.0 {
+			t.Errorf("Expected a 2.0 projection for TestSem, got %+v", resp)
+		}
+
+		// Nothing is persisted
+		var g shared.Grade
+		db.Collection("grades").FindOne(ctx, bson.M{"enrollment_id": enrollmentID1}).Decode(&g)
+		if g.Grade != "A" {
+			t.Errorf("Expected the published A to be untouched, got %s", g.Grade)
+		}
+
+		_, err = client.SimulateGPA(ctx, &pb.SimulateGPARequest{
+			StudentId: testStudentID1,
+			Grades:    []*pb.HypotheticalGrade{{CourseId: testCourseID, Grade: "E"}},
+		})
+		if shared.ErrorCodeOf(err) != shared.ErrCodeInvalidGrade {
+			t.Errorf("Expected %s for an invalid letter, got %v", shared.ErrCodeInvalidGrade, err)
+		}
+
+		_, err = client.SimulateGPA(ctx, &pb.SimulateGPARequest{
+			StudentId: testStudentID1,
+			Grades:    []*pb.HypotheticalGrade{{CourseId: "CS-GRADE-MISSING", Grade: "B"}},
+		})
+		if shared.ErrorCodeOf(err) != shared.ErrCodeCourseNotFound {
+			t.Errorf("Expected %s for a missing course, got %v", shared.ErrCodeCourseNotFound, err)
+		}
+	})
+
 	// ========================================================================
 	// Test 7: Get Class Roster
 	// ========================================================================
@@ -275,6 +324,9 @@ func TestGradeService_Integration(t *testing.T) {
 		if resp.TotalStudents != 2 {
 			t.Errorf("Expected 2 students in roster, got %d", resp.TotalStudents)
 		}
+		if s := resp.Summary; s.GetGraded() != 2 || s.GetUngraded() != 0 {
+			t.Errorf("Expected both students graded in the summary, got %+v", s)
+		}
 
 		// Verify we can see the grades in the roster (since they are uploaded)
 		foundA := false
@@ -291,4 +343,835 @@ func TestGradeService_Integration(t *testing.T) {
 			t.Error("Roster did not contain expected students with grades")
 		}
 	})
+
+	// ========================================================================
+	// Test 8: Get Dean's List
+	// ========================================================================
+	t.Run("Get Deans List", func(t *testing.T) {
+		// Student 1 (A, 3 units) qualifies; Student 2 (B) falls below 3.5
+		resp, err := client.GetDeansList(ctx, &pb.GetDeansListRequest{
+			Semester: "TestSem",
+			MinGpa:   3.5,
+			MinUnits: 3,
+		})
+		if err != nil {
+			t.Fatalf("GetDeansList failed: %v", err)
+		}
+
+		foundStudent1 := false
+		for _, s := range resp.Students {
+			if s.StudentId == testStudentID1 {
+				foundStudent1 = true
+				if s.Gpa != 4.0 || s.StudentName != "Student One" {
+					t.Errorf("Unexpected entry for Student 1: %+v", s)
+				}
+			}
+			if s.StudentId == testStudentID2 {
+				t.Error("Student 2 should not be on the dean's list")
+			}
+		}
+		if !foundStudent1 {
+			t.Error("Expected Student 1 on the dean's list")
+		}
+
+		// Default thresholds require 12 units, so 3 units is not enough
+		resp, err = client.GetDeansList(ctx, &pb.GetDeansListRequest{Semester: "TestSem"})
+		if err != nil {
+			t.Fatalf("GetDeansList (defaults) failed: %v", err)
+		}
+		if resp.MinGpa != shared.DeansListMinGPA || resp.MinUnits != shared.DeansListMinUnits {
+			t.Errorf("Expected default thresholds, got %.2f / %d", resp.MinGpa, resp.MinUnits)
+		}
+		for _, s := range resp.Students {
+			if s.StudentId == testStudentID1 {
+				t.Error("Student 1 should not meet the default unit threshold")
+			}
+		}
+	})
+
+	// ========================================================================
+	// Test 9: Permission and Not Found Errors
+	// ========================================================================
+	t.Run("Get Course Grades (Wrong Faculty)", func(t *testing.T) {
+		_, err := client.GetCourseGrades(ctx, &pb.GetCourseGradesRequest{
+			CourseId:  testCourseID,
+			FacultyId: otherFacultyID,
+		})
+		if status.Code(err) != codes.PermissionDenied {
+			t.Errorf("Expected PermissionDenied, got %v", err)
+		}
+	})
+
+	t.Run("Get Course Grades (Non-Faculty User)", func(t *testing.T) {
+		_, err := client.GetCourseGrades(ctx, &pb.GetCourseGradesRequest{
+			CourseId:  testCourseID,
+			FacultyId: testStudentID1,
+		})
+		if status.Code(err) != codes.PermissionDenied {
+			t.Errorf("Expected PermissionDenied, got %v", err)
+		}
+	})
+
+	t.Run("Get Course Grades (Missing Course)", func(t *testing.T) {
+		_, err := client.GetCourseGrades(ctx, &pb.GetCourseGradesRequest{
+			CourseId:  "CS-GRADE-MISSING",
+			FacultyId: testFacultyID,
+		})
+		if status.Code(err) != codes.NotFound {
+			t.Errorf("Expected NotFound, got %v", err)
+		}
+	})
+
+	t.Run("Get Class Roster (Missing Course)", func(t *testing.T) {
+		_, err := client.GetClassRoster(ctx, &pb.GetClassRosterRequest{
+			CourseId: "CS-GRADE-MISSING",
+		})
+		if status.Code(err) != codes.NotFound {
+			t.Errorf("Expected NotFound, got %v", err)
+		}
+	})
+
+	// ========================================================================
+	// Test 10: Semesters With Grades
+	// ========================================================================
+	t.Run("Get Student Semesters", func(t *testing.T) {
+		// Older published grades plus an unpublished one that must not be listed
+		db.Collection("grades").InsertMany(ctx, []interface{}{
+			bson.M{"enrollment_id": "ENR-SEM-1", "student_id": testStudentID1, "course_id": testCourseID, "grade": "B", "semester": "Fall 2023", "published": true},
+			bson.M{"enrollment_id": "ENR-SEM-2", "student_id": testStudentID1, "course_id": testCourseID, "grade": "A", "semester": "Spring 2024", "published": true},
+			bson.M{"enrollment_id": "ENR-SEM-3", "student_id": testStudentID1, "course_id": testCourseID, "grade": "C", "semester": "Spring 2024", "published": true},
+			bson.M{"enrollment_id": "ENR-SEM-4", "student_id": testStudentID1, "course_id": testCourseID, "grade": "A", "semester": "Summer 2024", "published": false},
+		})
+
+		resp, err := client.GetStudentSemesters(ctx, &pb.GetStudentSemestersRequest{StudentId: testStudentID1})
+		if err != nil {
+			t.Fatalf("GetStudentSemesters failed: %v", err)
+		}
+
+		// "TestSem" has no year, so it sorts after the dated semesters
+		expected := []struct {
+			semester string
+			count    int32
+		}{{"Fall 2023", 1}, {"Spring 2024", 2}, {"TestSem", 1}}
+		if len(resp.Semesters) != len(expected) {
+			t.Fatalf("Expected %d semesters, got %+v", len(expected), resp.Semesters)
+		}
+		for i, e := range expected {
+			if resp.Semesters[i].Semester != e.semester || resp.Semesters[i].GradeCount != e.count {
+				t.Errorf("Semester %d: expected %s (%d), got %s (%d)",
+					i, e.semester, e.count, resp.Semesters[i].Semester, resp.Semesters[i].GradeCount)
+			}
+		}
+	})
+
+	// ========================================================================
+	// Test 11: Get Course Grades (Filtered and Paged)
+	// ========================================================================
+	t.Run("Get Course Grades (Unpublished Filter)", func(t *testing.T) {
+		db.Collection("grades").InsertOne(ctx, bson.M{
+			"enrollment_id": "ENR-PENDING-1", "student_id": testStudentID2, "course_id": testCourseID,
+			"grade": "", "semester": "TestSem", "published": false,
+		})
+		courseTotal, _ := db.Collection("grades").CountDocuments(ctx, bson.M{"course_id": testCourseID})
+
+		resp, err := client.GetCourseGrades(ctx, &pb.GetCourseGradesRequest{
+			CourseId:  testCourseID,
+			FacultyId: testFacultyID,
+			Published: "false",
+		})
+		if err != nil {
+			t.Fatalf("GetCourseGrades failed: %v", err)
+		}
+		if len(resp.Grades) == 0 || int32(len(resp.Grades)) != resp.TotalCount {
+			t.Errorf("Expected %d unpublished grades, got %d", resp.TotalCount, len(resp.Grades))
+		}
+		for _, g := range resp.Grades {
+			if g.Published {
+				t.Errorf("Filter returned a published grade: %+v", g)
+			}
+		}
+		if int64(resp.TotalGrades) != courseTotal {
+			t.Errorf("Expected total_grades %d for the whole course, got %d", courseTotal, resp.TotalGrades)
+		}
+		if resp.AllPublished {
+			t.Error("all_published should be false while any grade is unpublished")
+		}
+
+		// A single-item page still reports the full course totals
+		resp, err = client.GetCourseGrades(ctx, &pb.GetCourseGradesRequest{
+			CourseId:  testCourseID,
+			FacultyId: testFacultyID,
+			Page:      2,
+			PageSize:  1,
+		})
+		if err != nil {
+			t.Fatalf("GetCourseGrades (paged) failed: %v", err)
+		}
+		if len(resp.Grades) != 1 || resp.Page != 2 || resp.PageSize != 1 {
+			t.Errorf("Expected 1 grade on page 2, got %d (page %d, size %d)", len(resp.Grades), resp.Page, resp.PageSize)
+		}
+		if int64(resp.TotalCount) != courseTotal || int64(resp.TotalGrades) != courseTotal {
+			t.Errorf("Expected counts of %d, got total_count %d / total_grades %d", courseTotal, resp.TotalCount, resp.TotalGrades)
+		}
+
+		_, err = client.GetCourseGrades(ctx, &pb.GetCourseGradesRequest{
+			CourseId:  testCourseID,
+			FacultyId: testFacultyID,
+			Published: "maybe",
+		})
+		if status.Code(err) != codes.InvalidArgument {
+			t.Errorf("Expected InvalidArgument for bad filter, got %v", err)
+		}
+	})
+
+	// ========================================================================
+	// Test 12: Student Grade History (Paged, Chronological)
+	// ========================================================================
+	t.Run("Get Student Grades (Paged)", func(t *testing.T) {
+		// Lexically "Fall 2024" sorts before "Spring 2024"; chronologically it is newer
+		db.Collection("grades").InsertOne(ctx, bson.M{
+			"enrollment_id": "ENR-SEM-5", "student_id": testStudentID1, "course_id": testCourseID,
+			"grade": "B", "semester": "Fall 2024", "published": true,
+		})
+		if _, err := NewGradeService(db).BackfillTermKeys(ctx); err != nil {
+			t.Fatalf("BackfillTermKeys failed: %v", err)
+		}
+		if n, _ := db.Collection("grades").CountDocuments(ctx, bson.M{"course_id": testCourseID, "term_key": bson.M{"$exists": false}}); n != 0 {
+			t.Errorf("Expected every grade to have a term key, %d missing", n)
+		}
+
+		published, _ := db.Collection("grades").CountDocuments(ctx, bson.M{"student_id": testStudentID1, "published": true})
+		resp, err := client.GetStudentGrades(ctx, &pb.GetStudentGradesRequest{StudentId: testStudentID1, PageSize: 1})
+		if err != nil {
+			t.Fatalf("GetStudentGrades failed: %v", err)
+		}
+		if len(resp.Grades) != 1 || resp.Grades[0].Semester != "Fall 2024" {
+			t.Errorf("Expected Fall 2024 first, got %+v", resp.Grades)
+		}
+		if int64(resp.TotalCount) != published || resp.Page != 1 || resp.PageSize != 1 {
+			t.Errorf("Expected total %d on page 1 of size 1, got %d (page %d, size %d)", published, resp.TotalCount, resp.Page, resp.PageSize)
+		}
+
+		// Labels without a year come last
+		resp, err = client.GetStudentGrades(ctx, &pb.GetStudentGradesRequest{StudentId: testStudentID1, Page: int32(published), PageSize: 1})
+		if err != nil || len(resp.Grades) != 1 || resp.Grades[0].Semester != "TestSem" {
+			t.Errorf("Expected TestSem on the last page, got %+v (err %v)", resp, err)
+		}
+	})
+	// ========================================================================
+	// Test 13: Grade Drafts (Autosave, Finalize)
+	// ========================================================================
+	t.Run("Grade Drafts", func(t *testing.T) {
+		draftFilter := bson.M{"course_id": testCourseID}
+		db.Collection("grade_drafts").DeleteMany(ctx, draftFilter)
+		defer db.Collection("grade_drafts").DeleteMany(ctx, draftFilter)
+
+		// Drafts accept anything; students and grades are only checked on finalize
+		_, err := client.SaveGradeDraft(ctx, &pb.SaveGradeDraftRequest{
+			CourseId: testCourseID, FacultyId: testFacultyID,
+			Grades: map[string]string{testStudentID1: "C", "student-grade-ghost": "A"},
+		})
+		if err != nil {
+			t.Fatalf("SaveGradeDraft failed: %v", err)
+		}
+		saved, err := client.SaveGradeDraft(ctx, &pb.SaveGradeDraftRequest{
+			CourseId: testCourseID, FacultyId: testFacultyID,
+			Grades: map[string]string{testStudentID2: "Z"},
+		})
+		if err != nil {
+			t.Fatalf("SaveGradeDraft (merge) failed: %v", err)
+		}
+		if len(saved.Draft.Grades) != 3 || !saved.Draft.ExpiresAt.AsTime().After(time.Now()) {
+			t.Errorf("Expected 3 merged grades expiring in the future, got %+v", saved.Draft)
+		}
+
+		got, err := client.GetGradeDraft(ctx, &pb.GetGradeDraftRequest{CourseId: testCourseID, FacultyId: testFacultyID})
+		if err != nil || !got.Found || got.Draft.Grades[testStudentID2] != "Z" {
+			t.Errorf("Expected saved draft, got %+v (err %v)", got, err)
+		}
+		if _, err := client.GetGradeDraft(ctx, &pb.GetGradeDraftRequest{CourseId: testCourseID, FacultyId: otherFacultyID}); status.Code(err) != codes.PermissionDenied {
+			t.Errorf("Expected PermissionDenied for another faculty, got %v", err)
+		}
+
+		resp, err := client.FinalizeDraft(ctx, &pb.FinalizeDraftRequest{CourseId: testCourseID, FacultyId: testFacultyID})
+		if err != nil {
+			t.Fatalf("FinalizeDraft failed: %v", err)
+		}
+		if resp.Success || resp.TotalProcessed != 3 || resp.Successful != 1 || resp.Failed != 2 {
+			t.Fatalf("Expected 1 of 3 finalized, got %+v", resp)
+		}
+		codesByStudent := map[string]string{}
+		for _, e := range resp.Errors {
+			codesByStudent[e.StudentId] = e.ErrorCode
+		}
+		if codesByStudent[testStudentID2] != string(shared.ErrCodeInvalidGrade) || codesByStudent["student-grade-ghost"] != string(shared.ErrCodeNotEnrolled) {
+			t.Errorf("Unexpected per-student errors: %+v", resp.Errors)
+		}
+
+		// Only the failed grades remain for correction
+		got, _ = client.GetGradeDraft(ctx, &pb.GetGradeDraftRequest{CourseId: testCourseID, FacultyId: testFacultyID})
+		if _, ok := got.Draft.Grades[testStudentID1]; ok || len(got.Draft.Grades) != 2 {
+			t.Errorf("Expected only failed grades left in the draft, got %v", got.Draft.Grades)
+		}
+
+		// Fix one and remove the other; finalizing then clears the draft
+		_, err = client.SaveGradeDraft(ctx, &pb.SaveGradeDraftRequest{
+			CourseId: testCourseID, FacultyId: testFacultyID,
+			Grades: map[string]string{testStudentID2: "B", "student-grade-ghost": ""},
+		})
+		if err != nil {
+			t.Fatalf("SaveGradeDraft (fix) failed: %v", err)
+		}
+		resp, err = client.FinalizeDraft(ctx, &pb.FinalizeDraftRequest{CourseId: testCourseID, FacultyId: testFacultyID})
+		if err != nil || !resp.Success || resp.Successful != 1 {
+			t.Fatalf("Expected the corrected grade to finalize, got %+v (err %v)", resp, err)
+		}
+		got, _ = client.GetGradeDraft(ctx, &pb.GetGradeDraftRequest{CourseId: testCourseID, FacultyId: testFacultyID})
+		if got.Found {
+			t.Errorf("Expected draft deleted after a clean finalize, got %+v", got.Draft)
+		}
+		if _, err := client.FinalizeDraft(ctx, &pb.FinalizeDraftRequest{CourseId: testCourseID, FacultyId: testFacultyID}); status.Code(err) != codes.FailedPrecondition {
+			t.Errorf("Expected FailedPrecondition without a draft, got %v", err)
+		}
+	})
+	// ========================================================================
+	// Test 14: Grade Appeals
+	// ========================================================================
+	t.Run("Grade Appeals", func(t *testing.T) {
+		appealFilter := bson.M{"course_id": testCourseID}
+		db.Collection("grade_appeals").DeleteMany(ctx, appealFilter)
+		defer db.Collection("grade_appeals").DeleteMany(ctx, appealFilter)
+		defer db.Collection("audit_logs").DeleteMany(ctx, bson.M{"resource": enrollmentID1, "action": shared.ActionGradeChange})
+
+		// Drafts above left unpublished grades; only published grades can be appealed
+		if _, err := client.PublishGrades(ctx, &pb.PublishGradesRequest{CourseId: testCourseID, FacultyId: testFacultyID}); err != nil {
+			t.Fatalf("PublishGrades failed: %v", err)
+		}
+		var before shared.Grade
+		db.Collection("grades").FindOne(ctx, bson.M{"enrollment_id": enrollmentID1}).Decode(&before)
+		newGrade := "A"
+		if before.Grade == "A" {
+			newGrade = "B"
+		}
+
+		submit := &pb.SubmitGradeAppealRequest{EnrollmentId: enrollmentID1, StudentId: testStudentID1, Reason: "Final exam was miscounted"}
+		if _, err := client.SubmitGradeAppeal(ctx, &pb.SubmitGradeAppealRequest{EnrollmentId: enrollmentID1, StudentId: testStudentID2, Reason: "Not mine"}); status.Code(err) != codes.PermissionDenied {
+			t.Errorf("Expected PermissionDenied for another student's grade, got %v", err)
+		}
+		tooLong := &pb.SubmitGradeAppealRequest{EnrollmentId: enrollmentID1, StudentId: testStudentID1, Reason: strings.Repeat("x", shared.MaxAppealReasonLength+1)}
+		if _, err := client.SubmitGradeAppeal(ctx, tooLong); shared.ErrorCodeOf(err) != shared.ErrCodeInvalidField {
+			t.Errorf("Expected %s for an overlong reason, got %v", shared.ErrCodeInvalidField, err)
+		}
+		submitted, err := client.SubmitGradeAppeal(ctx, submit)
+		if err != nil {
+			t.Fatalf("SubmitGradeAppeal failed: %v", err)
+		}
+		if submitted.Appeal.Status != shared.AppealOpen || submitted.Appeal.OriginalGrade != before.Grade || submitted.Appeal.FacultyId != testFacultyID {
+			t.Errorf("Unexpected appeal: %+v", submitted.Appeal)
+		}
+		if _, err := client.SubmitGradeAppeal(ctx, submit); shared.ErrorCodeOf(err) != shared.ErrCodeAppealOpen {
+			t.Errorf("Expected %s for a second open appeal, got %v", shared.ErrCodeAppealOpen, err)
+		}
+
+		list, err := client.ListGradeAppeals(ctx, &pb.ListGradeAppealsRequest{FacultyId: testFacultyID, Status: shared.AppealOpen})
+		if err != nil || list.TotalCount != 1 || list.Appeals[0].Id != submitted.Appeal.Id {
+			t.Errorf("Expected the open appeal for the faculty, got %+v (err %v)", list, err)
+		}
+		if list, _ := client.ListGradeAppeals(ctx, &pb.ListGradeAppealsRequest{StudentId: testStudentID2}); list.TotalCount != 0 {
+			t.Errorf("Expected no appeals for student 2, got %d", list.TotalCount)
+		}
+
+		resolve := &pb.ResolveGradeAppealRequest{AppealId: submitted.Appeal.Id, FacultyId: testFacultyID, Decision: shared.AppealApproved, NewGrade: newGrade, Comment: "Recounted"}
+		if _, err := client.ResolveGradeAppeal(ctx, &pb.ResolveGradeAppealRequest{AppealId: submitted.Appeal.Id, FacultyId: otherFacultyID, Decision: shared.AppealDenied}); status.Code(err) != codes.PermissionDenied {
+			t.Errorf("Expected PermissionDenied for another faculty, got %v", err)
+		}
+		if _, err := client.ResolveGradeAppeal(ctx, &pb.ResolveGradeAppealRequest{AppealId: submitted.Appeal.Id, FacultyId: testFacultyID, Decision: shared.AppealApproved}); status.Code(err) != codes.InvalidArgument {
+			t.Errorf("Expected InvalidArgument approving without a grade, got %v", err)
+		}
+		resolved, err := client.ResolveGradeAppeal(ctx, resolve)
+		if err != nil {
+			t.Fatalf("ResolveGradeAppeal failed: %v", err)
+		}
+		if resolved.Appeal.Status != shared.AppealApproved || resolved.Appeal.NewGrade != newGrade {
+			t.Errorf("Unexpected resolution: %+v", resolved.Appeal)
+		}
+
+		// The grade changed in place, stayed published, and the change is in its history
+		var after shared.Grade
+		db.Collection("grades").FindOne(ctx, bson.M{"enrollment_id": enrollmentID1}).Decode(&after)
+		if after.Grade != newGrade || !after.Published {
+			t.Errorf("Expected published grade %s, got %+v", newGrade, after)
+		}
+		if n, _ := db.Collection("audit_logs").CountDocuments(ctx, bson.M{"resource": enrollmentID1, "action": shared.ActionGradeChange}); n == 0 {
+			t.Error("Expected the grade change to be recorded in the audit log")
+		}
+		notification := bson.M{"user_id": testStudentID1, "type": shared.NotificationAppealResolved, "course_id": testCourseID}
+		defer db.Collection("notifications").DeleteMany(ctx, notification)
+		if n, _ := db.Collection("notifications").CountDocuments(ctx, notification); n != 1 {
+			t.Errorf("Expected the student to be notified of the decision, got %d notifications", n)
+		}
+		if _, err := client.ResolveGradeAppeal(ctx, resolve); shared.ErrorCodeOf(err) != shared.ErrCodeAppealResolved {
+			t.Errorf("Expected %s resolving twice, got %v", shared.ErrCodeAppealResolved, err)
+		}
+
+		// Once resolved, the student may appeal again
+		if _, err := client.SubmitGradeAppeal(ctx, submit); err != nil {
+			t.Errorf("Expected a new appeal after resolution, got %v", err)
+		}
+
+		// Appeals close grade_appeal_window_days after publication
+		windowFilter := bson.M{"key": shared.ConfigAppealWindowDays}
+		db.Collection("system_config").DeleteMany(ctx, windowFilter)
+		defer db.Collection("system_config").DeleteMany(ctx, windowFilter)
+		db.Collection("system_config").InsertOne(ctx, shared.SystemConfig{Key: shared.ConfigAppealWindowDays, Value: "7"})
+		db.Collection("grades").UpdateOne(ctx, bson.M{"enrollment_id": enrollmentID2},
+			bson.M{"$set": bson.M{"published_at": time.Now().AddDate(0, 0, -8)}})
+		_, err = client.SubmitGradeAppeal(ctx, &pb.SubmitGradeAppealRequest{EnrollmentId: enrollmentID2, StudentId: testStudentID2, Reason: "Too late"})
+		if shared.ErrorCodeOf(err) != shared.ErrCodeAppealWindowClosed {
+			t.Errorf("Expected %s after the appeal window, got %v", shared.ErrCodeAppealWindowClosed, err)
+		}
+	})
+
+	// ========================================================================
+	// Test 15: Semester Grade Report (Admin)
+	// ========================================================================
+	t.Run("Semester Grade Report", func(t *testing.T) {
+		db.Collection("users").UpdateOne(ctx, bson.M{"_id": testFacultyID}, bson.M{"$set": bson.M{"department": "Computer Science"}})
+		grade := func(enrollmentID, letter string, published bool) bson.M {
+			return bson.M{
+				"enrollment_id": enrollmentID, "course_id": testCourseID, "course_code": "CSG101",
+				"course_title": "Grade Integration Test", "semester": "ReportSem",
+				"units": 3, "grade": letter, "published": published,
+			}
+		}
+		db.Collection("grades").DeleteMany(ctx, bson.M{"semester": "ReportSem"})
+		defer db.Collection("grades").DeleteMany(ctx, bson.M{"semester": "ReportSem"})
+		db.Collection("grades").InsertMany(ctx, []interface{}{
+			grade("ENR-REPORT-001", "A", true),
+			grade("ENR-REPORT-002", "F", true),
+			grade("ENR-REPORT-003", "W", true),
+			grade("ENR-REPORT-004", "B", false), // unpublished grades are not reported
+		})
+
+		if _, err := client.GetSemesterGradeReport(ctx, &pb.GetSemesterGradeReportRequest{}); status.Code(err) != codes.InvalidArgument {
+			t.Errorf("Expected InvalidArgument without a semester, got %v", err)
+		}
+
+		resp, err := client.GetSemesterGradeReport(ctx, &pb.GetSemesterGradeReportRequest{Semester: "ReportSem"})
+		if err != nil {
+			t.Fatalf("GetSemesterGradeReport failed: %v", err)
+		}
+
+		// A (4.0) and F (0.0) over 3 units each; W counts in neither GPA nor pass rate
+		overall := resp.Overall
+		if overall.TotalGrades != 3 || overall.Distribution["A"] != 1 || overall.Distribution["F"] != 1 ||
+			overall.Distribution["W"] != 1 || overall.Distribution["B"] != 0 {
+			t.Errorf("Unexpected overall distribution: %+v", overall)
+		}
+		if overall.AverageGpa != 2.0 || overall.Passed != 1 || overall.Failed != 1 || overall.PassRate != 0.5 {
+			t.Errorf("Unexpected overall GPA or pass rate: %+v", overall)
+		}
+
+		if len(resp.Departments) != 1 || resp.Departments[0].Department != "Computer Science" || resp.Departments[0].CourseCount != 1 {
+			t.Errorf("Expected one Computer Science department, got %+v", resp.Departments)
+		}
+		if len(resp.Courses) != 1 || resp.Courses[0].CourseCode != "CSG101" || resp.Courses[0].Stats.TotalGrades != 3 {
+			t.Errorf("Expected one CSG101 course row, got %+v", resp.Courses)
+		}
+
+		// A semester without published grades reports zeros, not an error
+		empty, err := client.GetSemesterGradeReport(ctx, &pb.GetSemesterGradeReportRequest{Semester: "NoGradesSem"})
+		if err != nil || empty.Overall.TotalGrades != 0 || len(empty.Courses) != 0 {
+			t.Errorf("Expected an empty report, got %+v / %v", empty, err)
+		}
+	})
+
+	t.Run("Export Grades", func(t *testing.T) {
+		// One more published grade than fits a chunk, plus an unpublished one
+		var docs []interface{}
+		for i := 0; i <= exportGradesChunkSize; i++ {
+			docs = append(docs, bson.M{
+				"enrollment_id": fmt.Sprintf("ENR-EXPORT-%04d", i), "student_id": fmt.Sprintf("EXP-%04d", i),
+				"course_id": testCourseID, "course_code": "CSG101", "semester": "ExportSem",
+				"units": 3, "grade": "B", "published": true,
+			})
+		}
+		docs = append(docs, bson.M{
+			"enrollment_id": "ENR-EXPORT-DRAFT", "student_id": "EXP-DRAFT", "course_id": testCourseID,
+			"course_code": "CSG101", "semester": "ExportSem", "units": 3, "grade": "A", "published": false,
+		})
+		db.Collection("grades").InsertMany(ctx, docs)
+		adminID := "admin-grade-export"
+		db.Collection("users").InsertOne(ctx, shared.User{ID: adminID, Role: shared.RoleAdmin, Name: "Export Admin", IsActive: true})
+		defer db.Collection("users").DeleteOne(ctx, bson.M{"_id": adminID})
+
+		export := func(publishedOnly bool) (chunks []*pb.ExportGradesChunk) {
+			stream, err := client.ExportGrades(ctx, &pb.ExportGradesRequest{Semester: "ExportSem", PublishedOnly: publishedOnly, AdminId: adminID})
+			if err != nil {
+				t.Fatalf("ExportGrades failed: %v", err)
+			}
+			for {
+				chunk, err := stream.Recv()
+				if err == io.EOF {
+					return chunks
+				}
+				if err != nil {
+					t.Fatalf("ExportGrades stream failed: %v", err)
+				}
+				chunks = append(chunks, chunk)
+			}
+		}
+
+		chunks := export(true)
+		if len(chunks) != 2 || len(chunks[0].Grades) != exportGradesChunkSize || chunks[0].Summary != nil {
+			t.Fatalf("Expected a full chunk then a final one, got %d chunks", len(chunks))
+		}
+		last := chunks[1]
+		if len(last.Grades) != 1 || last.Summary == nil || last.Summary.TotalRows != exportGradesChunkSize+1 {
+			t.Errorf("Expected 1 trailing grade and a %d-row summary, got %d grades, summary %+v",
+				exportGradesChunkSize+1, len(last.Grades), last.Summary)
+		}
+		if first := chunks[0].Grades[0]; first.StudentId != "EXP-0000" || first.CourseCode != "CSG101" || first.Units != 3 {
+			t.Errorf("Expected denormalized fields in student order, got %+v", first)
+		}
+
+		if chunks := export(false); chunks[len(chunks)-1].Summary.TotalRows != exportGradesChunkSize+2 {
+			t.Errorf("Expected unpublished grades when published_only is false")
+		}
+
+		stream, _ := client.ExportGrades(ctx, &pb.ExportGradesRequest{AdminId: adminID})
+		if _, err := stream.Recv(); status.Code(err) != codes.InvalidArgument {
+			t.Errorf("Expected InvalidArgument without a semester, got %v", err)
+		}
+		stream, _ = client.ExportGrades(ctx, &pb.ExportGradesRequest{Semester: "ExportSem", AdminId: testFacultyID})
+		if _, err := stream.Recv(); status.Code(err) != codes.PermissionDenied {
+			t.Errorf("Expected PermissionDenied for a non-admin, got %v", err)
+		}
+	})
+
+	// ========================================================================
+	// Test 16: W Grades Sync Enrollment Status
+	// ========================================================================
+	t.Run("W Grades Sync Enrollment Status", func(t *testing.T) {
+		studentID, enrollmentID := "student-grade-w", "ENR-TEST-W"
+		db.Collection("users").InsertOne(ctx, shared.User{ID: studentID, Role: "student", Name: "Student W", IsActive: true})
+		db.Collection("enrollments").InsertOne(ctx, shared.Enrollment{ID: enrollmentID, StudentID: studentID, CourseID: testCourseID, Status: shared.StatusEnrolled})
+		db.Collection("courses").UpdateOne(ctx, bson.M{"_id": testCourseID}, bson.M{"$set": bson.M{"enrolled": 1}})
+		defer func() {
+			db.Collection("users").DeleteOne(ctx, bson.M{"_id": studentID})
+			db.Collection("enrollments").DeleteOne(ctx, bson.M{"_id": enrollmentID})
+			db.Collection("enrollment_events").DeleteMany(ctx, bson.M{"course_id": testCourseID})
+		}()
+
+		upload := func(grade string) {
+			stream, err := client.UploadGrades(ctx)
+			if err != nil {
+				t.Fatalf("Failed to open stream: %v", err)
+			}
+			stream.Send(&pb.UploadGradeEntryRequest{Payload: &pb.UploadGradeEntryRequest_Metadata{
+				Metadata: &pb.UploadMetadata{CourseId: testCourseID, FacultyId: testFacultyID},
+			}})
+			stream.Send(&pb.UploadGradeEntryRequest{Payload: &pb.UploadGradeEntryRequest_Entry{
+				Entry: &pb.GradeEntry{StudentId: studentID, Grade: grade},
+			}, IsLast: true})
+			resp, err := stream.CloseAndRecv()
+			if err != nil || resp.Successful != 1 {
+				t.Fatalf("Expected %s to upload, got %+v (err %v)", grade, resp, err)
+			}
+		}
+		enrollment := func() (e shared.Enrollment) {
+			db.Collection("enrollments").FindOne(ctx, bson.M{"_id": enrollmentID}).Decode(&e)
+			return e
+		}
+		enrolledCount := func() int32 {
+			var c shared.Course
+			db.Collection("courses").FindOne(ctx, bson.M{"_id": testCourseID}).Decode(&c)
+			return c.Enrolled
+		}
+
+		// The seat follows the drop deadline in the current config
+		canDrop := shared.LoadEnrollmentWindow(ctx, db.Collection("system_config")).CanDrop(time.Now())
+
+		upload("w")
+		e := enrollment()
+		if e.Status != shared.StatusWithdrawn || e.WithdrawnAt.IsZero() {
+			t.Fatalf("Expected a W to withdraw the enrollment, got %+v", e)
+		}
+		if e.SeatHeld == canDrop {
+			t.Errorf("Expected seat_held %v when drops are allowed is %v", !canDrop, canDrop)
+		}
+		if want := map[bool]int32{true: 0, false: 1}[canDrop]; enrolledCount() != want {
+			t.Errorf("Expected %d enrolled after the W, got %d", want, enrolledCount())
+		}
+
+		roster, err := client.GetClassRoster(ctx, &pb.GetClassRosterRequest{CourseId: testCourseID})
+		if err != nil {
+			t.Fatalf("GetClassRoster failed: %v", err)
+		}
+		for _, s := range roster.Students {
+			if s.StudentId == studentID {
+				t.Error("Expected the withdrawn student off the active roster")
+			}
+		}
+		if len(roster.Withdrawn) != 1 || roster.Withdrawn[0].StudentId != studentID || roster.Withdrawn[0].Grade != shared.GradeW {
+			t.Errorf("Expected the student listed as withdrawn with a W, got %+v", roster.Withdrawn)
+		}
+
+		// Correcting the W restores the enrollment, which no longer holds a seat
+		upload("A")
+		if e := enrollment(); e.Status != shared.StatusCompleted || !e.WithdrawnAt.IsZero() || e.SeatHeld {
+			t.Errorf("Expected the W->A correction to complete the enrollment, got %+v", e)
+		}
+		if enrolledCount() != 0 {
+			t.Errorf("Expected no seat held after the correction, got %d", enrolledCount())
+		}
+
+		roster, _ = client.GetClassRoster(ctx, &pb.GetClassRosterRequest{CourseId: testCourseID})
+		if len(roster.Withdrawn) != 0 {
+			t.Errorf("Expected no withdrawn students after the correction, got %+v", roster.Withdrawn)
+		}
+	})
+
+	t.Run("Upload Rejects Students Off The Roster", func(t *testing.T) {
+		droppedID, strangerID, enrolledID := "student-grade-dropped", "student-grade-stranger", "student-grade-enrolled"
+		enrollmentIDs := []string{"ENR-TEST-DROPPED", "ENR-TEST-ENROLLED"}
+		db.Collection("users").InsertMany(ctx, []interface{}{
+			shared.User{ID: droppedID, Role: "student", Name: "Student Dropped", IsActive: true},
+			shared.User{ID: strangerID, Role: "student", Name: "Student Stranger", IsActive: true},
+			shared.User{ID: enrolledID, Role: "student", Name: "Student Enrolled", IsActive: true},
+		})
+		db.Collection("enrollments").InsertMany(ctx, []interface{}{
+			shared.Enrollment{ID: enrollmentIDs[0], StudentID: droppedID, CourseID: testCourseID, Status: shared.StatusDropped},
+			shared.Enrollment{ID: enrollmentIDs[1], StudentID: enrolledID, CourseID: testCourseID, Status: shared.StatusEnrolled},
+		})
+		configCol := db.Collection("system_config")
+		var original shared.SystemConfig
+		hadOriginal := configCol.FindOne(ctx, bson.M{"key": shared.ConfigGradeEnrolled}).Decode(&original) == nil
+		defer func() {
+			db.Collection("users").DeleteMany(ctx, bson.M{"_id": bson.M{"$in": []string{droppedID, strangerID, enrolledID}}})
+			db.Collection("enrollments").DeleteMany(ctx, bson.M{"_id": bson.M{"$in": enrollmentIDs}})
+			if hadOriginal {
+				configCol.ReplaceOne(ctx, bson.M{"key": shared.ConfigGradeEnrolled}, original)
+			} else {
+				configCol.DeleteOne(ctx, bson.M{"key": shared.ConfigGradeEnrolled})
+			}
+		}()
+
+		// FinalizeDraft goes through the same per-student check and reports each failure
+		finalize := func(grades map[string]string) *pb.FinalizeDraftResponse {
+			if _, err := client.SaveGradeDraft(ctx, &pb.SaveGradeDraftRequest{CourseId: testCourseID, FacultyId: testFacultyID, Grades: grades, Replace: true}); err != nil {
+				t.Fatalf("SaveGradeDraft failed: %v", err)
+			}
+			resp, err := client.FinalizeDraft(ctx, &pb.FinalizeDraftRequest{CourseId: testCourseID, FacultyId: testFacultyID})
+			if err != nil {
+				t.Fatalf("FinalizeDraft failed: %v", err)
+			}
+			return resp
+		}
+		errorFor := func(resp *pb.FinalizeDraftResponse, studentID string) *pb.GradeEntryError {
+			for _, e := range resp.Errors {
+				if e.StudentId == studentID {
+					return e
+				}
+			}
+			return nil
+		}
+
+		resp := finalize(map[string]string{droppedID: "A", strangerID: "B"})
+		if resp.Successful != 0 || resp.Failed != 2 {
+			t.Fatalf("Expected both grades rejected, got %+v", resp)
+		}
+		if e := errorFor(resp, droppedID); e == nil || e.ErrorCode != string(shared.ErrCodeNotEnrolled) || !strings.Contains(e.Error, "dropped") {
+			t.Errorf("Expected the dropped student rejected as dropped, got %+v", e)
+		}
+		if e := errorFor(resp, strangerID); e == nil || e.ErrorCode != string(shared.ErrCodeNotEnrolled) || !strings.Contains(e.Error, "not enrolled") {
+			t.Errorf("Expected the stranger rejected as not enrolled, got %+v", e)
+		}
+		if n, _ := db.Collection("grades").CountDocuments(ctx, bson.M{"student_id": bson.M{"$in": []string{droppedID, strangerID}}}); n != 0 {
+			t.Errorf("Expected no grades stored for students off the roster, got %d", n)
+		}
+
+		// Still-enrolled students wait for the semester to close when the config says so
+		configCol.UpdateOne(ctx, bson.M{"key": shared.ConfigGradeEnrolled},
+			bson.M{"$set": bson.M{"value": "false"}}, options.Update().SetUpsert(true))
+		resp = finalize(map[string]string{enrolledID: "A"})
+		if e := errorFor(resp, enrolledID); e == nil || e.ErrorCode != string(shared.ErrCodeFailedPrecondition) {
+			t.Errorf("Expected the enrolled student rejected while grade_enrolled_students is false, got %+v", resp)
+		}
+
+		db.Collection("enrollments").UpdateOne(ctx, bson.M{"_id": enrollmentIDs[1]}, bson.M{"$set": bson.M{"status": shared.StatusCompleted}})
+		if resp = finalize(map[string]string{enrolledID: "A"}); resp.Successful != 1 {
+			t.Errorf("Expected the completed student graded, got %+v", resp)
+		}
+	})
+
+	t.Run("Upload Grades A Re-Enrolled Student", func(t *testing.T) {
+		studentID := "student-grade-reenrolled"
+		oldID, newID := "ENR-TEST-REENROLL-OLD", "ENR-TEST-REENROLL-NEW"
+		now := time.Now()
+		db.Collection("users").InsertOne(ctx, shared.User{ID: studentID, Role: "student", Name: "Student Re-Enrolled", IsActive: true})
+		// The withdrawn record is inserted last so that natural order would find it first
+		db.Collection("enrollments").InsertMany(ctx, []interface{}{
+			shared.Enrollment{ID: newID, StudentID: studentID, CourseID: testCourseID, Status: shared.StatusCompleted, EnrolledAt: now.Add(-time.Hour)},
+			shared.Enrollment{ID: oldID, StudentID: studentID, CourseID: testCourseID, Status: shared.StatusWithdrawn, EnrolledAt: now.Add(-30 * 24 * time.Hour)},
+		})
+		defer func() {
+			db.Collection("users").DeleteOne(ctx, bson.M{"_id": studentID})
+			db.Collection("enrollments").DeleteMany(ctx, bson.M{"_id": bson.M{"$in": []string{oldID, newID}}})
+			db.Collection("grades").DeleteMany(ctx, bson.M{"student_id": studentID})
+		}()
+
+		stream, err := client.UploadGrades(ctx)
+		if err != nil {
+			t.Fatalf("Failed to open stream: %v", err)
+		}
+		stream.Send(&pb.UploadGradeEntryRequest{Payload: &pb.UploadGradeEntryRequest_Metadata{
+			Metadata: &pb.UploadMetadata{CourseId: testCourseID, FacultyId: testFacultyID},
+		}})
+		stream.Send(&pb.UploadGradeEntryRequest{Payload: &pb.UploadGradeEntryRequest_Entry{
+			Entry: &pb.GradeEntry{StudentId: studentID, Grade: "B"},
+		}, IsLast: true})
+		resp, err := stream.CloseAndRecv()
+		if err != nil || resp.Successful != 1 {
+			t.Fatalf("Expected the grade to upload, got %+v (err %v)", resp, err)
+		}
+
+		var g shared.Grade
+		db.Collection("grades").FindOne(ctx, bson.M{"student_id": studentID, "course_id": testCourseID}).Decode(&g)
+		if g.EnrollmentID != newID {
+			t.Errorf("Expected the grade on the current enrollment %s, got %q", newID, g.EnrollmentID)
+		}
+		var old shared.Enrollment
+		db.Collection("enrollments").FindOne(ctx, bson.M{"_id": oldID}).Decode(&old)
+		if old.Status != shared.StatusWithdrawn {
+			t.Errorf("Expected the old withdrawn enrollment left alone, got %s", old.Status)
+		}
+	})
+}
+
+// TestGetClassRosterQueryCount checks that a roster loads its students and grades in
+// batches, so the number of queries does not grow with the class size
+func TestGetClassRosterQueryCount(t *testing.T) {
+	if err := godotenv.Load("../../cmd/grade/.env"); err != nil {
+		log.Println("No .env file found, using defaults")
+	}
+	cfg, _ := shared.LoadServiceConfig("grade-service")
+
+	var mu sync.Mutex
+	finds := 0
+	monitor := &event.CommandMonitor{Started: func(_ context.Context, e *event.CommandStartedEvent) {
+		if e.CommandName == "find" {
+			mu.Lock()
+			finds++
+			mu.Unlock()
+		}
+	}}
+
+	ctx := context.Background()
+	client, err := mongo.Connect(ctx, options.Client().ApplyURI(cfg.MongoDB.URI).SetMonitor(monitor))
+	if err != nil {
+		t.Fatalf("Failed to connect: %v", err)
+	}
+	defer client.Disconnect(ctx)
+	db := client.Database(cfg.MongoDB.Database)
+	service := NewGradeService(db)
+
+	courseID := "CS-ROSTER-QC"
+	cleanup := func() {
+		db.Collection("courses").DeleteOne(ctx, bson.M{"_id": courseID})
+		db.Collection("users").DeleteMany(ctx, bson.M{"_id": bson.M{"$regex": "^student-roster-qc-"}})
+		db.Collection("enrollments").DeleteMany(ctx, bson.M{"course_id": courseID})
+		db.Collection("grades").DeleteMany(ctx, bson.M{"course_id": courseID})
+	}
+	cleanup()
+	defer cleanup()
+
+	if _, err := db.Collection("courses").InsertOne(ctx, shared.Course{ID: courseID, Code: "CSQC101", Title: "Roster Query Count"}); err != nil {
+		t.Fatalf("Setup failed (course): %v", err)
+	}
+	added := 0
+	enroll := func(n int) {
+		for ; added < n; added++ {
+			studentID := fmt.Sprintf("student-roster-qc-%02d", added)
+			enrollmentID := fmt.Sprintf("ENR-ROSTER-QC-%02d", added)
+			db.Collection("users").InsertOne(ctx, shared.User{ID: studentID, Role: "student", Name: studentID, IsActive: true})
+			db.Collection("enrollments").InsertOne(ctx, shared.Enrollment{ID: enrollmentID, StudentID: studentID, CourseID: courseID, Status: shared.StatusEnrolled})
+			// Every third student is still ungraded; of the rest, every other grade is published
+			if added%3 != 2 {
+				db.Collection("grades").InsertOne(ctx, bson.M{
+					"enrollment_id": enrollmentID, "student_id": studentID, "course_id": courseID, "grade": "B", "published": added%2 == 0,
+				})
+			}
+		}
+	}
+	wantSummary := func(students int) (graded, ungraded, published int32) {
+		for i := 0; i < students; i++ {
+			switch {
+			case i%3 == 2:
+				ungraded++
+			case i%2 == 0:
+				graded++
+				published++
+			default:
+				graded++
+			}
+		}
+		return graded, ungraded, published
+	}
+	rosterFinds := func(students int) int {
+		enroll(students)
+		mu.Lock()
+		finds = 0
+		mu.Unlock()
+
+		resp, err := service.GetClassRoster(ctx, &pb.GetClassRosterRequest{CourseId: courseID})
+		if err != nil {
+			t.Fatalf("GetClassRoster failed: %v", err)
+		}
+		if int(resp.TotalStudents) != students || resp.Students[0].Grade != "B" {
+			t.Fatalf("Expected %d students, got %+v", students, resp)
+		}
+		graded, ungraded, published := wantSummary(students)
+		if s := resp.Summary; s.GetGraded() != graded || s.GetUngraded() != ungraded || s.GetPublished() != published {
+			t.Errorf("Expected %d graded, %d ungraded and %d published of %d, got %+v", graded, ungraded, published, students, s)
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		return finds
+	}
+
+	small, large := rosterFinds(2), rosterFinds(25)
+	if small != large {
+		t.Errorf("Expected the same number of queries for 2 and 25 students, got %d and %d", small, large)
+	}
+}
+
+// TestComputeGPA checks the unit-weighted GPA arithmetic shared by CalculateGPA and SimulateGPA
+func TestComputeGPA(t *testing.T) {
+	calc := computeGPA([]shared.GPARecord{
+		{Grade: shared.GradeA, Units: 3, Semester: "Fall 2024"},
+		{Grade: shared.GradeC, Units: 1, Semester: "Fall 2024"},
+		{Grade: shared.GradeB, Units: 4, Semester: "Spring 2024"},
+		{Grade: shared.GradeW, Units: 3, Semester: "Spring 2024"}, // not counted
+		{Grade: shared.GradeI, Units: 3, Semester: "Fall 2024"},   // not counted
+	})
+
+	// (4.0*3 + 2.0*1 + 3.0*4) / 8
+	if calc.Cgpa != 3.25 || calc.TotalUnitsAttempted != 8 {
+		t.Errorf("Expected CGPA 3.25 over 8 units, got %.2f over %d", calc.Cgpa, calc.TotalUnitsAttempted)
+	}
+	if len(calc.SemesterBreakdown) != 2 {
+		t.Fatalf("Expected 2 semesters, got %d", len(calc.SemesterBreakdown))
+	}
+	spring, fall := calc.SemesterBreakdown[0], calc.SemesterBreakdown[1]
+	if spring.Semester != "Spring 2024" || spring.Gpa != 3.0 || spring.CoursesCount != 1 {
+		t.Errorf("Unexpected Spring 2024 entry: %+v", spring)
+	}
+	if fall.Semester != "Fall 2024" || fall.Gpa != 3.5 || fall.Units != 4 {
+		t.Errorf("Unexpected Fall 2024 entry: %+v", fall)
+	}
+
+	if empty := computeGPA(nil); empty.Cgpa != 0 || len(empty.SemesterBreakdown) != 0 {
+		t.Errorf("Expected a zero calculation without grades, got %+v", empty)
+	}
 }
@@ -24,21 +24,30 @@ const (
 
 // Common messages (reusing some from other services)
 type Course struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
-	Title         string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
-	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
-	Units         int32                  `protobuf:"varint,5,opt,name=units,proto3" json:"units,omitempty"`
-	Schedule      string                 `protobuf:"bytes,6,opt,name=schedule,proto3" json:"schedule,omitempty"`
-	Room          string                 `protobuf:"bytes,7,opt,name=room,proto3" json:"room,omitempty"`
-	Capacity      int32                  `protobuf:"varint,8,opt,name=capacity,proto3" json:"capacity,omitempty"`
-	Enrolled      int32                  `protobuf:"varint,9,opt,name=enrolled,proto3" json:"enrolled,omitempty"`
-	FacultyId     string                 `protobuf:"bytes,10,opt,name=faculty_id,json=facultyId,proto3" json:"faculty_id,omitempty"`
-	IsOpen        bool                   `protobuf:"varint,11,opt,name=is_open,json=isOpen,proto3" json:"is_open,omitempty"`
-	Semester      string                 `protobuf:"bytes,12,opt,name=semester,proto3" json:"semester,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Code           string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	Title          string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Description    string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Units          int32                  `protobuf:"varint,5,opt,name=units,proto3" json:"units,omitempty"`
+	Schedule       string                 `protobuf:"bytes,6,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	Room           string                 `protobuf:"bytes,7,opt,name=room,proto3" json:"room,omitempty"`
+	Capacity       int32                  `protobuf:"varint,8,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	Enrolled       int32                  `protobuf:"varint,9,opt,name=enrolled,proto3" json:"enrolled,omitempty"`
+	FacultyId      string                 `protobuf:"bytes,10,opt,name=faculty_id,json=facultyId,proto3" json:"faculty_id,omitempty"`
+	IsOpen         bool                   `protobuf:"varint,11,opt,name=is_open,json=isOpen,proto3" json:"is_open,omitempty"`
+	Semester       string                 `protobuf:"bytes,12,opt,name=semester,proto3" json:"semester,omitempty"`
+	AllowedMajors  []string               `protobuf:"bytes,13,rep,name=allowed_majors,json=allowedMajors,proto3" json:"allowed_majors,omitempty"`     // empty = open to all majors
+	MinYearLevel   int32                  `protobuf:"varint,14,opt,name=min_year_level,json=minYearLevel,proto3" json:"min_year_level,omitempty"`     // 0 = no year level requirement
+	SeatsAvailable int32                  `protobuf:"varint,15,opt,name=seats_available,json=seatsAvailable,proto3" json:"seats_available,omitempty"` // capacity - enrolled, never negative
+	IsFull         bool                   `protobuf:"varint,16,opt,name=is_full,json=isFull,proto3" json:"is_full,omitempty"`
+	WaitlistCount  int32                  `protobuf:"varint,17,opt,name=waitlist_count,json=waitlistCount,proto3" json:"waitlist_count,omitempty"` // always 0 until waitlists exist
+	Department     string                 `protobuf:"bytes,18,opt,name=department,proto3" json:"department,omitempty"`
+	EnrollOpenAt   *timestamppb.Timestamp `protobuf:"bytes,19,opt,name=enroll_open_at,json=enrollOpenAt,proto3" json:"enroll_open_at,omitempty"` // optional per-course enrollment window
+	EnrollCloseAt  *timestamppb.Timestamp `protobuf:"bytes,20,opt,name=enroll_close_at,json=enrollCloseAt,proto3" json:"enroll_close_at,omitempty"`
+	Cancelled      bool                   `protobuf:"varint,21,opt,name=cancelled,proto3" json:"cancelled,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *Course) Reset() {
@@ -155,21 +164,86 @@ func (x *Course) GetSemester() string {
 	return ""
 }
 
+func (x *Course) GetAllowedMajors() []string {
+	if x != nil {
+		return x.AllowedMajors
+	}
+	return nil
+}
+
+func (x *Course) GetMinYearLevel() int32 {
+	if x != nil {
+		return x.MinYearLevel
+	}
+	return 0
+}
+
+func (x *Course) GetSeatsAvailable() int32 {
+	if x != nil {
+		return x.SeatsAvailable
+	}
+	return 0
+}
+
+func (x *Course) GetIsFull() bool {
+	if x != nil {
+		return x.IsFull
+	}
+	return false
+}
+
+func (x *Course) GetWaitlistCount() int32 {
+	if x != nil {
+		return x.WaitlistCount
+	}
+	return 0
+}
+
+func (x *Course) GetDepartment() string {
+	if x != nil {
+		return x.Department
+	}
+	return ""
+}
+
+func (x *Course) GetEnrollOpenAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EnrollOpenAt
+	}
+	return nil
+}
+
+func (x *Course) GetEnrollCloseAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EnrollCloseAt
+	}
+	return nil
+}
+
+func (x *Course) GetCancelled() bool {
+	if x != nil {
+		return x.Cancelled
+	}
+	return false
+}
+
 type User struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Email         string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
-	Role          string                 `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`
-	Name          string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
-	StudentId     string                 `protobuf:"bytes,5,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
-	FacultyId     string                 `protobuf:"bytes,6,opt,name=faculty_id,json=facultyId,proto3" json:"faculty_id,omitempty"`
-	Department    string                 `protobuf:"bytes,7,opt,name=department,proto3" json:"department,omitempty"`
-	Major         string                 `protobuf:"bytes,8,opt,name=major,proto3" json:"major,omitempty"`
-	YearLevel     int32                  `protobuf:"varint,9,opt,name=year_level,json=yearLevel,proto3" json:"year_level,omitempty"`
-	IsActive      bool                   `protobuf:"varint,10,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Id                 string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Email              string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	Role               string                 `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`
+	Name               string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	StudentId          string                 `protobuf:"bytes,5,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
+	FacultyId          string                 `protobuf:"bytes,6,opt,name=faculty_id,json=facultyId,proto3" json:"faculty_id,omitempty"`
+	Department         string                 `protobuf:"bytes,7,opt,name=department,proto3" json:"department,omitempty"`
+	Major              string                 `protobuf:"bytes,8,opt,name=major,proto3" json:"major,omitempty"`
+	YearLevel          int32                  `protobuf:"varint,9,opt,name=year_level,json=yearLevel,proto3" json:"year_level,omitempty"`
+	IsActive           bool                   `protobuf:"varint,10,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	CreatedAt          *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	LastLoginAt        *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=last_login_at,json=lastLoginAt,proto3" json:"last_login_at,omitempty"`
+	MustChangePassword bool                   `protobuf:"varint,13,opt,name=must_change_password,json=mustChangePassword,proto3" json:"must_change_password,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
 func (x *User) Reset() {
@@ -279,6 +353,20 @@ func (x *User) GetCreatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *User) GetLastLoginAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastLoginAt
+	}
+	return nil
+}
+
+func (x *User) GetMustChangePassword() bool {
+	if x != nil {
+		return x.MustChangePassword
+	}
+	return false
+}
+
 type SystemConfig struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
@@ -362,8 +450,11 @@ type SystemStats struct {
 	TotalCourses     int32                  `protobuf:"varint,3,opt,name=total_courses,json=totalCourses,proto3" json:"total_courses,omitempty"`
 	OpenCourses      int32                  `protobuf:"varint,4,opt,name=open_courses,json=openCourses,proto3" json:"open_courses,omitempty"`
 	TotalEnrollments int32                  `protobuf:"varint,5,opt,name=total_enrollments,json=totalEnrollments,proto3" json:"total_enrollments,omitempty"`
-	EnrollmentOpen   bool                   `protobuf:"varint,6,opt,name=enrollment_open,json=enrollmentOpen,proto3" json:"enrollment_open,omitempty"`
+	EnrollmentOpen   bool                   `protobuf:"varint,6,opt,name=enrollment_open,json=enrollmentOpen,proto3" json:"enrollment_open,omitempty"` // enrollment_enabled and now within the enrollment window
 	CurrentSemester  string                 `protobuf:"bytes,7,opt,name=current_semester,json=currentSemester,proto3" json:"current_semester,omitempty"`
+	EnrollmentsToday int32                  `protobuf:"varint,8,opt,name=enrollments_today,json=enrollmentsToday,proto3" json:"enrollments_today,omitempty"`  // enrolled_at since local midnight
+	DropsToday       int32                  `protobuf:"varint,9,opt,name=drops_today,json=dropsToday,proto3" json:"drops_today,omitempty"`                    // dropped_at since local midnight
+	AverageFillRate  float64                `protobuf:"fixed64,10,opt,name=average_fill_rate,json=averageFillRate,proto3" json:"average_fill_rate,omitempty"` // percent, over courses with a capacity
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -447,6 +538,27 @@ func (x *SystemStats) GetCurrentSemester() string {
 	return ""
 }
 
+func (x *SystemStats) GetEnrollmentsToday() int32 {
+	if x != nil {
+		return x.EnrollmentsToday
+	}
+	return 0
+}
+
+func (x *SystemStats) GetDropsToday() int32 {
+	if x != nil {
+		return x.DropsToday
+	}
+	return 0
+}
+
+func (x *SystemStats) GetAverageFillRate() float64 {
+	if x != nil {
+		return x.AverageFillRate
+	}
+	return 0
+}
+
 // Request/Response messages - Course Management
 type CreateCourseRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -459,6 +571,15 @@ type CreateCourseRequest struct {
 	Capacity      int32                  `protobuf:"varint,7,opt,name=capacity,proto3" json:"capacity,omitempty"`
 	FacultyId     string                 `protobuf:"bytes,8,opt,name=faculty_id,json=facultyId,proto3" json:"faculty_id,omitempty"`
 	Semester      string                 `protobuf:"bytes,9,opt,name=semester,proto3" json:"semester,omitempty"`
+	AllowedMajors []string               `protobuf:"bytes,10,rep,name=allowed_majors,json=allowedMajors,proto3" json:"allowed_majors,omitempty"` // optional enrollment restriction
+	MinYearLevel  int32                  `protobuf:"varint,11,opt,name=min_year_level,json=minYearLevel,proto3" json:"min_year_level,omitempty"` // optional enrollment restriction
+	Unscheduled   bool                   `protobuf:"varint,12,opt,name=unscheduled,proto3" json:"unscheduled,omitempty"`                         // async/online course without meeting times; schedule must be empty
+	Department    string                 `protobuf:"bytes,13,opt,name=department,proto3" json:"department,omitempty"`                            // optional department code or name; derived from the code when empty (e.g., "2024-CS-101" -> "CS")
+	// Optional per-course enrollment window (RFC 3339 or YYYY-MM-DD). A course with
+	// an open time is created open and becomes enrollable once the window starts.
+	EnrollOpenAt  string `protobuf:"bytes,14,opt,name=enroll_open_at,json=enrollOpenAt,proto3" json:"enroll_open_at,omitempty"`
+	EnrollCloseAt string `protobuf:"bytes,15,opt,name=enroll_close_at,json=enrollCloseAt,proto3" json:"enroll_close_at,omitempty"`
+	AdminId       string `protobuf:"bytes,16,opt,name=admin_id,json=adminId,proto3" json:"admin_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -556,6 +677,55 @@ func (x *CreateCourseRequest) GetSemester() string {
 	return ""
 }
 
+func (x *CreateCourseRequest) GetAllowedMajors() []string {
+	if x != nil {
+		return x.AllowedMajors
+	}
+	return nil
+}
+
+func (x *CreateCourseRequest) GetMinYearLevel() int32 {
+	if x != nil {
+		return x.MinYearLevel
+	}
+	return 0
+}
+
+func (x *CreateCourseRequest) GetUnscheduled() bool {
+	if x != nil {
+		return x.Unscheduled
+	}
+	return false
+}
+
+func (x *CreateCourseRequest) GetDepartment() string {
+	if x != nil {
+		return x.Department
+	}
+	return ""
+}
+
+func (x *CreateCourseRequest) GetEnrollOpenAt() string {
+	if x != nil {
+		return x.EnrollOpenAt
+	}
+	return ""
+}
+
+func (x *CreateCourseRequest) GetEnrollCloseAt() string {
+	if x != nil {
+		return x.EnrollCloseAt
+	}
+	return ""
+}
+
+func (x *CreateCourseRequest) GetAdminId() string {
+	if x != nil {
+		return x.AdminId
+	}
+	return ""
+}
+
 type CreateCourseResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
@@ -625,18 +795,29 @@ func (x *CreateCourseResponse) GetMessage() string {
 }
 
 type UpdateCourseRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	CourseId      string                 `protobuf:"bytes,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
-	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	Units         int32                  `protobuf:"varint,4,opt,name=units,proto3" json:"units,omitempty"`
-	Schedule      string                 `protobuf:"bytes,5,opt,name=schedule,proto3" json:"schedule,omitempty"`
-	Room          string                 `protobuf:"bytes,6,opt,name=room,proto3" json:"room,omitempty"`
-	Capacity      int32                  `protobuf:"varint,7,opt,name=capacity,proto3" json:"capacity,omitempty"`
-	FacultyId     string                 `protobuf:"bytes,8,opt,name=faculty_id,json=facultyId,proto3" json:"faculty_id,omitempty"`
-	IsOpen        bool                   `protobuf:"varint,9,opt,name=is_open,json=isOpen,proto3" json:"is_open,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	CourseId    string                 `protobuf:"bytes,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	Title       string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Description string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Units       int32                  `protobuf:"varint,4,opt,name=units,proto3" json:"units,omitempty"`
+	Schedule    string                 `protobuf:"bytes,5,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	Room        string                 `protobuf:"bytes,6,opt,name=room,proto3" json:"room,omitempty"`
+	Capacity    int32                  `protobuf:"varint,7,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	FacultyId   string                 `protobuf:"bytes,8,opt,name=faculty_id,json=facultyId,proto3" json:"faculty_id,omitempty"`
+	IsOpen      bool                   `protobuf:"varint,9,opt,name=is_open,json=isOpen,proto3" json:"is_open,omitempty"`
+	// When true, allowed_majors and min_year_level replace the current restrictions (empty/0 clears them)
+	UpdateRestrictions bool     `protobuf:"varint,10,opt,name=update_restrictions,json=updateRestrictions,proto3" json:"update_restrictions,omitempty"`
+	AllowedMajors      []string `protobuf:"bytes,11,rep,name=allowed_majors,json=allowedMajors,proto3" json:"allowed_majors,omitempty"`
+	MinYearLevel       int32    `protobuf:"varint,12,opt,name=min_year_level,json=minYearLevel,proto3" json:"min_year_level,omitempty"`
+	Unscheduled        bool     `protobuf:"varint,13,opt,name=unscheduled,proto3" json:"unscheduled,omitempty"` // clears the schedule (async/online); schedule must be empty
+	Department         string   `protobuf:"bytes,14,opt,name=department,proto3" json:"department,omitempty"`    // optional, replaces the department; must be a registered department
+	// When true, enroll_open_at and enroll_close_at replace the course's window (empty clears a bound)
+	UpdateEnrollWindow bool   `protobuf:"varint,15,opt,name=update_enroll_window,json=updateEnrollWindow,proto3" json:"update_enroll_window,omitempty"`
+	EnrollOpenAt       string `protobuf:"bytes,16,opt,name=enroll_open_at,json=enrollOpenAt,proto3" json:"enroll_open_at,omitempty"`
+	EnrollCloseAt      string `protobuf:"bytes,17,opt,name=enroll_close_at,json=enrollCloseAt,proto3" json:"enroll_close_at,omitempty"`
+	AdminId            string `protobuf:"bytes,18,opt,name=admin_id,json=adminId,proto3" json:"admin_id,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
 func (x *UpdateCourseRequest) Reset() {
@@ -732,6 +913,69 @@ func (x *UpdateCourseRequest) GetIsOpen() bool {
 	return false
 }
 
+func (x *UpdateCourseRequest) GetUpdateRestrictions() bool {
+	if x != nil {
+		return x.UpdateRestrictions
+	}
+	return false
+}
+
+func (x *UpdateCourseRequest) GetAllowedMajors() []string {
+	if x != nil {
+		return x.AllowedMajors
+	}
+	return nil
+}
+
+func (x *UpdateCourseRequest) GetMinYearLevel() int32 {
+	if x != nil {
+		return x.MinYearLevel
+	}
+	return 0
+}
+
+func (x *UpdateCourseRequest) GetUnscheduled() bool {
+	if x != nil {
+		return x.Unscheduled
+	}
+	return false
+}
+
+func (x *UpdateCourseRequest) GetDepartment() string {
+	if x != nil {
+		return x.Department
+	}
+	return ""
+}
+
+func (x *UpdateCourseRequest) GetUpdateEnrollWindow() bool {
+	if x != nil {
+		return x.UpdateEnrollWindow
+	}
+	return false
+}
+
+func (x *UpdateCourseRequest) GetEnrollOpenAt() string {
+	if x != nil {
+		return x.EnrollOpenAt
+	}
+	return ""
+}
+
+func (x *UpdateCourseRequest) GetEnrollCloseAt() string {
+	if x != nil {
+		return x.EnrollCloseAt
+	}
+	return ""
+}
+
+func (x *UpdateCourseRequest) GetAdminId() string {
+	if x != nil {
+		return x.AdminId
+	}
+	return ""
+}
+
 type UpdateCourseResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
@@ -888,28 +1132,30 @@ func (x *DeleteCourseResponse) GetMessage() string {
 	return ""
 }
 
-type AssignFacultyRequest struct {
+// Cancelling closes the course for good and drops everyone enrolled in it
+type CancelCourseRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	CourseId      string                 `protobuf:"bytes,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
-	FacultyId     string                 `protobuf:"bytes,2,opt,name=faculty_id,json=facultyId,proto3" json:"faculty_id,omitempty"`
+	AdminId       string                 `protobuf:"bytes,2,opt,name=admin_id,json=adminId,proto3" json:"admin_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"` // included in the notification sent to each student
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AssignFacultyRequest) Reset() {
-	*x = AssignFacultyRequest{}
+func (x *CancelCourseRequest) Reset() {
+	*x = CancelCourseRequest{}
 	mi := &file_backend_protos_admin_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AssignFacultyRequest) String() string {
+func (x *CancelCourseRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AssignFacultyRequest) ProtoMessage() {}
+func (*CancelCourseRequest) ProtoMessage() {}
 
-func (x *AssignFacultyRequest) ProtoReflect() protoreflect.Message {
+func (x *CancelCourseRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_backend_protos_admin_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -921,47 +1167,56 @@ func (x *AssignFacultyRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AssignFacultyRequest.ProtoReflect.Descriptor instead.
-func (*AssignFacultyRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CancelCourseRequest.ProtoReflect.Descriptor instead.
+func (*CancelCourseRequest) Descriptor() ([]byte, []int) {
 	return file_backend_protos_admin_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *AssignFacultyRequest) GetCourseId() string {
+func (x *CancelCourseRequest) GetCourseId() string {
 	if x != nil {
 		return x.CourseId
 	}
 	return ""
 }
 
-func (x *AssignFacultyRequest) GetFacultyId() string {
+func (x *CancelCourseRequest) GetAdminId() string {
 	if x != nil {
-		return x.FacultyId
+		return x.AdminId
 	}
 	return ""
 }
 
-type AssignFacultyResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *CancelCourseRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
 }
 
-func (x *AssignFacultyResponse) Reset() {
-	*x = AssignFacultyResponse{}
+type CancelCourseResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Success          bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message          string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	StudentsAffected int32                  `protobuf:"varint,3,opt,name=students_affected,json=studentsAffected,proto3" json:"students_affected,omitempty"` // enrollments dropped
+	CartsUpdated     int32                  `protobuf:"varint,4,opt,name=carts_updated,json=cartsUpdated,proto3" json:"carts_updated,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *CancelCourseResponse) Reset() {
+	*x = CancelCourseResponse{}
 	mi := &file_backend_protos_admin_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AssignFacultyResponse) String() string {
+func (x *CancelCourseResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AssignFacultyResponse) ProtoMessage() {}
+func (*CancelCourseResponse) ProtoMessage() {}
 
-func (x *AssignFacultyResponse) ProtoReflect() protoreflect.Message {
+func (x *CancelCourseResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_backend_protos_admin_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -973,54 +1228,61 @@ func (x *AssignFacultyResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AssignFacultyResponse.ProtoReflect.Descriptor instead.
-func (*AssignFacultyResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use CancelCourseResponse.ProtoReflect.Descriptor instead.
+func (*CancelCourseResponse) Descriptor() ([]byte, []int) {
 	return file_backend_protos_admin_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *AssignFacultyResponse) GetSuccess() bool {
+func (x *CancelCourseResponse) GetSuccess() bool {
 	if x != nil {
 		return x.Success
 	}
 	return false
 }
 
-func (x *AssignFacultyResponse) GetMessage() string {
+func (x *CancelCourseResponse) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-// Request/Response messages - User Management
-type CreateUserRequest struct {
+func (x *CancelCourseResponse) GetStudentsAffected() int32 {
+	if x != nil {
+		return x.StudentsAffected
+	}
+	return 0
+}
+
+func (x *CancelCourseResponse) GetCartsUpdated() int32 {
+	if x != nil {
+		return x.CartsUpdated
+	}
+	return 0
+}
+
+type AssignFacultyRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
-	Role          string                 `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"` // student, faculty, admin
-	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	StudentId     string                 `protobuf:"bytes,4,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`  // if role=student
-	FacultyId     string                 `protobuf:"bytes,5,opt,name=faculty_id,json=facultyId,proto3" json:"faculty_id,omitempty"`  // if role=faculty
-	Department    string                 `protobuf:"bytes,6,opt,name=department,proto3" json:"department,omitempty"`                 // if role=faculty
-	Major         string                 `protobuf:"bytes,7,opt,name=major,proto3" json:"major,omitempty"`                           // if role=student
-	YearLevel     int32                  `protobuf:"varint,8,opt,name=year_level,json=yearLevel,proto3" json:"year_level,omitempty"` // if role=student
+	CourseId      string                 `protobuf:"bytes,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	FacultyId     string                 `protobuf:"bytes,2,opt,name=faculty_id,json=facultyId,proto3" json:"faculty_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateUserRequest) Reset() {
-	*x = CreateUserRequest{}
+func (x *AssignFacultyRequest) Reset() {
+	*x = AssignFacultyRequest{}
 	mi := &file_backend_protos_admin_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateUserRequest) String() string {
+func (x *AssignFacultyRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateUserRequest) ProtoMessage() {}
+func (*AssignFacultyRequest) ProtoMessage() {}
 
-func (x *CreateUserRequest) ProtoReflect() protoreflect.Message {
+func (x *AssignFacultyRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_backend_protos_admin_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1032,93 +1294,3857 @@ func (x *CreateUserRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateUserRequest.ProtoReflect.Descriptor instead.
-func (*CreateUserRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use AssignFacultyRequest.ProtoReflect.Descriptor instead.
+func (*AssignFacultyRequest) Descriptor() ([]byte, []int) {
 	return file_backend_protos_admin_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *CreateUserRequest) GetEmail() string {
+func (x *AssignFacultyRequest) GetCourseId() string {
 	if x != nil {
-		return x.Email
+		return x.CourseId
+	}
+	return ""
+}
+
+func (x *AssignFacultyRequest) GetFacultyId() string {
+	if x != nil {
+		return x.FacultyId
+	}
+	return ""
+}
+
+type AssignFacultyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AssignFacultyResponse) Reset() {
+	*x = AssignFacultyResponse{}
+	mi := &file_backend_protos_admin_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssignFacultyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssignFacultyResponse) ProtoMessage() {}
+
+func (x *AssignFacultyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_protos_admin_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssignFacultyResponse.ProtoReflect.Descriptor instead.
+func (*AssignFacultyResponse) Descriptor() ([]byte, []int) {
+	return file_backend_protos_admin_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *AssignFacultyResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *AssignFacultyResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// Dry run of a course schedule; nothing is written
+type ValidateCourseScheduleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Schedule      string                 `protobuf:"bytes,1,opt,name=schedule,proto3" json:"schedule,omitempty"`                    // e.g. "MWF 9:00-10:00"
+	Room          string                 `protobuf:"bytes,2,opt,name=room,proto3" json:"room,omitempty"`                            // optional; checked for room conflicts
+	FacultyId     string                 `protobuf:"bytes,3,opt,name=faculty_id,json=facultyId,proto3" json:"faculty_id,omitempty"` // optional; checked for instructor conflicts
+	Semester      string                 `protobuf:"bytes,4,opt,name=semester,proto3" json:"semester,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ValidateCourseScheduleRequest) Reset() {
+	*x = ValidateCourseScheduleRequest{}
+	mi := &file_backend_protos_admin_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidateCourseScheduleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateCourseScheduleRequest) ProtoMessage() {}
+
+func (x *ValidateCourseScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_protos_admin_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateCourseScheduleRequest.ProtoReflect.Descriptor instead.
+func (*ValidateCourseScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_backend_protos_admin_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ValidateCourseScheduleRequest) GetSchedule() string {
+	if x != nil {
+		return x.Schedule
+	}
+	return ""
+}
+
+func (x *ValidateCourseScheduleRequest) GetRoom() string {
+	if x != nil {
+		return x.Room
+	}
+	return ""
+}
+
+func (x *ValidateCourseScheduleRequest) GetFacultyId() string {
+	if x != nil {
+		return x.FacultyId
+	}
+	return ""
+}
+
+func (x *ValidateCourseScheduleRequest) GetSemester() string {
+	if x != nil {
+		return x.Semester
+	}
+	return ""
+}
+
+type ScheduleConflict struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CourseId      string                 `protobuf:"bytes,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	CourseCode    string                 `protobuf:"bytes,2,opt,name=course_code,json=courseCode,proto3" json:"course_code,omitempty"`
+	Schedule      string                 `protobuf:"bytes,3,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	ConflictType  string                 `protobuf:"bytes,4,opt,name=conflict_type,json=conflictType,proto3" json:"conflict_type,omitempty"` // "room" or "faculty"
+	Details       string                 `protobuf:"bytes,5,opt,name=details,proto3" json:"details,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScheduleConflict) Reset() {
+	*x = ScheduleConflict{}
+	mi := &file_backend_protos_admin_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScheduleConflict) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduleConflict) ProtoMessage() {}
+
+func (x *ScheduleConflict) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_protos_admin_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduleConflict.ProtoReflect.Descriptor instead.
+func (*ScheduleConflict) Descriptor() ([]byte, []int) {
+	return file_backend_protos_admin_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ScheduleConflict) GetCourseId() string {
+	if x != nil {
+		return x.CourseId
+	}
+	return ""
+}
+
+func (x *ScheduleConflict) GetCourseCode() string {
+	if x != nil {
+		return x.CourseCode
+	}
+	return ""
+}
+
+func (x *ScheduleConflict) GetSchedule() string {
+	if x != nil {
+		return x.Schedule
+	}
+	return ""
+}
+
+func (x *ScheduleConflict) GetConflictType() string {
+	if x != nil {
+		return x.ConflictType
+	}
+	return ""
+}
+
+func (x *ScheduleConflict) GetDetails() string {
+	if x != nil {
+		return x.Details
+	}
+	return ""
+}
+
+type ValidateCourseScheduleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Valid         bool                   `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`                            // parseable and conflict-free
+	ParseError    string                 `protobuf:"bytes,2,opt,name=parse_error,json=parseError,proto3" json:"parse_error,omitempty"` // set when the schedule cannot be parsed
+	Days          []string               `protobuf:"bytes,3,rep,name=days,proto3" json:"days,omitempty"`
+	StartTime     string                 `protobuf:"bytes,4,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime       string                 `protobuf:"bytes,5,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	Conflicts     []*ScheduleConflict    `protobuf:"bytes,6,rep,name=conflicts,proto3" json:"conflicts,omitempty"`
+	Message       string                 `protobuf:"bytes,7,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ValidateCourseScheduleResponse) Reset() {
+	*x = ValidateCourseScheduleResponse{}
+	mi := &file_backend_protos_admin_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidateCourseScheduleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateCourseScheduleResponse) ProtoMessage() {}
+
+func (x *ValidateCourseScheduleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_protos_admin_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateCourseScheduleResponse.ProtoReflect.Descriptor instead.
+func (*ValidateCourseScheduleResponse) Descriptor() ([]byte, []int) {
+	return file_backend_protos_admin_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ValidateCourseScheduleResponse) GetValid() bool {
+	if x != nil {
+		return x.Valid
+	}
+	return false
+}
+
+func (x *ValidateCourseScheduleResponse) GetParseError() string {
+	if x != nil {
+		return x.ParseError
+	}
+	return ""
+}
+
+func (x *ValidateCourseScheduleResponse) GetDays() []string {
+	if x != nil {
+		return x.Days
+	}
+	return nil
+}
+
+func (x *ValidateCourseScheduleResponse) GetStartTime() string {
+	if x != nil {
+		return x.StartTime
+	}
+	return ""
+}
+
+func (x *ValidateCourseScheduleResponse) GetEndTime() string {
+	if x != nil {
+		return x.EndTime
+	}
+	return ""
+}
+
+func (x *ValidateCourseScheduleResponse) GetConflicts() []*ScheduleConflict {
+	if x != nil {
+		return x.Conflicts
+	}
+	return nil
+}
+
+func (x *ValidateCourseScheduleResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// One row of a bulk course import
+type CourseDefinition struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Code              string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Title             string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Description       string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Units             int32                  `protobuf:"varint,4,opt,name=units,proto3" json:"units,omitempty"`
+	Schedule          string                 `protobuf:"bytes,5,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	Room              string                 `protobuf:"bytes,6,opt,name=room,proto3" json:"room,omitempty"`
+	Capacity          int32                  `protobuf:"varint,7,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	FacultyId         string                 `protobuf:"bytes,8,opt,name=faculty_id,json=facultyId,proto3" json:"faculty_id,omitempty"`
+	Semester          string                 `protobuf:"bytes,9,opt,name=semester,proto3" json:"semester,omitempty"`
+	AllowedMajors     []string               `protobuf:"bytes,10,rep,name=allowed_majors,json=allowedMajors,proto3" json:"allowed_majors,omitempty"`
+	MinYearLevel      int32                  `protobuf:"varint,11,opt,name=min_year_level,json=minYearLevel,proto3" json:"min_year_level,omitempty"`
+	PrerequisiteCodes []string               `protobuf:"bytes,12,rep,name=prerequisite_codes,json=prerequisiteCodes,proto3" json:"prerequisite_codes,omitempty"` // linked after all rows are created
+	Unscheduled       bool                   `protobuf:"varint,13,opt,name=unscheduled,proto3" json:"unscheduled,omitempty"`                                     // as in CreateCourseRequest
+	Department        string                 `protobuf:"bytes,14,opt,name=department,proto3" json:"department,omitempty"`                                        // as in CreateCourseRequest
+	EnrollOpenAt      string                 `protobuf:"bytes,15,opt,name=enroll_open_at,json=enrollOpenAt,proto3" json:"enroll_open_at,omitempty"`              // as in CreateCourseRequest
+	EnrollCloseAt     string                 `protobuf:"bytes,16,opt,name=enroll_close_at,json=enrollCloseAt,proto3" json:"enroll_close_at,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *CourseDefinition) Reset() {
+	*x = CourseDefinition{}
+	mi := &file_backend_protos_admin_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CourseDefinition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CourseDefinition) ProtoMessage() {}
+
+func (x *CourseDefinition) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_protos_admin_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CourseDefinition.ProtoReflect.Descriptor instead.
+func (*CourseDefinition) Descriptor() ([]byte, []int) {
+	return file_backend_protos_admin_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *CourseDefinition) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *CourseDefinition) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CourseDefinition) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CourseDefinition) GetUnits() int32 {
+	if x != nil {
+		return x.Units
+	}
+	return 0
+}
+
+func (x *CourseDefinition) GetSchedule() string {
+	if x != nil {
+		return x.Schedule
+	}
+	return ""
+}
+
+func (x *CourseDefinition) GetRoom() string {
+	if x != nil {
+		return x.Room
+	}
+	return ""
+}
+
+func (x *CourseDefinition) GetCapacity() int32 {
+	if x != nil {
+		return x.Capacity
+	}
+	return 0
+}
+
+func (x *CourseDefinition) GetFacultyId() string {
+	if x != nil {
+		return x.FacultyId
+	}
+	return ""
+}
+
+func (x *CourseDefinition) GetSemester() string {
+	if x != nil {
+		return x.Semester
+	}
+	return ""
+}
+
+func (x *CourseDefinition) GetAllowedMajors() []string {
+	if x != nil {
+		return x.AllowedMajors
+	}
+	return nil
+}
+
+func (x *CourseDefinition) GetMinYearLevel() int32 {
+	if x != nil {
+		return x.MinYearLevel
+	}
+	return 0
+}
+
+func (x *CourseDefinition) GetPrerequisiteCodes() []string {
+	if x != nil {
+		return x.PrerequisiteCodes
+	}
+	return nil
+}
+
+func (x *CourseDefinition) GetUnscheduled() bool {
+	if x != nil {
+		return x.Unscheduled
+	}
+	return false
+}
+
+func (x *CourseDefinition) GetDepartment() string {
+	if x != nil {
+		return x.Department
+	}
+	return ""
+}
+
+func (x *CourseDefinition) GetEnrollOpenAt() string {
+	if x != nil {
+		return x.EnrollOpenAt
+	}
+	return ""
+}
+
+func (x *CourseDefinition) GetEnrollCloseAt() string {
+	if x != nil {
+		return x.EnrollCloseAt
+	}
+	return ""
+}
+
+type BulkCreateCoursesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Courses       []*CourseDefinition    `protobuf:"bytes,1,rep,name=courses,proto3" json:"courses,omitempty"`
+	AdminId       string                 `protobuf:"bytes,2,opt,name=admin_id,json=adminId,proto3" json:"admin_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkCreateCoursesRequest) Reset() {
+	*x = BulkCreateCoursesRequest{}
+	mi := &file_backend_protos_admin_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkCreateCoursesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkCreateCoursesRequest) ProtoMessage() {}
+
+func (x *BulkCreateCoursesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_protos_admin_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkCreateCoursesRequest.ProtoReflect.Descriptor instead.
+func (*BulkCreateCoursesRequest) Descriptor() ([]byte, []int) {
+	return file_backend_protos_admin_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *BulkCreateCoursesRequest) GetCourses() []*CourseDefinition {
+	if x != nil {
+		return x.Courses
+	}
+	return nil
+}
+
+func (x *BulkCreateCoursesRequest) GetAdminId() string {
+	if x != nil {
+		return x.AdminId
+	}
+	return ""
+}
+
+type BulkCourseResult struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Row                int32                  `protobuf:"varint,1,opt,name=row,proto3" json:"row,omitempty"` // 1-based position in the request
+	Code               string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	Semester           string                 `protobuf:"bytes,3,opt,name=semester,proto3" json:"semester,omitempty"`
+	Success            bool                   `protobuf:"varint,4,opt,name=success,proto3" json:"success,omitempty"`
+	CourseId           string                 `protobuf:"bytes,5,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	Error              string                 `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`
+	PrerequisiteErrors []string               `protobuf:"bytes,7,rep,name=prerequisite_errors,json=prerequisiteErrors,proto3" json:"prerequisite_errors,omitempty"` // course was created but these links failed
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *BulkCourseResult) Reset() {
+	*x = BulkCourseResult{}
+	mi := &file_backend_protos_admin_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkCourseResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkCourseResult) ProtoMessage() {}
+
+func (x *BulkCourseResult) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_protos_admin_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkCourseResult.ProtoReflect.Descriptor instead.
+func (*BulkCourseResult) Descriptor() ([]byte, []int) {
+	return file_backend_protos_admin_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *BulkCourseResult) GetRow() int32 {
+	if x != nil {
+		return x.Row
+	}
+	return 0
+}
+
+func (x *BulkCourseResult) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *BulkCourseResult) GetSemester() string {
+	if x != nil {
+		return x.Semester
+	}
+	return ""
+}
+
+func (x *BulkCourseResult) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *BulkCourseResult) GetCourseId() string {
+	if x != nil {
+		return x.CourseId
+	}
+	return ""
+}
+
+func (x *BulkCourseResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *BulkCourseResult) GetPrerequisiteErrors() []string {
+	if x != nil {
+		return x.PrerequisiteErrors
+	}
+	return nil
+}
+
+type BulkCreateCoursesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"` // true when every row was created
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	CreatedCount  int32                  `protobuf:"varint,3,opt,name=created_count,json=createdCount,proto3" json:"created_count,omitempty"`
+	FailedCount   int32                  `protobuf:"varint,4,opt,name=failed_count,json=failedCount,proto3" json:"failed_count,omitempty"`
+	Results       []*BulkCourseResult    `protobuf:"bytes,5,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkCreateCoursesResponse) Reset() {
+	*x = BulkCreateCoursesResponse{}
+	mi := &file_backend_protos_admin_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkCreateCoursesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkCreateCoursesResponse) ProtoMessage() {}
+
+func (x *BulkCreateCoursesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_protos_admin_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkCreateCoursesResponse.ProtoReflect.Descriptor instead.
+func (*BulkCreateCoursesResponse) Descriptor() ([]byte, []int) {
+	return file_backend_protos_admin_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *BulkCreateCoursesResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *BulkCreateCoursesResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *BulkCreateCoursesResponse) GetCreatedCount() int32 {
+	if x != nil {
+		return x.CreatedCount
+	}
+	return 0
+}
+
+func (x *BulkCreateCoursesResponse) GetFailedCount() int32 {
+	if x != nil {
+		return x.FailedCount
+	}
+	return 0
+}
+
+func (x *BulkCreateCoursesResponse) GetResults() []*BulkCourseResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type AddPrerequisiteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CourseId      string                 `protobuf:"bytes,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	PrereqId      string                 `protobuf:"bytes,2,opt,name=prereq_id,json=prereqId,proto3" json:"prereq_id,omitempty"` // course that must be completed first
+	AdminId       string                 `protobuf:"bytes,3,opt,name=admin_id,json=adminId,proto3" json:"admin_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddPrerequisiteRequest) Reset() {
+	*x = AddPrerequisiteRequest{}
+	mi := &file_backend_protos_admin_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddPrerequisiteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddPrerequisiteRequest) ProtoMessage() {}
+
+func (x *AddPrerequisiteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_protos_admin_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddPrerequisiteRequest.ProtoReflect.Descriptor instead.
+func (*AddPrerequisiteRequest) Descriptor() ([]byte, []int) {
+	return file_backend_protos_admin_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *AddPrerequisiteRequest) GetCourseId() string {
+	if x != nil {
+		return x.CourseId
+	}
+	return ""
+}
+
+func (x *AddPrerequisiteRequest) GetPrereqId() string {
+	if x != nil {
+		return x.PrereqId
+	}
+	return ""
+}
+
+func (x *AddPrerequisiteRequest) GetAdminId() string {
+	if x != nil {
+		return x.AdminId
+	}
+	return ""
+}
+
+type AddPrerequisiteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddPrerequisiteResponse) Reset() {
+	*x = AddPrerequisiteResponse{}
+	mi := &file_backend_protos_admin_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddPrerequisiteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddPrerequisiteResponse) ProtoMessage() {}
+
+func (x *AddPrerequisiteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_protos_admin_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddPrerequisiteResponse.ProtoReflect.Descriptor instead.
+func (*AddPrerequisiteResponse) Descriptor() ([]byte, []int) {
+	return file_backend_protos_admin_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *AddPrerequisiteResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *AddPrerequisiteResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type RemovePrerequisiteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CourseId      string                 `protobuf:"bytes,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	PrereqId      string                 `protobuf:"bytes,2,opt,name=prereq_id,json=prereqId,proto3" json:"prereq_id,omitempty"`
+	AdminId       string                 `protobuf:"bytes,3,opt,name=admin_id,json=adminId,proto3" json:"admin_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemovePrerequisiteRequest) Reset() {
+	*x = RemovePrerequisiteRequest{}
+	mi := &file_backend_protos_admin_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemovePrerequisiteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemovePrerequisiteRequest) ProtoMessage() {}
+
+func (x *RemovePrerequisiteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_protos_admin_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemovePrerequisiteRequest.ProtoReflect.Descriptor instead.
+func (*RemovePrerequisiteRequest) Descriptor() ([]byte, []int) {
+	return file_backend_protos_admin_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *RemovePrerequisiteRequest) GetCourseId() string {
+	if x != nil {
+		return x.CourseId
+	}
+	return ""
+}
+
+func (x *RemovePrerequisiteRequest) GetPrereqId() string {
+	if x != nil {
+		return x.PrereqId
+	}
+	return ""
+}
+
+func (x *RemovePrerequisiteRequest) GetAdminId() string {
+	if x != nil {
+		return x.AdminId
+	}
+	return ""
+}
+
+type RemovePrerequisiteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemovePrerequisiteResponse) Reset() {
+	*x = RemovePrerequisiteResponse{}
+	mi := &file_backend_protos_admin_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemovePrerequisiteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemovePrerequisiteResponse) ProtoMessage() {}
+
+func (x *RemovePrerequisiteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_protos_admin_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemovePrerequisiteResponse.ProtoReflect.Descriptor instead.
+func (*RemovePrerequisiteResponse) Descriptor() ([]byte, []int) {
+	return file_backend_protos_admin_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *RemovePrerequisiteResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *RemovePrerequisiteResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// Request/Response messages - Departments
+type Department struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"` // e.g. "CS"; stored on courses as their department
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"` // e.g. "Computer Science"; stored on faculty as their department
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Department) Reset() {
+	*x = Department{}
+	mi := &file_backend_protos_admin_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Department) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Department) ProtoMessage() {}
+
+func (x *Department) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_protos_admin_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Department.ProtoReflect.Descriptor instead.
+func (*Department) Descriptor() ([]byte, []int) {
+	return file_backend_protos_admin_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *Department) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *Department) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Department) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Department) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type CreateDepartmentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"` // 1-10 letters, case-insensitive
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	AdminId       string                 `protobuf:"bytes,3,opt,name=admin_id,json=adminId,proto3" json:"admin_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateDepartmentRequest) Reset() {
+	*x = CreateDepartmentRequest{}
+	mi := &file_backend_protos_admin_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateDepartmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateDepartmentRequest) ProtoMessage() {}
+
+func (x *CreateDepartmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_protos_admin_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateDepartmentRequest.ProtoReflect.Descriptor instead.
+func (*CreateDepartmentRequest) Descriptor() ([]byte, []int) {
+	return file_backend_protos_admin_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *CreateDepartmentRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *CreateDepartmentRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateDepartmentRequest) GetAdminId() string {
+	if x != nil {
+		return x.AdminId
+	}
+	return ""
+}
+
+type CreateDepartmentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Department    *Department            `protobuf:"bytes,3,opt,name=department,proto3" json:"department,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateDepartmentResponse) Reset() {
+	*x = CreateDepartmentResponse{}
+	mi := &file_backend_protos_admin_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateDepartmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateDepartmentResponse) ProtoMessage() {}
+
+func (x *CreateDepartmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_protos_admin_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateDepartmentResponse.ProtoReflect.Descriptor instead.
+func (*CreateDepartmentResponse) Descriptor() ([]byte, []int) {
+	return file_backend_protos_admin_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *CreateDepartmentResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *CreateDepartmentResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *CreateDepartmentResponse) GetDepartment() *Department {
+	if x != nil {
+		return x.Department
+	}
+	return nil
+}
+
+// Renames a department; the code is fixed because courses store it
+type UpdateDepartmentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	AdminId       string                 `protobuf:"bytes,3,opt,name=admin_id,json=adminId,proto3" json:"admin_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateDepartmentRequest) Reset() {
+	*x = UpdateDepartmentRequest{}
+	mi := &file_backend_protos_admin_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateDepartmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateDepartmentRequest) ProtoMessage() {}
+
+func (x *UpdateDepartmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_protos_admin_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateDepartmentRequest.ProtoReflect.Descriptor instead.
+func (*UpdateDepartmentRequest) Descriptor() ([]byte, []int) {
+	return file_backend_protos_admin_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *UpdateDepartmentRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *UpdateDepartmentRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateDepartmentRequest) GetAdminId() string {
+	if x != nil {
+		return x.AdminId
+	}
+	return ""
+}
+
+type UpdateDepartmentResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Success        bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message        string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Department     *Department            `protobuf:"bytes,3,opt,name=department,proto3" json:"department,omitempty"`
+	FacultyUpdated int32                  `protobuf:"varint,4,opt,name=faculty_updated,json=facultyUpdated,proto3" json:"faculty_updated,omitempty"` // faculty whose department was renamed with it
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *UpdateDepartmentResponse) Reset() {
+	*x = UpdateDepartmentResponse{}
+	mi := &file_backend_protos_admin_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateDepartmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateDepartmentResponse) ProtoMessage() {}
+
+func (x *UpdateDepartmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_protos_admin_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateDepartmentResponse.ProtoReflect.Descriptor instead.
+func (*UpdateDepartmentResponse) Descriptor() ([]byte, []int) {
+	return file_backend_protos_admin_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *UpdateDepartmentResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *UpdateDepartmentResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *UpdateDepartmentResponse) GetDepartment() *Department {
+	if x != nil {
+		return x.Department
+	}
+	return nil
+}
+
+func (x *UpdateDepartmentResponse) GetFacultyUpdated() int32 {
+	if x != nil {
+		return x.FacultyUpdated
+	}
+	return 0
+}
+
+// Refused while any course or faculty member is in the department
+type DeleteDepartmentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	AdminId       string                 `protobuf:"bytes,2,opt,name=admin_id,json=adminId,proto3" json:"admin_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteDepartmentRequest) Reset() {
+	*x = DeleteDepartmentRequest{}
+	mi := &file_backend_protos_admin_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteDepartmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteDepartmentRequest) ProtoMessage() {}
+
+func (x *DeleteDepartmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_protos_admin_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteDepartmentRequest.ProtoReflect.Descriptor instead.
+func (*DeleteDepartmentRequest) Descriptor() ([]byte, []int) {
+	return file_backend_protos_admin_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *DeleteDepartmentRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *DeleteDepartmentRequest) GetAdminId() string {
+	if x != nil {
+		return x.AdminId
+	}
+	return ""
+}
+
+type DeleteDepartmentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteDepartmentResponse) Reset() {
+	*x = DeleteDepartmentResponse{}
+	mi := &file_backend_protos_admin_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteDepartmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteDepartmentResponse) ProtoMessage() {}
+
+func (x *DeleteDepartmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_protos_admin_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteDepartmentResponse.ProtoReflect.Descriptor instead.
+func (*DeleteDepartmentResponse) Descriptor() ([]byte, []int) {
+	return file_backend_protos_admin_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *DeleteDepartmentResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *DeleteDepartmentResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// Request/Response messages - User Management
+type CreateUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	Role          string                 `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"` // student, faculty, admin
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	StudentId     string                 `protobuf:"bytes,4,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`  // if role=student
+	FacultyId     string                 `protobuf:"bytes,5,opt,name=faculty_id,json=facultyId,proto3" json:"faculty_id,omitempty"`  // if role=faculty
+	Department    string                 `protobuf:"bytes,6,opt,name=department,proto3" json:"department,omitempty"`                 // if role=faculty
+	Major         string                 `protobuf:"bytes,7,opt,name=major,proto3" json:"major,omitempty"`                           // if role=student
+	YearLevel     int32                  `protobuf:"varint,8,opt,name=year_level,json=yearLevel,proto3" json:"year_level,omitempty"` // if role=student
+	AdminId       string                 `protobuf:"bytes,9,opt,name=admin_id,json=adminId,proto3" json:"admin_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateUserRequest) Reset() {
+	*x = CreateUserRequest{}
+	mi := &file_backend_protos_admin_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateUserRequest) ProtoMessage() {}
+
+func (x *CreateUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_protos_admin_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateUserRequest.ProtoReflect.Descriptor instead.
+func (*CreateUserRequest) Descriptor() ([]byte, []int) {
+	return file_backend_protos_admin_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *CreateUserRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *CreateUserRequest) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *CreateUserRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateUserRequest) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *CreateUserRequest) GetFacultyId() string {
+	if x != nil {
+		return x.FacultyId
+	}
+	return ""
+}
+
+func (x *CreateUserRequest) GetDepartment() string {
+	if x != nil {
+		return x.Department
+	}
+	return ""
+}
+
+func (x *CreateUserRequest) GetMajor() string {
+	if x != nil {
+		return x.Major
+	}
+	return ""
+}
+
+func (x *CreateUserRequest) GetYearLevel() int32 {
+	if x != nil {
+		return x.YearLevel
+	}
+	return 0
+}
+
+func (x *CreateUserRequest) GetAdminId() string {
+	if x != nil {
+		return x.AdminId
+	}
+	return ""
+}
+
+type CreateUserResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Success         bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	UserId          string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	InitialPassword string                 `protobuf:"bytes,3,opt,name=initial_password,json=initialPassword,proto3" json:"initial_password,omitempty"` // displayed once
+	User            *User                  `protobuf:"bytes,4,opt,name=user,proto3" json:"user,omitempty"`
+	Message         string                 `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CreateUserResponse) Reset() {
+	*x = CreateUserResponse{}
+	mi := &file_backend_protos_admin_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateUserResponse) ProtoMessage() {}
+
+func (x *CreateUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_protos_admin_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateUserResponse.ProtoReflect.Descriptor instead.
+func (*CreateUserResponse) Descriptor() ([]byte, []int) {
+	return file_backend_protos_admin_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *CreateUserResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *CreateUserResponse) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CreateUserResponse) GetInitialPassword() string {
+	if x != nil {
+		return x.InitialPassword
+	}
+	return ""
+}
+
+func (x *CreateUserResponse) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *CreateUserResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type ListUsersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Role          string                 `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"` // optional filter
+	ActiveOnly    bool                   `protobuf:"varint,2,opt,name=active_only,json=activeOnly,proto3" json:"active_only,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUsersRequest) Reset() {
+	*x = ListUsersRequest{}
+	mi := &file_backend_protos_admin_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUsersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUsersRequest) ProtoMessage() {}
+
+func (x *ListUsersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_protos_admin_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUsersRequest.ProtoReflect.Descriptor instead.
+func (*ListUsersRequest) Descriptor() ([]byte, []int) {
+	return file_backend_protos_admin_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ListUsersRequest) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *ListUsersRequest) GetActiveOnly() bool {
+	if x != nil {
+		return x.ActiveOnly
+	}
+	return false
+}
+
+type ListUsersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Users         []*User                `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	TotalCount    int32                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUsersResponse) Reset() {
+	*x = ListUsersResponse{}
+	mi := &file_backend_protos_admin_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUsersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUsersResponse) ProtoMessage() {}
+
+func (x *ListUsersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_protos_admin_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUsersResponse.ProtoReflect.Descriptor instead.
+func (*ListUsersResponse) Descriptor() ([]byte, []int) {
+	return file_backend_protos_admin_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ListUsersResponse) GetUsers() []*User {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+func (x *ListUsersResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+type GetUserDetailRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserDetailRequest) Reset() {
+	*x = GetUserDetailRequest{}
+	mi := &file_backend_protos_admin_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserDetailRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserDetailRequest) ProtoMessage() {}
+
+func (x *GetUserDetailRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_protos_admin_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserDetailRequest.ProtoReflect.Descriptor instead.
+func (*GetUserDetailRequest) Descriptor() ([]byte, []int) {
+	return file_backend_protos_admin_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *GetUserDetailRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type UserEnrollment struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EnrollmentId  string                 `protobuf:"bytes,1,opt,name=enrollment_id,json=enrollmentId,proto3" json:"enrollment_id,omitempty"`
+	CourseId      string                 `protobuf:"bytes,2,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	CourseCode    string                 `protobuf:"bytes,3,opt,name=course_code,json=courseCode,proto3" json:"course_code,omitempty"`
+	CourseTitle   string                 `protobuf:"bytes,4,opt,name=course_title,json=courseTitle,proto3" json:"course_title,omitempty"`
+	Units         int32                  `protobuf:"varint,5,opt,name=units,proto3" json:"units,omitempty"`
+	Status        string                 `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserEnrollment) Reset() {
+	*x = UserEnrollment{}
+	mi := &file_backend_protos_admin_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserEnrollment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserEnrollment) ProtoMessage() {}
+
+func (x *UserEnrollment) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_protos_admin_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserEnrollment.ProtoReflect.Descriptor instead.
+func (*UserEnrollment) Descriptor() ([]byte, []int) {
+	return file_backend_protos_admin_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *UserEnrollment) GetEnrollmentId() string {
+	if x != nil {
+		return x.EnrollmentId
+	}
+	return ""
+}
+
+func (x *UserEnrollment) GetCourseId() string {
+	if x != nil {
+		return x.CourseId
+	}
+	return ""
+}
+
+func (x *UserEnrollment) GetCourseCode() string {
+	if x != nil {
+		return x.CourseCode
+	}
+	return ""
+}
+
+func (x *UserEnrollment) GetCourseTitle() string {
+	if x != nil {
+		return x.CourseTitle
+	}
+	return ""
+}
+
+func (x *UserEnrollment) GetUnits() int32 {
+	if x != nil {
+		return x.Units
+	}
+	return 0
+}
+
+func (x *UserEnrollment) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type GetUserDetailResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Success        bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message        string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	User           *User                  `protobuf:"bytes,3,opt,name=user,proto3" json:"user,omitempty"`
+	Semester       string                 `protobuf:"bytes,4,opt,name=semester,proto3" json:"semester,omitempty"`                                    // current semester the enrollments and courses are taken from
+	ActiveSessions int32                  `protobuf:"varint,5,opt,name=active_sessions,json=activeSessions,proto3" json:"active_sessions,omitempty"` // unexpired sessions
+	// Students only
+	Enrollments []*UserEnrollment `protobuf:"bytes,6,rep,name=enrollments,proto3" json:"enrollments,omitempty"`
+	Cgpa        float64           `protobuf:"fixed64,7,opt,name=cgpa,proto3" json:"cgpa,omitempty"` // from published grades; 0 when none are published
+	// Faculty only
+	AssignedCourses []*Course `protobuf:"bytes,8,rep,name=assigned_courses,json=assignedCourses,proto3" json:"assigned_courses,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetUserDetailResponse) Reset() {
+	*x = GetUserDetailResponse{}
+	mi := &file_backend_protos_admin_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserDetailResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserDetailResponse) ProtoMessage() {}
+
+func (x *GetUserDetailResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_protos_admin_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserDetailResponse.ProtoReflect.Descriptor instead.
+func (*GetUserDetailResponse) Descriptor() ([]byte, []int) {
+	return file_backend_protos_admin_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *GetUserDetailResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *GetUserDetailResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *GetUserDetailResponse) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *GetUserDetailResponse) GetSemester() string {
+	if x != nil {
+		return x.Semester
+	}
+	return ""
+}
+
+func (x *GetUserDetailResponse) GetActiveSessions() int32 {
+	if x != nil {
+		return x.ActiveSessions
+	}
+	return 0
+}
+
+func (x *GetUserDetailResponse) GetEnrollments() []*UserEnrollment {
+	if x != nil {
+		return x.Enrollments
+	}
+	return nil
+}
+
+func (x *GetUserDetailResponse) GetCgpa() float64 {
+	if x != nil {
+		return x.Cgpa
+	}
+	return 0
+}
+
+func (x *GetUserDetailResponse) GetAssignedCourses() []*Course {
+	if x != nil {
+		return x.AssignedCourses
+	}
+	return nil
+}
+
+type ResetPasswordRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResetPasswordRequest) Reset() {
+	*x = ResetPasswordRequest{}
+	mi := &file_backend_protos_admin_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResetPasswordRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetPasswordRequest) ProtoMessage() {}
+
+func (x *ResetPasswordRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_protos_admin_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetPasswordRequest.ProtoReflect.Descriptor instead.
+func (*ResetPasswordRequest) Descriptor() ([]byte, []int) {
+	return file_backend_protos_admin_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *ResetPasswordRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type ResetPasswordResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	NewPassword   string                 `protobuf:"bytes,2,opt,name=new_password,json=newPassword,proto3" json:"new_password,omitempty"` // displayed once
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResetPasswordResponse) Reset() {
+	*x = ResetPasswordResponse{}
+	mi := &file_backend_protos_admin_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResetPasswordResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetPasswordResponse) ProtoMessage() {}
+
+func (x *ResetPasswordResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_protos_admin_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetPasswordResponse.ProtoReflect.Descriptor instead.
+func (*ResetPasswordResponse) Descriptor() ([]byte, []int) {
+	return file_backend_protos_admin_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ResetPasswordResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ResetPasswordResponse) GetNewPassword() string {
+	if x != nil {
+		return x.NewPassword
+	}
+	return ""
+}
+
+func (x *ResetPasswordResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type ToggleUserStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Activate      bool                   `protobuf:"varint,2,opt,name=activate,proto3" json:"activate,omitempty"` // true=activate, false=deactivate
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ToggleUserStatusRequest) Reset() {
+	*x = ToggleUserStatusRequest{}
+	mi := &file_backend_protos_admin_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ToggleUserStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ToggleUserStatusRequest) ProtoMessage() {}
+
+func (x *ToggleUserStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_protos_admin_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ToggleUserStatusRequest.ProtoReflect.Descriptor instead.
+func (*ToggleUserStatusRequest) Descriptor() ([]byte, []int) {
+	return file_backend_protos_admin_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ToggleUserStatusRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ToggleUserStatusRequest) GetActivate() bool {
+	if x != nil {
+		return x.Activate
+	}
+	return false
+}
+
+type ToggleUserStatusResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ToggleUserStatusResponse) Reset() {
+	*x = ToggleUserStatusResponse{}
+	mi := &file_backend_protos_admin_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ToggleUserStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ToggleUserStatusResponse) ProtoMessage() {}
+
+func (x *ToggleUserStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_protos_admin_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ToggleUserStatusResponse.ProtoReflect.Descriptor instead.
+func (*ToggleUserStatusResponse) Descriptor() ([]byte, []int) {
+	return file_backend_protos_admin_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ToggleUserStatusResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ToggleUserStatusResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type TransferCredit struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Id                string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	StudentId         string                 `protobuf:"bytes,2,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
+	CourseCode        string                 `protobuf:"bytes,3,opt,name=course_code,json=courseCode,proto3" json:"course_code,omitempty"`
+	EquivalentUnits   int32                  `protobuf:"varint,4,opt,name=equivalent_units,json=equivalentUnits,proto3" json:"equivalent_units,omitempty"`
+	SourceInstitution string                 `protobuf:"bytes,5,opt,name=source_institution,json=sourceInstitution,proto3" json:"source_institution,omitempty"`
+	AddedBy           string                 `protobuf:"bytes,6,opt,name=added_by,json=addedBy,proto3" json:"added_by,omitempty"`
+	CreatedAt         *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *TransferCredit) Reset() {
+	*x = TransferCredit{}
+	mi := &file_backend_protos_admin_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransferCredit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferCredit) ProtoMessage() {}
+
+func (x *TransferCredit) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_protos_admin_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferCredit.ProtoReflect.Descriptor instead.
+func (*TransferCredit) Descriptor() ([]byte, []int) {
+	return file_backend_protos_admin_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *TransferCredit) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *TransferCredit) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *TransferCredit) GetCourseCode() string {
+	if x != nil {
+		return x.CourseCode
+	}
+	return ""
+}
+
+func (x *TransferCredit) GetEquivalentUnits() int32 {
+	if x != nil {
+		return x.EquivalentUnits
+	}
+	return 0
+}
+
+func (x *TransferCredit) GetSourceInstitution() string {
+	if x != nil {
+		return x.SourceInstitution
+	}
+	return ""
+}
+
+func (x *TransferCredit) GetAddedBy() string {
+	if x != nil {
+		return x.AddedBy
+	}
+	return ""
+}
+
+func (x *TransferCredit) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type AddTransferCreditRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	StudentId         string                 `protobuf:"bytes,1,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`    // student number or user ID
+	CourseCode        string                 `protobuf:"bytes,2,opt,name=course_code,json=courseCode,proto3" json:"course_code,omitempty"` // local course code the credit is equivalent to
+	EquivalentUnits   int32                  `protobuf:"varint,3,opt,name=equivalent_units,json=equivalentUnits,proto3" json:"equivalent_units,omitempty"`
+	SourceInstitution string                 `protobuf:"bytes,4,opt,name=source_institution,json=sourceInstitution,proto3" json:"source_institution,omitempty"`
+	AdminId           string                 `protobuf:"bytes,5,opt,name=admin_id,json=adminId,proto3" json:"admin_id,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *AddTransferCreditRequest) Reset() {
+	*x = AddTransferCreditRequest{}
+	mi := &file_backend_protos_admin_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddTransferCreditRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddTransferCreditRequest) ProtoMessage() {}
+
+func (x *AddTransferCreditRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_protos_admin_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddTransferCreditRequest.ProtoReflect.Descriptor instead.
+func (*AddTransferCreditRequest) Descriptor() ([]byte, []int) {
+	return file_backend_protos_admin_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *AddTransferCreditRequest) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *AddTransferCreditRequest) GetCourseCode() string {
+	if x != nil {
+		return x.CourseCode
+	}
+	return ""
+}
+
+func (x *AddTransferCreditRequest) GetEquivalentUnits() int32 {
+	if x != nil {
+		return x.EquivalentUnits
+	}
+	return 0
+}
+
+func (x *AddTransferCreditRequest) GetSourceInstitution() string {
+	if x != nil {
+		return x.SourceInstitution
+	}
+	return ""
+}
+
+func (x *AddTransferCreditRequest) GetAdminId() string {
+	if x != nil {
+		return x.AdminId
+	}
+	return ""
+}
+
+type AddTransferCreditResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Success        bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message        string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	TransferCredit *TransferCredit        `protobuf:"bytes,3,opt,name=transfer_credit,json=transferCredit,proto3" json:"transfer_credit,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *AddTransferCreditResponse) Reset() {
+	*x = AddTransferCreditResponse{}
+	mi := &file_backend_protos_admin_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddTransferCreditResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddTransferCreditResponse) ProtoMessage() {}
+
+func (x *AddTransferCreditResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_protos_admin_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddTransferCreditResponse.ProtoReflect.Descriptor instead.
+func (*AddTransferCreditResponse) Descriptor() ([]byte, []int) {
+	return file_backend_protos_admin_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *AddTransferCreditResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *AddTransferCreditResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *AddTransferCreditResponse) GetTransferCredit() *TransferCredit {
+	if x != nil {
+		return x.TransferCredit
+	}
+	return nil
+}
+
+// Request/Response messages - Holds
+type Hold struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	StudentId     string                 `protobuf:"bytes,2,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
+	Type          string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"` // "financial", "disciplinary", "academic", "administrative"
+	Reason        string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	Active        bool                   `protobuf:"varint,5,opt,name=active,proto3" json:"active,omitempty"`
+	PlacedBy      string                 `protobuf:"bytes,6,opt,name=placed_by,json=placedBy,proto3" json:"placed_by,omitempty"`
+	PlacedAt      *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=placed_at,json=placedAt,proto3" json:"placed_at,omitempty"`
+	ClearedBy     string                 `protobuf:"bytes,8,opt,name=cleared_by,json=clearedBy,proto3" json:"cleared_by,omitempty"`
+	ClearedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=cleared_at,json=clearedAt,proto3" json:"cleared_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Hold) Reset() {
+	*x = Hold{}
+	mi := &file_backend_protos_admin_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Hold) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Hold) ProtoMessage() {}
+
+func (x *Hold) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_protos_admin_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Hold.ProtoReflect.Descriptor instead.
+func (*Hold) Descriptor() ([]byte, []int) {
+	return file_backend_protos_admin_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *Hold) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Hold) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *Hold) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Hold) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *Hold) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+func (x *Hold) GetPlacedBy() string {
+	if x != nil {
+		return x.PlacedBy
+	}
+	return ""
+}
+
+func (x *Hold) GetPlacedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PlacedAt
+	}
+	return nil
+}
+
+func (x *Hold) GetClearedBy() string {
+	if x != nil {
+		return x.ClearedBy
+	}
+	return ""
+}
+
+func (x *Hold) GetClearedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ClearedAt
+	}
+	return nil
+}
+
+type PlaceHoldRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StudentId     string                 `protobuf:"bytes,1,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"` // student number or user ID
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	AdminId       string                 `protobuf:"bytes,4,opt,name=admin_id,json=adminId,proto3" json:"admin_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlaceHoldRequest) Reset() {
+	*x = PlaceHoldRequest{}
+	mi := &file_backend_protos_admin_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlaceHoldRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlaceHoldRequest) ProtoMessage() {}
+
+func (x *PlaceHoldRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_protos_admin_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlaceHoldRequest.ProtoReflect.Descriptor instead.
+func (*PlaceHoldRequest) Descriptor() ([]byte, []int) {
+	return file_backend_protos_admin_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *PlaceHoldRequest) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *PlaceHoldRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *PlaceHoldRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *PlaceHoldRequest) GetAdminId() string {
+	if x != nil {
+		return x.AdminId
+	}
+	return ""
+}
+
+type PlaceHoldResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Hold          *Hold                  `protobuf:"bytes,3,opt,name=hold,proto3" json:"hold,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlaceHoldResponse) Reset() {
+	*x = PlaceHoldResponse{}
+	mi := &file_backend_protos_admin_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlaceHoldResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlaceHoldResponse) ProtoMessage() {}
+
+func (x *PlaceHoldResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_protos_admin_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlaceHoldResponse.ProtoReflect.Descriptor instead.
+func (*PlaceHoldResponse) Descriptor() ([]byte, []int) {
+	return file_backend_protos_admin_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *PlaceHoldResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *PlaceHoldResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *PlaceHoldResponse) GetHold() *Hold {
+	if x != nil {
+		return x.Hold
+	}
+	return nil
+}
+
+type ClearHoldRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HoldId        string                 `protobuf:"bytes,1,opt,name=hold_id,json=holdId,proto3" json:"hold_id,omitempty"`
+	AdminId       string                 `protobuf:"bytes,2,opt,name=admin_id,json=adminId,proto3" json:"admin_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClearHoldRequest) Reset() {
+	*x = ClearHoldRequest{}
+	mi := &file_backend_protos_admin_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClearHoldRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClearHoldRequest) ProtoMessage() {}
+
+func (x *ClearHoldRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_protos_admin_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClearHoldRequest.ProtoReflect.Descriptor instead.
+func (*ClearHoldRequest) Descriptor() ([]byte, []int) {
+	return file_backend_protos_admin_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *ClearHoldRequest) GetHoldId() string {
+	if x != nil {
+		return x.HoldId
+	}
+	return ""
+}
+
+func (x *ClearHoldRequest) GetAdminId() string {
+	if x != nil {
+		return x.AdminId
+	}
+	return ""
+}
+
+type ClearHoldResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Hold          *Hold                  `protobuf:"bytes,3,opt,name=hold,proto3" json:"hold,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClearHoldResponse) Reset() {
+	*x = ClearHoldResponse{}
+	mi := &file_backend_protos_admin_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClearHoldResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClearHoldResponse) ProtoMessage() {}
+
+func (x *ClearHoldResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_protos_admin_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClearHoldResponse.ProtoReflect.Descriptor instead.
+func (*ClearHoldResponse) Descriptor() ([]byte, []int) {
+	return file_backend_protos_admin_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *ClearHoldResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ClearHoldResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ClearHoldResponse) GetHold() *Hold {
+	if x != nil {
+		return x.Hold
+	}
+	return nil
+}
+
+type GetStudentHoldsRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	StudentId      string                 `protobuf:"bytes,1,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"` // student number or user ID
+	IncludeCleared bool                   `protobuf:"varint,2,opt,name=include_cleared,json=includeCleared,proto3" json:"include_cleared,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetStudentHoldsRequest) Reset() {
+	*x = GetStudentHoldsRequest{}
+	mi := &file_backend_protos_admin_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStudentHoldsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStudentHoldsRequest) ProtoMessage() {}
+
+func (x *GetStudentHoldsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_protos_admin_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStudentHoldsRequest.ProtoReflect.Descriptor instead.
+func (*GetStudentHoldsRequest) Descriptor() ([]byte, []int) {
+	return file_backend_protos_admin_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *GetStudentHoldsRequest) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *GetStudentHoldsRequest) GetIncludeCleared() bool {
+	if x != nil {
+		return x.IncludeCleared
+	}
+	return false
+}
+
+type GetStudentHoldsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Holds         []*Hold                `protobuf:"bytes,3,rep,name=holds,proto3" json:"holds,omitempty"` // newest first
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStudentHoldsResponse) Reset() {
+	*x = GetStudentHoldsResponse{}
+	mi := &file_backend_protos_admin_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStudentHoldsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStudentHoldsResponse) ProtoMessage() {}
+
+func (x *GetStudentHoldsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_protos_admin_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStudentHoldsResponse.ProtoReflect.Descriptor instead.
+func (*GetStudentHoldsResponse) Descriptor() ([]byte, []int) {
+	return file_backend_protos_admin_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *GetStudentHoldsResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *GetStudentHoldsResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *GetStudentHoldsResponse) GetHolds() []*Hold {
+	if x != nil {
+		return x.Holds
+	}
+	return nil
+}
+
+// Request/Response messages - System Configuration
+type SetEnrollmentPeriodRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StartDate     string                 `protobuf:"bytes,1,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"` // RFC 3339 timestamp or YYYY-MM-DD
+	EndDate       string                 `protobuf:"bytes,2,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`       // RFC 3339 timestamp or YYYY-MM-DD; must be after start_date
+	AdminId       string                 `protobuf:"bytes,3,opt,name=admin_id,json=adminId,proto3" json:"admin_id,omitempty"`
+	AllowPastEnd  bool                   `protobuf:"varint,4,opt,name=allow_past_end,json=allowPastEnd,proto3" json:"allow_past_end,omitempty"` // accept an end_date that has already passed, closing enrollment
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetEnrollmentPeriodRequest) Reset() {
+	*x = SetEnrollmentPeriodRequest{}
+	mi := &file_backend_protos_admin_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetEnrollmentPeriodRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetEnrollmentPeriodRequest) ProtoMessage() {}
+
+func (x *SetEnrollmentPeriodRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_protos_admin_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetEnrollmentPeriodRequest.ProtoReflect.Descriptor instead.
+func (*SetEnrollmentPeriodRequest) Descriptor() ([]byte, []int) {
+	return file_backend_protos_admin_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *SetEnrollmentPeriodRequest) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *SetEnrollmentPeriodRequest) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *SetEnrollmentPeriodRequest) GetAdminId() string {
+	if x != nil {
+		return x.AdminId
+	}
+	return ""
+}
+
+func (x *SetEnrollmentPeriodRequest) GetAllowPastEnd() bool {
+	if x != nil {
+		return x.AllowPastEnd
+	}
+	return false
+}
+
+type SetEnrollmentPeriodResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Warning       string                 `protobuf:"bytes,3,opt,name=warning,proto3" json:"warning,omitempty"` // set when allow_past_end let a past end_date through
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetEnrollmentPeriodResponse) Reset() {
+	*x = SetEnrollmentPeriodResponse{}
+	mi := &file_backend_protos_admin_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetEnrollmentPeriodResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetEnrollmentPeriodResponse) ProtoMessage() {}
+
+func (x *SetEnrollmentPeriodResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_protos_admin_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetEnrollmentPeriodResponse.ProtoReflect.Descriptor instead.
+func (*SetEnrollmentPeriodResponse) Descriptor() ([]byte, []int) {
+	return file_backend_protos_admin_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *SetEnrollmentPeriodResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *SetEnrollmentPeriodResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *SetEnrollmentPeriodResponse) GetWarning() string {
+	if x != nil {
+		return x.Warning
+	}
+	return ""
+}
+
+type ToggleEnrollmentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Enable        bool                   `protobuf:"varint,1,opt,name=enable,proto3" json:"enable,omitempty"`
+	AdminId       string                 `protobuf:"bytes,2,opt,name=admin_id,json=adminId,proto3" json:"admin_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ToggleEnrollmentRequest) Reset() {
+	*x = ToggleEnrollmentRequest{}
+	mi := &file_backend_protos_admin_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ToggleEnrollmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ToggleEnrollmentRequest) ProtoMessage() {}
+
+func (x *ToggleEnrollmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_protos_admin_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ToggleEnrollmentRequest.ProtoReflect.Descriptor instead.
+func (*ToggleEnrollmentRequest) Descriptor() ([]byte, []int) {
+	return file_backend_protos_admin_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *ToggleEnrollmentRequest) GetEnable() bool {
+	if x != nil {
+		return x.Enable
+	}
+	return false
+}
+
+func (x *ToggleEnrollmentRequest) GetAdminId() string {
+	if x != nil {
+		return x.AdminId
+	}
+	return ""
+}
+
+type ToggleEnrollmentResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Success        bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	EnrollmentOpen bool                   `protobuf:"varint,2,opt,name=enrollment_open,json=enrollmentOpen,proto3" json:"enrollment_open,omitempty"`
+	Message        string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ToggleEnrollmentResponse) Reset() {
+	*x = ToggleEnrollmentResponse{}
+	mi := &file_backend_protos_admin_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ToggleEnrollmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ToggleEnrollmentResponse) ProtoMessage() {}
+
+func (x *ToggleEnrollmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_protos_admin_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ToggleEnrollmentResponse.ProtoReflect.Descriptor instead.
+func (*ToggleEnrollmentResponse) Descriptor() ([]byte, []int) {
+	return file_backend_protos_admin_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *ToggleEnrollmentResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ToggleEnrollmentResponse) GetEnrollmentOpen() bool {
+	if x != nil {
+		return x.EnrollmentOpen
+	}
+	return false
+}
+
+func (x *ToggleEnrollmentResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type GetSystemConfigRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"` // optional, if empty returns all
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSystemConfigRequest) Reset() {
+	*x = GetSystemConfigRequest{}
+	mi := &file_backend_protos_admin_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSystemConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSystemConfigRequest) ProtoMessage() {}
+
+func (x *GetSystemConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_protos_admin_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSystemConfigRequest.ProtoReflect.Descriptor instead.
+func (*GetSystemConfigRequest) Descriptor() ([]byte, []int) {
+	return file_backend_protos_admin_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *GetSystemConfigRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+type GetSystemConfigResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Configs       []*SystemConfig        `protobuf:"bytes,1,rep,name=configs,proto3" json:"configs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSystemConfigResponse) Reset() {
+	*x = GetSystemConfigResponse{}
+	mi := &file_backend_protos_admin_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSystemConfigResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSystemConfigResponse) ProtoMessage() {}
+
+func (x *GetSystemConfigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_protos_admin_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSystemConfigResponse.ProtoReflect.Descriptor instead.
+func (*GetSystemConfigResponse) Descriptor() ([]byte, []int) {
+	return file_backend_protos_admin_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *GetSystemConfigResponse) GetConfigs() []*SystemConfig {
+	if x != nil {
+		return x.Configs
+	}
+	return nil
+}
+
+type UpdateSystemConfigRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value         string                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	AdminId       string                 `protobuf:"bytes,3,opt,name=admin_id,json=adminId,proto3" json:"admin_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateSystemConfigRequest) Reset() {
+	*x = UpdateSystemConfigRequest{}
+	mi := &file_backend_protos_admin_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateSystemConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSystemConfigRequest) ProtoMessage() {}
+
+func (x *UpdateSystemConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_protos_admin_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSystemConfigRequest.ProtoReflect.Descriptor instead.
+func (*UpdateSystemConfigRequest) Descriptor() ([]byte, []int) {
+	return file_backend_protos_admin_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *UpdateSystemConfigRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *UpdateSystemConfigRequest) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *UpdateSystemConfigRequest) GetAdminId() string {
+	if x != nil {
+		return x.AdminId
+	}
+	return ""
+}
+
+type UpdateSystemConfigResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateSystemConfigResponse) Reset() {
+	*x = UpdateSystemConfigResponse{}
+	mi := &file_backend_protos_admin_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateSystemConfigResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSystemConfigResponse) ProtoMessage() {}
+
+func (x *UpdateSystemConfigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_protos_admin_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSystemConfigResponse.ProtoReflect.Descriptor instead.
+func (*UpdateSystemConfigResponse) Descriptor() ([]byte, []int) {
+	return file_backend_protos_admin_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *UpdateSystemConfigResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *UpdateSystemConfigResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// Request/Response messages - Overrides
+type OverrideEnrollmentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StudentId     string                 `protobuf:"bytes,1,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
+	CourseId      string                 `protobuf:"bytes,2,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	Action        string                 `protobuf:"bytes,3,opt,name=action,proto3" json:"action,omitempty"` // "force_enroll" or "force_drop"
+	Reason        string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	AdminId       string                 `protobuf:"bytes,5,opt,name=admin_id,json=adminId,proto3" json:"admin_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OverrideEnrollmentRequest) Reset() {
+	*x = OverrideEnrollmentRequest{}
+	mi := &file_backend_protos_admin_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OverrideEnrollmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OverrideEnrollmentRequest) ProtoMessage() {}
+
+func (x *OverrideEnrollmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_protos_admin_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OverrideEnrollmentRequest.ProtoReflect.Descriptor instead.
+func (*OverrideEnrollmentRequest) Descriptor() ([]byte, []int) {
+	return file_backend_protos_admin_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *OverrideEnrollmentRequest) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *OverrideEnrollmentRequest) GetCourseId() string {
+	if x != nil {
+		return x.CourseId
+	}
+	return ""
+}
+
+func (x *OverrideEnrollmentRequest) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *OverrideEnrollmentRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *OverrideEnrollmentRequest) GetAdminId() string {
+	if x != nil {
+		return x.AdminId
+	}
+	return ""
+}
+
+type OverrideEnrollmentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Enrolled      int32                  `protobuf:"varint,3,opt,name=enrolled,proto3" json:"enrolled,omitempty"` // course count after the override
+	Capacity      int32                  `protobuf:"varint,4,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	CourseClosed  bool                   `protobuf:"varint,5,opt,name=course_closed,json=courseClosed,proto3" json:"course_closed,omitempty"` // force_enroll filled the course and closed it
+	ErrorCode     string                 `protobuf:"bytes,6,opt,name=error_code,json=errorCode,proto3" json:"error_code,omitempty"`           // machine-readable failure reason (see shared.ErrorCode)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OverrideEnrollmentResponse) Reset() {
+	*x = OverrideEnrollmentResponse{}
+	mi := &file_backend_protos_admin_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OverrideEnrollmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OverrideEnrollmentResponse) ProtoMessage() {}
+
+func (x *OverrideEnrollmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_protos_admin_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OverrideEnrollmentResponse.ProtoReflect.Descriptor instead.
+func (*OverrideEnrollmentResponse) Descriptor() ([]byte, []int) {
+	return file_backend_protos_admin_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *OverrideEnrollmentResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *OverrideEnrollmentResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *OverrideEnrollmentResponse) GetEnrolled() int32 {
+	if x != nil {
+		return x.Enrolled
+	}
+	return 0
+}
+
+func (x *OverrideEnrollmentResponse) GetCapacity() int32 {
+	if x != nil {
+		return x.Capacity
+	}
+	return 0
+}
+
+func (x *OverrideEnrollmentResponse) GetCourseClosed() bool {
+	if x != nil {
+		return x.CourseClosed
+	}
+	return false
+}
+
+func (x *OverrideEnrollmentResponse) GetErrorCode() string {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return ""
+}
+
+type RestoreEnrollmentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EnrollmentId  string                 `protobuf:"bytes,1,opt,name=enrollment_id,json=enrollmentId,proto3" json:"enrollment_id,omitempty"`
+	Force         bool                   `protobuf:"varint,2,opt,name=force,proto3" json:"force,omitempty"` // restore even when the course is full
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	AdminId       string                 `protobuf:"bytes,4,opt,name=admin_id,json=adminId,proto3" json:"admin_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreEnrollmentRequest) Reset() {
+	*x = RestoreEnrollmentRequest{}
+	mi := &file_backend_protos_admin_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreEnrollmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreEnrollmentRequest) ProtoMessage() {}
+
+func (x *RestoreEnrollmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_protos_admin_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreEnrollmentRequest.ProtoReflect.Descriptor instead.
+func (*RestoreEnrollmentRequest) Descriptor() ([]byte, []int) {
+	return file_backend_protos_admin_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *RestoreEnrollmentRequest) GetEnrollmentId() string {
+	if x != nil {
+		return x.EnrollmentId
+	}
+	return ""
+}
+
+func (x *RestoreEnrollmentRequest) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
+func (x *RestoreEnrollmentRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *RestoreEnrollmentRequest) GetAdminId() string {
+	if x != nil {
+		return x.AdminId
+	}
+	return ""
+}
+
+type RestoreEnrollmentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Outcome       string                 `protobuf:"bytes,3,opt,name=outcome,proto3" json:"outcome,omitempty"` // "restored", "restored_over_capacity" or "refused"
+	EnrollmentId  string                 `protobuf:"bytes,4,opt,name=enrollment_id,json=enrollmentId,proto3" json:"enrollment_id,omitempty"`
+	Enrolled      int32                  `protobuf:"varint,5,opt,name=enrolled,proto3" json:"enrolled,omitempty"` // course count after the restore
+	Capacity      int32                  `protobuf:"varint,6,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreEnrollmentResponse) Reset() {
+	*x = RestoreEnrollmentResponse{}
+	mi := &file_backend_protos_admin_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreEnrollmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreEnrollmentResponse) ProtoMessage() {}
+
+func (x *RestoreEnrollmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_protos_admin_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreEnrollmentResponse.ProtoReflect.Descriptor instead.
+func (*RestoreEnrollmentResponse) Descriptor() ([]byte, []int) {
+	return file_backend_protos_admin_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *RestoreEnrollmentResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *RestoreEnrollmentResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *RestoreEnrollmentResponse) GetOutcome() string {
+	if x != nil {
+		return x.Outcome
+	}
+	return ""
+}
+
+func (x *RestoreEnrollmentResponse) GetEnrollmentId() string {
+	if x != nil {
+		return x.EnrollmentId
+	}
+	return ""
+}
+
+func (x *RestoreEnrollmentResponse) GetEnrolled() int32 {
+	if x != nil {
+		return x.Enrolled
+	}
+	return 0
+}
+
+func (x *RestoreEnrollmentResponse) GetCapacity() int32 {
+	if x != nil {
+		return x.Capacity
+	}
+	return 0
+}
+
+type RecalculateEnrollmentCountsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Semester      string                 `protobuf:"bytes,1,opt,name=semester,proto3" json:"semester,omitempty"` // optional; all courses when empty
+	AdminId       string                 `protobuf:"bytes,2,opt,name=admin_id,json=adminId,proto3" json:"admin_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecalculateEnrollmentCountsRequest) Reset() {
+	*x = RecalculateEnrollmentCountsRequest{}
+	mi := &file_backend_protos_admin_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecalculateEnrollmentCountsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecalculateEnrollmentCountsRequest) ProtoMessage() {}
+
+func (x *RecalculateEnrollmentCountsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_protos_admin_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecalculateEnrollmentCountsRequest.ProtoReflect.Descriptor instead.
+func (*RecalculateEnrollmentCountsRequest) Descriptor() ([]byte, []int) {
+	return file_backend_protos_admin_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *RecalculateEnrollmentCountsRequest) GetSemester() string {
+	if x != nil {
+		return x.Semester
+	}
+	return ""
+}
+
+func (x *RecalculateEnrollmentCountsRequest) GetAdminId() string {
+	if x != nil {
+		return x.AdminId
+	}
+	return ""
+}
+
+type EnrollmentCountCorrection struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CourseId      string                 `protobuf:"bytes,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	CourseCode    string                 `protobuf:"bytes,2,opt,name=course_code,json=courseCode,proto3" json:"course_code,omitempty"`
+	PreviousCount int32                  `protobuf:"varint,3,opt,name=previous_count,json=previousCount,proto3" json:"previous_count,omitempty"`
+	ActualCount   int32                  `protobuf:"varint,4,opt,name=actual_count,json=actualCount,proto3" json:"actual_count,omitempty"`
+	Delta         int32                  `protobuf:"varint,5,opt,name=delta,proto3" json:"delta,omitempty"` // actual_count - previous_count
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EnrollmentCountCorrection) Reset() {
+	*x = EnrollmentCountCorrection{}
+	mi := &file_backend_protos_admin_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EnrollmentCountCorrection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnrollmentCountCorrection) ProtoMessage() {}
+
+func (x *EnrollmentCountCorrection) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_protos_admin_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnrollmentCountCorrection.ProtoReflect.Descriptor instead.
+func (*EnrollmentCountCorrection) Descriptor() ([]byte, []int) {
+	return file_backend_protos_admin_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *EnrollmentCountCorrection) GetCourseId() string {
+	if x != nil {
+		return x.CourseId
+	}
+	return ""
+}
+
+func (x *EnrollmentCountCorrection) GetCourseCode() string {
+	if x != nil {
+		return x.CourseCode
+	}
+	return ""
+}
+
+func (x *EnrollmentCountCorrection) GetPreviousCount() int32 {
+	if x != nil {
+		return x.PreviousCount
+	}
+	return 0
+}
+
+func (x *EnrollmentCountCorrection) GetActualCount() int32 {
+	if x != nil {
+		return x.ActualCount
+	}
+	return 0
+}
+
+func (x *EnrollmentCountCorrection) GetDelta() int32 {
+	if x != nil {
+		return x.Delta
+	}
+	return 0
+}
+
+type RecalculateEnrollmentCountsResponse struct {
+	state          protoimpl.MessageState       `protogen:"open.v1"`
+	Success        bool                         `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message        string                       `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	CoursesChecked int32                        `protobuf:"varint,3,opt,name=courses_checked,json=coursesChecked,proto3" json:"courses_checked,omitempty"`
+	Corrections    []*EnrollmentCountCorrection `protobuf:"bytes,4,rep,name=corrections,proto3" json:"corrections,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *RecalculateEnrollmentCountsResponse) Reset() {
+	*x = RecalculateEnrollmentCountsResponse{}
+	mi := &file_backend_protos_admin_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecalculateEnrollmentCountsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecalculateEnrollmentCountsResponse) ProtoMessage() {}
+
+func (x *RecalculateEnrollmentCountsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_protos_admin_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecalculateEnrollmentCountsResponse.ProtoReflect.Descriptor instead.
+func (*RecalculateEnrollmentCountsResponse) Descriptor() ([]byte, []int) {
+	return file_backend_protos_admin_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *RecalculateEnrollmentCountsResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *RecalculateEnrollmentCountsResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *RecalculateEnrollmentCountsResponse) GetCoursesChecked() int32 {
+	if x != nil {
+		return x.CoursesChecked
+	}
+	return 0
+}
+
+func (x *RecalculateEnrollmentCountsResponse) GetCorrections() []*EnrollmentCountCorrection {
+	if x != nil {
+		return x.Corrections
+	}
+	return nil
+}
+
+type CloseSemesterRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Semester      string                 `protobuf:"bytes,1,opt,name=semester,proto3" json:"semester,omitempty"`
+	AdminId       string                 `protobuf:"bytes,2,opt,name=admin_id,json=adminId,proto3" json:"admin_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CloseSemesterRequest) Reset() {
+	*x = CloseSemesterRequest{}
+	mi := &file_backend_protos_admin_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CloseSemesterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloseSemesterRequest) ProtoMessage() {}
+
+func (x *CloseSemesterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_protos_admin_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *CreateUserRequest) GetRole() string {
+// Deprecated: Use CloseSemesterRequest.ProtoReflect.Descriptor instead.
+func (*CloseSemesterRequest) Descriptor() ([]byte, []int) {
+	return file_backend_protos_admin_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *CloseSemesterRequest) GetSemester() string {
 	if x != nil {
-		return x.Role
+		return x.Semester
 	}
 	return ""
 }
 
-func (x *CreateUserRequest) GetName() string {
+func (x *CloseSemesterRequest) GetAdminId() string {
 	if x != nil {
-		return x.Name
+		return x.AdminId
 	}
 	return ""
 }
 
-func (x *CreateUserRequest) GetStudentId() string {
+type CloseSemesterResponse struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Success              bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message              string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	CoursesClosed        int32                  `protobuf:"varint,3,opt,name=courses_closed,json=coursesClosed,proto3" json:"courses_closed,omitempty"`
+	EnrollmentsCompleted int32                  `protobuf:"varint,4,opt,name=enrollments_completed,json=enrollmentsCompleted,proto3" json:"enrollments_completed,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *CloseSemesterResponse) Reset() {
+	*x = CloseSemesterResponse{}
+	mi := &file_backend_protos_admin_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CloseSemesterResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloseSemesterResponse) ProtoMessage() {}
+
+func (x *CloseSemesterResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_protos_admin_proto_msgTypes[69]
 	if x != nil {
-		return x.StudentId
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *CreateUserRequest) GetFacultyId() string {
+// Deprecated: Use CloseSemesterResponse.ProtoReflect.Descriptor instead.
+func (*CloseSemesterResponse) Descriptor() ([]byte, []int) {
+	return file_backend_protos_admin_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *CloseSemesterResponse) GetSuccess() bool {
 	if x != nil {
-		return x.FacultyId
+		return x.Success
 	}
-	return ""
+	return false
 }
 
-func (x *CreateUserRequest) GetDepartment() string {
+func (x *CloseSemesterResponse) GetMessage() string {
 	if x != nil {
-		return x.Department
+		return x.Message
 	}
 	return ""
 }
 
-func (x *CreateUserRequest) GetMajor() string {
+func (x *CloseSemesterResponse) GetCoursesClosed() int32 {
 	if x != nil {
-		return x.Major
+		return x.CoursesClosed
 	}
-	return ""
+	return 0
 }
 
-func (x *CreateUserRequest) GetYearLevel() int32 {
+func (x *CloseSemesterResponse) GetEnrollmentsCompleted() int32 {
 	if x != nil {
-		return x.YearLevel
+		return x.EnrollmentsCompleted
 	}
 	return 0
 }
 
-type CreateUserResponse struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	Success         bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	UserId          string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	InitialPassword string                 `protobuf:"bytes,3,opt,name=initial_password,json=initialPassword,proto3" json:"initial_password,omitempty"` // displayed once
-	User            *User                  `protobuf:"bytes,4,opt,name=user,proto3" json:"user,omitempty"`
-	Message         string                 `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+type PublishAllGradesRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Semester       string                 `protobuf:"bytes,1,opt,name=semester,proto3" json:"semester,omitempty"`
+	AdminId        string                 `protobuf:"bytes,2,opt,name=admin_id,json=adminId,proto3" json:"admin_id,omitempty"`
+	Confirm        bool                   `protobuf:"varint,3,opt,name=confirm,proto3" json:"confirm,omitempty"`                                     // must be true; every faculty's unpublished grades become visible to students
+	NotifyStudents bool                   `protobuf:"varint,4,opt,name=notify_students,json=notifyStudents,proto3" json:"notify_students,omitempty"` // notify each student who had a grade published
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *CreateUserResponse) Reset() {
-	*x = CreateUserResponse{}
-	mi := &file_backend_protos_admin_proto_msgTypes[13]
+func (x *PublishAllGradesRequest) Reset() {
+	*x = PublishAllGradesRequest{}
+	mi := &file_backend_protos_admin_proto_msgTypes[70]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateUserResponse) String() string {
+func (x *PublishAllGradesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateUserResponse) ProtoMessage() {}
+func (*PublishAllGradesRequest) ProtoMessage() {}
 
-func (x *CreateUserResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_backend_protos_admin_proto_msgTypes[13]
+func (x *PublishAllGradesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_protos_admin_proto_msgTypes[70]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1129,69 +5155,65 @@ func (x *CreateUserResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateUserResponse.ProtoReflect.Descriptor instead.
-func (*CreateUserResponse) Descriptor() ([]byte, []int) {
-	return file_backend_protos_admin_proto_rawDescGZIP(), []int{13}
-}
-
-func (x *CreateUserResponse) GetSuccess() bool {
-	if x != nil {
-		return x.Success
-	}
-	return false
+// Deprecated: Use PublishAllGradesRequest.ProtoReflect.Descriptor instead.
+func (*PublishAllGradesRequest) Descriptor() ([]byte, []int) {
+	return file_backend_protos_admin_proto_rawDescGZIP(), []int{70}
 }
 
-func (x *CreateUserResponse) GetUserId() string {
+func (x *PublishAllGradesRequest) GetSemester() string {
 	if x != nil {
-		return x.UserId
+		return x.Semester
 	}
 	return ""
 }
 
-func (x *CreateUserResponse) GetInitialPassword() string {
+func (x *PublishAllGradesRequest) GetAdminId() string {
 	if x != nil {
-		return x.InitialPassword
+		return x.AdminId
 	}
 	return ""
 }
 
-func (x *CreateUserResponse) GetUser() *User {
+func (x *PublishAllGradesRequest) GetConfirm() bool {
 	if x != nil {
-		return x.User
+		return x.Confirm
 	}
-	return nil
+	return false
 }
 
-func (x *CreateUserResponse) GetMessage() string {
+func (x *PublishAllGradesRequest) GetNotifyStudents() bool {
 	if x != nil {
-		return x.Message
+		return x.NotifyStudents
 	}
-	return ""
+	return false
 }
 
-type ListUsersRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Role          string                 `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"` // optional filter
-	ActiveOnly    bool                   `protobuf:"varint,2,opt,name=active_only,json=activeOnly,proto3" json:"active_only,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type PublishAllGradesResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Success          bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message          string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	GradesPublished  int32                  `protobuf:"varint,3,opt,name=grades_published,json=gradesPublished,proto3" json:"grades_published,omitempty"`
+	CoursesAffected  int32                  `protobuf:"varint,4,opt,name=courses_affected,json=coursesAffected,proto3" json:"courses_affected,omitempty"` // courses that had a grade published
+	StudentsNotified int32                  `protobuf:"varint,5,opt,name=students_notified,json=studentsNotified,proto3" json:"students_notified,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
-func (x *ListUsersRequest) Reset() {
-	*x = ListUsersRequest{}
-	mi := &file_backend_protos_admin_proto_msgTypes[14]
+func (x *PublishAllGradesResponse) Reset() {
+	*x = PublishAllGradesResponse{}
+	mi := &file_backend_protos_admin_proto_msgTypes[71]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListUsersRequest) String() string {
+func (x *PublishAllGradesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListUsersRequest) ProtoMessage() {}
+func (*PublishAllGradesResponse) ProtoMessage() {}
 
-func (x *ListUsersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_backend_protos_admin_proto_msgTypes[14]
+func (x *PublishAllGradesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_protos_admin_proto_msgTypes[71]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1202,48 +5224,69 @@ func (x *ListUsersRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListUsersRequest.ProtoReflect.Descriptor instead.
-func (*ListUsersRequest) Descriptor() ([]byte, []int) {
-	return file_backend_protos_admin_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use PublishAllGradesResponse.ProtoReflect.Descriptor instead.
+func (*PublishAllGradesResponse) Descriptor() ([]byte, []int) {
+	return file_backend_protos_admin_proto_rawDescGZIP(), []int{71}
 }
 
-func (x *ListUsersRequest) GetRole() string {
+func (x *PublishAllGradesResponse) GetSuccess() bool {
 	if x != nil {
-		return x.Role
+		return x.Success
+	}
+	return false
+}
+
+func (x *PublishAllGradesResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
 	}
 	return ""
 }
 
-func (x *ListUsersRequest) GetActiveOnly() bool {
+func (x *PublishAllGradesResponse) GetGradesPublished() int32 {
 	if x != nil {
-		return x.ActiveOnly
+		return x.GradesPublished
 	}
-	return false
+	return 0
 }
 
-type ListUsersResponse struct {
+func (x *PublishAllGradesResponse) GetCoursesAffected() int32 {
+	if x != nil {
+		return x.CoursesAffected
+	}
+	return 0
+}
+
+func (x *PublishAllGradesResponse) GetStudentsNotified() int32 {
+	if x != nil {
+		return x.StudentsNotified
+	}
+	return 0
+}
+
+type GetNearlyFullCoursesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Users         []*User                `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
-	TotalCount    int32                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	Semester      string                 `protobuf:"bytes,1,opt,name=semester,proto3" json:"semester,omitempty"`    // optional; all courses when empty
+	Threshold     int32                  `protobuf:"varint,2,opt,name=threshold,proto3" json:"threshold,omitempty"` // fill rate percent (1-100); capacity_warning_threshold config when 0
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListUsersResponse) Reset() {
-	*x = ListUsersResponse{}
-	mi := &file_backend_protos_admin_proto_msgTypes[15]
+func (x *GetNearlyFullCoursesRequest) Reset() {
+	*x = GetNearlyFullCoursesRequest{}
+	mi := &file_backend_protos_admin_proto_msgTypes[72]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListUsersResponse) String() string {
+func (x *GetNearlyFullCoursesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListUsersResponse) ProtoMessage() {}
+func (*GetNearlyFullCoursesRequest) ProtoMessage() {}
 
-func (x *ListUsersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_backend_protos_admin_proto_msgTypes[15]
+func (x *GetNearlyFullCoursesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_protos_admin_proto_msgTypes[72]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1254,47 +5297,54 @@ func (x *ListUsersResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListUsersResponse.ProtoReflect.Descriptor instead.
-func (*ListUsersResponse) Descriptor() ([]byte, []int) {
-	return file_backend_protos_admin_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use GetNearlyFullCoursesRequest.ProtoReflect.Descriptor instead.
+func (*GetNearlyFullCoursesRequest) Descriptor() ([]byte, []int) {
+	return file_backend_protos_admin_proto_rawDescGZIP(), []int{72}
 }
 
-func (x *ListUsersResponse) GetUsers() []*User {
+func (x *GetNearlyFullCoursesRequest) GetSemester() string {
 	if x != nil {
-		return x.Users
+		return x.Semester
 	}
-	return nil
+	return ""
 }
 
-func (x *ListUsersResponse) GetTotalCount() int32 {
+func (x *GetNearlyFullCoursesRequest) GetThreshold() int32 {
 	if x != nil {
-		return x.TotalCount
+		return x.Threshold
 	}
 	return 0
 }
 
-type ResetPasswordRequest struct {
+type NearlyFullCourse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	CourseId      string                 `protobuf:"bytes,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	CourseCode    string                 `protobuf:"bytes,2,opt,name=course_code,json=courseCode,proto3" json:"course_code,omitempty"`
+	Title         string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Semester      string                 `protobuf:"bytes,4,opt,name=semester,proto3" json:"semester,omitempty"`
+	Enrolled      int32                  `protobuf:"varint,5,opt,name=enrolled,proto3" json:"enrolled,omitempty"`
+	Capacity      int32                  `protobuf:"varint,6,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	FillRate      float64                `protobuf:"fixed64,7,opt,name=fill_rate,json=fillRate,proto3" json:"fill_rate,omitempty"` // percent of capacity
+	IsOpen        bool                   `protobuf:"varint,8,opt,name=is_open,json=isOpen,proto3" json:"is_open,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ResetPasswordRequest) Reset() {
-	*x = ResetPasswordRequest{}
-	mi := &file_backend_protos_admin_proto_msgTypes[16]
+func (x *NearlyFullCourse) Reset() {
+	*x = NearlyFullCourse{}
+	mi := &file_backend_protos_admin_proto_msgTypes[73]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ResetPasswordRequest) String() string {
+func (x *NearlyFullCourse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ResetPasswordRequest) ProtoMessage() {}
+func (*NearlyFullCourse) ProtoMessage() {}
 
-func (x *ResetPasswordRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_backend_protos_admin_proto_msgTypes[16]
+func (x *NearlyFullCourse) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_protos_admin_proto_msgTypes[73]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1305,42 +5355,92 @@ func (x *ResetPasswordRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ResetPasswordRequest.ProtoReflect.Descriptor instead.
-func (*ResetPasswordRequest) Descriptor() ([]byte, []int) {
-	return file_backend_protos_admin_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use NearlyFullCourse.ProtoReflect.Descriptor instead.
+func (*NearlyFullCourse) Descriptor() ([]byte, []int) {
+	return file_backend_protos_admin_proto_rawDescGZIP(), []int{73}
 }
 
-func (x *ResetPasswordRequest) GetUserId() string {
+func (x *NearlyFullCourse) GetCourseId() string {
 	if x != nil {
-		return x.UserId
+		return x.CourseId
 	}
 	return ""
 }
 
-type ResetPasswordResponse struct {
+func (x *NearlyFullCourse) GetCourseCode() string {
+	if x != nil {
+		return x.CourseCode
+	}
+	return ""
+}
+
+func (x *NearlyFullCourse) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *NearlyFullCourse) GetSemester() string {
+	if x != nil {
+		return x.Semester
+	}
+	return ""
+}
+
+func (x *NearlyFullCourse) GetEnrolled() int32 {
+	if x != nil {
+		return x.Enrolled
+	}
+	return 0
+}
+
+func (x *NearlyFullCourse) GetCapacity() int32 {
+	if x != nil {
+		return x.Capacity
+	}
+	return 0
+}
+
+func (x *NearlyFullCourse) GetFillRate() float64 {
+	if x != nil {
+		return x.FillRate
+	}
+	return 0
+}
+
+func (x *NearlyFullCourse) GetIsOpen() bool {
+	if x != nil {
+		return x.IsOpen
+	}
+	return false
+}
+
+type GetNearlyFullCoursesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	NewPassword   string                 `protobuf:"bytes,2,opt,name=new_password,json=newPassword,proto3" json:"new_password,omitempty"` // displayed once
-	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Threshold     int32                  `protobuf:"varint,3,opt,name=threshold,proto3" json:"threshold,omitempty"` // threshold actually applied
+	Courses       []*NearlyFullCourse    `protobuf:"bytes,4,rep,name=courses,proto3" json:"courses,omitempty"`      // fullest first
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ResetPasswordResponse) Reset() {
-	*x = ResetPasswordResponse{}
-	mi := &file_backend_protos_admin_proto_msgTypes[17]
+func (x *GetNearlyFullCoursesResponse) Reset() {
+	*x = GetNearlyFullCoursesResponse{}
+	mi := &file_backend_protos_admin_proto_msgTypes[74]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ResetPasswordResponse) String() string {
+func (x *GetNearlyFullCoursesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ResetPasswordResponse) ProtoMessage() {}
+func (*GetNearlyFullCoursesResponse) ProtoMessage() {}
 
-func (x *ResetPasswordResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_backend_protos_admin_proto_msgTypes[17]
+func (x *GetNearlyFullCoursesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_protos_admin_proto_msgTypes[74]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1351,55 +5451,106 @@ func (x *ResetPasswordResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ResetPasswordResponse.ProtoReflect.Descriptor instead.
-func (*ResetPasswordResponse) Descriptor() ([]byte, []int) {
-	return file_backend_protos_admin_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use GetNearlyFullCoursesResponse.ProtoReflect.Descriptor instead.
+func (*GetNearlyFullCoursesResponse) Descriptor() ([]byte, []int) {
+	return file_backend_protos_admin_proto_rawDescGZIP(), []int{74}
 }
 
-func (x *ResetPasswordResponse) GetSuccess() bool {
+func (x *GetNearlyFullCoursesResponse) GetSuccess() bool {
 	if x != nil {
 		return x.Success
 	}
 	return false
 }
 
-func (x *ResetPasswordResponse) GetNewPassword() string {
+func (x *GetNearlyFullCoursesResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *GetNearlyFullCoursesResponse) GetThreshold() int32 {
+	if x != nil {
+		return x.Threshold
+	}
+	return 0
+}
+
+func (x *GetNearlyFullCoursesResponse) GetCourses() []*NearlyFullCourse {
+	if x != nil {
+		return x.Courses
+	}
+	return nil
+}
+
+type GetCourseFillTimelineRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CourseId      string                 `protobuf:"bytes,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCourseFillTimelineRequest) Reset() {
+	*x = GetCourseFillTimelineRequest{}
+	mi := &file_backend_protos_admin_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCourseFillTimelineRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCourseFillTimelineRequest) ProtoMessage() {}
+
+func (x *GetCourseFillTimelineRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_protos_admin_proto_msgTypes[75]
 	if x != nil {
-		return x.NewPassword
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *ResetPasswordResponse) GetMessage() string {
+// Deprecated: Use GetCourseFillTimelineRequest.ProtoReflect.Descriptor instead.
+func (*GetCourseFillTimelineRequest) Descriptor() ([]byte, []int) {
+	return file_backend_protos_admin_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *GetCourseFillTimelineRequest) GetCourseId() string {
 	if x != nil {
-		return x.Message
+		return x.CourseId
 	}
 	return ""
 }
 
-type ToggleUserStatusRequest struct {
+type FillTimelinePoint struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Activate      bool                   `protobuf:"varint,2,opt,name=activate,proto3" json:"activate,omitempty"` // true=activate, false=deactivate
+	Timestamp     *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Enrolled      int32                  `protobuf:"varint,2,opt,name=enrolled,proto3" json:"enrolled,omitempty"` // enrolled count after the changes at this instant
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ToggleUserStatusRequest) Reset() {
-	*x = ToggleUserStatusRequest{}
-	mi := &file_backend_protos_admin_proto_msgTypes[18]
+func (x *FillTimelinePoint) Reset() {
+	*x = FillTimelinePoint{}
+	mi := &file_backend_protos_admin_proto_msgTypes[76]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ToggleUserStatusRequest) String() string {
+func (x *FillTimelinePoint) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ToggleUserStatusRequest) ProtoMessage() {}
+func (*FillTimelinePoint) ProtoMessage() {}
 
-func (x *ToggleUserStatusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_backend_protos_admin_proto_msgTypes[18]
+func (x *FillTimelinePoint) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_protos_admin_proto_msgTypes[76]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1410,48 +5561,52 @@ func (x *ToggleUserStatusRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ToggleUserStatusRequest.ProtoReflect.Descriptor instead.
-func (*ToggleUserStatusRequest) Descriptor() ([]byte, []int) {
-	return file_backend_protos_admin_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use FillTimelinePoint.ProtoReflect.Descriptor instead.
+func (*FillTimelinePoint) Descriptor() ([]byte, []int) {
+	return file_backend_protos_admin_proto_rawDescGZIP(), []int{76}
 }
 
-func (x *ToggleUserStatusRequest) GetUserId() string {
+func (x *FillTimelinePoint) GetTimestamp() *timestamppb.Timestamp {
 	if x != nil {
-		return x.UserId
+		return x.Timestamp
 	}
-	return ""
+	return nil
 }
 
-func (x *ToggleUserStatusRequest) GetActivate() bool {
+func (x *FillTimelinePoint) GetEnrolled() int32 {
 	if x != nil {
-		return x.Activate
+		return x.Enrolled
 	}
-	return false
+	return 0
 }
 
-type ToggleUserStatusResponse struct {
+type GetCourseFillTimelineResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	CourseId      string                 `protobuf:"bytes,3,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	CourseCode    string                 `protobuf:"bytes,4,opt,name=course_code,json=courseCode,proto3" json:"course_code,omitempty"`
+	Capacity      int32                  `protobuf:"varint,5,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	Points        []*FillTimelinePoint   `protobuf:"bytes,6,rep,name=points,proto3" json:"points,omitempty"` // oldest first, one per distinct timestamp
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ToggleUserStatusResponse) Reset() {
-	*x = ToggleUserStatusResponse{}
-	mi := &file_backend_protos_admin_proto_msgTypes[19]
+func (x *GetCourseFillTimelineResponse) Reset() {
+	*x = GetCourseFillTimelineResponse{}
+	mi := &file_backend_protos_admin_proto_msgTypes[77]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ToggleUserStatusResponse) String() string {
+func (x *GetCourseFillTimelineResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ToggleUserStatusResponse) ProtoMessage() {}
+func (*GetCourseFillTimelineResponse) ProtoMessage() {}
 
-func (x *ToggleUserStatusResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_backend_protos_admin_proto_msgTypes[19]
+func (x *GetCourseFillTimelineResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_protos_admin_proto_msgTypes[77]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1462,49 +5617,80 @@ func (x *ToggleUserStatusResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ToggleUserStatusResponse.ProtoReflect.Descriptor instead.
-func (*ToggleUserStatusResponse) Descriptor() ([]byte, []int) {
-	return file_backend_protos_admin_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use GetCourseFillTimelineResponse.ProtoReflect.Descriptor instead.
+func (*GetCourseFillTimelineResponse) Descriptor() ([]byte, []int) {
+	return file_backend_protos_admin_proto_rawDescGZIP(), []int{77}
 }
 
-func (x *ToggleUserStatusResponse) GetSuccess() bool {
+func (x *GetCourseFillTimelineResponse) GetSuccess() bool {
 	if x != nil {
 		return x.Success
 	}
 	return false
 }
 
-func (x *ToggleUserStatusResponse) GetMessage() string {
+func (x *GetCourseFillTimelineResponse) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-// Request/Response messages - System Configuration
-type SetEnrollmentPeriodRequest struct {
+func (x *GetCourseFillTimelineResponse) GetCourseId() string {
+	if x != nil {
+		return x.CourseId
+	}
+	return ""
+}
+
+func (x *GetCourseFillTimelineResponse) GetCourseCode() string {
+	if x != nil {
+		return x.CourseCode
+	}
+	return ""
+}
+
+func (x *GetCourseFillTimelineResponse) GetCapacity() int32 {
+	if x != nil {
+		return x.Capacity
+	}
+	return 0
+}
+
+func (x *GetCourseFillTimelineResponse) GetPoints() []*FillTimelinePoint {
+	if x != nil {
+		return x.Points
+	}
+	return nil
+}
+
+// Exactly one of course_id or department is required
+type GetEnrollmentTrendRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	StartDate     string                 `protobuf:"bytes,1,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"` // ISO 8601 format
-	EndDate       string                 `protobuf:"bytes,2,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`       // ISO 8601 format
+	CourseId      string                 `protobuf:"bytes,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	Department    string                 `protobuf:"bytes,2,opt,name=department,proto3" json:"department,omitempty"` // course department, e.g. "CS"
+	Interval      string                 `protobuf:"bytes,3,opt,name=interval,proto3" json:"interval,omitempty"`     // "hour" or "day" (default); buckets are UTC
+	From          *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=from,proto3" json:"from,omitempty"`             // optional, inclusive
+	To            *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=to,proto3" json:"to,omitempty"`                 // optional, exclusive
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SetEnrollmentPeriodRequest) Reset() {
-	*x = SetEnrollmentPeriodRequest{}
-	mi := &file_backend_protos_admin_proto_msgTypes[20]
+func (x *GetEnrollmentTrendRequest) Reset() {
+	*x = GetEnrollmentTrendRequest{}
+	mi := &file_backend_protos_admin_proto_msgTypes[78]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SetEnrollmentPeriodRequest) String() string {
+func (x *GetEnrollmentTrendRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetEnrollmentPeriodRequest) ProtoMessage() {}
+func (*GetEnrollmentTrendRequest) ProtoMessage() {}
 
-func (x *SetEnrollmentPeriodRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_backend_protos_admin_proto_msgTypes[20]
+func (x *GetEnrollmentTrendRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_protos_admin_proto_msgTypes[78]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1515,48 +5701,72 @@ func (x *SetEnrollmentPeriodRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetEnrollmentPeriodRequest.ProtoReflect.Descriptor instead.
-func (*SetEnrollmentPeriodRequest) Descriptor() ([]byte, []int) {
-	return file_backend_protos_admin_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use GetEnrollmentTrendRequest.ProtoReflect.Descriptor instead.
+func (*GetEnrollmentTrendRequest) Descriptor() ([]byte, []int) {
+	return file_backend_protos_admin_proto_rawDescGZIP(), []int{78}
 }
 
-func (x *SetEnrollmentPeriodRequest) GetStartDate() string {
+func (x *GetEnrollmentTrendRequest) GetCourseId() string {
 	if x != nil {
-		return x.StartDate
+		return x.CourseId
 	}
 	return ""
 }
 
-func (x *SetEnrollmentPeriodRequest) GetEndDate() string {
+func (x *GetEnrollmentTrendRequest) GetDepartment() string {
 	if x != nil {
-		return x.EndDate
+		return x.Department
 	}
 	return ""
 }
 
-type SetEnrollmentPeriodResponse struct {
+func (x *GetEnrollmentTrendRequest) GetInterval() string {
+	if x != nil {
+		return x.Interval
+	}
+	return ""
+}
+
+func (x *GetEnrollmentTrendRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *GetEnrollmentTrendRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+type EnrollmentTrendBucket struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Start         *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=start,proto3" json:"start,omitempty"`
+	Enrolls       int32                  `protobuf:"varint,2,opt,name=enrolls,proto3" json:"enrolls,omitempty"` // seats taken in this bucket
+	Drops         int32                  `protobuf:"varint,3,opt,name=drops,proto3" json:"drops,omitempty"`     // seats released in this bucket
+	Net           int32                  `protobuf:"varint,4,opt,name=net,proto3" json:"net,omitempty"`
+	Cumulative    int32                  `protobuf:"varint,5,opt,name=cumulative,proto3" json:"cumulative,omitempty"` // running net from the first bucket
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SetEnrollmentPeriodResponse) Reset() {
-	*x = SetEnrollmentPeriodResponse{}
-	mi := &file_backend_protos_admin_proto_msgTypes[21]
+func (x *EnrollmentTrendBucket) Reset() {
+	*x = EnrollmentTrendBucket{}
+	mi := &file_backend_protos_admin_proto_msgTypes[79]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SetEnrollmentPeriodResponse) String() string {
+func (x *EnrollmentTrendBucket) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetEnrollmentPeriodResponse) ProtoMessage() {}
+func (*EnrollmentTrendBucket) ProtoMessage() {}
 
-func (x *SetEnrollmentPeriodResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_backend_protos_admin_proto_msgTypes[21]
+func (x *EnrollmentTrendBucket) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_protos_admin_proto_msgTypes[79]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1567,47 +5777,71 @@ func (x *SetEnrollmentPeriodResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetEnrollmentPeriodResponse.ProtoReflect.Descriptor instead.
-func (*SetEnrollmentPeriodResponse) Descriptor() ([]byte, []int) {
-	return file_backend_protos_admin_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use EnrollmentTrendBucket.ProtoReflect.Descriptor instead.
+func (*EnrollmentTrendBucket) Descriptor() ([]byte, []int) {
+	return file_backend_protos_admin_proto_rawDescGZIP(), []int{79}
 }
 
-func (x *SetEnrollmentPeriodResponse) GetSuccess() bool {
+func (x *EnrollmentTrendBucket) GetStart() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Success
+		return x.Start
 	}
-	return false
+	return nil
 }
 
-func (x *SetEnrollmentPeriodResponse) GetMessage() string {
+func (x *EnrollmentTrendBucket) GetEnrolls() int32 {
 	if x != nil {
-		return x.Message
+		return x.Enrolls
 	}
-	return ""
+	return 0
 }
 
-type ToggleEnrollmentRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Enable        bool                   `protobuf:"varint,1,opt,name=enable,proto3" json:"enable,omitempty"`
+func (x *EnrollmentTrendBucket) GetDrops() int32 {
+	if x != nil {
+		return x.Drops
+	}
+	return 0
+}
+
+func (x *EnrollmentTrendBucket) GetNet() int32 {
+	if x != nil {
+		return x.Net
+	}
+	return 0
+}
+
+func (x *EnrollmentTrendBucket) GetCumulative() int32 {
+	if x != nil {
+		return x.Cumulative
+	}
+	return 0
+}
+
+type GetEnrollmentTrendResponse struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Success       bool                     `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                   `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Interval      string                   `protobuf:"bytes,3,opt,name=interval,proto3" json:"interval,omitempty"`
+	Buckets       []*EnrollmentTrendBucket `protobuf:"bytes,4,rep,name=buckets,proto3" json:"buckets,omitempty"` // oldest first, with empty buckets filled in
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ToggleEnrollmentRequest) Reset() {
-	*x = ToggleEnrollmentRequest{}
-	mi := &file_backend_protos_admin_proto_msgTypes[22]
+func (x *GetEnrollmentTrendResponse) Reset() {
+	*x = GetEnrollmentTrendResponse{}
+	mi := &file_backend_protos_admin_proto_msgTypes[80]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ToggleEnrollmentRequest) String() string {
+func (x *GetEnrollmentTrendResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ToggleEnrollmentRequest) ProtoMessage() {}
+func (*GetEnrollmentTrendResponse) ProtoMessage() {}
 
-func (x *ToggleEnrollmentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_backend_protos_admin_proto_msgTypes[22]
+func (x *GetEnrollmentTrendResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_protos_admin_proto_msgTypes[80]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1618,42 +5852,63 @@ func (x *ToggleEnrollmentRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ToggleEnrollmentRequest.ProtoReflect.Descriptor instead.
-func (*ToggleEnrollmentRequest) Descriptor() ([]byte, []int) {
-	return file_backend_protos_admin_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use GetEnrollmentTrendResponse.ProtoReflect.Descriptor instead.
+func (*GetEnrollmentTrendResponse) Descriptor() ([]byte, []int) {
+	return file_backend_protos_admin_proto_rawDescGZIP(), []int{80}
 }
 
-func (x *ToggleEnrollmentRequest) GetEnable() bool {
+func (x *GetEnrollmentTrendResponse) GetSuccess() bool {
 	if x != nil {
-		return x.Enable
+		return x.Success
 	}
 	return false
 }
 
-type ToggleEnrollmentResponse struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Success        bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	EnrollmentOpen bool                   `protobuf:"varint,2,opt,name=enrollment_open,json=enrollmentOpen,proto3" json:"enrollment_open,omitempty"`
-	Message        string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+func (x *GetEnrollmentTrendResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
 }
 
-func (x *ToggleEnrollmentResponse) Reset() {
-	*x = ToggleEnrollmentResponse{}
-	mi := &file_backend_protos_admin_proto_msgTypes[23]
+func (x *GetEnrollmentTrendResponse) GetInterval() string {
+	if x != nil {
+		return x.Interval
+	}
+	return ""
+}
+
+func (x *GetEnrollmentTrendResponse) GetBuckets() []*EnrollmentTrendBucket {
+	if x != nil {
+		return x.Buckets
+	}
+	return nil
+}
+
+type GetFacultyLoadReportRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Semester          string                 `protobuf:"bytes,1,opt,name=semester,proto3" json:"semester,omitempty"`
+	MaxUnits          int32                  `protobuf:"varint,2,opt,name=max_units,json=maxUnits,proto3" json:"max_units,omitempty"`                            // load threshold; faculty_load_max_units config when 0
+	IncludeUnassigned bool                   `protobuf:"varint,3,opt,name=include_unassigned,json=includeUnassigned,proto3" json:"include_unassigned,omitempty"` // also list active faculty with no courses this semester
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *GetFacultyLoadReportRequest) Reset() {
+	*x = GetFacultyLoadReportRequest{}
+	mi := &file_backend_protos_admin_proto_msgTypes[81]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ToggleEnrollmentResponse) String() string {
+func (x *GetFacultyLoadReportRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ToggleEnrollmentResponse) ProtoMessage() {}
+func (*GetFacultyLoadReportRequest) ProtoMessage() {}
 
-func (x *ToggleEnrollmentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_backend_protos_admin_proto_msgTypes[23]
+func (x *GetFacultyLoadReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_protos_admin_proto_msgTypes[81]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1664,54 +5919,62 @@ func (x *ToggleEnrollmentResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ToggleEnrollmentResponse.ProtoReflect.Descriptor instead.
-func (*ToggleEnrollmentResponse) Descriptor() ([]byte, []int) {
-	return file_backend_protos_admin_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use GetFacultyLoadReportRequest.ProtoReflect.Descriptor instead.
+func (*GetFacultyLoadReportRequest) Descriptor() ([]byte, []int) {
+	return file_backend_protos_admin_proto_rawDescGZIP(), []int{81}
 }
 
-func (x *ToggleEnrollmentResponse) GetSuccess() bool {
+func (x *GetFacultyLoadReportRequest) GetSemester() string {
 	if x != nil {
-		return x.Success
+		return x.Semester
 	}
-	return false
+	return ""
 }
 
-func (x *ToggleEnrollmentResponse) GetEnrollmentOpen() bool {
+func (x *GetFacultyLoadReportRequest) GetMaxUnits() int32 {
 	if x != nil {
-		return x.EnrollmentOpen
+		return x.MaxUnits
 	}
-	return false
+	return 0
 }
 
-func (x *ToggleEnrollmentResponse) GetMessage() string {
+func (x *GetFacultyLoadReportRequest) GetIncludeUnassigned() bool {
 	if x != nil {
-		return x.Message
+		return x.IncludeUnassigned
 	}
-	return ""
+	return false
 }
 
-type GetSystemConfigRequest struct {
+type FacultyLoad struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"` // optional, if empty returns all
+	FacultyId     string                 `protobuf:"bytes,1,opt,name=faculty_id,json=facultyId,proto3" json:"faculty_id,omitempty"`
+	FacultyName   string                 `protobuf:"bytes,2,opt,name=faculty_name,json=facultyName,proto3" json:"faculty_name,omitempty"` // empty when the faculty_id matches no user
+	Email         string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	CourseCount   int32                  `protobuf:"varint,4,opt,name=course_count,json=courseCount,proto3" json:"course_count,omitempty"`
+	TotalUnits    int32                  `protobuf:"varint,5,opt,name=total_units,json=totalUnits,proto3" json:"total_units,omitempty"`
+	TotalEnrolled int32                  `protobuf:"varint,6,opt,name=total_enrolled,json=totalEnrolled,proto3" json:"total_enrolled,omitempty"`
+	Overloaded    bool                   `protobuf:"varint,7,opt,name=overloaded,proto3" json:"overloaded,omitempty"` // total_units above the applied max_units
+	CourseCodes   []string               `protobuf:"bytes,8,rep,name=course_codes,json=courseCodes,proto3" json:"course_codes,omitempty"`
+	ContactHours  float64                `protobuf:"fixed64,9,opt,name=contact_hours,json=contactHours,proto3" json:"contact_hours,omitempty"` // weekly scheduled hours across the courses, from their schedules
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetSystemConfigRequest) Reset() {
-	*x = GetSystemConfigRequest{}
-	mi := &file_backend_protos_admin_proto_msgTypes[24]
+func (x *FacultyLoad) Reset() {
+	*x = FacultyLoad{}
+	mi := &file_backend_protos_admin_proto_msgTypes[82]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetSystemConfigRequest) String() string {
+func (x *FacultyLoad) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSystemConfigRequest) ProtoMessage() {}
+func (*FacultyLoad) ProtoMessage() {}
 
-func (x *GetSystemConfigRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_backend_protos_admin_proto_msgTypes[24]
+func (x *FacultyLoad) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_protos_admin_proto_msgTypes[82]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1722,40 +5985,100 @@ func (x *GetSystemConfigRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSystemConfigRequest.ProtoReflect.Descriptor instead.
-func (*GetSystemConfigRequest) Descriptor() ([]byte, []int) {
-	return file_backend_protos_admin_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use FacultyLoad.ProtoReflect.Descriptor instead.
+func (*FacultyLoad) Descriptor() ([]byte, []int) {
+	return file_backend_protos_admin_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *FacultyLoad) GetFacultyId() string {
+	if x != nil {
+		return x.FacultyId
+	}
+	return ""
+}
+
+func (x *FacultyLoad) GetFacultyName() string {
+	if x != nil {
+		return x.FacultyName
+	}
+	return ""
+}
+
+func (x *FacultyLoad) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *FacultyLoad) GetCourseCount() int32 {
+	if x != nil {
+		return x.CourseCount
+	}
+	return 0
+}
+
+func (x *FacultyLoad) GetTotalUnits() int32 {
+	if x != nil {
+		return x.TotalUnits
+	}
+	return 0
+}
+
+func (x *FacultyLoad) GetTotalEnrolled() int32 {
+	if x != nil {
+		return x.TotalEnrolled
+	}
+	return 0
+}
+
+func (x *FacultyLoad) GetOverloaded() bool {
+	if x != nil {
+		return x.Overloaded
+	}
+	return false
+}
+
+func (x *FacultyLoad) GetCourseCodes() []string {
+	if x != nil {
+		return x.CourseCodes
+	}
+	return nil
 }
 
-func (x *GetSystemConfigRequest) GetKey() string {
+func (x *FacultyLoad) GetContactHours() float64 {
 	if x != nil {
-		return x.Key
+		return x.ContactHours
 	}
-	return ""
+	return 0
 }
 
-type GetSystemConfigResponse struct {
+type GetFacultyLoadReportResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Configs       []*SystemConfig        `protobuf:"bytes,1,rep,name=configs,proto3" json:"configs,omitempty"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Semester      string                 `protobuf:"bytes,3,opt,name=semester,proto3" json:"semester,omitempty"`
+	MaxUnits      int32                  `protobuf:"varint,4,opt,name=max_units,json=maxUnits,proto3" json:"max_units,omitempty"` // threshold actually applied
+	Faculty       []*FacultyLoad         `protobuf:"bytes,5,rep,name=faculty,proto3" json:"faculty,omitempty"`                    // heaviest load first
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetSystemConfigResponse) Reset() {
-	*x = GetSystemConfigResponse{}
-	mi := &file_backend_protos_admin_proto_msgTypes[25]
+func (x *GetFacultyLoadReportResponse) Reset() {
+	*x = GetFacultyLoadReportResponse{}
+	mi := &file_backend_protos_admin_proto_msgTypes[83]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetSystemConfigResponse) String() string {
+func (x *GetFacultyLoadReportResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSystemConfigResponse) ProtoMessage() {}
+func (*GetFacultyLoadReportResponse) ProtoMessage() {}
 
-func (x *GetSystemConfigResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_backend_protos_admin_proto_msgTypes[25]
+func (x *GetFacultyLoadReportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_protos_admin_proto_msgTypes[83]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1766,42 +6089,75 @@ func (x *GetSystemConfigResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSystemConfigResponse.ProtoReflect.Descriptor instead.
-func (*GetSystemConfigResponse) Descriptor() ([]byte, []int) {
-	return file_backend_protos_admin_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use GetFacultyLoadReportResponse.ProtoReflect.Descriptor instead.
+func (*GetFacultyLoadReportResponse) Descriptor() ([]byte, []int) {
+	return file_backend_protos_admin_proto_rawDescGZIP(), []int{83}
 }
 
-func (x *GetSystemConfigResponse) GetConfigs() []*SystemConfig {
+func (x *GetFacultyLoadReportResponse) GetSuccess() bool {
 	if x != nil {
-		return x.Configs
+		return x.Success
+	}
+	return false
+}
+
+func (x *GetFacultyLoadReportResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *GetFacultyLoadReportResponse) GetSemester() string {
+	if x != nil {
+		return x.Semester
+	}
+	return ""
+}
+
+func (x *GetFacultyLoadReportResponse) GetMaxUnits() int32 {
+	if x != nil {
+		return x.MaxUnits
+	}
+	return 0
+}
+
+func (x *GetFacultyLoadReportResponse) GetFaculty() []*FacultyLoad {
+	if x != nil {
+		return x.Faculty
 	}
 	return nil
 }
 
-type UpdateSystemConfigRequest struct {
+// Request/Response messages - Audit
+type AuditEvent struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
-	Value         string                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
-	AdminId       string                 `protobuf:"bytes,3,opt,name=admin_id,json=adminId,proto3" json:"admin_id,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Timestamp     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	UserId        string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Action        string                 `protobuf:"bytes,4,opt,name=action,proto3" json:"action,omitempty"`
+	Resource      string                 `protobuf:"bytes,5,opt,name=resource,proto3" json:"resource,omitempty"`
+	Details       map[string]string      `protobuf:"bytes,6,rep,name=details,proto3" json:"details,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // values rendered as text
+	IpAddress     string                 `protobuf:"bytes,7,opt,name=ip_address,json=ipAddress,proto3" json:"ip_address,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateSystemConfigRequest) Reset() {
-	*x = UpdateSystemConfigRequest{}
-	mi := &file_backend_protos_admin_proto_msgTypes[26]
+func (x *AuditEvent) Reset() {
+	*x = AuditEvent{}
+	mi := &file_backend_protos_admin_proto_msgTypes[84]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateSystemConfigRequest) String() string {
+func (x *AuditEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateSystemConfigRequest) ProtoMessage() {}
+func (*AuditEvent) ProtoMessage() {}
 
-func (x *UpdateSystemConfigRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_backend_protos_admin_proto_msgTypes[26]
+func (x *AuditEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_protos_admin_proto_msgTypes[84]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1812,111 +6168,84 @@ func (x *UpdateSystemConfigRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateSystemConfigRequest.ProtoReflect.Descriptor instead.
-func (*UpdateSystemConfigRequest) Descriptor() ([]byte, []int) {
-	return file_backend_protos_admin_proto_rawDescGZIP(), []int{26}
+// Deprecated: Use AuditEvent.ProtoReflect.Descriptor instead.
+func (*AuditEvent) Descriptor() ([]byte, []int) {
+	return file_backend_protos_admin_proto_rawDescGZIP(), []int{84}
 }
 
-func (x *UpdateSystemConfigRequest) GetKey() string {
+func (x *AuditEvent) GetId() string {
 	if x != nil {
-		return x.Key
+		return x.Id
 	}
 	return ""
 }
 
-func (x *UpdateSystemConfigRequest) GetValue() string {
+func (x *AuditEvent) GetTimestamp() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Value
+		return x.Timestamp
 	}
-	return ""
+	return nil
 }
 
-func (x *UpdateSystemConfigRequest) GetAdminId() string {
+func (x *AuditEvent) GetUserId() string {
 	if x != nil {
-		return x.AdminId
+		return x.UserId
 	}
 	return ""
 }
 
-type UpdateSystemConfigResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *UpdateSystemConfigResponse) Reset() {
-	*x = UpdateSystemConfigResponse{}
-	mi := &file_backend_protos_admin_proto_msgTypes[27]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *UpdateSystemConfigResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*UpdateSystemConfigResponse) ProtoMessage() {}
-
-func (x *UpdateSystemConfigResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_backend_protos_admin_proto_msgTypes[27]
+func (x *AuditEvent) GetAction() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Action
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use UpdateSystemConfigResponse.ProtoReflect.Descriptor instead.
-func (*UpdateSystemConfigResponse) Descriptor() ([]byte, []int) {
-	return file_backend_protos_admin_proto_rawDescGZIP(), []int{27}
+func (x *AuditEvent) GetResource() string {
+	if x != nil {
+		return x.Resource
+	}
+	return ""
 }
 
-func (x *UpdateSystemConfigResponse) GetSuccess() bool {
+func (x *AuditEvent) GetDetails() map[string]string {
 	if x != nil {
-		return x.Success
+		return x.Details
 	}
-	return false
+	return nil
 }
 
-func (x *UpdateSystemConfigResponse) GetMessage() string {
+func (x *AuditEvent) GetIpAddress() string {
 	if x != nil {
-		return x.Message
+		return x.IpAddress
 	}
 	return ""
 }
 
-// Request/Response messages - Overrides
-type OverrideEnrollmentRequest struct {
+type GetResourceHistoryRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	StudentId     string                 `protobuf:"bytes,1,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
-	CourseId      string                 `protobuf:"bytes,2,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
-	Action        string                 `protobuf:"bytes,3,opt,name=action,proto3" json:"action,omitempty"` // "force_enroll" or "force_drop"
-	Reason        string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
-	AdminId       string                 `protobuf:"bytes,5,opt,name=admin_id,json=adminId,proto3" json:"admin_id,omitempty"`
+	Resource      string                 `protobuf:"bytes,1,opt,name=resource,proto3" json:"resource,omitempty"`                  // e.g. a course ID, hold ID, config key or "<student_id>:<course_id>"
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`                         // 1-based, defaults to 1
+	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"` // defaults to 100, max 500
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *OverrideEnrollmentRequest) Reset() {
-	*x = OverrideEnrollmentRequest{}
-	mi := &file_backend_protos_admin_proto_msgTypes[28]
+func (x *GetResourceHistoryRequest) Reset() {
+	*x = GetResourceHistoryRequest{}
+	mi := &file_backend_protos_admin_proto_msgTypes[85]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *OverrideEnrollmentRequest) String() string {
+func (x *GetResourceHistoryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OverrideEnrollmentRequest) ProtoMessage() {}
+func (*GetResourceHistoryRequest) ProtoMessage() {}
 
-func (x *OverrideEnrollmentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_backend_protos_admin_proto_msgTypes[28]
+func (x *GetResourceHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_protos_admin_proto_msgTypes[85]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1927,69 +6256,60 @@ func (x *OverrideEnrollmentRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OverrideEnrollmentRequest.ProtoReflect.Descriptor instead.
-func (*OverrideEnrollmentRequest) Descriptor() ([]byte, []int) {
-	return file_backend_protos_admin_proto_rawDescGZIP(), []int{28}
-}
-
-func (x *OverrideEnrollmentRequest) GetStudentId() string {
-	if x != nil {
-		return x.StudentId
-	}
-	return ""
-}
-
-func (x *OverrideEnrollmentRequest) GetCourseId() string {
-	if x != nil {
-		return x.CourseId
-	}
-	return ""
+// Deprecated: Use GetResourceHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetResourceHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_backend_protos_admin_proto_rawDescGZIP(), []int{85}
 }
 
-func (x *OverrideEnrollmentRequest) GetAction() string {
+func (x *GetResourceHistoryRequest) GetResource() string {
 	if x != nil {
-		return x.Action
+		return x.Resource
 	}
 	return ""
 }
 
-func (x *OverrideEnrollmentRequest) GetReason() string {
+func (x *GetResourceHistoryRequest) GetPage() int32 {
 	if x != nil {
-		return x.Reason
+		return x.Page
 	}
-	return ""
+	return 0
 }
 
-func (x *OverrideEnrollmentRequest) GetAdminId() string {
+func (x *GetResourceHistoryRequest) GetPageSize() int32 {
 	if x != nil {
-		return x.AdminId
+		return x.PageSize
 	}
-	return ""
+	return 0
 }
 
-type OverrideEnrollmentResponse struct {
+type GetResourceHistoryResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Resource      string                 `protobuf:"bytes,3,opt,name=resource,proto3" json:"resource,omitempty"`
+	Events        []*AuditEvent          `protobuf:"bytes,4,rep,name=events,proto3" json:"events,omitempty"`                            // oldest first
+	TotalCount    int32                  `protobuf:"varint,5,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"` // events recorded against the resource
+	Page          int32                  `protobuf:"varint,6,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,7,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *OverrideEnrollmentResponse) Reset() {
-	*x = OverrideEnrollmentResponse{}
-	mi := &file_backend_protos_admin_proto_msgTypes[29]
+func (x *GetResourceHistoryResponse) Reset() {
+	*x = GetResourceHistoryResponse{}
+	mi := &file_backend_protos_admin_proto_msgTypes[86]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *OverrideEnrollmentResponse) String() string {
+func (x *GetResourceHistoryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OverrideEnrollmentResponse) ProtoMessage() {}
+func (*GetResourceHistoryResponse) ProtoMessage() {}
 
-func (x *OverrideEnrollmentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_backend_protos_admin_proto_msgTypes[29]
+func (x *GetResourceHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_protos_admin_proto_msgTypes[86]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2000,25 +6320,60 @@ func (x *OverrideEnrollmentResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OverrideEnrollmentResponse.ProtoReflect.Descriptor instead.
-func (*OverrideEnrollmentResponse) Descriptor() ([]byte, []int) {
-	return file_backend_protos_admin_proto_rawDescGZIP(), []int{29}
+// Deprecated: Use GetResourceHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetResourceHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_backend_protos_admin_proto_rawDescGZIP(), []int{86}
 }
 
-func (x *OverrideEnrollmentResponse) GetSuccess() bool {
+func (x *GetResourceHistoryResponse) GetSuccess() bool {
 	if x != nil {
 		return x.Success
 	}
 	return false
 }
 
-func (x *OverrideEnrollmentResponse) GetMessage() string {
+func (x *GetResourceHistoryResponse) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
+func (x *GetResourceHistoryResponse) GetResource() string {
+	if x != nil {
+		return x.Resource
+	}
+	return ""
+}
+
+func (x *GetResourceHistoryResponse) GetEvents() []*AuditEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *GetResourceHistoryResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *GetResourceHistoryResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetResourceHistoryResponse) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
 // Request/Response messages - Statistics
 type GetSystemStatsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -2028,7 +6383,7 @@ type GetSystemStatsRequest struct {
 
 func (x *GetSystemStatsRequest) Reset() {
 	*x = GetSystemStatsRequest{}
-	mi := &file_backend_protos_admin_proto_msgTypes[30]
+	mi := &file_backend_protos_admin_proto_msgTypes[87]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2040,7 +6395,7 @@ func (x *GetSystemStatsRequest) String() string {
 func (*GetSystemStatsRequest) ProtoMessage() {}
 
 func (x *GetSystemStatsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_backend_protos_admin_proto_msgTypes[30]
+	mi := &file_backend_protos_admin_proto_msgTypes[87]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2053,7 +6408,7 @@ func (x *GetSystemStatsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetSystemStatsRequest.ProtoReflect.Descriptor instead.
 func (*GetSystemStatsRequest) Descriptor() ([]byte, []int) {
-	return file_backend_protos_admin_proto_rawDescGZIP(), []int{30}
+	return file_backend_protos_admin_proto_rawDescGZIP(), []int{87}
 }
 
 type GetSystemStatsResponse struct {
@@ -2065,7 +6420,7 @@ type GetSystemStatsResponse struct {
 
 func (x *GetSystemStatsResponse) Reset() {
 	*x = GetSystemStatsResponse{}
-	mi := &file_backend_protos_admin_proto_msgTypes[31]
+	mi := &file_backend_protos_admin_proto_msgTypes[88]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2077,7 +6432,7 @@ func (x *GetSystemStatsResponse) String() string {
 func (*GetSystemStatsResponse) ProtoMessage() {}
 
 func (x *GetSystemStatsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_backend_protos_admin_proto_msgTypes[31]
+	mi := &file_backend_protos_admin_proto_msgTypes[88]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2090,7 +6445,7 @@ func (x *GetSystemStatsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetSystemStatsResponse.ProtoReflect.Descriptor instead.
 func (*GetSystemStatsResponse) Descriptor() ([]byte, []int) {
-	return file_backend_protos_admin_proto_rawDescGZIP(), []int{31}
+	return file_backend_protos_admin_proto_rawDescGZIP(), []int{88}
 }
 
 func (x *GetSystemStatsResponse) GetStats() *SystemStats {
@@ -2104,7 +6459,7 @@ var File_backend_protos_admin_proto protoreflect.FileDescriptor
 
 const file_backend_protos_admin_proto_rawDesc = "" +
 	"\n" +
-	"\x1abackend/protos/admin.proto\x12\x05admin\x1a\x1fgoogle/protobuf/timestamp.proto\"\xb6\x02\n" +
+	"\x1abackend/protos/admin.proto\x12\x05admin\x1a\x1fgoogle/protobuf/timestamp.proto\"\xb0\x05\n" +
 	"\x06Course\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04code\x18\x02 \x01(\tR\x04code\x12\x14\n" +
@@ -2119,7 +6474,18 @@ const file_backend_protos_admin_proto_rawDesc = "" +
 	"faculty_id\x18\n" +
 	" \x01(\tR\tfacultyId\x12\x17\n" +
 	"\ais_open\x18\v \x01(\bR\x06isOpen\x12\x1a\n" +
-	"\bsemester\x18\f \x01(\tR\bsemester\"\xbf\x02\n" +
+	"\bsemester\x18\f \x01(\tR\bsemester\x12%\n" +
+	"\x0eallowed_majors\x18\r \x03(\tR\rallowedMajors\x12$\n" +
+	"\x0emin_year_level\x18\x0e \x01(\x05R\fminYearLevel\x12'\n" +
+	"\x0fseats_available\x18\x0f \x01(\x05R\x0eseatsAvailable\x12\x17\n" +
+	"\ais_full\x18\x10 \x01(\bR\x06isFull\x12%\n" +
+	"\x0ewaitlist_count\x18\x11 \x01(\x05R\rwaitlistCount\x12\x1e\n" +
+	"\n" +
+	"department\x18\x12 \x01(\tR\n" +
+	"department\x12@\n" +
+	"\x0eenroll_open_at\x18\x13 \x01(\v2\x1a.google.protobuf.TimestampR\fenrollOpenAt\x12B\n" +
+	"\x0fenroll_close_at\x18\x14 \x01(\v2\x1a.google.protobuf.TimestampR\renrollCloseAt\x12\x1c\n" +
+	"\tcancelled\x18\x15 \x01(\bR\tcancelled\"\xb1\x03\n" +
 	"\x04User\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
 	"\x05email\x18\x02 \x01(\tR\x05email\x12\x12\n" +
@@ -2138,7 +6504,9 @@ const file_backend_protos_admin_proto_rawDesc = "" +
 	"\tis_active\x18\n" +
 	" \x01(\bR\bisActive\x129\n" +
 	"\n" +
-	"created_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xb2\x01\n" +
+	"created_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12>\n" +
+	"\rlast_login_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\vlastLoginAt\x120\n" +
+	"\x14must_change_password\x18\r \x01(\bR\x12mustChangePassword\"\xb2\x01\n" +
 	"\fSystemConfig\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
 	"\x05value\x18\x02 \x01(\tR\x05value\x129\n" +
@@ -2146,7 +6514,7 @@ const file_backend_protos_admin_proto_rawDesc = "" +
 	"updated_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x1d\n" +
 	"\n" +
 	"updated_by\x18\x04 \x01(\tR\tupdatedBy\x12 \n" +
-	"\vdescription\x18\x05 \x01(\tR\vdescription\"\xa2\x02\n" +
+	"\vdescription\x18\x05 \x01(\tR\vdescription\"\x9c\x03\n" +
 	"\vSystemStats\x12%\n" +
 	"\x0etotal_students\x18\x01 \x01(\x05R\rtotalStudents\x12#\n" +
 	"\rtotal_faculty\x18\x02 \x01(\x05R\ftotalFaculty\x12#\n" +
@@ -2154,7 +6522,12 @@ const file_backend_protos_admin_proto_rawDesc = "" +
 	"\fopen_courses\x18\x04 \x01(\x05R\vopenCourses\x12+\n" +
 	"\x11total_enrollments\x18\x05 \x01(\x05R\x10totalEnrollments\x12'\n" +
 	"\x0fenrollment_open\x18\x06 \x01(\bR\x0eenrollmentOpen\x12)\n" +
-	"\x10current_semester\x18\a \x01(\tR\x0fcurrentSemester\"\xfe\x01\n" +
+	"\x10current_semester\x18\a \x01(\tR\x0fcurrentSemester\x12+\n" +
+	"\x11enrollments_today\x18\b \x01(\x05R\x10enrollmentsToday\x12\x1f\n" +
+	"\vdrops_today\x18\t \x01(\x05R\n" +
+	"dropsToday\x12*\n" +
+	"\x11average_fill_rate\x18\n" +
+	" \x01(\x01R\x0faverageFillRate\"\xf6\x03\n" +
 	"\x13CreateCourseRequest\x12\x12\n" +
 	"\x04code\x18\x01 \x01(\tR\x04code\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12 \n" +
@@ -2165,12 +6538,22 @@ const file_backend_protos_admin_proto_rawDesc = "" +
 	"\bcapacity\x18\a \x01(\x05R\bcapacity\x12\x1d\n" +
 	"\n" +
 	"faculty_id\x18\b \x01(\tR\tfacultyId\x12\x1a\n" +
-	"\bsemester\x18\t \x01(\tR\bsemester\"\x8e\x01\n" +
+	"\bsemester\x18\t \x01(\tR\bsemester\x12%\n" +
+	"\x0eallowed_majors\x18\n" +
+	" \x03(\tR\rallowedMajors\x12$\n" +
+	"\x0emin_year_level\x18\v \x01(\x05R\fminYearLevel\x12 \n" +
+	"\vunscheduled\x18\f \x01(\bR\vunscheduled\x12\x1e\n" +
+	"\n" +
+	"department\x18\r \x01(\tR\n" +
+	"department\x12$\n" +
+	"\x0eenroll_open_at\x18\x0e \x01(\tR\fenrollOpenAt\x12&\n" +
+	"\x0fenroll_close_at\x18\x0f \x01(\tR\renrollCloseAt\x12\x19\n" +
+	"\badmin_id\x18\x10 \x01(\tR\aadminId\"\x8e\x01\n" +
 	"\x14CreateCourseResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x1b\n" +
 	"\tcourse_id\x18\x02 \x01(\tR\bcourseId\x12%\n" +
 	"\x06course\x18\x03 \x01(\v2\r.admin.CourseR\x06course\x12\x18\n" +
-	"\amessage\x18\x04 \x01(\tR\amessage\"\x84\x02\n" +
+	"\amessage\x18\x04 \x01(\tR\amessage\"\xdf\x04\n" +
 	"\x13UpdateCourseRequest\x12\x1b\n" +
 	"\tcourse_id\x18\x01 \x01(\tR\bcourseId\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12 \n" +
@@ -2181,7 +6564,19 @@ const file_backend_protos_admin_proto_rawDesc = "" +
 	"\bcapacity\x18\a \x01(\x05R\bcapacity\x12\x1d\n" +
 	"\n" +
 	"faculty_id\x18\b \x01(\tR\tfacultyId\x12\x17\n" +
-	"\ais_open\x18\t \x01(\bR\x06isOpen\"q\n" +
+	"\ais_open\x18\t \x01(\bR\x06isOpen\x12/\n" +
+	"\x13update_restrictions\x18\n" +
+	" \x01(\bR\x12updateRestrictions\x12%\n" +
+	"\x0eallowed_majors\x18\v \x03(\tR\rallowedMajors\x12$\n" +
+	"\x0emin_year_level\x18\f \x01(\x05R\fminYearLevel\x12 \n" +
+	"\vunscheduled\x18\r \x01(\bR\vunscheduled\x12\x1e\n" +
+	"\n" +
+	"department\x18\x0e \x01(\tR\n" +
+	"department\x120\n" +
+	"\x14update_enroll_window\x18\x0f \x01(\bR\x12updateEnrollWindow\x12$\n" +
+	"\x0eenroll_open_at\x18\x10 \x01(\tR\fenrollOpenAt\x12&\n" +
+	"\x0fenroll_close_at\x18\x11 \x01(\tR\renrollCloseAt\x12\x19\n" +
+	"\badmin_id\x18\x12 \x01(\tR\aadminId\"q\n" +
 	"\x14UpdateCourseResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12%\n" +
 	"\x06course\x18\x02 \x01(\v2\r.admin.CourseR\x06course\x12\x18\n" +
@@ -2190,14 +6585,133 @@ const file_backend_protos_admin_proto_rawDesc = "" +
 	"\tcourse_id\x18\x01 \x01(\tR\bcourseId\"J\n" +
 	"\x14DeleteCourseResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\"R\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"e\n" +
+	"\x13CancelCourseRequest\x12\x1b\n" +
+	"\tcourse_id\x18\x01 \x01(\tR\bcourseId\x12\x19\n" +
+	"\badmin_id\x18\x02 \x01(\tR\aadminId\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"\x9c\x01\n" +
+	"\x14CancelCourseResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12+\n" +
+	"\x11students_affected\x18\x03 \x01(\x05R\x10studentsAffected\x12#\n" +
+	"\rcarts_updated\x18\x04 \x01(\x05R\fcartsUpdated\"R\n" +
 	"\x14AssignFacultyRequest\x12\x1b\n" +
 	"\tcourse_id\x18\x01 \x01(\tR\bcourseId\x12\x1d\n" +
 	"\n" +
 	"faculty_id\x18\x02 \x01(\tR\tfacultyId\"K\n" +
 	"\x15AssignFacultyResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\"\xe4\x01\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\x8a\x01\n" +
+	"\x1dValidateCourseScheduleRequest\x12\x1a\n" +
+	"\bschedule\x18\x01 \x01(\tR\bschedule\x12\x12\n" +
+	"\x04room\x18\x02 \x01(\tR\x04room\x12\x1d\n" +
+	"\n" +
+	"faculty_id\x18\x03 \x01(\tR\tfacultyId\x12\x1a\n" +
+	"\bsemester\x18\x04 \x01(\tR\bsemester\"\xab\x01\n" +
+	"\x10ScheduleConflict\x12\x1b\n" +
+	"\tcourse_id\x18\x01 \x01(\tR\bcourseId\x12\x1f\n" +
+	"\vcourse_code\x18\x02 \x01(\tR\n" +
+	"courseCode\x12\x1a\n" +
+	"\bschedule\x18\x03 \x01(\tR\bschedule\x12#\n" +
+	"\rconflict_type\x18\x04 \x01(\tR\fconflictType\x12\x18\n" +
+	"\adetails\x18\x05 \x01(\tR\adetails\"\xf6\x01\n" +
+	"\x1eValidateCourseScheduleResponse\x12\x14\n" +
+	"\x05valid\x18\x01 \x01(\bR\x05valid\x12\x1f\n" +
+	"\vparse_error\x18\x02 \x01(\tR\n" +
+	"parseError\x12\x12\n" +
+	"\x04days\x18\x03 \x03(\tR\x04days\x12\x1d\n" +
+	"\n" +
+	"start_time\x18\x04 \x01(\tR\tstartTime\x12\x19\n" +
+	"\bend_time\x18\x05 \x01(\tR\aendTime\x125\n" +
+	"\tconflicts\x18\x06 \x03(\v2\x17.admin.ScheduleConflictR\tconflicts\x12\x18\n" +
+	"\amessage\x18\a \x01(\tR\amessage\"\x87\x04\n" +
+	"\x10CourseDefinition\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x14\n" +
+	"\x05units\x18\x04 \x01(\x05R\x05units\x12\x1a\n" +
+	"\bschedule\x18\x05 \x01(\tR\bschedule\x12\x12\n" +
+	"\x04room\x18\x06 \x01(\tR\x04room\x12\x1a\n" +
+	"\bcapacity\x18\a \x01(\x05R\bcapacity\x12\x1d\n" +
+	"\n" +
+	"faculty_id\x18\b \x01(\tR\tfacultyId\x12\x1a\n" +
+	"\bsemester\x18\t \x01(\tR\bsemester\x12%\n" +
+	"\x0eallowed_majors\x18\n" +
+	" \x03(\tR\rallowedMajors\x12$\n" +
+	"\x0emin_year_level\x18\v \x01(\x05R\fminYearLevel\x12-\n" +
+	"\x12prerequisite_codes\x18\f \x03(\tR\x11prerequisiteCodes\x12 \n" +
+	"\vunscheduled\x18\r \x01(\bR\vunscheduled\x12\x1e\n" +
+	"\n" +
+	"department\x18\x0e \x01(\tR\n" +
+	"department\x12$\n" +
+	"\x0eenroll_open_at\x18\x0f \x01(\tR\fenrollOpenAt\x12&\n" +
+	"\x0fenroll_close_at\x18\x10 \x01(\tR\renrollCloseAt\"h\n" +
+	"\x18BulkCreateCoursesRequest\x121\n" +
+	"\acourses\x18\x01 \x03(\v2\x17.admin.CourseDefinitionR\acourses\x12\x19\n" +
+	"\badmin_id\x18\x02 \x01(\tR\aadminId\"\xd2\x01\n" +
+	"\x10BulkCourseResult\x12\x10\n" +
+	"\x03row\x18\x01 \x01(\x05R\x03row\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\x12\x1a\n" +
+	"\bsemester\x18\x03 \x01(\tR\bsemester\x12\x18\n" +
+	"\asuccess\x18\x04 \x01(\bR\asuccess\x12\x1b\n" +
+	"\tcourse_id\x18\x05 \x01(\tR\bcourseId\x12\x14\n" +
+	"\x05error\x18\x06 \x01(\tR\x05error\x12/\n" +
+	"\x13prerequisite_errors\x18\a \x03(\tR\x12prerequisiteErrors\"\xca\x01\n" +
+	"\x19BulkCreateCoursesResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12#\n" +
+	"\rcreated_count\x18\x03 \x01(\x05R\fcreatedCount\x12!\n" +
+	"\ffailed_count\x18\x04 \x01(\x05R\vfailedCount\x121\n" +
+	"\aresults\x18\x05 \x03(\v2\x17.admin.BulkCourseResultR\aresults\"m\n" +
+	"\x16AddPrerequisiteRequest\x12\x1b\n" +
+	"\tcourse_id\x18\x01 \x01(\tR\bcourseId\x12\x1b\n" +
+	"\tprereq_id\x18\x02 \x01(\tR\bprereqId\x12\x19\n" +
+	"\badmin_id\x18\x03 \x01(\tR\aadminId\"M\n" +
+	"\x17AddPrerequisiteResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"p\n" +
+	"\x19RemovePrerequisiteRequest\x12\x1b\n" +
+	"\tcourse_id\x18\x01 \x01(\tR\bcourseId\x12\x1b\n" +
+	"\tprereq_id\x18\x02 \x01(\tR\bprereqId\x12\x19\n" +
+	"\badmin_id\x18\x03 \x01(\tR\aadminId\"P\n" +
+	"\x1aRemovePrerequisiteResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\xaa\x01\n" +
+	"\n" +
+	"Department\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x129\n" +
+	"\n" +
+	"created_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\\\n" +
+	"\x17CreateDepartmentRequest\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x19\n" +
+	"\badmin_id\x18\x03 \x01(\tR\aadminId\"\x81\x01\n" +
+	"\x18CreateDepartmentResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x121\n" +
+	"\n" +
+	"department\x18\x03 \x01(\v2\x11.admin.DepartmentR\n" +
+	"department\"\\\n" +
+	"\x17UpdateDepartmentRequest\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x19\n" +
+	"\badmin_id\x18\x03 \x01(\tR\aadminId\"\xaa\x01\n" +
+	"\x18UpdateDepartmentResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x121\n" +
+	"\n" +
+	"department\x18\x03 \x01(\v2\x11.admin.DepartmentR\n" +
+	"department\x12'\n" +
+	"\x0ffaculty_updated\x18\x04 \x01(\x05R\x0efacultyUpdated\"H\n" +
+	"\x17DeleteDepartmentRequest\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x19\n" +
+	"\badmin_id\x18\x02 \x01(\tR\aadminId\"N\n" +
+	"\x18DeleteDepartmentResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\xff\x01\n" +
 	"\x11CreateUserRequest\x12\x14\n" +
 	"\x05email\x18\x01 \x01(\tR\x05email\x12\x12\n" +
 	"\x04role\x18\x02 \x01(\tR\x04role\x12\x12\n" +
@@ -2211,7 +6725,8 @@ const file_backend_protos_admin_proto_rawDesc = "" +
 	"department\x12\x14\n" +
 	"\x05major\x18\a \x01(\tR\x05major\x12\x1d\n" +
 	"\n" +
-	"year_level\x18\b \x01(\x05R\tyearLevel\"\xad\x01\n" +
+	"year_level\x18\b \x01(\x05R\tyearLevel\x12\x19\n" +
+	"\badmin_id\x18\t \x01(\tR\aadminId\"\xad\x01\n" +
 	"\x12CreateUserResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\tR\x06userId\x12)\n" +
@@ -2226,6 +6741,25 @@ const file_backend_protos_admin_proto_rawDesc = "" +
 	"\x05users\x18\x01 \x03(\v2\v.admin.UserR\x05users\x12\x1f\n" +
 	"\vtotal_count\x18\x02 \x01(\x05R\n" +
 	"totalCount\"/\n" +
+	"\x14GetUserDetailRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"\xc4\x01\n" +
+	"\x0eUserEnrollment\x12#\n" +
+	"\renrollment_id\x18\x01 \x01(\tR\fenrollmentId\x12\x1b\n" +
+	"\tcourse_id\x18\x02 \x01(\tR\bcourseId\x12\x1f\n" +
+	"\vcourse_code\x18\x03 \x01(\tR\n" +
+	"courseCode\x12!\n" +
+	"\fcourse_title\x18\x04 \x01(\tR\vcourseTitle\x12\x14\n" +
+	"\x05units\x18\x05 \x01(\x05R\x05units\x12\x16\n" +
+	"\x06status\x18\x06 \x01(\tR\x06status\"\xb8\x02\n" +
+	"\x15GetUserDetailResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x1f\n" +
+	"\x04user\x18\x03 \x01(\v2\v.admin.UserR\x04user\x12\x1a\n" +
+	"\bsemester\x18\x04 \x01(\tR\bsemester\x12'\n" +
+	"\x0factive_sessions\x18\x05 \x01(\x05R\x0eactiveSessions\x127\n" +
+	"\venrollments\x18\x06 \x03(\v2\x15.admin.UserEnrollmentR\venrollments\x12\x12\n" +
+	"\x04cgpa\x18\a \x01(\x01R\x04cgpa\x128\n" +
+	"\x10assigned_courses\x18\b \x03(\v2\r.admin.CourseR\x0fassignedCourses\"/\n" +
 	"\x14ResetPasswordRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\"n\n" +
 	"\x15ResetPasswordResponse\x12\x18\n" +
@@ -2237,16 +6771,81 @@ const file_backend_protos_admin_proto_rawDesc = "" +
 	"\bactivate\x18\x02 \x01(\bR\bactivate\"N\n" +
 	"\x18ToggleUserStatusResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\"V\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\x90\x02\n" +
+	"\x0eTransferCredit\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"student_id\x18\x02 \x01(\tR\tstudentId\x12\x1f\n" +
+	"\vcourse_code\x18\x03 \x01(\tR\n" +
+	"courseCode\x12)\n" +
+	"\x10equivalent_units\x18\x04 \x01(\x05R\x0fequivalentUnits\x12-\n" +
+	"\x12source_institution\x18\x05 \x01(\tR\x11sourceInstitution\x12\x19\n" +
+	"\badded_by\x18\x06 \x01(\tR\aaddedBy\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xcf\x01\n" +
+	"\x18AddTransferCreditRequest\x12\x1d\n" +
+	"\n" +
+	"student_id\x18\x01 \x01(\tR\tstudentId\x12\x1f\n" +
+	"\vcourse_code\x18\x02 \x01(\tR\n" +
+	"courseCode\x12)\n" +
+	"\x10equivalent_units\x18\x03 \x01(\x05R\x0fequivalentUnits\x12-\n" +
+	"\x12source_institution\x18\x04 \x01(\tR\x11sourceInstitution\x12\x19\n" +
+	"\badmin_id\x18\x05 \x01(\tR\aadminId\"\x8f\x01\n" +
+	"\x19AddTransferCreditResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12>\n" +
+	"\x0ftransfer_credit\x18\x03 \x01(\v2\x15.admin.TransferCreditR\x0etransferCredit\"\xa9\x02\n" +
+	"\x04Hold\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"student_id\x18\x02 \x01(\tR\tstudentId\x12\x12\n" +
+	"\x04type\x18\x03 \x01(\tR\x04type\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\x12\x16\n" +
+	"\x06active\x18\x05 \x01(\bR\x06active\x12\x1b\n" +
+	"\tplaced_by\x18\x06 \x01(\tR\bplacedBy\x127\n" +
+	"\tplaced_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\bplacedAt\x12\x1d\n" +
+	"\n" +
+	"cleared_by\x18\b \x01(\tR\tclearedBy\x129\n" +
+	"\n" +
+	"cleared_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tclearedAt\"x\n" +
+	"\x10PlaceHoldRequest\x12\x1d\n" +
+	"\n" +
+	"student_id\x18\x01 \x01(\tR\tstudentId\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\x12\x19\n" +
+	"\badmin_id\x18\x04 \x01(\tR\aadminId\"h\n" +
+	"\x11PlaceHoldResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x1f\n" +
+	"\x04hold\x18\x03 \x01(\v2\v.admin.HoldR\x04hold\"F\n" +
+	"\x10ClearHoldRequest\x12\x17\n" +
+	"\ahold_id\x18\x01 \x01(\tR\x06holdId\x12\x19\n" +
+	"\badmin_id\x18\x02 \x01(\tR\aadminId\"h\n" +
+	"\x11ClearHoldResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x1f\n" +
+	"\x04hold\x18\x03 \x01(\v2\v.admin.HoldR\x04hold\"`\n" +
+	"\x16GetStudentHoldsRequest\x12\x1d\n" +
+	"\n" +
+	"student_id\x18\x01 \x01(\tR\tstudentId\x12'\n" +
+	"\x0finclude_cleared\x18\x02 \x01(\bR\x0eincludeCleared\"p\n" +
+	"\x17GetStudentHoldsResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12!\n" +
+	"\x05holds\x18\x03 \x03(\v2\v.admin.HoldR\x05holds\"\x97\x01\n" +
 	"\x1aSetEnrollmentPeriodRequest\x12\x1d\n" +
 	"\n" +
 	"start_date\x18\x01 \x01(\tR\tstartDate\x12\x19\n" +
-	"\bend_date\x18\x02 \x01(\tR\aendDate\"Q\n" +
+	"\bend_date\x18\x02 \x01(\tR\aendDate\x12\x19\n" +
+	"\badmin_id\x18\x03 \x01(\tR\aadminId\x12$\n" +
+	"\x0eallow_past_end\x18\x04 \x01(\bR\fallowPastEnd\"k\n" +
 	"\x1bSetEnrollmentPeriodResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\"1\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x18\n" +
+	"\awarning\x18\x03 \x01(\tR\awarning\"L\n" +
 	"\x17ToggleEnrollmentRequest\x12\x16\n" +
-	"\x06enable\x18\x01 \x01(\bR\x06enable\"w\n" +
+	"\x06enable\x18\x01 \x01(\bR\x06enable\x12\x19\n" +
+	"\badmin_id\x18\x02 \x01(\tR\aadminId\"w\n" +
 	"\x18ToggleEnrollmentResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12'\n" +
 	"\x0fenrollment_open\x18\x02 \x01(\bR\x0eenrollmentOpen\x12\x18\n" +
@@ -2268,28 +6867,203 @@ const file_backend_protos_admin_proto_rawDesc = "" +
 	"\tcourse_id\x18\x02 \x01(\tR\bcourseId\x12\x16\n" +
 	"\x06action\x18\x03 \x01(\tR\x06action\x12\x16\n" +
 	"\x06reason\x18\x04 \x01(\tR\x06reason\x12\x19\n" +
-	"\badmin_id\x18\x05 \x01(\tR\aadminId\"P\n" +
+	"\badmin_id\x18\x05 \x01(\tR\aadminId\"\xcc\x01\n" +
 	"\x1aOverrideEnrollmentResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\"\x17\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x1a\n" +
+	"\benrolled\x18\x03 \x01(\x05R\benrolled\x12\x1a\n" +
+	"\bcapacity\x18\x04 \x01(\x05R\bcapacity\x12#\n" +
+	"\rcourse_closed\x18\x05 \x01(\bR\fcourseClosed\x12\x1d\n" +
+	"\n" +
+	"error_code\x18\x06 \x01(\tR\terrorCode\"\x88\x01\n" +
+	"\x18RestoreEnrollmentRequest\x12#\n" +
+	"\renrollment_id\x18\x01 \x01(\tR\fenrollmentId\x12\x14\n" +
+	"\x05force\x18\x02 \x01(\bR\x05force\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\x12\x19\n" +
+	"\badmin_id\x18\x04 \x01(\tR\aadminId\"\xc6\x01\n" +
+	"\x19RestoreEnrollmentResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x18\n" +
+	"\aoutcome\x18\x03 \x01(\tR\aoutcome\x12#\n" +
+	"\renrollment_id\x18\x04 \x01(\tR\fenrollmentId\x12\x1a\n" +
+	"\benrolled\x18\x05 \x01(\x05R\benrolled\x12\x1a\n" +
+	"\bcapacity\x18\x06 \x01(\x05R\bcapacity\"[\n" +
+	"\"RecalculateEnrollmentCountsRequest\x12\x1a\n" +
+	"\bsemester\x18\x01 \x01(\tR\bsemester\x12\x19\n" +
+	"\badmin_id\x18\x02 \x01(\tR\aadminId\"\xb9\x01\n" +
+	"\x19EnrollmentCountCorrection\x12\x1b\n" +
+	"\tcourse_id\x18\x01 \x01(\tR\bcourseId\x12\x1f\n" +
+	"\vcourse_code\x18\x02 \x01(\tR\n" +
+	"courseCode\x12%\n" +
+	"\x0eprevious_count\x18\x03 \x01(\x05R\rpreviousCount\x12!\n" +
+	"\factual_count\x18\x04 \x01(\x05R\vactualCount\x12\x14\n" +
+	"\x05delta\x18\x05 \x01(\x05R\x05delta\"\xc6\x01\n" +
+	"#RecalculateEnrollmentCountsResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12'\n" +
+	"\x0fcourses_checked\x18\x03 \x01(\x05R\x0ecoursesChecked\x12B\n" +
+	"\vcorrections\x18\x04 \x03(\v2 .admin.EnrollmentCountCorrectionR\vcorrections\"M\n" +
+	"\x14CloseSemesterRequest\x12\x1a\n" +
+	"\bsemester\x18\x01 \x01(\tR\bsemester\x12\x19\n" +
+	"\badmin_id\x18\x02 \x01(\tR\aadminId\"\xa7\x01\n" +
+	"\x15CloseSemesterResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12%\n" +
+	"\x0ecourses_closed\x18\x03 \x01(\x05R\rcoursesClosed\x123\n" +
+	"\x15enrollments_completed\x18\x04 \x01(\x05R\x14enrollmentsCompleted\"\x93\x01\n" +
+	"\x17PublishAllGradesRequest\x12\x1a\n" +
+	"\bsemester\x18\x01 \x01(\tR\bsemester\x12\x19\n" +
+	"\badmin_id\x18\x02 \x01(\tR\aadminId\x12\x18\n" +
+	"\aconfirm\x18\x03 \x01(\bR\aconfirm\x12'\n" +
+	"\x0fnotify_students\x18\x04 \x01(\bR\x0enotifyStudents\"\xd1\x01\n" +
+	"\x18PublishAllGradesResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12)\n" +
+	"\x10grades_published\x18\x03 \x01(\x05R\x0fgradesPublished\x12)\n" +
+	"\x10courses_affected\x18\x04 \x01(\x05R\x0fcoursesAffected\x12+\n" +
+	"\x11students_notified\x18\x05 \x01(\x05R\x10studentsNotified\"W\n" +
+	"\x1bGetNearlyFullCoursesRequest\x12\x1a\n" +
+	"\bsemester\x18\x01 \x01(\tR\bsemester\x12\x1c\n" +
+	"\tthreshold\x18\x02 \x01(\x05R\tthreshold\"\xf0\x01\n" +
+	"\x10NearlyFullCourse\x12\x1b\n" +
+	"\tcourse_id\x18\x01 \x01(\tR\bcourseId\x12\x1f\n" +
+	"\vcourse_code\x18\x02 \x01(\tR\n" +
+	"courseCode\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x12\x1a\n" +
+	"\bsemester\x18\x04 \x01(\tR\bsemester\x12\x1a\n" +
+	"\benrolled\x18\x05 \x01(\x05R\benrolled\x12\x1a\n" +
+	"\bcapacity\x18\x06 \x01(\x05R\bcapacity\x12\x1b\n" +
+	"\tfill_rate\x18\a \x01(\x01R\bfillRate\x12\x17\n" +
+	"\ais_open\x18\b \x01(\bR\x06isOpen\"\xa3\x01\n" +
+	"\x1cGetNearlyFullCoursesResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x1c\n" +
+	"\tthreshold\x18\x03 \x01(\x05R\tthreshold\x121\n" +
+	"\acourses\x18\x04 \x03(\v2\x17.admin.NearlyFullCourseR\acourses\";\n" +
+	"\x1cGetCourseFillTimelineRequest\x12\x1b\n" +
+	"\tcourse_id\x18\x01 \x01(\tR\bcourseId\"i\n" +
+	"\x11FillTimelinePoint\x128\n" +
+	"\ttimestamp\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\x12\x1a\n" +
+	"\benrolled\x18\x02 \x01(\x05R\benrolled\"\xdf\x01\n" +
+	"\x1dGetCourseFillTimelineResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x1b\n" +
+	"\tcourse_id\x18\x03 \x01(\tR\bcourseId\x12\x1f\n" +
+	"\vcourse_code\x18\x04 \x01(\tR\n" +
+	"courseCode\x12\x1a\n" +
+	"\bcapacity\x18\x05 \x01(\x05R\bcapacity\x120\n" +
+	"\x06points\x18\x06 \x03(\v2\x18.admin.FillTimelinePointR\x06points\"\xd0\x01\n" +
+	"\x19GetEnrollmentTrendRequest\x12\x1b\n" +
+	"\tcourse_id\x18\x01 \x01(\tR\bcourseId\x12\x1e\n" +
+	"\n" +
+	"department\x18\x02 \x01(\tR\n" +
+	"department\x12\x1a\n" +
+	"\binterval\x18\x03 \x01(\tR\binterval\x12.\n" +
+	"\x04from\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
+	"\x02to\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x02to\"\xab\x01\n" +
+	"\x15EnrollmentTrendBucket\x120\n" +
+	"\x05start\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x05start\x12\x18\n" +
+	"\aenrolls\x18\x02 \x01(\x05R\aenrolls\x12\x14\n" +
+	"\x05drops\x18\x03 \x01(\x05R\x05drops\x12\x10\n" +
+	"\x03net\x18\x04 \x01(\x05R\x03net\x12\x1e\n" +
+	"\n" +
+	"cumulative\x18\x05 \x01(\x05R\n" +
+	"cumulative\"\xa4\x01\n" +
+	"\x1aGetEnrollmentTrendResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x1a\n" +
+	"\binterval\x18\x03 \x01(\tR\binterval\x126\n" +
+	"\abuckets\x18\x04 \x03(\v2\x1c.admin.EnrollmentTrendBucketR\abuckets\"\x85\x01\n" +
+	"\x1bGetFacultyLoadReportRequest\x12\x1a\n" +
+	"\bsemester\x18\x01 \x01(\tR\bsemester\x12\x1b\n" +
+	"\tmax_units\x18\x02 \x01(\x05R\bmaxUnits\x12-\n" +
+	"\x12include_unassigned\x18\x03 \x01(\bR\x11includeUnassigned\"\xb8\x02\n" +
+	"\vFacultyLoad\x12\x1d\n" +
+	"\n" +
+	"faculty_id\x18\x01 \x01(\tR\tfacultyId\x12!\n" +
+	"\ffaculty_name\x18\x02 \x01(\tR\vfacultyName\x12\x14\n" +
+	"\x05email\x18\x03 \x01(\tR\x05email\x12!\n" +
+	"\fcourse_count\x18\x04 \x01(\x05R\vcourseCount\x12\x1f\n" +
+	"\vtotal_units\x18\x05 \x01(\x05R\n" +
+	"totalUnits\x12%\n" +
+	"\x0etotal_enrolled\x18\x06 \x01(\x05R\rtotalEnrolled\x12\x1e\n" +
+	"\n" +
+	"overloaded\x18\a \x01(\bR\n" +
+	"overloaded\x12!\n" +
+	"\fcourse_codes\x18\b \x03(\tR\vcourseCodes\x12#\n" +
+	"\rcontact_hours\x18\t \x01(\x01R\fcontactHours\"\xb9\x01\n" +
+	"\x1cGetFacultyLoadReportResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x1a\n" +
+	"\bsemester\x18\x03 \x01(\tR\bsemester\x12\x1b\n" +
+	"\tmax_units\x18\x04 \x01(\x05R\bmaxUnits\x12,\n" +
+	"\afaculty\x18\x05 \x03(\v2\x12.admin.FacultyLoadR\afaculty\"\xb8\x02\n" +
+	"\n" +
+	"AuditEvent\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x128\n" +
+	"\ttimestamp\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\x12\x16\n" +
+	"\x06action\x18\x04 \x01(\tR\x06action\x12\x1a\n" +
+	"\bresource\x18\x05 \x01(\tR\bresource\x128\n" +
+	"\adetails\x18\x06 \x03(\v2\x1e.admin.AuditEvent.DetailsEntryR\adetails\x12\x1d\n" +
+	"\n" +
+	"ip_address\x18\a \x01(\tR\tipAddress\x1a:\n" +
+	"\fDetailsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"h\n" +
+	"\x19GetResourceHistoryRequest\x12\x1a\n" +
+	"\bresource\x18\x01 \x01(\tR\bresource\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\"\xe9\x01\n" +
+	"\x1aGetResourceHistoryResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x1a\n" +
+	"\bresource\x18\x03 \x01(\tR\bresource\x12)\n" +
+	"\x06events\x18\x04 \x03(\v2\x11.admin.AuditEventR\x06events\x12\x1f\n" +
+	"\vtotal_count\x18\x05 \x01(\x05R\n" +
+	"totalCount\x12\x12\n" +
+	"\x04page\x18\x06 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\a \x01(\x05R\bpageSize\"\x17\n" +
 	"\x15GetSystemStatsRequest\"B\n" +
 	"\x16GetSystemStatsResponse\x12(\n" +
-	"\x05stats\x18\x01 \x01(\v2\x12.admin.SystemStatsR\x05stats2\xe3\b\n" +
+	"\x05stats\x18\x01 \x01(\v2\x12.admin.SystemStatsR\x05stats2\xd8\x17\n" +
 	"\fAdminService\x12G\n" +
 	"\fCreateCourse\x12\x1a.admin.CreateCourseRequest\x1a\x1b.admin.CreateCourseResponse\x12G\n" +
 	"\fUpdateCourse\x12\x1a.admin.UpdateCourseRequest\x1a\x1b.admin.UpdateCourseResponse\x12G\n" +
-	"\fDeleteCourse\x12\x1a.admin.DeleteCourseRequest\x1a\x1b.admin.DeleteCourseResponse\x12J\n" +
-	"\rAssignFaculty\x12\x1b.admin.AssignFacultyRequest\x1a\x1c.admin.AssignFacultyResponse\x12A\n" +
+	"\fDeleteCourse\x12\x1a.admin.DeleteCourseRequest\x1a\x1b.admin.DeleteCourseResponse\x12G\n" +
+	"\fCancelCourse\x12\x1a.admin.CancelCourseRequest\x1a\x1b.admin.CancelCourseResponse\x12J\n" +
+	"\rAssignFaculty\x12\x1b.admin.AssignFacultyRequest\x1a\x1c.admin.AssignFacultyResponse\x12e\n" +
+	"\x16ValidateCourseSchedule\x12$.admin.ValidateCourseScheduleRequest\x1a%.admin.ValidateCourseScheduleResponse\x12V\n" +
+	"\x11BulkCreateCourses\x12\x1f.admin.BulkCreateCoursesRequest\x1a .admin.BulkCreateCoursesResponse\x12P\n" +
+	"\x0fAddPrerequisite\x12\x1d.admin.AddPrerequisiteRequest\x1a\x1e.admin.AddPrerequisiteResponse\x12Y\n" +
+	"\x12RemovePrerequisite\x12 .admin.RemovePrerequisiteRequest\x1a!.admin.RemovePrerequisiteResponse\x12S\n" +
+	"\x10CreateDepartment\x12\x1e.admin.CreateDepartmentRequest\x1a\x1f.admin.CreateDepartmentResponse\x12S\n" +
+	"\x10UpdateDepartment\x12\x1e.admin.UpdateDepartmentRequest\x1a\x1f.admin.UpdateDepartmentResponse\x12S\n" +
+	"\x10DeleteDepartment\x12\x1e.admin.DeleteDepartmentRequest\x1a\x1f.admin.DeleteDepartmentResponse\x12A\n" +
 	"\n" +
 	"CreateUser\x12\x18.admin.CreateUserRequest\x1a\x19.admin.CreateUserResponse\x12>\n" +
 	"\tListUsers\x12\x17.admin.ListUsersRequest\x1a\x18.admin.ListUsersResponse\x12J\n" +
+	"\rGetUserDetail\x12\x1b.admin.GetUserDetailRequest\x1a\x1c.admin.GetUserDetailResponse\x12J\n" +
 	"\rResetPassword\x12\x1b.admin.ResetPasswordRequest\x1a\x1c.admin.ResetPasswordResponse\x12S\n" +
-	"\x10ToggleUserStatus\x12\x1e.admin.ToggleUserStatusRequest\x1a\x1f.admin.ToggleUserStatusResponse\x12\\\n" +
+	"\x10ToggleUserStatus\x12\x1e.admin.ToggleUserStatusRequest\x1a\x1f.admin.ToggleUserStatusResponse\x12V\n" +
+	"\x11AddTransferCredit\x12\x1f.admin.AddTransferCreditRequest\x1a .admin.AddTransferCreditResponse\x12>\n" +
+	"\tPlaceHold\x12\x17.admin.PlaceHoldRequest\x1a\x18.admin.PlaceHoldResponse\x12>\n" +
+	"\tClearHold\x12\x17.admin.ClearHoldRequest\x1a\x18.admin.ClearHoldResponse\x12P\n" +
+	"\x0fGetStudentHolds\x12\x1d.admin.GetStudentHoldsRequest\x1a\x1e.admin.GetStudentHoldsResponse\x12\\\n" +
 	"\x13SetEnrollmentPeriod\x12!.admin.SetEnrollmentPeriodRequest\x1a\".admin.SetEnrollmentPeriodResponse\x12S\n" +
 	"\x10ToggleEnrollment\x12\x1e.admin.ToggleEnrollmentRequest\x1a\x1f.admin.ToggleEnrollmentResponse\x12P\n" +
 	"\x0fGetSystemConfig\x12\x1d.admin.GetSystemConfigRequest\x1a\x1e.admin.GetSystemConfigResponse\x12Y\n" +
 	"\x12UpdateSystemConfig\x12 .admin.UpdateSystemConfigRequest\x1a!.admin.UpdateSystemConfigResponse\x12Y\n" +
-	"\x12OverrideEnrollment\x12 .admin.OverrideEnrollmentRequest\x1a!.admin.OverrideEnrollmentResponse\x12M\n" +
+	"\x12OverrideEnrollment\x12 .admin.OverrideEnrollmentRequest\x1a!.admin.OverrideEnrollmentResponse\x12V\n" +
+	"\x11RestoreEnrollment\x12\x1f.admin.RestoreEnrollmentRequest\x1a .admin.RestoreEnrollmentResponse\x12t\n" +
+	"\x1bRecalculateEnrollmentCounts\x12).admin.RecalculateEnrollmentCountsRequest\x1a*.admin.RecalculateEnrollmentCountsResponse\x12_\n" +
+	"\x14GetNearlyFullCourses\x12\".admin.GetNearlyFullCoursesRequest\x1a#.admin.GetNearlyFullCoursesResponse\x12b\n" +
+	"\x15GetCourseFillTimeline\x12#.admin.GetCourseFillTimelineRequest\x1a$.admin.GetCourseFillTimelineResponse\x12Y\n" +
+	"\x12GetEnrollmentTrend\x12 .admin.GetEnrollmentTrendRequest\x1a!.admin.GetEnrollmentTrendResponse\x12_\n" +
+	"\x14GetFacultyLoadReport\x12\".admin.GetFacultyLoadReportRequest\x1a#.admin.GetFacultyLoadReportResponse\x12J\n" +
+	"\rCloseSemester\x12\x1b.admin.CloseSemesterRequest\x1a\x1c.admin.CloseSemesterResponse\x12S\n" +
+	"\x10PublishAllGrades\x12\x1e.admin.PublishAllGradesRequest\x1a\x1f.admin.PublishAllGradesResponse\x12Y\n" +
+	"\x12GetResourceHistory\x12 .admin.GetResourceHistoryRequest\x1a!.admin.GetResourceHistoryResponse\x12M\n" +
 	"\x0eGetSystemStats\x12\x1c.admin.GetSystemStatsRequest\x1a\x1d.admin.GetSystemStatsResponseB\x12Z\x10backend/pb/adminb\x06proto3"
 
 var (
@@ -2304,84 +7078,218 @@ func file_backend_protos_admin_proto_rawDescGZIP() []byte {
 	return file_backend_protos_admin_proto_rawDescData
 }
 
-var file_backend_protos_admin_proto_msgTypes = make([]protoimpl.MessageInfo, 32)
+var file_backend_protos_admin_proto_msgTypes = make([]protoimpl.MessageInfo, 90)
 var file_backend_protos_admin_proto_goTypes = []any{
-	(*Course)(nil),                      // 0: admin.Course
-	(*User)(nil),                        // 1: admin.User
-	(*SystemConfig)(nil),                // 2: admin.SystemConfig
-	(*SystemStats)(nil),                 // 3: admin.SystemStats
-	(*CreateCourseRequest)(nil),         // 4: admin.CreateCourseRequest
-	(*CreateCourseResponse)(nil),        // 5: admin.CreateCourseResponse
-	(*UpdateCourseRequest)(nil),         // 6: admin.UpdateCourseRequest
-	(*UpdateCourseResponse)(nil),        // 7: admin.UpdateCourseResponse
-	(*DeleteCourseRequest)(nil),         // 8: admin.DeleteCourseRequest
-	(*DeleteCourseResponse)(nil),        // 9: admin.DeleteCourseResponse
-	(*AssignFacultyRequest)(nil),        // 10: admin.AssignFacultyRequest
-	(*AssignFacultyResponse)(nil),       // 11: admin.AssignFacultyResponse
-	(*CreateUserRequest)(nil),           // 12: admin.CreateUserRequest
-	(*CreateUserResponse)(nil),          // 13: admin.CreateUserResponse
-	(*ListUsersRequest)(nil),            // 14: admin.ListUsersRequest
-	(*ListUsersResponse)(nil),           // 15: admin.ListUsersResponse
-	(*ResetPasswordRequest)(nil),        // 16: admin.ResetPasswordRequest
-	(*ResetPasswordResponse)(nil),       // 17: admin.ResetPasswordResponse
-	(*ToggleUserStatusRequest)(nil),     // 18: admin.ToggleUserStatusRequest
-	(*ToggleUserStatusResponse)(nil),    // 19: admin.ToggleUserStatusResponse
-	(*SetEnrollmentPeriodRequest)(nil),  // 20: admin.SetEnrollmentPeriodRequest
-	(*SetEnrollmentPeriodResponse)(nil), // 21: admin.SetEnrollmentPeriodResponse
-	(*ToggleEnrollmentRequest)(nil),     // 22: admin.ToggleEnrollmentRequest
-	(*ToggleEnrollmentResponse)(nil),    // 23: admin.ToggleEnrollmentResponse
-	(*GetSystemConfigRequest)(nil),      // 24: admin.GetSystemConfigRequest
-	(*GetSystemConfigResponse)(nil),     // 25: admin.GetSystemConfigResponse
-	(*UpdateSystemConfigRequest)(nil),   // 26: admin.UpdateSystemConfigRequest
-	(*UpdateSystemConfigResponse)(nil),  // 27: admin.UpdateSystemConfigResponse
-	(*OverrideEnrollmentRequest)(nil),   // 28: admin.OverrideEnrollmentRequest
-	(*OverrideEnrollmentResponse)(nil),  // 29: admin.OverrideEnrollmentResponse
-	(*GetSystemStatsRequest)(nil),       // 30: admin.GetSystemStatsRequest
-	(*GetSystemStatsResponse)(nil),      // 31: admin.GetSystemStatsResponse
-	(*timestamppb.Timestamp)(nil),       // 32: google.protobuf.Timestamp
+	(*Course)(nil),                              // 0: admin.Course
+	(*User)(nil),                                // 1: admin.User
+	(*SystemConfig)(nil),                        // 2: admin.SystemConfig
+	(*SystemStats)(nil),                         // 3: admin.SystemStats
+	(*CreateCourseRequest)(nil),                 // 4: admin.CreateCourseRequest
+	(*CreateCourseResponse)(nil),                // 5: admin.CreateCourseResponse
+	(*UpdateCourseRequest)(nil),                 // 6: admin.UpdateCourseRequest
+	(*UpdateCourseResponse)(nil),                // 7: admin.UpdateCourseResponse
+	(*DeleteCourseRequest)(nil),                 // 8: admin.DeleteCourseRequest
+	(*DeleteCourseResponse)(nil),                // 9: admin.DeleteCourseResponse
+	(*CancelCourseRequest)(nil),                 // 10: admin.CancelCourseRequest
+	(*CancelCourseResponse)(nil),                // 11: admin.CancelCourseResponse
+	(*AssignFacultyRequest)(nil),                // 12: admin.AssignFacultyRequest
+	(*AssignFacultyResponse)(nil),               // 13: admin.AssignFacultyResponse
+	(*ValidateCourseScheduleRequest)(nil),       // 14: admin.ValidateCourseScheduleRequest
+	(*ScheduleConflict)(nil),                    // 15: admin.ScheduleConflict
+	(*ValidateCourseScheduleResponse)(nil),      // 16: admin.ValidateCourseScheduleResponse
+	(*CourseDefinition)(nil),                    // 17: admin.CourseDefinition
+	(*BulkCreateCoursesRequest)(nil),            // 18: admin.BulkCreateCoursesRequest
+	(*BulkCourseResult)(nil),                    // 19: admin.BulkCourseResult
+	(*BulkCreateCoursesResponse)(nil),           // 20: admin.BulkCreateCoursesResponse
+	(*AddPrerequisiteRequest)(nil),              // 21: admin.AddPrerequisiteRequest
+	(*AddPrerequisiteResponse)(nil),             // 22: admin.AddPrerequisiteResponse
+	(*RemovePrerequisiteRequest)(nil),           // 23: admin.RemovePrerequisiteRequest
+	(*RemovePrerequisiteResponse)(nil),          // 24: admin.RemovePrerequisiteResponse
+	(*Department)(nil),                          // 25: admin.Department
+	(*CreateDepartmentRequest)(nil),             // 26: admin.CreateDepartmentRequest
+	(*CreateDepartmentResponse)(nil),            // 27: admin.CreateDepartmentResponse
+	(*UpdateDepartmentRequest)(nil),             // 28: admin.UpdateDepartmentRequest
+	(*UpdateDepartmentResponse)(nil),            // 29: admin.UpdateDepartmentResponse
+	(*DeleteDepartmentRequest)(nil),             // 30: admin.DeleteDepartmentRequest
+	(*DeleteDepartmentResponse)(nil),            // 31: admin.DeleteDepartmentResponse
+	(*CreateUserRequest)(nil),                   // 32: admin.CreateUserRequest
+	(*CreateUserResponse)(nil),                  // 33: admin.CreateUserResponse
+	(*ListUsersRequest)(nil),                    // 34: admin.ListUsersRequest
+	(*ListUsersResponse)(nil),                   // 35: admin.ListUsersResponse
+	(*GetUserDetailRequest)(nil),                // 36: admin.GetUserDetailRequest
+	(*UserEnrollment)(nil),                      // 37: admin.UserEnrollment
+	(*GetUserDetailResponse)(nil),               // 38: admin.GetUserDetailResponse
+	(*ResetPasswordRequest)(nil),                // 39: admin.ResetPasswordRequest
+	(*ResetPasswordResponse)(nil),               // 40: admin.ResetPasswordResponse
+	(*ToggleUserStatusRequest)(nil),             // 41: admin.ToggleUserStatusRequest
+	(*ToggleUserStatusResponse)(nil),            // 42: admin.ToggleUserStatusResponse
+	(*TransferCredit)(nil),                      // 43: admin.TransferCredit
+	(*AddTransferCreditRequest)(nil),            // 44: admin.AddTransferCreditRequest
+	(*AddTransferCreditResponse)(nil),           // 45: admin.AddTransferCreditResponse
+	(*Hold)(nil),                                // 46: admin.Hold
+	(*PlaceHoldRequest)(nil),                    // 47: admin.PlaceHoldRequest
+	(*PlaceHoldResponse)(nil),                   // 48: admin.PlaceHoldResponse
+	(*ClearHoldRequest)(nil),                    // 49: admin.ClearHoldRequest
+	(*ClearHoldResponse)(nil),                   // 50: admin.ClearHoldResponse
+	(*GetStudentHoldsRequest)(nil),              // 51: admin.GetStudentHoldsRequest
+	(*GetStudentHoldsResponse)(nil),             // 52: admin.GetStudentHoldsResponse
+	(*SetEnrollmentPeriodRequest)(nil),          // 53: admin.SetEnrollmentPeriodRequest
+	(*SetEnrollmentPeriodResponse)(nil),         // 54: admin.SetEnrollmentPeriodResponse
+	(*ToggleEnrollmentRequest)(nil),             // 55: admin.ToggleEnrollmentRequest
+	(*ToggleEnrollmentResponse)(nil),            // 56: admin.ToggleEnrollmentResponse
+	(*GetSystemConfigRequest)(nil),              // 57: admin.GetSystemConfigRequest
+	(*GetSystemConfigResponse)(nil),             // 58: admin.GetSystemConfigResponse
+	(*UpdateSystemConfigRequest)(nil),           // 59: admin.UpdateSystemConfigRequest
+	(*UpdateSystemConfigResponse)(nil),          // 60: admin.UpdateSystemConfigResponse
+	(*OverrideEnrollmentRequest)(nil),           // 61: admin.OverrideEnrollmentRequest
+	(*OverrideEnrollmentResponse)(nil),          // 62: admin.OverrideEnrollmentResponse
+	(*RestoreEnrollmentRequest)(nil),            // 63: admin.RestoreEnrollmentRequest
+	(*RestoreEnrollmentResponse)(nil),           // 64: admin.RestoreEnrollmentResponse
+	(*RecalculateEnrollmentCountsRequest)(nil),  // 65: admin.RecalculateEnrollmentCountsRequest
+	(*EnrollmentCountCorrection)(nil),           // 66: admin.EnrollmentCountCorrection
+	(*RecalculateEnrollmentCountsResponse)(nil), // 67: admin.RecalculateEnrollmentCountsResponse
+	(*CloseSemesterRequest)(nil),                // 68: admin.CloseSemesterRequest
+	(*CloseSemesterResponse)(nil),               // 69: admin.CloseSemesterResponse
+	(*PublishAllGradesRequest)(nil),             // 70: admin.PublishAllGradesRequest
+	(*PublishAllGradesResponse)(nil),            // 71: admin.PublishAllGradesResponse
+	(*GetNearlyFullCoursesRequest)(nil),         // 72: admin.GetNearlyFullCoursesRequest
+	(*NearlyFullCourse)(nil),                    // 73: admin.NearlyFullCourse
+	(*GetNearlyFullCoursesResponse)(nil),        // 74: admin.GetNearlyFullCoursesResponse
+	(*GetCourseFillTimelineRequest)(nil),        // 75: admin.GetCourseFillTimelineRequest
+	(*FillTimelinePoint)(nil),                   // 76: admin.FillTimelinePoint
+	(*GetCourseFillTimelineResponse)(nil),       // 77: admin.GetCourseFillTimelineResponse
+	(*GetEnrollmentTrendRequest)(nil),           // 78: admin.GetEnrollmentTrendRequest
+	(*EnrollmentTrendBucket)(nil),               // 79: admin.EnrollmentTrendBucket
+	(*GetEnrollmentTrendResponse)(nil),          // 80: admin.GetEnrollmentTrendResponse
+	(*GetFacultyLoadReportRequest)(nil),         // 81: admin.GetFacultyLoadReportRequest
+	(*FacultyLoad)(nil),                         // 82: admin.FacultyLoad
+	(*GetFacultyLoadReportResponse)(nil),        // 83: admin.GetFacultyLoadReportResponse
+	(*AuditEvent)(nil),                          // 84: admin.AuditEvent
+	(*GetResourceHistoryRequest)(nil),           // 85: admin.GetResourceHistoryRequest
+	(*GetResourceHistoryResponse)(nil),          // 86: admin.GetResourceHistoryResponse
+	(*GetSystemStatsRequest)(nil),               // 87: admin.GetSystemStatsRequest
+	(*GetSystemStatsResponse)(nil),              // 88: admin.GetSystemStatsResponse
+	nil,                                         // 89: admin.AuditEvent.DetailsEntry
+	(*timestamppb.Timestamp)(nil),               // 90: google.protobuf.Timestamp
 }
 var file_backend_protos_admin_proto_depIdxs = []int32{
-	32, // 0: admin.User.created_at:type_name -> google.protobuf.Timestamp
-	32, // 1: admin.SystemConfig.updated_at:type_name -> google.protobuf.Timestamp
-	0,  // 2: admin.CreateCourseResponse.course:type_name -> admin.Course
-	0,  // 3: admin.UpdateCourseResponse.course:type_name -> admin.Course
-	1,  // 4: admin.CreateUserResponse.user:type_name -> admin.User
-	1,  // 5: admin.ListUsersResponse.users:type_name -> admin.User
-	2,  // 6: admin.GetSystemConfigResponse.configs:type_name -> admin.SystemConfig
-	3,  // 7: admin.GetSystemStatsResponse.stats:type_name -> admin.SystemStats
-	4,  // 8: admin.AdminService.CreateCourse:input_type -> admin.CreateCourseRequest
-	6,  // 9: admin.AdminService.UpdateCourse:input_type -> admin.UpdateCourseRequest
-	8,  // 10: admin.AdminService.DeleteCourse:input_type -> admin.DeleteCourseRequest
-	10, // 11: admin.AdminService.AssignFaculty:input_type -> admin.AssignFacultyRequest
-	12, // 12: admin.AdminService.CreateUser:input_type -> admin.CreateUserRequest
-	14, // 13: admin.AdminService.ListUsers:input_type -> admin.ListUsersRequest
-	16, // 14: admin.AdminService.ResetPassword:input_type -> admin.ResetPasswordRequest
-	18, // 15: admin.AdminService.ToggleUserStatus:input_type -> admin.ToggleUserStatusRequest
-	20, // 16: admin.AdminService.SetEnrollmentPeriod:input_type -> admin.SetEnrollmentPeriodRequest
-	22, // 17: admin.AdminService.ToggleEnrollment:input_type -> admin.ToggleEnrollmentRequest
-	24, // 18: admin.AdminService.GetSystemConfig:input_type -> admin.GetSystemConfigRequest
-	26, // 19: admin.AdminService.UpdateSystemConfig:input_type -> admin.UpdateSystemConfigRequest
-	28, // 20: admin.AdminService.OverrideEnrollment:input_type -> admin.OverrideEnrollmentRequest
-	30, // 21: admin.AdminService.GetSystemStats:input_type -> admin.GetSystemStatsRequest
-	5,  // 22: admin.AdminService.CreateCourse:output_type -> admin.CreateCourseResponse
-	7,  // 23: admin.AdminService.UpdateCourse:output_type -> admin.UpdateCourseResponse
-	9,  // 24: admin.AdminService.DeleteCourse:output_type -> admin.DeleteCourseResponse
-	11, // 25: admin.AdminService.AssignFaculty:output_type -> admin.AssignFacultyResponse
-	13, // 26: admin.AdminService.CreateUser:output_type -> admin.CreateUserResponse
-	15, // 27: admin.AdminService.ListUsers:output_type -> admin.ListUsersResponse
-	17, // 28: admin.AdminService.ResetPassword:output_type -> admin.ResetPasswordResponse
-	19, // 29: admin.AdminService.ToggleUserStatus:output_type -> admin.ToggleUserStatusResponse
-	21, // 30: admin.AdminService.SetEnrollmentPeriod:output_type -> admin.SetEnrollmentPeriodResponse
-	23, // 31: admin.AdminService.ToggleEnrollment:output_type -> admin.ToggleEnrollmentResponse
-	25, // 32: admin.AdminService.GetSystemConfig:output_type -> admin.GetSystemConfigResponse
-	27, // 33: admin.AdminService.UpdateSystemConfig:output_type -> admin.UpdateSystemConfigResponse
-	29, // 34: admin.AdminService.OverrideEnrollment:output_type -> admin.OverrideEnrollmentResponse
-	31, // 35: admin.AdminService.GetSystemStats:output_type -> admin.GetSystemStatsResponse
-	22, // [22:36] is the sub-list for method output_type
-	8,  // [8:22] is the sub-list for method input_type
-	8,  // [8:8] is the sub-list for extension type_name
-	8,  // [8:8] is the sub-list for extension extendee
-	0,  // [0:8] is the sub-list for field type_name
+	90, // 0: admin.Course.enroll_open_at:type_name -> google.protobuf.Timestamp
+	90, // 1: admin.Course.enroll_close_at:type_name -> google.protobuf.Timestamp
+	90, // 2: admin.User.created_at:type_name -> google.protobuf.Timestamp
+	90, // 3: admin.User.last_login_at:type_name -> google.protobuf.Timestamp
+	90, // 4: admin.SystemConfig.updated_at:type_name -> google.protobuf.Timestamp
+	0,  // 5: admin.CreateCourseResponse.course:type_name -> admin.Course
+	0,  // 6: admin.UpdateCourseResponse.course:type_name -> admin.Course
+	15, // 7: admin.ValidateCourseScheduleResponse.conflicts:type_name -> admin.ScheduleConflict
+	17, // 8: admin.BulkCreateCoursesRequest.courses:type_name -> admin.CourseDefinition
+	19, // 9: admin.BulkCreateCoursesResponse.results:type_name -> admin.BulkCourseResult
+	90, // 10: admin.Department.created_at:type_name -> google.protobuf.Timestamp
+	90, // 11: admin.Department.updated_at:type_name -> google.protobuf.Timestamp
+	25, // 12: admin.CreateDepartmentResponse.department:type_name -> admin.Department
+	25, // 13: admin.UpdateDepartmentResponse.department:type_name -> admin.Department
+	1,  // 14: admin.CreateUserResponse.user:type_name -> admin.User
+	1,  // 15: admin.ListUsersResponse.users:type_name -> admin.User
+	1,  // 16: admin.GetUserDetailResponse.user:type_name -> admin.User
+	37, // 17: admin.GetUserDetailResponse.enrollments:type_name -> admin.UserEnrollment
+	0,  // 18: admin.GetUserDetailResponse.assigned_courses:type_name -> admin.Course
+	90, // 19: admin.TransferCredit.created_at:type_name -> google.protobuf.Timestamp
+	43, // 20: admin.AddTransferCreditResponse.transfer_credit:type_name -> admin.TransferCredit
+	90, // 21: admin.Hold.placed_at:type_name -> google.protobuf.Timestamp
+	90, // 22: admin.Hold.cleared_at:type_name -> google.protobuf.Timestamp
+	46, // 23: admin.PlaceHoldResponse.hold:type_name -> admin.Hold
+	46, // 24: admin.ClearHoldResponse.hold:type_name -> admin.Hold
+	46, // 25: admin.GetStudentHoldsResponse.holds:type_name -> admin.Hold
+	2,  // 26: admin.GetSystemConfigResponse.configs:type_name -> admin.SystemConfig
+	66, // 27: admin.RecalculateEnrollmentCountsResponse.corrections:type_name -> admin.EnrollmentCountCorrection
+	73, // 28: admin.GetNearlyFullCoursesResponse.courses:type_name -> admin.NearlyFullCourse
+	90, // 29: admin.FillTimelinePoint.timestamp:type_name -> google.protobuf.Timestamp
+	76, // 30: admin.GetCourseFillTimelineResponse.points:type_name -> admin.FillTimelinePoint
+	90, // 31: admin.GetEnrollmentTrendRequest.from:type_name -> google.protobuf.Timestamp
+	90, // 32: admin.GetEnrollmentTrendRequest.to:type_name -> google.protobuf.Timestamp
+	90, // 33: admin.EnrollmentTrendBucket.start:type_name -> google.protobuf.Timestamp
+	79, // 34: admin.GetEnrollmentTrendResponse.buckets:type_name -> admin.EnrollmentTrendBucket
+	82, // 35: admin.GetFacultyLoadReportResponse.faculty:type_name -> admin.FacultyLoad
+	90, // 36: admin.AuditEvent.timestamp:type_name -> google.protobuf.Timestamp
+	89, // 37: admin.AuditEvent.details:type_name -> admin.AuditEvent.DetailsEntry
+	84, // 38: admin.GetResourceHistoryResponse.events:type_name -> admin.AuditEvent
+	3,  // 39: admin.GetSystemStatsResponse.stats:type_name -> admin.SystemStats
+	4,  // 40: admin.AdminService.CreateCourse:input_type -> admin.CreateCourseRequest
+	6,  // 41: admin.AdminService.UpdateCourse:input_type -> admin.UpdateCourseRequest
+	8,  // 42: admin.AdminService.DeleteCourse:input_type -> admin.DeleteCourseRequest
+	10, // 43: admin.AdminService.CancelCourse:input_type -> admin.CancelCourseRequest
+	12, // 44: admin.AdminService.AssignFaculty:input_type -> admin.AssignFacultyRequest
+	14, // 45: admin.AdminService.ValidateCourseSchedule:input_type -> admin.ValidateCourseScheduleRequest
+	18, // 46: admin.AdminService.BulkCreateCourses:input_type -> admin.BulkCreateCoursesRequest
+	21, // 47: admin.AdminService.AddPrerequisite:input_type -> admin.AddPrerequisiteRequest
+	23, // 48: admin.AdminService.RemovePrerequisite:input_type -> admin.RemovePrerequisiteRequest
+	26, // 49: admin.AdminService.CreateDepartment:input_type -> admin.CreateDepartmentRequest
+	28, // 50: admin.AdminService.UpdateDepartment:input_type -> admin.UpdateDepartmentRequest
+	30, // 51: admin.AdminService.DeleteDepartment:input_type -> admin.DeleteDepartmentRequest
+	32, // 52: admin.AdminService.CreateUser:input_type -> admin.CreateUserRequest
+	34, // 53: admin.AdminService.ListUsers:input_type -> admin.ListUsersRequest
+	36, // 54: admin.AdminService.GetUserDetail:input_type -> admin.GetUserDetailRequest
+	39, // 55: admin.AdminService.ResetPassword:input_type -> admin.ResetPasswordRequest
+	41, // 56: admin.AdminService.ToggleUserStatus:input_type -> admin.ToggleUserStatusRequest
+	44, // 57: admin.AdminService.AddTransferCredit:input_type -> admin.AddTransferCreditRequest
+	47, // 58: admin.AdminService.PlaceHold:input_type -> admin.PlaceHoldRequest
+	49, // 59: admin.AdminService.ClearHold:input_type -> admin.ClearHoldRequest
+	51, // 60: admin.AdminService.GetStudentHolds:input_type -> admin.GetStudentHoldsRequest
+	53, // 61: admin.AdminService.SetEnrollmentPeriod:input_type -> admin.SetEnrollmentPeriodRequest
+	55, // 62: admin.AdminService.ToggleEnrollment:input_type -> admin.ToggleEnrollmentRequest
+	57, // 63: admin.AdminService.GetSystemConfig:input_type -> admin.GetSystemConfigRequest
+	59, // 64: admin.AdminService.UpdateSystemConfig:input_type -> admin.UpdateSystemConfigRequest
+	61, // 65: admin.AdminService.OverrideEnrollment:input_type -> admin.OverrideEnrollmentRequest
+	63, // 66: admin.AdminService.RestoreEnrollment:input_type -> admin.RestoreEnrollmentRequest
+	65, // 67: admin.AdminService.RecalculateEnrollmentCounts:input_type -> admin.RecalculateEnrollmentCountsRequest
+	72, // 68: admin.AdminService.GetNearlyFullCourses:input_type -> admin.GetNearlyFullCoursesRequest
+	75, // 69: admin.AdminService.GetCourseFillTimeline:input_type -> admin.GetCourseFillTimelineRequest
+	78, // 70: admin.AdminService.GetEnrollmentTrend:input_type -> admin.GetEnrollmentTrendRequest
+	81, // 71: admin.AdminService.GetFacultyLoadReport:input_type -> admin.GetFacultyLoadReportRequest
+	68, // 72: admin.AdminService.CloseSemester:input_type -> admin.CloseSemesterRequest
+	70, // 73: admin.AdminService.PublishAllGrades:input_type -> admin.PublishAllGradesRequest
+	85, // 74: admin.AdminService.GetResourceHistory:input_type -> admin.GetResourceHistoryRequest
+	87, // 75: admin.AdminService.GetSystemStats:input_type -> admin.GetSystemStatsRequest
+	5,  // 76: admin.AdminService.CreateCourse:output_type -> admin.CreateCourseResponse
+	7,  // 77: admin.AdminService.UpdateCourse:output_type -> admin.UpdateCourseResponse
+	9,  // 78: admin.AdminService.DeleteCourse:output_type -> admin.DeleteCourseResponse
+	11, // 79: admin.AdminService.CancelCourse:output_type -> admin.CancelCourseResponse
+	13, // 80: admin.AdminService.AssignFaculty:output_type -> admin.AssignFacultyResponse
+	16, // 81: admin.AdminService.ValidateCourseSchedule:output_type -> admin.ValidateCourseScheduleResponse
+	20, // 82: admin.AdminService.BulkCreateCourses:output_type -> admin.BulkCreateCoursesResponse
+	22, // 83: admin.AdminService.AddPrerequisite:output_type -> admin.AddPrerequisiteResponse
+	24, // 84: admin.AdminService.RemovePrerequisite:output_type -> admin.RemovePrerequisiteResponse
+	27, // 85: admin.AdminService.CreateDepartment:output_type -> admin.CreateDepartmentResponse
+	29, // 86: admin.AdminService.UpdateDepartment:output_type -> admin.UpdateDepartmentResponse
+	31, // 87: admin.AdminService.DeleteDepartment:output_type -> admin.DeleteDepartmentResponse
+	33, // 88: admin.AdminService.CreateUser:output_type -> admin.CreateUserResponse
+	35, // 89: admin.AdminService.ListUsers:output_type -> admin.ListUsersResponse
+	38, // 90: admin.AdminService.GetUserDetail:output_type -> admin.GetUserDetailResponse
+	40, // 91: admin.AdminService.ResetPassword:output_type -> admin.ResetPasswordResponse
+	42, // 92: admin.AdminService.ToggleUserStatus:output_type -> admin.ToggleUserStatusResponse
+	45, // 93: admin.AdminService.AddTransferCredit:output_type -> admin.AddTransferCreditResponse
+	48, // 94: admin.AdminService.PlaceHold:output_type -> admin.PlaceHoldResponse
+	50, // 95: admin.AdminService.ClearHold:output_type -> admin.ClearHoldResponse
+	52, // 96: admin.AdminService.GetStudentHolds:output_type -> admin.GetStudentHoldsResponse
+	54, // 97: admin.AdminService.SetEnrollmentPeriod:output_type -> admin.SetEnrollmentPeriodResponse
+	56, // 98: admin.AdminService.ToggleEnrollment:output_type -> admin.ToggleEnrollmentResponse
+	58, // 99: admin.AdminService.GetSystemConfig:output_type -> admin.GetSystemConfigResponse
+	60, // 100: admin.AdminService.UpdateSystemConfig:output_type -> admin.UpdateSystemConfigResponse
+	62, // 101: admin.AdminService.OverrideEnrollment:output_type -> admin.OverrideEnrollmentResponse
+	64, // 102: admin.AdminService.RestoreEnrollment:output_type -> admin.RestoreEnrollmentResponse
+	67, // 103: admin.AdminService.RecalculateEnrollmentCounts:output_type -> admin.RecalculateEnrollmentCountsResponse
+	74, // 104: admin.AdminService.GetNearlyFullCourses:output_type -> admin.GetNearlyFullCoursesResponse
+	77, // 105: admin.AdminService.GetCourseFillTimeline:output_type -> admin.GetCourseFillTimelineResponse
+	80, // 106: admin.AdminService.GetEnrollmentTrend:output_type -> admin.GetEnrollmentTrendResponse
+	83, // 107: admin.AdminService.GetFacultyLoadReport:output_type -> admin.GetFacultyLoadReportResponse
+	69, // 108: admin.AdminService.CloseSemester:output_type -> admin.CloseSemesterResponse
+	71, // 109: admin.AdminService.PublishAllGrades:output_type -> admin.PublishAllGradesResponse
+	86, // 110: admin.AdminService.GetResourceHistory:output_type -> admin.GetResourceHistoryResponse
+	88, // 111: admin.AdminService.GetSystemStats:output_type -> admin.GetSystemStatsResponse
+	76, // [76:112] is the sub-list for method output_type
+	40, // [40:76] is the sub-list for method input_type
+	40, // [40:40] is the sub-list for extension type_name
+	40, // [40:40] is the sub-list for extension extendee
+	0,  // [0:40] is the sub-list for field type_name
 }
 
 func init() { file_backend_protos_admin_proto_init() }
@@ -2395,7 +7303,7 @@ func file_backend_protos_admin_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_backend_protos_admin_proto_rawDesc), len(file_backend_protos_admin_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   32,
+			NumMessages:   90,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,20 +19,42 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	AdminService_CreateCourse_FullMethodName        = "/admin.AdminService/CreateCourse"
-	AdminService_UpdateCourse_FullMethodName        = "/admin.AdminService/UpdateCourse"
-	AdminService_DeleteCourse_FullMethodName        = "/admin.AdminService/DeleteCourse"
-	AdminService_AssignFaculty_FullMethodName       = "/admin.AdminService/AssignFaculty"
-	AdminService_CreateUser_FullMethodName          = "/admin.AdminService/CreateUser"
-	AdminService_ListUsers_FullMethodName           = "/admin.AdminService/ListUsers"
-	AdminService_ResetPassword_FullMethodName       = "/admin.AdminService/ResetPassword"
-	AdminService_ToggleUserStatus_FullMethodName    = "/admin.AdminService/ToggleUserStatus"
-	AdminService_SetEnrollmentPeriod_FullMethodName = "/admin.AdminService/SetEnrollmentPeriod"
-	AdminService_ToggleEnrollment_FullMethodName    = "/admin.AdminService/ToggleEnrollment"
-	AdminService_GetSystemConfig_FullMethodName     = "/admin.AdminService/GetSystemConfig"
-	AdminService_UpdateSystemConfig_FullMethodName  = "/admin.AdminService/UpdateSystemConfig"
-	AdminService_OverrideEnrollment_FullMethodName  = "/admin.AdminService/OverrideEnrollment"
-	AdminService_GetSystemStats_FullMethodName      = "/admin.AdminService/GetSystemStats"
+	AdminService_CreateCourse_FullMethodName                = "/admin.AdminService/CreateCourse"
+	AdminService_UpdateCourse_FullMethodName                = "/admin.AdminService/UpdateCourse"
+	AdminService_DeleteCourse_FullMethodName                = "/admin.AdminService/DeleteCourse"
+	AdminService_CancelCourse_FullMethodName                = "/admin.AdminService/CancelCourse"
+	AdminService_AssignFaculty_FullMethodName               = "/admin.AdminService/AssignFaculty"
+	AdminService_ValidateCourseSchedule_FullMethodName      = "/admin.AdminService/ValidateCourseSchedule"
+	AdminService_BulkCreateCourses_FullMethodName           = "/admin.AdminService/BulkCreateCourses"
+	AdminService_AddPrerequisite_FullMethodName             = "/admin.AdminService/AddPrerequisite"
+	AdminService_RemovePrerequisite_FullMethodName          = "/admin.AdminService/RemovePrerequisite"
+	AdminService_CreateDepartment_FullMethodName            = "/admin.AdminService/CreateDepartment"
+	AdminService_UpdateDepartment_FullMethodName            = "/admin.AdminService/UpdateDepartment"
+	AdminService_DeleteDepartment_FullMethodName            = "/admin.AdminService/DeleteDepartment"
+	AdminService_CreateUser_FullMethodName                  = "/admin.AdminService/CreateUser"
+	AdminService_ListUsers_FullMethodName                   = "/admin.AdminService/ListUsers"
+	AdminService_GetUserDetail_FullMethodName               = "/admin.AdminService/GetUserDetail"
+	AdminService_ResetPassword_FullMethodName               = "/admin.AdminService/ResetPassword"
+	AdminService_ToggleUserStatus_FullMethodName            = "/admin.AdminService/ToggleUserStatus"
+	AdminService_AddTransferCredit_FullMethodName           = "/admin.AdminService/AddTransferCredit"
+	AdminService_PlaceHold_FullMethodName                   = "/admin.AdminService/PlaceHold"
+	AdminService_ClearHold_FullMethodName                   = "/admin.AdminService/ClearHold"
+	AdminService_GetStudentHolds_FullMethodName             = "/admin.AdminService/GetStudentHolds"
+	AdminService_SetEnrollmentPeriod_FullMethodName         = "/admin.AdminService/SetEnrollmentPeriod"
+	AdminService_ToggleEnrollment_FullMethodName            = "/admin.AdminService/ToggleEnrollment"
+	AdminService_GetSystemConfig_FullMethodName             = "/admin.AdminService/GetSystemConfig"
+	AdminService_UpdateSystemConfig_FullMethodName          = "/admin.AdminService/UpdateSystemConfig"
+	AdminService_OverrideEnrollment_FullMethodName          = "/admin.AdminService/OverrideEnrollment"
+	AdminService_RestoreEnrollment_FullMethodName           = "/admin.AdminService/RestoreEnrollment"
+	AdminService_RecalculateEnrollmentCounts_FullMethodName = "/admin.AdminService/RecalculateEnrollmentCounts"
+	AdminService_GetNearlyFullCourses_FullMethodName        = "/admin.AdminService/GetNearlyFullCourses"
+	AdminService_GetCourseFillTimeline_FullMethodName       = "/admin.AdminService/GetCourseFillTimeline"
+	AdminService_GetEnrollmentTrend_FullMethodName          = "/admin.AdminService/GetEnrollmentTrend"
+	AdminService_GetFacultyLoadReport_FullMethodName        = "/admin.AdminService/GetFacultyLoadReport"
+	AdminService_CloseSemester_FullMethodName               = "/admin.AdminService/CloseSemester"
+	AdminService_PublishAllGrades_FullMethodName            = "/admin.AdminService/PublishAllGrades"
+	AdminService_GetResourceHistory_FullMethodName          = "/admin.AdminService/GetResourceHistory"
+	AdminService_GetSystemStats_FullMethodName              = "/admin.AdminService/GetSystemStats"
 )
 
 // AdminServiceClient is the client API for AdminService service.
@@ -45,12 +67,27 @@ type AdminServiceClient interface {
 	CreateCourse(ctx context.Context, in *CreateCourseRequest, opts ...grpc.CallOption) (*CreateCourseResponse, error)
 	UpdateCourse(ctx context.Context, in *UpdateCourseRequest, opts ...grpc.CallOption) (*UpdateCourseResponse, error)
 	DeleteCourse(ctx context.Context, in *DeleteCourseRequest, opts ...grpc.CallOption) (*DeleteCourseResponse, error)
+	CancelCourse(ctx context.Context, in *CancelCourseRequest, opts ...grpc.CallOption) (*CancelCourseResponse, error)
 	AssignFaculty(ctx context.Context, in *AssignFacultyRequest, opts ...grpc.CallOption) (*AssignFacultyResponse, error)
+	ValidateCourseSchedule(ctx context.Context, in *ValidateCourseScheduleRequest, opts ...grpc.CallOption) (*ValidateCourseScheduleResponse, error)
+	BulkCreateCourses(ctx context.Context, in *BulkCreateCoursesRequest, opts ...grpc.CallOption) (*BulkCreateCoursesResponse, error)
+	AddPrerequisite(ctx context.Context, in *AddPrerequisiteRequest, opts ...grpc.CallOption) (*AddPrerequisiteResponse, error)
+	RemovePrerequisite(ctx context.Context, in *RemovePrerequisiteRequest, opts ...grpc.CallOption) (*RemovePrerequisiteResponse, error)
+	// Departments
+	CreateDepartment(ctx context.Context, in *CreateDepartmentRequest, opts ...grpc.CallOption) (*CreateDepartmentResponse, error)
+	UpdateDepartment(ctx context.Context, in *UpdateDepartmentRequest, opts ...grpc.CallOption) (*UpdateDepartmentResponse, error)
+	DeleteDepartment(ctx context.Context, in *DeleteDepartmentRequest, opts ...grpc.CallOption) (*DeleteDepartmentResponse, error)
 	// User Management
 	CreateUser(ctx context.Context, in *CreateUserRequest, opts ...grpc.CallOption) (*CreateUserResponse, error)
 	ListUsers(ctx context.Context, in *ListUsersRequest, opts ...grpc.CallOption) (*ListUsersResponse, error)
+	GetUserDetail(ctx context.Context, in *GetUserDetailRequest, opts ...grpc.CallOption) (*GetUserDetailResponse, error)
 	ResetPassword(ctx context.Context, in *ResetPasswordRequest, opts ...grpc.CallOption) (*ResetPasswordResponse, error)
 	ToggleUserStatus(ctx context.Context, in *ToggleUserStatusRequest, opts ...grpc.CallOption) (*ToggleUserStatusResponse, error)
+	AddTransferCredit(ctx context.Context, in *AddTransferCreditRequest, opts ...grpc.CallOption) (*AddTransferCreditResponse, error)
+	// Holds
+	PlaceHold(ctx context.Context, in *PlaceHoldRequest, opts ...grpc.CallOption) (*PlaceHoldResponse, error)
+	ClearHold(ctx context.Context, in *ClearHoldRequest, opts ...grpc.CallOption) (*ClearHoldResponse, error)
+	GetStudentHolds(ctx context.Context, in *GetStudentHoldsRequest, opts ...grpc.CallOption) (*GetStudentHoldsResponse, error)
 	// System Configuration
 	SetEnrollmentPeriod(ctx context.Context, in *SetEnrollmentPeriodRequest, opts ...grpc.CallOption) (*SetEnrollmentPeriodResponse, error)
 	ToggleEnrollment(ctx context.Context, in *ToggleEnrollmentRequest, opts ...grpc.CallOption) (*ToggleEnrollmentResponse, error)
@@ -58,6 +95,17 @@ type AdminServiceClient interface {
 	UpdateSystemConfig(ctx context.Context, in *UpdateSystemConfigRequest, opts ...grpc.CallOption) (*UpdateSystemConfigResponse, error)
 	// Overrides
 	OverrideEnrollment(ctx context.Context, in *OverrideEnrollmentRequest, opts ...grpc.CallOption) (*OverrideEnrollmentResponse, error)
+	RestoreEnrollment(ctx context.Context, in *RestoreEnrollmentRequest, opts ...grpc.CallOption) (*RestoreEnrollmentResponse, error)
+	RecalculateEnrollmentCounts(ctx context.Context, in *RecalculateEnrollmentCountsRequest, opts ...grpc.CallOption) (*RecalculateEnrollmentCountsResponse, error)
+	GetNearlyFullCourses(ctx context.Context, in *GetNearlyFullCoursesRequest, opts ...grpc.CallOption) (*GetNearlyFullCoursesResponse, error)
+	GetCourseFillTimeline(ctx context.Context, in *GetCourseFillTimelineRequest, opts ...grpc.CallOption) (*GetCourseFillTimelineResponse, error)
+	GetEnrollmentTrend(ctx context.Context, in *GetEnrollmentTrendRequest, opts ...grpc.CallOption) (*GetEnrollmentTrendResponse, error)
+	GetFacultyLoadReport(ctx context.Context, in *GetFacultyLoadReportRequest, opts ...grpc.CallOption) (*GetFacultyLoadReportResponse, error)
+	// Semester Rollover
+	CloseSemester(ctx context.Context, in *CloseSemesterRequest, opts ...grpc.CallOption) (*CloseSemesterResponse, error)
+	PublishAllGrades(ctx context.Context, in *PublishAllGradesRequest, opts ...grpc.CallOption) (*PublishAllGradesResponse, error)
+	// Audit
+	GetResourceHistory(ctx context.Context, in *GetResourceHistoryRequest, opts ...grpc.CallOption) (*GetResourceHistoryResponse, error)
 	// Statistics
 	GetSystemStats(ctx context.Context, in *GetSystemStatsRequest, opts ...grpc.CallOption) (*GetSystemStatsResponse, error)
 }
@@ -100,6 +148,16 @@ func (c *adminServiceClient) DeleteCourse(ctx context.Context, in *DeleteCourseR
 	return out, nil
 }
 
+func (c *adminServiceClient) CancelCourse(ctx context.Context, in *CancelCourseRequest, opts ...grpc.CallOption) (*CancelCourseResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CancelCourseResponse)
+	err := c.cc.Invoke(ctx, AdminService_CancelCourse_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *adminServiceClient) AssignFaculty(ctx context.Context, in *AssignFacultyRequest, opts ...grpc.CallOption) (*AssignFacultyResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(AssignFacultyResponse)
@@ -110,6 +168,76 @@ func (c *adminServiceClient) AssignFaculty(ctx context.Context, in *AssignFacult
 	return out, nil
 }
 
+func (c *adminServiceClient) ValidateCourseSchedule(ctx context.Context, in *ValidateCourseScheduleRequest, opts ...grpc.CallOption) (*ValidateCourseScheduleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ValidateCourseScheduleResponse)
+	err := c.cc.Invoke(ctx, AdminService_ValidateCourseSchedule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) BulkCreateCourses(ctx context.Context, in *BulkCreateCoursesRequest, opts ...grpc.CallOption) (*BulkCreateCoursesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BulkCreateCoursesResponse)
+	err := c.cc.Invoke(ctx, AdminService_BulkCreateCourses_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) AddPrerequisite(ctx context.Context, in *AddPrerequisiteRequest, opts ...grpc.CallOption) (*AddPrerequisiteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddPrerequisiteResponse)
+	err := c.cc.Invoke(ctx, AdminService_AddPrerequisite_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) RemovePrerequisite(ctx context.Context, in *RemovePrerequisiteRequest, opts ...grpc.CallOption) (*RemovePrerequisiteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemovePrerequisiteResponse)
+	err := c.cc.Invoke(ctx, AdminService_RemovePrerequisite_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) CreateDepartment(ctx context.Context, in *CreateDepartmentRequest, opts ...grpc.CallOption) (*CreateDepartmentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateDepartmentResponse)
+	err := c.cc.Invoke(ctx, AdminService_CreateDepartment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) UpdateDepartment(ctx context.Context, in *UpdateDepartmentRequest, opts ...grpc.CallOption) (*UpdateDepartmentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateDepartmentResponse)
+	err := c.cc.Invoke(ctx, AdminService_UpdateDepartment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) DeleteDepartment(ctx context.Context, in *DeleteDepartmentRequest, opts ...grpc.CallOption) (*DeleteDepartmentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteDepartmentResponse)
+	err := c.cc.Invoke(ctx, AdminService_DeleteDepartment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *adminServiceClient) CreateUser(ctx context.Context, in *CreateUserRequest, opts ...grpc.CallOption) (*CreateUserResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CreateUserResponse)
@@ -130,6 +258,16 @@ func (c *adminServiceClient) ListUsers(ctx context.Context, in *ListUsersRequest
 	return out, nil
 }
 
+func (c *adminServiceClient) GetUserDetail(ctx context.Context, in *GetUserDetailRequest, opts ...grpc.CallOption) (*GetUserDetailResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetUserDetailResponse)
+	err := c.cc.Invoke(ctx, AdminService_GetUserDetail_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *adminServiceClient) ResetPassword(ctx context.Context, in *ResetPasswordRequest, opts ...grpc.CallOption) (*ResetPasswordResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ResetPasswordResponse)
@@ -150,6 +288,46 @@ func (c *adminServiceClient) ToggleUserStatus(ctx context.Context, in *ToggleUse
 	return out, nil
 }
 
+func (c *adminServiceClient) AddTransferCredit(ctx context.Context, in *AddTransferCreditRequest, opts ...grpc.CallOption) (*AddTransferCreditResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddTransferCreditResponse)
+	err := c.cc.Invoke(ctx, AdminService_AddTransferCredit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) PlaceHold(ctx context.Context, in *PlaceHoldRequest, opts ...grpc.CallOption) (*PlaceHoldResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PlaceHoldResponse)
+	err := c.cc.Invoke(ctx, AdminService_PlaceHold_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ClearHold(ctx context.Context, in *ClearHoldRequest, opts ...grpc.CallOption) (*ClearHoldResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ClearHoldResponse)
+	err := c.cc.Invoke(ctx, AdminService_ClearHold_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) GetStudentHolds(ctx context.Context, in *GetStudentHoldsRequest, opts ...grpc.CallOption) (*GetStudentHoldsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetStudentHoldsResponse)
+	err := c.cc.Invoke(ctx, AdminService_GetStudentHolds_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *adminServiceClient) SetEnrollmentPeriod(ctx context.Context, in *SetEnrollmentPeriodRequest, opts ...grpc.CallOption) (*SetEnrollmentPeriodResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(SetEnrollmentPeriodResponse)
@@ -200,6 +378,96 @@ func (c *adminServiceClient) OverrideEnrollment(ctx context.Context, in *Overrid
 	return out, nil
 }
 
+func (c *adminServiceClient) RestoreEnrollment(ctx context.Context, in *RestoreEnrollmentRequest, opts ...grpc.CallOption) (*RestoreEnrollmentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestoreEnrollmentResponse)
+	err := c.cc.Invoke(ctx, AdminService_RestoreEnrollment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) RecalculateEnrollmentCounts(ctx context.Context, in *RecalculateEnrollmentCountsRequest, opts ...grpc.CallOption) (*RecalculateEnrollmentCountsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RecalculateEnrollmentCountsResponse)
+	err := c.cc.Invoke(ctx, AdminService_RecalculateEnrollmentCounts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) GetNearlyFullCourses(ctx context.Context, in *GetNearlyFullCoursesRequest, opts ...grpc.CallOption) (*GetNearlyFullCoursesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetNearlyFullCoursesResponse)
+	err := c.cc.Invoke(ctx, AdminService_GetNearlyFullCourses_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) GetCourseFillTimeline(ctx context.Context, in *GetCourseFillTimelineRequest, opts ...grpc.CallOption) (*GetCourseFillTimelineResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCourseFillTimelineResponse)
+	err := c.cc.Invoke(ctx, AdminService_GetCourseFillTimeline_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) GetEnrollmentTrend(ctx context.Context, in *GetEnrollmentTrendRequest, opts ...grpc.CallOption) (*GetEnrollmentTrendResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetEnrollmentTrendResponse)
+	err := c.cc.Invoke(ctx, AdminService_GetEnrollmentTrend_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) GetFacultyLoadReport(ctx context.Context, in *GetFacultyLoadReportRequest, opts ...grpc.CallOption) (*GetFacultyLoadReportResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetFacultyLoadReportResponse)
+	err := c.cc.Invoke(ctx, AdminService_GetFacultyLoadReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) CloseSemester(ctx context.Context, in *CloseSemesterRequest, opts ...grpc.CallOption) (*CloseSemesterResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CloseSemesterResponse)
+	err := c.cc.Invoke(ctx, AdminService_CloseSemester_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) PublishAllGrades(ctx context.Context, in *PublishAllGradesRequest, opts ...grpc.CallOption) (*PublishAllGradesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PublishAllGradesResponse)
+	err := c.cc.Invoke(ctx, AdminService_PublishAllGrades_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) GetResourceHistory(ctx context.Context, in *GetResourceHistoryRequest, opts ...grpc.CallOption) (*GetResourceHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetResourceHistoryResponse)
+	err := c.cc.Invoke(ctx, AdminService_GetResourceHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *adminServiceClient) GetSystemStats(ctx context.Context, in *GetSystemStatsRequest, opts ...grpc.CallOption) (*GetSystemStatsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetSystemStatsResponse)
@@ -220,12 +488,27 @@ type AdminServiceServer interface {
 	CreateCourse(context.Context, *CreateCourseRequest) (*CreateCourseResponse, error)
 	UpdateCourse(context.Context, *UpdateCourseRequest) (*UpdateCourseResponse, error)
 	DeleteCourse(context.Context, *DeleteCourseRequest) (*DeleteCourseResponse, error)
+	CancelCourse(context.Context, *CancelCourseRequest) (*CancelCourseResponse, error)
 	AssignFaculty(context.Context, *AssignFacultyRequest) (*AssignFacultyResponse, error)
+	ValidateCourseSchedule(context.Context, *ValidateCourseScheduleRequest) (*ValidateCourseScheduleResponse, error)
+	BulkCreateCourses(context.Context, *BulkCreateCoursesRequest) (*BulkCreateCoursesResponse, error)
+	AddPrerequisite(context.Context, *AddPrerequisiteRequest) (*AddPrerequisiteResponse, error)
+	RemovePrerequisite(context.Context, *RemovePrerequisiteRequest) (*RemovePrerequisiteResponse, error)
+	// Departments
+	CreateDepartment(context.Context, *CreateDepartmentRequest) (*CreateDepartmentResponse, error)
+	UpdateDepartment(context.Context, *UpdateDepartmentRequest) (*UpdateDepartmentResponse, error)
+	DeleteDepartment(context.Context, *DeleteDepartmentRequest) (*DeleteDepartmentResponse, error)
 	// User Management
 	CreateUser(context.Context, *CreateUserRequest) (*CreateUserResponse, error)
 	ListUsers(context.Context, *ListUsersRequest) (*ListUsersResponse, error)
+	GetUserDetail(context.Context, *GetUserDetailRequest) (*GetUserDetailResponse, error)
 	ResetPassword(context.Context, *ResetPasswordRequest) (*ResetPasswordResponse, error)
 	ToggleUserStatus(context.Context, *ToggleUserStatusRequest) (*ToggleUserStatusResponse, error)
+	AddTransferCredit(context.Context, *AddTransferCreditRequest) (*AddTransferCreditResponse, error)
+	// Holds
+	PlaceHold(context.Context, *PlaceHoldRequest) (*PlaceHoldResponse, error)
+	ClearHold(context.Context, *ClearHoldRequest) (*ClearHoldResponse, error)
+	GetStudentHolds(context.Context, *GetStudentHoldsRequest) (*GetStudentHoldsResponse, error)
 	// System Configuration
 	SetEnrollmentPeriod(context.Context, *SetEnrollmentPeriodRequest) (*SetEnrollmentPeriodResponse, error)
 	ToggleEnrollment(context.Context, *ToggleEnrollmentRequest) (*ToggleEnrollmentResponse, error)
@@ -233,6 +516,17 @@ type AdminServiceServer interface {
 	UpdateSystemConfig(context.Context, *UpdateSystemConfigRequest) (*UpdateSystemConfigResponse, error)
 	// Overrides
 	OverrideEnrollment(context.Context, *OverrideEnrollmentRequest) (*OverrideEnrollmentResponse, error)
+	RestoreEnrollment(context.Context, *RestoreEnrollmentRequest) (*RestoreEnrollmentResponse, error)
+	RecalculateEnrollmentCounts(context.Context, *RecalculateEnrollmentCountsRequest) (*RecalculateEnrollmentCountsResponse, error)
+	GetNearlyFullCourses(context.Context, *GetNearlyFullCoursesRequest) (*GetNearlyFullCoursesResponse, error)
+	GetCourseFillTimeline(context.Context, *GetCourseFillTimelineRequest) (*GetCourseFillTimelineResponse, error)
+	GetEnrollmentTrend(context.Context, *GetEnrollmentTrendRequest) (*GetEnrollmentTrendResponse, error)
+	GetFacultyLoadReport(context.Context, *GetFacultyLoadReportRequest) (*GetFacultyLoadReportResponse, error)
+	// Semester Rollover
+	CloseSemester(context.Context, *CloseSemesterRequest) (*CloseSemesterResponse, error)
+	PublishAllGrades(context.Context, *PublishAllGradesRequest) (*PublishAllGradesResponse, error)
+	// Audit
+	GetResourceHistory(context.Context, *GetResourceHistoryRequest) (*GetResourceHistoryResponse, error)
 	// Statistics
 	GetSystemStats(context.Context, *GetSystemStatsRequest) (*GetSystemStatsResponse, error)
 	mustEmbedUnimplementedAdminServiceServer()
@@ -254,21 +548,60 @@ func (UnimplementedAdminServiceServer) UpdateCourse(context.Context, *UpdateCour
 func (UnimplementedAdminServiceServer) DeleteCourse(context.Context, *DeleteCourseRequest) (*DeleteCourseResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteCourse not implemented")
 }
+func (UnimplementedAdminServiceServer) CancelCourse(context.Context, *CancelCourseRequest) (*CancelCourseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelCourse not implemented")
+}
 func (UnimplementedAdminServiceServer) AssignFaculty(context.Context, *AssignFacultyRequest) (*AssignFacultyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AssignFaculty not implemented")
 }
+func (UnimplementedAdminServiceServer) ValidateCourseSchedule(context.Context, *ValidateCourseScheduleRequest) (*ValidateCourseScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidateCourseSchedule not implemented")
+}
+func (UnimplementedAdminServiceServer) BulkCreateCourses(context.Context, *BulkCreateCoursesRequest) (*BulkCreateCoursesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BulkCreateCourses not implemented")
+}
+func (UnimplementedAdminServiceServer) AddPrerequisite(context.Context, *AddPrerequisiteRequest) (*AddPrerequisiteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddPrerequisite not implemented")
+}
+func (UnimplementedAdminServiceServer) RemovePrerequisite(context.Context, *RemovePrerequisiteRequest) (*RemovePrerequisiteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemovePrerequisite not implemented")
+}
+func (UnimplementedAdminServiceServer) CreateDepartment(context.Context, *CreateDepartmentRequest) (*CreateDepartmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateDepartment not implemented")
+}
+func (UnimplementedAdminServiceServer) UpdateDepartment(context.Context, *UpdateDepartmentRequest) (*UpdateDepartmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateDepartment not implemented")
+}
+func (UnimplementedAdminServiceServer) DeleteDepartment(context.Context, *DeleteDepartmentRequest) (*DeleteDepartmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteDepartment not implemented")
+}
 func (UnimplementedAdminServiceServer) CreateUser(context.Context, *CreateUserRequest) (*CreateUserResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateUser not implemented")
 }
 func (UnimplementedAdminServiceServer) ListUsers(context.Context, *ListUsersRequest) (*ListUsersResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListUsers not implemented")
 }
+func (UnimplementedAdminServiceServer) GetUserDetail(context.Context, *GetUserDetailRequest) (*GetUserDetailResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserDetail not implemented")
+}
 func (UnimplementedAdminServiceServer) ResetPassword(context.Context, *ResetPasswordRequest) (*ResetPasswordResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ResetPassword not implemented")
 }
 func (UnimplementedAdminServiceServer) ToggleUserStatus(context.Context, *ToggleUserStatusRequest) (*ToggleUserStatusResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ToggleUserStatus not implemented")
 }
+func (UnimplementedAdminServiceServer) AddTransferCredit(context.Context, *AddTransferCreditRequest) (*AddTransferCreditResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddTransferCredit not implemented")
+}
+func (UnimplementedAdminServiceServer) PlaceHold(context.Context, *PlaceHoldRequest) (*PlaceHoldResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PlaceHold not implemented")
+}
+func (UnimplementedAdminServiceServer) ClearHold(context.Context, *ClearHoldRequest) (*ClearHoldResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClearHold not implemented")
+}
+func (UnimplementedAdminServiceServer) GetStudentHolds(context.Context, *GetStudentHoldsRequest) (*GetStudentHoldsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStudentHolds not implemented")
+}
 func (UnimplementedAdminServiceServer) SetEnrollmentPeriod(context.Context, *SetEnrollmentPeriodRequest) (*SetEnrollmentPeriodResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetEnrollmentPeriod not implemented")
 }
@@ -284,6 +617,33 @@ func (UnimplementedAdminServiceServer) UpdateSystemConfig(context.Context, *Upda
 func (UnimplementedAdminServiceServer) OverrideEnrollment(context.Context, *OverrideEnrollmentRequest) (*OverrideEnrollmentResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method OverrideEnrollment not implemented")
 }
+func (UnimplementedAdminServiceServer) RestoreEnrollment(context.Context, *RestoreEnrollmentRequest) (*RestoreEnrollmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreEnrollment not implemented")
+}
+func (UnimplementedAdminServiceServer) RecalculateEnrollmentCounts(context.Context, *RecalculateEnrollmentCountsRequest) (*RecalculateEnrollmentCountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecalculateEnrollmentCounts not implemented")
+}
+func (UnimplementedAdminServiceServer) GetNearlyFullCourses(context.Context, *GetNearlyFullCoursesRequest) (*GetNearlyFullCoursesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNearlyFullCourses not implemented")
+}
+func (UnimplementedAdminServiceServer) GetCourseFillTimeline(context.Context, *GetCourseFillTimelineRequest) (*GetCourseFillTimelineResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCourseFillTimeline not implemented")
+}
+func (UnimplementedAdminServiceServer) GetEnrollmentTrend(context.Context, *GetEnrollmentTrendRequest) (*GetEnrollmentTrendResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEnrollmentTrend not implemented")
+}
+func (UnimplementedAdminServiceServer) GetFacultyLoadReport(context.Context, *GetFacultyLoadReportRequest) (*GetFacultyLoadReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFacultyLoadReport not implemented")
+}
+func (UnimplementedAdminServiceServer) CloseSemester(context.Context, *CloseSemesterRequest) (*CloseSemesterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloseSemester not implemented")
+}
+func (UnimplementedAdminServiceServer) PublishAllGrades(context.Context, *PublishAllGradesRequest) (*PublishAllGradesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PublishAllGrades not implemented")
+}
+func (UnimplementedAdminServiceServer) GetResourceHistory(context.Context, *GetResourceHistoryRequest) (*GetResourceHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetResourceHistory not implemented")
+}
 func (UnimplementedAdminServiceServer) GetSystemStats(context.Context, *GetSystemStatsRequest) (*GetSystemStatsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetSystemStats not implemented")
 }
@@ -362,6 +722,24 @@ func _AdminService_DeleteCourse_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AdminService_CancelCourse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelCourseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).CancelCourse(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_CancelCourse_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).CancelCourse(ctx, req.(*CancelCourseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AdminService_AssignFaculty_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(AssignFacultyRequest)
 	if err := dec(in); err != nil {
@@ -380,6 +758,132 @@ func _AdminService_AssignFaculty_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AdminService_ValidateCourseSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidateCourseScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ValidateCourseSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ValidateCourseSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ValidateCourseSchedule(ctx, req.(*ValidateCourseScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_BulkCreateCourses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BulkCreateCoursesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).BulkCreateCourses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_BulkCreateCourses_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).BulkCreateCourses(ctx, req.(*BulkCreateCoursesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_AddPrerequisite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddPrerequisiteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).AddPrerequisite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_AddPrerequisite_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).AddPrerequisite(ctx, req.(*AddPrerequisiteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_RemovePrerequisite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemovePrerequisiteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).RemovePrerequisite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_RemovePrerequisite_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).RemovePrerequisite(ctx, req.(*RemovePrerequisiteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_CreateDepartment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateDepartmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).CreateDepartment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_CreateDepartment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).CreateDepartment(ctx, req.(*CreateDepartmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_UpdateDepartment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateDepartmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).UpdateDepartment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_UpdateDepartment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).UpdateDepartment(ctx, req.(*UpdateDepartmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_DeleteDepartment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteDepartmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).DeleteDepartment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_DeleteDepartment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).DeleteDepartment(ctx, req.(*DeleteDepartmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AdminService_CreateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateUserRequest)
 	if err := dec(in); err != nil {
@@ -416,6 +920,24 @@ func _AdminService_ListUsers_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AdminService_GetUserDetail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserDetailRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetUserDetail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_GetUserDetail_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetUserDetail(ctx, req.(*GetUserDetailRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AdminService_ResetPassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ResetPasswordRequest)
 	if err := dec(in); err != nil {
@@ -452,6 +974,78 @@ func _AdminService_ToggleUserStatus_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AdminService_AddTransferCredit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddTransferCreditRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).AddTransferCredit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_AddTransferCredit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).AddTransferCredit(ctx, req.(*AddTransferCreditRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_PlaceHold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PlaceHoldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).PlaceHold(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_PlaceHold_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).PlaceHold(ctx, req.(*PlaceHoldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ClearHold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClearHoldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ClearHold(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ClearHold_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ClearHold(ctx, req.(*ClearHoldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_GetStudentHolds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStudentHoldsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetStudentHolds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_GetStudentHolds_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetStudentHolds(ctx, req.(*GetStudentHoldsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AdminService_SetEnrollmentPeriod_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SetEnrollmentPeriodRequest)
 	if err := dec(in); err != nil {
@@ -542,6 +1136,168 @@ func _AdminService_OverrideEnrollment_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AdminService_RestoreEnrollment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreEnrollmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).RestoreEnrollment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_RestoreEnrollment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).RestoreEnrollment(ctx, req.(*RestoreEnrollmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_RecalculateEnrollmentCounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecalculateEnrollmentCountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).RecalculateEnrollmentCounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_RecalculateEnrollmentCounts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).RecalculateEnrollmentCounts(ctx, req.(*RecalculateEnrollmentCountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_GetNearlyFullCourses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNearlyFullCoursesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetNearlyFullCourses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_GetNearlyFullCourses_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetNearlyFullCourses(ctx, req.(*GetNearlyFullCoursesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_GetCourseFillTimeline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCourseFillTimelineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetCourseFillTimeline(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_GetCourseFillTimeline_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetCourseFillTimeline(ctx, req.(*GetCourseFillTimelineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_GetEnrollmentTrend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEnrollmentTrendRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetEnrollmentTrend(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_GetEnrollmentTrend_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetEnrollmentTrend(ctx, req.(*GetEnrollmentTrendRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_GetFacultyLoadReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFacultyLoadReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetFacultyLoadReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_GetFacultyLoadReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetFacultyLoadReport(ctx, req.(*GetFacultyLoadReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_CloseSemester_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloseSemesterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).CloseSemester(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_CloseSemester_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).CloseSemester(ctx, req.(*CloseSemesterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_PublishAllGrades_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PublishAllGradesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).PublishAllGrades(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_PublishAllGrades_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).PublishAllGrades(ctx, req.(*PublishAllGradesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_GetResourceHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetResourceHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetResourceHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_GetResourceHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetResourceHistory(ctx, req.(*GetResourceHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AdminService_GetSystemStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetSystemStatsRequest)
 	if err := dec(in); err != nil {
@@ -579,10 +1335,42 @@ var AdminService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteCourse",
 			Handler:    _AdminService_DeleteCourse_Handler,
 		},
+		{
+			MethodName: "CancelCourse",
+			Handler:    _AdminService_CancelCourse_Handler,
+		},
 		{
 			MethodName: "AssignFaculty",
 			Handler:    _AdminService_AssignFaculty_Handler,
 		},
+		{
+			MethodName: "ValidateCourseSchedule",
+			Handler:    _AdminService_ValidateCourseSchedule_Handler,
+		},
+		{
+			MethodName: "BulkCreateCourses",
+			Handler:    _AdminService_BulkCreateCourses_Handler,
+		},
+		{
+			MethodName: "AddPrerequisite",
+			Handler:    _AdminService_AddPrerequisite_Handler,
+		},
+		{
+			MethodName: "RemovePrerequisite",
+			Handler:    _AdminService_RemovePrerequisite_Handler,
+		},
+		{
+			MethodName: "CreateDepartment",
+			Handler:    _AdminService_CreateDepartment_Handler,
+		},
+		{
+			MethodName: "UpdateDepartment",
+			Handler:    _AdminService_UpdateDepartment_Handler,
+		},
+		{
+			MethodName: "DeleteDepartment",
+			Handler:    _AdminService_DeleteDepartment_Handler,
+		},
 		{
 			MethodName: "CreateUser",
 			Handler:    _AdminService_CreateUser_Handler,
@@ -591,6 +1379,10 @@ var AdminService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListUsers",
 			Handler:    _AdminService_ListUsers_Handler,
 		},
+		{
+			MethodName: "GetUserDetail",
+			Handler:    _AdminService_GetUserDetail_Handler,
+		},
 		{
 			MethodName: "ResetPassword",
 			Handler:    _AdminService_ResetPassword_Handler,
@@ -599,6 +1391,22 @@ var AdminService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ToggleUserStatus",
 			Handler:    _AdminService_ToggleUserStatus_Handler,
 		},
+		{
+			MethodName: "AddTransferCredit",
+			Handler:    _AdminService_AddTransferCredit_Handler,
+		},
+		{
+			MethodName: "PlaceHold",
+			Handler:    _AdminService_PlaceHold_Handler,
+		},
+		{
+			MethodName: "ClearHold",
+			Handler:    _AdminService_ClearHold_Handler,
+		},
+		{
+			MethodName: "GetStudentHolds",
+			Handler:    _AdminService_GetStudentHolds_Handler,
+		},
 		{
 			MethodName: "SetEnrollmentPeriod",
 			Handler:    _AdminService_SetEnrollmentPeriod_Handler,
@@ -619,6 +1427,42 @@ var AdminService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "OverrideEnrollment",
 			Handler:    _AdminService_OverrideEnrollment_Handler,
 		},
+		{
+			MethodName: "RestoreEnrollment",
+			Handler:    _AdminService_RestoreEnrollment_Handler,
+		},
+		{
+			MethodName: "RecalculateEnrollmentCounts",
+			Handler:    _AdminService_RecalculateEnrollmentCounts_Handler,
+		},
+		{
+			MethodName: "GetNearlyFullCourses",
+			Handler:    _AdminService_GetNearlyFullCourses_Handler,
+		},
+		{
+			MethodName: "GetCourseFillTimeline",
+			Handler:    _AdminService_GetCourseFillTimeline_Handler,
+		},
+		{
+			MethodName: "GetEnrollmentTrend",
+			Handler:    _AdminService_GetEnrollmentTrend_Handler,
+		},
+		{
+			MethodName: "GetFacultyLoadReport",
+			Handler:    _AdminService_GetFacultyLoadReport_Handler,
+		},
+		{
+			MethodName: "CloseSemester",
+			Handler:    _AdminService_CloseSemester_Handler,
+		},
+		{
+			MethodName: "PublishAllGrades",
+			Handler:    _AdminService_PublishAllGrades_Handler,
+		},
+		{
+			MethodName: "GetResourceHistory",
+			Handler:    _AdminService_GetResourceHistory_Handler,
+		},
 		{
 			MethodName: "GetSystemStats",
 			Handler:    _AdminService_GetSystemStats_Handler,
@@ -24,25 +24,38 @@ const (
 
 // Common messages
 type Course struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
-	Title         string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
-	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
-	Units         int32                  `protobuf:"varint,5,opt,name=units,proto3" json:"units,omitempty"`
-	Schedule      string                 `protobuf:"bytes,6,opt,name=schedule,proto3" json:"schedule,omitempty"` // e.g., "MWF 9:00-10:00"
-	Room          string                 `protobuf:"bytes,7,opt,name=room,proto3" json:"room,omitempty"`
-	Capacity      int32                  `protobuf:"varint,8,opt,name=capacity,proto3" json:"capacity,omitempty"`
-	Enrolled      int32                  `protobuf:"varint,9,opt,name=enrolled,proto3" json:"enrolled,omitempty"`
-	FacultyId     string                 `protobuf:"bytes,10,opt,name=faculty_id,json=facultyId,proto3" json:"faculty_id,omitempty"`
-	FacultyName   string                 `protobuf:"bytes,11,opt,name=faculty_name,json=facultyName,proto3" json:"faculty_name,omitempty"` // denormalized for display
-	IsOpen        bool                   `protobuf:"varint,12,opt,name=is_open,json=isOpen,proto3" json:"is_open,omitempty"`
-	Semester      string                 `protobuf:"bytes,13,opt,name=semester,proto3" json:"semester,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	Prerequisites []string               `protobuf:"bytes,16,rep,name=prerequisites,proto3" json:"prerequisites,omitempty"` // list of course IDs
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Code           string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	Title          string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Description    string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Units          int32                  `protobuf:"varint,5,opt,name=units,proto3" json:"units,omitempty"`
+	Schedule       string                 `protobuf:"bytes,6,opt,name=schedule,proto3" json:"schedule,omitempty"` // e.g., "MWF 9:00-10:00"
+	Room           string                 `protobuf:"bytes,7,opt,name=room,proto3" json:"room,omitempty"`
+	Capacity       int32                  `protobuf:"varint,8,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	Enrolled       int32                  `protobuf:"varint,9,opt,name=enrolled,proto3" json:"enrolled,omitempty"`
+	FacultyId      string                 `protobuf:"bytes,10,opt,name=faculty_id,json=facultyId,proto3" json:"faculty_id,omitempty"`
+	FacultyName    string                 `protobuf:"bytes,11,opt,name=faculty_name,json=facultyName,proto3" json:"faculty_name,omitempty"` // denormalized for display
+	IsOpen         bool                   `protobuf:"varint,12,opt,name=is_open,json=isOpen,proto3" json:"is_open,omitempty"`
+	Semester       string                 `protobuf:"bytes,13,opt,name=semester,proto3" json:"semester,omitempty"`
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt      *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Prerequisites  []string               `protobuf:"bytes,16,rep,name=prerequisites,proto3" json:"prerequisites,omitempty"`                          // list of course IDs
+	Score          float64                `protobuf:"fixed64,17,opt,name=score,proto3" json:"score,omitempty"`                                        // text search relevance, set only for full-text queries
+	Materials      []*CourseMaterial      `protobuf:"bytes,18,rep,name=materials,proto3" json:"materials,omitempty"`                                  // syllabus and resource links
+	AllowedMajors  []string               `protobuf:"bytes,19,rep,name=allowed_majors,json=allowedMajors,proto3" json:"allowed_majors,omitempty"`     // empty = open to all majors
+	MinYearLevel   int32                  `protobuf:"varint,20,opt,name=min_year_level,json=minYearLevel,proto3" json:"min_year_level,omitempty"`     // 0 = no year level requirement
+	SeatsAvailable int32                  `protobuf:"varint,21,opt,name=seats_available,json=seatsAvailable,proto3" json:"seats_available,omitempty"` // capacity - enrolled - seats_held, never negative
+	IsFull         bool                   `protobuf:"varint,22,opt,name=is_full,json=isFull,proto3" json:"is_full,omitempty"`
+	WaitlistCount  int32                  `protobuf:"varint,23,opt,name=waitlist_count,json=waitlistCount,proto3" json:"waitlist_count,omitempty"` // always 0 until waitlists exist
+	Department     string                 `protobuf:"bytes,24,opt,name=department,proto3" json:"department,omitempty"`                             // e.g., "CS"
+	EnrollOpenAt   *timestamppb.Timestamp `protobuf:"bytes,25,opt,name=enroll_open_at,json=enrollOpenAt,proto3" json:"enroll_open_at,omitempty"`   // optional per-course enrollment window
+	EnrollCloseAt  *timestamppb.Timestamp `protobuf:"bytes,26,opt,name=enroll_close_at,json=enrollCloseAt,proto3" json:"enroll_close_at,omitempty"`
+	Enrollable     bool                   `protobuf:"varint,27,opt,name=enrollable,proto3" json:"enrollable,omitempty"`                // is_open and now within the course's own window
+	SeatsHeld      int32                  `protobuf:"varint,28,opt,name=seats_held,json=seatsHeld,proto3" json:"seats_held,omitempty"` // seats held in carts while seat_holds_enabled is on (GetCourse only)
+	Cancelled      bool                   `protobuf:"varint,29,opt,name=cancelled,proto3" json:"cancelled,omitempty"`                  // cancelled by an admin; never reopens
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *Course) Reset() {
@@ -187,19 +200,182 @@ func (x *Course) GetPrerequisites() []string {
 	return nil
 }
 
-type CourseFilter struct {
+func (x *Course) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *Course) GetMaterials() []*CourseMaterial {
+	if x != nil {
+		return x.Materials
+	}
+	return nil
+}
+
+func (x *Course) GetAllowedMajors() []string {
+	if x != nil {
+		return x.AllowedMajors
+	}
+	return nil
+}
+
+func (x *Course) GetMinYearLevel() int32 {
+	if x != nil {
+		return x.MinYearLevel
+	}
+	return 0
+}
+
+func (x *Course) GetSeatsAvailable() int32 {
+	if x != nil {
+		return x.SeatsAvailable
+	}
+	return 0
+}
+
+func (x *Course) GetIsFull() bool {
+	if x != nil {
+		return x.IsFull
+	}
+	return false
+}
+
+func (x *Course) GetWaitlistCount() int32 {
+	if x != nil {
+		return x.WaitlistCount
+	}
+	return 0
+}
+
+func (x *Course) GetDepartment() string {
+	if x != nil {
+		return x.Department
+	}
+	return ""
+}
+
+func (x *Course) GetEnrollOpenAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EnrollOpenAt
+	}
+	return nil
+}
+
+func (x *Course) GetEnrollCloseAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EnrollCloseAt
+	}
+	return nil
+}
+
+func (x *Course) GetEnrollable() bool {
+	if x != nil {
+		return x.Enrollable
+	}
+	return false
+}
+
+func (x *Course) GetSeatsHeld() int32 {
+	if x != nil {
+		return x.SeatsHeld
+	}
+	return 0
+}
+
+func (x *Course) GetCancelled() bool {
+	if x != nil {
+		return x.Cancelled
+	}
+	return false
+}
+
+type CourseMaterial struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Department    string                 `protobuf:"bytes,1,opt,name=department,proto3" json:"department,omitempty"`                      // filter by department code (e.g., "CS")
-	SearchQuery   string                 `protobuf:"bytes,2,opt,name=search_query,json=searchQuery,proto3" json:"search_query,omitempty"` // search in code or title
-	OpenOnly      bool                   `protobuf:"varint,3,opt,name=open_only,json=openOnly,proto3" json:"open_only,omitempty"`         // filter only open courses
-	Semester      string                 `protobuf:"bytes,4,opt,name=semester,proto3" json:"semester,omitempty"`                          // filter by semester
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Url           string                 `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"` // http or https only
+	AddedAt       *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=added_at,json=addedAt,proto3" json:"added_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
+func (x *CourseMaterial) Reset() {
+	*x = CourseMaterial{}
+	mi := &file_backend_protos_course_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CourseMaterial) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CourseMaterial) ProtoMessage() {}
+
+func (x *CourseMaterial) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_protos_course_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CourseMaterial.ProtoReflect.Descriptor instead.
+func (*CourseMaterial) Descriptor() ([]byte, []int) {
+	return file_backend_protos_course_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CourseMaterial) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CourseMaterial) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CourseMaterial) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *CourseMaterial) GetAddedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AddedAt
+	}
+	return nil
+}
+
+type CourseFilter struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Department       string                 `protobuf:"bytes,1,opt,name=department,proto3" json:"department,omitempty"`                                      // filter by the course's department (e.g., "CS"), case-insensitive
+	SearchQuery      string                 `protobuf:"bytes,2,opt,name=search_query,json=searchQuery,proto3" json:"search_query,omitempty"`                 // search in code or title
+	OpenOnly         bool                   `protobuf:"varint,3,opt,name=open_only,json=openOnly,proto3" json:"open_only,omitempty"`                         // filter only open courses
+	Semester         string                 `protobuf:"bytes,4,opt,name=semester,proto3" json:"semester,omitempty"`                                          // filter by semester
+	TextSearch       string                 `protobuf:"bytes,5,opt,name=text_search,json=textSearch,proto3" json:"text_search,omitempty"`                    // full-text search over title and description, ranked by relevance
+	Days             []string               `protobuf:"bytes,6,rep,name=days,proto3" json:"days,omitempty"`                                                  // only courses meeting on these days (a subset), e.g. ["T", "TH"]; "TTH" also works
+	TimeWindow       *TimeWindow            `protobuf:"bytes,7,opt,name=time_window,json=timeWindow,proto3" json:"time_window,omitempty"`                    // only courses meeting entirely within the window
+	IncludeCancelled bool                   `protobuf:"varint,8,opt,name=include_cancelled,json=includeCancelled,proto3" json:"include_cancelled,omitempty"` // cancelled courses are left out unless set
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
 func (x *CourseFilter) Reset() {
 	*x = CourseFilter{}
-	mi := &file_backend_protos_course_proto_msgTypes[1]
+	mi := &file_backend_protos_course_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -211,7 +387,7 @@ func (x *CourseFilter) String() string {
 func (*CourseFilter) ProtoMessage() {}
 
 func (x *CourseFilter) ProtoReflect() protoreflect.Message {
-	mi := &file_backend_protos_course_proto_msgTypes[1]
+	mi := &file_backend_protos_course_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -224,7 +400,7 @@ func (x *CourseFilter) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CourseFilter.ProtoReflect.Descriptor instead.
 func (*CourseFilter) Descriptor() ([]byte, []int) {
-	return file_backend_protos_course_proto_rawDescGZIP(), []int{1}
+	return file_backend_protos_course_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *CourseFilter) GetDepartment() string {
@@ -255,17 +431,101 @@ func (x *CourseFilter) GetSemester() string {
 	return ""
 }
 
+func (x *CourseFilter) GetTextSearch() string {
+	if x != nil {
+		return x.TextSearch
+	}
+	return ""
+}
+
+func (x *CourseFilter) GetDays() []string {
+	if x != nil {
+		return x.Days
+	}
+	return nil
+}
+
+func (x *CourseFilter) GetTimeWindow() *TimeWindow {
+	if x != nil {
+		return x.TimeWindow
+	}
+	return nil
+}
+
+func (x *CourseFilter) GetIncludeCancelled() bool {
+	if x != nil {
+		return x.IncludeCancelled
+	}
+	return false
+}
+
+// Either bound may be empty: start defaults to 00:00 and end to the end of the day
+type TimeWindow struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Start         string                 `protobuf:"bytes,1,opt,name=start,proto3" json:"start,omitempty"` // "HH:MM", 24-hour
+	End           string                 `protobuf:"bytes,2,opt,name=end,proto3" json:"end,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TimeWindow) Reset() {
+	*x = TimeWindow{}
+	mi := &file_backend_protos_course_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TimeWindow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TimeWindow) ProtoMessage() {}
+
+func (x *TimeWindow) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_protos_course_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TimeWindow.ProtoReflect.Descriptor instead.
+func (*TimeWindow) Descriptor() ([]byte, []int) {
+	return file_backend_protos_course_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *TimeWindow) GetStart() string {
+	if x != nil {
+		return x.Start
+	}
+	return ""
+}
+
+func (x *TimeWindow) GetEnd() string {
+	if x != nil {
+		return x.End
+	}
+	return ""
+}
+
 // Request/Response messages
 type ListCoursesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Filters       *CourseFilter          `protobuf:"bytes,1,opt,name=filters,proto3" json:"filters,omitempty"`
+	Live          bool                   `protobuf:"varint,2,opt,name=live,proto3" json:"live,omitempty"`                           // bypass the catalog cache, e.g. for admins who must see their edits at once
+	SortBy        string                 `protobuf:"bytes,3,opt,name=sort_by,json=sortBy,proto3" json:"sort_by,omitempty"`          // code (default), title, units, seats_available or enrolled
+	SortOrder     string                 `protobuf:"bytes,4,opt,name=sort_order,json=sortOrder,proto3" json:"sort_order,omitempty"` // asc (default) or desc
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ListCoursesRequest) Reset() {
 	*x = ListCoursesRequest{}
-	mi := &file_backend_protos_course_proto_msgTypes[2]
+	mi := &file_backend_protos_course_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -277,7 +537,7 @@ func (x *ListCoursesRequest) String() string {
 func (*ListCoursesRequest) ProtoMessage() {}
 
 func (x *ListCoursesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_backend_protos_course_proto_msgTypes[2]
+	mi := &file_backend_protos_course_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -290,7 +550,7 @@ func (x *ListCoursesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListCoursesRequest.ProtoReflect.Descriptor instead.
 func (*ListCoursesRequest) Descriptor() ([]byte, []int) {
-	return file_backend_protos_course_proto_rawDescGZIP(), []int{2}
+	return file_backend_protos_course_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *ListCoursesRequest) GetFilters() *CourseFilter {
@@ -300,6 +560,27 @@ func (x *ListCoursesRequest) GetFilters() *CourseFilter {
 	return nil
 }
 
+func (x *ListCoursesRequest) GetLive() bool {
+	if x != nil {
+		return x.Live
+	}
+	return false
+}
+
+func (x *ListCoursesRequest) GetSortBy() string {
+	if x != nil {
+		return x.SortBy
+	}
+	return ""
+}
+
+func (x *ListCoursesRequest) GetSortOrder() string {
+	if x != nil {
+		return x.SortOrder
+	}
+	return ""
+}
+
 type ListCoursesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Courses       []*Course              `protobuf:"bytes,1,rep,name=courses,proto3" json:"courses,omitempty"`
@@ -310,7 +591,7 @@ type ListCoursesResponse struct {
 
 func (x *ListCoursesResponse) Reset() {
 	*x = ListCoursesResponse{}
-	mi := &file_backend_protos_course_proto_msgTypes[3]
+	mi := &file_backend_protos_course_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -322,7 +603,7 @@ func (x *ListCoursesResponse) String() string {
 func (*ListCoursesResponse) ProtoMessage() {}
 
 func (x *ListCoursesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_backend_protos_course_proto_msgTypes[3]
+	mi := &file_backend_protos_course_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -335,7 +616,7 @@ func (x *ListCoursesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListCoursesResponse.ProtoReflect.Descriptor instead.
 func (*ListCoursesResponse) Descriptor() ([]byte, []int) {
-	return file_backend_protos_course_proto_rawDescGZIP(), []int{3}
+	return file_backend_protos_course_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *ListCoursesResponse) GetCourses() []*Course {
@@ -352,28 +633,29 @@ func (x *ListCoursesResponse) GetTotalCount() int32 {
 	return 0
 }
 
-type GetCourseRequest struct {
+type Department struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	CourseId      string                 `protobuf:"bytes,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"` // the value courses store and CourseFilter.department matches, e.g. "CS"
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"` // e.g. "Computer Science"
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetCourseRequest) Reset() {
-	*x = GetCourseRequest{}
-	mi := &file_backend_protos_course_proto_msgTypes[4]
+func (x *Department) Reset() {
+	*x = Department{}
+	mi := &file_backend_protos_course_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCourseRequest) String() string {
+func (x *Department) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCourseRequest) ProtoMessage() {}
+func (*Department) ProtoMessage() {}
 
-func (x *GetCourseRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_backend_protos_course_proto_msgTypes[4]
+func (x *Department) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_protos_course_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -384,42 +666,46 @@ func (x *GetCourseRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCourseRequest.ProtoReflect.Descriptor instead.
-func (*GetCourseRequest) Descriptor() ([]byte, []int) {
-	return file_backend_protos_course_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use Department.ProtoReflect.Descriptor instead.
+func (*Department) Descriptor() ([]byte, []int) {
+	return file_backend_protos_course_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *GetCourseRequest) GetCourseId() string {
+func (x *Department) GetCode() string {
 	if x != nil {
-		return x.CourseId
+		return x.Code
 	}
 	return ""
 }
 
-type GetCourseResponse struct {
+func (x *Department) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type ListDepartmentsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Course        *Course                `protobuf:"bytes,2,opt,name=course,proto3" json:"course,omitempty"`
-	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetCourseResponse) Reset() {
-	*x = GetCourseResponse{}
-	mi := &file_backend_protos_course_proto_msgTypes[5]
+func (x *ListDepartmentsRequest) Reset() {
+	*x = ListDepartmentsRequest{}
+	mi := &file_backend_protos_course_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCourseResponse) String() string {
+func (x *ListDepartmentsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCourseResponse) ProtoMessage() {}
+func (*ListDepartmentsRequest) ProtoMessage() {}
 
-func (x *GetCourseResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_backend_protos_course_proto_msgTypes[5]
+func (x *ListDepartmentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_protos_course_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -430,55 +716,1521 @@ func (x *GetCourseResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCourseResponse.ProtoReflect.Descriptor instead.
-func (*GetCourseResponse) Descriptor() ([]byte, []int) {
-	return file_backend_protos_course_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use ListDepartmentsRequest.ProtoReflect.Descriptor instead.
+func (*ListDepartmentsRequest) Descriptor() ([]byte, []int) {
+	return file_backend_protos_course_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *GetCourseResponse) GetSuccess() bool {
+type ListDepartmentsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Departments   []*Department          `protobuf:"bytes,1,rep,name=departments,proto3" json:"departments,omitempty"` // sorted by code
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDepartmentsResponse) Reset() {
+	*x = ListDepartmentsResponse{}
+	mi := &file_backend_protos_course_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDepartmentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDepartmentsResponse) ProtoMessage() {}
+
+func (x *ListDepartmentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_protos_course_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDepartmentsResponse.ProtoReflect.Descriptor instead.
+func (*ListDepartmentsResponse) Descriptor() ([]byte, []int) {
+	return file_backend_protos_course_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ListDepartmentsResponse) GetDepartments() []*Department {
+	if x != nil {
+		return x.Departments
+	}
+	return nil
+}
+
+type GetCourseRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CourseId      string                 `protobuf:"bytes,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCourseRequest) Reset() {
+	*x = GetCourseRequest{}
+	mi := &file_backend_protos_course_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCourseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCourseRequest) ProtoMessage() {}
+
+func (x *GetCourseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_protos_course_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCourseRequest.ProtoReflect.Descriptor instead.
+func (*GetCourseRequest) Descriptor() ([]byte, []int) {
+	return file_backend_protos_course_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetCourseRequest) GetCourseId() string {
+	if x != nil {
+		return x.CourseId
+	}
+	return ""
+}
+
+type GetCourseResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Course        *Course                `protobuf:"bytes,2,opt,name=course,proto3" json:"course,omitempty"`
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCourseResponse) Reset() {
+	*x = GetCourseResponse{}
+	mi := &file_backend_protos_course_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCourseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCourseResponse) ProtoMessage() {}
+
+func (x *GetCourseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_protos_course_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCourseResponse.ProtoReflect.Descriptor instead.
+func (*GetCourseResponse) Descriptor() ([]byte, []int) {
+	return file_backend_protos_course_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetCourseResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *GetCourseResponse) GetCourse() *Course {
+	if x != nil {
+		return x.Course
+	}
+	return nil
+}
+
+func (x *GetCourseResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type GetCourseDetailRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CourseId      string                 `protobuf:"bytes,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	StudentId     string                 `protobuf:"bytes,2,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"` // optional, annotates the course for this student
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCourseDetailRequest) Reset() {
+	*x = GetCourseDetailRequest{}
+	mi := &file_backend_protos_course_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCourseDetailRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCourseDetailRequest) ProtoMessage() {}
+
+func (x *GetCourseDetailRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_protos_course_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCourseDetailRequest.ProtoReflect.Descriptor instead.
+func (*GetCourseDetailRequest) Descriptor() ([]byte, []int) {
+	return file_backend_protos_course_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetCourseDetailRequest) GetCourseId() string {
+	if x != nil {
+		return x.CourseId
+	}
+	return ""
+}
+
+func (x *GetCourseDetailRequest) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+// Where a course stands for one student
+type CourseStudentStatus struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	InCart        bool                   `protobuf:"varint,1,opt,name=in_cart,json=inCart,proto3" json:"in_cart,omitempty"`
+	Enrolled      bool                   `protobuf:"varint,2,opt,name=enrolled,proto3" json:"enrolled,omitempty"` // an active enrollment
+	Completed     bool                   `protobuf:"varint,3,opt,name=completed,proto3" json:"completed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CourseStudentStatus) Reset() {
+	*x = CourseStudentStatus{}
+	mi := &file_backend_protos_course_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CourseStudentStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CourseStudentStatus) ProtoMessage() {}
+
+func (x *CourseStudentStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_protos_course_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CourseStudentStatus.ProtoReflect.Descriptor instead.
+func (*CourseStudentStatus) Descriptor() ([]byte, []int) {
+	return file_backend_protos_course_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *CourseStudentStatus) GetInCart() bool {
+	if x != nil {
+		return x.InCart
+	}
+	return false
+}
+
+func (x *CourseStudentStatus) GetEnrolled() bool {
+	if x != nil {
+		return x.Enrolled
+	}
+	return false
+}
+
+func (x *CourseStudentStatus) GetCompleted() bool {
+	if x != nil {
+		return x.Completed
+	}
+	return false
+}
+
+type GetCourseDetailResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Course        *Course                `protobuf:"bytes,2,opt,name=course,proto3" json:"course,omitempty"`
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	StudentStatus *CourseStudentStatus   `protobuf:"bytes,4,opt,name=student_status,json=studentStatus,proto3" json:"student_status,omitempty"` // set when student_id was given
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCourseDetailResponse) Reset() {
+	*x = GetCourseDetailResponse{}
+	mi := &file_backend_protos_course_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCourseDetailResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCourseDetailResponse) ProtoMessage() {}
+
+func (x *GetCourseDetailResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_protos_course_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCourseDetailResponse.ProtoReflect.Descriptor instead.
+func (*GetCourseDetailResponse) Descriptor() ([]byte, []int) {
+	return file_backend_protos_course_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetCourseDetailResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *GetCourseDetailResponse) GetCourse() *Course {
+	if x != nil {
+		return x.Course
+	}
+	return nil
+}
+
+func (x *GetCourseDetailResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *GetCourseDetailResponse) GetStudentStatus() *CourseStudentStatus {
+	if x != nil {
+		return x.StudentStatus
+	}
+	return nil
+}
+
+type BatchGetCoursesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CourseIds     []string               `protobuf:"bytes,1,rep,name=course_ids,json=courseIds,proto3" json:"course_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchGetCoursesRequest) Reset() {
+	*x = BatchGetCoursesRequest{}
+	mi := &file_backend_protos_course_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchGetCoursesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGetCoursesRequest) ProtoMessage() {}
+
+func (x *BatchGetCoursesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_protos_course_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGetCoursesRequest.ProtoReflect.Descriptor instead.
+func (*BatchGetCoursesRequest) Descriptor() ([]byte, []int) {
+	return file_backend_protos_course_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *BatchGetCoursesRequest) GetCourseIds() []string {
+	if x != nil {
+		return x.CourseIds
+	}
+	return nil
+}
+
+type BatchGetCoursesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Courses       []*Course              `protobuf:"bytes,1,rep,name=courses,proto3" json:"courses,omitempty"`                         // in no particular order
+	MissingIds    []string               `protobuf:"bytes,2,rep,name=missing_ids,json=missingIds,proto3" json:"missing_ids,omitempty"` // requested IDs with no matching course
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchGetCoursesResponse) Reset() {
+	*x = BatchGetCoursesResponse{}
+	mi := &file_backend_protos_course_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchGetCoursesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGetCoursesResponse) ProtoMessage() {}
+
+func (x *BatchGetCoursesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_protos_course_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGetCoursesResponse.ProtoReflect.Descriptor instead.
+func (*BatchGetCoursesResponse) Descriptor() ([]byte, []int) {
+	return file_backend_protos_course_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *BatchGetCoursesResponse) GetCourses() []*Course {
+	if x != nil {
+		return x.Courses
+	}
+	return nil
+}
+
+func (x *BatchGetCoursesResponse) GetMissingIds() []string {
+	if x != nil {
+		return x.MissingIds
+	}
+	return nil
+}
+
+type CheckPrerequisitesRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	StudentId       string                 `protobuf:"bytes,1,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
+	CourseId        string                 `protobuf:"bytes,2,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	AllowInProgress bool                   `protobuf:"varint,3,opt,name=allow_in_progress,json=allowInProgress,proto3" json:"allow_in_progress,omitempty"` // count current enrollments in a prerequisite as provisionally met
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CheckPrerequisitesRequest) Reset() {
+	*x = CheckPrerequisitesRequest{}
+	mi := &file_backend_protos_course_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckPrerequisitesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckPrerequisitesRequest) ProtoMessage() {}
+
+func (x *CheckPrerequisitesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_protos_course_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckPrerequisitesRequest.ProtoReflect.Descriptor instead.
+func (*CheckPrerequisitesRequest) Descriptor() ([]byte, []int) {
+	return file_backend_protos_course_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *CheckPrerequisitesRequest) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *CheckPrerequisitesRequest) GetCourseId() string {
+	if x != nil {
+		return x.CourseId
+	}
+	return ""
+}
+
+func (x *CheckPrerequisitesRequest) GetAllowInProgress() bool {
+	if x != nil {
+		return x.AllowInProgress
+	}
+	return false
+}
+
+type PrerequisiteStatus struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CourseId      string                 `protobuf:"bytes,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	CourseCode    string                 `protobuf:"bytes,2,opt,name=course_code,json=courseCode,proto3" json:"course_code,omitempty"`
+	Met           bool                   `protobuf:"varint,3,opt,name=met,proto3" json:"met,omitempty"`
+	Grade         string                 `protobuf:"bytes,4,opt,name=grade,proto3" json:"grade,omitempty"`                              // grade received if taken
+	MetVia        string                 `protobuf:"bytes,5,opt,name=met_via,json=metVia,proto3" json:"met_via,omitempty"`              // "completed" or "transfer" when met
+	InProgress    bool                   `protobuf:"varint,6,opt,name=in_progress,json=inProgress,proto3" json:"in_progress,omitempty"` // not met yet, but currently enrolled (only with allow_in_progress)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PrerequisiteStatus) Reset() {
+	*x = PrerequisiteStatus{}
+	mi := &file_backend_protos_course_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PrerequisiteStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PrerequisiteStatus) ProtoMessage() {}
+
+func (x *PrerequisiteStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_protos_course_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PrerequisiteStatus.ProtoReflect.Descriptor instead.
+func (*PrerequisiteStatus) Descriptor() ([]byte, []int) {
+	return file_backend_protos_course_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *PrerequisiteStatus) GetCourseId() string {
+	if x != nil {
+		return x.CourseId
+	}
+	return ""
+}
+
+func (x *PrerequisiteStatus) GetCourseCode() string {
+	if x != nil {
+		return x.CourseCode
+	}
+	return ""
+}
+
+func (x *PrerequisiteStatus) GetMet() bool {
+	if x != nil {
+		return x.Met
+	}
+	return false
+}
+
+func (x *PrerequisiteStatus) GetGrade() string {
+	if x != nil {
+		return x.Grade
+	}
+	return ""
+}
+
+func (x *PrerequisiteStatus) GetMetVia() string {
+	if x != nil {
+		return x.MetVia
+	}
+	return ""
+}
+
+func (x *PrerequisiteStatus) GetInProgress() bool {
+	if x != nil {
+		return x.InProgress
+	}
+	return false
+}
+
+type CheckPrerequisitesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AllMet        bool                   `protobuf:"varint,1,opt,name=all_met,json=allMet,proto3" json:"all_met,omitempty"` // in-progress prerequisites count as met
+	Prerequisites []*PrerequisiteStatus  `protobuf:"bytes,2,rep,name=prerequisites,proto3" json:"prerequisites,omitempty"`
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	Provisional   bool                   `protobuf:"varint,4,opt,name=provisional,proto3" json:"provisional,omitempty"` // all_met relies on at least one in-progress prerequisite
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckPrerequisitesResponse) Reset() {
+	*x = CheckPrerequisitesResponse{}
+	mi := &file_backend_protos_course_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckPrerequisitesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckPrerequisitesResponse) ProtoMessage() {}
+
+func (x *CheckPrerequisitesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_protos_course_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckPrerequisitesResponse.ProtoReflect.Descriptor instead.
+func (*CheckPrerequisitesResponse) Descriptor() ([]byte, []int) {
+	return file_backend_protos_course_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *CheckPrerequisitesResponse) GetAllMet() bool {
+	if x != nil {
+		return x.AllMet
+	}
+	return false
+}
+
+func (x *CheckPrerequisitesResponse) GetPrerequisites() []*PrerequisiteStatus {
+	if x != nil {
+		return x.Prerequisites
+	}
+	return nil
+}
+
+func (x *CheckPrerequisitesResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *CheckPrerequisitesResponse) GetProvisional() bool {
+	if x != nil {
+		return x.Provisional
+	}
+	return false
+}
+
+type GetPrerequisitesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CourseId      string                 `protobuf:"bytes,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPrerequisitesRequest) Reset() {
+	*x = GetPrerequisitesRequest{}
+	mi := &file_backend_protos_course_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPrerequisitesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPrerequisitesRequest) ProtoMessage() {}
+
+func (x *GetPrerequisitesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_protos_course_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPrerequisitesRequest.ProtoReflect.Descriptor instead.
+func (*GetPrerequisitesRequest) Descriptor() ([]byte, []int) {
+	return file_backend_protos_course_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetPrerequisitesRequest) GetCourseId() string {
+	if x != nil {
+		return x.CourseId
+	}
+	return ""
+}
+
+type Prerequisite struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CourseId      string                 `protobuf:"bytes,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	CourseCode    string                 `protobuf:"bytes,2,opt,name=course_code,json=courseCode,proto3" json:"course_code,omitempty"` // empty if the prerequisite course no longer exists
+	Title         string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Prerequisite) Reset() {
+	*x = Prerequisite{}
+	mi := &file_backend_protos_course_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Prerequisite) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Prerequisite) ProtoMessage() {}
+
+func (x *Prerequisite) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_protos_course_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Prerequisite.ProtoReflect.Descriptor instead.
+func (*Prerequisite) Descriptor() ([]byte, []int) {
+	return file_backend_protos_course_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *Prerequisite) GetCourseId() string {
+	if x != nil {
+		return x.CourseId
+	}
+	return ""
+}
+
+func (x *Prerequisite) GetCourseCode() string {
+	if x != nil {
+		return x.CourseCode
+	}
+	return ""
+}
+
+func (x *Prerequisite) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+type GetPrerequisitesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CourseId      string                 `protobuf:"bytes,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	Prerequisites []*Prerequisite        `protobuf:"bytes,2,rep,name=prerequisites,proto3" json:"prerequisites,omitempty"` // sorted by course code
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPrerequisitesResponse) Reset() {
+	*x = GetPrerequisitesResponse{}
+	mi := &file_backend_protos_course_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPrerequisitesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPrerequisitesResponse) ProtoMessage() {}
+
+func (x *GetPrerequisitesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_protos_course_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPrerequisitesResponse.ProtoReflect.Descriptor instead.
+func (*GetPrerequisitesResponse) Descriptor() ([]byte, []int) {
+	return file_backend_protos_course_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetPrerequisitesResponse) GetCourseId() string {
+	if x != nil {
+		return x.CourseId
+	}
+	return ""
+}
+
+func (x *GetPrerequisitesResponse) GetPrerequisites() []*Prerequisite {
+	if x != nil {
+		return x.Prerequisites
+	}
+	return nil
+}
+
+type GetEligibleCoursesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StudentId     string                 `protobuf:"bytes,1,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
+	Semester      string                 `protobuf:"bytes,2,opt,name=semester,proto3" json:"semester,omitempty"`
+	Department    string                 `protobuf:"bytes,3,opt,name=department,proto3" json:"department,omitempty"` // optional, filter by the course's department (e.g., "CS")
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEligibleCoursesRequest) Reset() {
+	*x = GetEligibleCoursesRequest{}
+	mi := &file_backend_protos_course_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEligibleCoursesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEligibleCoursesRequest) ProtoMessage() {}
+
+func (x *GetEligibleCoursesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_protos_course_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEligibleCoursesRequest.ProtoReflect.Descriptor instead.
+func (*GetEligibleCoursesRequest) Descriptor() ([]byte, []int) {
+	return file_backend_protos_course_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GetEligibleCoursesRequest) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *GetEligibleCoursesRequest) GetSemester() string {
+	if x != nil {
+		return x.Semester
+	}
+	return ""
+}
+
+func (x *GetEligibleCoursesRequest) GetDepartment() string {
+	if x != nil {
+		return x.Department
+	}
+	return ""
+}
+
+type EligibleCourse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Course        *Course                `protobuf:"bytes,1,opt,name=course,proto3" json:"course,omitempty"`
+	Eligible      bool                   `protobuf:"varint,2,opt,name=eligible,proto3" json:"eligible,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`               // why the student cannot take the course yet (empty when eligible)
+	Prerequisites []*PrerequisiteStatus  `protobuf:"bytes,4,rep,name=prerequisites,proto3" json:"prerequisites,omitempty"` // in-progress prerequisites are flagged, not counted as met
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EligibleCourse) Reset() {
+	*x = EligibleCourse{}
+	mi := &file_backend_protos_course_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EligibleCourse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EligibleCourse) ProtoMessage() {}
+
+func (x *EligibleCourse) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_protos_course_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EligibleCourse.ProtoReflect.Descriptor instead.
+func (*EligibleCourse) Descriptor() ([]byte, []int) {
+	return file_backend_protos_course_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *EligibleCourse) GetCourse() *Course {
+	if x != nil {
+		return x.Course
+	}
+	return nil
+}
+
+func (x *EligibleCourse) GetEligible() bool {
+	if x != nil {
+		return x.Eligible
+	}
+	return false
+}
+
+func (x *EligibleCourse) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *EligibleCourse) GetPrerequisites() []*PrerequisiteStatus {
+	if x != nil {
+		return x.Prerequisites
+	}
+	return nil
+}
+
+type GetEligibleCoursesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Courses       []*EligibleCourse      `protobuf:"bytes,1,rep,name=courses,proto3" json:"courses,omitempty"` // eligible courses first, then by code
+	EligibleCount int32                  `protobuf:"varint,2,opt,name=eligible_count,json=eligibleCount,proto3" json:"eligible_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEligibleCoursesResponse) Reset() {
+	*x = GetEligibleCoursesResponse{}
+	mi := &file_backend_protos_course_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEligibleCoursesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEligibleCoursesResponse) ProtoMessage() {}
+
+func (x *GetEligibleCoursesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_protos_course_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEligibleCoursesResponse.ProtoReflect.Descriptor instead.
+func (*GetEligibleCoursesResponse) Descriptor() ([]byte, []int) {
+	return file_backend_protos_course_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *GetEligibleCoursesResponse) GetCourses() []*EligibleCourse {
+	if x != nil {
+		return x.Courses
+	}
+	return nil
+}
+
+func (x *GetEligibleCoursesResponse) GetEligibleCount() int32 {
+	if x != nil {
+		return x.EligibleCount
+	}
+	return 0
+}
+
+type GetCourseAvailabilityRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CourseId      string                 `protobuf:"bytes,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCourseAvailabilityRequest) Reset() {
+	*x = GetCourseAvailabilityRequest{}
+	mi := &file_backend_protos_course_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCourseAvailabilityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCourseAvailabilityRequest) ProtoMessage() {}
+
+func (x *GetCourseAvailabilityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_protos_course_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCourseAvailabilityRequest.ProtoReflect.Descriptor instead.
+func (*GetCourseAvailabilityRequest) Descriptor() ([]byte, []int) {
+	return file_backend_protos_course_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *GetCourseAvailabilityRequest) GetCourseId() string {
+	if x != nil {
+		return x.CourseId
+	}
+	return ""
+}
+
+type GetCourseAvailabilityResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Available      bool                   `protobuf:"varint,1,opt,name=available,proto3" json:"available,omitempty"`
+	Capacity       int32                  `protobuf:"varint,2,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	Enrolled       int32                  `protobuf:"varint,3,opt,name=enrolled,proto3" json:"enrolled,omitempty"`
+	SeatsRemaining int32                  `protobuf:"varint,4,opt,name=seats_remaining,json=seatsRemaining,proto3" json:"seats_remaining,omitempty"`
+	IsOpen         bool                   `protobuf:"varint,5,opt,name=is_open,json=isOpen,proto3" json:"is_open,omitempty"`
+	Message        string                 `protobuf:"bytes,6,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetCourseAvailabilityResponse) Reset() {
+	*x = GetCourseAvailabilityResponse{}
+	mi := &file_backend_protos_course_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCourseAvailabilityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCourseAvailabilityResponse) ProtoMessage() {}
+
+func (x *GetCourseAvailabilityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_protos_course_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCourseAvailabilityResponse.ProtoReflect.Descriptor instead.
+func (*GetCourseAvailabilityResponse) Descriptor() ([]byte, []int) {
+	return file_backend_protos_course_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *GetCourseAvailabilityResponse) GetAvailable() bool {
+	if x != nil {
+		return x.Available
+	}
+	return false
+}
+
+func (x *GetCourseAvailabilityResponse) GetCapacity() int32 {
+	if x != nil {
+		return x.Capacity
+	}
+	return 0
+}
+
+func (x *GetCourseAvailabilityResponse) GetEnrolled() int32 {
+	if x != nil {
+		return x.Enrolled
+	}
+	return 0
+}
+
+func (x *GetCourseAvailabilityResponse) GetSeatsRemaining() int32 {
+	if x != nil {
+		return x.SeatsRemaining
+	}
+	return 0
+}
+
+func (x *GetCourseAvailabilityResponse) GetIsOpen() bool {
+	if x != nil {
+		return x.IsOpen
+	}
+	return false
+}
+
+func (x *GetCourseAvailabilityResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type AddCourseMaterialRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CourseId      string                 `protobuf:"bytes,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // faculty or admin making the change
+	Title         string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Url           string                 `protobuf:"bytes,4,opt,name=url,proto3" json:"url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddCourseMaterialRequest) Reset() {
+	*x = AddCourseMaterialRequest{}
+	mi := &file_backend_protos_course_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddCourseMaterialRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddCourseMaterialRequest) ProtoMessage() {}
+
+func (x *AddCourseMaterialRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_protos_course_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddCourseMaterialRequest.ProtoReflect.Descriptor instead.
+func (*AddCourseMaterialRequest) Descriptor() ([]byte, []int) {
+	return file_backend_protos_course_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *AddCourseMaterialRequest) GetCourseId() string {
+	if x != nil {
+		return x.CourseId
+	}
+	return ""
+}
+
+func (x *AddCourseMaterialRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *AddCourseMaterialRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *AddCourseMaterialRequest) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+type AddCourseMaterialResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Material      *CourseMaterial        `protobuf:"bytes,2,opt,name=material,proto3" json:"material,omitempty"`
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddCourseMaterialResponse) Reset() {
+	*x = AddCourseMaterialResponse{}
+	mi := &file_backend_protos_course_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddCourseMaterialResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddCourseMaterialResponse) ProtoMessage() {}
+
+func (x *AddCourseMaterialResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_protos_course_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddCourseMaterialResponse.ProtoReflect.Descriptor instead.
+func (*AddCourseMaterialResponse) Descriptor() ([]byte, []int) {
+	return file_backend_protos_course_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *AddCourseMaterialResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *AddCourseMaterialResponse) GetMaterial() *CourseMaterial {
+	if x != nil {
+		return x.Material
+	}
+	return nil
+}
+
+func (x *AddCourseMaterialResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type RemoveCourseMaterialRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CourseId      string                 `protobuf:"bytes,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // faculty or admin making the change
+	MaterialId    string                 `protobuf:"bytes,3,opt,name=material_id,json=materialId,proto3" json:"material_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveCourseMaterialRequest) Reset() {
+	*x = RemoveCourseMaterialRequest{}
+	mi := &file_backend_protos_course_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveCourseMaterialRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveCourseMaterialRequest) ProtoMessage() {}
+
+func (x *RemoveCourseMaterialRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_protos_course_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveCourseMaterialRequest.ProtoReflect.Descriptor instead.
+func (*RemoveCourseMaterialRequest) Descriptor() ([]byte, []int) {
+	return file_backend_protos_course_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *RemoveCourseMaterialRequest) GetCourseId() string {
+	if x != nil {
+		return x.CourseId
+	}
+	return ""
+}
+
+func (x *RemoveCourseMaterialRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *RemoveCourseMaterialRequest) GetMaterialId() string {
+	if x != nil {
+		return x.MaterialId
+	}
+	return ""
+}
+
+type RemoveCourseMaterialResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveCourseMaterialResponse) Reset() {
+	*x = RemoveCourseMaterialResponse{}
+	mi := &file_backend_protos_course_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveCourseMaterialResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveCourseMaterialResponse) ProtoMessage() {}
+
+func (x *RemoveCourseMaterialResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_protos_course_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveCourseMaterialResponse.ProtoReflect.Descriptor instead.
+func (*RemoveCourseMaterialResponse) Descriptor() ([]byte, []int) {
+	return file_backend_protos_course_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *RemoveCourseMaterialResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *RemoveCourseMaterialResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// Each rating is 1 (poor) to 5 (excellent)
+type ReviewRatings struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Content       int32                  `protobuf:"varint,1,opt,name=content,proto3" json:"content,omitempty"`
+	Instruction   int32                  `protobuf:"varint,2,opt,name=instruction,proto3" json:"instruction,omitempty"`
+	Organization  int32                  `protobuf:"varint,3,opt,name=organization,proto3" json:"organization,omitempty"`
+	Overall       int32                  `protobuf:"varint,4,opt,name=overall,proto3" json:"overall,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReviewRatings) Reset() {
+	*x = ReviewRatings{}
+	mi := &file_backend_protos_course_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReviewRatings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewRatings) ProtoMessage() {}
+
+func (x *ReviewRatings) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_protos_course_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewRatings.ProtoReflect.Descriptor instead.
+func (*ReviewRatings) Descriptor() ([]byte, []int) {
+	return file_backend_protos_course_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ReviewRatings) GetContent() int32 {
+	if x != nil {
+		return x.Content
+	}
+	return 0
+}
+
+func (x *ReviewRatings) GetInstruction() int32 {
+	if x != nil {
+		return x.Instruction
+	}
+	return 0
+}
+
+func (x *ReviewRatings) GetOrganization() int32 {
+	if x != nil {
+		return x.Organization
+	}
+	return 0
+}
+
+func (x *ReviewRatings) GetOverall() int32 {
+	if x != nil {
+		return x.Overall
+	}
+	return 0
+}
+
+type SubmitReviewRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CourseId      string                 `protobuf:"bytes,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	StudentId     string                 `protobuf:"bytes,2,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"` // must own the enrollment; not stored with the review
+	EnrollmentId  string                 `protobuf:"bytes,3,opt,name=enrollment_id,json=enrollmentId,proto3" json:"enrollment_id,omitempty"`
+	Ratings       *ReviewRatings         `protobuf:"bytes,4,opt,name=ratings,proto3" json:"ratings,omitempty"`
+	Comment       string                 `protobuf:"bytes,5,opt,name=comment,proto3" json:"comment,omitempty"` // optional
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitReviewRequest) Reset() {
+	*x = SubmitReviewRequest{}
+	mi := &file_backend_protos_course_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitReviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitReviewRequest) ProtoMessage() {}
+
+func (x *SubmitReviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_protos_course_proto_msgTypes[32]
 	if x != nil {
-		return x.Success
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return false
+	return mi.MessageOf(x)
 }
 
-func (x *GetCourseResponse) GetCourse() *Course {
+// Deprecated: Use SubmitReviewRequest.ProtoReflect.Descriptor instead.
+func (*SubmitReviewRequest) Descriptor() ([]byte, []int) {
+	return file_backend_protos_course_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *SubmitReviewRequest) GetCourseId() string {
 	if x != nil {
-		return x.Course
+		return x.CourseId
+	}
+	return ""
+}
+
+func (x *SubmitReviewRequest) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *SubmitReviewRequest) GetEnrollmentId() string {
+	if x != nil {
+		return x.EnrollmentId
+	}
+	return ""
+}
+
+func (x *SubmitReviewRequest) GetRatings() *ReviewRatings {
+	if x != nil {
+		return x.Ratings
 	}
 	return nil
 }
 
-func (x *GetCourseResponse) GetMessage() string {
+func (x *SubmitReviewRequest) GetComment() string {
 	if x != nil {
-		return x.Message
+		return x.Comment
 	}
 	return ""
 }
 
-type CheckPrerequisitesRequest struct {
+type SubmitReviewResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	StudentId     string                 `protobuf:"bytes,1,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
-	CourseId      string                 `protobuf:"bytes,2,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	ReviewId      string                 `protobuf:"bytes,2,opt,name=review_id,json=reviewId,proto3" json:"review_id,omitempty"`
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CheckPrerequisitesRequest) Reset() {
-	*x = CheckPrerequisitesRequest{}
-	mi := &file_backend_protos_course_proto_msgTypes[6]
+func (x *SubmitReviewResponse) Reset() {
+	*x = SubmitReviewResponse{}
+	mi := &file_backend_protos_course_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CheckPrerequisitesRequest) String() string {
+func (x *SubmitReviewResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CheckPrerequisitesRequest) ProtoMessage() {}
+func (*SubmitReviewResponse) ProtoMessage() {}
 
-func (x *CheckPrerequisitesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_backend_protos_course_proto_msgTypes[6]
+func (x *SubmitReviewResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_protos_course_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -489,50 +2241,56 @@ func (x *CheckPrerequisitesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CheckPrerequisitesRequest.ProtoReflect.Descriptor instead.
-func (*CheckPrerequisitesRequest) Descriptor() ([]byte, []int) {
-	return file_backend_protos_course_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use SubmitReviewResponse.ProtoReflect.Descriptor instead.
+func (*SubmitReviewResponse) Descriptor() ([]byte, []int) {
+	return file_backend_protos_course_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *CheckPrerequisitesRequest) GetStudentId() string {
+func (x *SubmitReviewResponse) GetSuccess() bool {
 	if x != nil {
-		return x.StudentId
+		return x.Success
+	}
+	return false
+}
+
+func (x *SubmitReviewResponse) GetReviewId() string {
+	if x != nil {
+		return x.ReviewId
 	}
 	return ""
 }
 
-func (x *CheckPrerequisitesRequest) GetCourseId() string {
+func (x *SubmitReviewResponse) GetMessage() string {
 	if x != nil {
-		return x.CourseId
+		return x.Message
 	}
 	return ""
 }
 
-type PrerequisiteStatus struct {
+type GetCourseReviewSummaryRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	CourseId      string                 `protobuf:"bytes,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
-	CourseCode    string                 `protobuf:"bytes,2,opt,name=course_code,json=courseCode,proto3" json:"course_code,omitempty"`
-	Met           bool                   `protobuf:"varint,3,opt,name=met,proto3" json:"met,omitempty"`
-	Grade         string                 `protobuf:"bytes,4,opt,name=grade,proto3" json:"grade,omitempty"` // grade received if taken
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // faculty or admin requesting the summary
+	Semester      string                 `protobuf:"bytes,3,opt,name=semester,proto3" json:"semester,omitempty"`           // optional, restrict to one offering
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PrerequisiteStatus) Reset() {
-	*x = PrerequisiteStatus{}
-	mi := &file_backend_protos_course_proto_msgTypes[7]
+func (x *GetCourseReviewSummaryRequest) Reset() {
+	*x = GetCourseReviewSummaryRequest{}
+	mi := &file_backend_protos_course_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PrerequisiteStatus) String() string {
+func (x *GetCourseReviewSummaryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PrerequisiteStatus) ProtoMessage() {}
+func (*GetCourseReviewSummaryRequest) ProtoMessage() {}
 
-func (x *PrerequisiteStatus) ProtoReflect() protoreflect.Message {
-	mi := &file_backend_protos_course_proto_msgTypes[7]
+func (x *GetCourseReviewSummaryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_protos_course_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -543,63 +2301,124 @@ func (x *PrerequisiteStatus) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PrerequisiteStatus.ProtoReflect.Descriptor instead.
-func (*PrerequisiteStatus) Descriptor() ([]byte, []int) {
-	return file_backend_protos_course_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use GetCourseReviewSummaryRequest.ProtoReflect.Descriptor instead.
+func (*GetCourseReviewSummaryRequest) Descriptor() ([]byte, []int) {
+	return file_backend_protos_course_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *PrerequisiteStatus) GetCourseId() string {
+func (x *GetCourseReviewSummaryRequest) GetCourseId() string {
 	if x != nil {
 		return x.CourseId
 	}
 	return ""
 }
 
-func (x *PrerequisiteStatus) GetCourseCode() string {
+func (x *GetCourseReviewSummaryRequest) GetUserId() string {
 	if x != nil {
-		return x.CourseCode
+		return x.UserId
 	}
 	return ""
 }
 
-func (x *PrerequisiteStatus) GetMet() bool {
+func (x *GetCourseReviewSummaryRequest) GetSemester() string {
 	if x != nil {
-		return x.Met
+		return x.Semester
 	}
-	return false
+	return ""
 }
 
-func (x *PrerequisiteStatus) GetGrade() string {
+type ReviewAverages struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Content       float64                `protobuf:"fixed64,1,opt,name=content,proto3" json:"content,omitempty"`
+	Instruction   float64                `protobuf:"fixed64,2,opt,name=instruction,proto3" json:"instruction,omitempty"`
+	Organization  float64                `protobuf:"fixed64,3,opt,name=organization,proto3" json:"organization,omitempty"`
+	Overall       float64                `protobuf:"fixed64,4,opt,name=overall,proto3" json:"overall,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReviewAverages) Reset() {
+	*x = ReviewAverages{}
+	mi := &file_backend_protos_course_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReviewAverages) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewAverages) ProtoMessage() {}
+
+func (x *ReviewAverages) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_protos_course_proto_msgTypes[35]
 	if x != nil {
-		return x.Grade
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-type CheckPrerequisitesResponse struct {
+// Deprecated: Use ReviewAverages.ProtoReflect.Descriptor instead.
+func (*ReviewAverages) Descriptor() ([]byte, []int) {
+	return file_backend_protos_course_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ReviewAverages) GetContent() float64 {
+	if x != nil {
+		return x.Content
+	}
+	return 0
+}
+
+func (x *ReviewAverages) GetInstruction() float64 {
+	if x != nil {
+		return x.Instruction
+	}
+	return 0
+}
+
+func (x *ReviewAverages) GetOrganization() float64 {
+	if x != nil {
+		return x.Organization
+	}
+	return 0
+}
+
+func (x *ReviewAverages) GetOverall() float64 {
+	if x != nil {
+		return x.Overall
+	}
+	return 0
+}
+
+type ReviewComment struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	AllMet        bool                   `protobuf:"varint,1,opt,name=all_met,json=allMet,proto3" json:"all_met,omitempty"`
-	Prerequisites []*PrerequisiteStatus  `protobuf:"bytes,2,rep,name=prerequisites,proto3" json:"prerequisites,omitempty"`
-	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	Comment       string                 `protobuf:"bytes,1,opt,name=comment,proto3" json:"comment,omitempty"`
+	Semester      string                 `protobuf:"bytes,2,opt,name=semester,proto3" json:"semester,omitempty"`
+	SubmittedAt   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=submitted_at,json=submittedAt,proto3" json:"submitted_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CheckPrerequisitesResponse) Reset() {
-	*x = CheckPrerequisitesResponse{}
-	mi := &file_backend_protos_course_proto_msgTypes[8]
+func (x *ReviewComment) Reset() {
+	*x = ReviewComment{}
+	mi := &file_backend_protos_course_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CheckPrerequisitesResponse) String() string {
+func (x *ReviewComment) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CheckPrerequisitesResponse) ProtoMessage() {}
+func (*ReviewComment) ProtoMessage() {}
 
-func (x *CheckPrerequisitesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_backend_protos_course_proto_msgTypes[8]
+func (x *ReviewComment) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_protos_course_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -610,54 +2429,57 @@ func (x *CheckPrerequisitesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CheckPrerequisitesResponse.ProtoReflect.Descriptor instead.
-func (*CheckPrerequisitesResponse) Descriptor() ([]byte, []int) {
-	return file_backend_protos_course_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use ReviewComment.ProtoReflect.Descriptor instead.
+func (*ReviewComment) Descriptor() ([]byte, []int) {
+	return file_backend_protos_course_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *CheckPrerequisitesResponse) GetAllMet() bool {
+func (x *ReviewComment) GetComment() string {
 	if x != nil {
-		return x.AllMet
+		return x.Comment
 	}
-	return false
+	return ""
 }
 
-func (x *CheckPrerequisitesResponse) GetPrerequisites() []*PrerequisiteStatus {
+func (x *ReviewComment) GetSemester() string {
 	if x != nil {
-		return x.Prerequisites
+		return x.Semester
 	}
-	return nil
+	return ""
 }
 
-func (x *CheckPrerequisitesResponse) GetMessage() string {
+func (x *ReviewComment) GetSubmittedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Message
+		return x.SubmittedAt
 	}
-	return ""
+	return nil
 }
 
-type GetCourseAvailabilityRequest struct {
+type GetCourseReviewSummaryResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	CourseId      string                 `protobuf:"bytes,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	ReviewCount   int32                  `protobuf:"varint,2,opt,name=review_count,json=reviewCount,proto3" json:"review_count,omitempty"`
+	Averages      *ReviewAverages        `protobuf:"bytes,3,opt,name=averages,proto3" json:"averages,omitempty"` // zero when there are no reviews
+	Comments      []*ReviewComment       `protobuf:"bytes,4,rep,name=comments,proto3" json:"comments,omitempty"` // admins only, newest first
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetCourseAvailabilityRequest) Reset() {
-	*x = GetCourseAvailabilityRequest{}
-	mi := &file_backend_protos_course_proto_msgTypes[9]
+func (x *GetCourseReviewSummaryResponse) Reset() {
+	*x = GetCourseReviewSummaryResponse{}
+	mi := &file_backend_protos_course_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCourseAvailabilityRequest) String() string {
+func (x *GetCourseReviewSummaryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCourseAvailabilityRequest) ProtoMessage() {}
+func (*GetCourseReviewSummaryResponse) ProtoMessage() {}
 
-func (x *GetCourseAvailabilityRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_backend_protos_course_proto_msgTypes[9]
+func (x *GetCourseReviewSummaryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_protos_course_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -668,45 +2490,61 @@ func (x *GetCourseAvailabilityRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCourseAvailabilityRequest.ProtoReflect.Descriptor instead.
-func (*GetCourseAvailabilityRequest) Descriptor() ([]byte, []int) {
-	return file_backend_protos_course_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use GetCourseReviewSummaryResponse.ProtoReflect.Descriptor instead.
+func (*GetCourseReviewSummaryResponse) Descriptor() ([]byte, []int) {
+	return file_backend_protos_course_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *GetCourseAvailabilityRequest) GetCourseId() string {
+func (x *GetCourseReviewSummaryResponse) GetCourseId() string {
 	if x != nil {
 		return x.CourseId
 	}
 	return ""
 }
 
-type GetCourseAvailabilityResponse struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Available      bool                   `protobuf:"varint,1,opt,name=available,proto3" json:"available,omitempty"`
-	Capacity       int32                  `protobuf:"varint,2,opt,name=capacity,proto3" json:"capacity,omitempty"`
-	Enrolled       int32                  `protobuf:"varint,3,opt,name=enrolled,proto3" json:"enrolled,omitempty"`
-	SeatsRemaining int32                  `protobuf:"varint,4,opt,name=seats_remaining,json=seatsRemaining,proto3" json:"seats_remaining,omitempty"`
-	IsOpen         bool                   `protobuf:"varint,5,opt,name=is_open,json=isOpen,proto3" json:"is_open,omitempty"`
-	Message        string                 `protobuf:"bytes,6,opt,name=message,proto3" json:"message,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+func (x *GetCourseReviewSummaryResponse) GetReviewCount() int32 {
+	if x != nil {
+		return x.ReviewCount
+	}
+	return 0
 }
 
-func (x *GetCourseAvailabilityResponse) Reset() {
-	*x = GetCourseAvailabilityResponse{}
-	mi := &file_backend_protos_course_proto_msgTypes[10]
+func (x *GetCourseReviewSummaryResponse) GetAverages() *ReviewAverages {
+	if x != nil {
+		return x.Averages
+	}
+	return nil
+}
+
+func (x *GetCourseReviewSummaryResponse) GetComments() []*ReviewComment {
+	if x != nil {
+		return x.Comments
+	}
+	return nil
+}
+
+type GetCatalogCacheStatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // must be an admin
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCatalogCacheStatsRequest) Reset() {
+	*x = GetCatalogCacheStatsRequest{}
+	mi := &file_backend_protos_course_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCourseAvailabilityResponse) String() string {
+func (x *GetCatalogCacheStatsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCourseAvailabilityResponse) ProtoMessage() {}
+func (*GetCatalogCacheStatsRequest) ProtoMessage() {}
 
-func (x *GetCourseAvailabilityResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_backend_protos_course_proto_msgTypes[10]
+func (x *GetCatalogCacheStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_protos_course_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -717,58 +2555,91 @@ func (x *GetCourseAvailabilityResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCourseAvailabilityResponse.ProtoReflect.Descriptor instead.
-func (*GetCourseAvailabilityResponse) Descriptor() ([]byte, []int) {
-	return file_backend_protos_course_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use GetCatalogCacheStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetCatalogCacheStatsRequest) Descriptor() ([]byte, []int) {
+	return file_backend_protos_course_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *GetCourseAvailabilityResponse) GetAvailable() bool {
+func (x *GetCatalogCacheStatsRequest) GetUserId() string {
 	if x != nil {
-		return x.Available
+		return x.UserId
 	}
-	return false
+	return ""
 }
 
-func (x *GetCourseAvailabilityResponse) GetCapacity() int32 {
+type GetCatalogCacheStatsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Hits          int64                  `protobuf:"varint,1,opt,name=hits,proto3" json:"hits,omitempty"`                       // cacheable ListCourses calls served from the cache
+	Misses        int64                  `protobuf:"varint,2,opt,name=misses,proto3" json:"misses,omitempty"`                   // cacheable calls that went to the database; live (admin) calls are not counted
+	HitRate       float64                `protobuf:"fixed64,3,opt,name=hit_rate,json=hitRate,proto3" json:"hit_rate,omitempty"` // hits / (hits + misses), 0 before the first lookup
+	Entries       int32                  `protobuf:"varint,4,opt,name=entries,proto3" json:"entries,omitempty"`                 // cached pages currently held, expired ones included until evicted
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCatalogCacheStatsResponse) Reset() {
+	*x = GetCatalogCacheStatsResponse{}
+	mi := &file_backend_protos_course_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCatalogCacheStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCatalogCacheStatsResponse) ProtoMessage() {}
+
+func (x *GetCatalogCacheStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_protos_course_proto_msgTypes[39]
 	if x != nil {
-		return x.Capacity
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *GetCourseAvailabilityResponse) GetEnrolled() int32 {
+// Deprecated: Use GetCatalogCacheStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetCatalogCacheStatsResponse) Descriptor() ([]byte, []int) {
+	return file_backend_protos_course_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *GetCatalogCacheStatsResponse) GetHits() int64 {
 	if x != nil {
-		return x.Enrolled
+		return x.Hits
 	}
 	return 0
 }
 
-func (x *GetCourseAvailabilityResponse) GetSeatsRemaining() int32 {
+func (x *GetCatalogCacheStatsResponse) GetMisses() int64 {
 	if x != nil {
-		return x.SeatsRemaining
+		return x.Misses
 	}
 	return 0
 }
 
-func (x *GetCourseAvailabilityResponse) GetIsOpen() bool {
+func (x *GetCatalogCacheStatsResponse) GetHitRate() float64 {
 	if x != nil {
-		return x.IsOpen
+		return x.HitRate
 	}
-	return false
+	return 0
 }
 
-func (x *GetCourseAvailabilityResponse) GetMessage() string {
+func (x *GetCatalogCacheStatsResponse) GetEntries() int32 {
 	if x != nil {
-		return x.Message
+		return x.Entries
 	}
-	return ""
+	return 0
 }
 
 var File_backend_protos_course_proto protoreflect.FileDescriptor
 
 const file_backend_protos_course_proto_rawDesc = "" +
 	"\n" +
-	"\x1bbackend/protos/course.proto\x12\x06course\x1a\x1fgoogle/protobuf/timestamp.proto\"\xf5\x03\n" +
+	"\x1bbackend/protos/course.proto\x12\x06course\x1a\x1fgoogle/protobuf/timestamp.proto\"\xfa\a\n" +
 	"\x06Course\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04code\x18\x02 \x01(\tR\x04code\x12\x14\n" +
@@ -789,40 +2660,134 @@ const file_backend_protos_course_proto_rawDesc = "" +
 	"created_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
 	"updated_at\x18\x0f \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12$\n" +
-	"\rprerequisites\x18\x10 \x03(\tR\rprerequisites\"\x8a\x01\n" +
+	"\rprerequisites\x18\x10 \x03(\tR\rprerequisites\x12\x14\n" +
+	"\x05score\x18\x11 \x01(\x01R\x05score\x124\n" +
+	"\tmaterials\x18\x12 \x03(\v2\x16.course.CourseMaterialR\tmaterials\x12%\n" +
+	"\x0eallowed_majors\x18\x13 \x03(\tR\rallowedMajors\x12$\n" +
+	"\x0emin_year_level\x18\x14 \x01(\x05R\fminYearLevel\x12'\n" +
+	"\x0fseats_available\x18\x15 \x01(\x05R\x0eseatsAvailable\x12\x17\n" +
+	"\ais_full\x18\x16 \x01(\bR\x06isFull\x12%\n" +
+	"\x0ewaitlist_count\x18\x17 \x01(\x05R\rwaitlistCount\x12\x1e\n" +
+	"\n" +
+	"department\x18\x18 \x01(\tR\n" +
+	"department\x12@\n" +
+	"\x0eenroll_open_at\x18\x19 \x01(\v2\x1a.google.protobuf.TimestampR\fenrollOpenAt\x12B\n" +
+	"\x0fenroll_close_at\x18\x1a \x01(\v2\x1a.google.protobuf.TimestampR\renrollCloseAt\x12\x1e\n" +
+	"\n" +
+	"enrollable\x18\x1b \x01(\bR\n" +
+	"enrollable\x12\x1d\n" +
+	"\n" +
+	"seats_held\x18\x1c \x01(\x05R\tseatsHeld\x12\x1c\n" +
+	"\tcancelled\x18\x1d \x01(\bR\tcancelled\"\x7f\n" +
+	"\x0eCourseMaterial\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x10\n" +
+	"\x03url\x18\x03 \x01(\tR\x03url\x125\n" +
+	"\badded_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\aaddedAt\"\xa1\x02\n" +
 	"\fCourseFilter\x12\x1e\n" +
 	"\n" +
 	"department\x18\x01 \x01(\tR\n" +
 	"department\x12!\n" +
 	"\fsearch_query\x18\x02 \x01(\tR\vsearchQuery\x12\x1b\n" +
 	"\topen_only\x18\x03 \x01(\bR\bopenOnly\x12\x1a\n" +
-	"\bsemester\x18\x04 \x01(\tR\bsemester\"D\n" +
+	"\bsemester\x18\x04 \x01(\tR\bsemester\x12\x1f\n" +
+	"\vtext_search\x18\x05 \x01(\tR\n" +
+	"textSearch\x12\x12\n" +
+	"\x04days\x18\x06 \x03(\tR\x04days\x123\n" +
+	"\vtime_window\x18\a \x01(\v2\x12.course.TimeWindowR\n" +
+	"timeWindow\x12+\n" +
+	"\x11include_cancelled\x18\b \x01(\bR\x10includeCancelled\"4\n" +
+	"\n" +
+	"TimeWindow\x12\x14\n" +
+	"\x05start\x18\x01 \x01(\tR\x05start\x12\x10\n" +
+	"\x03end\x18\x02 \x01(\tR\x03end\"\x90\x01\n" +
 	"\x12ListCoursesRequest\x12.\n" +
-	"\afilters\x18\x01 \x01(\v2\x14.course.CourseFilterR\afilters\"`\n" +
+	"\afilters\x18\x01 \x01(\v2\x14.course.CourseFilterR\afilters\x12\x12\n" +
+	"\x04live\x18\x02 \x01(\bR\x04live\x12\x17\n" +
+	"\asort_by\x18\x03 \x01(\tR\x06sortBy\x12\x1d\n" +
+	"\n" +
+	"sort_order\x18\x04 \x01(\tR\tsortOrder\"`\n" +
 	"\x13ListCoursesResponse\x12(\n" +
 	"\acourses\x18\x01 \x03(\v2\x0e.course.CourseR\acourses\x12\x1f\n" +
 	"\vtotal_count\x18\x02 \x01(\x05R\n" +
-	"totalCount\"/\n" +
+	"totalCount\"4\n" +
+	"\n" +
+	"Department\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\"\x18\n" +
+	"\x16ListDepartmentsRequest\"O\n" +
+	"\x17ListDepartmentsResponse\x124\n" +
+	"\vdepartments\x18\x01 \x03(\v2\x12.course.DepartmentR\vdepartments\"/\n" +
 	"\x10GetCourseRequest\x12\x1b\n" +
 	"\tcourse_id\x18\x01 \x01(\tR\bcourseId\"o\n" +
 	"\x11GetCourseResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12&\n" +
 	"\x06course\x18\x02 \x01(\v2\x0e.course.CourseR\x06course\x12\x18\n" +
-	"\amessage\x18\x03 \x01(\tR\amessage\"W\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\"T\n" +
+	"\x16GetCourseDetailRequest\x12\x1b\n" +
+	"\tcourse_id\x18\x01 \x01(\tR\bcourseId\x12\x1d\n" +
+	"\n" +
+	"student_id\x18\x02 \x01(\tR\tstudentId\"h\n" +
+	"\x13CourseStudentStatus\x12\x17\n" +
+	"\ain_cart\x18\x01 \x01(\bR\x06inCart\x12\x1a\n" +
+	"\benrolled\x18\x02 \x01(\bR\benrolled\x12\x1c\n" +
+	"\tcompleted\x18\x03 \x01(\bR\tcompleted\"\xb9\x01\n" +
+	"\x17GetCourseDetailResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12&\n" +
+	"\x06course\x18\x02 \x01(\v2\x0e.course.CourseR\x06course\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\x12B\n" +
+	"\x0estudent_status\x18\x04 \x01(\v2\x1b.course.CourseStudentStatusR\rstudentStatus\"7\n" +
+	"\x16BatchGetCoursesRequest\x12\x1d\n" +
+	"\n" +
+	"course_ids\x18\x01 \x03(\tR\tcourseIds\"d\n" +
+	"\x17BatchGetCoursesResponse\x12(\n" +
+	"\acourses\x18\x01 \x03(\v2\x0e.course.CourseR\acourses\x12\x1f\n" +
+	"\vmissing_ids\x18\x02 \x03(\tR\n" +
+	"missingIds\"\x83\x01\n" +
 	"\x19CheckPrerequisitesRequest\x12\x1d\n" +
 	"\n" +
 	"student_id\x18\x01 \x01(\tR\tstudentId\x12\x1b\n" +
-	"\tcourse_id\x18\x02 \x01(\tR\bcourseId\"z\n" +
+	"\tcourse_id\x18\x02 \x01(\tR\bcourseId\x12*\n" +
+	"\x11allow_in_progress\x18\x03 \x01(\bR\x0fallowInProgress\"\xb4\x01\n" +
 	"\x12PrerequisiteStatus\x12\x1b\n" +
 	"\tcourse_id\x18\x01 \x01(\tR\bcourseId\x12\x1f\n" +
 	"\vcourse_code\x18\x02 \x01(\tR\n" +
 	"courseCode\x12\x10\n" +
 	"\x03met\x18\x03 \x01(\bR\x03met\x12\x14\n" +
-	"\x05grade\x18\x04 \x01(\tR\x05grade\"\x91\x01\n" +
+	"\x05grade\x18\x04 \x01(\tR\x05grade\x12\x17\n" +
+	"\amet_via\x18\x05 \x01(\tR\x06metVia\x12\x1f\n" +
+	"\vin_progress\x18\x06 \x01(\bR\n" +
+	"inProgress\"\xb3\x01\n" +
 	"\x1aCheckPrerequisitesResponse\x12\x17\n" +
 	"\aall_met\x18\x01 \x01(\bR\x06allMet\x12@\n" +
 	"\rprerequisites\x18\x02 \x03(\v2\x1a.course.PrerequisiteStatusR\rprerequisites\x12\x18\n" +
-	"\amessage\x18\x03 \x01(\tR\amessage\";\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\x12 \n" +
+	"\vprovisional\x18\x04 \x01(\bR\vprovisional\"6\n" +
+	"\x17GetPrerequisitesRequest\x12\x1b\n" +
+	"\tcourse_id\x18\x01 \x01(\tR\bcourseId\"b\n" +
+	"\fPrerequisite\x12\x1b\n" +
+	"\tcourse_id\x18\x01 \x01(\tR\bcourseId\x12\x1f\n" +
+	"\vcourse_code\x18\x02 \x01(\tR\n" +
+	"courseCode\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\"s\n" +
+	"\x18GetPrerequisitesResponse\x12\x1b\n" +
+	"\tcourse_id\x18\x01 \x01(\tR\bcourseId\x12:\n" +
+	"\rprerequisites\x18\x02 \x03(\v2\x14.course.PrerequisiteR\rprerequisites\"v\n" +
+	"\x19GetEligibleCoursesRequest\x12\x1d\n" +
+	"\n" +
+	"student_id\x18\x01 \x01(\tR\tstudentId\x12\x1a\n" +
+	"\bsemester\x18\x02 \x01(\tR\bsemester\x12\x1e\n" +
+	"\n" +
+	"department\x18\x03 \x01(\tR\n" +
+	"department\"\xae\x01\n" +
+	"\x0eEligibleCourse\x12&\n" +
+	"\x06course\x18\x01 \x01(\v2\x0e.course.CourseR\x06course\x12\x1a\n" +
+	"\beligible\x18\x02 \x01(\bR\beligible\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\x12@\n" +
+	"\rprerequisites\x18\x04 \x03(\v2\x1a.course.PrerequisiteStatusR\rprerequisites\"u\n" +
+	"\x1aGetEligibleCoursesResponse\x120\n" +
+	"\acourses\x18\x01 \x03(\v2\x16.course.EligibleCourseR\acourses\x12%\n" +
+	"\x0eeligible_count\x18\x02 \x01(\x05R\religibleCount\";\n" +
 	"\x1cGetCourseAvailabilityRequest\x12\x1b\n" +
 	"\tcourse_id\x18\x01 \x01(\tR\bcourseId\"\xd1\x01\n" +
 	"\x1dGetCourseAvailabilityResponse\x12\x1c\n" +
@@ -831,12 +2796,80 @@ const file_backend_protos_course_proto_rawDesc = "" +
 	"\benrolled\x18\x03 \x01(\x05R\benrolled\x12'\n" +
 	"\x0fseats_remaining\x18\x04 \x01(\x05R\x0eseatsRemaining\x12\x17\n" +
 	"\ais_open\x18\x05 \x01(\bR\x06isOpen\x12\x18\n" +
-	"\amessage\x18\x06 \x01(\tR\amessage2\xdc\x02\n" +
+	"\amessage\x18\x06 \x01(\tR\amessage\"x\n" +
+	"\x18AddCourseMaterialRequest\x12\x1b\n" +
+	"\tcourse_id\x18\x01 \x01(\tR\bcourseId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x12\x10\n" +
+	"\x03url\x18\x04 \x01(\tR\x03url\"\x83\x01\n" +
+	"\x19AddCourseMaterialResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x122\n" +
+	"\bmaterial\x18\x02 \x01(\v2\x16.course.CourseMaterialR\bmaterial\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\"t\n" +
+	"\x1bRemoveCourseMaterialRequest\x12\x1b\n" +
+	"\tcourse_id\x18\x01 \x01(\tR\bcourseId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x1f\n" +
+	"\vmaterial_id\x18\x03 \x01(\tR\n" +
+	"materialId\"R\n" +
+	"\x1cRemoveCourseMaterialResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\x89\x01\n" +
+	"\rReviewRatings\x12\x18\n" +
+	"\acontent\x18\x01 \x01(\x05R\acontent\x12 \n" +
+	"\vinstruction\x18\x02 \x01(\x05R\vinstruction\x12\"\n" +
+	"\forganization\x18\x03 \x01(\x05R\forganization\x12\x18\n" +
+	"\aoverall\x18\x04 \x01(\x05R\aoverall\"\xc1\x01\n" +
+	"\x13SubmitReviewRequest\x12\x1b\n" +
+	"\tcourse_id\x18\x01 \x01(\tR\bcourseId\x12\x1d\n" +
+	"\n" +
+	"student_id\x18\x02 \x01(\tR\tstudentId\x12#\n" +
+	"\renrollment_id\x18\x03 \x01(\tR\fenrollmentId\x12/\n" +
+	"\aratings\x18\x04 \x01(\v2\x15.course.ReviewRatingsR\aratings\x12\x18\n" +
+	"\acomment\x18\x05 \x01(\tR\acomment\"g\n" +
+	"\x14SubmitReviewResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x1b\n" +
+	"\treview_id\x18\x02 \x01(\tR\breviewId\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\"q\n" +
+	"\x1dGetCourseReviewSummaryRequest\x12\x1b\n" +
+	"\tcourse_id\x18\x01 \x01(\tR\bcourseId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x1a\n" +
+	"\bsemester\x18\x03 \x01(\tR\bsemester\"\x8a\x01\n" +
+	"\x0eReviewAverages\x12\x18\n" +
+	"\acontent\x18\x01 \x01(\x01R\acontent\x12 \n" +
+	"\vinstruction\x18\x02 \x01(\x01R\vinstruction\x12\"\n" +
+	"\forganization\x18\x03 \x01(\x01R\forganization\x12\x18\n" +
+	"\aoverall\x18\x04 \x01(\x01R\aoverall\"\x84\x01\n" +
+	"\rReviewComment\x12\x18\n" +
+	"\acomment\x18\x01 \x01(\tR\acomment\x12\x1a\n" +
+	"\bsemester\x18\x02 \x01(\tR\bsemester\x12=\n" +
+	"\fsubmitted_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\vsubmittedAt\"\xc7\x01\n" +
+	"\x1eGetCourseReviewSummaryResponse\x12\x1b\n" +
+	"\tcourse_id\x18\x01 \x01(\tR\bcourseId\x12!\n" +
+	"\freview_count\x18\x02 \x01(\x05R\vreviewCount\x122\n" +
+	"\baverages\x18\x03 \x01(\v2\x16.course.ReviewAveragesR\baverages\x121\n" +
+	"\bcomments\x18\x04 \x03(\v2\x15.course.ReviewCommentR\bcomments\"6\n" +
+	"\x1bGetCatalogCacheStatsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"\x7f\n" +
+	"\x1cGetCatalogCacheStatsResponse\x12\x12\n" +
+	"\x04hits\x18\x01 \x01(\x03R\x04hits\x12\x16\n" +
+	"\x06misses\x18\x02 \x01(\x03R\x06misses\x12\x19\n" +
+	"\bhit_rate\x18\x03 \x01(\x01R\ahitRate\x12\x18\n" +
+	"\aentries\x18\x04 \x01(\x05R\aentries2\xe0\t\n" +
 	"\rCourseService\x12F\n" +
 	"\vListCourses\x12\x1a.course.ListCoursesRequest\x1a\x1b.course.ListCoursesResponse\x12@\n" +
-	"\tGetCourse\x12\x18.course.GetCourseRequest\x1a\x19.course.GetCourseResponse\x12[\n" +
-	"\x12CheckPrerequisites\x12!.course.CheckPrerequisitesRequest\x1a\".course.CheckPrerequisitesResponse\x12d\n" +
-	"\x15GetCourseAvailability\x12$.course.GetCourseAvailabilityRequest\x1a%.course.GetCourseAvailabilityResponseB\x13Z\x11backend/pb/courseb\x06proto3"
+	"\tGetCourse\x12\x18.course.GetCourseRequest\x1a\x19.course.GetCourseResponse\x12R\n" +
+	"\x0fGetCourseDetail\x12\x1e.course.GetCourseDetailRequest\x1a\x1f.course.GetCourseDetailResponse\x12R\n" +
+	"\x0fBatchGetCourses\x12\x1e.course.BatchGetCoursesRequest\x1a\x1f.course.BatchGetCoursesResponse\x12[\n" +
+	"\x12CheckPrerequisites\x12!.course.CheckPrerequisitesRequest\x1a\".course.CheckPrerequisitesResponse\x12U\n" +
+	"\x10GetPrerequisites\x12\x1f.course.GetPrerequisitesRequest\x1a .course.GetPrerequisitesResponse\x12d\n" +
+	"\x15GetCourseAvailability\x12$.course.GetCourseAvailabilityRequest\x1a%.course.GetCourseAvailabilityResponse\x12[\n" +
+	"\x12GetEligibleCourses\x12!.course.GetEligibleCoursesRequest\x1a\".course.GetEligibleCoursesResponse\x12R\n" +
+	"\x0fListDepartments\x12\x1e.course.ListDepartmentsRequest\x1a\x1f.course.ListDepartmentsResponse\x12X\n" +
+	"\x11AddCourseMaterial\x12 .course.AddCourseMaterialRequest\x1a!.course.AddCourseMaterialResponse\x12a\n" +
+	"\x14RemoveCourseMaterial\x12#.course.RemoveCourseMaterialRequest\x1a$.course.RemoveCourseMaterialResponse\x12I\n" +
+	"\fSubmitReview\x12\x1b.course.SubmitReviewRequest\x1a\x1c.course.SubmitReviewResponse\x12g\n" +
+	"\x16GetCourseReviewSummary\x12%.course.GetCourseReviewSummaryRequest\x1a&.course.GetCourseReviewSummaryResponse\x12a\n" +
+	"\x14GetCatalogCacheStats\x12#.course.GetCatalogCacheStatsRequest\x1a$.course.GetCatalogCacheStatsResponseB\x13Z\x11backend/pb/courseb\x06proto3"
 
 var (
 	file_backend_protos_course_proto_rawDescOnce sync.Once
@@ -850,41 +2883,108 @@ func file_backend_protos_course_proto_rawDescGZIP() []byte {
 	return file_backend_protos_course_proto_rawDescData
 }
 
-var file_backend_protos_course_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_backend_protos_course_proto_msgTypes = make([]protoimpl.MessageInfo, 40)
 var file_backend_protos_course_proto_goTypes = []any{
-	(*Course)(nil),                        // 0: course.Course
-	(*CourseFilter)(nil),                  // 1: course.CourseFilter
-	(*ListCoursesRequest)(nil),            // 2: course.ListCoursesRequest
-	(*ListCoursesResponse)(nil),           // 3: course.ListCoursesResponse
-	(*GetCourseRequest)(nil),              // 4: course.GetCourseRequest
-	(*GetCourseResponse)(nil),             // 5: course.GetCourseResponse
-	(*CheckPrerequisitesRequest)(nil),     // 6: course.CheckPrerequisitesRequest
-	(*PrerequisiteStatus)(nil),            // 7: course.PrerequisiteStatus
-	(*CheckPrerequisitesResponse)(nil),    // 8: course.CheckPrerequisitesResponse
-	(*GetCourseAvailabilityRequest)(nil),  // 9: course.GetCourseAvailabilityRequest
-	(*GetCourseAvailabilityResponse)(nil), // 10: course.GetCourseAvailabilityResponse
-	(*timestamppb.Timestamp)(nil),         // 11: google.protobuf.Timestamp
+	(*Course)(nil),                         // 0: course.Course
+	(*CourseMaterial)(nil),                 // 1: course.CourseMaterial
+	(*CourseFilter)(nil),                   // 2: course.CourseFilter
+	(*TimeWindow)(nil),                     // 3: course.TimeWindow
+	(*ListCoursesRequest)(nil),             // 4: course.ListCoursesRequest
+	(*ListCoursesResponse)(nil),            // 5: course.ListCoursesResponse
+	(*Department)(nil),                     // 6: course.Department
+	(*ListDepartmentsRequest)(nil),         // 7: course.ListDepartmentsRequest
+	(*ListDepartmentsResponse)(nil),        // 8: course.ListDepartmentsResponse
+	(*GetCourseRequest)(nil),               // 9: course.GetCourseRequest
+	(*GetCourseResponse)(nil),              // 10: course.GetCourseResponse
+	(*GetCourseDetailRequest)(nil),         // 11: course.GetCourseDetailRequest
+	(*CourseStudentStatus)(nil),            // 12: course.CourseStudentStatus
+	(*GetCourseDetailResponse)(nil),        // 13: course.GetCourseDetailResponse
+	(*BatchGetCoursesRequest)(nil),         // 14: course.BatchGetCoursesRequest
+	(*BatchGetCoursesResponse)(nil),        // 15: course.BatchGetCoursesResponse
+	(*CheckPrerequisitesRequest)(nil),      // 16: course.CheckPrerequisitesRequest
+	(*PrerequisiteStatus)(nil),             // 17: course.PrerequisiteStatus
+	(*CheckPrerequisitesResponse)(nil),     // 18: course.CheckPrerequisitesResponse
+	(*GetPrerequisitesRequest)(nil),        // 19: course.GetPrerequisitesRequest
+	(*Prerequisite)(nil),                   // 20: course.Prerequisite
+	(*GetPrerequisitesResponse)(nil),       // 21: course.GetPrerequisitesResponse
+	(*GetEligibleCoursesRequest)(nil),      // 22: course.GetEligibleCoursesRequest
+	(*EligibleCourse)(nil),                 // 23: course.EligibleCourse
+	(*GetEligibleCoursesResponse)(nil),     // 24: course.GetEligibleCoursesResponse
+	(*GetCourseAvailabilityRequest)(nil),   // 25: course.GetCourseAvailabilityRequest
+	(*GetCourseAvailabilityResponse)(nil),  // 26: course.GetCourseAvailabilityResponse
+	(*AddCourseMaterialRequest)(nil),       // 27: course.AddCourseMaterialRequest
+	(*AddCourseMaterialResponse)(nil),      // 28: course.AddCourseMaterialResponse
+	(*RemoveCourseMaterialRequest)(nil),    // 29: course.RemoveCourseMaterialRequest
+	(*RemoveCourseMaterialResponse)(nil),   // 30: course.RemoveCourseMaterialResponse
+	(*ReviewRatings)(nil),                  // 31: course.ReviewRatings
+	(*SubmitReviewRequest)(nil),            // 32: course.SubmitReviewRequest
+	(*SubmitReviewResponse)(nil),           // 33: course.SubmitReviewResponse
+	(*GetCourseReviewSummaryRequest)(nil),  // 34: course.GetCourseReviewSummaryRequest
+	(*ReviewAverages)(nil),                 // 35: course.ReviewAverages
+	(*ReviewComment)(nil),                  // 36: course.ReviewComment
+	(*GetCourseReviewSummaryResponse)(nil), // 37: course.GetCourseReviewSummaryResponse
+	(*GetCatalogCacheStatsRequest)(nil),    // 38: course.GetCatalogCacheStatsRequest
+	(*GetCatalogCacheStatsResponse)(nil),   // 39: course.GetCatalogCacheStatsResponse
+	(*timestamppb.Timestamp)(nil),          // 40: google.protobuf.Timestamp
 }
 var file_backend_protos_course_proto_depIdxs = []int32{
-	11, // 0: course.Course.created_at:type_name -> google.protobuf.Timestamp
-	11, // 1: course.Course.updated_at:type_name -> google.protobuf.Timestamp
-	1,  // 2: course.ListCoursesRequest.filters:type_name -> course.CourseFilter
-	0,  // 3: course.ListCoursesResponse.courses:type_name -> course.Course
-	0,  // 4: course.GetCourseResponse.course:type_name -> course.Course
-	7,  // 5: course.CheckPrerequisitesResponse.prerequisites:type_name -> course.PrerequisiteStatus
-	2,  // 6: course.CourseService.ListCourses:input_type -> course.ListCoursesRequest
-	4,  // 7: course.CourseService.GetCourse:input_type -> course.GetCourseRequest
-	6,  // 8: course.CourseService.CheckPrerequisites:input_type -> course.CheckPrerequisitesRequest
-	9,  // 9: course.CourseService.GetCourseAvailability:input_type -> course.GetCourseAvailabilityRequest
-	3,  // 10: course.CourseService.ListCourses:output_type -> course.ListCoursesResponse
-	5,  // 11: course.CourseService.GetCourse:output_type -> course.GetCourseResponse
-	8,  // 12: course.CourseService.CheckPrerequisites:output_type -> course.CheckPrerequisitesResponse
-	10, // 13: course.CourseService.GetCourseAvailability:output_type -> course.GetCourseAvailabilityResponse
-	10, // [10:14] is the sub-list for method output_type
-	6,  // [6:10] is the sub-list for method input_type
-	6,  // [6:6] is the sub-list for extension type_name
-	6,  // [6:6] is the sub-list for extension extendee
-	0,  // [0:6] is the sub-list for field type_name
+	40, // 0: course.Course.created_at:type_name -> google.protobuf.Timestamp
+	40, // 1: course.Course.updated_at:type_name -> google.protobuf.Timestamp
+	1,  // 2: course.Course.materials:type_name -> course.CourseMaterial
+	40, // 3: course.Course.enroll_open_at:type_name -> google.protobuf.Timestamp
+	40, // 4: course.Course.enroll_close_at:type_name -> google.protobuf.Timestamp
+	40, // 5: course.CourseMaterial.added_at:type_name -> google.protobuf.Timestamp
+	3,  // 6: course.CourseFilter.time_window:type_name -> course.TimeWindow
+	2,  // 7: course.ListCoursesRequest.filters:type_name -> course.CourseFilter
+	0,  // 8: course.ListCoursesResponse.courses:type_name -> course.Course
+	6,  // 9: course.ListDepartmentsResponse.departments:type_name -> course.Department
+	0,  // 10: course.GetCourseResponse.course:type_name -> course.Course
+	0,  // 11: course.GetCourseDetailResponse.course:type_name -> course.Course
+	12, // 12: course.GetCourseDetailResponse.student_status:type_name -> course.CourseStudentStatus
+	0,  // 13: course.BatchGetCoursesResponse.courses:type_name -> course.Course
+	17, // 14: course.CheckPrerequisitesResponse.prerequisites:type_name -> course.PrerequisiteStatus
+	20, // 15: course.GetPrerequisitesResponse.prerequisites:type_name -> course.Prerequisite
+	0,  // 16: course.EligibleCourse.course:type_name -> course.Course
+	17, // 17: course.EligibleCourse.prerequisites:type_name -> course.PrerequisiteStatus
+	23, // 18: course.GetEligibleCoursesResponse.courses:type_name -> course.EligibleCourse
+	1,  // 19: course.AddCourseMaterialResponse.material:type_name -> course.CourseMaterial
+	31, // 20: course.SubmitReviewRequest.ratings:type_name -> course.ReviewRatings
+	40, // 21: course.ReviewComment.submitted_at:type_name -> google.protobuf.Timestamp
+	35, // 22: course.GetCourseReviewSummaryResponse.averages:type_name -> course.ReviewAverages
+	36, // 23: course.GetCourseReviewSummaryResponse.comments:type_name -> course.ReviewComment
+	4,  // 24: course.CourseService.ListCourses:input_type -> course.ListCoursesRequest
+	9,  // 25: course.CourseService.GetCourse:input_type -> course.GetCourseRequest
+	11, // 26: course.CourseService.GetCourseDetail:input_type -> course.GetCourseDetailRequest
+	14, // 27: course.CourseService.BatchGetCourses:input_type -> course.BatchGetCoursesRequest
+	16, // 28: course.CourseService.CheckPrerequisites:input_type -> course.CheckPrerequisitesRequest
+	19, // 29: course.CourseService.GetPrerequisites:input_type -> course.GetPrerequisitesRequest
+	25, // 30: course.CourseService.GetCourseAvailability:input_type -> course.GetCourseAvailabilityRequest
+	22, // 31: course.CourseService.GetEligibleCourses:input_type -> course.GetEligibleCoursesRequest
+	7,  // 32: course.CourseService.ListDepartments:input_type -> course.ListDepartmentsRequest
+	27, // 33: course.CourseService.AddCourseMaterial:input_type -> course.AddCourseMaterialRequest
+	29, // 34: course.CourseService.RemoveCourseMaterial:input_type -> course.RemoveCourseMaterialRequest
+	32, // 35: course.CourseService.SubmitReview:input_type -> course.SubmitReviewRequest
+	34, // 36: course.CourseService.GetCourseReviewSummary:input_type -> course.GetCourseReviewSummaryRequest
+	38, // 37: course.CourseService.GetCatalogCacheStats:input_type -> course.GetCatalogCacheStatsRequest
+	5,  // 38: course.CourseService.ListCourses:output_type -> course.ListCoursesResponse
+	10, // 39: course.CourseService.GetCourse:output_type -> course.GetCourseResponse
+	13, // 40: course.CourseService.GetCourseDetail:output_type -> course.GetCourseDetailResponse
+	15, // 41: course.CourseService.BatchGetCourses:output_type -> course.BatchGetCoursesResponse
+	18, // 42: course.CourseService.CheckPrerequisites:output_type -> course.CheckPrerequisitesResponse
+	21, // 43: course.CourseService.GetPrerequisites:output_type -> course.GetPrerequisitesResponse
+	26, // 44: course.CourseService.GetCourseAvailability:output_type -> course.GetCourseAvailabilityResponse
+	24, // 45: course.CourseService.GetEligibleCourses:output_type -> course.GetEligibleCoursesResponse
+	8,  // 46: course.CourseService.ListDepartments:output_type -> course.ListDepartmentsResponse
+	28, // 47: course.CourseService.AddCourseMaterial:output_type -> course.AddCourseMaterialResponse
+	30, // 48: course.CourseService.RemoveCourseMaterial:output_type -> course.RemoveCourseMaterialResponse
+	33, // 49: course.CourseService.SubmitReview:output_type -> course.SubmitReviewResponse
+	37, // 50: course.CourseService.GetCourseReviewSummary:output_type -> course.GetCourseReviewSummaryResponse
+	39, // 51: course.CourseService.GetCatalogCacheStats:output_type -> course.GetCatalogCacheStatsResponse
+	38, // [38:52] is the sub-list for method output_type
+	24, // [24:38] is the sub-list for method input_type
+	24, // [24:24] is the sub-list for extension type_name
+	24, // [24:24] is the sub-list for extension extendee
+	0,  // [0:24] is the sub-list for field type_name
 }
 
 func init() { file_backend_protos_course_proto_init() }
@@ -898,7 +2998,7 @@ func file_backend_protos_course_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_backend_protos_course_proto_rawDesc), len(file_backend_protos_course_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   11,
+			NumMessages:   40,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
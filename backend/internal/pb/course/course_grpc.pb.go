@@ -19,10 +19,20 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	CourseService_ListCourses_FullMethodName           = "/course.CourseService/ListCourses"
-	CourseService_GetCourse_FullMethodName             = "/course.CourseService/GetCourse"
-	CourseService_CheckPrerequisites_FullMethodName    = "/course.CourseService/CheckPrerequisites"
-	CourseService_GetCourseAvailability_FullMethodName = "/course.CourseService/GetCourseAvailability"
+	CourseService_ListCourses_FullMethodName            = "/course.CourseService/ListCourses"
+	CourseService_GetCourse_FullMethodName              = "/course.CourseService/GetCourse"
+	CourseService_GetCourseDetail_FullMethodName        = "/course.CourseService/GetCourseDetail"
+	CourseService_BatchGetCourses_FullMethodName        = "/course.CourseService/BatchGetCourses"
+	CourseService_CheckPrerequisites_FullMethodName     = "/course.CourseService/CheckPrerequisites"
+	CourseService_GetPrerequisites_FullMethodName       = "/course.CourseService/GetPrerequisites"
+	CourseService_GetCourseAvailability_FullMethodName  = "/course.CourseService/GetCourseAvailability"
+	CourseService_GetEligibleCourses_FullMethodName     = "/course.CourseService/GetEligibleCourses"
+	CourseService_ListDepartments_FullMethodName        = "/course.CourseService/ListDepartments"
+	CourseService_AddCourseMaterial_FullMethodName      = "/course.CourseService/AddCourseMaterial"
+	CourseService_RemoveCourseMaterial_FullMethodName   = "/course.CourseService/RemoveCourseMaterial"
+	CourseService_SubmitReview_FullMethodName           = "/course.CourseService/SubmitReview"
+	CourseService_GetCourseReviewSummary_FullMethodName = "/course.CourseService/GetCourseReviewSummary"
+	CourseService_GetCatalogCacheStats_FullMethodName   = "/course.CourseService/GetCatalogCacheStats"
 )
 
 // CourseServiceClient is the client API for CourseService service.
@@ -33,8 +43,22 @@ const (
 type CourseServiceClient interface {
 	ListCourses(ctx context.Context, in *ListCoursesRequest, opts ...grpc.CallOption) (*ListCoursesResponse, error)
 	GetCourse(ctx context.Context, in *GetCourseRequest, opts ...grpc.CallOption) (*GetCourseResponse, error)
+	GetCourseDetail(ctx context.Context, in *GetCourseDetailRequest, opts ...grpc.CallOption) (*GetCourseDetailResponse, error)
+	BatchGetCourses(ctx context.Context, in *BatchGetCoursesRequest, opts ...grpc.CallOption) (*BatchGetCoursesResponse, error)
 	CheckPrerequisites(ctx context.Context, in *CheckPrerequisitesRequest, opts ...grpc.CallOption) (*CheckPrerequisitesResponse, error)
+	GetPrerequisites(ctx context.Context, in *GetPrerequisitesRequest, opts ...grpc.CallOption) (*GetPrerequisitesResponse, error)
 	GetCourseAvailability(ctx context.Context, in *GetCourseAvailabilityRequest, opts ...grpc.CallOption) (*GetCourseAvailabilityResponse, error)
+	GetEligibleCourses(ctx context.Context, in *GetEligibleCoursesRequest, opts ...grpc.CallOption) (*GetEligibleCoursesResponse, error)
+	ListDepartments(ctx context.Context, in *ListDepartmentsRequest, opts ...grpc.CallOption) (*ListDepartmentsResponse, error)
+	// Restricted to the assigned faculty or an admin
+	AddCourseMaterial(ctx context.Context, in *AddCourseMaterialRequest, opts ...grpc.CallOption) (*AddCourseMaterialResponse, error)
+	RemoveCourseMaterial(ctx context.Context, in *RemoveCourseMaterialRequest, opts ...grpc.CallOption) (*RemoveCourseMaterialResponse, error)
+	// Course evaluations, submitted by students once their grade is published
+	SubmitReview(ctx context.Context, in *SubmitReviewRequest, opts ...grpc.CallOption) (*SubmitReviewResponse, error)
+	// Restricted to the assigned faculty or an admin; only admins see comments
+	GetCourseReviewSummary(ctx context.Context, in *GetCourseReviewSummaryRequest, opts ...grpc.CallOption) (*GetCourseReviewSummaryResponse, error)
+	// ListCourses cache counters since the service started, for monitoring; admins only
+	GetCatalogCacheStats(ctx context.Context, in *GetCatalogCacheStatsRequest, opts ...grpc.CallOption) (*GetCatalogCacheStatsResponse, error)
 }
 
 type courseServiceClient struct {
@@ -65,6 +89,26 @@ func (c *courseServiceClient) GetCourse(ctx context.Context, in *GetCourseReques
 	return out, nil
 }
 
+func (c *courseServiceClient) GetCourseDetail(ctx context.Context, in *GetCourseDetailRequest, opts ...grpc.CallOption) (*GetCourseDetailResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCourseDetailResponse)
+	err := c.cc.Invoke(ctx, CourseService_GetCourseDetail_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *courseServiceClient) BatchGetCourses(ctx context.Context, in *BatchGetCoursesRequest, opts ...grpc.CallOption) (*BatchGetCoursesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchGetCoursesResponse)
+	err := c.cc.Invoke(ctx, CourseService_BatchGetCourses_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *courseServiceClient) CheckPrerequisites(ctx context.Context, in *CheckPrerequisitesRequest, opts ...grpc.CallOption) (*CheckPrerequisitesResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CheckPrerequisitesResponse)
@@ -75,6 +119,16 @@ func (c *courseServiceClient) CheckPrerequisites(ctx context.Context, in *CheckP
 	return out, nil
 }
 
+func (c *courseServiceClient) GetPrerequisites(ctx context.Context, in *GetPrerequisitesRequest, opts ...grpc.CallOption) (*GetPrerequisitesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPrerequisitesResponse)
+	err := c.cc.Invoke(ctx, CourseService_GetPrerequisites_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *courseServiceClient) GetCourseAvailability(ctx context.Context, in *GetCourseAvailabilityRequest, opts ...grpc.CallOption) (*GetCourseAvailabilityResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetCourseAvailabilityResponse)
@@ -85,6 +139,76 @@ func (c *courseServiceClient) GetCourseAvailability(ctx context.Context, in *Get
 	return out, nil
 }
 
+func (c *courseServiceClient) GetEligibleCourses(ctx context.Context, in *GetEligibleCoursesRequest, opts ...grpc.CallOption) (*GetEligibleCoursesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetEligibleCoursesResponse)
+	err := c.cc.Invoke(ctx, CourseService_GetEligibleCourses_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *courseServiceClient) ListDepartments(ctx context.Context, in *ListDepartmentsRequest, opts ...grpc.CallOption) (*ListDepartmentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDepartmentsResponse)
+	err := c.cc.Invoke(ctx, CourseService_ListDepartments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *courseServiceClient) AddCourseMaterial(ctx context.Context, in *AddCourseMaterialRequest, opts ...grpc.CallOption) (*AddCourseMaterialResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddCourseMaterialResponse)
+	err := c.cc.Invoke(ctx, CourseService_AddCourseMaterial_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *courseServiceClient) RemoveCourseMaterial(ctx context.Context, in *RemoveCourseMaterialRequest, opts ...grpc.CallOption) (*RemoveCourseMaterialResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveCourseMaterialResponse)
+	err := c.cc.Invoke(ctx, CourseService_RemoveCourseMaterial_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *courseServiceClient) SubmitReview(ctx context.Context, in *SubmitReviewRequest, opts ...grpc.CallOption) (*SubmitReviewResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SubmitReviewResponse)
+	err := c.cc.Invoke(ctx, CourseService_SubmitReview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *courseServiceClient) GetCourseReviewSummary(ctx context.Context, in *GetCourseReviewSummaryRequest, opts ...grpc.CallOption) (*GetCourseReviewSummaryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCourseReviewSummaryResponse)
+	err := c.cc.Invoke(ctx, CourseService_GetCourseReviewSummary_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *courseServiceClient) GetCatalogCacheStats(ctx context.Context, in *GetCatalogCacheStatsRequest, opts ...grpc.CallOption) (*GetCatalogCacheStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCatalogCacheStatsResponse)
+	err := c.cc.Invoke(ctx, CourseService_GetCatalogCacheStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // CourseServiceServer is the server API for CourseService service.
 // All implementations must embed UnimplementedCourseServiceServer
 // for forward compatibility.
@@ -93,8 +217,22 @@ func (c *courseServiceClient) GetCourseAvailability(ctx context.Context, in *Get
 type CourseServiceServer interface {
 	ListCourses(context.Context, *ListCoursesRequest) (*ListCoursesResponse, error)
 	GetCourse(context.Context, *GetCourseRequest) (*GetCourseResponse, error)
+	GetCourseDetail(context.Context, *GetCourseDetailRequest) (*GetCourseDetailResponse, error)
+	BatchGetCourses(context.Context, *BatchGetCoursesRequest) (*BatchGetCoursesResponse, error)
 	CheckPrerequisites(context.Context, *CheckPrerequisitesRequest) (*CheckPrerequisitesResponse, error)
+	GetPrerequisites(context.Context, *GetPrerequisitesRequest) (*GetPrerequisitesResponse, error)
 	GetCourseAvailability(context.Context, *GetCourseAvailabilityRequest) (*GetCourseAvailabilityResponse, error)
+	GetEligibleCourses(context.Context, *GetEligibleCoursesRequest) (*GetEligibleCoursesResponse, error)
+	ListDepartments(context.Context, *ListDepartmentsRequest) (*ListDepartmentsResponse, error)
+	// Restricted to the assigned faculty or an admin
+	AddCourseMaterial(context.Context, *AddCourseMaterialRequest) (*AddCourseMaterialResponse, error)
+	RemoveCourseMaterial(context.Context, *RemoveCourseMaterialRequest) (*RemoveCourseMaterialResponse, error)
+	// Course evaluations, submitted by students once their grade is published
+	SubmitReview(context.Context, *SubmitReviewRequest) (*SubmitReviewResponse, error)
+	// Restricted to the assigned faculty or an admin; only admins see comments
+	GetCourseReviewSummary(context.Context, *GetCourseReviewSummaryRequest) (*GetCourseReviewSummaryResponse, error)
+	// ListCourses cache counters since the service started, for monitoring; admins only
+	GetCatalogCacheStats(context.Context, *GetCatalogCacheStatsRequest) (*GetCatalogCacheStatsResponse, error)
 	mustEmbedUnimplementedCourseServiceServer()
 }
 
@@ -111,12 +249,42 @@ func (UnimplementedCourseServiceServer) ListCourses(context.Context, *ListCourse
 func (UnimplementedCourseServiceServer) GetCourse(context.Context, *GetCourseRequest) (*GetCourseResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetCourse not implemented")
 }
+func (UnimplementedCourseServiceServer) GetCourseDetail(context.Context, *GetCourseDetailRequest) (*GetCourseDetailResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCourseDetail not implemented")
+}
+func (UnimplementedCourseServiceServer) BatchGetCourses(context.Context, *BatchGetCoursesRequest) (*BatchGetCoursesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchGetCourses not implemented")
+}
 func (UnimplementedCourseServiceServer) CheckPrerequisites(context.Context, *CheckPrerequisitesRequest) (*CheckPrerequisitesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CheckPrerequisites not implemented")
 }
+func (UnimplementedCourseServiceServer) GetPrerequisites(context.Context, *GetPrerequisitesRequest) (*GetPrerequisitesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPrerequisites not implemented")
+}
 func (UnimplementedCourseServiceServer) GetCourseAvailability(context.Context, *GetCourseAvailabilityRequest) (*GetCourseAvailabilityResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetCourseAvailability not implemented")
 }
+func (UnimplementedCourseServiceServer) GetEligibleCourses(context.Context, *GetEligibleCoursesRequest) (*GetEligibleCoursesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEligibleCourses not implemented")
+}
+func (UnimplementedCourseServiceServer) ListDepartments(context.Context, *ListDepartmentsRequest) (*ListDepartmentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDepartments not implemented")
+}
+func (UnimplementedCourseServiceServer) AddCourseMaterial(context.Context, *AddCourseMaterialRequest) (*AddCourseMaterialResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddCourseMaterial not implemented")
+}
+func (UnimplementedCourseServiceServer) RemoveCourseMaterial(context.Context, *RemoveCourseMaterialRequest) (*RemoveCourseMaterialResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveCourseMaterial not implemented")
+}
+func (UnimplementedCourseServiceServer) SubmitReview(context.Context, *SubmitReviewRequest) (*SubmitReviewResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitReview not implemented")
+}
+func (UnimplementedCourseServiceServer) GetCourseReviewSummary(context.Context, *GetCourseReviewSummaryRequest) (*GetCourseReviewSummaryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCourseReviewSummary not implemented")
+}
+func (UnimplementedCourseServiceServer) GetCatalogCacheStats(context.Context, *GetCatalogCacheStatsRequest) (*GetCatalogCacheStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCatalogCacheStats not implemented")
+}
 func (UnimplementedCourseServiceServer) mustEmbedUnimplementedCourseServiceServer() {}
 func (UnimplementedCourseServiceServer) testEmbeddedByValue()                       {}
 
@@ -174,6 +342,42 @@ func _CourseService_GetCourse_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CourseService_GetCourseDetail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCourseDetailRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourseServiceServer).GetCourseDetail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CourseService_GetCourseDetail_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseServiceServer).GetCourseDetail(ctx, req.(*GetCourseDetailRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CourseService_BatchGetCourses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchGetCoursesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourseServiceServer).BatchGetCourses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CourseService_BatchGetCourses_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseServiceServer).BatchGetCourses(ctx, req.(*BatchGetCoursesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _CourseService_CheckPrerequisites_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CheckPrerequisitesRequest)
 	if err := dec(in); err != nil {
@@ -192,6 +396,24 @@ func _CourseService_CheckPrerequisites_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CourseService_GetPrerequisites_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPrerequisitesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourseServiceServer).GetPrerequisites(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CourseService_GetPrerequisites_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseServiceServer).GetPrerequisites(ctx, req.(*GetPrerequisitesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _CourseService_GetCourseAvailability_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetCourseAvailabilityRequest)
 	if err := dec(in); err != nil {
@@ -210,6 +432,132 @@ func _CourseService_GetCourseAvailability_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CourseService_GetEligibleCourses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEligibleCoursesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourseServiceServer).GetEligibleCourses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CourseService_GetEligibleCourses_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseServiceServer).GetEligibleCourses(ctx, req.(*GetEligibleCoursesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CourseService_ListDepartments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDepartmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourseServiceServer).ListDepartments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CourseService_ListDepartments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseServiceServer).ListDepartments(ctx, req.(*ListDepartmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CourseService_AddCourseMaterial_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddCourseMaterialRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourseServiceServer).AddCourseMaterial(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CourseService_AddCourseMaterial_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseServiceServer).AddCourseMaterial(ctx, req.(*AddCourseMaterialRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CourseService_RemoveCourseMaterial_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveCourseMaterialRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourseServiceServer).RemoveCourseMaterial(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CourseService_RemoveCourseMaterial_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseServiceServer).RemoveCourseMaterial(ctx, req.(*RemoveCourseMaterialRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CourseService_SubmitReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitReviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourseServiceServer).SubmitReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CourseService_SubmitReview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseServiceServer).SubmitReview(ctx, req.(*SubmitReviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CourseService_GetCourseReviewSummary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCourseReviewSummaryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourseServiceServer).GetCourseReviewSummary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CourseService_GetCourseReviewSummary_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseServiceServer).GetCourseReviewSummary(ctx, req.(*GetCourseReviewSummaryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CourseService_GetCatalogCacheStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCatalogCacheStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourseServiceServer).GetCatalogCacheStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CourseService_GetCatalogCacheStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseServiceServer).GetCatalogCacheStats(ctx, req.(*GetCatalogCacheStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // CourseService_ServiceDesc is the grpc.ServiceDesc for CourseService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -225,14 +573,54 @@ var CourseService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetCourse",
 			Handler:    _CourseService_GetCourse_Handler,
 		},
+		{
+			MethodName: "GetCourseDetail",
+			Handler:    _CourseService_GetCourseDetail_Handler,
+		},
+		{
+			MethodName: "BatchGetCourses",
+			Handler:    _CourseService_BatchGetCourses_Handler,
+		},
 		{
 			MethodName: "CheckPrerequisites",
 			Handler:    _CourseService_CheckPrerequisites_Handler,
 		},
+		{
+			MethodName: "GetPrerequisites",
+			Handler:    _CourseService_GetPrerequisites_Handler,
+		},
 		{
 			MethodName: "GetCourseAvailability",
 			Handler:    _CourseService_GetCourseAvailability_Handler,
 		},
+		{
+			MethodName: "GetEligibleCourses",
+			Handler:    _CourseService_GetEligibleCourses_Handler,
+		},
+		{
+			MethodName: "ListDepartments",
+			Handler:    _CourseService_ListDepartments_Handler,
+		},
+		{
+			MethodName: "AddCourseMaterial",
+			Handler:    _CourseService_AddCourseMaterial_Handler,
+		},
+		{
+			MethodName: "RemoveCourseMaterial",
+			Handler:    _CourseService_RemoveCourseMaterial_Handler,
+		},
+		{
+			MethodName: "SubmitReview",
+			Handler:    _CourseService_SubmitReview_Handler,
+		},
+		{
+			MethodName: "GetCourseReviewSummary",
+			Handler:    _CourseService_GetCourseReviewSummary_Handler,
+		},
+		{
+			MethodName: "GetCatalogCacheStats",
+			Handler:    _CourseService_GetCatalogCacheStats_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "backend/protos/course.proto",
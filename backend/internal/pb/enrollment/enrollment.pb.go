@@ -199,6 +199,159 @@ func (x *Enrollment) GetScheduleInfo() *ScheduleInfo {
 	return nil
 }
 
+type ReceiptCourse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EnrollmentId  string                 `protobuf:"bytes,1,opt,name=enrollment_id,json=enrollmentId,proto3" json:"enrollment_id,omitempty"`
+	CourseId      string                 `protobuf:"bytes,2,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	CourseCode    string                 `protobuf:"bytes,3,opt,name=course_code,json=courseCode,proto3" json:"course_code,omitempty"`
+	CourseTitle   string                 `protobuf:"bytes,4,opt,name=course_title,json=courseTitle,proto3" json:"course_title,omitempty"`
+	Units         int32                  `protobuf:"varint,5,opt,name=units,proto3" json:"units,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReceiptCourse) Reset() {
+	*x = ReceiptCourse{}
+	mi := &file_backend_protos_enrollment_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReceiptCourse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReceiptCourse) ProtoMessage() {}
+
+func (x *ReceiptCourse) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_protos_enrollment_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReceiptCourse.ProtoReflect.Descriptor instead.
+func (*ReceiptCourse) Descriptor() ([]byte, []int) {
+	return file_backend_protos_enrollment_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ReceiptCourse) GetEnrollmentId() string {
+	if x != nil {
+		return x.EnrollmentId
+	}
+	return ""
+}
+
+func (x *ReceiptCourse) GetCourseId() string {
+	if x != nil {
+		return x.CourseId
+	}
+	return ""
+}
+
+func (x *ReceiptCourse) GetCourseCode() string {
+	if x != nil {
+		return x.CourseCode
+	}
+	return ""
+}
+
+func (x *ReceiptCourse) GetCourseTitle() string {
+	if x != nil {
+		return x.CourseTitle
+	}
+	return ""
+}
+
+func (x *ReceiptCourse) GetUnits() int32 {
+	if x != nil {
+		return x.Units
+	}
+	return 0
+}
+
+// Confirmation record of one EnrollAll transaction
+type EnrollmentReceipt struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReferenceId   string                 `protobuf:"bytes,1,opt,name=reference_id,json=referenceId,proto3" json:"reference_id,omitempty"`
+	StudentId     string                 `protobuf:"bytes,2,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
+	Courses       []*ReceiptCourse       `protobuf:"bytes,3,rep,name=courses,proto3" json:"courses,omitempty"`
+	TotalUnits    int32                  `protobuf:"varint,4,opt,name=total_units,json=totalUnits,proto3" json:"total_units,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EnrollmentReceipt) Reset() {
+	*x = EnrollmentReceipt{}
+	mi := &file_backend_protos_enrollment_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EnrollmentReceipt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnrollmentReceipt) ProtoMessage() {}
+
+func (x *EnrollmentReceipt) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_protos_enrollment_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnrollmentReceipt.ProtoReflect.Descriptor instead.
+func (*EnrollmentReceipt) Descriptor() ([]byte, []int) {
+	return file_backend_protos_enrollment_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *EnrollmentReceipt) GetReferenceId() string {
+	if x != nil {
+		return x.ReferenceId
+	}
+	return ""
+}
+
+func (x *EnrollmentReceipt) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *EnrollmentReceipt) GetCourses() []*ReceiptCourse {
+	if x != nil {
+		return x.Courses
+	}
+	return nil
+}
+
+func (x *EnrollmentReceipt) GetTotalUnits() int32 {
+	if x != nil {
+		return x.TotalUnits
+	}
+	return 0
+}
+
+func (x *EnrollmentReceipt) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
 type CartItem struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	CourseId      string                 `protobuf:"bytes,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
@@ -212,7 +365,7 @@ type CartItem struct {
 
 func (x *CartItem) Reset() {
 	*x = CartItem{}
-	mi := &file_backend_protos_enrollment_proto_msgTypes[2]
+	mi := &file_backend_protos_enrollment_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -224,7 +377,7 @@ func (x *CartItem) String() string {
 func (*CartItem) ProtoMessage() {}
 
 func (x *CartItem) ProtoReflect() protoreflect.Message {
-	mi := &file_backend_protos_enrollment_proto_msgTypes[2]
+	mi := &file_backend_protos_enrollment_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -237,7 +390,7 @@ func (x *CartItem) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CartItem.ProtoReflect.Descriptor instead.
 func (*CartItem) Descriptor() ([]byte, []int) {
-	return file_backend_protos_enrollment_proto_rawDescGZIP(), []int{2}
+	return file_backend_protos_enrollment_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *CartItem) GetCourseId() string {
@@ -276,20 +429,24 @@ func (x *CartItem) GetScheduleInfo() *ScheduleInfo {
 }
 
 type Cart struct {
-	state                protoimpl.MessageState `protogen:"open.v1"`
-	StudentId            string                 `protobuf:"bytes,1,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
-	Items                []*CartItem            `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
-	TotalUnits           int32                  `protobuf:"varint,3,opt,name=total_units,json=totalUnits,proto3" json:"total_units,omitempty"`
-	HasConflicts         bool                   `protobuf:"varint,4,opt,name=has_conflicts,json=hasConflicts,proto3" json:"has_conflicts,omitempty"`
-	MissingPrerequisites []string               `protobuf:"bytes,5,rep,name=missing_prerequisites,json=missingPrerequisites,proto3" json:"missing_prerequisites,omitempty"`
-	UpdatedAt            *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	unknownFields        protoimpl.UnknownFields
-	sizeCache            protoimpl.SizeCache
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	StudentId             string                 `protobuf:"bytes,1,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
+	Items                 []*CartItem            `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+	TotalUnits            int32                  `protobuf:"varint,3,opt,name=total_units,json=totalUnits,proto3" json:"total_units,omitempty"`
+	HasConflicts          bool                   `protobuf:"varint,4,opt,name=has_conflicts,json=hasConflicts,proto3" json:"has_conflicts,omitempty"`
+	MissingPrerequisites  []string               `protobuf:"bytes,5,rep,name=missing_prerequisites,json=missingPrerequisites,proto3" json:"missing_prerequisites,omitempty"`
+	UpdatedAt             *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	RestrictionViolations []string               `protobuf:"bytes,7,rep,name=restriction_violations,json=restrictionViolations,proto3" json:"restriction_violations,omitempty"` // "<course code>: <reason>" for major/year level restrictions
+	ActiveHolds           []string               `protobuf:"bytes,8,rep,name=active_holds,json=activeHolds,proto3" json:"active_holds,omitempty"`                               // "<type> hold"; any active hold blocks EnrollAll
+	ConditionalCourses    []string               `protobuf:"bytes,9,rep,name=conditional_courses,json=conditionalCourses,proto3" json:"conditional_courses,omitempty"`          // future-semester courses whose prerequisites are still in progress
+	Warnings              []string               `protobuf:"bytes,10,rep,name=warnings,proto3" json:"warnings,omitempty"`                                                       // non-blocking notices, e.g. a load below min_full_time_units
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
 }
 
 func (x *Cart) Reset() {
 	*x = Cart{}
-	mi := &file_backend_protos_enrollment_proto_msgTypes[3]
+	mi := &file_backend_protos_enrollment_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -301,7 +458,7 @@ func (x *Cart) String() string {
 func (*Cart) ProtoMessage() {}
 
 func (x *Cart) ProtoReflect() protoreflect.Message {
-	mi := &file_backend_protos_enrollment_proto_msgTypes[3]
+	mi := &file_backend_protos_enrollment_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -314,7 +471,7 @@ func (x *Cart) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Cart.ProtoReflect.Descriptor instead.
 func (*Cart) Descriptor() ([]byte, []int) {
-	return file_backend_protos_enrollment_proto_rawDescGZIP(), []int{3}
+	return file_backend_protos_enrollment_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *Cart) GetStudentId() string {
@@ -359,6 +516,34 @@ func (x *Cart) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Cart) GetRestrictionViolations() []string {
+	if x != nil {
+		return x.RestrictionViolations
+	}
+	return nil
+}
+
+func (x *Cart) GetActiveHolds() []string {
+	if x != nil {
+		return x.ActiveHolds
+	}
+	return nil
+}
+
+func (x *Cart) GetConditionalCourses() []string {
+	if x != nil {
+		return x.ConditionalCourses
+	}
+	return nil
+}
+
+func (x *Cart) GetWarnings() []string {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
+}
+
 type Conflict struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Course1Id     string                 `protobuf:"bytes,1,opt,name=course1_id,json=course1Id,proto3" json:"course1_id,omitempty"`
@@ -373,7 +558,7 @@ type Conflict struct {
 
 func (x *Conflict) Reset() {
 	*x = Conflict{}
-	mi := &file_backend_protos_enrollment_proto_msgTypes[4]
+	mi := &file_backend_protos_enrollment_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -385,7 +570,7 @@ func (x *Conflict) String() string {
 func (*Conflict) ProtoMessage() {}
 
 func (x *Conflict) ProtoReflect() protoreflect.Message {
-	mi := &file_backend_protos_enrollment_proto_msgTypes[4]
+	mi := &file_backend_protos_enrollment_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -398,7 +583,7 @@ func (x *Conflict) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Conflict.ProtoReflect.Descriptor instead.
 func (*Conflict) Descriptor() ([]byte, []int) {
-	return file_backend_protos_enrollment_proto_rawDescGZIP(), []int{4}
+	return file_backend_protos_enrollment_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *Conflict) GetCourse1Id() string {
@@ -454,7 +639,7 @@ type AddToCartRequest struct {
 
 func (x *AddToCartRequest) Reset() {
 	*x = AddToCartRequest{}
-	mi := &file_backend_protos_enrollment_proto_msgTypes[5]
+	mi := &file_backend_protos_enrollment_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -466,7 +651,7 @@ func (x *AddToCartRequest) String() string {
 func (*AddToCartRequest) ProtoMessage() {}
 
 func (x *AddToCartRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_backend_protos_enrollment_proto_msgTypes[5]
+	mi := &file_backend_protos_enrollment_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -479,7 +664,7 @@ func (x *AddToCartRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddToCartRequest.ProtoReflect.Descriptor instead.
 func (*AddToCartRequest) Descriptor() ([]byte, []int) {
-	return file_backend_protos_enrollment_proto_rawDescGZIP(), []int{5}
+	return file_backend_protos_enrollment_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *AddToCartRequest) GetStudentId() string {
@@ -507,7 +692,7 @@ type AddToCartResponse struct {
 
 func (x *AddToCartResponse) Reset() {
 	*x = AddToCartResponse{}
-	mi := &file_backend_protos_enrollment_proto_msgTypes[6]
+	mi := &file_backend_protos_enrollment_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -519,7 +704,7 @@ func (x *AddToCartResponse) String() string {
 func (*AddToCartResponse) ProtoMessage() {}
 
 func (x *AddToCartResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_backend_protos_enrollment_proto_msgTypes[6]
+	mi := &file_backend_protos_enrollment_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -532,7 +717,7 @@ func (x *AddToCartResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddToCartResponse.ProtoReflect.Descriptor instead.
 func (*AddToCartResponse) Descriptor() ([]byte, []int) {
-	return file_backend_protos_enrollment_proto_rawDescGZIP(), []int{6}
+	return file_backend_protos_enrollment_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *AddToCartResponse) GetSuccess() bool {
@@ -566,7 +751,7 @@ type RemoveFromCartRequest struct {
 
 func (x *RemoveFromCartRequest) Reset() {
 	*x = RemoveFromCartRequest{}
-	mi := &file_backend_protos_enrollment_proto_msgTypes[7]
+	mi := &file_backend_protos_enrollment_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -578,7 +763,7 @@ func (x *RemoveFromCartRequest) String() string {
 func (*RemoveFromCartRequest) ProtoMessage() {}
 
 func (x *RemoveFromCartRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_backend_protos_enrollment_proto_msgTypes[7]
+	mi := &file_backend_protos_enrollment_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -591,7 +776,7 @@ func (x *RemoveFromCartRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RemoveFromCartRequest.ProtoReflect.Descriptor instead.
 func (*RemoveFromCartRequest) Descriptor() ([]byte, []int) {
-	return file_backend_protos_enrollment_proto_rawDescGZIP(), []int{7}
+	return file_backend_protos_enrollment_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *RemoveFromCartRequest) GetStudentId() string {
@@ -619,7 +804,7 @@ type RemoveFromCartResponse struct {
 
 func (x *RemoveFromCartResponse) Reset() {
 	*x = RemoveFromCartResponse{}
-	mi := &file_backend_protos_enrollment_proto_msgTypes[8]
+	mi := &file_backend_protos_enrollment_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -631,7 +816,7 @@ func (x *RemoveFromCartResponse) String() string {
 func (*RemoveFromCartResponse) ProtoMessage() {}
 
 func (x *RemoveFromCartResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_backend_protos_enrollment_proto_msgTypes[8]
+	mi := &file_backend_protos_enrollment_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -644,7 +829,7 @@ func (x *RemoveFromCartResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RemoveFromCartResponse.ProtoReflect.Descriptor instead.
 func (*RemoveFromCartResponse) Descriptor() ([]byte, []int) {
-	return file_backend_protos_enrollment_proto_rawDescGZIP(), []int{8}
+	return file_backend_protos_enrollment_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *RemoveFromCartResponse) GetSuccess() bool {
@@ -677,7 +862,7 @@ type GetCartRequest struct {
 
 func (x *GetCartRequest) Reset() {
 	*x = GetCartRequest{}
-	mi := &file_backend_protos_enrollment_proto_msgTypes[9]
+	mi := &file_backend_protos_enrollment_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -689,7 +874,7 @@ func (x *GetCartRequest) String() string {
 func (*GetCartRequest) ProtoMessage() {}
 
 func (x *GetCartRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_backend_protos_enrollment_proto_msgTypes[9]
+	mi := &file_backend_protos_enrollment_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -702,7 +887,7 @@ func (x *GetCartRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetCartRequest.ProtoReflect.Descriptor instead.
 func (*GetCartRequest) Descriptor() ([]byte, []int) {
-	return file_backend_protos_enrollment_proto_rawDescGZIP(), []int{9}
+	return file_backend_protos_enrollment_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *GetCartRequest) GetStudentId() string {
@@ -717,13 +902,15 @@ type GetCartResponse struct {
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 	Cart          *Cart                  `protobuf:"bytes,2,opt,name=cart,proto3" json:"cart,omitempty"`
 	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	MaxCourses    int32                  `protobuf:"varint,4,opt,name=max_courses,json=maxCourses,proto3" json:"max_courses,omitempty"` // effective max_courses_in_cart
+	MaxUnits      int32                  `protobuf:"varint,5,opt,name=max_units,json=maxUnits,proto3" json:"max_units,omitempty"`       // effective max_units_per_semester
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetCartResponse) Reset() {
 	*x = GetCartResponse{}
-	mi := &file_backend_protos_enrollment_proto_msgTypes[10]
+	mi := &file_backend_protos_enrollment_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -735,7 +922,7 @@ func (x *GetCartResponse) String() string {
 func (*GetCartResponse) ProtoMessage() {}
 
 func (x *GetCartResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_backend_protos_enrollment_proto_msgTypes[10]
+	mi := &file_backend_protos_enrollment_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -748,7 +935,7 @@ func (x *GetCartResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetCartResponse.ProtoReflect.Descriptor instead.
 func (*GetCartResponse) Descriptor() ([]byte, []int) {
-	return file_backend_protos_enrollment_proto_rawDescGZIP(), []int{10}
+	return file_backend_protos_enrollment_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *GetCartResponse) GetSuccess() bool {
@@ -772,28 +959,42 @@ func (x *GetCartResponse) GetMessage() string {
 	return ""
 }
 
-type ClearCartRequest struct {
+func (x *GetCartResponse) GetMaxCourses() int32 {
+	if x != nil {
+		return x.MaxCourses
+	}
+	return 0
+}
+
+func (x *GetCartResponse) GetMaxUnits() int32 {
+	if x != nil {
+		return x.MaxUnits
+	}
+	return 0
+}
+
+type GetCartSummaryRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	StudentId     string                 `protobuf:"bytes,1,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ClearCartRequest) Reset() {
-	*x = ClearCartRequest{}
-	mi := &file_backend_protos_enrollment_proto_msgTypes[11]
+func (x *GetCartSummaryRequest) Reset() {
+	*x = GetCartSummaryRequest{}
+	mi := &file_backend_protos_enrollment_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ClearCartRequest) String() string {
+func (x *GetCartSummaryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ClearCartRequest) ProtoMessage() {}
+func (*GetCartSummaryRequest) ProtoMessage() {}
 
-func (x *ClearCartRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_backend_protos_enrollment_proto_msgTypes[11]
+func (x *GetCartSummaryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_protos_enrollment_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -804,41 +1005,43 @@ func (x *ClearCartRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ClearCartRequest.ProtoReflect.Descriptor instead.
-func (*ClearCartRequest) Descriptor() ([]byte, []int) {
-	return file_backend_protos_enrollment_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use GetCartSummaryRequest.ProtoReflect.Descriptor instead.
+func (*GetCartSummaryRequest) Descriptor() ([]byte, []int) {
+	return file_backend_protos_enrollment_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *ClearCartRequest) GetStudentId() string {
+func (x *GetCartSummaryRequest) GetStudentId() string {
 	if x != nil {
 		return x.StudentId
 	}
 	return ""
 }
 
-type ClearCartResponse struct {
+type GetCartSummaryResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	ItemCount     int32                  `protobuf:"varint,2,opt,name=item_count,json=itemCount,proto3" json:"item_count,omitempty"`
+	TotalUnits    int32                  `protobuf:"varint,3,opt,name=total_units,json=totalUnits,proto3" json:"total_units,omitempty"`
+	Message       string                 `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ClearCartResponse) Reset() {
-	*x = ClearCartResponse{}
-	mi := &file_backend_protos_enrollment_proto_msgTypes[12]
+func (x *GetCartSummaryResponse) Reset() {
+	*x = GetCartSummaryResponse{}
+	mi := &file_backend_protos_enrollment_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ClearCartResponse) String() string {
+func (x *GetCartSummaryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ClearCartResponse) ProtoMessage() {}
+func (*GetCartSummaryResponse) ProtoMessage() {}
 
-func (x *ClearCartResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_backend_protos_enrollment_proto_msgTypes[12]
+func (x *GetCartSummaryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_protos_enrollment_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -849,49 +1052,160 @@ func (x *ClearCartResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ClearCartResponse.ProtoReflect.Descriptor instead.
-func (*ClearCartResponse) Descriptor() ([]byte, []int) {
-	return file_backend_protos_enrollment_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use GetCartSummaryResponse.ProtoReflect.Descriptor instead.
+func (*GetCartSummaryResponse) Descriptor() ([]byte, []int) {
+	return file_backend_protos_enrollment_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *ClearCartResponse) GetSuccess() bool {
+func (x *GetCartSummaryResponse) GetSuccess() bool {
 	if x != nil {
 		return x.Success
 	}
 	return false
 }
 
-func (x *ClearCartResponse) GetMessage() string {
+func (x *GetCartSummaryResponse) GetItemCount() int32 {
+	if x != nil {
+		return x.ItemCount
+	}
+	return 0
+}
+
+func (x *GetCartSummaryResponse) GetTotalUnits() int32 {
+	if x != nil {
+		return x.TotalUnits
+	}
+	return 0
+}
+
+func (x *GetCartSummaryResponse) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-type CheckConflictsRequest struct {
+type ClearCartRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	StudentId     string                 `protobuf:"bytes,1,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
-	CourseIds     []string               `protobuf:"bytes,2,rep,name=course_ids,json=courseIds,proto3" json:"course_ids,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CheckConflictsRequest) Reset() {
-	*x = CheckConflictsRequest{}
-	mi := &file_backend_protos_enrollment_proto_msgTypes[13]
+func (x *ClearCartRequest) Reset() {
+	*x = ClearCartRequest{}
+	mi := &file_backend_protos_enrollment_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CheckConflictsRequest) String() string {
+func (x *ClearCartRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CheckConflictsRequest) ProtoMessage() {}
+func (*ClearCartRequest) ProtoMessage() {}
 
-func (x *CheckConflictsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_backend_protos_enrollment_proto_msgTypes[13]
-	if x != nil {
+func (x *ClearCartRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_protos_enrollment_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClearCartRequest.ProtoReflect.Descriptor instead.
+func (*ClearCartRequest) Descriptor() ([]byte, []int) {
+	return file_backend_protos_enrollment_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ClearCartRequest) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+type ClearCartResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClearCartResponse) Reset() {
+	*x = ClearCartResponse{}
+	mi := &file_backend_protos_enrollment_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClearCartResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClearCartResponse) ProtoMessage() {}
+
+func (x *ClearCartResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_protos_enrollment_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClearCartResponse.ProtoReflect.Descriptor instead.
+func (*ClearCartResponse) Descriptor() ([]byte, []int) {
+	return file_backend_protos_enrollment_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ClearCartResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ClearCartResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type CheckConflictsRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	StudentId       string                 `protobuf:"bytes,1,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
+	CourseIds       []string               `protobuf:"bytes,2,rep,name=course_ids,json=courseIds,proto3" json:"course_ids,omitempty"`
+	IncludeEnrolled bool                   `protobuf:"varint,3,opt,name=include_enrolled,json=includeEnrolled,proto3" json:"include_enrolled,omitempty"` // also compare against student_id's active enrollments in the same semesters
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CheckConflictsRequest) Reset() {
+	*x = CheckConflictsRequest{}
+	mi := &file_backend_protos_enrollment_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckConflictsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckConflictsRequest) ProtoMessage() {}
+
+func (x *CheckConflictsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_protos_enrollment_proto_msgTypes[17]
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -903,7 +1217,7 @@ func (x *CheckConflictsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CheckConflictsRequest.ProtoReflect.Descriptor instead.
 func (*CheckConflictsRequest) Descriptor() ([]byte, []int) {
-	return file_backend_protos_enrollment_proto_rawDescGZIP(), []int{13}
+	return file_backend_protos_enrollment_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *CheckConflictsRequest) GetStudentId() string {
@@ -920,6 +1234,13 @@ func (x *CheckConflictsRequest) GetCourseIds() []string {
 	return nil
 }
 
+func (x *CheckConflictsRequest) GetIncludeEnrolled() bool {
+	if x != nil {
+		return x.IncludeEnrolled
+	}
+	return false
+}
+
 type CheckConflictsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	HasConflicts  bool                   `protobuf:"varint,1,opt,name=has_conflicts,json=hasConflicts,proto3" json:"has_conflicts,omitempty"`
@@ -931,7 +1252,7 @@ type CheckConflictsResponse struct {
 
 func (x *CheckConflictsResponse) Reset() {
 	*x = CheckConflictsResponse{}
-	mi := &file_backend_protos_enrollment_proto_msgTypes[14]
+	mi := &file_backend_protos_enrollment_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -943,7 +1264,7 @@ func (x *CheckConflictsResponse) String() string {
 func (*CheckConflictsResponse) ProtoMessage() {}
 
 func (x *CheckConflictsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_backend_protos_enrollment_proto_msgTypes[14]
+	mi := &file_backend_protos_enrollment_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -956,7 +1277,7 @@ func (x *CheckConflictsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CheckConflictsResponse.ProtoReflect.Descriptor instead.
 func (*CheckConflictsResponse) Descriptor() ([]byte, []int) {
-	return file_backend_protos_enrollment_proto_rawDescGZIP(), []int{14}
+	return file_backend_protos_enrollment_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *CheckConflictsResponse) GetHasConflicts() bool {
@@ -989,7 +1310,7 @@ type EnrollAllRequest struct {
 
 func (x *EnrollAllRequest) Reset() {
 	*x = EnrollAllRequest{}
-	mi := &file_backend_protos_enrollment_proto_msgTypes[15]
+	mi := &file_backend_protos_enrollment_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1001,7 +1322,7 @@ func (x *EnrollAllRequest) String() string {
 func (*EnrollAllRequest) ProtoMessage() {}
 
 func (x *EnrollAllRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_backend_protos_enrollment_proto_msgTypes[15]
+	mi := &file_backend_protos_enrollment_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1014,7 +1335,7 @@ func (x *EnrollAllRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EnrollAllRequest.ProtoReflect.Descriptor instead.
 func (*EnrollAllRequest) Descriptor() ([]byte, []int) {
-	return file_backend_protos_enrollment_proto_rawDescGZIP(), []int{15}
+	return file_backend_protos_enrollment_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *EnrollAllRequest) GetStudentId() string {
@@ -1030,13 +1351,16 @@ type EnrollAllResponse struct {
 	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
 	Enrollments   []*Enrollment          `protobuf:"bytes,3,rep,name=enrollments,proto3" json:"enrollments,omitempty"`
 	FailedCourses []string               `protobuf:"bytes,4,rep,name=failed_courses,json=failedCourses,proto3" json:"failed_courses,omitempty"` // courses that failed to enroll
+	ErrorCode     string                 `protobuf:"bytes,5,opt,name=error_code,json=errorCode,proto3" json:"error_code,omitempty"`             // machine-readable failure reason (see shared.ErrorCode)
+	Receipt       *EnrollmentReceipt     `protobuf:"bytes,6,opt,name=receipt,proto3" json:"receipt,omitempty"`                                  // set on success
+	Warnings      []string               `protobuf:"bytes,7,rep,name=warnings,proto3" json:"warnings,omitempty"`                                // non-blocking notices about the resulting load
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *EnrollAllResponse) Reset() {
 	*x = EnrollAllResponse{}
-	mi := &file_backend_protos_enrollment_proto_msgTypes[16]
+	mi := &file_backend_protos_enrollment_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1048,7 +1372,7 @@ func (x *EnrollAllResponse) String() string {
 func (*EnrollAllResponse) ProtoMessage() {}
 
 func (x *EnrollAllResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_backend_protos_enrollment_proto_msgTypes[16]
+	mi := &file_backend_protos_enrollment_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1061,7 +1385,7 @@ func (x *EnrollAllResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EnrollAllResponse.ProtoReflect.Descriptor instead.
 func (*EnrollAllResponse) Descriptor() ([]byte, []int) {
-	return file_backend_protos_enrollment_proto_rawDescGZIP(), []int{16}
+	return file_backend_protos_enrollment_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *EnrollAllResponse) GetSuccess() bool {
@@ -1092,6 +1416,27 @@ func (x *EnrollAllResponse) GetFailedCourses() []string {
 	return nil
 }
 
+func (x *EnrollAllResponse) GetErrorCode() string {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return ""
+}
+
+func (x *EnrollAllResponse) GetReceipt() *EnrollmentReceipt {
+	if x != nil {
+		return x.Receipt
+	}
+	return nil
+}
+
+func (x *EnrollAllResponse) GetWarnings() []string {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
+}
+
 type DropCourseRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	StudentId     string                 `protobuf:"bytes,1,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
@@ -1102,7 +1447,7 @@ type DropCourseRequest struct {
 
 func (x *DropCourseRequest) Reset() {
 	*x = DropCourseRequest{}
-	mi := &file_backend_protos_enrollment_proto_msgTypes[17]
+	mi := &file_backend_protos_enrollment_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1114,7 +1459,7 @@ func (x *DropCourseRequest) String() string {
 func (*DropCourseRequest) ProtoMessage() {}
 
 func (x *DropCourseRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_backend_protos_enrollment_proto_msgTypes[17]
+	mi := &file_backend_protos_enrollment_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1127,7 +1472,7 @@ func (x *DropCourseRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DropCourseRequest.ProtoReflect.Descriptor instead.
 func (*DropCourseRequest) Descriptor() ([]byte, []int) {
-	return file_backend_protos_enrollment_proto_rawDescGZIP(), []int{17}
+	return file_backend_protos_enrollment_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *DropCourseRequest) GetStudentId() string {
@@ -1154,7 +1499,7 @@ type DropCourseResponse struct {
 
 func (x *DropCourseResponse) Reset() {
 	*x = DropCourseResponse{}
-	mi := &file_backend_protos_enrollment_proto_msgTypes[18]
+	mi := &file_backend_protos_enrollment_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1166,7 +1511,7 @@ func (x *DropCourseResponse) String() string {
 func (*DropCourseResponse) ProtoMessage() {}
 
 func (x *DropCourseResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_backend_protos_enrollment_proto_msgTypes[18]
+	mi := &file_backend_protos_enrollment_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1179,7 +1524,7 @@ func (x *DropCourseResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DropCourseResponse.ProtoReflect.Descriptor instead.
 func (*DropCourseResponse) Descriptor() ([]byte, []int) {
-	return file_backend_protos_enrollment_proto_rawDescGZIP(), []int{18}
+	return file_backend_protos_enrollment_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *DropCourseResponse) GetSuccess() bool {
@@ -1199,15 +1544,17 @@ func (x *DropCourseResponse) GetMessage() string {
 type GetStudentEnrollmentsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	StudentId     string                 `protobuf:"bytes,1,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
-	Semester      string                 `protobuf:"bytes,2,opt,name=semester,proto3" json:"semester,omitempty"` // optional filter
-	Status        string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`     // optional filter: enrolled, dropped, completed
+	Semester      string                 `protobuf:"bytes,2,opt,name=semester,proto3" json:"semester,omitempty"`                  // optional filter
+	Status        string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`                      // optional filter: enrolled, dropped, completed
+	Page          int32                  `protobuf:"varint,4,opt,name=page,proto3" json:"page,omitempty"`                         // 1-based, defaults to 1
+	PageSize      int32                  `protobuf:"varint,5,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"` // defaults to 50, max 200
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetStudentEnrollmentsRequest) Reset() {
 	*x = GetStudentEnrollmentsRequest{}
-	mi := &file_backend_protos_enrollment_proto_msgTypes[19]
+	mi := &file_backend_protos_enrollment_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1219,7 +1566,7 @@ func (x *GetStudentEnrollmentsRequest) String() string {
 func (*GetStudentEnrollmentsRequest) ProtoMessage() {}
 
 func (x *GetStudentEnrollmentsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_backend_protos_enrollment_proto_msgTypes[19]
+	mi := &file_backend_protos_enrollment_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1232,7 +1579,7 @@ func (x *GetStudentEnrollmentsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetStudentEnrollmentsRequest.ProtoReflect.Descriptor instead.
 func (*GetStudentEnrollmentsRequest) Descriptor() ([]byte, []int) {
-	return file_backend_protos_enrollment_proto_rawDescGZIP(), []int{19}
+	return file_backend_protos_enrollment_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *GetStudentEnrollmentsRequest) GetStudentId() string {
@@ -1256,17 +1603,37 @@ func (x *GetStudentEnrollmentsRequest) GetStatus() string {
 	return ""
 }
 
+func (x *GetStudentEnrollmentsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetStudentEnrollmentsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
 type GetStudentEnrollmentsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Enrollments   []*Enrollment          `protobuf:"bytes,1,rep,name=enrollments,proto3" json:"enrollments,omitempty"`
-	TotalUnits    int32                  `protobuf:"varint,2,opt,name=total_units,json=totalUnits,proto3" json:"total_units,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Enrollments         []*Enrollment          `protobuf:"bytes,1,rep,name=enrollments,proto3" json:"enrollments,omitempty"`                  // newest first
+	TotalUnits          int32                  `protobuf:"varint,2,opt,name=total_units,json=totalUnits,proto3" json:"total_units,omitempty"` // units of active enrollments across all pages
+	TotalCount          int32                  `protobuf:"varint,3,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"` // enrollments matching the filters
+	Page                int32                  `protobuf:"varint,4,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize            int32                  `protobuf:"varint,5,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	MaxDropsPerSemester int32                  `protobuf:"varint,6,opt,name=max_drops_per_semester,json=maxDropsPerSemester,proto3" json:"max_drops_per_semester,omitempty"` // 0 when drops are not limited
+	RemainingDrops      int32                  `protobuf:"varint,7,opt,name=remaining_drops,json=remainingDrops,proto3" json:"remaining_drops,omitempty"`                    // drops left in the requested (or current) semester; 0 when not limited
+	DropsSemester       string                 `protobuf:"bytes,8,opt,name=drops_semester,json=dropsSemester,proto3" json:"drops_semester,omitempty"`                        // semester remaining_drops counts
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
 }
 
 func (x *GetStudentEnrollmentsResponse) Reset() {
 	*x = GetStudentEnrollmentsResponse{}
-	mi := &file_backend_protos_enrollment_proto_msgTypes[20]
+	mi := &file_backend_protos_enrollment_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1278,7 +1645,7 @@ func (x *GetStudentEnrollmentsResponse) String() string {
 func (*GetStudentEnrollmentsResponse) ProtoMessage() {}
 
 func (x *GetStudentEnrollmentsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_backend_protos_enrollment_proto_msgTypes[20]
+	mi := &file_backend_protos_enrollment_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1291,7 +1658,7 @@ func (x *GetStudentEnrollmentsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetStudentEnrollmentsResponse.ProtoReflect.Descriptor instead.
 func (*GetStudentEnrollmentsResponse) Descriptor() ([]byte, []int) {
-	return file_backend_protos_enrollment_proto_rawDescGZIP(), []int{20}
+	return file_backend_protos_enrollment_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *GetStudentEnrollmentsResponse) GetEnrollments() []*Enrollment {
@@ -1308,132 +1675,1061 @@ func (x *GetStudentEnrollmentsResponse) GetTotalUnits() int32 {
 	return 0
 }
 
-var File_backend_protos_enrollment_proto protoreflect.FileDescriptor
+func (x *GetStudentEnrollmentsResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
 
-const file_backend_protos_enrollment_proto_rawDesc = "" +
-	"\n" +
-	"\x1fbackend/protos/enrollment.proto\x12\n" +
-	"enrollment\x1a\x1fgoogle/protobuf/timestamp.proto\"\\\n" +
-	"\fScheduleInfo\x12\x12\n" +
-	"\x04days\x18\x01 \x03(\tR\x04days\x12\x1d\n" +
-	"\n" +
-	"start_time\x18\x02 \x01(\tR\tstartTime\x12\x19\n" +
-	"\bend_time\x18\x03 \x01(\tR\aendTime\"\x81\x03\n" +
-	"\n" +
-	"Enrollment\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
-	"\n" +
-	"student_id\x18\x02 \x01(\tR\tstudentId\x12\x1b\n" +
-	"\tcourse_id\x18\x03 \x01(\tR\bcourseId\x12\x1f\n" +
-	"\vcourse_code\x18\x04 \x01(\tR\n" +
-	"courseCode\x12!\n" +
-	"\fcourse_title\x18\x05 \x01(\tR\vcourseTitle\x12\x14\n" +
-	"\x05units\x18\x06 \x01(\x05R\x05units\x12\x16\n" +
-	"\x06status\x18\a \x01(\tR\x06status\x12;\n" +
-	"\venrolled_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\n" +
-	"enrolledAt\x129\n" +
-	"\n" +
-	"dropped_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tdroppedAt\x12=\n" +
-	"\rschedule_info\x18\n" +
-	" \x01(\v2\x18.enrollment.ScheduleInfoR\fscheduleInfo\"\xc0\x01\n" +
-	"\bCartItem\x12\x1b\n" +
-	"\tcourse_id\x18\x01 \x01(\tR\bcourseId\x12\x1f\n" +
-	"\vcourse_code\x18\x02 \x01(\tR\n" +
-	"courseCode\x12!\n" +
-	"\fcourse_title\x18\x03 \x01(\tR\vcourseTitle\x12\x14\n" +
-	"\x05units\x18\x04 \x01(\x05R\x05units\x12=\n" +
-	"\rschedule_info\x18\x05 \x01(\v2\x18.enrollment.ScheduleInfoR\fscheduleInfo\"\x87\x02\n" +
-	"\x04Cart\x12\x1d\n" +
-	"\n" +
-	"student_id\x18\x01 \x01(\tR\tstudentId\x12*\n" +
-	"\x05items\x18\x02 \x03(\v2\x14.enrollment.CartItemR\x05items\x12\x1f\n" +
-	"\vtotal_units\x18\x03 \x01(\x05R\n" +
-	"totalUnits\x12#\n" +
-	"\rhas_conflicts\x18\x04 \x01(\bR\fhasConflicts\x123\n" +
-	"\x15missing_prerequisites\x18\x05 \x03(\tR\x14missingPrerequisites\x129\n" +
-	"\n" +
-	"updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xcd\x01\n" +
-	"\bConflict\x12\x1d\n" +
-	"\n" +
-	"course1_id\x18\x01 \x01(\tR\tcourse1Id\x12!\n" +
-	"\fcourse1_code\x18\x02 \x01(\tR\vcourse1Code\x12\x1d\n" +
-	"\n" +
-	"course2_id\x18\x03 \x01(\tR\tcourse2Id\x12!\n" +
-	"\fcourse2_code\x18\x04 \x01(\tR\vcourse2Code\x12#\n" +
-	"\rconflict_type\x18\x05 \x01(\tR\fconflictType\x12\x18\n" +
-	"\adetails\x18\x06 \x01(\tR\adetails\"N\n" +
-	"\x10AddToCartRequest\x12\x1d\n" +
-	"\n" +
-	"student_id\x18\x01 \x01(\tR\tstudentId\x12\x1b\n" +
-	"\tcourse_id\x18\x02 \x01(\tR\bcourseId\"m\n" +
-	"\x11AddToCartResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\x12$\n" +
-	"\x04cart\x18\x03 \x01(\v2\x10.enrollment.CartR\x04cart\"S\n" +
-	"\x15RemoveFromCartRequest\x12\x1d\n" +
-	"\n" +
-	"student_id\x18\x01 \x01(\tR\tstudentId\x12\x1b\n" +
-	"\tcourse_id\x18\x02 \x01(\tR\bcourseId\"r\n" +
-	"\x16RemoveFromCartResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\x12$\n" +
-	"\x04cart\x18\x03 \x01(\v2\x10.enrollment.CartR\x04cart\"/\n" +
-	"\x0eGetCartRequest\x12\x1d\n" +
-	"\n" +
-	"student_id\x18\x01 \x01(\tR\tstudentId\"k\n" +
-	"\x0fGetCartResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\x12$\n" +
-	"\x04cart\x18\x02 \x01(\v2\x10.enrollment.CartR\x04cart\x12\x18\n" +
-	"\amessage\x18\x03 \x01(\tR\amessage\"1\n" +
-	"\x10ClearCartRequest\x12\x1d\n" +
-	"\n" +
-	"student_id\x18\x01 \x01(\tR\tstudentId\"G\n" +
-	"\x11ClearCartResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\"U\n" +
-	"\x15CheckConflictsRequest\x12\x1d\n" +
-	"\n" +
-	"student_id\x18\x01 \x01(\tR\tstudentId\x12\x1d\n" +
-	"\n" +
-	"course_ids\x18\x02 \x03(\tR\tcourseIds\"\x8b\x01\n" +
-	"\x16CheckConflictsResponse\x12#\n" +
-	"\rhas_conflicts\x18\x01 \x01(\bR\fhasConflicts\x122\n" +
-	"\tconflicts\x18\x02 \x03(\v2\x14.enrollment.ConflictR\tconflicts\x12\x18\n" +
-	"\amessage\x18\x03 \x01(\tR\amessage\"1\n" +
-	"\x10EnrollAllRequest\x12\x1d\n" +
-	"\n" +
-	"student_id\x18\x01 \x01(\tR\tstudentId\"\xa8\x01\n" +
-	"\x11EnrollAllResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\x128\n" +
-	"\venrollments\x18\x03 \x03(\v2\x16.enrollment.EnrollmentR\venrollments\x12%\n" +
-	"\x0efailed_courses\x18\x04 \x03(\tR\rfailedCourses\"O\n" +
+func (x *GetStudentEnrollmentsResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetStudentEnrollmentsResponse) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetStudentEnrollmentsResponse) GetMaxDropsPerSemester() int32 {
+	if x != nil {
+		return x.MaxDropsPerSemester
+	}
+	return 0
+}
+
+func (x *GetStudentEnrollmentsResponse) GetRemainingDrops() int32 {
+	if x != nil {
+		return x.RemainingDrops
+	}
+	return 0
+}
+
+func (x *GetStudentEnrollmentsResponse) GetDropsSemester() string {
+	if x != nil {
+		return x.DropsSemester
+	}
+	return ""
+}
+
+type GetDropHistoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StudentId     string                 `protobuf:"bytes,1,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
+	Semester      string                 `protobuf:"bytes,2,opt,name=semester,proto3" json:"semester,omitempty"` // optional filter
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDropHistoryRequest) Reset() {
+	*x = GetDropHistoryRequest{}
+	mi := &file_backend_protos_enrollment_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDropHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDropHistoryRequest) ProtoMessage() {}
+
+func (x *GetDropHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_protos_enrollment_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDropHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetDropHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_backend_protos_enrollment_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *GetDropHistoryRequest) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *GetDropHistoryRequest) GetSemester() string {
+	if x != nil {
+		return x.Semester
+	}
+	return ""
+}
+
+// One dropped enrollment
+type DropRecord struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EnrollmentId  string                 `protobuf:"bytes,1,opt,name=enrollment_id,json=enrollmentId,proto3" json:"enrollment_id,omitempty"`
+	CourseId      string                 `protobuf:"bytes,2,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	CourseCode    string                 `protobuf:"bytes,3,opt,name=course_code,json=courseCode,proto3" json:"course_code,omitempty"`
+	CourseTitle   string                 `protobuf:"bytes,4,opt,name=course_title,json=courseTitle,proto3" json:"course_title,omitempty"`
+	Semester      string                 `protobuf:"bytes,5,opt,name=semester,proto3" json:"semester,omitempty"`
+	DroppedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=dropped_at,json=droppedAt,proto3" json:"dropped_at,omitempty"`
+	Source        string                 `protobuf:"bytes,7,opt,name=source,proto3" json:"source,omitempty"` // "self", "admin_forced" or "course_cancelled"
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DropRecord) Reset() {
+	*x = DropRecord{}
+	mi := &file_backend_protos_enrollment_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DropRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DropRecord) ProtoMessage() {}
+
+func (x *DropRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_protos_enrollment_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DropRecord.ProtoReflect.Descriptor instead.
+func (*DropRecord) Descriptor() ([]byte, []int) {
+	return file_backend_protos_enrollment_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *DropRecord) GetEnrollmentId() string {
+	if x != nil {
+		return x.EnrollmentId
+	}
+	return ""
+}
+
+func (x *DropRecord) GetCourseId() string {
+	if x != nil {
+		return x.CourseId
+	}
+	return ""
+}
+
+func (x *DropRecord) GetCourseCode() string {
+	if x != nil {
+		return x.CourseCode
+	}
+	return ""
+}
+
+func (x *DropRecord) GetCourseTitle() string {
+	if x != nil {
+		return x.CourseTitle
+	}
+	return ""
+}
+
+func (x *DropRecord) GetSemester() string {
+	if x != nil {
+		return x.Semester
+	}
+	return ""
+}
+
+func (x *DropRecord) GetDroppedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DroppedAt
+	}
+	return nil
+}
+
+func (x *DropRecord) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+type GetDropHistoryResponse struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Success             bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message             string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Drops               []*DropRecord          `protobuf:"bytes,3,rep,name=drops,proto3" json:"drops,omitempty"`                                                             // newest first
+	MaxDropsPerSemester int32                  `protobuf:"varint,4,opt,name=max_drops_per_semester,json=maxDropsPerSemester,proto3" json:"max_drops_per_semester,omitempty"` // 0 when drops are not limited
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *GetDropHistoryResponse) Reset() {
+	*x = GetDropHistoryResponse{}
+	mi := &file_backend_protos_enrollment_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDropHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDropHistoryResponse) ProtoMessage() {}
+
+func (x *GetDropHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_protos_enrollment_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDropHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetDropHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_backend_protos_enrollment_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *GetDropHistoryResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *GetDropHistoryResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *GetDropHistoryResponse) GetDrops() []*DropRecord {
+	if x != nil {
+		return x.Drops
+	}
+	return nil
+}
+
+func (x *GetDropHistoryResponse) GetMaxDropsPerSemester() int32 {
+	if x != nil {
+		return x.MaxDropsPerSemester
+	}
+	return 0
+}
+
+type GetEnrollmentReceiptRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReferenceId   string                 `protobuf:"bytes,1,opt,name=reference_id,json=referenceId,proto3" json:"reference_id,omitempty"`
+	StudentId     string                 `protobuf:"bytes,2,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"` // optional, receipt must belong to this student when set
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEnrollmentReceiptRequest) Reset() {
+	*x = GetEnrollmentReceiptRequest{}
+	mi := &file_backend_protos_enrollment_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEnrollmentReceiptRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEnrollmentReceiptRequest) ProtoMessage() {}
+
+func (x *GetEnrollmentReceiptRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_protos_enrollment_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEnrollmentReceiptRequest.ProtoReflect.Descriptor instead.
+func (*GetEnrollmentReceiptRequest) Descriptor() ([]byte, []int) {
+	return file_backend_protos_enrollment_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *GetEnrollmentReceiptRequest) GetReferenceId() string {
+	if x != nil {
+		return x.ReferenceId
+	}
+	return ""
+}
+
+func (x *GetEnrollmentReceiptRequest) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+type GetEnrollmentReceiptResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Receipt       *EnrollmentReceipt     `protobuf:"bytes,2,opt,name=receipt,proto3" json:"receipt,omitempty"`
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEnrollmentReceiptResponse) Reset() {
+	*x = GetEnrollmentReceiptResponse{}
+	mi := &file_backend_protos_enrollment_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEnrollmentReceiptResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEnrollmentReceiptResponse) ProtoMessage() {}
+
+func (x *GetEnrollmentReceiptResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_protos_enrollment_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEnrollmentReceiptResponse.ProtoReflect.Descriptor instead.
+func (*GetEnrollmentReceiptResponse) Descriptor() ([]byte, []int) {
+	return file_backend_protos_enrollment_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *GetEnrollmentReceiptResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *GetEnrollmentReceiptResponse) GetReceipt() *EnrollmentReceipt {
+	if x != nil {
+		return x.Receipt
+	}
+	return nil
+}
+
+func (x *GetEnrollmentReceiptResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type GetEnrollmentStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEnrollmentStatusRequest) Reset() {
+	*x = GetEnrollmentStatusRequest{}
+	mi := &file_backend_protos_enrollment_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEnrollmentStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEnrollmentStatusRequest) ProtoMessage() {}
+
+func (x *GetEnrollmentStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_protos_enrollment_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEnrollmentStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetEnrollmentStatusRequest) Descriptor() ([]byte, []int) {
+	return file_backend_protos_enrollment_proto_rawDescGZIP(), []int{30}
+}
+
+type GetEnrollmentStatusResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Phase           string                 `protobuf:"bytes,1,opt,name=phase,proto3" json:"phase,omitempty"`                                            // "preview", "open" or "closed"
+	EnrollmentStart *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=enrollment_start,json=enrollmentStart,proto3" json:"enrollment_start,omitempty"` // unset when unbounded
+	EnrollmentEnd   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=enrollment_end,json=enrollmentEnd,proto3" json:"enrollment_end,omitempty"`       // unset when unbounded
+	DropDeadline    *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=drop_deadline,json=dropDeadline,proto3" json:"drop_deadline,omitempty"`          // enrollment_end plus the drop grace period
+	CanAddToCart    bool                   `protobuf:"varint,5,opt,name=can_add_to_cart,json=canAddToCart,proto3" json:"can_add_to_cart,omitempty"`
+	CanEnroll       bool                   `protobuf:"varint,6,opt,name=can_enroll,json=canEnroll,proto3" json:"can_enroll,omitempty"`
+	CanDrop         bool                   `protobuf:"varint,7,opt,name=can_drop,json=canDrop,proto3" json:"can_drop,omitempty"`
+	Message         string                 `protobuf:"bytes,8,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetEnrollmentStatusResponse) Reset() {
+	*x = GetEnrollmentStatusResponse{}
+	mi := &file_backend_protos_enrollment_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEnrollmentStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEnrollmentStatusResponse) ProtoMessage() {}
+
+func (x *GetEnrollmentStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_protos_enrollment_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEnrollmentStatusResponse.ProtoReflect.Descriptor instead.
+func (*GetEnrollmentStatusResponse) Descriptor() ([]byte, []int) {
+	return file_backend_protos_enrollment_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *GetEnrollmentStatusResponse) GetPhase() string {
+	if x != nil {
+		return x.Phase
+	}
+	return ""
+}
+
+func (x *GetEnrollmentStatusResponse) GetEnrollmentStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EnrollmentStart
+	}
+	return nil
+}
+
+func (x *GetEnrollmentStatusResponse) GetEnrollmentEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EnrollmentEnd
+	}
+	return nil
+}
+
+func (x *GetEnrollmentStatusResponse) GetDropDeadline() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DropDeadline
+	}
+	return nil
+}
+
+func (x *GetEnrollmentStatusResponse) GetCanAddToCart() bool {
+	if x != nil {
+		return x.CanAddToCart
+	}
+	return false
+}
+
+func (x *GetEnrollmentStatusResponse) GetCanEnroll() bool {
+	if x != nil {
+		return x.CanEnroll
+	}
+	return false
+}
+
+func (x *GetEnrollmentStatusResponse) GetCanDrop() bool {
+	if x != nil {
+		return x.CanDrop
+	}
+	return false
+}
+
+func (x *GetEnrollmentStatusResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type SuggestSchedulesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StudentId     string                 `protobuf:"bytes,1,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
+	CourseCodes   []string               `protobuf:"bytes,2,rep,name=course_codes,json=courseCodes,proto3" json:"course_codes,omitempty"` // e.g. ["CS-201", "MATH-102"]; at most 8 are searched
+	Semester      string                 `protobuf:"bytes,3,opt,name=semester,proto3" json:"semester,omitempty"`                          // defaults to the current semester
+	MaxResults    int32                  `protobuf:"varint,4,opt,name=max_results,json=maxResults,proto3" json:"max_results,omitempty"`   // defaults to 5, max 20
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SuggestSchedulesRequest) Reset() {
+	*x = SuggestSchedulesRequest{}
+	mi := &file_backend_protos_enrollment_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuggestSchedulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestSchedulesRequest) ProtoMessage() {}
+
+func (x *SuggestSchedulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_protos_enrollment_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestSchedulesRequest.ProtoReflect.Descriptor instead.
+func (*SuggestSchedulesRequest) Descriptor() ([]byte, []int) {
+	return file_backend_protos_enrollment_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *SuggestSchedulesRequest) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *SuggestSchedulesRequest) GetCourseCodes() []string {
+	if x != nil {
+		return x.CourseCodes
+	}
+	return nil
+}
+
+func (x *SuggestSchedulesRequest) GetSemester() string {
+	if x != nil {
+		return x.Semester
+	}
+	return ""
+}
+
+func (x *SuggestSchedulesRequest) GetMaxResults() int32 {
+	if x != nil {
+		return x.MaxResults
+	}
+	return 0
+}
+
+// One open section picked for a suggested schedule
+type ScheduleSection struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	CourseId       string                 `protobuf:"bytes,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	CourseCode     string                 `protobuf:"bytes,2,opt,name=course_code,json=courseCode,proto3" json:"course_code,omitempty"`
+	CourseTitle    string                 `protobuf:"bytes,3,opt,name=course_title,json=courseTitle,proto3" json:"course_title,omitempty"`
+	Units          int32                  `protobuf:"varint,4,opt,name=units,proto3" json:"units,omitempty"`
+	ScheduleInfo   *ScheduleInfo          `protobuf:"bytes,5,opt,name=schedule_info,json=scheduleInfo,proto3" json:"schedule_info,omitempty"`
+	SeatsAvailable int32                  `protobuf:"varint,6,opt,name=seats_available,json=seatsAvailable,proto3" json:"seats_available,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ScheduleSection) Reset() {
+	*x = ScheduleSection{}
+	mi := &file_backend_protos_enrollment_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScheduleSection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduleSection) ProtoMessage() {}
+
+func (x *ScheduleSection) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_protos_enrollment_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduleSection.ProtoReflect.Descriptor instead.
+func (*ScheduleSection) Descriptor() ([]byte, []int) {
+	return file_backend_protos_enrollment_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ScheduleSection) GetCourseId() string {
+	if x != nil {
+		return x.CourseId
+	}
+	return ""
+}
+
+func (x *ScheduleSection) GetCourseCode() string {
+	if x != nil {
+		return x.CourseCode
+	}
+	return ""
+}
+
+func (x *ScheduleSection) GetCourseTitle() string {
+	if x != nil {
+		return x.CourseTitle
+	}
+	return ""
+}
+
+func (x *ScheduleSection) GetUnits() int32 {
+	if x != nil {
+		return x.Units
+	}
+	return 0
+}
+
+func (x *ScheduleSection) GetScheduleInfo() *ScheduleInfo {
+	if x != nil {
+		return x.ScheduleInfo
+	}
+	return nil
+}
+
+func (x *ScheduleSection) GetSeatsAvailable() int32 {
+	if x != nil {
+		return x.SeatsAvailable
+	}
+	return 0
+}
+
+type SuggestedSchedule struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sections      []*ScheduleSection     `protobuf:"bytes,1,rep,name=sections,proto3" json:"sections,omitempty"`                        // one per searched course code, in request order
+	GapMinutes    int32                  `protobuf:"varint,2,opt,name=gap_minutes,json=gapMinutes,proto3" json:"gap_minutes,omitempty"` // idle time between classes on the same day, summed over the week
+	TotalUnits    int32                  `protobuf:"varint,3,opt,name=total_units,json=totalUnits,proto3" json:"total_units,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SuggestedSchedule) Reset() {
+	*x = SuggestedSchedule{}
+	mi := &file_backend_protos_enrollment_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuggestedSchedule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestedSchedule) ProtoMessage() {}
+
+func (x *SuggestedSchedule) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_protos_enrollment_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestedSchedule.ProtoReflect.Descriptor instead.
+func (*SuggestedSchedule) Descriptor() ([]byte, []int) {
+	return file_backend_protos_enrollment_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *SuggestedSchedule) GetSections() []*ScheduleSection {
+	if x != nil {
+		return x.Sections
+	}
+	return nil
+}
+
+func (x *SuggestedSchedule) GetGapMinutes() int32 {
+	if x != nil {
+		return x.GapMinutes
+	}
+	return 0
+}
+
+func (x *SuggestedSchedule) GetTotalUnits() int32 {
+	if x != nil {
+		return x.TotalUnits
+	}
+	return 0
+}
+
+type SuggestSchedulesResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Success          bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message          string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Schedules        []*SuggestedSchedule   `protobuf:"bytes,3,rep,name=schedules,proto3" json:"schedules,omitempty"` // fewest gaps first
+	Semester         string                 `protobuf:"bytes,4,opt,name=semester,proto3" json:"semester,omitempty"`
+	UnavailableCodes []string               `protobuf:"bytes,5,rep,name=unavailable_codes,json=unavailableCodes,proto3" json:"unavailable_codes,omitempty"` // codes with no open section with seats this semester
+	SkippedCodes     []string               `protobuf:"bytes,6,rep,name=skipped_codes,json=skippedCodes,proto3" json:"skipped_codes,omitempty"`             // codes past the search limit, left out of every schedule
+	Partial          bool                   `protobuf:"varint,7,opt,name=partial,proto3" json:"partial,omitempty"`                                          // the search was capped; other conflict-free schedules may exist
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SuggestSchedulesResponse) Reset() {
+	*x = SuggestSchedulesResponse{}
+	mi := &file_backend_protos_enrollment_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuggestSchedulesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestSchedulesResponse) ProtoMessage() {}
+
+func (x *SuggestSchedulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_protos_enrollment_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestSchedulesResponse.ProtoReflect.Descriptor instead.
+func (*SuggestSchedulesResponse) Descriptor() ([]byte, []int) {
+	return file_backend_protos_enrollment_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *SuggestSchedulesResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *SuggestSchedulesResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *SuggestSchedulesResponse) GetSchedules() []*SuggestedSchedule {
+	if x != nil {
+		return x.Schedules
+	}
+	return nil
+}
+
+func (x *SuggestSchedulesResponse) GetSemester() string {
+	if x != nil {
+		return x.Semester
+	}
+	return ""
+}
+
+func (x *SuggestSchedulesResponse) GetUnavailableCodes() []string {
+	if x != nil {
+		return x.UnavailableCodes
+	}
+	return nil
+}
+
+func (x *SuggestSchedulesResponse) GetSkippedCodes() []string {
+	if x != nil {
+		return x.SkippedCodes
+	}
+	return nil
+}
+
+func (x *SuggestSchedulesResponse) GetPartial() bool {
+	if x != nil {
+		return x.Partial
+	}
+	return false
+}
+
+var File_backend_protos_enrollment_proto protoreflect.FileDescriptor
+
+const file_backend_protos_enrollment_proto_rawDesc = "" +
+	"\n" +
+	"\x1fbackend/protos/enrollment.proto\x12\n" +
+	"enrollment\x1a\x1fgoogle/protobuf/timestamp.proto\"\\\n" +
+	"\fScheduleInfo\x12\x12\n" +
+	"\x04days\x18\x01 \x03(\tR\x04days\x12\x1d\n" +
+	"\n" +
+	"start_time\x18\x02 \x01(\tR\tstartTime\x12\x19\n" +
+	"\bend_time\x18\x03 \x01(\tR\aendTime\"\x81\x03\n" +
+	"\n" +
+	"Enrollment\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"student_id\x18\x02 \x01(\tR\tstudentId\x12\x1b\n" +
+	"\tcourse_id\x18\x03 \x01(\tR\bcourseId\x12\x1f\n" +
+	"\vcourse_code\x18\x04 \x01(\tR\n" +
+	"courseCode\x12!\n" +
+	"\fcourse_title\x18\x05 \x01(\tR\vcourseTitle\x12\x14\n" +
+	"\x05units\x18\x06 \x01(\x05R\x05units\x12\x16\n" +
+	"\x06status\x18\a \x01(\tR\x06status\x12;\n" +
+	"\venrolled_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"enrolledAt\x129\n" +
+	"\n" +
+	"dropped_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tdroppedAt\x12=\n" +
+	"\rschedule_info\x18\n" +
+	" \x01(\v2\x18.enrollment.ScheduleInfoR\fscheduleInfo\"\xab\x01\n" +
+	"\rReceiptCourse\x12#\n" +
+	"\renrollment_id\x18\x01 \x01(\tR\fenrollmentId\x12\x1b\n" +
+	"\tcourse_id\x18\x02 \x01(\tR\bcourseId\x12\x1f\n" +
+	"\vcourse_code\x18\x03 \x01(\tR\n" +
+	"courseCode\x12!\n" +
+	"\fcourse_title\x18\x04 \x01(\tR\vcourseTitle\x12\x14\n" +
+	"\x05units\x18\x05 \x01(\x05R\x05units\"\xe6\x01\n" +
+	"\x11EnrollmentReceipt\x12!\n" +
+	"\freference_id\x18\x01 \x01(\tR\vreferenceId\x12\x1d\n" +
+	"\n" +
+	"student_id\x18\x02 \x01(\tR\tstudentId\x123\n" +
+	"\acourses\x18\x03 \x03(\v2\x19.enrollment.ReceiptCourseR\acourses\x12\x1f\n" +
+	"\vtotal_units\x18\x04 \x01(\x05R\n" +
+	"totalUnits\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xc0\x01\n" +
+	"\bCartItem\x12\x1b\n" +
+	"\tcourse_id\x18\x01 \x01(\tR\bcourseId\x12\x1f\n" +
+	"\vcourse_code\x18\x02 \x01(\tR\n" +
+	"courseCode\x12!\n" +
+	"\fcourse_title\x18\x03 \x01(\tR\vcourseTitle\x12\x14\n" +
+	"\x05units\x18\x04 \x01(\x05R\x05units\x12=\n" +
+	"\rschedule_info\x18\x05 \x01(\v2\x18.enrollment.ScheduleInfoR\fscheduleInfo\"\xae\x03\n" +
+	"\x04Cart\x12\x1d\n" +
+	"\n" +
+	"student_id\x18\x01 \x01(\tR\tstudentId\x12*\n" +
+	"\x05items\x18\x02 \x03(\v2\x14.enrollment.CartItemR\x05items\x12\x1f\n" +
+	"\vtotal_units\x18\x03 \x01(\x05R\n" +
+	"totalUnits\x12#\n" +
+	"\rhas_conflicts\x18\x04 \x01(\bR\fhasConflicts\x123\n" +
+	"\x15missing_prerequisites\x18\x05 \x03(\tR\x14missingPrerequisites\x129\n" +
+	"\n" +
+	"updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x125\n" +
+	"\x16restriction_violations\x18\a \x03(\tR\x15restrictionViolations\x12!\n" +
+	"\factive_holds\x18\b \x03(\tR\vactiveHolds\x12/\n" +
+	"\x13conditional_courses\x18\t \x03(\tR\x12conditionalCourses\x12\x1a\n" +
+	"\bwarnings\x18\n" +
+	" \x03(\tR\bwarnings\"\xcd\x01\n" +
+	"\bConflict\x12\x1d\n" +
+	"\n" +
+	"course1_id\x18\x01 \x01(\tR\tcourse1Id\x12!\n" +
+	"\fcourse1_code\x18\x02 \x01(\tR\vcourse1Code\x12\x1d\n" +
+	"\n" +
+	"course2_id\x18\x03 \x01(\tR\tcourse2Id\x12!\n" +
+	"\fcourse2_code\x18\x04 \x01(\tR\vcourse2Code\x12#\n" +
+	"\rconflict_type\x18\x05 \x01(\tR\fconflictType\x12\x18\n" +
+	"\adetails\x18\x06 \x01(\tR\adetails\"N\n" +
+	"\x10AddToCartRequest\x12\x1d\n" +
+	"\n" +
+	"student_id\x18\x01 \x01(\tR\tstudentId\x12\x1b\n" +
+	"\tcourse_id\x18\x02 \x01(\tR\bcourseId\"m\n" +
+	"\x11AddToCartResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12$\n" +
+	"\x04cart\x18\x03 \x01(\v2\x10.enrollment.CartR\x04cart\"S\n" +
+	"\x15RemoveFromCartRequest\x12\x1d\n" +
+	"\n" +
+	"student_id\x18\x01 \x01(\tR\tstudentId\x12\x1b\n" +
+	"\tcourse_id\x18\x02 \x01(\tR\bcourseId\"r\n" +
+	"\x16RemoveFromCartResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12$\n" +
+	"\x04cart\x18\x03 \x01(\v2\x10.enrollment.CartR\x04cart\"/\n" +
+	"\x0eGetCartRequest\x12\x1d\n" +
+	"\n" +
+	"student_id\x18\x01 \x01(\tR\tstudentId\"\xa9\x01\n" +
+	"\x0fGetCartResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12$\n" +
+	"\x04cart\x18\x02 \x01(\v2\x10.enrollment.CartR\x04cart\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\x12\x1f\n" +
+	"\vmax_courses\x18\x04 \x01(\x05R\n" +
+	"maxCourses\x12\x1b\n" +
+	"\tmax_units\x18\x05 \x01(\x05R\bmaxUnits\"6\n" +
+	"\x15GetCartSummaryRequest\x12\x1d\n" +
+	"\n" +
+	"student_id\x18\x01 \x01(\tR\tstudentId\"\x8c\x01\n" +
+	"\x16GetCartSummaryResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x1d\n" +
+	"\n" +
+	"item_count\x18\x02 \x01(\x05R\titemCount\x12\x1f\n" +
+	"\vtotal_units\x18\x03 \x01(\x05R\n" +
+	"totalUnits\x12\x18\n" +
+	"\amessage\x18\x04 \x01(\tR\amessage\"1\n" +
+	"\x10ClearCartRequest\x12\x1d\n" +
+	"\n" +
+	"student_id\x18\x01 \x01(\tR\tstudentId\"G\n" +
+	"\x11ClearCartResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\x80\x01\n" +
+	"\x15CheckConflictsRequest\x12\x1d\n" +
+	"\n" +
+	"student_id\x18\x01 \x01(\tR\tstudentId\x12\x1d\n" +
+	"\n" +
+	"course_ids\x18\x02 \x03(\tR\tcourseIds\x12)\n" +
+	"\x10include_enrolled\x18\x03 \x01(\bR\x0fincludeEnrolled\"\x8b\x01\n" +
+	"\x16CheckConflictsResponse\x12#\n" +
+	"\rhas_conflicts\x18\x01 \x01(\bR\fhasConflicts\x122\n" +
+	"\tconflicts\x18\x02 \x03(\v2\x14.enrollment.ConflictR\tconflicts\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\"1\n" +
+	"\x10EnrollAllRequest\x12\x1d\n" +
+	"\n" +
+	"student_id\x18\x01 \x01(\tR\tstudentId\"\x9c\x02\n" +
+	"\x11EnrollAllResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x128\n" +
+	"\venrollments\x18\x03 \x03(\v2\x16.enrollment.EnrollmentR\venrollments\x12%\n" +
+	"\x0efailed_courses\x18\x04 \x03(\tR\rfailedCourses\x12\x1d\n" +
+	"\n" +
+	"error_code\x18\x05 \x01(\tR\terrorCode\x127\n" +
+	"\areceipt\x18\x06 \x01(\v2\x1d.enrollment.EnrollmentReceiptR\areceipt\x12\x1a\n" +
+	"\bwarnings\x18\a \x03(\tR\bwarnings\"O\n" +
 	"\x11DropCourseRequest\x12\x1d\n" +
 	"\n" +
 	"student_id\x18\x01 \x01(\tR\tstudentId\x12\x1b\n" +
 	"\tcourse_id\x18\x02 \x01(\tR\bcourseId\"H\n" +
 	"\x12DropCourseResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\"q\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\xa2\x01\n" +
 	"\x1cGetStudentEnrollmentsRequest\x12\x1d\n" +
 	"\n" +
 	"student_id\x18\x01 \x01(\tR\tstudentId\x12\x1a\n" +
 	"\bsemester\x18\x02 \x01(\tR\bsemester\x12\x16\n" +
-	"\x06status\x18\x03 \x01(\tR\x06status\"z\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12\x12\n" +
+	"\x04page\x18\x04 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x05 \x01(\x05R\bpageSize\"\xd1\x02\n" +
 	"\x1dGetStudentEnrollmentsResponse\x128\n" +
 	"\venrollments\x18\x01 \x03(\v2\x16.enrollment.EnrollmentR\venrollments\x12\x1f\n" +
 	"\vtotal_units\x18\x02 \x01(\x05R\n" +
-	"totalUnits2\xa2\x05\n" +
+	"totalUnits\x12\x1f\n" +
+	"\vtotal_count\x18\x03 \x01(\x05R\n" +
+	"totalCount\x12\x12\n" +
+	"\x04page\x18\x04 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x05 \x01(\x05R\bpageSize\x123\n" +
+	"\x16max_drops_per_semester\x18\x06 \x01(\x05R\x13maxDropsPerSemester\x12'\n" +
+	"\x0fremaining_drops\x18\a \x01(\x05R\x0eremainingDrops\x12%\n" +
+	"\x0edrops_semester\x18\b \x01(\tR\rdropsSemester\"R\n" +
+	"\x15GetDropHistoryRequest\x12\x1d\n" +
+	"\n" +
+	"student_id\x18\x01 \x01(\tR\tstudentId\x12\x1a\n" +
+	"\bsemester\x18\x02 \x01(\tR\bsemester\"\x81\x02\n" +
+	"\n" +
+	"DropRecord\x12#\n" +
+	"\renrollment_id\x18\x01 \x01(\tR\fenrollmentId\x12\x1b\n" +
+	"\tcourse_id\x18\x02 \x01(\tR\bcourseId\x12\x1f\n" +
+	"\vcourse_code\x18\x03 \x01(\tR\n" +
+	"courseCode\x12!\n" +
+	"\fcourse_title\x18\x04 \x01(\tR\vcourseTitle\x12\x1a\n" +
+	"\bsemester\x18\x05 \x01(\tR\bsemester\x129\n" +
+	"\n" +
+	"dropped_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tdroppedAt\x12\x16\n" +
+	"\x06source\x18\a \x01(\tR\x06source\"\xaf\x01\n" +
+	"\x16GetDropHistoryResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12,\n" +
+	"\x05drops\x18\x03 \x03(\v2\x16.enrollment.DropRecordR\x05drops\x123\n" +
+	"\x16max_drops_per_semester\x18\x04 \x01(\x05R\x13maxDropsPerSemester\"_\n" +
+	"\x1bGetEnrollmentReceiptRequest\x12!\n" +
+	"\freference_id\x18\x01 \x01(\tR\vreferenceId\x12\x1d\n" +
+	"\n" +
+	"student_id\x18\x02 \x01(\tR\tstudentId\"\x8b\x01\n" +
+	"\x1cGetEnrollmentReceiptResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x127\n" +
+	"\areceipt\x18\x02 \x01(\v2\x1d.enrollment.EnrollmentReceiptR\areceipt\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\"\x1c\n" +
+	"\x1aGetEnrollmentStatusRequest\"\xf9\x02\n" +
+	"\x1bGetEnrollmentStatusResponse\x12\x14\n" +
+	"\x05phase\x18\x01 \x01(\tR\x05phase\x12E\n" +
+	"\x10enrollment_start\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x0fenrollmentStart\x12A\n" +
+	"\x0eenrollment_end\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\renrollmentEnd\x12?\n" +
+	"\rdrop_deadline\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\fdropDeadline\x12%\n" +
+	"\x0fcan_add_to_cart\x18\x05 \x01(\bR\fcanAddToCart\x12\x1d\n" +
+	"\n" +
+	"can_enroll\x18\x06 \x01(\bR\tcanEnroll\x12\x19\n" +
+	"\bcan_drop\x18\a \x01(\bR\acanDrop\x12\x18\n" +
+	"\amessage\x18\b \x01(\tR\amessage\"\x98\x01\n" +
+	"\x17SuggestSchedulesRequest\x12\x1d\n" +
+	"\n" +
+	"student_id\x18\x01 \x01(\tR\tstudentId\x12!\n" +
+	"\fcourse_codes\x18\x02 \x03(\tR\vcourseCodes\x12\x1a\n" +
+	"\bsemester\x18\x03 \x01(\tR\bsemester\x12\x1f\n" +
+	"\vmax_results\x18\x04 \x01(\x05R\n" +
+	"maxResults\"\xf0\x01\n" +
+	"\x0fScheduleSection\x12\x1b\n" +
+	"\tcourse_id\x18\x01 \x01(\tR\bcourseId\x12\x1f\n" +
+	"\vcourse_code\x18\x02 \x01(\tR\n" +
+	"courseCode\x12!\n" +
+	"\fcourse_title\x18\x03 \x01(\tR\vcourseTitle\x12\x14\n" +
+	"\x05units\x18\x04 \x01(\x05R\x05units\x12=\n" +
+	"\rschedule_info\x18\x05 \x01(\v2\x18.enrollment.ScheduleInfoR\fscheduleInfo\x12'\n" +
+	"\x0fseats_available\x18\x06 \x01(\x05R\x0eseatsAvailable\"\x8e\x01\n" +
+	"\x11SuggestedSchedule\x127\n" +
+	"\bsections\x18\x01 \x03(\v2\x1b.enrollment.ScheduleSectionR\bsections\x12\x1f\n" +
+	"\vgap_minutes\x18\x02 \x01(\x05R\n" +
+	"gapMinutes\x12\x1f\n" +
+	"\vtotal_units\x18\x03 \x01(\x05R\n" +
+	"totalUnits\"\x93\x02\n" +
+	"\x18SuggestSchedulesResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12;\n" +
+	"\tschedules\x18\x03 \x03(\v2\x1d.enrollment.SuggestedScheduleR\tschedules\x12\x1a\n" +
+	"\bsemester\x18\x04 \x01(\tR\bsemester\x12+\n" +
+	"\x11unavailable_codes\x18\x05 \x03(\tR\x10unavailableCodes\x12#\n" +
+	"\rskipped_codes\x18\x06 \x03(\tR\fskippedCodes\x12\x18\n" +
+	"\apartial\x18\a \x01(\bR\apartial2\x86\t\n" +
 	"\x11EnrollmentService\x12H\n" +
 	"\tAddToCart\x12\x1c.enrollment.AddToCartRequest\x1a\x1d.enrollment.AddToCartResponse\x12W\n" +
 	"\x0eRemoveFromCart\x12!.enrollment.RemoveFromCartRequest\x1a\".enrollment.RemoveFromCartResponse\x12B\n" +
-	"\aGetCart\x12\x1a.enrollment.GetCartRequest\x1a\x1b.enrollment.GetCartResponse\x12H\n" +
+	"\aGetCart\x12\x1a.enrollment.GetCartRequest\x1a\x1b.enrollment.GetCartResponse\x12W\n" +
+	"\x0eGetCartSummary\x12!.enrollment.GetCartSummaryRequest\x1a\".enrollment.GetCartSummaryResponse\x12H\n" +
 	"\tClearCart\x12\x1c.enrollment.ClearCartRequest\x1a\x1d.enrollment.ClearCartResponse\x12W\n" +
 	"\x0eCheckConflicts\x12!.enrollment.CheckConflictsRequest\x1a\".enrollment.CheckConflictsResponse\x12H\n" +
 	"\tEnrollAll\x12\x1c.enrollment.EnrollAllRequest\x1a\x1d.enrollment.EnrollAllResponse\x12K\n" +
 	"\n" +
 	"DropCourse\x12\x1d.enrollment.DropCourseRequest\x1a\x1e.enrollment.DropCourseResponse\x12l\n" +
-	"\x15GetStudentEnrollments\x12(.enrollment.GetStudentEnrollmentsRequest\x1a).enrollment.GetStudentEnrollmentsResponseB\x17Z\x15backend/pb/enrollmentb\x06proto3"
+	"\x15GetStudentEnrollments\x12(.enrollment.GetStudentEnrollmentsRequest\x1a).enrollment.GetStudentEnrollmentsResponse\x12W\n" +
+	"\x0eGetDropHistory\x12!.enrollment.GetDropHistoryRequest\x1a\".enrollment.GetDropHistoryResponse\x12i\n" +
+	"\x14GetEnrollmentReceipt\x12'.enrollment.GetEnrollmentReceiptRequest\x1a(.enrollment.GetEnrollmentReceiptResponse\x12f\n" +
+	"\x13GetEnrollmentStatus\x12&.enrollment.GetEnrollmentStatusRequest\x1a'.enrollment.GetEnrollmentStatusResponse\x12]\n" +
+	"\x10SuggestSchedules\x12#.enrollment.SuggestSchedulesRequest\x1a$.enrollment.SuggestSchedulesResponseB\x17Z\x15backend/pb/enrollmentb\x06proto3"
 
 var (
 	file_backend_protos_enrollment_proto_rawDescOnce sync.Once
@@ -1447,65 +2743,102 @@ func file_backend_protos_enrollment_proto_rawDescGZIP() []byte {
 	return file_backend_protos_enrollment_proto_rawDescData
 }
 
-var file_backend_protos_enrollment_proto_msgTypes = make([]protoimpl.MessageInfo, 21)
+var file_backend_protos_enrollment_proto_msgTypes = make([]protoimpl.MessageInfo, 36)
 var file_backend_protos_enrollment_proto_goTypes = []any{
 	(*ScheduleInfo)(nil),                  // 0: enrollment.ScheduleInfo
 	(*Enrollment)(nil),                    // 1: enrollment.Enrollment
-	(*CartItem)(nil),                      // 2: enrollment.CartItem
-	(*Cart)(nil),                          // 3: enrollment.Cart
-	(*Conflict)(nil),                      // 4: enrollment.Conflict
-	(*AddToCartRequest)(nil),              // 5: enrollment.AddToCartRequest
-	(*AddToCartResponse)(nil),             // 6: enrollment.AddToCartResponse
-	(*RemoveFromCartRequest)(nil),         // 7: enrollment.RemoveFromCartRequest
-	(*RemoveFromCartResponse)(nil),        // 8: enrollment.RemoveFromCartResponse
-	(*GetCartRequest)(nil),                // 9: enrollment.GetCartRequest
-	(*GetCartResponse)(nil),               // 10: enrollment.GetCartResponse
-	(*ClearCartRequest)(nil),              // 11: enrollment.ClearCartRequest
-	(*ClearCartResponse)(nil),             // 12: enrollment.ClearCartResponse
-	(*CheckConflictsRequest)(nil),         // 13: enrollment.CheckConflictsRequest
-	(*CheckConflictsResponse)(nil),        // 14: enrollment.CheckConflictsResponse
-	(*EnrollAllRequest)(nil),              // 15: enrollment.EnrollAllRequest
-	(*EnrollAllResponse)(nil),             // 16: enrollment.EnrollAllResponse
-	(*DropCourseRequest)(nil),             // 17: enrollment.DropCourseRequest
-	(*DropCourseResponse)(nil),            // 18: enrollment.DropCourseResponse
-	(*GetStudentEnrollmentsRequest)(nil),  // 19: enrollment.GetStudentEnrollmentsRequest
-	(*GetStudentEnrollmentsResponse)(nil), // 20: enrollment.GetStudentEnrollmentsResponse
-	(*timestamppb.Timestamp)(nil),         // 21: google.protobuf.Timestamp
+	(*ReceiptCourse)(nil),                 // 2: enrollment.ReceiptCourse
+	(*EnrollmentReceipt)(nil),             // 3: enrollment.EnrollmentReceipt
+	(*CartItem)(nil),                      // 4: enrollment.CartItem
+	(*Cart)(nil),                          // 5: enrollment.Cart
+	(*Conflict)(nil),                      // 6: enrollment.Conflict
+	(*AddToCartRequest)(nil),              // 7: enrollment.AddToCartRequest
+	(*AddToCartResponse)(nil),             // 8: enrollment.AddToCartResponse
+	(*RemoveFromCartRequest)(nil),         // 9: enrollment.RemoveFromCartRequest
+	(*RemoveFromCartResponse)(nil),        // 10: enrollment.RemoveFromCartResponse
+	(*GetCartRequest)(nil),                // 11: enrollment.GetCartRequest
+	(*GetCartResponse)(nil),               // 12: enrollment.GetCartResponse
+	(*GetCartSummaryRequest)(nil),         // 13: enrollment.GetCartSummaryRequest
+	(*GetCartSummaryResponse)(nil),        // 14: enrollment.GetCartSummaryResponse
+	(*ClearCartRequest)(nil),              // 15: enrollment.ClearCartRequest
+	(*ClearCartResponse)(nil),             // 16: enrollment.ClearCartResponse
+	(*CheckConflictsRequest)(nil),         // 17: enrollment.CheckConflictsRequest
+	(*CheckConflictsResponse)(nil),        // 18: enrollment.CheckConflictsResponse
+	(*EnrollAllRequest)(nil),              // 19: enrollment.EnrollAllRequest
+	(*EnrollAllResponse)(nil),             // 20: enrollment.EnrollAllResponse
+	(*DropCourseRequest)(nil),             // 21: enrollment.DropCourseRequest
+	(*DropCourseResponse)(nil),            // 22: enrollment.DropCourseResponse
+	(*GetStudentEnrollmentsRequest)(nil),  // 23: enrollment.GetStudentEnrollmentsRequest
+	(*GetStudentEnrollmentsResponse)(nil), // 24: enrollment.GetStudentEnrollmentsResponse
+	(*GetDropHistoryRequest)(nil),         // 25: enrollment.GetDropHistoryRequest
+	(*DropRecord)(nil),                    // 26: enrollment.DropRecord
+	(*GetDropHistoryResponse)(nil),        // 27: enrollment.GetDropHistoryResponse
+	(*GetEnrollmentReceiptRequest)(nil),   // 28: enrollment.GetEnrollmentReceiptRequest
+	(*GetEnrollmentReceiptResponse)(nil),  // 29: enrollment.GetEnrollmentReceiptResponse
+	(*GetEnrollmentStatusRequest)(nil),    // 30: enrollment.GetEnrollmentStatusRequest
+	(*GetEnrollmentStatusResponse)(nil),   // 31: enrollment.GetEnrollmentStatusResponse
+	(*SuggestSchedulesRequest)(nil),       // 32: enrollment.SuggestSchedulesRequest
+	(*ScheduleSection)(nil),               // 33: enrollment.ScheduleSection
+	(*SuggestedSchedule)(nil),             // 34: enrollment.SuggestedSchedule
+	(*SuggestSchedulesResponse)(nil),      // 35: enrollment.SuggestSchedulesResponse
+	(*timestamppb.Timestamp)(nil),         // 36: google.protobuf.Timestamp
 }
 var file_backend_protos_enrollment_proto_depIdxs = []int32{
-	21, // 0: enrollment.Enrollment.enrolled_at:type_name -> google.protobuf.Timestamp
-	21, // 1: enrollment.Enrollment.dropped_at:type_name -> google.protobuf.Timestamp
+	36, // 0: enrollment.Enrollment.enrolled_at:type_name -> google.protobuf.Timestamp
+	36, // 1: enrollment.Enrollment.dropped_at:type_name -> google.protobuf.Timestamp
 	0,  // 2: enrollment.Enrollment.schedule_info:type_name -> enrollment.ScheduleInfo
-	0,  // 3: enrollment.CartItem.schedule_info:type_name -> enrollment.ScheduleInfo
-	2,  // 4: enrollment.Cart.items:type_name -> enrollment.CartItem
-	21, // 5: enrollment.Cart.updated_at:type_name -> google.protobuf.Timestamp
-	3,  // 6: enrollment.AddToCartResponse.cart:type_name -> enrollment.Cart
-	3,  // 7: enrollment.RemoveFromCartResponse.cart:type_name -> enrollment.Cart
-	3,  // 8: enrollment.GetCartResponse.cart:type_name -> enrollment.Cart
-	4,  // 9: enrollment.CheckConflictsResponse.conflicts:type_name -> enrollment.Conflict
-	1,  // 10: enrollment.EnrollAllResponse.enrollments:type_name -> enrollment.Enrollment
-	1,  // 11: enrollment.GetStudentEnrollmentsResponse.enrollments:type_name -> enrollment.Enrollment
-	5,  // 12: enrollment.EnrollmentService.AddToCart:input_type -> enrollment.AddToCartRequest
-	7,  // 13: enrollment.EnrollmentService.RemoveFromCart:input_type -> enrollment.RemoveFromCartRequest
-	9,  // 14: enrollment.EnrollmentService.GetCart:input_type -> enrollment.GetCartRequest
-	11, // 15: enrollment.EnrollmentService.ClearCart:input_type -> enrollment.ClearCartRequest
-	13, // 16: enrollment.EnrollmentService.CheckConflicts:input_type -> enrollment.CheckConflictsRequest
-	15, // 17: enrollment.EnrollmentService.EnrollAll:input_type -> enrollment.EnrollAllRequest
-	17, // 18: enrollment.EnrollmentService.DropCourse:input_type -> enrollment.DropCourseRequest
-	19, // 19: enrollment.EnrollmentService.GetStudentEnrollments:input_type -> enrollment.GetStudentEnrollmentsRequest
-	6,  // 20: enrollment.EnrollmentService.AddToCart:output_type -> enrollment.AddToCartResponse
-	8,  // 21: enrollment.EnrollmentService.RemoveFromCart:output_type -> enrollment.RemoveFromCartResponse
-	10, // 22: enrollment.EnrollmentService.GetCart:output_type -> enrollment.GetCartResponse
-	12, // 23: enrollment.EnrollmentService.ClearCart:output_type -> enrollment.ClearCartResponse
-	14, // 24: enrollment.EnrollmentService.CheckConflicts:output_type -> enrollment.CheckConflictsResponse
-	16, // 25: enrollment.EnrollmentService.EnrollAll:output_type -> enrollment.EnrollAllResponse
-	18, // 26: enrollment.EnrollmentService.DropCourse:output_type -> enrollment.DropCourseResponse
-	20, // 27: enrollment.EnrollmentService.GetStudentEnrollments:output_type -> enrollment.GetStudentEnrollmentsResponse
-	20, // [20:28] is the sub-list for method output_type
-	12, // [12:20] is the sub-list for method input_type
-	12, // [12:12] is the sub-list for extension type_name
-	12, // [12:12] is the sub-list for extension extendee
-	0,  // [0:12] is the sub-list for field type_name
+	2,  // 3: enrollment.EnrollmentReceipt.courses:type_name -> enrollment.ReceiptCourse
+	36, // 4: enrollment.EnrollmentReceipt.created_at:type_name -> google.protobuf.Timestamp
+	0,  // 5: enrollment.CartItem.schedule_info:type_name -> enrollment.ScheduleInfo
+	4,  // 6: enrollment.Cart.items:type_name -> enrollment.CartItem
+	36, // 7: enrollment.Cart.updated_at:type_name -> google.protobuf.Timestamp
+	5,  // 8: enrollment.AddToCartResponse.cart:type_name -> enrollment.Cart
+	5,  // 9: enrollment.RemoveFromCartResponse.cart:type_name -> enrollment.Cart
+	5,  // 10: enrollment.GetCartResponse.cart:type_name -> enrollment.Cart
+	6,  // 11: enrollment.CheckConflictsResponse.conflicts:type_name -> enrollment.Conflict
+	1,  // 12: enrollment.EnrollAllResponse.enrollments:type_name -> enrollment.Enrollment
+	3,  // 13: enrollment.EnrollAllResponse.receipt:type_name -> enrollment.EnrollmentReceipt
+	1,  // 14: enrollment.GetStudentEnrollmentsResponse.enrollments:type_name -> enrollment.Enrollment
+	36, // 15: enrollment.DropRecord.dropped_at:type_name -> google.protobuf.Timestamp
+	26, // 16: enrollment.GetDropHistoryResponse.drops:type_name -> enrollment.DropRecord
+	3,  // 17: enrollment.GetEnrollmentReceiptResponse.receipt:type_name -> enrollment.EnrollmentReceipt
+	36, // 18: enrollment.GetEnrollmentStatusResponse.enrollment_start:type_name -> google.protobuf.Timestamp
+	36, // 19: enrollment.GetEnrollmentStatusResponse.enrollment_end:type_name -> google.protobuf.Timestamp
+	36, // 20: enrollment.GetEnrollmentStatusResponse.drop_deadline:type_name -> google.protobuf.Timestamp
+	0,  // 21: enrollment.ScheduleSection.schedule_info:type_name -> enrollment.ScheduleInfo
+	33, // 22: enrollment.SuggestedSchedule.sections:type_name -> enrollment.ScheduleSection
+	34, // 23: enrollment.SuggestSchedulesResponse.schedules:type_name -> enrollment.SuggestedSchedule
+	7,  // 24: enrollment.EnrollmentService.AddToCart:input_type -> enrollment.AddToCartRequest
+	9,  // 25: enrollment.EnrollmentService.RemoveFromCart:input_type -> enrollment.RemoveFromCartRequest
+	11, // 26: enrollment.EnrollmentService.GetCart:input_type -> enrollment.GetCartRequest
+	13, // 27: enrollment.EnrollmentService.GetCartSummary:input_type -> enrollment.GetCartSummaryRequest
+	15, // 28: enrollment.EnrollmentService.ClearCart:input_type -> enrollment.ClearCartRequest
+	17, // 29: enrollment.EnrollmentService.CheckConflicts:input_type -> enrollment.CheckConflictsRequest
+	19, // 30: enrollment.EnrollmentService.EnrollAll:input_type -> enrollment.EnrollAllRequest
+	21, // 31: enrollment.EnrollmentService.DropCourse:input_type -> enrollment.DropCourseRequest
+	23, // 32: enrollment.EnrollmentService.GetStudentEnrollments:input_type -> enrollment.GetStudentEnrollmentsRequest
+	25, // 33: enrollment.EnrollmentService.GetDropHistory:input_type -> enrollment.GetDropHistoryRequest
+	28, // 34: enrollment.EnrollmentService.GetEnrollmentReceipt:input_type -> enrollment.GetEnrollmentReceiptRequest
+	30, // 35: enrollment.EnrollmentService.GetEnrollmentStatus:input_type -> enrollment.GetEnrollmentStatusRequest
+	32, // 36: enrollment.EnrollmentService.SuggestSchedules:input_type -> enrollment.SuggestSchedulesRequest
+	8,  // 37: enrollment.EnrollmentService.AddToCart:output_type -> enrollment.AddToCartResponse
+	10, // 38: enrollment.EnrollmentService.RemoveFromCart:output_type -> enrollment.RemoveFromCartResponse
+	12, // 39: enrollment.EnrollmentService.GetCart:output_type -> enrollment.GetCartResponse
+	14, // 40: enrollment.EnrollmentService.GetCartSummary:output_type -> enrollment.GetCartSummaryResponse
+	16, // 41: enrollment.EnrollmentService.ClearCart:output_type -> enrollment.ClearCartResponse
+	18, // 42: enrollment.EnrollmentService.CheckConflicts:output_type -> enrollment.CheckConflictsResponse
+	20, // 43: enrollment.EnrollmentService.EnrollAll:output_type -> enrollment.EnrollAllResponse
+	22, // 44: enrollment.EnrollmentService.DropCourse:output_type -> enrollment.DropCourseResponse
+	24, // 45: enrollment.EnrollmentService.GetStudentEnrollments:output_type -> enrollment.GetStudentEnrollmentsResponse
+	27, // 46: enrollment.EnrollmentService.GetDropHistory:output_type -> enrollment.GetDropHistoryResponse
+	29, // 47: enrollment.EnrollmentService.GetEnrollmentReceipt:output_type -> enrollment.GetEnrollmentReceiptResponse
+	31, // 48: enrollment.EnrollmentService.GetEnrollmentStatus:output_type -> enrollment.GetEnrollmentStatusResponse
+	35, // 49: enrollment.EnrollmentService.SuggestSchedules:output_type -> enrollment.SuggestSchedulesResponse
+	37, // [37:50] is the sub-list for method output_type
+	24, // [24:37] is the sub-list for method input_type
+	24, // [24:24] is the sub-list for extension type_name
+	24, // [24:24] is the sub-list for extension extendee
+	0,  // [0:24] is the sub-list for field type_name
 }
 
 func init() { file_backend_protos_enrollment_proto_init() }
@@ -1519,7 +2852,7 @@ func file_backend_protos_enrollment_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_backend_protos_enrollment_proto_rawDesc), len(file_backend_protos_enrollment_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   21,
+			NumMessages:   36,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -22,11 +22,16 @@ const (
 	EnrollmentService_AddToCart_FullMethodName             = "/enrollment.EnrollmentService/AddToCart"
 	EnrollmentService_RemoveFromCart_FullMethodName        = "/enrollment.EnrollmentService/RemoveFromCart"
 	EnrollmentService_GetCart_FullMethodName               = "/enrollment.EnrollmentService/GetCart"
+	EnrollmentService_GetCartSummary_FullMethodName        = "/enrollment.EnrollmentService/GetCartSummary"
 	EnrollmentService_ClearCart_FullMethodName             = "/enrollment.EnrollmentService/ClearCart"
 	EnrollmentService_CheckConflicts_FullMethodName        = "/enrollment.EnrollmentService/CheckConflicts"
 	EnrollmentService_EnrollAll_FullMethodName             = "/enrollment.EnrollmentService/EnrollAll"
 	EnrollmentService_DropCourse_FullMethodName            = "/enrollment.EnrollmentService/DropCourse"
 	EnrollmentService_GetStudentEnrollments_FullMethodName = "/enrollment.EnrollmentService/GetStudentEnrollments"
+	EnrollmentService_GetDropHistory_FullMethodName        = "/enrollment.EnrollmentService/GetDropHistory"
+	EnrollmentService_GetEnrollmentReceipt_FullMethodName  = "/enrollment.EnrollmentService/GetEnrollmentReceipt"
+	EnrollmentService_GetEnrollmentStatus_FullMethodName   = "/enrollment.EnrollmentService/GetEnrollmentStatus"
+	EnrollmentService_SuggestSchedules_FullMethodName      = "/enrollment.EnrollmentService/SuggestSchedules"
 )
 
 // EnrollmentServiceClient is the client API for EnrollmentService service.
@@ -38,11 +43,16 @@ type EnrollmentServiceClient interface {
 	AddToCart(ctx context.Context, in *AddToCartRequest, opts ...grpc.CallOption) (*AddToCartResponse, error)
 	RemoveFromCart(ctx context.Context, in *RemoveFromCartRequest, opts ...grpc.CallOption) (*RemoveFromCartResponse, error)
 	GetCart(ctx context.Context, in *GetCartRequest, opts ...grpc.CallOption) (*GetCartResponse, error)
+	GetCartSummary(ctx context.Context, in *GetCartSummaryRequest, opts ...grpc.CallOption) (*GetCartSummaryResponse, error)
 	ClearCart(ctx context.Context, in *ClearCartRequest, opts ...grpc.CallOption) (*ClearCartResponse, error)
 	CheckConflicts(ctx context.Context, in *CheckConflictsRequest, opts ...grpc.CallOption) (*CheckConflictsResponse, error)
 	EnrollAll(ctx context.Context, in *EnrollAllRequest, opts ...grpc.CallOption) (*EnrollAllResponse, error)
 	DropCourse(ctx context.Context, in *DropCourseRequest, opts ...grpc.CallOption) (*DropCourseResponse, error)
 	GetStudentEnrollments(ctx context.Context, in *GetStudentEnrollmentsRequest, opts ...grpc.CallOption) (*GetStudentEnrollmentsResponse, error)
+	GetDropHistory(ctx context.Context, in *GetDropHistoryRequest, opts ...grpc.CallOption) (*GetDropHistoryResponse, error)
+	GetEnrollmentReceipt(ctx context.Context, in *GetEnrollmentReceiptRequest, opts ...grpc.CallOption) (*GetEnrollmentReceiptResponse, error)
+	GetEnrollmentStatus(ctx context.Context, in *GetEnrollmentStatusRequest, opts ...grpc.CallOption) (*GetEnrollmentStatusResponse, error)
+	SuggestSchedules(ctx context.Context, in *SuggestSchedulesRequest, opts ...grpc.CallOption) (*SuggestSchedulesResponse, error)
 }
 
 type enrollmentServiceClient struct {
@@ -83,6 +93,16 @@ func (c *enrollmentServiceClient) GetCart(ctx context.Context, in *GetCartReques
 	return out, nil
 }
 
+func (c *enrollmentServiceClient) GetCartSummary(ctx context.Context, in *GetCartSummaryRequest, opts ...grpc.CallOption) (*GetCartSummaryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCartSummaryResponse)
+	err := c.cc.Invoke(ctx, EnrollmentService_GetCartSummary_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *enrollmentServiceClient) ClearCart(ctx context.Context, in *ClearCartRequest, opts ...grpc.CallOption) (*ClearCartResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ClearCartResponse)
@@ -133,6 +153,46 @@ func (c *enrollmentServiceClient) GetStudentEnrollments(ctx context.Context, in
 	return out, nil
 }
 
+func (c *enrollmentServiceClient) GetDropHistory(ctx context.Context, in *GetDropHistoryRequest, opts ...grpc.CallOption) (*GetDropHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetDropHistoryResponse)
+	err := c.cc.Invoke(ctx, EnrollmentService_GetDropHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *enrollmentServiceClient) GetEnrollmentReceipt(ctx context.Context, in *GetEnrollmentReceiptRequest, opts ...grpc.CallOption) (*GetEnrollmentReceiptResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetEnrollmentReceiptResponse)
+	err := c.cc.Invoke(ctx, EnrollmentService_GetEnrollmentReceipt_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *enrollmentServiceClient) GetEnrollmentStatus(ctx context.Context, in *GetEnrollmentStatusRequest, opts ...grpc.CallOption) (*GetEnrollmentStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetEnrollmentStatusResponse)
+	err := c.cc.Invoke(ctx, EnrollmentService_GetEnrollmentStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *enrollmentServiceClient) SuggestSchedules(ctx context.Context, in *SuggestSchedulesRequest, opts ...grpc.CallOption) (*SuggestSchedulesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SuggestSchedulesResponse)
+	err := c.cc.Invoke(ctx, EnrollmentService_SuggestSchedules_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // EnrollmentServiceServer is the server API for EnrollmentService service.
 // All implementations must embed UnimplementedEnrollmentServiceServer
 // for forward compatibility.
@@ -142,11 +202,16 @@ type EnrollmentServiceServer interface {
 	AddToCart(context.Context, *AddToCartRequest) (*AddToCartResponse, error)
 	RemoveFromCart(context.Context, *RemoveFromCartRequest) (*RemoveFromCartResponse, error)
 	GetCart(context.Context, *GetCartRequest) (*GetCartResponse, error)
+	GetCartSummary(context.Context, *GetCartSummaryRequest) (*GetCartSummaryResponse, error)
 	ClearCart(context.Context, *ClearCartRequest) (*ClearCartResponse, error)
 	CheckConflicts(context.Context, *CheckConflictsRequest) (*CheckConflictsResponse, error)
 	EnrollAll(context.Context, *EnrollAllRequest) (*EnrollAllResponse, error)
 	DropCourse(context.Context, *DropCourseRequest) (*DropCourseResponse, error)
 	GetStudentEnrollments(context.Context, *GetStudentEnrollmentsRequest) (*GetStudentEnrollmentsResponse, error)
+	GetDropHistory(context.Context, *GetDropHistoryRequest) (*GetDropHistoryResponse, error)
+	GetEnrollmentReceipt(context.Context, *GetEnrollmentReceiptRequest) (*GetEnrollmentReceiptResponse, error)
+	GetEnrollmentStatus(context.Context, *GetEnrollmentStatusRequest) (*GetEnrollmentStatusResponse, error)
+	SuggestSchedules(context.Context, *SuggestSchedulesRequest) (*SuggestSchedulesResponse, error)
 	mustEmbedUnimplementedEnrollmentServiceServer()
 }
 
@@ -166,6 +231,9 @@ func (UnimplementedEnrollmentServiceServer) RemoveFromCart(context.Context, *Rem
 func (UnimplementedEnrollmentServiceServer) GetCart(context.Context, *GetCartRequest) (*GetCartResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetCart not implemented")
 }
+func (UnimplementedEnrollmentServiceServer) GetCartSummary(context.Context, *GetCartSummaryRequest) (*GetCartSummaryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCartSummary not implemented")
+}
 func (UnimplementedEnrollmentServiceServer) ClearCart(context.Context, *ClearCartRequest) (*ClearCartResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ClearCart not implemented")
 }
@@ -181,6 +249,18 @@ func (UnimplementedEnrollmentServiceServer) DropCourse(context.Context, *DropCou
 func (UnimplementedEnrollmentServiceServer) GetStudentEnrollments(context.Context, *GetStudentEnrollmentsRequest) (*GetStudentEnrollmentsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetStudentEnrollments not implemented")
 }
+func (UnimplementedEnrollmentServiceServer) GetDropHistory(context.Context, *GetDropHistoryRequest) (*GetDropHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDropHistory not implemented")
+}
+func (UnimplementedEnrollmentServiceServer) GetEnrollmentReceipt(context.Context, *GetEnrollmentReceiptRequest) (*GetEnrollmentReceiptResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEnrollmentReceipt not implemented")
+}
+func (UnimplementedEnrollmentServiceServer) GetEnrollmentStatus(context.Context, *GetEnrollmentStatusRequest) (*GetEnrollmentStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEnrollmentStatus not implemented")
+}
+func (UnimplementedEnrollmentServiceServer) SuggestSchedules(context.Context, *SuggestSchedulesRequest) (*SuggestSchedulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SuggestSchedules not implemented")
+}
 func (UnimplementedEnrollmentServiceServer) mustEmbedUnimplementedEnrollmentServiceServer() {}
 func (UnimplementedEnrollmentServiceServer) testEmbeddedByValue()                           {}
 
@@ -256,6 +336,24 @@ func _EnrollmentService_GetCart_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _EnrollmentService_GetCartSummary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCartSummaryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EnrollmentServiceServer).GetCartSummary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EnrollmentService_GetCartSummary_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EnrollmentServiceServer).GetCartSummary(ctx, req.(*GetCartSummaryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _EnrollmentService_ClearCart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ClearCartRequest)
 	if err := dec(in); err != nil {
@@ -346,6 +444,78 @@ func _EnrollmentService_GetStudentEnrollments_Handler(srv interface{}, ctx conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _EnrollmentService_GetDropHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDropHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EnrollmentServiceServer).GetDropHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EnrollmentService_GetDropHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EnrollmentServiceServer).GetDropHistory(ctx, req.(*GetDropHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EnrollmentService_GetEnrollmentReceipt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEnrollmentReceiptRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EnrollmentServiceServer).GetEnrollmentReceipt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EnrollmentService_GetEnrollmentReceipt_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EnrollmentServiceServer).GetEnrollmentReceipt(ctx, req.(*GetEnrollmentReceiptRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EnrollmentService_GetEnrollmentStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEnrollmentStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EnrollmentServiceServer).GetEnrollmentStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EnrollmentService_GetEnrollmentStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EnrollmentServiceServer).GetEnrollmentStatus(ctx, req.(*GetEnrollmentStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EnrollmentService_SuggestSchedules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuggestSchedulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EnrollmentServiceServer).SuggestSchedules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EnrollmentService_SuggestSchedules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EnrollmentServiceServer).SuggestSchedules(ctx, req.(*SuggestSchedulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // EnrollmentService_ServiceDesc is the grpc.ServiceDesc for EnrollmentService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -365,6 +535,10 @@ var EnrollmentService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetCart",
 			Handler:    _EnrollmentService_GetCart_Handler,
 		},
+		{
+			MethodName: "GetCartSummary",
+			Handler:    _EnrollmentService_GetCartSummary_Handler,
+		},
 		{
 			MethodName: "ClearCart",
 			Handler:    _EnrollmentService_ClearCart_Handler,
@@ -385,6 +559,22 @@ var EnrollmentService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetStudentEnrollments",
 			Handler:    _EnrollmentService_GetStudentEnrollments_Handler,
 		},
+		{
+			MethodName: "GetDropHistory",
+			Handler:    _EnrollmentService_GetDropHistory_Handler,
+		},
+		{
+			MethodName: "GetEnrollmentReceipt",
+			Handler:    _EnrollmentService_GetEnrollmentReceipt_Handler,
+		},
+		{
+			MethodName: "GetEnrollmentStatus",
+			Handler:    _EnrollmentService_GetEnrollmentStatus_Handler,
+		},
+		{
+			MethodName: "SuggestSchedules",
+			Handler:    _EnrollmentService_SuggestSchedules_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "backend/protos/enrollment.proto",
@@ -399,6 +399,98 @@ func (x *StudentRosterEntry) GetGrade() string {
 	return ""
 }
 
+type DeansListEntry struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StudentId     string                 `protobuf:"bytes,1,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
+	StudentName   string                 `protobuf:"bytes,2,opt,name=student_name,json=studentName,proto3" json:"student_name,omitempty"`
+	Major         string                 `protobuf:"bytes,3,opt,name=major,proto3" json:"major,omitempty"`
+	YearLevel     int32                  `protobuf:"varint,4,opt,name=year_level,json=yearLevel,proto3" json:"year_level,omitempty"`
+	Gpa           float64                `protobuf:"fixed64,5,opt,name=gpa,proto3" json:"gpa,omitempty"`                                // term GPA for the semester
+	TotalUnits    int32                  `protobuf:"varint,6,opt,name=total_units,json=totalUnits,proto3" json:"total_units,omitempty"` // graded units counted in the GPA
+	CoursesCount  int32                  `protobuf:"varint,7,opt,name=courses_count,json=coursesCount,proto3" json:"courses_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeansListEntry) Reset() {
+	*x = DeansListEntry{}
+	mi := &file_backend_protos_grade_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeansListEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeansListEntry) ProtoMessage() {}
+
+func (x *DeansListEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_protos_grade_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeansListEntry.ProtoReflect.Descriptor instead.
+func (*DeansListEntry) Descriptor() ([]byte, []int) {
+	return file_backend_protos_grade_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *DeansListEntry) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *DeansListEntry) GetStudentName() string {
+	if x != nil {
+		return x.StudentName
+	}
+	return ""
+}
+
+func (x *DeansListEntry) GetMajor() string {
+	if x != nil {
+		return x.Major
+	}
+	return ""
+}
+
+func (x *DeansListEntry) GetYearLevel() int32 {
+	if x != nil {
+		return x.YearLevel
+	}
+	return 0
+}
+
+func (x *DeansListEntry) GetGpa() float64 {
+	if x != nil {
+		return x.Gpa
+	}
+	return 0
+}
+
+func (x *DeansListEntry) GetTotalUnits() int32 {
+	if x != nil {
+		return x.TotalUnits
+	}
+	return 0
+}
+
+func (x *DeansListEntry) GetCoursesCount() int32 {
+	if x != nil {
+		return x.CoursesCount
+	}
+	return 0
+}
+
 type GradeEntry struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	StudentId     string                 `protobuf:"bytes,1,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
@@ -409,7 +501,7 @@ type GradeEntry struct {
 
 func (x *GradeEntry) Reset() {
 	*x = GradeEntry{}
-	mi := &file_backend_protos_grade_proto_msgTypes[4]
+	mi := &file_backend_protos_grade_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -421,7 +513,7 @@ func (x *GradeEntry) String() string {
 func (*GradeEntry) ProtoMessage() {}
 
 func (x *GradeEntry) ProtoReflect() protoreflect.Message {
-	mi := &file_backend_protos_grade_proto_msgTypes[4]
+	mi := &file_backend_protos_grade_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -434,7 +526,7 @@ func (x *GradeEntry) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GradeEntry.ProtoReflect.Descriptor instead.
 func (*GradeEntry) Descriptor() ([]byte, []int) {
-	return file_backend_protos_grade_proto_rawDescGZIP(), []int{4}
+	return file_backend_protos_grade_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *GradeEntry) GetStudentId() string {
@@ -455,14 +547,16 @@ func (x *GradeEntry) GetGrade() string {
 type GetStudentGradesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	StudentId     string                 `protobuf:"bytes,1,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
-	Semester      string                 `protobuf:"bytes,2,opt,name=semester,proto3" json:"semester,omitempty"` // optional filter
+	Semester      string                 `protobuf:"bytes,2,opt,name=semester,proto3" json:"semester,omitempty"`                  // optional filter
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`                         // 1-based, defaults to 1
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"` // defaults to the grades_page_size config (50), max 200
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetStudentGradesRequest) Reset() {
 	*x = GetStudentGradesRequest{}
-	mi := &file_backend_protos_grade_proto_msgTypes[5]
+	mi := &file_backend_protos_grade_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -474,7 +568,7 @@ func (x *GetStudentGradesRequest) String() string {
 func (*GetStudentGradesRequest) ProtoMessage() {}
 
 func (x *GetStudentGradesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_backend_protos_grade_proto_msgTypes[5]
+	mi := &file_backend_protos_grade_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -487,7 +581,7 @@ func (x *GetStudentGradesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetStudentGradesRequest.ProtoReflect.Descriptor instead.
 func (*GetStudentGradesRequest) Descriptor() ([]byte, []int) {
-	return file_backend_protos_grade_proto_rawDescGZIP(), []int{5}
+	return file_backend_protos_grade_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *GetStudentGradesRequest) GetStudentId() string {
@@ -504,17 +598,34 @@ func (x *GetStudentGradesRequest) GetSemester() string {
 	return ""
 }
 
+func (x *GetStudentGradesRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetStudentGradesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
 type GetStudentGradesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Grades        []*Grade               `protobuf:"bytes,1,rep,name=grades,proto3" json:"grades,omitempty"`
-	GpaInfo       *GPACalculation        `protobuf:"bytes,2,opt,name=gpa_info,json=gpaInfo,proto3" json:"gpa_info,omitempty"`
+	Grades        []*Grade               `protobuf:"bytes,1,rep,name=grades,proto3" json:"grades,omitempty"`                            // newest semester first
+	GpaInfo       *GPACalculation        `protobuf:"bytes,2,opt,name=gpa_info,json=gpaInfo,proto3" json:"gpa_info,omitempty"`           // across all pages
+	TotalCount    int32                  `protobuf:"varint,3,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"` // published grades matching the filter
+	Page          int32                  `protobuf:"varint,4,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,5,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetStudentGradesResponse) Reset() {
 	*x = GetStudentGradesResponse{}
-	mi := &file_backend_protos_grade_proto_msgTypes[6]
+	mi := &file_backend_protos_grade_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -526,7 +637,7 @@ func (x *GetStudentGradesResponse) String() string {
 func (*GetStudentGradesResponse) ProtoMessage() {}
 
 func (x *GetStudentGradesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_backend_protos_grade_proto_msgTypes[6]
+	mi := &file_backend_protos_grade_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -539,7 +650,7 @@ func (x *GetStudentGradesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetStudentGradesResponse.ProtoReflect.Descriptor instead.
 func (*GetStudentGradesResponse) Descriptor() ([]byte, []int) {
-	return file_backend_protos_grade_proto_rawDescGZIP(), []int{6}
+	return file_backend_protos_grade_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *GetStudentGradesResponse) GetGrades() []*Grade {
@@ -556,81 +667,48 @@ func (x *GetStudentGradesResponse) GetGpaInfo() *GPACalculation {
 	return nil
 }
 
-type CalculateGPARequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	StudentId     string                 `protobuf:"bytes,1,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
-	Semester      string                 `protobuf:"bytes,2,opt,name=semester,proto3" json:"semester,omitempty"` // optional, if empty calculates CGPA
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *CalculateGPARequest) Reset() {
-	*x = CalculateGPARequest{}
-	mi := &file_backend_protos_grade_proto_msgTypes[7]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *CalculateGPARequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*CalculateGPARequest) ProtoMessage() {}
-
-func (x *CalculateGPARequest) ProtoReflect() protoreflect.Message {
-	mi := &file_backend_protos_grade_proto_msgTypes[7]
+func (x *GetStudentGradesResponse) GetTotalCount() int32 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.TotalCount
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use CalculateGPARequest.ProtoReflect.Descriptor instead.
-func (*CalculateGPARequest) Descriptor() ([]byte, []int) {
-	return file_backend_protos_grade_proto_rawDescGZIP(), []int{7}
+	return 0
 }
 
-func (x *CalculateGPARequest) GetStudentId() string {
+func (x *GetStudentGradesResponse) GetPage() int32 {
 	if x != nil {
-		return x.StudentId
+		return x.Page
 	}
-	return ""
+	return 0
 }
 
-func (x *CalculateGPARequest) GetSemester() string {
+func (x *GetStudentGradesResponse) GetPageSize() int32 {
 	if x != nil {
-		return x.Semester
+		return x.PageSize
 	}
-	return ""
+	return 0
 }
 
-type CalculateGPAResponse struct {
+type GetStudentSemestersRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	GpaInfo       *GPACalculation        `protobuf:"bytes,2,opt,name=gpa_info,json=gpaInfo,proto3" json:"gpa_info,omitempty"`
-	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	StudentId     string                 `protobuf:"bytes,1,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CalculateGPAResponse) Reset() {
-	*x = CalculateGPAResponse{}
+func (x *GetStudentSemestersRequest) Reset() {
+	*x = GetStudentSemestersRequest{}
 	mi := &file_backend_protos_grade_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CalculateGPAResponse) String() string {
+func (x *GetStudentSemestersRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CalculateGPAResponse) ProtoMessage() {}
+func (*GetStudentSemestersRequest) ProtoMessage() {}
 
-func (x *CalculateGPAResponse) ProtoReflect() protoreflect.Message {
+func (x *GetStudentSemestersRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_backend_protos_grade_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -642,53 +720,40 @@ func (x *CalculateGPAResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CalculateGPAResponse.ProtoReflect.Descriptor instead.
-func (*CalculateGPAResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetStudentSemestersRequest.ProtoReflect.Descriptor instead.
+func (*GetStudentSemestersRequest) Descriptor() ([]byte, []int) {
 	return file_backend_protos_grade_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *CalculateGPAResponse) GetSuccess() bool {
-	if x != nil {
-		return x.Success
-	}
-	return false
-}
-
-func (x *CalculateGPAResponse) GetGpaInfo() *GPACalculation {
-	if x != nil {
-		return x.GpaInfo
-	}
-	return nil
-}
-
-func (x *CalculateGPAResponse) GetMessage() string {
+func (x *GetStudentSemestersRequest) GetStudentId() string {
 	if x != nil {
-		return x.Message
+		return x.StudentId
 	}
 	return ""
 }
 
-type GetClassRosterRequest struct {
+type StudentSemester struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	CourseId      string                 `protobuf:"bytes,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	Semester      string                 `protobuf:"bytes,1,opt,name=semester,proto3" json:"semester,omitempty"`
+	GradeCount    int32                  `protobuf:"varint,2,opt,name=grade_count,json=gradeCount,proto3" json:"grade_count,omitempty"` // published grades in this semester
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetClassRosterRequest) Reset() {
-	*x = GetClassRosterRequest{}
+func (x *StudentSemester) Reset() {
+	*x = StudentSemester{}
 	mi := &file_backend_protos_grade_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetClassRosterRequest) String() string {
+func (x *StudentSemester) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetClassRosterRequest) ProtoMessage() {}
+func (*StudentSemester) ProtoMessage() {}
 
-func (x *GetClassRosterRequest) ProtoReflect() protoreflect.Message {
+func (x *StudentSemester) ProtoReflect() protoreflect.Message {
 	mi := &file_backend_protos_grade_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -700,43 +765,46 @@ func (x *GetClassRosterRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetClassRosterRequest.ProtoReflect.Descriptor instead.
-func (*GetClassRosterRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use StudentSemester.ProtoReflect.Descriptor instead.
+func (*StudentSemester) Descriptor() ([]byte, []int) {
 	return file_backend_protos_grade_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *GetClassRosterRequest) GetCourseId() string {
+func (x *StudentSemester) GetSemester() string {
 	if x != nil {
-		return x.CourseId
+		return x.Semester
 	}
 	return ""
 }
 
-type GetClassRosterResponse struct {
+func (x *StudentSemester) GetGradeCount() int32 {
+	if x != nil {
+		return x.GradeCount
+	}
+	return 0
+}
+
+type GetStudentSemestersResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	CourseId      string                 `protobuf:"bytes,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
-	CourseCode    string                 `protobuf:"bytes,2,opt,name=course_code,json=courseCode,proto3" json:"course_code,omitempty"`
-	CourseTitle   string                 `protobuf:"bytes,3,opt,name=course_title,json=courseTitle,proto3" json:"course_title,omitempty"`
-	Students      []*StudentRosterEntry  `protobuf:"bytes,4,rep,name=students,proto3" json:"students,omitempty"`
-	TotalStudents int32                  `protobuf:"varint,5,opt,name=total_students,json=totalStudents,proto3" json:"total_students,omitempty"`
+	Semesters     []*StudentSemester     `protobuf:"bytes,1,rep,name=semesters,proto3" json:"semesters,omitempty"` // oldest first
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetClassRosterResponse) Reset() {
-	*x = GetClassRosterResponse{}
+func (x *GetStudentSemestersResponse) Reset() {
+	*x = GetStudentSemestersResponse{}
 	mi := &file_backend_protos_grade_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetClassRosterResponse) String() string {
+func (x *GetStudentSemestersResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetClassRosterResponse) ProtoMessage() {}
+func (*GetStudentSemestersResponse) ProtoMessage() {}
 
-func (x *GetClassRosterResponse) ProtoReflect() protoreflect.Message {
+func (x *GetStudentSemestersResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_backend_protos_grade_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -748,68 +816,40 @@ func (x *GetClassRosterResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetClassRosterResponse.ProtoReflect.Descriptor instead.
-func (*GetClassRosterResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetStudentSemestersResponse.ProtoReflect.Descriptor instead.
+func (*GetStudentSemestersResponse) Descriptor() ([]byte, []int) {
 	return file_backend_protos_grade_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *GetClassRosterResponse) GetCourseId() string {
-	if x != nil {
-		return x.CourseId
-	}
-	return ""
-}
-
-func (x *GetClassRosterResponse) GetCourseCode() string {
-	if x != nil {
-		return x.CourseCode
-	}
-	return ""
-}
-
-func (x *GetClassRosterResponse) GetCourseTitle() string {
-	if x != nil {
-		return x.CourseTitle
-	}
-	return ""
-}
-
-func (x *GetClassRosterResponse) GetStudents() []*StudentRosterEntry {
+func (x *GetStudentSemestersResponse) GetSemesters() []*StudentSemester {
 	if x != nil {
-		return x.Students
+		return x.Semesters
 	}
 	return nil
 }
 
-func (x *GetClassRosterResponse) GetTotalStudents() int32 {
-	if x != nil {
-		return x.TotalStudents
-	}
-	return 0
-}
-
-type UploadGradesRequest struct {
+type CalculateGPARequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	CourseId      string                 `protobuf:"bytes,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
-	FacultyId     string                 `protobuf:"bytes,2,opt,name=faculty_id,json=facultyId,proto3" json:"faculty_id,omitempty"`
+	StudentId     string                 `protobuf:"bytes,1,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
+	Semester      string                 `protobuf:"bytes,2,opt,name=semester,proto3" json:"semester,omitempty"` // optional, if empty calculates CGPA
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UploadGradesRequest) Reset() {
-	*x = UploadGradesRequest{}
+func (x *CalculateGPARequest) Reset() {
+	*x = CalculateGPARequest{}
 	mi := &file_backend_protos_grade_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UploadGradesRequest) String() string {
+func (x *CalculateGPARequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UploadGradesRequest) ProtoMessage() {}
+func (*CalculateGPARequest) ProtoMessage() {}
 
-func (x *UploadGradesRequest) ProtoReflect() protoreflect.Message {
+func (x *CalculateGPARequest) ProtoReflect() protoreflect.Message {
 	mi := &file_backend_protos_grade_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -821,52 +861,48 @@ func (x *UploadGradesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UploadGradesRequest.ProtoReflect.Descriptor instead.
-func (*UploadGradesRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CalculateGPARequest.ProtoReflect.Descriptor instead.
+func (*CalculateGPARequest) Descriptor() ([]byte, []int) {
 	return file_backend_protos_grade_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *UploadGradesRequest) GetCourseId() string {
+func (x *CalculateGPARequest) GetStudentId() string {
 	if x != nil {
-		return x.CourseId
+		return x.StudentId
 	}
 	return ""
 }
 
-func (x *UploadGradesRequest) GetFacultyId() string {
+func (x *CalculateGPARequest) GetSemester() string {
 	if x != nil {
-		return x.FacultyId
+		return x.Semester
 	}
 	return ""
 }
 
-// Server streaming: Gateway sends grade entries one by one
-type UploadGradeEntryRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Types that are valid to be assigned to Payload:
-	//
-	//	*UploadGradeEntryRequest_Metadata
-	//	*UploadGradeEntryRequest_Entry
-	Payload       isUploadGradeEntryRequest_Payload `protobuf_oneof:"payload"`
-	IsLast        bool                              `protobuf:"varint,3,opt,name=is_last,json=isLast,proto3" json:"is_last,omitempty"`
+type CalculateGPAResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	GpaInfo       *GPACalculation        `protobuf:"bytes,2,opt,name=gpa_info,json=gpaInfo,proto3" json:"gpa_info,omitempty"`
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UploadGradeEntryRequest) Reset() {
-	*x = UploadGradeEntryRequest{}
+func (x *CalculateGPAResponse) Reset() {
+	*x = CalculateGPAResponse{}
 	mi := &file_backend_protos_grade_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UploadGradeEntryRequest) String() string {
+func (x *CalculateGPAResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UploadGradeEntryRequest) ProtoMessage() {}
+func (*CalculateGPAResponse) ProtoMessage() {}
 
-func (x *UploadGradeEntryRequest) ProtoReflect() protoreflect.Message {
+func (x *CalculateGPAResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_backend_protos_grade_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -878,82 +914,2252 @@ func (x *UploadGradeEntryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UploadGradeEntryRequest.ProtoReflect.Descriptor instead.
-func (*UploadGradeEntryRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CalculateGPAResponse.ProtoReflect.Descriptor instead.
+func (*CalculateGPAResponse) Descriptor() ([]byte, []int) {
 	return file_backend_protos_grade_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *UploadGradeEntryRequest) GetPayload() isUploadGradeEntryRequest_Payload {
+func (x *CalculateGPAResponse) GetSuccess() bool {
 	if x != nil {
-		return x.Payload
+		return x.Success
+	}
+	return false
+}
+
+func (x *CalculateGPAResponse) GetGpaInfo() *GPACalculation {
+	if x != nil {
+		return x.GpaInfo
 	}
 	return nil
 }
 
-func (x *UploadGradeEntryRequest) GetMetadata() *UploadMetadata {
+func (x *CalculateGPAResponse) GetMessage() string {
 	if x != nil {
-		if x, ok := x.Payload.(*UploadGradeEntryRequest_Metadata); ok {
-			return x.Metadata
+		return x.Message
+	}
+	return ""
+}
+
+type HypotheticalGrade struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CourseId      string                 `protobuf:"bytes,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	Grade         string                 `protobuf:"bytes,2,opt,name=grade,proto3" json:"grade,omitempty"` // letter grade the student expects
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HypotheticalGrade) Reset() {
+	*x = HypotheticalGrade{}
+	mi := &file_backend_protos_grade_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HypotheticalGrade) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HypotheticalGrade) ProtoMessage() {}
+
+func (x *HypotheticalGrade) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_protos_grade_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *UploadGradeEntryRequest) GetEntry() *GradeEntry {
+// Deprecated: Use HypotheticalGrade.ProtoReflect.Descriptor instead.
+func (*HypotheticalGrade) Descriptor() ([]byte, []int) {
+	return file_backend_protos_grade_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *HypotheticalGrade) GetCourseId() string {
 	if x != nil {
-		if x, ok := x.Payload.(*UploadGradeEntryRequest_Entry); ok {
-			return x.Entry
+		return x.CourseId
+	}
+	return ""
+}
+
+func (x *HypotheticalGrade) GetGrade() string {
+	if x != nil {
+		return x.Grade
+	}
+	return ""
+}
+
+type SimulateGPARequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StudentId     string                 `protobuf:"bytes,1,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
+	Grades        []*HypotheticalGrade   `protobuf:"bytes,2,rep,name=grades,proto3" json:"grades,omitempty"` // replace any published grade for the same course
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SimulateGPARequest) Reset() {
+	*x = SimulateGPARequest{}
+	mi := &file_backend_protos_grade_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SimulateGPARequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SimulateGPARequest) ProtoMessage() {}
+
+func (x *SimulateGPARequest) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_protos_grade_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SimulateGPARequest.ProtoReflect.Descriptor instead.
+func (*SimulateGPARequest) Descriptor() ([]byte, []int) {
+	return file_backend_protos_grade_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *SimulateGPARequest) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *SimulateGPARequest) GetGrades() []*HypotheticalGrade {
+	if x != nil {
+		return x.Grades
 	}
 	return nil
 }
 
-func (x *UploadGradeEntryRequest) GetIsLast() bool {
+type SimulateGPAResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	GpaInfo       *GPACalculation        `protobuf:"bytes,2,opt,name=gpa_info,json=gpaInfo,proto3" json:"gpa_info,omitempty"` // term_gpa is for term, cgpa includes every published grade
+	Term          string                 `protobuf:"bytes,3,opt,name=term,proto3" json:"term,omitempty"`                      // latest semester among the hypothetical courses
+	Message       string                 `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SimulateGPAResponse) Reset() {
+	*x = SimulateGPAResponse{}
+	mi := &file_backend_protos_grade_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SimulateGPAResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SimulateGPAResponse) ProtoMessage() {}
+
+func (x *SimulateGPAResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_protos_grade_proto_msgTypes[15]
 	if x != nil {
-		return x.IsLast
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SimulateGPAResponse.ProtoReflect.Descriptor instead.
+func (*SimulateGPAResponse) Descriptor() ([]byte, []int) {
+	return file_backend_protos_grade_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *SimulateGPAResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
 	}
 	return false
 }
 
-type isUploadGradeEntryRequest_Payload interface {
-	isUploadGradeEntryRequest_Payload()
+func (x *SimulateGPAResponse) GetGpaInfo() *GPACalculation {
+	if x != nil {
+		return x.GpaInfo
+	}
+	return nil
 }
 
-type UploadGradeEntryRequest_Metadata struct {
-	Metadata *UploadMetadata `protobuf:"bytes,1,opt,name=metadata,proto3,oneof"` // First message only
+func (x *SimulateGPAResponse) GetTerm() string {
+	if x != nil {
+		return x.Term
+	}
+	return ""
 }
 
-type UploadGradeEntryRequest_Entry struct {
-	Entry *GradeEntry `protobuf:"bytes,2,opt,name=entry,proto3,oneof"` // Subsequent messages
+func (x *SimulateGPAResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
 }
 
-func (*UploadGradeEntryRequest_Metadata) isUploadGradeEntryRequest_Payload() {}
+type GetClassRosterRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CourseId      string                 `protobuf:"bytes,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	FacultyId     string                 `protobuf:"bytes,2,opt,name=faculty_id,json=facultyId,proto3" json:"faculty_id,omitempty"` // optional, when set the faculty must teach the course
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-func (*UploadGradeEntryRequest_Entry) isUploadGradeEntryRequest_Payload() {}
+func (x *GetClassRosterRequest) Reset() {
+	*x = GetClassRosterRequest{}
+	mi := &file_backend_protos_grade_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetClassRosterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetClassRosterRequest) ProtoMessage() {}
+
+func (x *GetClassRosterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_protos_grade_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetClassRosterRequest.ProtoReflect.Descriptor instead.
+func (*GetClassRosterRequest) Descriptor() ([]byte, []int) {
+	return file_backend_protos_grade_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GetClassRosterRequest) GetCourseId() string {
+	if x != nil {
+		return x.CourseId
+	}
+	return ""
+}
+
+func (x *GetClassRosterRequest) GetFacultyId() string {
+	if x != nil {
+		return x.FacultyId
+	}
+	return ""
+}
+
+type GetClassRosterResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CourseId      string                 `protobuf:"bytes,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	CourseCode    string                 `protobuf:"bytes,2,opt,name=course_code,json=courseCode,proto3" json:"course_code,omitempty"`
+	CourseTitle   string                 `protobuf:"bytes,3,opt,name=course_title,json=courseTitle,proto3" json:"course_title,omitempty"`
+	Students      []*StudentRosterEntry  `protobuf:"bytes,4,rep,name=students,proto3" json:"students,omitempty"`
+	TotalStudents int32                  `protobuf:"varint,5,opt,name=total_students,json=totalStudents,proto3" json:"total_students,omitempty"`
+	Withdrawn     []*StudentRosterEntry  `protobuf:"bytes,6,rep,name=withdrawn,proto3" json:"withdrawn,omitempty"` // graded W; not counted in total_students
+	Summary       *RosterGradeSummary    `protobuf:"bytes,7,opt,name=summary,proto3" json:"summary,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetClassRosterResponse) Reset() {
+	*x = GetClassRosterResponse{}
+	mi := &file_backend_protos_grade_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetClassRosterResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetClassRosterResponse) ProtoMessage() {}
+
+func (x *GetClassRosterResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_protos_grade_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetClassRosterResponse.ProtoReflect.Descriptor instead.
+func (*GetClassRosterResponse) Descriptor() ([]byte, []int) {
+	return file_backend_protos_grade_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetClassRosterResponse) GetCourseId() string {
+	if x != nil {
+		return x.CourseId
+	}
+	return ""
+}
+
+func (x *GetClassRosterResponse) GetCourseCode() string {
+	if x != nil {
+		return x.CourseCode
+	}
+	return ""
+}
+
+func (x *GetClassRosterResponse) GetCourseTitle() string {
+	if x != nil {
+		return x.CourseTitle
+	}
+	return ""
+}
+
+func (x *GetClassRosterResponse) GetStudents() []*StudentRosterEntry {
+	if x != nil {
+		return x.Students
+	}
+	return nil
+}
+
+func (x *GetClassRosterResponse) GetTotalStudents() int32 {
+	if x != nil {
+		return x.TotalStudents
+	}
+	return 0
+}
+
+func (x *GetClassRosterResponse) GetWithdrawn() []*StudentRosterEntry {
+	if x != nil {
+		return x.Withdrawn
+	}
+	return nil
+}
+
+func (x *GetClassRosterResponse) GetSummary() *RosterGradeSummary {
+	if x != nil {
+		return x.Summary
+	}
+	return nil
+}
+
+// Grading progress over the students of a roster; withdrawn students are not counted
+type RosterGradeSummary struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Graded        int32                  `protobuf:"varint,1,opt,name=graded,proto3" json:"graded,omitempty"` // students with an uploaded grade
+	Ungraded      int32                  `protobuf:"varint,2,opt,name=ungraded,proto3" json:"ungraded,omitempty"`
+	Published     int32                  `protobuf:"varint,3,opt,name=published,proto3" json:"published,omitempty"` // students whose grade is published
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RosterGradeSummary) Reset() {
+	*x = RosterGradeSummary{}
+	mi := &file_backend_protos_grade_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RosterGradeSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RosterGradeSummary) ProtoMessage() {}
+
+func (x *RosterGradeSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_protos_grade_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RosterGradeSummary.ProtoReflect.Descriptor instead.
+func (*RosterGradeSummary) Descriptor() ([]byte, []int) {
+	return file_backend_protos_grade_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *RosterGradeSummary) GetGraded() int32 {
+	if x != nil {
+		return x.Graded
+	}
+	return 0
+}
+
+func (x *RosterGradeSummary) GetUngraded() int32 {
+	if x != nil {
+		return x.Ungraded
+	}
+	return 0
+}
+
+func (x *RosterGradeSummary) GetPublished() int32 {
+	if x != nil {
+		return x.Published
+	}
+	return 0
+}
+
+type UploadGradesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CourseId      string                 `protobuf:"bytes,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	FacultyId     string                 `protobuf:"bytes,2,opt,name=faculty_id,json=facultyId,proto3" json:"faculty_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadGradesRequest) Reset() {
+	*x = UploadGradesRequest{}
+	mi := &file_backend_protos_grade_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadGradesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadGradesRequest) ProtoMessage() {}
+
+func (x *UploadGradesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_protos_grade_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadGradesRequest.ProtoReflect.Descriptor instead.
+func (*UploadGradesRequest) Descriptor() ([]byte, []int) {
+	return file_backend_protos_grade_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *UploadGradesRequest) GetCourseId() string {
+	if x != nil {
+		return x.CourseId
+	}
+	return ""
+}
+
+func (x *UploadGradesRequest) GetFacultyId() string {
+	if x != nil {
+		return x.FacultyId
+	}
+	return ""
+}
+
+// Server streaming: Gateway sends grade entries one by one
+type UploadGradeEntryRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Payload:
+	//
+	//	*UploadGradeEntryRequest_Metadata
+	//	*UploadGradeEntryRequest_Entry
+	Payload       isUploadGradeEntryRequest_Payload `protobuf_oneof:"payload"`
+	IsLast        bool                              `protobuf:"varint,3,opt,name=is_last,json=isLast,proto3" json:"is_last,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadGradeEntryRequest) Reset() {
+	*x = UploadGradeEntryRequest{}
+	mi := &file_backend_protos_grade_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadGradeEntryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadGradeEntryRequest) ProtoMessage() {}
+
+func (x *UploadGradeEntryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_protos_grade_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadGradeEntryRequest.ProtoReflect.Descriptor instead.
+func (*UploadGradeEntryRequest) Descriptor() ([]byte, []int) {
+	return file_backend_protos_grade_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *UploadGradeEntryRequest) GetPayload() isUploadGradeEntryRequest_Payload {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *UploadGradeEntryRequest) GetMetadata() *UploadMetadata {
+	if x != nil {
+		if x, ok := x.Payload.(*UploadGradeEntryRequest_Metadata); ok {
+			return x.Metadata
+		}
+	}
+	return nil
+}
+
+func (x *UploadGradeEntryRequest) GetEntry() *GradeEntry {
+	if x != nil {
+		if x, ok := x.Payload.(*UploadGradeEntryRequest_Entry); ok {
+			return x.Entry
+		}
+	}
+	return nil
+}
+
+func (x *UploadGradeEntryRequest) GetIsLast() bool {
+	if x != nil {
+		return x.IsLast
+	}
+	return false
+}
+
+type isUploadGradeEntryRequest_Payload interface {
+	isUploadGradeEntryRequest_Payload()
+}
+
+type UploadGradeEntryRequest_Metadata struct {
+	Metadata *UploadMetadata `protobuf:"bytes,1,opt,name=metadata,proto3,oneof"` // First message only
+}
+
+type UploadGradeEntryRequest_Entry struct {
+	Entry *GradeEntry `protobuf:"bytes,2,opt,name=entry,proto3,oneof"` // Subsequent messages
+}
+
+func (*UploadGradeEntryRequest_Metadata) isUploadGradeEntryRequest_Payload() {}
+
+func (*UploadGradeEntryRequest_Entry) isUploadGradeEntryRequest_Payload() {}
+
+type UploadMetadata struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CourseId      string                 `protobuf:"bytes,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	FacultyId     string                 `protobuf:"bytes,2,opt,name=faculty_id,json=facultyId,proto3" json:"faculty_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadMetadata) Reset() {
+	*x = UploadMetadata{}
+	mi := &file_backend_protos_grade_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadMetadata) ProtoMessage() {}
+
+func (x *UploadMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_protos_grade_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadMetadata.ProtoReflect.Descriptor instead.
+func (*UploadMetadata) Descriptor() ([]byte, []int) {
+	return file_backend_protos_grade_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *UploadMetadata) GetCourseId() string {
+	if x != nil {
+		return x.CourseId
+	}
+	return ""
+}
+
+func (x *UploadMetadata) GetFacultyId() string {
+	if x != nil {
+		return x.FacultyId
+	}
+	return ""
+}
+
+type UploadGradesResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Success        bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	TotalProcessed int32                  `protobuf:"varint,2,opt,name=total_processed,json=totalProcessed,proto3" json:"total_processed,omitempty"`
+	Successful     int32                  `protobuf:"varint,3,opt,name=successful,proto3" json:"successful,omitempty"`
+	Failed         int32                  `protobuf:"varint,4,opt,name=failed,proto3" json:"failed,omitempty"`
+	Errors         []string               `protobuf:"bytes,5,rep,name=errors,proto3" json:"errors,omitempty"`
+	Message        string                 `protobuf:"bytes,6,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *UploadGradesResponse) Reset() {
+	*x = UploadGradesResponse{}
+	mi := &file_backend_protos_grade_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadGradesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadGradesResponse) ProtoMessage() {}
+
+func (x *UploadGradesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_protos_grade_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadGradesResponse.ProtoReflect.Descriptor instead.
+func (*UploadGradesResponse) Descriptor() ([]byte, []int) {
+	return file_backend_protos_grade_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *UploadGradesResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *UploadGradesResponse) GetTotalProcessed() int32 {
+	if x != nil {
+		return x.TotalProcessed
+	}
+	return 0
+}
+
+func (x *UploadGradesResponse) GetSuccessful() int32 {
+	if x != nil {
+		return x.Successful
+	}
+	return 0
+}
+
+func (x *UploadGradesResponse) GetFailed() int32 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+func (x *UploadGradesResponse) GetErrors() []string {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+func (x *UploadGradesResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type PublishGradesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CourseId      string                 `protobuf:"bytes,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	FacultyId     string                 `protobuf:"bytes,2,opt,name=faculty_id,json=facultyId,proto3" json:"faculty_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PublishGradesRequest) Reset() {
+	*x = PublishGradesRequest{}
+	mi := &file_backend_protos_grade_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PublishGradesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublishGradesRequest) ProtoMessage() {}
+
+func (x *PublishGradesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_protos_grade_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublishGradesRequest.ProtoReflect.Descriptor instead.
+func (*PublishGradesRequest) Descriptor() ([]byte, []int) {
+	return file_backend_protos_grade_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *PublishGradesRequest) GetCourseId() string {
+	if x != nil {
+		return x.CourseId
+	}
+	return ""
+}
+
+func (x *PublishGradesRequest) GetFacultyId() string {
+	if x != nil {
+		return x.FacultyId
+	}
+	return ""
+}
+
+type PublishGradesResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Success         bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	GradesPublished int32                  `protobuf:"varint,2,opt,name=grades_published,json=gradesPublished,proto3" json:"grades_published,omitempty"`
+	Message         string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *PublishGradesResponse) Reset() {
+	*x = PublishGradesResponse{}
+	mi := &file_backend_protos_grade_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PublishGradesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublishGradesResponse) ProtoMessage() {}
+
+func (x *PublishGradesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_protos_grade_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublishGradesResponse.ProtoReflect.Descriptor instead.
+func (*PublishGradesResponse) Descriptor() ([]byte, []int) {
+	return file_backend_protos_grade_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *PublishGradesResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *PublishGradesResponse) GetGradesPublished() int32 {
+	if x != nil {
+		return x.GradesPublished
+	}
+	return 0
+}
+
+func (x *PublishGradesResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type GetCourseGradesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CourseId      string                 `protobuf:"bytes,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	FacultyId     string                 `protobuf:"bytes,2,opt,name=faculty_id,json=facultyId,proto3" json:"faculty_id,omitempty"` // for authorization
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`                           // 1-based, defaults to 1
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`   // defaults to 100, max 500
+	Published     string                 `protobuf:"bytes,5,opt,name=published,proto3" json:"published,omitempty"`                  // optional filter: "true" or "false"
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCourseGradesRequest) Reset() {
+	*x = GetCourseGradesRequest{}
+	mi := &file_backend_protos_grade_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCourseGradesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCourseGradesRequest) ProtoMessage() {}
+
+func (x *GetCourseGradesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_protos_grade_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCourseGradesRequest.ProtoReflect.Descriptor instead.
+func (*GetCourseGradesRequest) Descriptor() ([]byte, []int) {
+	return file_backend_protos_grade_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *GetCourseGradesRequest) GetCourseId() string {
+	if x != nil {
+		return x.CourseId
+	}
+	return ""
+}
+
+func (x *GetCourseGradesRequest) GetFacultyId() string {
+	if x != nil {
+		return x.FacultyId
+	}
+	return ""
+}
+
+func (x *GetCourseGradesRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetCourseGradesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetCourseGradesRequest) GetPublished() string {
+	if x != nil {
+		return x.Published
+	}
+	return ""
+}
+
+type GetCourseGradesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Grades        []*Grade               `protobuf:"bytes,1,rep,name=grades,proto3" json:"grades,omitempty"`                                  // ordered by student_id
+	TotalGrades   int32                  `protobuf:"varint,2,opt,name=total_grades,json=totalGrades,proto3" json:"total_grades,omitempty"`    // all grades in the course, regardless of filter
+	AllPublished  bool                   `protobuf:"varint,3,opt,name=all_published,json=allPublished,proto3" json:"all_published,omitempty"` // across the whole course, not just this page
+	TotalCount    int32                  `protobuf:"varint,4,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`       // grades matching the filter
+	Page          int32                  `protobuf:"varint,5,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,6,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCourseGradesResponse) Reset() {
+	*x = GetCourseGradesResponse{}
+	mi := &file_backend_protos_grade_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCourseGradesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCourseGradesResponse) ProtoMessage() {}
+
+func (x *GetCourseGradesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_protos_grade_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCourseGradesResponse.ProtoReflect.Descriptor instead.
+func (*GetCourseGradesResponse) Descriptor() ([]byte, []int) {
+	return file_backend_protos_grade_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *GetCourseGradesResponse) GetGrades() []*Grade {
+	if x != nil {
+		return x.Grades
+	}
+	return nil
+}
+
+func (x *GetCourseGradesResponse) GetTotalGrades() int32 {
+	if x != nil {
+		return x.TotalGrades
+	}
+	return 0
+}
+
+func (x *GetCourseGradesResponse) GetAllPublished() bool {
+	if x != nil {
+		return x.AllPublished
+	}
+	return false
+}
+
+func (x *GetCourseGradesResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *GetCourseGradesResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetCourseGradesResponse) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type GradeDraft struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CourseId      string                 `protobuf:"bytes,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	FacultyId     string                 `protobuf:"bytes,2,opt,name=faculty_id,json=facultyId,proto3" json:"faculty_id,omitempty"`
+	Grades        map[string]string      `protobuf:"bytes,3,rep,name=grades,proto3" json:"grades,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // student_id -> grade, not yet validated
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"` // the grade upload deadline, or 30 days without one
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GradeDraft) Reset() {
+	*x = GradeDraft{}
+	mi := &file_backend_protos_grade_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GradeDraft) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GradeDraft) ProtoMessage() {}
+
+func (x *GradeDraft) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_protos_grade_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GradeDraft.ProtoReflect.Descriptor instead.
+func (*GradeDraft) Descriptor() ([]byte, []int) {
+	return file_backend_protos_grade_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *GradeDraft) GetCourseId() string {
+	if x != nil {
+		return x.CourseId
+	}
+	return ""
+}
+
+func (x *GradeDraft) GetFacultyId() string {
+	if x != nil {
+		return x.FacultyId
+	}
+	return ""
+}
+
+func (x *GradeDraft) GetGrades() map[string]string {
+	if x != nil {
+		return x.Grades
+	}
+	return nil
+}
+
+func (x *GradeDraft) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *GradeDraft) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+type SaveGradeDraftRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CourseId      string                 `protobuf:"bytes,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	FacultyId     string                 `protobuf:"bytes,2,opt,name=faculty_id,json=facultyId,proto3" json:"faculty_id,omitempty"`
+	Grades        map[string]string      `protobuf:"bytes,3,rep,name=grades,proto3" json:"grades,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // merged into the draft; an empty grade removes the student
+	Replace       bool                   `protobuf:"varint,4,opt,name=replace,proto3" json:"replace,omitempty"`                                                                        // replace the whole draft instead of merging
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SaveGradeDraftRequest) Reset() {
+	*x = SaveGradeDraftRequest{}
+	mi := &file_backend_protos_grade_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SaveGradeDraftRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveGradeDraftRequest) ProtoMessage() {}
+
+func (x *SaveGradeDraftRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_protos_grade_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveGradeDraftRequest.ProtoReflect.Descriptor instead.
+func (*SaveGradeDraftRequest) Descriptor() ([]byte, []int) {
+	return file_backend_protos_grade_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *SaveGradeDraftRequest) GetCourseId() string {
+	if x != nil {
+		return x.CourseId
+	}
+	return ""
+}
+
+func (x *SaveGradeDraftRequest) GetFacultyId() string {
+	if x != nil {
+		return x.FacultyId
+	}
+	return ""
+}
+
+func (x *SaveGradeDraftRequest) GetGrades() map[string]string {
+	if x != nil {
+		return x.Grades
+	}
+	return nil
+}
+
+func (x *SaveGradeDraftRequest) GetReplace() bool {
+	if x != nil {
+		return x.Replace
+	}
+	return false
+}
+
+type SaveGradeDraftResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Draft         *GradeDraft            `protobuf:"bytes,3,opt,name=draft,proto3" json:"draft,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SaveGradeDraftResponse) Reset() {
+	*x = SaveGradeDraftResponse{}
+	mi := &file_backend_protos_grade_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SaveGradeDraftResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveGradeDraftResponse) ProtoMessage() {}
+
+func (x *SaveGradeDraftResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_protos_grade_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveGradeDraftResponse.ProtoReflect.Descriptor instead.
+func (*SaveGradeDraftResponse) Descriptor() ([]byte, []int) {
+	return file_backend_protos_grade_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *SaveGradeDraftResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *SaveGradeDraftResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *SaveGradeDraftResponse) GetDraft() *GradeDraft {
+	if x != nil {
+		return x.Draft
+	}
+	return nil
+}
+
+type GetGradeDraftRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CourseId      string                 `protobuf:"bytes,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	FacultyId     string                 `protobuf:"bytes,2,opt,name=faculty_id,json=facultyId,proto3" json:"faculty_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGradeDraftRequest) Reset() {
+	*x = GetGradeDraftRequest{}
+	mi := &file_backend_protos_grade_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGradeDraftRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGradeDraftRequest) ProtoMessage() {}
+
+func (x *GetGradeDraftRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_protos_grade_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGradeDraftRequest.ProtoReflect.Descriptor instead.
+func (*GetGradeDraftRequest) Descriptor() ([]byte, []int) {
+	return file_backend_protos_grade_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *GetGradeDraftRequest) GetCourseId() string {
+	if x != nil {
+		return x.CourseId
+	}
+	return ""
+}
+
+func (x *GetGradeDraftRequest) GetFacultyId() string {
+	if x != nil {
+		return x.FacultyId
+	}
+	return ""
+}
+
+type GetGradeDraftResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Found         bool                   `protobuf:"varint,1,opt,name=found,proto3" json:"found,omitempty"`
+	Draft         *GradeDraft            `protobuf:"bytes,2,opt,name=draft,proto3" json:"draft,omitempty"` // empty when no draft is saved
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGradeDraftResponse) Reset() {
+	*x = GetGradeDraftResponse{}
+	mi := &file_backend_protos_grade_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGradeDraftResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGradeDraftResponse) ProtoMessage() {}
+
+func (x *GetGradeDraftResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_protos_grade_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGradeDraftResponse.ProtoReflect.Descriptor instead.
+func (*GetGradeDraftResponse) Descriptor() ([]byte, []int) {
+	return file_backend_protos_grade_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *GetGradeDraftResponse) GetFound() bool {
+	if x != nil {
+		return x.Found
+	}
+	return false
+}
+
+func (x *GetGradeDraftResponse) GetDraft() *GradeDraft {
+	if x != nil {
+		return x.Draft
+	}
+	return nil
+}
+
+type FinalizeDraftRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CourseId      string                 `protobuf:"bytes,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	FacultyId     string                 `protobuf:"bytes,2,opt,name=faculty_id,json=facultyId,proto3" json:"faculty_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FinalizeDraftRequest) Reset() {
+	*x = FinalizeDraftRequest{}
+	mi := &file_backend_protos_grade_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FinalizeDraftRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FinalizeDraftRequest) ProtoMessage() {}
+
+func (x *FinalizeDraftRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_protos_grade_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FinalizeDraftRequest.ProtoReflect.Descriptor instead.
+func (*FinalizeDraftRequest) Descriptor() ([]byte, []int) {
+	return file_backend_protos_grade_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *FinalizeDraftRequest) GetCourseId() string {
+	if x != nil {
+		return x.CourseId
+	}
+	return ""
+}
+
+func (x *FinalizeDraftRequest) GetFacultyId() string {
+	if x != nil {
+		return x.FacultyId
+	}
+	return ""
+}
+
+type GradeEntryError struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StudentId     string                 `protobuf:"bytes,1,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
+	Grade         string                 `protobuf:"bytes,2,opt,name=grade,proto3" json:"grade,omitempty"`
+	Error         string                 `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	ErrorCode     string                 `protobuf:"bytes,4,opt,name=error_code,json=errorCode,proto3" json:"error_code,omitempty"` // machine-readable failure reason (see shared.ErrorCode)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GradeEntryError) Reset() {
+	*x = GradeEntryError{}
+	mi := &file_backend_protos_grade_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GradeEntryError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GradeEntryError) ProtoMessage() {}
+
+func (x *GradeEntryError) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_protos_grade_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GradeEntryError.ProtoReflect.Descriptor instead.
+func (*GradeEntryError) Descriptor() ([]byte, []int) {
+	return file_backend_protos_grade_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *GradeEntryError) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *GradeEntryError) GetGrade() string {
+	if x != nil {
+		return x.Grade
+	}
+	return ""
+}
+
+func (x *GradeEntryError) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *GradeEntryError) GetErrorCode() string {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return ""
+}
+
+type FinalizeDraftResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Success        bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"` // every draft grade was uploaded
+	TotalProcessed int32                  `protobuf:"varint,2,opt,name=total_processed,json=totalProcessed,proto3" json:"total_processed,omitempty"`
+	Successful     int32                  `protobuf:"varint,3,opt,name=successful,proto3" json:"successful,omitempty"`
+	Failed         int32                  `protobuf:"varint,4,opt,name=failed,proto3" json:"failed,omitempty"`
+	Errors         []*GradeEntryError     `protobuf:"bytes,5,rep,name=errors,proto3" json:"errors,omitempty"` // failed grades stay in the draft for correction
+	Message        string                 `protobuf:"bytes,6,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *FinalizeDraftResponse) Reset() {
+	*x = FinalizeDraftResponse{}
+	mi := &file_backend_protos_grade_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FinalizeDraftResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FinalizeDraftResponse) ProtoMessage() {}
+
+func (x *FinalizeDraftResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_protos_grade_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FinalizeDraftResponse.ProtoReflect.Descriptor instead.
+func (*FinalizeDraftResponse) Descriptor() ([]byte, []int) {
+	return file_backend_protos_grade_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *FinalizeDraftResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *FinalizeDraftResponse) GetTotalProcessed() int32 {
+	if x != nil {
+		return x.TotalProcessed
+	}
+	return 0
+}
+
+func (x *FinalizeDraftResponse) GetSuccessful() int32 {
+	if x != nil {
+		return x.Successful
+	}
+	return 0
+}
+
+func (x *FinalizeDraftResponse) GetFailed() int32 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+func (x *FinalizeDraftResponse) GetErrors() []*GradeEntryError {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+func (x *FinalizeDraftResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type GradeAppeal struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	EnrollmentId  string                 `protobuf:"bytes,2,opt,name=enrollment_id,json=enrollmentId,proto3" json:"enrollment_id,omitempty"`
+	StudentId     string                 `protobuf:"bytes,3,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
+	CourseId      string                 `protobuf:"bytes,4,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	CourseCode    string                 `protobuf:"bytes,5,opt,name=course_code,json=courseCode,proto3" json:"course_code,omitempty"`
+	FacultyId     string                 `protobuf:"bytes,6,opt,name=faculty_id,json=facultyId,proto3" json:"faculty_id,omitempty"` // course faculty when the appeal was submitted
+	OriginalGrade string                 `protobuf:"bytes,7,opt,name=original_grade,json=originalGrade,proto3" json:"original_grade,omitempty"`
+	Reason        string                 `protobuf:"bytes,8,opt,name=reason,proto3" json:"reason,omitempty"`
+	Status        string                 `protobuf:"bytes,9,opt,name=status,proto3" json:"status,omitempty"`                      // open, approved, denied
+	NewGrade      string                 `protobuf:"bytes,10,opt,name=new_grade,json=newGrade,proto3" json:"new_grade,omitempty"` // set when approved
+	Comment       string                 `protobuf:"bytes,11,opt,name=comment,proto3" json:"comment,omitempty"`                   // faculty response to the student
+	SubmittedAt   *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=submitted_at,json=submittedAt,proto3" json:"submitted_at,omitempty"`
+	ResolvedBy    string                 `protobuf:"bytes,13,opt,name=resolved_by,json=resolvedBy,proto3" json:"resolved_by,omitempty"`
+	ResolvedAt    *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=resolved_at,json=resolvedAt,proto3" json:"resolved_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GradeAppeal) Reset() {
+	*x = GradeAppeal{}
+	mi := &file_backend_protos_grade_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GradeAppeal) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GradeAppeal) ProtoMessage() {}
+
+func (x *GradeAppeal) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_protos_grade_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GradeAppeal.ProtoReflect.Descriptor instead.
+func (*GradeAppeal) Descriptor() ([]byte, []int) {
+	return file_backend_protos_grade_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *GradeAppeal) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GradeAppeal) GetEnrollmentId() string {
+	if x != nil {
+		return x.EnrollmentId
+	}
+	return ""
+}
+
+func (x *GradeAppeal) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *GradeAppeal) GetCourseId() string {
+	if x != nil {
+		return x.CourseId
+	}
+	return ""
+}
+
+func (x *GradeAppeal) GetCourseCode() string {
+	if x != nil {
+		return x.CourseCode
+	}
+	return ""
+}
+
+func (x *GradeAppeal) GetFacultyId() string {
+	if x != nil {
+		return x.FacultyId
+	}
+	return ""
+}
+
+func (x *GradeAppeal) GetOriginalGrade() string {
+	if x != nil {
+		return x.OriginalGrade
+	}
+	return ""
+}
+
+func (x *GradeAppeal) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *GradeAppeal) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *GradeAppeal) GetNewGrade() string {
+	if x != nil {
+		return x.NewGrade
+	}
+	return ""
+}
+
+func (x *GradeAppeal) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *GradeAppeal) GetSubmittedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SubmittedAt
+	}
+	return nil
+}
+
+func (x *GradeAppeal) GetResolvedBy() string {
+	if x != nil {
+		return x.ResolvedBy
+	}
+	return ""
+}
+
+func (x *GradeAppeal) GetResolvedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ResolvedAt
+	}
+	return nil
+}
+
+type SubmitGradeAppealRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EnrollmentId  string                 `protobuf:"bytes,1,opt,name=enrollment_id,json=enrollmentId,proto3" json:"enrollment_id,omitempty"`
+	StudentId     string                 `protobuf:"bytes,2,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"` // must own the enrollment
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`                        // max 1000 characters
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitGradeAppealRequest) Reset() {
+	*x = SubmitGradeAppealRequest{}
+	mi := &file_backend_protos_grade_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitGradeAppealRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitGradeAppealRequest) ProtoMessage() {}
+
+func (x *SubmitGradeAppealRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_protos_grade_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitGradeAppealRequest.ProtoReflect.Descriptor instead.
+func (*SubmitGradeAppealRequest) Descriptor() ([]byte, []int) {
+	return file_backend_protos_grade_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *SubmitGradeAppealRequest) GetEnrollmentId() string {
+	if x != nil {
+		return x.EnrollmentId
+	}
+	return ""
+}
+
+func (x *SubmitGradeAppealRequest) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *SubmitGradeAppealRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type SubmitGradeAppealResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Appeal        *GradeAppeal           `protobuf:"bytes,3,opt,name=appeal,proto3" json:"appeal,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitGradeAppealResponse) Reset() {
+	*x = SubmitGradeAppealResponse{}
+	mi := &file_backend_protos_grade_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitGradeAppealResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitGradeAppealResponse) ProtoMessage() {}
+
+func (x *SubmitGradeAppealResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_protos_grade_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitGradeAppealResponse.ProtoReflect.Descriptor instead.
+func (*SubmitGradeAppealResponse) Descriptor() ([]byte, []int) {
+	return file_backend_protos_grade_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *SubmitGradeAppealResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *SubmitGradeAppealResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *SubmitGradeAppealResponse) GetAppeal() *GradeAppeal {
+	if x != nil {
+		return x.Appeal
+	}
+	return nil
+}
+
+type ListGradeAppealsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// At least one of faculty_id, course_id or student_id is required
+	FacultyId     string `protobuf:"bytes,1,opt,name=faculty_id,json=facultyId,proto3" json:"faculty_id,omitempty"`
+	CourseId      string `protobuf:"bytes,2,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	StudentId     string `protobuf:"bytes,3,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
+	Status        string `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"` // optional filter: open, approved, denied
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListGradeAppealsRequest) Reset() {
+	*x = ListGradeAppealsRequest{}
+	mi := &file_backend_protos_grade_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListGradeAppealsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGradeAppealsRequest) ProtoMessage() {}
+
+func (x *ListGradeAppealsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_protos_grade_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGradeAppealsRequest.ProtoReflect.Descriptor instead.
+func (*ListGradeAppealsRequest) Descriptor() ([]byte, []int) {
+	return file_backend_protos_grade_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ListGradeAppealsRequest) GetFacultyId() string {
+	if x != nil {
+		return x.FacultyId
+	}
+	return ""
+}
+
+func (x *ListGradeAppealsRequest) GetCourseId() string {
+	if x != nil {
+		return x.CourseId
+	}
+	return ""
+}
+
+func (x *ListGradeAppealsRequest) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *ListGradeAppealsRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type ListGradeAppealsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Appeals       []*GradeAppeal         `protobuf:"bytes,1,rep,name=appeals,proto3" json:"appeals,omitempty"` // newest first
+	TotalCount    int32                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListGradeAppealsResponse) Reset() {
+	*x = ListGradeAppealsResponse{}
+	mi := &file_backend_protos_grade_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListGradeAppealsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGradeAppealsResponse) ProtoMessage() {}
+
+func (x *ListGradeAppealsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_protos_grade_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGradeAppealsResponse.ProtoReflect.Descriptor instead.
+func (*ListGradeAppealsResponse) Descriptor() ([]byte, []int) {
+	return file_backend_protos_grade_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *ListGradeAppealsResponse) GetAppeals() []*GradeAppeal {
+	if x != nil {
+		return x.Appeals
+	}
+	return nil
+}
+
+func (x *ListGradeAppealsResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+type ResolveGradeAppealRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AppealId      string                 `protobuf:"bytes,1,opt,name=appeal_id,json=appealId,proto3" json:"appeal_id,omitempty"`
+	FacultyId     string                 `protobuf:"bytes,2,opt,name=faculty_id,json=facultyId,proto3" json:"faculty_id,omitempty"` // must teach the course
+	Decision      string                 `protobuf:"bytes,3,opt,name=decision,proto3" json:"decision,omitempty"`                    // "approved" or "denied"
+	NewGrade      string                 `protobuf:"bytes,4,opt,name=new_grade,json=newGrade,proto3" json:"new_grade,omitempty"`    // required when approved, must differ from the current grade
+	Comment       string                 `protobuf:"bytes,5,opt,name=comment,proto3" json:"comment,omitempty"`                      // optional response to the student, max 1000 characters
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResolveGradeAppealRequest) Reset() {
+	*x = ResolveGradeAppealRequest{}
+	mi := &file_backend_protos_grade_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveGradeAppealRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveGradeAppealRequest) ProtoMessage() {}
+
+func (x *ResolveGradeAppealRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_protos_grade_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveGradeAppealRequest.ProtoReflect.Descriptor instead.
+func (*ResolveGradeAppealRequest) Descriptor() ([]byte, []int) {
+	return file_backend_protos_grade_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ResolveGradeAppealRequest) GetAppealId() string {
+	if x != nil {
+		return x.AppealId
+	}
+	return ""
+}
+
+func (x *ResolveGradeAppealRequest) GetFacultyId() string {
+	if x != nil {
+		return x.FacultyId
+	}
+	return ""
+}
+
+func (x *ResolveGradeAppealRequest) GetDecision() string {
+	if x != nil {
+		return x.Decision
+	}
+	return ""
+}
+
+func (x *ResolveGradeAppealRequest) GetNewGrade() string {
+	if x != nil {
+		return x.NewGrade
+	}
+	return ""
+}
+
+func (x *ResolveGradeAppealRequest) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+type ResolveGradeAppealResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Appeal        *GradeAppeal           `protobuf:"bytes,3,opt,name=appeal,proto3" json:"appeal,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResolveGradeAppealResponse) Reset() {
+	*x = ResolveGradeAppealResponse{}
+	mi := &file_backend_protos_grade_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveGradeAppealResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveGradeAppealResponse) ProtoMessage() {}
+
+func (x *ResolveGradeAppealResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_protos_grade_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveGradeAppealResponse.ProtoReflect.Descriptor instead.
+func (*ResolveGradeAppealResponse) Descriptor() ([]byte, []int) {
+	return file_backend_protos_grade_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ResolveGradeAppealResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ResolveGradeAppealResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ResolveGradeAppealResponse) GetAppeal() *GradeAppeal {
+	if x != nil {
+		return x.Appeal
+	}
+	return nil
+}
+
+type GetDeansListRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Semester      string                 `protobuf:"bytes,1,opt,name=semester,proto3" json:"semester,omitempty"`
+	MinGpa        float64                `protobuf:"fixed64,2,opt,name=min_gpa,json=minGpa,proto3" json:"min_gpa,omitempty"`      // optional, defaults to 3.5
+	MinUnits      int32                  `protobuf:"varint,3,opt,name=min_units,json=minUnits,proto3" json:"min_units,omitempty"` // optional, defaults to 12
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDeansListRequest) Reset() {
+	*x = GetDeansListRequest{}
+	mi := &file_backend_protos_grade_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDeansListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDeansListRequest) ProtoMessage() {}
+
+func (x *GetDeansListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_protos_grade_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDeansListRequest.ProtoReflect.Descriptor instead.
+func (*GetDeansListRequest) Descriptor() ([]byte, []int) {
+	return file_backend_protos_grade_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *GetDeansListRequest) GetSemester() string {
+	if x != nil {
+		return x.Semester
+	}
+	return ""
+}
+
+func (x *GetDeansListRequest) GetMinGpa() float64 {
+	if x != nil {
+		return x.MinGpa
+	}
+	return 0
+}
+
+func (x *GetDeansListRequest) GetMinUnits() int32 {
+	if x != nil {
+		return x.MinUnits
+	}
+	return 0
+}
+
+type GetDeansListResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Semester      string                 `protobuf:"bytes,1,opt,name=semester,proto3" json:"semester,omitempty"`
+	MinGpa        float64                `protobuf:"fixed64,2,opt,name=min_gpa,json=minGpa,proto3" json:"min_gpa,omitempty"`      // threshold actually applied
+	MinUnits      int32                  `protobuf:"varint,3,opt,name=min_units,json=minUnits,proto3" json:"min_units,omitempty"` // threshold actually applied
+	Students      []*DeansListEntry      `protobuf:"bytes,4,rep,name=students,proto3" json:"students,omitempty"`                  // sorted by GPA descending
+	TotalStudents int32                  `protobuf:"varint,5,opt,name=total_students,json=totalStudents,proto3" json:"total_students,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDeansListResponse) Reset() {
+	*x = GetDeansListResponse{}
+	mi := &file_backend_protos_grade_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDeansListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDeansListResponse) ProtoMessage() {}
+
+func (x *GetDeansListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_protos_grade_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDeansListResponse.ProtoReflect.Descriptor instead.
+func (*GetDeansListResponse) Descriptor() ([]byte, []int) {
+	return file_backend_protos_grade_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *GetDeansListResponse) GetSemester() string {
+	if x != nil {
+		return x.Semester
+	}
+	return ""
+}
+
+func (x *GetDeansListResponse) GetMinGpa() float64 {
+	if x != nil {
+		return x.MinGpa
+	}
+	return 0
+}
+
+func (x *GetDeansListResponse) GetMinUnits() int32 {
+	if x != nil {
+		return x.MinUnits
+	}
+	return 0
+}
+
+func (x *GetDeansListResponse) GetStudents() []*DeansListEntry {
+	if x != nil {
+		return x.Students
+	}
+	return nil
+}
+
+func (x *GetDeansListResponse) GetTotalStudents() int32 {
+	if x != nil {
+		return x.TotalStudents
+	}
+	return 0
+}
+
+// Published grade statistics for one group of grades
+type GradeStats struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TotalGrades   int32                  `protobuf:"varint,1,opt,name=total_grades,json=totalGrades,proto3" json:"total_grades,omitempty"`
+	Distribution  map[string]int32       `protobuf:"bytes,2,rep,name=distribution,proto3" json:"distribution,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"` // letter grade -> count
+	AverageGpa    float64                `protobuf:"fixed64,3,opt,name=average_gpa,json=averageGpa,proto3" json:"average_gpa,omitempty"`                                                            // unit-weighted, excluding I and W
+	Passed        int32                  `protobuf:"varint,4,opt,name=passed,proto3" json:"passed,omitempty"`                                                                                       // A through D
+	Failed        int32                  `protobuf:"varint,5,opt,name=failed,proto3" json:"failed,omitempty"`                                                                                       // F
+	PassRate      float64                `protobuf:"fixed64,6,opt,name=pass_rate,json=passRate,proto3" json:"pass_rate,omitempty"`                                                                  // passed / (passed + failed), 0 when nothing is graded
+	FailRate      float64                `protobuf:"fixed64,7,opt,name=fail_rate,json=failRate,proto3" json:"fail_rate,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GradeStats) Reset() {
+	*x = GradeStats{}
+	mi := &file_backend_protos_grade_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GradeStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GradeStats) ProtoMessage() {}
+
+func (x *GradeStats) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_protos_grade_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GradeStats.ProtoReflect.Descriptor instead.
+func (*GradeStats) Descriptor() ([]byte, []int) {
+	return file_backend_protos_grade_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *GradeStats) GetTotalGrades() int32 {
+	if x != nil {
+		return x.TotalGrades
+	}
+	return 0
+}
+
+func (x *GradeStats) GetDistribution() map[string]int32 {
+	if x != nil {
+		return x.Distribution
+	}
+	return nil
+}
+
+func (x *GradeStats) GetAverageGpa() float64 {
+	if x != nil {
+		return x.AverageGpa
+	}
+	return 0
+}
+
+func (x *GradeStats) GetPassed() int32 {
+	if x != nil {
+		return x.Passed
+	}
+	return 0
+}
+
+func (x *GradeStats) GetFailed() int32 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+func (x *GradeStats) GetPassRate() float64 {
+	if x != nil {
+		return x.PassRate
+	}
+	return 0
+}
+
+func (x *GradeStats) GetFailRate() float64 {
+	if x != nil {
+		return x.FailRate
+	}
+	return 0
+}
 
-type UploadMetadata struct {
+type CourseGradeReport struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	CourseId      string                 `protobuf:"bytes,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
-	FacultyId     string                 `protobuf:"bytes,2,opt,name=faculty_id,json=facultyId,proto3" json:"faculty_id,omitempty"`
+	CourseCode    string                 `protobuf:"bytes,2,opt,name=course_code,json=courseCode,proto3" json:"course_code,omitempty"`
+	CourseTitle   string                 `protobuf:"bytes,3,opt,name=course_title,json=courseTitle,proto3" json:"course_title,omitempty"`
+	Department    string                 `protobuf:"bytes,4,opt,name=department,proto3" json:"department,omitempty"` // department of the course's faculty, empty if unassigned
+	Stats         *GradeStats            `protobuf:"bytes,5,opt,name=stats,proto3" json:"stats,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UploadMetadata) Reset() {
-	*x = UploadMetadata{}
-	mi := &file_backend_protos_grade_proto_msgTypes[13]
+func (x *CourseGradeReport) Reset() {
+	*x = CourseGradeReport{}
+	mi := &file_backend_protos_grade_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UploadMetadata) String() string {
+func (x *CourseGradeReport) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UploadMetadata) ProtoMessage() {}
+func (*CourseGradeReport) ProtoMessage() {}
 
-func (x *UploadMetadata) ProtoReflect() protoreflect.Message {
-	mi := &file_backend_protos_grade_proto_msgTypes[13]
+func (x *CourseGradeReport) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_protos_grade_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -964,52 +3170,70 @@ func (x *UploadMetadata) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UploadMetadata.ProtoReflect.Descriptor instead.
-func (*UploadMetadata) Descriptor() ([]byte, []int) {
-	return file_backend_protos_grade_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use CourseGradeReport.ProtoReflect.Descriptor instead.
+func (*CourseGradeReport) Descriptor() ([]byte, []int) {
+	return file_backend_protos_grade_proto_rawDescGZIP(), []int{45}
 }
 
-func (x *UploadMetadata) GetCourseId() string {
+func (x *CourseGradeReport) GetCourseId() string {
 	if x != nil {
 		return x.CourseId
 	}
 	return ""
 }
 
-func (x *UploadMetadata) GetFacultyId() string {
+func (x *CourseGradeReport) GetCourseCode() string {
 	if x != nil {
-		return x.FacultyId
+		return x.CourseCode
 	}
 	return ""
 }
 
-type UploadGradesResponse struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Success        bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	TotalProcessed int32                  `protobuf:"varint,2,opt,name=total_processed,json=totalProcessed,proto3" json:"total_processed,omitempty"`
-	Successful     int32                  `protobuf:"varint,3,opt,name=successful,proto3" json:"successful,omitempty"`
-	Failed         int32                  `protobuf:"varint,4,opt,name=failed,proto3" json:"failed,omitempty"`
-	Errors         []string               `protobuf:"bytes,5,rep,name=errors,proto3" json:"errors,omitempty"`
-	Message        string                 `protobuf:"bytes,6,opt,name=message,proto3" json:"message,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+func (x *CourseGradeReport) GetCourseTitle() string {
+	if x != nil {
+		return x.CourseTitle
+	}
+	return ""
 }
 
-func (x *UploadGradesResponse) Reset() {
-	*x = UploadGradesResponse{}
-	mi := &file_backend_protos_grade_proto_msgTypes[14]
+func (x *CourseGradeReport) GetDepartment() string {
+	if x != nil {
+		return x.Department
+	}
+	return ""
+}
+
+func (x *CourseGradeReport) GetStats() *GradeStats {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+type DepartmentGradeReport struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Department    string                 `protobuf:"bytes,1,opt,name=department,proto3" json:"department,omitempty"` // empty groups courses without a faculty department
+	CourseCount   int32                  `protobuf:"varint,2,opt,name=course_count,json=courseCount,proto3" json:"course_count,omitempty"`
+	Stats         *GradeStats            `protobuf:"bytes,3,opt,name=stats,proto3" json:"stats,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DepartmentGradeReport) Reset() {
+	*x = DepartmentGradeReport{}
+	mi := &file_backend_protos_grade_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UploadGradesResponse) String() string {
+func (x *DepartmentGradeReport) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UploadGradesResponse) ProtoMessage() {}
+func (*DepartmentGradeReport) ProtoMessage() {}
 
-func (x *UploadGradesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_backend_protos_grade_proto_msgTypes[14]
+func (x *DepartmentGradeReport) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_protos_grade_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1020,76 +3244,101 @@ func (x *UploadGradesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UploadGradesResponse.ProtoReflect.Descriptor instead.
-func (*UploadGradesResponse) Descriptor() ([]byte, []int) {
-	return file_backend_protos_grade_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use DepartmentGradeReport.ProtoReflect.Descriptor instead.
+func (*DepartmentGradeReport) Descriptor() ([]byte, []int) {
+	return file_backend_protos_grade_proto_rawDescGZIP(), []int{46}
 }
 
-func (x *UploadGradesResponse) GetSuccess() bool {
+func (x *DepartmentGradeReport) GetDepartment() string {
 	if x != nil {
-		return x.Success
+		return x.Department
 	}
-	return false
+	return ""
 }
 
-func (x *UploadGradesResponse) GetTotalProcessed() int32 {
+func (x *DepartmentGradeReport) GetCourseCount() int32 {
 	if x != nil {
-		return x.TotalProcessed
+		return x.CourseCount
 	}
 	return 0
 }
 
-func (x *UploadGradesResponse) GetSuccessful() int32 {
+func (x *DepartmentGradeReport) GetStats() *GradeStats {
 	if x != nil {
-		return x.Successful
+		return x.Stats
 	}
-	return 0
+	return nil
 }
 
-func (x *UploadGradesResponse) GetFailed() int32 {
-	if x != nil {
-		return x.Failed
-	}
-	return 0
+type GetSemesterGradeReportRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Semester      string                 `protobuf:"bytes,1,opt,name=semester,proto3" json:"semester,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UploadGradesResponse) GetErrors() []string {
+func (x *GetSemesterGradeReportRequest) Reset() {
+	*x = GetSemesterGradeReportRequest{}
+	mi := &file_backend_protos_grade_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSemesterGradeReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSemesterGradeReportRequest) ProtoMessage() {}
+
+func (x *GetSemesterGradeReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_protos_grade_proto_msgTypes[47]
 	if x != nil {
-		return x.Errors
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *UploadGradesResponse) GetMessage() string {
+// Deprecated: Use GetSemesterGradeReportRequest.ProtoReflect.Descriptor instead.
+func (*GetSemesterGradeReportRequest) Descriptor() ([]byte, []int) {
+	return file_backend_protos_grade_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *GetSemesterGradeReportRequest) GetSemester() string {
 	if x != nil {
-		return x.Message
+		return x.Semester
 	}
 	return ""
 }
 
-type PublishGradesRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	CourseId      string                 `protobuf:"bytes,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
-	FacultyId     string                 `protobuf:"bytes,2,opt,name=faculty_id,json=facultyId,proto3" json:"faculty_id,omitempty"`
+type GetSemesterGradeReportResponse struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Semester      string                   `protobuf:"bytes,1,opt,name=semester,proto3" json:"semester,omitempty"`
+	Overall       *GradeStats              `protobuf:"bytes,2,opt,name=overall,proto3" json:"overall,omitempty"`
+	Departments   []*DepartmentGradeReport `protobuf:"bytes,3,rep,name=departments,proto3" json:"departments,omitempty"` // sorted by department
+	Courses       []*CourseGradeReport     `protobuf:"bytes,4,rep,name=courses,proto3" json:"courses,omitempty"`         // sorted by course code
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PublishGradesRequest) Reset() {
-	*x = PublishGradesRequest{}
-	mi := &file_backend_protos_grade_proto_msgTypes[15]
+func (x *GetSemesterGradeReportResponse) Reset() {
+	*x = GetSemesterGradeReportResponse{}
+	mi := &file_backend_protos_grade_proto_msgTypes[48]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PublishGradesRequest) String() string {
+func (x *GetSemesterGradeReportResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PublishGradesRequest) ProtoMessage() {}
+func (*GetSemesterGradeReportResponse) ProtoMessage() {}
 
-func (x *PublishGradesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_backend_protos_grade_proto_msgTypes[15]
+func (x *GetSemesterGradeReportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_protos_grade_proto_msgTypes[48]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1100,49 +3349,63 @@ func (x *PublishGradesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PublishGradesRequest.ProtoReflect.Descriptor instead.
-func (*PublishGradesRequest) Descriptor() ([]byte, []int) {
-	return file_backend_protos_grade_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use GetSemesterGradeReportResponse.ProtoReflect.Descriptor instead.
+func (*GetSemesterGradeReportResponse) Descriptor() ([]byte, []int) {
+	return file_backend_protos_grade_proto_rawDescGZIP(), []int{48}
 }
 
-func (x *PublishGradesRequest) GetCourseId() string {
+func (x *GetSemesterGradeReportResponse) GetSemester() string {
 	if x != nil {
-		return x.CourseId
+		return x.Semester
 	}
 	return ""
 }
 
-func (x *PublishGradesRequest) GetFacultyId() string {
+func (x *GetSemesterGradeReportResponse) GetOverall() *GradeStats {
 	if x != nil {
-		return x.FacultyId
+		return x.Overall
 	}
-	return ""
+	return nil
 }
 
-type PublishGradesResponse struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	Success         bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	GradesPublished int32                  `protobuf:"varint,2,opt,name=grades_published,json=gradesPublished,proto3" json:"grades_published,omitempty"`
-	Message         string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+func (x *GetSemesterGradeReportResponse) GetDepartments() []*DepartmentGradeReport {
+	if x != nil {
+		return x.Departments
+	}
+	return nil
 }
 
-func (x *PublishGradesResponse) Reset() {
-	*x = PublishGradesResponse{}
-	mi := &file_backend_protos_grade_proto_msgTypes[16]
+func (x *GetSemesterGradeReportResponse) GetCourses() []*CourseGradeReport {
+	if x != nil {
+		return x.Courses
+	}
+	return nil
+}
+
+type ExportGradesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Semester      string                 `protobuf:"bytes,1,opt,name=semester,proto3" json:"semester,omitempty"`
+	PublishedOnly bool                   `protobuf:"varint,2,opt,name=published_only,json=publishedOnly,proto3" json:"published_only,omitempty"`
+	AdminId       string                 `protobuf:"bytes,3,opt,name=admin_id,json=adminId,proto3" json:"admin_id,omitempty"` // must be an admin
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportGradesRequest) Reset() {
+	*x = ExportGradesRequest{}
+	mi := &file_backend_protos_grade_proto_msgTypes[49]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PublishGradesResponse) String() string {
+func (x *ExportGradesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PublishGradesResponse) ProtoMessage() {}
+func (*ExportGradesRequest) ProtoMessage() {}
 
-func (x *PublishGradesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_backend_protos_grade_proto_msgTypes[16]
+func (x *ExportGradesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_protos_grade_proto_msgTypes[49]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1153,55 +3416,56 @@ func (x *PublishGradesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PublishGradesResponse.ProtoReflect.Descriptor instead.
-func (*PublishGradesResponse) Descriptor() ([]byte, []int) {
-	return file_backend_protos_grade_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use ExportGradesRequest.ProtoReflect.Descriptor instead.
+func (*ExportGradesRequest) Descriptor() ([]byte, []int) {
+	return file_backend_protos_grade_proto_rawDescGZIP(), []int{49}
 }
 
-func (x *PublishGradesResponse) GetSuccess() bool {
+func (x *ExportGradesRequest) GetSemester() string {
 	if x != nil {
-		return x.Success
+		return x.Semester
 	}
-	return false
+	return ""
 }
 
-func (x *PublishGradesResponse) GetGradesPublished() int32 {
+func (x *ExportGradesRequest) GetPublishedOnly() bool {
 	if x != nil {
-		return x.GradesPublished
+		return x.PublishedOnly
 	}
-	return 0
+	return false
 }
 
-func (x *PublishGradesResponse) GetMessage() string {
+func (x *ExportGradesRequest) GetAdminId() string {
 	if x != nil {
-		return x.Message
+		return x.AdminId
 	}
 	return ""
 }
 
-type GetCourseGradesRequest struct {
+// One message of the ExportGrades stream; only the last one carries the summary
+type ExportGradesChunk struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	CourseId      string                 `protobuf:"bytes,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
-	FacultyId     string                 `protobuf:"bytes,2,opt,name=faculty_id,json=facultyId,proto3" json:"faculty_id,omitempty"` // for authorization
+	Grades        []*Grade               `protobuf:"bytes,1,rep,name=grades,proto3" json:"grades,omitempty"` // sorted by course code, then student
+	Summary       *ExportGradesSummary   `protobuf:"bytes,2,opt,name=summary,proto3" json:"summary,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetCourseGradesRequest) Reset() {
-	*x = GetCourseGradesRequest{}
-	mi := &file_backend_protos_grade_proto_msgTypes[17]
+func (x *ExportGradesChunk) Reset() {
+	*x = ExportGradesChunk{}
+	mi := &file_backend_protos_grade_proto_msgTypes[50]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCourseGradesRequest) String() string {
+func (x *ExportGradesChunk) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCourseGradesRequest) ProtoMessage() {}
+func (*ExportGradesChunk) ProtoMessage() {}
 
-func (x *GetCourseGradesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_backend_protos_grade_proto_msgTypes[17]
+func (x *ExportGradesChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_protos_grade_proto_msgTypes[50]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1212,49 +3476,48 @@ func (x *GetCourseGradesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCourseGradesRequest.ProtoReflect.Descriptor instead.
-func (*GetCourseGradesRequest) Descriptor() ([]byte, []int) {
-	return file_backend_protos_grade_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use ExportGradesChunk.ProtoReflect.Descriptor instead.
+func (*ExportGradesChunk) Descriptor() ([]byte, []int) {
+	return file_backend_protos_grade_proto_rawDescGZIP(), []int{50}
 }
 
-func (x *GetCourseGradesRequest) GetCourseId() string {
+func (x *ExportGradesChunk) GetGrades() []*Grade {
 	if x != nil {
-		return x.CourseId
+		return x.Grades
 	}
-	return ""
+	return nil
 }
 
-func (x *GetCourseGradesRequest) GetFacultyId() string {
+func (x *ExportGradesChunk) GetSummary() *ExportGradesSummary {
 	if x != nil {
-		return x.FacultyId
+		return x.Summary
 	}
-	return ""
+	return nil
 }
 
-type GetCourseGradesResponse struct {
+type ExportGradesSummary struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Grades        []*Grade               `protobuf:"bytes,1,rep,name=grades,proto3" json:"grades,omitempty"`
-	TotalGrades   int32                  `protobuf:"varint,2,opt,name=total_grades,json=totalGrades,proto3" json:"total_grades,omitempty"`
-	AllPublished  bool                   `protobuf:"varint,3,opt,name=all_published,json=allPublished,proto3" json:"all_published,omitempty"`
+	Semester      string                 `protobuf:"bytes,1,opt,name=semester,proto3" json:"semester,omitempty"`
+	TotalRows     int32                  `protobuf:"varint,2,opt,name=total_rows,json=totalRows,proto3" json:"total_rows,omitempty"` // grades sent across all chunks
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetCourseGradesResponse) Reset() {
-	*x = GetCourseGradesResponse{}
-	mi := &file_backend_protos_grade_proto_msgTypes[18]
+func (x *ExportGradesSummary) Reset() {
+	*x = ExportGradesSummary{}
+	mi := &file_backend_protos_grade_proto_msgTypes[51]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCourseGradesResponse) String() string {
+func (x *ExportGradesSummary) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCourseGradesResponse) ProtoMessage() {}
+func (*ExportGradesSummary) ProtoMessage() {}
 
-func (x *GetCourseGradesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_backend_protos_grade_proto_msgTypes[18]
+func (x *ExportGradesSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_protos_grade_proto_msgTypes[51]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1265,32 +3528,25 @@ func (x *GetCourseGradesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCourseGradesResponse.ProtoReflect.Descriptor instead.
-func (*GetCourseGradesResponse) Descriptor() ([]byte, []int) {
-	return file_backend_protos_grade_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use ExportGradesSummary.ProtoReflect.Descriptor instead.
+func (*ExportGradesSummary) Descriptor() ([]byte, []int) {
+	return file_backend_protos_grade_proto_rawDescGZIP(), []int{51}
 }
 
-func (x *GetCourseGradesResponse) GetGrades() []*Grade {
+func (x *ExportGradesSummary) GetSemester() string {
 	if x != nil {
-		return x.Grades
+		return x.Semester
 	}
-	return nil
+	return ""
 }
 
-func (x *GetCourseGradesResponse) GetTotalGrades() int32 {
+func (x *ExportGradesSummary) GetTotalRows() int32 {
 	if x != nil {
-		return x.TotalGrades
+		return x.TotalRows
 	}
 	return 0
 }
 
-func (x *GetCourseGradesResponse) GetAllPublished() bool {
-	if x != nil {
-		return x.AllPublished
-	}
-	return false
-}
-
 var File_backend_protos_grade_proto protoreflect.FileDescriptor
 
 const file_backend_protos_grade_proto_rawDesc = "" +
@@ -1335,19 +3591,45 @@ const file_backend_protos_grade_proto_rawDesc = "" +
 	"\x05major\x18\x04 \x01(\tR\x05major\x12\x1d\n" +
 	"\n" +
 	"year_level\x18\x05 \x01(\x05R\tyearLevel\x12\x14\n" +
-	"\x05grade\x18\x06 \x01(\tR\x05grade\"A\n" +
+	"\x05grade\x18\x06 \x01(\tR\x05grade\"\xdf\x01\n" +
+	"\x0eDeansListEntry\x12\x1d\n" +
+	"\n" +
+	"student_id\x18\x01 \x01(\tR\tstudentId\x12!\n" +
+	"\fstudent_name\x18\x02 \x01(\tR\vstudentName\x12\x14\n" +
+	"\x05major\x18\x03 \x01(\tR\x05major\x12\x1d\n" +
+	"\n" +
+	"year_level\x18\x04 \x01(\x05R\tyearLevel\x12\x10\n" +
+	"\x03gpa\x18\x05 \x01(\x01R\x03gpa\x12\x1f\n" +
+	"\vtotal_units\x18\x06 \x01(\x05R\n" +
+	"totalUnits\x12#\n" +
+	"\rcourses_count\x18\a \x01(\x05R\fcoursesCount\"A\n" +
 	"\n" +
 	"GradeEntry\x12\x1d\n" +
 	"\n" +
 	"student_id\x18\x01 \x01(\tR\tstudentId\x12\x14\n" +
-	"\x05grade\x18\x02 \x01(\tR\x05grade\"T\n" +
+	"\x05grade\x18\x02 \x01(\tR\x05grade\"\x85\x01\n" +
 	"\x17GetStudentGradesRequest\x12\x1d\n" +
 	"\n" +
 	"student_id\x18\x01 \x01(\tR\tstudentId\x12\x1a\n" +
-	"\bsemester\x18\x02 \x01(\tR\bsemester\"r\n" +
+	"\bsemester\x18\x02 \x01(\tR\bsemester\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"\xc4\x01\n" +
 	"\x18GetStudentGradesResponse\x12$\n" +
 	"\x06grades\x18\x01 \x03(\v2\f.grade.GradeR\x06grades\x120\n" +
-	"\bgpa_info\x18\x02 \x01(\v2\x15.grade.GPACalculationR\agpaInfo\"P\n" +
+	"\bgpa_info\x18\x02 \x01(\v2\x15.grade.GPACalculationR\agpaInfo\x12\x1f\n" +
+	"\vtotal_count\x18\x03 \x01(\x05R\n" +
+	"totalCount\x12\x12\n" +
+	"\x04page\x18\x04 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x05 \x01(\x05R\bpageSize\";\n" +
+	"\x1aGetStudentSemestersRequest\x12\x1d\n" +
+	"\n" +
+	"student_id\x18\x01 \x01(\tR\tstudentId\"N\n" +
+	"\x0fStudentSemester\x12\x1a\n" +
+	"\bsemester\x18\x01 \x01(\tR\bsemester\x12\x1f\n" +
+	"\vgrade_count\x18\x02 \x01(\x05R\n" +
+	"gradeCount\"S\n" +
+	"\x1bGetStudentSemestersResponse\x124\n" +
+	"\tsemesters\x18\x01 \x03(\v2\x16.grade.StudentSemesterR\tsemesters\"P\n" +
 	"\x13CalculateGPARequest\x12\x1d\n" +
 	"\n" +
 	"student_id\x18\x01 \x01(\tR\tstudentId\x12\x1a\n" +
@@ -1355,16 +3637,36 @@ const file_backend_protos_grade_proto_rawDesc = "" +
 	"\x14CalculateGPAResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x120\n" +
 	"\bgpa_info\x18\x02 \x01(\v2\x15.grade.GPACalculationR\agpaInfo\x12\x18\n" +
-	"\amessage\x18\x03 \x01(\tR\amessage\"4\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\"F\n" +
+	"\x11HypotheticalGrade\x12\x1b\n" +
+	"\tcourse_id\x18\x01 \x01(\tR\bcourseId\x12\x14\n" +
+	"\x05grade\x18\x02 \x01(\tR\x05grade\"e\n" +
+	"\x12SimulateGPARequest\x12\x1d\n" +
+	"\n" +
+	"student_id\x18\x01 \x01(\tR\tstudentId\x120\n" +
+	"\x06grades\x18\x02 \x03(\v2\x18.grade.HypotheticalGradeR\x06grades\"\x8f\x01\n" +
+	"\x13SimulateGPAResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x120\n" +
+	"\bgpa_info\x18\x02 \x01(\v2\x15.grade.GPACalculationR\agpaInfo\x12\x12\n" +
+	"\x04term\x18\x03 \x01(\tR\x04term\x12\x18\n" +
+	"\amessage\x18\x04 \x01(\tR\amessage\"S\n" +
 	"\x15GetClassRosterRequest\x12\x1b\n" +
-	"\tcourse_id\x18\x01 \x01(\tR\bcourseId\"\xd7\x01\n" +
+	"\tcourse_id\x18\x01 \x01(\tR\bcourseId\x12\x1d\n" +
+	"\n" +
+	"faculty_id\x18\x02 \x01(\tR\tfacultyId\"\xc5\x02\n" +
 	"\x16GetClassRosterResponse\x12\x1b\n" +
 	"\tcourse_id\x18\x01 \x01(\tR\bcourseId\x12\x1f\n" +
 	"\vcourse_code\x18\x02 \x01(\tR\n" +
 	"courseCode\x12!\n" +
 	"\fcourse_title\x18\x03 \x01(\tR\vcourseTitle\x125\n" +
 	"\bstudents\x18\x04 \x03(\v2\x19.grade.StudentRosterEntryR\bstudents\x12%\n" +
-	"\x0etotal_students\x18\x05 \x01(\x05R\rtotalStudents\"Q\n" +
+	"\x0etotal_students\x18\x05 \x01(\x05R\rtotalStudents\x127\n" +
+	"\twithdrawn\x18\x06 \x03(\v2\x19.grade.StudentRosterEntryR\twithdrawn\x123\n" +
+	"\asummary\x18\a \x01(\v2\x19.grade.RosterGradeSummaryR\asummary\"f\n" +
+	"\x12RosterGradeSummary\x12\x16\n" +
+	"\x06graded\x18\x01 \x01(\x05R\x06graded\x12\x1a\n" +
+	"\bungraded\x18\x02 \x01(\x05R\bungraded\x12\x1c\n" +
+	"\tpublished\x18\x03 \x01(\x05R\tpublished\"Q\n" +
 	"\x13UploadGradesRequest\x12\x1b\n" +
 	"\tcourse_id\x18\x01 \x01(\tR\bcourseId\x12\x1d\n" +
 	"\n" +
@@ -1394,22 +3696,202 @@ const file_backend_protos_grade_proto_rawDesc = "" +
 	"\x15PublishGradesResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12)\n" +
 	"\x10grades_published\x18\x02 \x01(\x05R\x0fgradesPublished\x12\x18\n" +
-	"\amessage\x18\x03 \x01(\tR\amessage\"T\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\"\xa3\x01\n" +
 	"\x16GetCourseGradesRequest\x12\x1b\n" +
 	"\tcourse_id\x18\x01 \x01(\tR\bcourseId\x12\x1d\n" +
 	"\n" +
-	"faculty_id\x18\x02 \x01(\tR\tfacultyId\"\x87\x01\n" +
+	"faculty_id\x18\x02 \x01(\tR\tfacultyId\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\x12\x1c\n" +
+	"\tpublished\x18\x05 \x01(\tR\tpublished\"\xd9\x01\n" +
 	"\x17GetCourseGradesResponse\x12$\n" +
 	"\x06grades\x18\x01 \x03(\v2\f.grade.GradeR\x06grades\x12!\n" +
 	"\ftotal_grades\x18\x02 \x01(\x05R\vtotalGrades\x12#\n" +
-	"\rall_published\x18\x03 \x01(\bR\fallPublished2\xe8\x03\n" +
+	"\rall_published\x18\x03 \x01(\bR\fallPublished\x12\x1f\n" +
+	"\vtotal_count\x18\x04 \x01(\x05R\n" +
+	"totalCount\x12\x12\n" +
+	"\x04page\x18\x05 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x06 \x01(\x05R\bpageSize\"\xb0\x02\n" +
+	"\n" +
+	"GradeDraft\x12\x1b\n" +
+	"\tcourse_id\x18\x01 \x01(\tR\bcourseId\x12\x1d\n" +
+	"\n" +
+	"faculty_id\x18\x02 \x01(\tR\tfacultyId\x125\n" +
+	"\x06grades\x18\x03 \x03(\v2\x1d.grade.GradeDraft.GradesEntryR\x06grades\x129\n" +
+	"\n" +
+	"updated_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x129\n" +
+	"\n" +
+	"expires_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x1a9\n" +
+	"\vGradesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xea\x01\n" +
+	"\x15SaveGradeDraftRequest\x12\x1b\n" +
+	"\tcourse_id\x18\x01 \x01(\tR\bcourseId\x12\x1d\n" +
+	"\n" +
+	"faculty_id\x18\x02 \x01(\tR\tfacultyId\x12@\n" +
+	"\x06grades\x18\x03 \x03(\v2(.grade.SaveGradeDraftRequest.GradesEntryR\x06grades\x12\x18\n" +
+	"\areplace\x18\x04 \x01(\bR\areplace\x1a9\n" +
+	"\vGradesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"u\n" +
+	"\x16SaveGradeDraftResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12'\n" +
+	"\x05draft\x18\x03 \x01(\v2\x11.grade.GradeDraftR\x05draft\"R\n" +
+	"\x14GetGradeDraftRequest\x12\x1b\n" +
+	"\tcourse_id\x18\x01 \x01(\tR\bcourseId\x12\x1d\n" +
+	"\n" +
+	"faculty_id\x18\x02 \x01(\tR\tfacultyId\"V\n" +
+	"\x15GetGradeDraftResponse\x12\x14\n" +
+	"\x05found\x18\x01 \x01(\bR\x05found\x12'\n" +
+	"\x05draft\x18\x02 \x01(\v2\x11.grade.GradeDraftR\x05draft\"R\n" +
+	"\x14FinalizeDraftRequest\x12\x1b\n" +
+	"\tcourse_id\x18\x01 \x01(\tR\bcourseId\x12\x1d\n" +
+	"\n" +
+	"faculty_id\x18\x02 \x01(\tR\tfacultyId\"{\n" +
+	"\x0fGradeEntryError\x12\x1d\n" +
+	"\n" +
+	"student_id\x18\x01 \x01(\tR\tstudentId\x12\x14\n" +
+	"\x05grade\x18\x02 \x01(\tR\x05grade\x12\x14\n" +
+	"\x05error\x18\x03 \x01(\tR\x05error\x12\x1d\n" +
+	"\n" +
+	"error_code\x18\x04 \x01(\tR\terrorCode\"\xdc\x01\n" +
+	"\x15FinalizeDraftResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12'\n" +
+	"\x0ftotal_processed\x18\x02 \x01(\x05R\x0etotalProcessed\x12\x1e\n" +
+	"\n" +
+	"successful\x18\x03 \x01(\x05R\n" +
+	"successful\x12\x16\n" +
+	"\x06failed\x18\x04 \x01(\x05R\x06failed\x12.\n" +
+	"\x06errors\x18\x05 \x03(\v2\x16.grade.GradeEntryErrorR\x06errors\x12\x18\n" +
+	"\amessage\x18\x06 \x01(\tR\amessage\"\xe9\x03\n" +
+	"\vGradeAppeal\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12#\n" +
+	"\renrollment_id\x18\x02 \x01(\tR\fenrollmentId\x12\x1d\n" +
+	"\n" +
+	"student_id\x18\x03 \x01(\tR\tstudentId\x12\x1b\n" +
+	"\tcourse_id\x18\x04 \x01(\tR\bcourseId\x12\x1f\n" +
+	"\vcourse_code\x18\x05 \x01(\tR\n" +
+	"courseCode\x12\x1d\n" +
+	"\n" +
+	"faculty_id\x18\x06 \x01(\tR\tfacultyId\x12%\n" +
+	"\x0eoriginal_grade\x18\a \x01(\tR\roriginalGrade\x12\x16\n" +
+	"\x06reason\x18\b \x01(\tR\x06reason\x12\x16\n" +
+	"\x06status\x18\t \x01(\tR\x06status\x12\x1b\n" +
+	"\tnew_grade\x18\n" +
+	" \x01(\tR\bnewGrade\x12\x18\n" +
+	"\acomment\x18\v \x01(\tR\acomment\x12=\n" +
+	"\fsubmitted_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\vsubmittedAt\x12\x1f\n" +
+	"\vresolved_by\x18\r \x01(\tR\n" +
+	"resolvedBy\x12;\n" +
+	"\vresolved_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"resolvedAt\"v\n" +
+	"\x18SubmitGradeAppealRequest\x12#\n" +
+	"\renrollment_id\x18\x01 \x01(\tR\fenrollmentId\x12\x1d\n" +
+	"\n" +
+	"student_id\x18\x02 \x01(\tR\tstudentId\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"{\n" +
+	"\x19SubmitGradeAppealResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12*\n" +
+	"\x06appeal\x18\x03 \x01(\v2\x12.grade.GradeAppealR\x06appeal\"\x8c\x01\n" +
+	"\x17ListGradeAppealsRequest\x12\x1d\n" +
+	"\n" +
+	"faculty_id\x18\x01 \x01(\tR\tfacultyId\x12\x1b\n" +
+	"\tcourse_id\x18\x02 \x01(\tR\bcourseId\x12\x1d\n" +
+	"\n" +
+	"student_id\x18\x03 \x01(\tR\tstudentId\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\"i\n" +
+	"\x18ListGradeAppealsResponse\x12,\n" +
+	"\aappeals\x18\x01 \x03(\v2\x12.grade.GradeAppealR\aappeals\x12\x1f\n" +
+	"\vtotal_count\x18\x02 \x01(\x05R\n" +
+	"totalCount\"\xaa\x01\n" +
+	"\x19ResolveGradeAppealRequest\x12\x1b\n" +
+	"\tappeal_id\x18\x01 \x01(\tR\bappealId\x12\x1d\n" +
+	"\n" +
+	"faculty_id\x18\x02 \x01(\tR\tfacultyId\x12\x1a\n" +
+	"\bdecision\x18\x03 \x01(\tR\bdecision\x12\x1b\n" +
+	"\tnew_grade\x18\x04 \x01(\tR\bnewGrade\x12\x18\n" +
+	"\acomment\x18\x05 \x01(\tR\acomment\"|\n" +
+	"\x1aResolveGradeAppealResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12*\n" +
+	"\x06appeal\x18\x03 \x01(\v2\x12.grade.GradeAppealR\x06appeal\"g\n" +
+	"\x13GetDeansListRequest\x12\x1a\n" +
+	"\bsemester\x18\x01 \x01(\tR\bsemester\x12\x17\n" +
+	"\amin_gpa\x18\x02 \x01(\x01R\x06minGpa\x12\x1b\n" +
+	"\tmin_units\x18\x03 \x01(\x05R\bminUnits\"\xc2\x01\n" +
+	"\x14GetDeansListResponse\x12\x1a\n" +
+	"\bsemester\x18\x01 \x01(\tR\bsemester\x12\x17\n" +
+	"\amin_gpa\x18\x02 \x01(\x01R\x06minGpa\x12\x1b\n" +
+	"\tmin_units\x18\x03 \x01(\x05R\bminUnits\x121\n" +
+	"\bstudents\x18\x04 \x03(\v2\x15.grade.DeansListEntryR\bstudents\x12%\n" +
+	"\x0etotal_students\x18\x05 \x01(\x05R\rtotalStudents\"\xc4\x02\n" +
+	"\n" +
+	"GradeStats\x12!\n" +
+	"\ftotal_grades\x18\x01 \x01(\x05R\vtotalGrades\x12G\n" +
+	"\fdistribution\x18\x02 \x03(\v2#.grade.GradeStats.DistributionEntryR\fdistribution\x12\x1f\n" +
+	"\vaverage_gpa\x18\x03 \x01(\x01R\n" +
+	"averageGpa\x12\x16\n" +
+	"\x06passed\x18\x04 \x01(\x05R\x06passed\x12\x16\n" +
+	"\x06failed\x18\x05 \x01(\x05R\x06failed\x12\x1b\n" +
+	"\tpass_rate\x18\x06 \x01(\x01R\bpassRate\x12\x1b\n" +
+	"\tfail_rate\x18\a \x01(\x01R\bfailRate\x1a?\n" +
+	"\x11DistributionEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01\"\xbd\x01\n" +
+	"\x11CourseGradeReport\x12\x1b\n" +
+	"\tcourse_id\x18\x01 \x01(\tR\bcourseId\x12\x1f\n" +
+	"\vcourse_code\x18\x02 \x01(\tR\n" +
+	"courseCode\x12!\n" +
+	"\fcourse_title\x18\x03 \x01(\tR\vcourseTitle\x12\x1e\n" +
+	"\n" +
+	"department\x18\x04 \x01(\tR\n" +
+	"department\x12'\n" +
+	"\x05stats\x18\x05 \x01(\v2\x11.grade.GradeStatsR\x05stats\"\x83\x01\n" +
+	"\x15DepartmentGradeReport\x12\x1e\n" +
+	"\n" +
+	"department\x18\x01 \x01(\tR\n" +
+	"department\x12!\n" +
+	"\fcourse_count\x18\x02 \x01(\x05R\vcourseCount\x12'\n" +
+	"\x05stats\x18\x03 \x01(\v2\x11.grade.GradeStatsR\x05stats\";\n" +
+	"\x1dGetSemesterGradeReportRequest\x12\x1a\n" +
+	"\bsemester\x18\x01 \x01(\tR\bsemester\"\xdd\x01\n" +
+	"\x1eGetSemesterGradeReportResponse\x12\x1a\n" +
+	"\bsemester\x18\x01 \x01(\tR\bsemester\x12+\n" +
+	"\aoverall\x18\x02 \x01(\v2\x11.grade.GradeStatsR\aoverall\x12>\n" +
+	"\vdepartments\x18\x03 \x03(\v2\x1c.grade.DepartmentGradeReportR\vdepartments\x122\n" +
+	"\acourses\x18\x04 \x03(\v2\x18.grade.CourseGradeReportR\acourses\"s\n" +
+	"\x13ExportGradesRequest\x12\x1a\n" +
+	"\bsemester\x18\x01 \x01(\tR\bsemester\x12%\n" +
+	"\x0epublished_only\x18\x02 \x01(\bR\rpublishedOnly\x12\x19\n" +
+	"\badmin_id\x18\x03 \x01(\tR\aadminId\"o\n" +
+	"\x11ExportGradesChunk\x12$\n" +
+	"\x06grades\x18\x01 \x03(\v2\f.grade.GradeR\x06grades\x124\n" +
+	"\asummary\x18\x02 \x01(\v2\x1a.grade.ExportGradesSummaryR\asummary\"P\n" +
+	"\x13ExportGradesSummary\x12\x1a\n" +
+	"\bsemester\x18\x01 \x01(\tR\bsemester\x12\x1d\n" +
+	"\n" +
+	"total_rows\x18\x02 \x01(\x05R\ttotalRows2\xf3\n" +
+	"\n" +
 	"\fGradeService\x12S\n" +
-	"\x10GetStudentGrades\x12\x1e.grade.GetStudentGradesRequest\x1a\x1f.grade.GetStudentGradesResponse\x12G\n" +
-	"\fCalculateGPA\x12\x1a.grade.CalculateGPARequest\x1a\x1b.grade.CalculateGPAResponse\x12M\n" +
+	"\x10GetStudentGrades\x12\x1e.grade.GetStudentGradesRequest\x1a\x1f.grade.GetStudentGradesResponse\x12\\\n" +
+	"\x13GetStudentSemesters\x12!.grade.GetStudentSemestersRequest\x1a\".grade.GetStudentSemestersResponse\x12G\n" +
+	"\fCalculateGPA\x12\x1a.grade.CalculateGPARequest\x1a\x1b.grade.CalculateGPAResponse\x12D\n" +
+	"\vSimulateGPA\x12\x19.grade.SimulateGPARequest\x1a\x1a.grade.SimulateGPAResponse\x12M\n" +
 	"\x0eGetClassRoster\x12\x1c.grade.GetClassRosterRequest\x1a\x1d.grade.GetClassRosterResponse\x12M\n" +
 	"\fUploadGrades\x12\x1e.grade.UploadGradeEntryRequest\x1a\x1b.grade.UploadGradesResponse(\x01\x12J\n" +
 	"\rPublishGrades\x12\x1b.grade.PublishGradesRequest\x1a\x1c.grade.PublishGradesResponse\x12P\n" +
-	"\x0fGetCourseGrades\x12\x1d.grade.GetCourseGradesRequest\x1a\x1e.grade.GetCourseGradesResponseB\x12Z\x10backend/pb/gradeb\x06proto3"
+	"\x0fGetCourseGrades\x12\x1d.grade.GetCourseGradesRequest\x1a\x1e.grade.GetCourseGradesResponse\x12G\n" +
+	"\fGetDeansList\x12\x1a.grade.GetDeansListRequest\x1a\x1b.grade.GetDeansListResponse\x12e\n" +
+	"\x16GetSemesterGradeReport\x12$.grade.GetSemesterGradeReportRequest\x1a%.grade.GetSemesterGradeReportResponse\x12F\n" +
+	"\fExportGrades\x12\x1a.grade.ExportGradesRequest\x1a\x18.grade.ExportGradesChunk0\x01\x12M\n" +
+	"\x0eSaveGradeDraft\x12\x1c.grade.SaveGradeDraftRequest\x1a\x1d.grade.SaveGradeDraftResponse\x12J\n" +
+	"\rGetGradeDraft\x12\x1b.grade.GetGradeDraftRequest\x1a\x1c.grade.GetGradeDraftResponse\x12J\n" +
+	"\rFinalizeDraft\x12\x1b.grade.FinalizeDraftRequest\x1a\x1c.grade.FinalizeDraftResponse\x12V\n" +
+	"\x11SubmitGradeAppeal\x12\x1f.grade.SubmitGradeAppealRequest\x1a .grade.SubmitGradeAppealResponse\x12S\n" +
+	"\x10ListGradeAppeals\x12\x1e.grade.ListGradeAppealsRequest\x1a\x1f.grade.ListGradeAppealsResponse\x12Y\n" +
+	"\x12ResolveGradeAppeal\x12 .grade.ResolveGradeAppealRequest\x1a!.grade.ResolveGradeAppealResponseB\x12Z\x10backend/pb/gradeb\x06proto3"
 
 var (
 	file_backend_protos_grade_proto_rawDescOnce sync.Once
@@ -1423,57 +3905,141 @@ func file_backend_protos_grade_proto_rawDescGZIP() []byte {
 	return file_backend_protos_grade_proto_rawDescData
 }
 
-var file_backend_protos_grade_proto_msgTypes = make([]protoimpl.MessageInfo, 19)
+var file_backend_protos_grade_proto_msgTypes = make([]protoimpl.MessageInfo, 55)
 var file_backend_protos_grade_proto_goTypes = []any{
-	(*Grade)(nil),                    // 0: grade.Grade
-	(*GPACalculation)(nil),           // 1: grade.GPACalculation
-	(*SemesterGPA)(nil),              // 2: grade.SemesterGPA
-	(*StudentRosterEntry)(nil),       // 3: grade.StudentRosterEntry
-	(*GradeEntry)(nil),               // 4: grade.GradeEntry
-	(*GetStudentGradesRequest)(nil),  // 5: grade.GetStudentGradesRequest
-	(*GetStudentGradesResponse)(nil), // 6: grade.GetStudentGradesResponse
-	(*CalculateGPARequest)(nil),      // 7: grade.CalculateGPARequest
-	(*CalculateGPAResponse)(nil),     // 8: grade.CalculateGPAResponse
-	(*GetClassRosterRequest)(nil),    // 9: grade.GetClassRosterRequest
-	(*GetClassRosterResponse)(nil),   // 10: grade.GetClassRosterResponse
-	(*UploadGradesRequest)(nil),      // 11: grade.UploadGradesRequest
-	(*UploadGradeEntryRequest)(nil),  // 12: grade.UploadGradeEntryRequest
-	(*UploadMetadata)(nil),           // 13: grade.UploadMetadata
-	(*UploadGradesResponse)(nil),     // 14: grade.UploadGradesResponse
-	(*PublishGradesRequest)(nil),     // 15: grade.PublishGradesRequest
-	(*PublishGradesResponse)(nil),    // 16: grade.PublishGradesResponse
-	(*GetCourseGradesRequest)(nil),   // 17: grade.GetCourseGradesRequest
-	(*GetCourseGradesResponse)(nil),  // 18: grade.GetCourseGradesResponse
-	(*timestamppb.Timestamp)(nil),    // 19: google.protobuf.Timestamp
+	(*Grade)(nil),                          // 0: grade.Grade
+	(*GPACalculation)(nil),                 // 1: grade.GPACalculation
+	(*SemesterGPA)(nil),                    // 2: grade.SemesterGPA
+	(*StudentRosterEntry)(nil),             // 3: grade.StudentRosterEntry
+	(*DeansListEntry)(nil),                 // 4: grade.DeansListEntry
+	(*GradeEntry)(nil),                     // 5: grade.GradeEntry
+	(*GetStudentGradesRequest)(nil),        // 6: grade.GetStudentGradesRequest
+	(*GetStudentGradesResponse)(nil),       // 7: grade.GetStudentGradesResponse
+	(*GetStudentSemestersRequest)(nil),     // 8: grade.GetStudentSemestersRequest
+	(*StudentSemester)(nil),                // 9: grade.StudentSemester
+	(*GetStudentSemestersResponse)(nil),    // 10: grade.GetStudentSemestersResponse
+	(*CalculateGPARequest)(nil),            // 11: grade.CalculateGPARequest
+	(*CalculateGPAResponse)(nil),           // 12: grade.CalculateGPAResponse
+	(*HypotheticalGrade)(nil),              // 13: grade.HypotheticalGrade
+	(*SimulateGPARequest)(nil),             // 14: grade.SimulateGPARequest
+	(*SimulateGPAResponse)(nil),            // 15: grade.SimulateGPAResponse
+	(*GetClassRosterRequest)(nil),          // 16: grade.GetClassRosterRequest
+	(*GetClassRosterResponse)(nil),         // 17: grade.GetClassRosterResponse
+	(*RosterGradeSummary)(nil),             // 18: grade.RosterGradeSummary
+	(*UploadGradesRequest)(nil),            // 19: grade.UploadGradesRequest
+	(*UploadGradeEntryRequest)(nil),        // 20: grade.UploadGradeEntryRequest
+	(*UploadMetadata)(nil),                 // 21: grade.UploadMetadata
+	(*UploadGradesResponse)(nil),           // 22: grade.UploadGradesResponse
+	(*PublishGradesRequest)(nil),           // 23: grade.PublishGradesRequest
+	(*PublishGradesResponse)(nil),          // 24: grade.PublishGradesResponse
+	(*GetCourseGradesRequest)(nil),         // 25: grade.GetCourseGradesRequest
+	(*GetCourseGradesResponse)(nil),        // 26: grade.GetCourseGradesResponse
+	(*GradeDraft)(nil),                     // 27: grade.GradeDraft
+	(*SaveGradeDraftRequest)(nil),          // 28: grade.SaveGradeDraftRequest
+	(*SaveGradeDraftResponse)(nil),         // 29: grade.SaveGradeDraftResponse
+	(*GetGradeDraftRequest)(nil),           // 30: grade.GetGradeDraftRequest
+	(*GetGradeDraftResponse)(nil),          // 31: grade.GetGradeDraftResponse
+	(*FinalizeDraftRequest)(nil),           // 32: grade.FinalizeDraftRequest
+	(*GradeEntryError)(nil),                // 33: grade.GradeEntryError
+	(*FinalizeDraftResponse)(nil),          // 34: grade.FinalizeDraftResponse
+	(*GradeAppeal)(nil),                    // 35: grade.GradeAppeal
+	(*SubmitGradeAppealRequest)(nil),       // 36: grade.SubmitGradeAppealRequest
+	(*SubmitGradeAppealResponse)(nil),      // 37: grade.SubmitGradeAppealResponse
+	(*ListGradeAppealsRequest)(nil),        // 38: grade.ListGradeAppealsRequest
+	(*ListGradeAppealsResponse)(nil),       // 39: grade.ListGradeAppealsResponse
+	(*ResolveGradeAppealRequest)(nil),      // 40: grade.ResolveGradeAppealRequest
+	(*ResolveGradeAppealResponse)(nil),     // 41: grade.ResolveGradeAppealResponse
+	(*GetDeansListRequest)(nil),            // 42: grade.GetDeansListRequest
+	(*GetDeansListResponse)(nil),           // 43: grade.GetDeansListResponse
+	(*GradeStats)(nil),                     // 44: grade.GradeStats
+	(*CourseGradeReport)(nil),              // 45: grade.CourseGradeReport
+	(*DepartmentGradeReport)(nil),          // 46: grade.DepartmentGradeReport
+	(*GetSemesterGradeReportRequest)(nil),  // 47: grade.GetSemesterGradeReportRequest
+	(*GetSemesterGradeReportResponse)(nil), // 48: grade.GetSemesterGradeReportResponse
+	(*ExportGradesRequest)(nil),            // 49: grade.ExportGradesRequest
+	(*ExportGradesChunk)(nil),              // 50: grade.ExportGradesChunk
+	(*ExportGradesSummary)(nil),            // 51: grade.ExportGradesSummary
+	nil,                                    // 52: grade.GradeDraft.GradesEntry
+	nil,                                    // 53: grade.SaveGradeDraftRequest.GradesEntry
+	nil,                                    // 54: grade.GradeStats.DistributionEntry
+	(*timestamppb.Timestamp)(nil),          // 55: google.protobuf.Timestamp
 }
 var file_backend_protos_grade_proto_depIdxs = []int32{
-	19, // 0: grade.Grade.uploaded_at:type_name -> google.protobuf.Timestamp
-	19, // 1: grade.Grade.published_at:type_name -> google.protobuf.Timestamp
+	55, // 0: grade.Grade.uploaded_at:type_name -> google.protobuf.Timestamp
+	55, // 1: grade.Grade.published_at:type_name -> google.protobuf.Timestamp
 	2,  // 2: grade.GPACalculation.semester_breakdown:type_name -> grade.SemesterGPA
 	0,  // 3: grade.GetStudentGradesResponse.grades:type_name -> grade.Grade
 	1,  // 4: grade.GetStudentGradesResponse.gpa_info:type_name -> grade.GPACalculation
-	1,  // 5: grade.CalculateGPAResponse.gpa_info:type_name -> grade.GPACalculation
-	3,  // 6: grade.GetClassRosterResponse.students:type_name -> grade.StudentRosterEntry
-	13, // 7: grade.UploadGradeEntryRequest.metadata:type_name -> grade.UploadMetadata
-	4,  // 8: grade.UploadGradeEntryRequest.entry:type_name -> grade.GradeEntry
-	0,  // 9: grade.GetCourseGradesResponse.grades:type_name -> grade.Grade
-	5,  // 10: grade.GradeService.GetStudentGrades:input_type -> grade.GetStudentGradesRequest
-	7,  // 11: grade.GradeService.CalculateGPA:input_type -> grade.CalculateGPARequest
-	9,  // 12: grade.GradeService.GetClassRoster:input_type -> grade.GetClassRosterRequest
-	12, // 13: grade.GradeService.UploadGrades:input_type -> grade.UploadGradeEntryRequest
-	15, // 14: grade.GradeService.PublishGrades:input_type -> grade.PublishGradesRequest
-	17, // 15: grade.GradeService.GetCourseGrades:input_type -> grade.GetCourseGradesRequest
-	6,  // 16: grade.GradeService.GetStudentGrades:output_type -> grade.GetStudentGradesResponse
-	8,  // 17: grade.GradeService.CalculateGPA:output_type -> grade.CalculateGPAResponse
-	10, // 18: grade.GradeService.GetClassRoster:output_type -> grade.GetClassRosterResponse
-	14, // 19: grade.GradeService.UploadGrades:output_type -> grade.UploadGradesResponse
-	16, // 20: grade.GradeService.PublishGrades:output_type -> grade.PublishGradesResponse
-	18, // 21: grade.GradeService.GetCourseGrades:output_type -> grade.GetCourseGradesResponse
-	16, // [16:22] is the sub-list for method output_type
-	10, // [10:16] is the sub-list for method input_type
-	10, // [10:10] is the sub-list for extension type_name
-	10, // [10:10] is the sub-list for extension extendee
-	0,  // [0:10] is the sub-list for field type_name
+	9,  // 5: grade.GetStudentSemestersResponse.semesters:type_name -> grade.StudentSemester
+	1,  // 6: grade.CalculateGPAResponse.gpa_info:type_name -> grade.GPACalculation
+	13, // 7: grade.SimulateGPARequest.grades:type_name -> grade.HypotheticalGrade
+	1,  // 8: grade.SimulateGPAResponse.gpa_info:type_name -> grade.GPACalculation
+	3,  // 9: grade.GetClassRosterResponse.students:type_name -> grade.StudentRosterEntry
+	3,  // 10: grade.GetClassRosterResponse.withdrawn:type_name -> grade.StudentRosterEntry
+	18, // 11: grade.GetClassRosterResponse.summary:type_name -> grade.RosterGradeSummary
+	21, // 12: grade.UploadGradeEntryRequest.metadata:type_name -> grade.UploadMetadata
+	5,  // 13: grade.UploadGradeEntryRequest.entry:type_name -> grade.GradeEntry
+	0,  // 14: grade.GetCourseGradesResponse.grades:type_name -> grade.Grade
+	52, // 15: grade.GradeDraft.grades:type_name -> grade.GradeDraft.GradesEntry
+	55, // 16: grade.GradeDraft.updated_at:type_name -> google.protobuf.Timestamp
+	55, // 17: grade.GradeDraft.expires_at:type_name -> google.protobuf.Timestamp
+	53, // 18: grade.SaveGradeDraftRequest.grades:type_name -> grade.SaveGradeDraftRequest.GradesEntry
+	27, // 19: grade.SaveGradeDraftResponse.draft:type_name -> grade.GradeDraft
+	27, // 20: grade.GetGradeDraftResponse.draft:type_name -> grade.GradeDraft
+	33, // 21: grade.FinalizeDraftResponse.errors:type_name -> grade.GradeEntryError
+	55, // 22: grade.GradeAppeal.submitted_at:type_name -> google.protobuf.Timestamp
+	55, // 23: grade.GradeAppeal.resolved_at:type_name -> google.protobuf.Timestamp
+	35, // 24: grade.SubmitGradeAppealResponse.appeal:type_name -> grade.GradeAppeal
+	35, // 25: grade.ListGradeAppealsResponse.appeals:type_name -> grade.GradeAppeal
+	35, // 26: grade.ResolveGradeAppealResponse.appeal:type_name -> grade.GradeAppeal
+	4,  // 27: grade.GetDeansListResponse.students:type_name -> grade.DeansListEntry
+	54, // 28: grade.GradeStats.distribution:type_name -> grade.GradeStats.DistributionEntry
+	44, // 29: grade.CourseGradeReport.stats:type_name -> grade.GradeStats
+	44, // 30: grade.DepartmentGradeReport.stats:type_name -> grade.GradeStats
+	44, // 31: grade.GetSemesterGradeReportResponse.overall:type_name -> grade.GradeStats
+	46, // 32: grade.GetSemesterGradeReportResponse.departments:type_name -> grade.DepartmentGradeReport
+	45, // 33: grade.GetSemesterGradeReportResponse.courses:type_name -> grade.CourseGradeReport
+	0,  // 34: grade.ExportGradesChunk.grades:type_name -> grade.Grade
+	51, // 35: grade.ExportGradesChunk.summary:type_name -> grade.ExportGradesSummary
+	6,  // 36: grade.GradeService.GetStudentGrades:input_type -> grade.GetStudentGradesRequest
+	8,  // 37: grade.GradeService.GetStudentSemesters:input_type -> grade.GetStudentSemestersRequest
+	11, // 38: grade.GradeService.CalculateGPA:input_type -> grade.CalculateGPARequest
+	14, // 39: grade.GradeService.SimulateGPA:input_type -> grade.SimulateGPARequest
+	16, // 40: grade.GradeService.GetClassRoster:input_type -> grade.GetClassRosterRequest
+	20, // 41: grade.GradeService.UploadGrades:input_type -> grade.UploadGradeEntryRequest
+	23, // 42: grade.GradeService.PublishGrades:input_type -> grade.PublishGradesRequest
+	25, // 43: grade.GradeService.GetCourseGrades:input_type -> grade.GetCourseGradesRequest
+	42, // 44: grade.GradeService.GetDeansList:input_type -> grade.GetDeansListRequest
+	47, // 45: grade.GradeService.GetSemesterGradeReport:input_type -> grade.GetSemesterGradeReportRequest
+	49, // 46: grade.GradeService.ExportGrades:input_type -> grade.ExportGradesRequest
+	28, // 47: grade.GradeService.SaveGradeDraft:input_type -> grade.SaveGradeDraftRequest
+	30, // 48: grade.GradeService.GetGradeDraft:input_type -> grade.GetGradeDraftRequest
+	32, // 49: grade.GradeService.FinalizeDraft:input_type -> grade.FinalizeDraftRequest
+	36, // 50: grade.GradeService.SubmitGradeAppeal:input_type -> grade.SubmitGradeAppealRequest
+	38, // 51: grade.GradeService.ListGradeAppeals:input_type -> grade.ListGradeAppealsRequest
+	40, // 52: grade.GradeService.ResolveGradeAppeal:input_type -> grade.ResolveGradeAppealRequest
+	7,  // 53: grade.GradeService.GetStudentGrades:output_type -> grade.GetStudentGradesResponse
+	10, // 54: grade.GradeService.GetStudentSemesters:output_type -> grade.GetStudentSemestersResponse
+	12, // 55: grade.GradeService.CalculateGPA:output_type -> grade.CalculateGPAResponse
+	15, // 56: grade.GradeService.SimulateGPA:output_type -> grade.SimulateGPAResponse
+	17, // 57: grade.GradeService.GetClassRoster:output_type -> grade.GetClassRosterResponse
+	22, // 58: grade.GradeService.UploadGrades:output_type -> grade.UploadGradesResponse
+	24, // 59: grade.GradeService.PublishGrades:output_type -> grade.PublishGradesResponse
+	26, // 60: grade.GradeService.GetCourseGrades:output_type -> grade.GetCourseGradesResponse
+	43, // 61: grade.GradeService.GetDeansList:output_type -> grade.GetDeansListResponse
+	48, // 62: grade.GradeService.GetSemesterGradeReport:output_type -> grade.GetSemesterGradeReportResponse
+	50, // 63: grade.GradeService.ExportGrades:output_type -> grade.ExportGradesChunk
+	29, // 64: grade.GradeService.SaveGradeDraft:output_type -> grade.SaveGradeDraftResponse
+	31, // 65: grade.GradeService.GetGradeDraft:output_type -> grade.GetGradeDraftResponse
+	34, // 66: grade.GradeService.FinalizeDraft:output_type -> grade.FinalizeDraftResponse
+	37, // 67: grade.GradeService.SubmitGradeAppeal:output_type -> grade.SubmitGradeAppealResponse
+	39, // 68: grade.GradeService.ListGradeAppeals:output_type -> grade.ListGradeAppealsResponse
+	41, // 69: grade.GradeService.ResolveGradeAppeal:output_type -> grade.ResolveGradeAppealResponse
+	53, // [53:70] is the sub-list for method output_type
+	36, // [36:53] is the sub-list for method input_type
+	36, // [36:36] is the sub-list for extension type_name
+	36, // [36:36] is the sub-list for extension extendee
+	0,  // [0:36] is the sub-list for field type_name
 }
 
 func init() { file_backend_protos_grade_proto_init() }
@@ -1481,7 +4047,7 @@ func file_backend_protos_grade_proto_init() {
 	if File_backend_protos_grade_proto != nil {
 		return
 	}
-	file_backend_protos_grade_proto_msgTypes[12].OneofWrappers = []any{
+	file_backend_protos_grade_proto_msgTypes[20].OneofWrappers = []any{
 		(*UploadGradeEntryRequest_Metadata)(nil),
 		(*UploadGradeEntryRequest_Entry)(nil),
 	}
@@ -1491,7 +4057,7 @@ func file_backend_protos_grade_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_backend_protos_grade_proto_rawDesc), len(file_backend_protos_grade_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   19,
+			NumMessages:   55,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
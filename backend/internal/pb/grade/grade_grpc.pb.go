@@ -19,12 +19,23 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	GradeService_GetStudentGrades_FullMethodName = "/grade.GradeService/GetStudentGrades"
-	GradeService_CalculateGPA_FullMethodName     = "/grade.GradeService/CalculateGPA"
-	GradeService_GetClassRoster_FullMethodName   = "/grade.GradeService/GetClassRoster"
-	GradeService_UploadGrades_FullMethodName     = "/grade.GradeService/UploadGrades"
-	GradeService_PublishGrades_FullMethodName    = "/grade.GradeService/PublishGrades"
-	GradeService_GetCourseGrades_FullMethodName  = "/grade.GradeService/GetCourseGrades"
+	GradeService_GetStudentGrades_FullMethodName       = "/grade.GradeService/GetStudentGrades"
+	GradeService_GetStudentSemesters_FullMethodName    = "/grade.GradeService/GetStudentSemesters"
+	GradeService_CalculateGPA_FullMethodName           = "/grade.GradeService/CalculateGPA"
+	GradeService_SimulateGPA_FullMethodName            = "/grade.GradeService/SimulateGPA"
+	GradeService_GetClassRoster_FullMethodName         = "/grade.GradeService/GetClassRoster"
+	GradeService_UploadGrades_FullMethodName           = "/grade.GradeService/UploadGrades"
+	GradeService_PublishGrades_FullMethodName          = "/grade.GradeService/PublishGrades"
+	GradeService_GetCourseGrades_FullMethodName        = "/grade.GradeService/GetCourseGrades"
+	GradeService_GetDeansList_FullMethodName           = "/grade.GradeService/GetDeansList"
+	GradeService_GetSemesterGradeReport_FullMethodName = "/grade.GradeService/GetSemesterGradeReport"
+	GradeService_ExportGrades_FullMethodName           = "/grade.GradeService/ExportGrades"
+	GradeService_SaveGradeDraft_FullMethodName         = "/grade.GradeService/SaveGradeDraft"
+	GradeService_GetGradeDraft_FullMethodName          = "/grade.GradeService/GetGradeDraft"
+	GradeService_FinalizeDraft_FullMethodName          = "/grade.GradeService/FinalizeDraft"
+	GradeService_SubmitGradeAppeal_FullMethodName      = "/grade.GradeService/SubmitGradeAppeal"
+	GradeService_ListGradeAppeals_FullMethodName       = "/grade.GradeService/ListGradeAppeals"
+	GradeService_ResolveGradeAppeal_FullMethodName     = "/grade.GradeService/ResolveGradeAppeal"
 )
 
 // GradeServiceClient is the client API for GradeService service.
@@ -34,12 +45,26 @@ const (
 // Service definition
 type GradeServiceClient interface {
 	GetStudentGrades(ctx context.Context, in *GetStudentGradesRequest, opts ...grpc.CallOption) (*GetStudentGradesResponse, error)
+	GetStudentSemesters(ctx context.Context, in *GetStudentSemestersRequest, opts ...grpc.CallOption) (*GetStudentSemestersResponse, error)
 	CalculateGPA(ctx context.Context, in *CalculateGPARequest, opts ...grpc.CallOption) (*CalculateGPAResponse, error)
+	SimulateGPA(ctx context.Context, in *SimulateGPARequest, opts ...grpc.CallOption) (*SimulateGPAResponse, error)
 	GetClassRoster(ctx context.Context, in *GetClassRosterRequest, opts ...grpc.CallOption) (*GetClassRosterResponse, error)
 	// Client streaming: Gateway streams grade entries to service
 	UploadGrades(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[UploadGradeEntryRequest, UploadGradesResponse], error)
 	PublishGrades(ctx context.Context, in *PublishGradesRequest, opts ...grpc.CallOption) (*PublishGradesResponse, error)
 	GetCourseGrades(ctx context.Context, in *GetCourseGradesRequest, opts ...grpc.CallOption) (*GetCourseGradesResponse, error)
+	GetDeansList(ctx context.Context, in *GetDeansListRequest, opts ...grpc.CallOption) (*GetDeansListResponse, error)
+	GetSemesterGradeReport(ctx context.Context, in *GetSemesterGradeReportRequest, opts ...grpc.CallOption) (*GetSemesterGradeReportResponse, error)
+	// Server streaming: registrar extract of a semester's grades, sent in chunks
+	ExportGrades(ctx context.Context, in *ExportGradesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportGradesChunk], error)
+	// Autosaved grade entry; drafts skip enrollment validation until finalized
+	SaveGradeDraft(ctx context.Context, in *SaveGradeDraftRequest, opts ...grpc.CallOption) (*SaveGradeDraftResponse, error)
+	GetGradeDraft(ctx context.Context, in *GetGradeDraftRequest, opts ...grpc.CallOption) (*GetGradeDraftResponse, error)
+	FinalizeDraft(ctx context.Context, in *FinalizeDraftRequest, opts ...grpc.CallOption) (*FinalizeDraftResponse, error)
+	// Grade appeals: a student contests a published grade, the course faculty resolves it
+	SubmitGradeAppeal(ctx context.Context, in *SubmitGradeAppealRequest, opts ...grpc.CallOption) (*SubmitGradeAppealResponse, error)
+	ListGradeAppeals(ctx context.Context, in *ListGradeAppealsRequest, opts ...grpc.CallOption) (*ListGradeAppealsResponse, error)
+	ResolveGradeAppeal(ctx context.Context, in *ResolveGradeAppealRequest, opts ...grpc.CallOption) (*ResolveGradeAppealResponse, error)
 }
 
 type gradeServiceClient struct {
@@ -60,6 +85,16 @@ func (c *gradeServiceClient) GetStudentGrades(ctx context.Context, in *GetStuden
 	return out, nil
 }
 
+func (c *gradeServiceClient) GetStudentSemesters(ctx context.Context, in *GetStudentSemestersRequest, opts ...grpc.CallOption) (*GetStudentSemestersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetStudentSemestersResponse)
+	err := c.cc.Invoke(ctx, GradeService_GetStudentSemesters_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *gradeServiceClient) CalculateGPA(ctx context.Context, in *CalculateGPARequest, opts ...grpc.CallOption) (*CalculateGPAResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CalculateGPAResponse)
@@ -70,6 +105,16 @@ func (c *gradeServiceClient) CalculateGPA(ctx context.Context, in *CalculateGPAR
 	return out, nil
 }
 
+func (c *gradeServiceClient) SimulateGPA(ctx context.Context, in *SimulateGPARequest, opts ...grpc.CallOption) (*SimulateGPAResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SimulateGPAResponse)
+	err := c.cc.Invoke(ctx, GradeService_SimulateGPA_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *gradeServiceClient) GetClassRoster(ctx context.Context, in *GetClassRosterRequest, opts ...grpc.CallOption) (*GetClassRosterResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetClassRosterResponse)
@@ -113,6 +158,105 @@ func (c *gradeServiceClient) GetCourseGrades(ctx context.Context, in *GetCourseG
 	return out, nil
 }
 
+func (c *gradeServiceClient) GetDeansList(ctx context.Context, in *GetDeansListRequest, opts ...grpc.CallOption) (*GetDeansListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetDeansListResponse)
+	err := c.cc.Invoke(ctx, GradeService_GetDeansList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gradeServiceClient) GetSemesterGradeReport(ctx context.Context, in *GetSemesterGradeReportRequest, opts ...grpc.CallOption) (*GetSemesterGradeReportResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSemesterGradeReportResponse)
+	err := c.cc.Invoke(ctx, GradeService_GetSemesterGradeReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gradeServiceClient) ExportGrades(ctx context.Context, in *ExportGradesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportGradesChunk], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &GradeService_ServiceDesc.Streams[1], GradeService_ExportGrades_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ExportGradesRequest, ExportGradesChunk]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type GradeService_ExportGradesClient = grpc.ServerStreamingClient[ExportGradesChunk]
+
+func (c *gradeServiceClient) SaveGradeDraft(ctx context.Context, in *SaveGradeDraftRequest, opts ...grpc.CallOption) (*SaveGradeDraftResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SaveGradeDraftResponse)
+	err := c.cc.Invoke(ctx, GradeService_SaveGradeDraft_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gradeServiceClient) GetGradeDraft(ctx context.Context, in *GetGradeDraftRequest, opts ...grpc.CallOption) (*GetGradeDraftResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetGradeDraftResponse)
+	err := c.cc.Invoke(ctx, GradeService_GetGradeDraft_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gradeServiceClient) FinalizeDraft(ctx context.Context, in *FinalizeDraftRequest, opts ...grpc.CallOption) (*FinalizeDraftResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FinalizeDraftResponse)
+	err := c.cc.Invoke(ctx, GradeService_FinalizeDraft_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gradeServiceClient) SubmitGradeAppeal(ctx context.Context, in *SubmitGradeAppealRequest, opts ...grpc.CallOption) (*SubmitGradeAppealResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SubmitGradeAppealResponse)
+	err := c.cc.Invoke(ctx, GradeService_SubmitGradeAppeal_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gradeServiceClient) ListGradeAppeals(ctx context.Context, in *ListGradeAppealsRequest, opts ...grpc.CallOption) (*ListGradeAppealsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListGradeAppealsResponse)
+	err := c.cc.Invoke(ctx, GradeService_ListGradeAppeals_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gradeServiceClient) ResolveGradeAppeal(ctx context.Context, in *ResolveGradeAppealRequest, opts ...grpc.CallOption) (*ResolveGradeAppealResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResolveGradeAppealResponse)
+	err := c.cc.Invoke(ctx, GradeService_ResolveGradeAppeal_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // GradeServiceServer is the server API for GradeService service.
 // All implementations must embed UnimplementedGradeServiceServer
 // for forward compatibility.
@@ -120,12 +264,26 @@ func (c *gradeServiceClient) GetCourseGrades(ctx context.Context, in *GetCourseG
 // Service definition
 type GradeServiceServer interface {
 	GetStudentGrades(context.Context, *GetStudentGradesRequest) (*GetStudentGradesResponse, error)
+	GetStudentSemesters(context.Context, *GetStudentSemestersRequest) (*GetStudentSemestersResponse, error)
 	CalculateGPA(context.Context, *CalculateGPARequest) (*CalculateGPAResponse, error)
+	SimulateGPA(context.Context, *SimulateGPARequest) (*SimulateGPAResponse, error)
 	GetClassRoster(context.Context, *GetClassRosterRequest) (*GetClassRosterResponse, error)
 	// Client streaming: Gateway streams grade entries to service
 	UploadGrades(grpc.ClientStreamingServer[UploadGradeEntryRequest, UploadGradesResponse]) error
 	PublishGrades(context.Context, *PublishGradesRequest) (*PublishGradesResponse, error)
 	GetCourseGrades(context.Context, *GetCourseGradesRequest) (*GetCourseGradesResponse, error)
+	GetDeansList(context.Context, *GetDeansListRequest) (*GetDeansListResponse, error)
+	GetSemesterGradeReport(context.Context, *GetSemesterGradeReportRequest) (*GetSemesterGradeReportResponse, error)
+	// Server streaming: registrar extract of a semester's grades, sent in chunks
+	ExportGrades(*ExportGradesRequest, grpc.ServerStreamingServer[ExportGradesChunk]) error
+	// Autosaved grade entry; drafts skip enrollment validation until finalized
+	SaveGradeDraft(context.Context, *SaveGradeDraftRequest) (*SaveGradeDraftResponse, error)
+	GetGradeDraft(context.Context, *GetGradeDraftRequest) (*GetGradeDraftResponse, error)
+	FinalizeDraft(context.Context, *FinalizeDraftRequest) (*FinalizeDraftResponse, error)
+	// Grade appeals: a student contests a published grade, the course faculty resolves it
+	SubmitGradeAppeal(context.Context, *SubmitGradeAppealRequest) (*SubmitGradeAppealResponse, error)
+	ListGradeAppeals(context.Context, *ListGradeAppealsRequest) (*ListGradeAppealsResponse, error)
+	ResolveGradeAppeal(context.Context, *ResolveGradeAppealRequest) (*ResolveGradeAppealResponse, error)
 	mustEmbedUnimplementedGradeServiceServer()
 }
 
@@ -139,9 +297,15 @@ type UnimplementedGradeServiceServer struct{}
 func (UnimplementedGradeServiceServer) GetStudentGrades(context.Context, *GetStudentGradesRequest) (*GetStudentGradesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetStudentGrades not implemented")
 }
+func (UnimplementedGradeServiceServer) GetStudentSemesters(context.Context, *GetStudentSemestersRequest) (*GetStudentSemestersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStudentSemesters not implemented")
+}
 func (UnimplementedGradeServiceServer) CalculateGPA(context.Context, *CalculateGPARequest) (*CalculateGPAResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CalculateGPA not implemented")
 }
+func (UnimplementedGradeServiceServer) SimulateGPA(context.Context, *SimulateGPARequest) (*SimulateGPAResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateGPA not implemented")
+}
 func (UnimplementedGradeServiceServer) GetClassRoster(context.Context, *GetClassRosterRequest) (*GetClassRosterResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetClassRoster not implemented")
 }
@@ -154,6 +318,33 @@ func (UnimplementedGradeServiceServer) PublishGrades(context.Context, *PublishGr
 func (UnimplementedGradeServiceServer) GetCourseGrades(context.Context, *GetCourseGradesRequest) (*GetCourseGradesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetCourseGrades not implemented")
 }
+func (UnimplementedGradeServiceServer) GetDeansList(context.Context, *GetDeansListRequest) (*GetDeansListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDeansList not implemented")
+}
+func (UnimplementedGradeServiceServer) GetSemesterGradeReport(context.Context, *GetSemesterGradeReportRequest) (*GetSemesterGradeReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSemesterGradeReport not implemented")
+}
+func (UnimplementedGradeServiceServer) ExportGrades(*ExportGradesRequest, grpc.ServerStreamingServer[ExportGradesChunk]) error {
+	return status.Errorf(codes.Unimplemented, "method ExportGrades not implemented")
+}
+func (UnimplementedGradeServiceServer) SaveGradeDraft(context.Context, *SaveGradeDraftRequest) (*SaveGradeDraftResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SaveGradeDraft not implemented")
+}
+func (UnimplementedGradeServiceServer) GetGradeDraft(context.Context, *GetGradeDraftRequest) (*GetGradeDraftResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGradeDraft not implemented")
+}
+func (UnimplementedGradeServiceServer) FinalizeDraft(context.Context, *FinalizeDraftRequest) (*FinalizeDraftResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FinalizeDraft not implemented")
+}
+func (UnimplementedGradeServiceServer) SubmitGradeAppeal(context.Context, *SubmitGradeAppealRequest) (*SubmitGradeAppealResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitGradeAppeal not implemented")
+}
+func (UnimplementedGradeServiceServer) ListGradeAppeals(context.Context, *ListGradeAppealsRequest) (*ListGradeAppealsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListGradeAppeals not implemented")
+}
+func (UnimplementedGradeServiceServer) ResolveGradeAppeal(context.Context, *ResolveGradeAppealRequest) (*ResolveGradeAppealResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveGradeAppeal not implemented")
+}
 func (UnimplementedGradeServiceServer) mustEmbedUnimplementedGradeServiceServer() {}
 func (UnimplementedGradeServiceServer) testEmbeddedByValue()                      {}
 
@@ -193,6 +384,24 @@ func _GradeService_GetStudentGrades_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _GradeService_GetStudentSemesters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStudentSemestersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GradeServiceServer).GetStudentSemesters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GradeService_GetStudentSemesters_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GradeServiceServer).GetStudentSemesters(ctx, req.(*GetStudentSemestersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _GradeService_CalculateGPA_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CalculateGPARequest)
 	if err := dec(in); err != nil {
@@ -211,6 +420,24 @@ func _GradeService_CalculateGPA_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _GradeService_SimulateGPA_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SimulateGPARequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GradeServiceServer).SimulateGPA(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GradeService_SimulateGPA_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GradeServiceServer).SimulateGPA(ctx, req.(*SimulateGPARequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _GradeService_GetClassRoster_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetClassRosterRequest)
 	if err := dec(in); err != nil {
@@ -272,6 +499,161 @@ func _GradeService_GetCourseGrades_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _GradeService_GetDeansList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDeansListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GradeServiceServer).GetDeansList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GradeService_GetDeansList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GradeServiceServer).GetDeansList(ctx, req.(*GetDeansListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GradeService_GetSemesterGradeReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSemesterGradeReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GradeServiceServer).GetSemesterGradeReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GradeService_GetSemesterGradeReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GradeServiceServer).GetSemesterGradeReport(ctx, req.(*GetSemesterGradeReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GradeService_ExportGrades_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportGradesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GradeServiceServer).ExportGrades(m, &grpc.GenericServerStream[ExportGradesRequest, ExportGradesChunk]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type GradeService_ExportGradesServer = grpc.ServerStreamingServer[ExportGradesChunk]
+
+func _GradeService_SaveGradeDraft_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SaveGradeDraftRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GradeServiceServer).SaveGradeDraft(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GradeService_SaveGradeDraft_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GradeServiceServer).SaveGradeDraft(ctx, req.(*SaveGradeDraftRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GradeService_GetGradeDraft_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGradeDraftRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GradeServiceServer).GetGradeDraft(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GradeService_GetGradeDraft_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GradeServiceServer).GetGradeDraft(ctx, req.(*GetGradeDraftRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GradeService_FinalizeDraft_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FinalizeDraftRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GradeServiceServer).FinalizeDraft(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GradeService_FinalizeDraft_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GradeServiceServer).FinalizeDraft(ctx, req.(*FinalizeDraftRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GradeService_SubmitGradeAppeal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitGradeAppealRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GradeServiceServer).SubmitGradeAppeal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GradeService_SubmitGradeAppeal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GradeServiceServer).SubmitGradeAppeal(ctx, req.(*SubmitGradeAppealRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GradeService_ListGradeAppeals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListGradeAppealsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GradeServiceServer).ListGradeAppeals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GradeService_ListGradeAppeals_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GradeServiceServer).ListGradeAppeals(ctx, req.(*ListGradeAppealsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GradeService_ResolveGradeAppeal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolveGradeAppealRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GradeServiceServer).ResolveGradeAppeal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GradeService_ResolveGradeAppeal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GradeServiceServer).ResolveGradeAppeal(ctx, req.(*ResolveGradeAppealRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // GradeService_ServiceDesc is the grpc.ServiceDesc for GradeService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -283,10 +665,18 @@ var GradeService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetStudentGrades",
 			Handler:    _GradeService_GetStudentGrades_Handler,
 		},
+		{
+			MethodName: "GetStudentSemesters",
+			Handler:    _GradeService_GetStudentSemesters_Handler,
+		},
 		{
 			MethodName: "CalculateGPA",
 			Handler:    _GradeService_CalculateGPA_Handler,
 		},
+		{
+			MethodName: "SimulateGPA",
+			Handler:    _GradeService_SimulateGPA_Handler,
+		},
 		{
 			MethodName: "GetClassRoster",
 			Handler:    _GradeService_GetClassRoster_Handler,
@@ -299,6 +689,38 @@ var GradeService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetCourseGrades",
 			Handler:    _GradeService_GetCourseGrades_Handler,
 		},
+		{
+			MethodName: "GetDeansList",
+			Handler:    _GradeService_GetDeansList_Handler,
+		},
+		{
+			MethodName: "GetSemesterGradeReport",
+			Handler:    _GradeService_GetSemesterGradeReport_Handler,
+		},
+		{
+			MethodName: "SaveGradeDraft",
+			Handler:    _GradeService_SaveGradeDraft_Handler,
+		},
+		{
+			MethodName: "GetGradeDraft",
+			Handler:    _GradeService_GetGradeDraft_Handler,
+		},
+		{
+			MethodName: "FinalizeDraft",
+			Handler:    _GradeService_FinalizeDraft_Handler,
+		},
+		{
+			MethodName: "SubmitGradeAppeal",
+			Handler:    _GradeService_SubmitGradeAppeal_Handler,
+		},
+		{
+			MethodName: "ListGradeAppeals",
+			Handler:    _GradeService_ListGradeAppeals_Handler,
+		},
+		{
+			MethodName: "ResolveGradeAppeal",
+			Handler:    _GradeService_ResolveGradeAppeal_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -306,6 +728,11 @@ var GradeService_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _GradeService_UploadGrades_Handler,
 			ClientStreams: true,
 		},
+		{
+			StreamName:    "ExportGrades",
+			Handler:       _GradeService_ExportGrades_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "backend/protos/grade.proto",
 }
@@ -10,6 +10,7 @@ import (
 	"log"
 	"os"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/joho/godotenv"
@@ -42,14 +43,58 @@ type GRPCConfig struct {
 	MaxSendMsgSize    int // Maximum send message size in bytes
 	ConnectionTimeout time.Duration
 	RequestTimeout    time.Duration
+	TLS               TLSConfig
 }
 
 // SecurityConfig holds security-related configuration
 type SecurityConfig struct {
-	JWTSecret          string
+	JWTSecret          string   // Current signing secret (newest entry of JWTKeys)
+	JWTKeys            []JWTKey // All accepted signing keys, oldest first
 	JWTExpirationHours int
+	JWTExpiration      time.Duration // finer-grained token lifetime; overrides JWTExpirationHours when set
 	SessionTimeout     time.Duration
 	BCryptCost         int // BCrypt hashing cost (10-12 recommended)
+
+	// AllowAnyJWTExpiration skips the MinJWTExpiration..MaxJWTExpiration check
+	AllowAnyJWTExpiration bool
+
+	// TrustedProxies are the peers (IPs or CIDRs) allowed to forward the
+	// client IP in MetadataClientIP, i.e. the gateway
+	TrustedProxies []string
+}
+
+// Bounds for a sane token lifetime, enforced at startup unless overridden
+const (
+	MinJWTExpiration = 15 * time.Minute
+	MaxJWTExpiration = 7 * 24 * time.Hour
+)
+
+// TokenLifetime is how long issued JWTs (and so their sessions) stay valid
+func (c SecurityConfig) TokenLifetime() time.Duration {
+	if c.JWTExpiration != 0 {
+		return c.JWTExpiration
+	}
+	return time.Duration(c.JWTExpirationHours) * time.Hour
+}
+
+// ValidateTokenLifetime rejects a non-positive token lifetime, or one outside
+// MinJWTExpiration..MaxJWTExpiration unless AllowAnyJWTExpiration is set
+func (c SecurityConfig) ValidateTokenLifetime() error {
+	lifetime := c.TokenLifetime()
+	if lifetime <= 0 {
+		return fmt.Errorf("JWT expiration must be positive, got %v", lifetime)
+	}
+	if !c.AllowAnyJWTExpiration && (lifetime < MinJWTExpiration || lifetime > MaxJWTExpiration) {
+		return fmt.Errorf("JWT expiration %v is outside %v to %v (set JWT_EXPIRATION_ALLOW_ANY=true to override)",
+			lifetime, MinJWTExpiration, MaxJWTExpiration)
+	}
+	return nil
+}
+
+// JWTKey is a JWT signing secret identified by the token's kid header
+type JWTKey struct {
+	ID     string
+	Secret string
 }
 
 // GatewayConfig holds gateway-specific configuration
@@ -66,6 +111,47 @@ type GatewayConfig struct {
 
 	// CORS Configuration
 	CORS CORSConfig
+
+	// Per-route-group deadlines for downstream calls
+	Timeouts GatewayTimeouts
+}
+
+// GatewayTimeouts holds the request deadlines the gateway applies per route group
+type GatewayTimeouts struct {
+	Default             time.Duration // GATEWAY_TIMEOUT_DEFAULT
+	Auth                time.Duration // GATEWAY_TIMEOUT_AUTH: login, tokens, profile, sessions
+	EnrollmentMutations time.Duration // GATEWAY_TIMEOUT_ENROLLMENT_MUTATIONS: cart changes, enroll, drop
+	Reports             time.Duration // GATEWAY_TIMEOUT_REPORTS: rosters, CSV exports, admin repairs
+	Uploads             time.Duration // GATEWAY_TIMEOUT_UPLOADS: streamed grade CSV uploads, course imports
+	Exports             time.Duration // GATEWAY_TIMEOUT_EXPORTS: streamed full-semester grade exports
+}
+
+// Validate checks that every deadline is positive
+func (t GatewayTimeouts) Validate() error {
+	for name, d := range map[string]time.Duration{
+		"GATEWAY_TIMEOUT_DEFAULT":              t.Default,
+		"GATEWAY_TIMEOUT_AUTH":                 t.Auth,
+		"GATEWAY_TIMEOUT_ENROLLMENT_MUTATIONS": t.EnrollmentMutations,
+		"GATEWAY_TIMEOUT_REPORTS":              t.Reports,
+		"GATEWAY_TIMEOUT_UPLOADS":              t.Uploads,
+		"GATEWAY_TIMEOUT_EXPORTS":              t.Exports,
+	} {
+		if d <= 0 {
+			return fmt.Errorf("%s must be positive, got %v", name, d)
+		}
+	}
+	return nil
+}
+
+// Longest returns the largest route deadline, used to size HTTP server timeouts
+func (t GatewayTimeouts) Longest() time.Duration {
+	longest := t.Default
+	for _, d := range []time.Duration{t.Auth, t.EnrollmentMutations, t.Reports, t.Uploads, t.Exports} {
+		if d > longest {
+			longest = d
+		}
+	}
+	return longest
 }
 
 // CORSConfig holds CORS-related configuration
@@ -96,6 +182,20 @@ func LoadEnv(envFile string) error {
 	return nil
 }
 
+// ReloadEnv re-reads the .env file, overriding variables that are already set
+func ReloadEnv(envFile string) error {
+	if envFile == "" {
+		envFile = ".env"
+	}
+
+	if err := godotenv.Overload(envFile); err != nil {
+		return err
+	}
+
+	log.Printf("Reloaded environment from %s", envFile)
+	return nil
+}
+
 // LoadServiceConfig loads common service configuration from environment
 func LoadServiceConfig(serviceName string) (*ServiceConfig, error) {
 	config := &ServiceConfig{
@@ -118,6 +218,8 @@ func LoadServiceConfig(serviceName string) (*ServiceConfig, error) {
 		MaxPoolSize:    uint64(GetIntEnv("MONGO_MAX_POOL_SIZE", 50)),
 		MinPoolSize:    uint64(GetIntEnv("MONGO_MIN_POOL_SIZE", 10)),
 		MaxIdleTime:    GetDurationEnv("MONGO_MAX_IDLE_TIME", 30*time.Second),
+		// Production refuses to run without transactions unless explicitly allowed
+		RequireTransactions: GetBoolEnv("MONGO_REQUIRE_TRANSACTIONS", config.Environment == "production"),
 	}
 
 	// Load gRPC configuration
@@ -126,24 +228,72 @@ func LoadServiceConfig(serviceName string) (*ServiceConfig, error) {
 		MaxSendMsgSize:    GetIntEnv("GRPC_MAX_SEND_MSG_SIZE", 10*1024*1024), // 10MB
 		ConnectionTimeout: GetDurationEnv("GRPC_CONNECTION_TIMEOUT", 10*time.Second),
 		RequestTimeout:    GetDurationEnv("GRPC_REQUEST_TIMEOUT", 30*time.Second),
+		TLS:               LoadTLSConfig(),
 	}
 
 	// Load security configuration
 	config.Security = SecurityConfig{
-		JWTSecret:          GetEnv("JWT_SECRET", ""),
+		JWTKeys:            LoadJWTKeys(),
 		JWTExpirationHours: GetIntEnv("JWT_EXPIRATION_HOURS", 24),
+		JWTExpiration:      GetDurationEnv("JWT_EXPIRATION", 0),
 		SessionTimeout:     GetDurationEnv("SESSION_TIMEOUT", 30*time.Minute),
 		BCryptCost:         GetIntEnv("BCRYPT_COST", 10),
+
+		AllowAnyJWTExpiration: GetBoolEnv("JWT_EXPIRATION_ALLOW_ANY", false),
+		TrustedProxies:        GetStringSliceEnv("TRUSTED_PROXIES", []string{"127.0.0.1", "::1"}),
+	}
+	if n := len(config.Security.JWTKeys); n > 0 {
+		config.Security.JWTSecret = config.Security.JWTKeys[n-1].Secret
 	}
 
 	// Validate required fields
 	if config.Security.JWTSecret == "" && serviceName == "auth-service" {
-		return nil, fmt.Errorf("JWT_SECRET environment variable is required for auth service")
+		return nil, fmt.Errorf("JWT_SECRET, JWT_SECRETS or JWT_SECRET_V1 environment variable is required for auth service")
+	}
+	if serviceName == "auth-service" {
+		if err := config.Security.ValidateTokenLifetime(); err != nil {
+			return nil, err
+		}
 	}
 
 	return config, nil
 }
 
+// LoadJWTKeys reads the JWT key set from the environment, oldest key first.
+// JWT_SECRETS is a comma-separated list of "kid:secret" pairs or bare secrets
+// (numbered v1, v2, ...). Without it, JWT_SECRET_V1, JWT_SECRET_V2, ... are read
+// in order, and a lone JWT_SECRET becomes key "v0". The last key signs new tokens.
+func LoadJWTKeys() []JWTKey {
+	if entries := GetStringSliceEnv("JWT_SECRETS", nil); len(entries) > 0 {
+		keys := make([]JWTKey, 0, len(entries))
+		for i, entry := range entries {
+			id, secret, found := strings.Cut(entry, ":")
+			if !found {
+				id, secret = fmt.Sprintf("v%d", i+1), entry
+			}
+			keys = append(keys, JWTKey{ID: id, Secret: secret})
+		}
+		return keys
+	}
+
+	var keys []JWTKey
+	for i := 1; ; i++ {
+		secret := os.Getenv(fmt.Sprintf("JWT_SECRET_V%d", i))
+		if secret == "" {
+			break
+		}
+		keys = append(keys, JWTKey{ID: fmt.Sprintf("v%d", i), Secret: secret})
+	}
+	if len(keys) > 0 {
+		return keys
+	}
+
+	if secret := os.Getenv("JWT_SECRET"); secret != "" {
+		return []JWTKey{{ID: "v0", Secret: secret}}
+	}
+	return nil
+}
+
 // LoadGatewayConfig loads gateway-specific configuration
 func LoadGatewayConfig() (*GatewayConfig, error) {
 	baseConfig, err := LoadServiceConfig("gateway")
@@ -161,6 +311,7 @@ func LoadGatewayConfig() (*GatewayConfig, error) {
 		EnrollmentServiceAddr: GetEnv("ENROLLMENT_SERVICE_ADDR", "localhost:50053"),
 		GradeServiceAddr:      GetEnv("GRADE_SERVICE_ADDR", "localhost:50054"),
 		AdminServiceAddr:      GetEnv("ADMIN_SERVICE_ADDR", "localhost:50055"),
+		Timeouts:              LoadGatewayTimeouts(),
 	}
 
 	// Load CORS configuration
@@ -175,6 +326,18 @@ func LoadGatewayConfig() (*GatewayConfig, error) {
 	return config, nil
 }
 
+// LoadGatewayTimeouts reads the gateway's per-route-group deadlines from the environment
+func LoadGatewayTimeouts() GatewayTimeouts {
+	return GatewayTimeouts{
+		Default:             GetDurationEnv("GATEWAY_TIMEOUT_DEFAULT", 5*time.Second),
+		Auth:                GetDurationEnv("GATEWAY_TIMEOUT_AUTH", 3*time.Second),
+		EnrollmentMutations: GetDurationEnv("GATEWAY_TIMEOUT_ENROLLMENT_MUTATIONS", 15*time.Second),
+		Reports:             GetDurationEnv("GATEWAY_TIMEOUT_REPORTS", 30*time.Second),
+		Uploads:             GetDurationEnv("GATEWAY_TIMEOUT_UPLOADS", 2*time.Minute),
+		Exports:             GetDurationEnv("GATEWAY_TIMEOUT_EXPORTS", 10*time.Minute),
+	}
+}
+
 // ============================================================================
 // Environment Variable Helper Functions
 // ============================================================================
@@ -342,6 +505,10 @@ func ValidateGatewayConfig(config *GatewayConfig) error {
 		return fmt.Errorf("admin service address is required")
 	}
 
+	if err := config.Timeouts.Validate(); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -364,8 +531,10 @@ func PrintConfig(config *ServiceConfig) {
 	log.Printf("Max Recv Msg Size: %d bytes", config.GRPC.MaxRecvMsgSize)
 	log.Printf("Max Send Msg Size: %d bytes", config.GRPC.MaxSendMsgSize)
 	log.Printf("Connection Timeout: %v", config.GRPC.ConnectionTimeout)
+	log.Printf("TLS Enabled: %t (mutual: %t)", config.GRPC.TLS.Enabled, config.GRPC.TLS.Enabled && config.GRPC.TLS.CAFile != "")
 	log.Println("=== Security Configuration ===")
-	log.Printf("JWT Expiration: %d hours", config.Security.JWTExpirationHours)
+	log.Printf("JWT Keys: %d", len(config.Security.JWTKeys))
+	log.Printf("JWT Expiration: %v", config.Security.TokenLifetime())
 	log.Printf("Session Timeout: %v", config.Security.SessionTimeout)
 	log.Printf("BCrypt Cost: %d", config.Security.BCryptCost)
 	log.Println("=============================")
@@ -385,6 +554,8 @@ func PrintGatewayConfig(config *GatewayConfig) {
 	log.Printf("Allowed Origins: %v", config.CORS.AllowedOrigins)
 	log.Printf("Allowed Methods: %v", config.CORS.AllowedMethods)
 	log.Printf("Allow Credentials: %t", config.CORS.AllowCredentials)
+	log.Printf("Timeouts: default=%v auth=%v enrollment=%v reports=%v uploads=%v",
+		config.Timeouts.Default, config.Timeouts.Auth, config.Timeouts.EnrollmentMutations, config.Timeouts.Reports, config.Timeouts.Uploads)
 	log.Println("======================================")
 }
 
@@ -510,7 +681,10 @@ func CheckRequiredEnvVars(serviceName string) error {
 	// Service-specific required variables
 	switch serviceName {
 	case "auth-service":
-		required = append(required, "JWT_SECRET")
+		// JWT_SECRETS or JWT_SECRET_V1... may stand in for JWT_SECRET
+		if len(LoadJWTKeys()) == 0 {
+			required = append(required, "JWT_SECRET")
+		}
 	case "gateway":
 		required = append(required,
 			"AUTH_SERVICE_ADDR",
@@ -0,0 +1,92 @@
+package shared
+
+import (
+	"testing"
+	"time"
+)
+
+func TestGatewayTimeouts(t *testing.T) {
+	t.Setenv("GATEWAY_TIMEOUT_EXPORTS", "20m")
+	timeouts := LoadGatewayTimeouts()
+	if err := timeouts.Validate(); err != nil {
+		t.Fatalf("Expected defaults to be valid, got %v", err)
+	}
+	if timeouts.Longest() != 20*time.Minute {
+		t.Errorf("Expected longest timeout 20m, got %v", timeouts.Longest())
+	}
+
+	timeouts.Reports = 0
+	if err := timeouts.Validate(); err == nil {
+		t.Error("Expected zero reports timeout to be rejected")
+	}
+	timeouts.Reports = -time.Second
+	if err := timeouts.Validate(); err == nil {
+		t.Error("Expected negative reports timeout to be rejected")
+	}
+}
+
+func TestTokenLifetime(t *testing.T) {
+	if got := (SecurityConfig{JWTExpirationHours: 24}).TokenLifetime(); got != 24*time.Hour {
+		t.Errorf("Expected 24h from JWTExpirationHours, got %v", got)
+	}
+	if got := (SecurityConfig{JWTExpirationHours: 24, JWTExpiration: 20 * time.Minute}).TokenLifetime(); got != 20*time.Minute {
+		t.Errorf("Expected JWTExpiration to take precedence, got %v", got)
+	}
+
+	for _, c := range []struct {
+		cfg   SecurityConfig
+		valid bool
+	}{
+		{SecurityConfig{JWTExpirationHours: 1}, true},
+		{SecurityConfig{JWTExpiration: 15 * time.Minute}, true},
+		{SecurityConfig{JWTExpirationHours: 168}, true},
+		{SecurityConfig{JWTExpiration: 5 * time.Minute}, false},
+		{SecurityConfig{JWTExpirationHours: 169}, false},
+		{SecurityConfig{JWTExpirationHours: 0}, false},
+		{SecurityConfig{JWTExpiration: -time.Hour, JWTExpirationHours: 24}, false},
+		{SecurityConfig{JWTExpiration: 5 * time.Minute, AllowAnyJWTExpiration: true}, true},
+		{SecurityConfig{JWTExpirationHours: -1, AllowAnyJWTExpiration: true}, false},
+	} {
+		if err := c.cfg.ValidateTokenLifetime(); (err == nil) != c.valid {
+			t.Errorf("ValidateTokenLifetime(%v): expected valid=%v, got %v", c.cfg.TokenLifetime(), c.valid, err)
+		}
+	}
+
+	// The auth service refuses to start with an out-of-range lifetime
+	t.Setenv("MONGO_URI", "mongodb://localhost:27017")
+	t.Setenv("JWT_SECRET", "config-test-secret")
+	t.Setenv("JWT_EXPIRATION", "5m")
+	if _, err := LoadServiceConfig("auth-service"); err == nil {
+		t.Error("Expected a 5m JWT expiration to be rejected at startup")
+	}
+	t.Setenv("JWT_EXPIRATION_ALLOW_ANY", "true")
+	if _, err := LoadServiceConfig("auth-service"); err != nil {
+		t.Errorf("Expected the override to allow a 5m JWT expiration, got %v", err)
+	}
+}
+
+func TestRequireTransactionsDefault(t *testing.T) {
+	t.Setenv("MONGO_URI", "mongodb://localhost:27017")
+	t.Setenv("MONGO_REQUIRE_TRANSACTIONS", "")
+
+	t.Setenv("ENVIRONMENT", "development")
+	cfg, err := LoadServiceConfig("course-service")
+	if err != nil {
+		t.Fatalf("LoadServiceConfig failed: %v", err)
+	}
+	if cfg.MongoDB.RequireTransactions {
+		t.Error("Expected development to allow best-effort writes")
+	}
+
+	t.Setenv("ENVIRONMENT", "production")
+	cfg, _ = LoadServiceConfig("course-service")
+	if !cfg.MongoDB.RequireTransactions {
+		t.Error("Expected production to require transactions by default")
+	}
+
+	t.Setenv("MONGO_REQUIRE_TRANSACTIONS", "false")
+	cfg, _ = LoadServiceConfig("course-service")
+	if cfg.MongoDB.RequireTransactions {
+		t.Error("Expected MONGO_REQUIRE_TRANSACTIONS=false to override the production default")
+	}
+}
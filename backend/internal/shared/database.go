@@ -7,15 +7,24 @@ package shared
 
 import (
 	"context"
+	"crypto/rand"
+	"encoding/hex"
 	"fmt"
 	"log"
+	"strconv"
+	"strings"
+	"sync"
 	"time"
+	"unicode"
+	"unicode/utf8"
 
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
 	"go.mongodb.org/mongo-driver/mongo/readpref"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 // MongoConfig holds MongoDB connection configuration
@@ -26,8 +35,29 @@ type MongoConfig struct {
 	MaxPoolSize    uint64
 	MinPoolSize    uint64
 	MaxIdleTime    time.Duration
+
+	// RequireTransactions makes ConnectMongoDB fail when the deployment cannot run
+	// multi-document transactions instead of falling back to best-effort writes
+	RequireTransactions bool
+	// TransactionMode is set by ConnectMongoDB to what WithTransaction will do
+	TransactionMode TransactionMode
 }
 
+// TransactionMode describes how WithTransaction runs its callback
+type TransactionMode string
+
+const (
+	// TransactionModeTransactional runs callbacks in a multi-document transaction (replica set or mongos)
+	TransactionModeTransactional TransactionMode = "transactional"
+	// TransactionModeBestEffort runs callbacks without a transaction (standalone mongod);
+	// a failure part-way through leaves earlier writes in place
+	TransactionModeBestEffort TransactionMode = "best-effort"
+)
+
+// transactionModes caches the detected mode per client so WithTransaction
+// does not run hello on every call
+var transactionModes sync.Map // *mongo.Client -> TransactionMode
+
 // DefaultMongoConfig returns default MongoDB configuration
 func DefaultMongoConfig(uri, database string) *MongoConfig {
 	return &MongoConfig{
@@ -75,7 +105,18 @@ func ConnectMongoDB(config *MongoConfig) (*mongo.Client, *mongo.Database, error)
 		return nil, nil, fmt.Errorf("failed to ping MongoDB: %w", err)
 	}
 
-	log.Printf("Successfully connected to MongoDB (Database: %s)", config.Database)
+	mode, err := DetectTransactionMode(pingCtx, client)
+	if err != nil {
+		client.Disconnect(context.Background())
+		return nil, nil, fmt.Errorf("failed to detect MongoDB topology: %w", err)
+	}
+	if mode == TransactionModeBestEffort && config.RequireTransactions {
+		client.Disconnect(context.Background())
+		return nil, nil, fmt.Errorf("MongoDB at this URI is a standalone server without transaction support (MONGO_REQUIRE_TRANSACTIONS is set)")
+	}
+	config.TransactionMode = mode
+
+	log.Printf("Successfully connected to MongoDB (Database: %s, Transactions: %s)", config.Database, mode)
 
 	db := client.Database(config.Database)
 	return client, db, nil
@@ -162,6 +203,24 @@ func GetTime(value interface{}) (time.Time, error) {
 	}
 }
 
+// ToProtoTime converts t to a Protobuf timestamp, returning nil for the zero time
+// so unset fields (e.g. dropped_at of an active enrollment) are omitted rather than
+// sent as a bogus epoch date
+func ToProtoTime(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+	return timestamppb.New(t)
+}
+
+// FromProtoTime is the inverse of ToProtoTime: a nil timestamp becomes the zero time
+func FromProtoTime(ts *timestamppb.Timestamp) time.Time {
+	if ts == nil {
+		return time.Time{}
+	}
+	return ts.AsTime()
+}
+
 // GetStringArray safely extracts string array from BSON Array
 func GetStringArray(value interface{}) ([]string, error) {
 	switch v := value.(type) {
@@ -192,6 +251,18 @@ func GetStringArray(value interface{}) ([]string, error) {
 // Schedule Parsing Helpers (for enrollment conflict detection)
 // ============================================================================
 
+// WeeklyContactHours is the scheduled class time per week for a schedule string:
+// the meeting length times the number of meeting days. Unscheduled or unparsable
+// schedules count as zero.
+func WeeklyContactHours(schedule string) float64 {
+	days, startTime, endTime := ParseSchedule(schedule)
+	minutes := timeToMinutes(endTime) - timeToMinutes(startTime)
+	if minutes <= 0 {
+		return 0
+	}
+	return float64(len(days)*minutes) / 60
+}
+
 // ParseSchedule extracts days, start time, and end time from schedule string
 // Format: "MWF 9:00-10:00" or "TTH 14:00-15:30"
 func ParseSchedule(schedule string) (days []string, startTime string, endTime string) {
@@ -220,6 +291,110 @@ func ParseSchedule(schedule string) (days []string, startTime string, endTime st
 	return days, startTime, endTime
 }
 
+// ValidateSchedule reports why a schedule string cannot be used, or nil when
+// ParseSchedule yields known days and a well-formed, increasing time range
+func ValidateSchedule(schedule string) error {
+	parts := splitBySpace(schedule)
+	if len(parts) != 2 {
+		return fmt.Errorf("schedule must be \"DAYS HH:MM-HH:MM\", e.g. \"MWF 9:00-10:00\"")
+	}
+
+	days, startTime, endTime := ParseSchedule(schedule)
+	seen := make(map[string]bool, len(days))
+	for _, day := range days {
+		if !validDays[day] {
+			return fmt.Errorf("unknown day %q (use M, T, W, TH, F, S)", day)
+		}
+		if seen[day] {
+			return fmt.Errorf("day %q listed more than once", day)
+		}
+		seen[day] = true
+	}
+
+	if len(splitByDash(parts[1])) != 2 {
+		return fmt.Errorf("time range must be \"HH:MM-HH:MM\"")
+	}
+	for _, t := range []string{startTime, endTime} {
+		if !isClockTime(t) {
+			return fmt.Errorf("invalid time %q", t)
+		}
+	}
+	if timeToMinutes(startTime) >= timeToMinutes(endTime) {
+		return fmt.Errorf("start time must be before end time")
+	}
+	return nil
+}
+
+// NewScheduleSlot parses a schedule into the slot stored on its course, or
+// returns nil when the schedule is empty or invalid
+func NewScheduleSlot(schedule string) *ScheduleSlot {
+	if ValidateSchedule(schedule) != nil {
+		return nil
+	}
+	days, startTime, endTime := ParseSchedule(schedule)
+	return &ScheduleSlot{Days: days, StartMinute: int32(timeToMinutes(startTime)), EndMinute: int32(timeToMinutes(endTime))}
+}
+
+// ParseMeetingDays parses a day filter written like a schedule ("TTH") or as a
+// list ("T,TH"), returning the distinct day codes
+func ParseMeetingDays(value string) ([]string, error) {
+	var days []string
+	seen := make(map[string]bool)
+	for _, part := range strings.FieldsFunc(strings.ToUpper(value), func(r rune) bool { return r == ',' || r == ' ' }) {
+		for _, day := range parseDays(part) {
+			if !validDays[day] {
+				return nil, fmt.Errorf("unknown day %q (use M, T, W, TH, F, S)", day)
+			}
+			if !seen[day] {
+				seen[day] = true
+				days = append(days, day)
+			}
+		}
+	}
+	return days, nil
+}
+
+// ParseTimeWindow converts "HH:MM" bounds to minutes since midnight. An empty
+// start is midnight and an empty end is the end of the day.
+func ParseTimeWindow(start, end string) (from, to int32, err error) {
+	from, to = 0, 24*60
+	if start != "" {
+		if !isClockTime(start) {
+			return 0, 0, fmt.Errorf("invalid start time %q", start)
+		}
+		from = int32(timeToMinutes(start))
+	}
+	if end != "" {
+		if !isClockTime(end) {
+			return 0, 0, fmt.Errorf("invalid end time %q", end)
+		}
+		to = int32(timeToMinutes(end))
+	}
+	if from >= to {
+		return 0, 0, fmt.Errorf("time window start must be before its end")
+	}
+	return from, to, nil
+}
+
+// validDays lists the day codes produced by parseDays
+var validDays = map[string]bool{"M": true, "T": true, "W": true, "TH": true, "F": true, "S": true}
+
+// isClockTime checks an "H:MM" or "HH:MM" 24-hour time
+func isClockTime(t string) bool {
+	parts := splitByColon(t)
+	if len(parts) != 2 || len(parts[0]) < 1 || len(parts[0]) > 2 || len(parts[1]) != 2 {
+		return false
+	}
+	for _, char := range parts[0] + parts[1] {
+		if char < '0' || char > '9' {
+			return false
+		}
+	}
+	hours, _ := strconv.Atoi(parts[0])
+	minutes, _ := strconv.Atoi(parts[1])
+	return hours <= 23 && minutes <= 59
+}
+
 // parseDays converts day string to array (e.g., "MWF" -> ["M", "W", "F"])
 func parseDays(daysStr string) []string {
 	days := []string{}
@@ -359,10 +534,82 @@ func DaysOverlap(days1, days2 []string) bool {
 // ID Generation Helpers
 // ============================================================================
 
-// GenerateID generates a unique ID with prefix and timestamp
+// GenerateID generates a unique ID from a prefix, a timestamp and a random suffix.
+// The suffix keeps IDs apart when two processes read the same clock tick.
 func GenerateID(prefix string) string {
 	timestamp := time.Now().UnixNano()
-	return fmt.Sprintf("%s_%d", prefix, timestamp)
+	return fmt.Sprintf("%s_%d_%s", prefix, timestamp, randomHex(4))
+}
+
+// maxCourseIDAttempts bounds how many suffixes InsertWithCourseID draws for one course
+const maxCourseIDAttempts = 5
+
+// CourseSlug is the readable part of a course ID: the code lowercased without
+// punctuation and the semester shortened, e.g. "CS-101", "Fall 2024" -> "cs101-fall24"
+func CourseSlug(code, semester string) string {
+	var sem strings.Builder
+	for _, field := range strings.Fields(semester) {
+		if len(field) == 4 {
+			if _, err := strconv.Atoi(field); err == nil {
+				field = field[2:]
+			}
+		}
+		sem.WriteString(slugPart(field))
+	}
+
+	slug := slugPart(code)
+	if slug == "" {
+		slug = "course"
+	}
+	if sem.Len() > 0 {
+		slug += "-" + sem.String()
+	}
+	return slug
+}
+
+// NewCourseID returns a course ID for code and semester: CourseSlug plus a short
+// random suffix, e.g. "cs101-fall24-a3f9". Seeded courses keep their legacy
+// IDs ("CS101_Fall24"); course IDs are never parsed, so both forms work everywhere.
+func NewCourseID(code, semester string) string {
+	return CourseSlug(code, semester) + "-" + randomHex(2)
+}
+
+// InsertWithCourseID calls insert with new course IDs for code and semester until
+// one is free, returning the ID used. insert must write the course under the given
+// ID; when that ID is already taken a fresh suffix is drawn and insert runs again.
+func InsertWithCourseID(code, semester string, insert func(courseID string) error) (string, error) {
+	var err error
+	for attempt := 0; attempt < maxCourseIDAttempts; attempt++ {
+		courseID := NewCourseID(code, semester)
+		if err = insert(courseID); !IsDuplicateIDError(err) {
+			return courseID, err
+		}
+	}
+	return "", fmt.Errorf("no free course ID for %s after %d attempts: %w", code, maxCourseIDAttempts, err)
+}
+
+// IsDuplicateIDError reports whether a write failed because its _id is already taken,
+// as opposed to a clash on another unique index
+func IsDuplicateIDError(err error) bool {
+	return err != nil && mongo.IsDuplicateKeyError(err) && strings.Contains(err.Error(), "index: _id_")
+}
+
+// slugPart lowercases s and keeps only its ASCII letters and digits
+func slugPart(s string) string {
+	var b strings.Builder
+	for _, r := range strings.ToLower(s) {
+		if r >= 'a' && r <= 'z' || r >= '0' && r <= '9' {
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+// randomHex returns n random bytes as lowercase hex
+func randomHex(n int) string {
+	b := make([]byte, n)
+	rand.Read(b)
+	return hex.EncodeToString(b)
 }
 
 // GenerateEnrollmentID generates enrollment ID
@@ -370,6 +617,46 @@ func GenerateEnrollmentID() string {
 	return GenerateID("ENR")
 }
 
+// GenerateReceiptID generates enrollment receipt reference ID
+func GenerateReceiptID() string {
+	return GenerateID("RCPT")
+}
+
+// GenerateTransferCreditID generates transfer credit ID
+func GenerateTransferCreditID() string {
+	return GenerateID("TRF")
+}
+
+// GenerateHoldID generates student hold ID
+func GenerateHoldID() string {
+	return GenerateID("HOLD")
+}
+
+// GenerateGradeAppealID generates grade appeal ID
+func GenerateGradeAppealID() string {
+	return GenerateID("APPEAL")
+}
+
+// GenerateCourseReviewID generates course review ID
+func GenerateCourseReviewID() string {
+	return GenerateID("REVIEW")
+}
+
+// GenerateEnrollmentEventID generates enrollment event ID
+func GenerateEnrollmentEventID() string {
+	return GenerateID("EVT")
+}
+
+// GenerateSeatHoldID generates seat hold ID
+func GenerateSeatHoldID() string {
+	return GenerateID("SEATHOLD")
+}
+
+// GenerateNotificationID generates notification ID
+func GenerateNotificationID() string {
+	return GenerateID("NOTIF")
+}
+
 // GenerateAuditLogID generates audit log ID
 func GenerateAuditLogID() string {
 	return GenerateID("AUDIT")
@@ -379,30 +666,439 @@ func GenerateAuditLogID() string {
 // Document Field Extraction Helpers
 // ============================================================================
 
-// ExtractScheduleInfo extracts schedule_info subdocument from course
-func ExtractScheduleInfo(doc bson.M) bson.M {
-	scheduleStr, err := GetString(doc["schedule"])
+// NewScheduleInfo builds the schedule_info stored on enrollments from a course
+// schedule string. Every enrollment path uses it so conflict checks see the same shape.
+func NewScheduleInfo(schedule string) ScheduleInfo {
+	days, startTime, endTime := ParseSchedule(schedule)
+	return ScheduleInfo{Days: days, StartTime: startTime, EndTime: endTime}
+}
+
+// GetCourseField safely gets a field from course document
+func GetCourseField(doc bson.M, field string) interface{} {
+	if val, exists := doc[field]; exists {
+		return val
+	}
+	return nil
+}
+
+// GetCapacityWarningPercent reads the capacity_warning_threshold system config,
+// falling back to DefaultCapacityWarningPercent when it is unset or outside 1-100
+func GetCapacityWarningPercent(ctx context.Context, systemConfigCol *mongo.Collection) int32 {
+	var cfg SystemConfig
+	if err := systemConfigCol.FindOne(ctx, bson.M{"key": ConfigCapacityWarning}).Decode(&cfg); err != nil {
+		return DefaultCapacityWarningPercent
+	}
+
+	percent, err := strconv.Atoi(strings.TrimSuffix(strings.TrimSpace(cfg.Value), "%"))
+	if err != nil || percent < 1 || percent > 100 {
+		return DefaultCapacityWarningPercent
+	}
+	return int32(percent)
+}
+
+// GetCartExpiry reads the cart_expiry_days system config, falling back to
+// DefaultCartExpiryDays when it is unset or not a positive number of days
+func GetCartExpiry(ctx context.Context, systemConfigCol *mongo.Collection) time.Duration {
+	days := DefaultCartExpiryDays
+	var cfg SystemConfig
+	if err := systemConfigCol.FindOne(ctx, bson.M{"key": ConfigCartExpiryDays}).Decode(&cfg); err == nil {
+		if n, err := strconv.Atoi(strings.TrimSpace(cfg.Value)); err == nil && n > 0 {
+			days = n
+		}
+	}
+	return time.Duration(days) * 24 * time.Hour
+}
+
+// GetCartValidationTTL reads the cart_validation_ttl_seconds system config, falling back
+// to DefaultCartValidationTTLSeconds when it is unset or not a positive number of seconds
+func GetCartValidationTTL(ctx context.Context, systemConfigCol *mongo.Collection) time.Duration {
+	seconds := DefaultCartValidationTTLSeconds
+	var cfg SystemConfig
+	if err := systemConfigCol.FindOne(ctx, bson.M{"key": ConfigCartValidationTTL}).Decode(&cfg); err == nil {
+		if n, err := strconv.Atoi(strings.TrimSpace(cfg.Value)); err == nil && n > 0 {
+			seconds = n
+		}
+	}
+	return time.Duration(seconds) * time.Second
+}
+
+// GetSeatHoldDuration reads the seat hold config. It returns 0, meaning seat holds
+// are off, unless seat_holds_enabled is true; the duration is seat_hold_minutes,
+// falling back to DefaultSeatHoldMinutes when that is unset or not positive.
+func GetSeatHoldDuration(ctx context.Context, systemConfigCol *mongo.Collection) time.Duration {
+	cursor, err := systemConfigCol.Find(ctx, bson.M{"key": bson.M{"$in": []string{
+		ConfigSeatHoldsEnabled, ConfigSeatHoldMinutes,
+	}}})
+	if err != nil {
+		log.Printf("Warning: failed to read seat hold config: %v", err)
+		return 0
+	}
+	var configs []SystemConfig
+	if err := cursor.All(ctx, &configs); err != nil {
+		log.Printf("Warning: failed to decode seat hold config: %v", err)
+		return 0
+	}
+
+	enabled, minutes := false, DefaultSeatHoldMinutes
+	for _, cfg := range configs {
+		value := strings.TrimSpace(cfg.Value)
+		switch cfg.Key {
+		case ConfigSeatHoldsEnabled:
+			enabled, _ = strconv.ParseBool(value)
+		case ConfigSeatHoldMinutes:
+			if n, err := strconv.Atoi(value); err == nil && n > 0 {
+				minutes = n
+			}
+		}
+	}
+	if !enabled {
+		return 0
+	}
+	return time.Duration(minutes) * time.Minute
+}
+
+// CountSeatHolds counts the unexpired seat holds on a course, leaving out those of
+// exceptStudentID when it is set. The TTL monitor can lag, so expires_at is checked too.
+func CountSeatHolds(ctx context.Context, seatHoldsCol *mongo.Collection, courseID, exceptStudentID string, now time.Time) (int32, error) {
+	filter := bson.M{"course_id": courseID, "expires_at": bson.M{"$gt": now}}
+	if exceptStudentID != "" {
+		filter["student_id"] = bson.M{"$ne": exceptStudentID}
+	}
+	n, err := seatHoldsCol.CountDocuments(ctx, filter)
+	return int32(n), err
+}
+
+// CountSeatHoldsByCourse is CountSeatHolds for many courses in one query; courses
+// without holds are left out of the result
+func CountSeatHoldsByCourse(ctx context.Context, seatHoldsCol *mongo.Collection, courseIDs []string, exceptStudentID string, now time.Time) (map[string]int32, error) {
+	held := make(map[string]int32)
+	if len(courseIDs) == 0 {
+		return held, nil
+	}
+	match := bson.M{"course_id": bson.M{"$in": courseIDs}, "expires_at": bson.M{"$gt": now}}
+	if exceptStudentID != "" {
+		match["student_id"] = bson.M{"$ne": exceptStudentID}
+	}
+	cursor, err := seatHoldsCol.Aggregate(ctx, mongo.Pipeline{
+		{{Key: "$match", Value: match}},
+		{{Key: "$group", Value: bson.M{"_id": "$course_id", "held": bson.M{"$sum": 1}}}},
+	})
 	if err != nil {
-		return bson.M{}
+		return nil, err
+	}
+	var counts []struct {
+		CourseID string `bson:"_id"`
+		Held     int32  `bson:"held"`
+	}
+	if err := cursor.All(ctx, &counts); err != nil {
+		return nil, err
+	}
+	for _, c := range counts {
+		held[c.CourseID] = c.Held
+	}
+	return held, nil
+}
+
+// IsSemesterClosed reports whether CloseSemester has finished with a semester; its
+// courses then take no more enrollments
+func IsSemesterClosed(ctx context.Context, closuresCol *mongo.Collection, semester string) (bool, error) {
+	if semester == "" {
+		return false, nil
 	}
+	n, err := closuresCol.CountDocuments(ctx, bson.M{"_id": semester}, options.Count().SetLimit(1))
+	return n > 0, err
+}
 
-	days, startTime, endTime := ParseSchedule(scheduleStr)
+// GetMinFullTimeUnits reads the min_full_time_units system config. It returns 0, which
+// turns the full-time warning off, when the key is unset or not a positive number.
+func GetMinFullTimeUnits(ctx context.Context, systemConfigCol *mongo.Collection) int32 {
+	var cfg SystemConfig
+	if err := systemConfigCol.FindOne(ctx, bson.M{"key": ConfigMinFullTimeUnits}).Decode(&cfg); err != nil {
+		return 0
+	}
+	n, err := strconv.Atoi(strings.TrimSpace(cfg.Value))
+	if err != nil || n < 0 {
+		return 0
+	}
+	return int32(n)
+}
+
+// GetMaxDrops reads the max_drops_per_semester system config. It returns 0, meaning
+// drops are not limited, when the key is unset or outside the range ValidateConfigValue
+// allows.
+func GetMaxDrops(ctx context.Context, systemConfigCol *mongo.Collection) int32 {
+	var cfg SystemConfig
+	if err := systemConfigCol.FindOne(ctx, bson.M{"key": ConfigMaxDrops}).Decode(&cfg); err != nil {
+		return 0
+	}
+	if err := ValidateConfigValue(cfg.Key, cfg.Value); err != nil {
+		log.Printf("Warning: invalid %s config value %q, not limiting drops", cfg.Key, cfg.Value)
+		return 0
+	}
+	n, _ := strconv.Atoi(strings.TrimSpace(cfg.Value))
+	return int32(n)
+}
 
+// SelfDropFilter matches the enrollments a student dropped themselves in any of
+// courseIDs, the drops max_drops_per_semester counts
+func SelfDropFilter(studentID string, courseIDs interface{}) bson.M {
 	return bson.M{
-		"days":       days,
-		"start_time": startTime,
-		"end_time":   endTime,
+		"student_id":  studentID,
+		"status":      StatusDropped,
+		"course_id":   bson.M{"$in": courseIDs},
+		"drop_reason": bson.M{"$exists": false},
 	}
 }
 
-// GetCourseField safely gets a field from course document
-func GetCourseField(doc bson.M, field string) interface{} {
-	if val, exists := doc[field]; exists {
-		return val
+// DropSource reports who made a drop from its drop_reason
+func DropSource(dropReason string) string {
+	if dropReason == "" {
+		return DropSourceSelf
+	}
+	return dropReason
+}
+
+// GetAppealWindow reads the grade_appeal_window_days system config, falling back to
+// DefaultAppealWindowDays when it is unset or not a positive number of days
+func GetAppealWindow(ctx context.Context, systemConfigCol *mongo.Collection) time.Duration {
+	days := DefaultAppealWindowDays
+	var cfg SystemConfig
+	if err := systemConfigCol.FindOne(ctx, bson.M{"key": ConfigAppealWindowDays}).Decode(&cfg); err == nil {
+		if n, err := strconv.Atoi(strings.TrimSpace(cfg.Value)); err == nil && n > 0 {
+			days = n
+		}
+	}
+	return time.Duration(days) * 24 * time.Hour
+}
+
+// CartLimits are the effective caps on a student's cart and semester load
+type CartLimits struct {
+	MaxCourses int
+	MaxUnits   int
+}
+
+// LoadCartLimits reads the max_courses_in_cart and max_units_per_semester system
+// config, falling back to MaxCoursesInCart and MaxUnitsPerSemester for a key that is
+// unset or outside the range ValidateConfigValue allows
+func LoadCartLimits(ctx context.Context, systemConfigCol *mongo.Collection) CartLimits {
+	limits := CartLimits{MaxCourses: MaxCoursesInCart, MaxUnits: MaxUnitsPerSemester}
+
+	cursor, err := systemConfigCol.Find(ctx, bson.M{"key": bson.M{"$in": []string{
+		ConfigMaxCourses, ConfigMaxUnits,
+	}}})
+	if err != nil {
+		log.Printf("Warning: failed to read cart limit config: %v", err)
+		return limits
+	}
+	var configs []SystemConfig
+	if err := cursor.All(ctx, &configs); err != nil {
+		log.Printf("Warning: failed to decode cart limit config: %v", err)
+		return limits
+	}
+
+	for _, cfg := range configs {
+		if err := ValidateConfigValue(cfg.Key, cfg.Value); err != nil {
+			log.Printf("Warning: invalid %s config value %q, using default", cfg.Key, cfg.Value)
+			continue
+		}
+		n, _ := strconv.Atoi(strings.TrimSpace(cfg.Value))
+		switch cfg.Key {
+		case ConfigMaxCourses:
+			limits.MaxCourses = n
+		case ConfigMaxUnits:
+			limits.MaxUnits = n
+		}
+	}
+	return limits
+}
+
+// BumpCatalogVersion stamps course_catalog_version with the current time so course
+// services stop serving catalog pages cached before a course change
+func BumpCatalogVersion(ctx context.Context, systemConfigCol *mongo.Collection) error {
+	_, err := systemConfigCol.UpdateOne(ctx, bson.M{"key": ConfigCatalogVersion}, bson.M{
+		"$set": bson.M{"value": strconv.FormatInt(time.Now().UnixNano(), 10), "updated_at": time.Now(), "updated_by": "system"},
+	}, options.Update().SetUpsert(true))
+	if err != nil {
+		return fmt.Errorf("failed to bump %s: %w", ConfigCatalogVersion, err)
 	}
 	return nil
 }
 
+// DefaultSemester names the semester a date falls in: Spring from January to May,
+// Summer in June and July, Fall from August
+func DefaultSemester(now time.Time) string {
+	switch {
+	case now.Month() <= time.May:
+		return fmt.Sprintf("Spring %d", now.Year())
+	case now.Month() <= time.July:
+		return fmt.Sprintf("Summer %d", now.Year())
+	default:
+		return fmt.Sprintf("Fall %d", now.Year())
+	}
+}
+
+// DefaultConfig is the value of every known system config key on a fresh deployment.
+// Each value matches how the readers treat a missing key, so seeding an existing
+// deployment changes nothing; an empty value means the setting is off or unbounded.
+func DefaultConfig(now time.Time) []SystemConfig {
+	itoa := strconv.Itoa
+	configs := []SystemConfig{
+		{Key: ConfigCurrentSemester, Value: DefaultSemester(now), Description: "Current active academic semester"},
+		{Key: ConfigEnrollmentOn, Value: "true", Description: "Flag to enable/disable enrollment system-wide"},
+		{Key: ConfigEnrollmentStart, Value: "", Description: "Start date of enrollment period (empty: no bound)"},
+		{Key: ConfigEnrollmentEnd, Value: "", Description: "End date of enrollment period (empty: no bound)"},
+		{Key: ConfigDropGrace, Value: itoa(DefaultDropGraceHours), Description: "Hours after the enrollment period during which students may still drop"},
+		{Key: ConfigMaxUnits, Value: itoa(MaxUnitsPerSemester), Description: "Maximum units a student may take per semester"},
+		{Key: ConfigMaxCourses, Value: itoa(MaxCoursesInCart), Description: "Maximum courses in a cart"},
+		{Key: ConfigGradeDeadline, Value: "", Description: "Grade upload deadline (empty: none)"},
+		{Key: ConfigGradeEnrolled, Value: "true", Description: "Allow grading students who are still enrolled, before the semester is closed"},
+		{Key: ConfigCapacityWarning, Value: itoa(DefaultCapacityWarningPercent), Description: "Fill rate percent that triggers a capacity warning"},
+		{Key: ConfigRestoreWindow, Value: itoa(DefaultRestoreWindowHours), Description: "Hours after a drop during which an admin may restore it"},
+		{Key: ConfigOverrideAutoClose, Value: "true", Description: "Close a course once a forced enrollment fills it"},
+		{Key: ConfigGradesPageSize, Value: itoa(DefaultGradesPageSize), Description: "Default page size of a student's grade history"},
+		{Key: ConfigCartExpiryDays, Value: itoa(DefaultCartExpiryDays), Description: "Days an untouched cart is kept"},
+		{Key: ConfigSeatHoldsEnabled, Value: "false", Description: "Hold seats in nearly full courses for students who carted them"},
+		{Key: ConfigSeatHoldMinutes, Value: itoa(DefaultSeatHoldMinutes), Description: "Minutes a seat hold lasts"},
+		{Key: ConfigMinFullTimeUnits, Value: "0", Description: "Units below which students are warned they are not full-time (0: off)"},
+		{Key: ConfigCartValidationTTL, Value: itoa(DefaultCartValidationTTLSeconds), Description: "Seconds enrollment may reuse stored cart validation"},
+		{Key: ConfigAppealWindowDays, Value: itoa(DefaultAppealWindowDays), Description: "Days after publication during which a grade may be appealed"},
+		{Key: ConfigFacultyLoadMaxUnits, Value: itoa(DefaultFacultyLoadMaxUnits), Description: "Units per semester above which a faculty load is flagged"},
+		{Key: ConfigMaxDrops, Value: "0", Description: "Courses a student may drop per semester (0: no limit)"},
+	}
+	for i := range configs {
+		configs[i].UpdatedAt = now
+		configs[i].UpdatedBy = "system"
+	}
+	return configs
+}
+
+// EnsureDefaultConfig inserts DefaultConfig for every key missing from system_config,
+// leaving existing values untouched, and returns the keys it created
+func EnsureDefaultConfig(ctx context.Context, db *mongo.Database) ([]string, error) {
+	configCol := db.Collection("system_config")
+	opts := options.Update().SetUpsert(true)
+
+	var created []string
+	for _, cfg := range DefaultConfig(time.Now()) {
+		res, err := configCol.UpdateOne(ctx, bson.M{"key": cfg.Key}, bson.M{"$setOnInsert": cfg}, opts)
+		if err != nil {
+			return created, fmt.Errorf("failed to set default %s config: %w", cfg.Key, err)
+		}
+		if res.UpsertedCount > 0 {
+			created = append(created, cfg.Key)
+		}
+	}
+	return created, nil
+}
+
+// LoadEnrollmentWindow reads the enrollment period from system config. Dates are
+// RFC 3339 timestamps or YYYY-MM-DD dates; a date-only end covers that whole day.
+// Unset or invalid values leave the window unbounded on that side.
+func LoadEnrollmentWindow(ctx context.Context, systemConfigCol *mongo.Collection) EnrollmentWindow {
+	window := EnrollmentWindow{Enabled: true, DropGrace: DefaultDropGraceHours * time.Hour}
+
+	cursor, err := systemConfigCol.Find(ctx, bson.M{"key": bson.M{"$in": []string{
+		ConfigEnrollmentStart, ConfigEnrollmentEnd, ConfigEnrollmentOn, ConfigDropGrace,
+	}}})
+	if err != nil {
+		log.Printf("Warning: failed to read enrollment window config: %v", err)
+		return window
+	}
+	var configs []SystemConfig
+	if err := cursor.All(ctx, &configs); err != nil {
+		log.Printf("Warning: failed to decode enrollment window config: %v", err)
+		return window
+	}
+
+	for _, cfg := range configs {
+		value := strings.TrimSpace(cfg.Value)
+		switch cfg.Key {
+		case ConfigEnrollmentStart:
+			window.Start, _ = parseWindowDate(value, false)
+		case ConfigEnrollmentEnd:
+			window.End, _ = parseWindowDate(value, true)
+		case ConfigEnrollmentOn:
+			if enabled, err := strconv.ParseBool(value); err == nil {
+				window.Enabled = enabled
+			}
+		case ConfigDropGrace:
+			if hours, err := strconv.Atoi(value); err == nil && hours >= 0 {
+				window.DropGrace = time.Duration(hours) * time.Hour
+			}
+		}
+	}
+	return window
+}
+
+// LoadGradeDeadline reads the grade_upload_deadline system config, returning the
+// zero time when it is unset or invalid. A date-only value means the end of that day.
+func LoadGradeDeadline(ctx context.Context, systemConfigCol *mongo.Collection) time.Time {
+	var cfg SystemConfig
+	if err := systemConfigCol.FindOne(ctx, bson.M{"key": ConfigGradeDeadline}).Decode(&cfg); err != nil {
+		if err != mongo.ErrNoDocuments {
+			log.Printf("Warning: failed to read %s config: %v", ConfigGradeDeadline, err)
+		}
+		return time.Time{}
+	}
+	deadline, _ := parseWindowDate(strings.TrimSpace(cfg.Value), true)
+	return deadline
+}
+
+// parseWindowDate parses an enrollment window bound; endOfDay moves a date-only
+// value to the last instant of that day
+func parseWindowDate(value string, endOfDay bool) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, fmt.Errorf("empty date")
+	}
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+	t, err := time.Parse("2006-01-02", value)
+	if err != nil {
+		log.Printf("Warning: invalid enrollment window date %q", value)
+		return time.Time{}, err
+	}
+	if endOfDay {
+		t = t.Add(24*time.Hour - time.Nanosecond)
+	}
+	return t, nil
+}
+
+// ParseEnrollmentPeriod validates a new enrollment period: both bounds in a format
+// LoadEnrollmentWindow accepts, and the end after the start
+func ParseEnrollmentPeriod(startDate, endDate string) (start, end time.Time, err error) {
+	if start, err = parseWindowDate(strings.TrimSpace(startDate), false); err != nil {
+		return start, end, fmt.Errorf("start_date must be an RFC 3339 timestamp or a YYYY-MM-DD date")
+	}
+	if end, err = parseWindowDate(strings.TrimSpace(endDate), true); err != nil {
+		return start, end, fmt.Errorf("end_date must be an RFC 3339 timestamp or a YYYY-MM-DD date")
+	}
+	if !end.After(start) {
+		return start, end, fmt.Errorf("end_date must be after start_date")
+	}
+	return start, end, nil
+}
+
+// ParseCourseWindow validates a course's own enrollment window. Either bound may
+// be empty to leave that side open; when both are set the close must come after
+// the open.
+func ParseCourseWindow(openAt, closeAt string) (opens, closes time.Time, err error) {
+	if openAt = strings.TrimSpace(openAt); openAt != "" {
+		if opens, err = parseWindowDate(openAt, false); err != nil {
+			return opens, closes, fmt.Errorf("enroll_open_at must be an RFC 3339 timestamp or a YYYY-MM-DD date")
+		}
+	}
+	if closeAt = strings.TrimSpace(closeAt); closeAt != "" {
+		if closes, err = parseWindowDate(closeAt, true); err != nil {
+			return opens, closes, fmt.Errorf("enroll_close_at must be an RFC 3339 timestamp or a YYYY-MM-DD date")
+		}
+	}
+	if !opens.IsZero() && !closes.IsZero() && !closes.After(opens) {
+		return opens, closes, fmt.Errorf("enroll_close_at must be after enroll_open_at")
+	}
+	return opens, closes, nil
+}
+
 // ============================================================================
 // Audit Logging Helper
 // ============================================================================
@@ -424,6 +1120,9 @@ func LogAuditEvent(ctx context.Context, auditCol *mongo.Collection, userID, acti
 	if details != nil {
 		auditDoc["details"] = details
 	}
+	if key := IdempotencyKeyFromContext(ctx); key != "" {
+		auditDoc["idempotency_key"] = key
+	}
 
 	insertCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
 	defer cancel()
@@ -437,6 +1136,176 @@ func LogAuditEvent(ctx context.Context, auditCol *mongo.Collection, userID, acti
 	return nil
 }
 
+// IdempotencyKeyFromContext returns the Idempotency-Key the gateway forwarded
+// with the incoming gRPC call, or "" when the client sent none
+func IdempotencyKeyFromContext(ctx context.Context) string {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(MetadataIdempotencyKey); len(values) > 0 {
+			return values[0]
+		}
+	}
+	return ""
+}
+
+// RecordAuditEvent is LogAuditEvent for writes that must not outlive their audit
+// entry. Inside a transaction a failed insert is returned so the caller's writes
+// roll back with it; in best-effort mode (standalone MongoDB) nothing can be rolled
+// back, so it is only logged. Writes outside a transaction keep using LogAuditEvent,
+// where auditing never fails the request.
+func RecordAuditEvent(ctx context.Context, client *mongo.Client, auditCol *mongo.Collection, userID, action, resource string, details map[string]interface{}) error {
+	err := LogAuditEvent(ctx, auditCol, userID, action, resource, details)
+	if err == nil {
+		return nil
+	}
+	if mode, _ := DetectTransactionMode(ctx, client); mode == TransactionModeBestEffort {
+		return nil
+	}
+	return fmt.Errorf("failed to record audit event: %w", err)
+}
+
+// RecordEnrollmentEvent inserts a seat change into enrollment_events. Inside a
+// transaction a failed insert is returned so the change stays atomic; in best-effort
+// mode (standalone MongoDB) it is only logged so the enrollment still goes through.
+func RecordEnrollmentEvent(ctx context.Context, client *mongo.Client, eventsCol *mongo.Collection, courseID string, delta int32, source string) error {
+	_, err := eventsCol.InsertOne(ctx, EnrollmentEvent{
+		ID:        GenerateEnrollmentEventID(),
+		CourseID:  courseID,
+		Delta:     delta,
+		Source:    source,
+		Timestamp: time.Now(),
+	})
+	if err == nil {
+		return nil
+	}
+	if mode, _ := DetectTransactionMode(ctx, client); mode == TransactionModeBestEffort {
+		log.Printf("Warning: failed to record enrollment event for %s: %v", courseID, err)
+		return nil
+	}
+	return fmt.Errorf("failed to record enrollment event: %w", err)
+}
+
+// BatchGetUsers loads the users with the given IDs in one query, keyed by ID.
+// IDs without a user are left out of the map.
+func BatchGetUsers(ctx context.Context, usersCol *mongo.Collection, ids []string) (map[string]*User, error) {
+	users := make(map[string]*User, len(ids))
+	if len(ids) == 0 {
+		return users, nil
+	}
+	cursor, err := usersCol.Find(ctx, bson.M{"_id": bson.M{"$in": ids}})
+	if err != nil {
+		return nil, err
+	}
+	var found []User
+	if err := cursor.All(ctx, &found); err != nil {
+		return nil, err
+	}
+	for i := range found {
+		users[found[i].ID] = &found[i]
+	}
+	return users, nil
+}
+
+// SeatHoldingFilter matches the enrollments counted against course capacity: active
+// ones and withdrawals after the drop deadline, which keep their seat for the term
+func SeatHoldingFilter() bson.M {
+	return bson.M{"$or": bson.A{
+		bson.M{"status": StatusEnrolled},
+		bson.M{"status": StatusWithdrawn, "seat_held": true},
+	}}
+}
+
+// FindCurrentEnrollment returns a student's active enrollment in a course, or the
+// completed one when there is none, so a drop can tell "not enrolled" apart from a
+// change CanTransition refuses. It returns mongo.ErrNoDocuments when neither exists.
+func FindCurrentEnrollment(ctx context.Context, enrollmentsCol *mongo.Collection, studentID, courseID string) (*Enrollment, error) {
+	var enrollment Enrollment
+	err := enrollmentsCol.FindOne(ctx,
+		bson.M{
+			"student_id": studentID,
+			"course_id":  courseID,
+			"status":     bson.M{"$in": []string{StatusEnrolled, StatusCompleted}},
+		},
+		// "enrolled" sorts after "completed"
+		options.FindOne().SetSort(bson.D{{Key: "status", Value: -1}}),
+	).Decode(&enrollment)
+	if err != nil {
+		return nil, err
+	}
+	return &enrollment, nil
+}
+
+// TransitionEnrollment moves an enrollment to a new status after CheckTransition.
+// The write only matches while the stored status is still the one that was checked,
+// so a concurrent change returns ErrConcurrentModification instead of being
+// overwritten. update may carry other fields and operators; status is added to $set.
+func TransitionEnrollment(ctx context.Context, enrollmentsCol *mongo.Collection, enrollment *Enrollment, to string, update bson.M) error {
+	if err := CheckTransition(enrollment.Status, to); err != nil {
+		return err
+	}
+	if update == nil {
+		update = bson.M{}
+	}
+	set, _ := update["$set"].(bson.M)
+	if set == nil {
+		set = bson.M{}
+		update["$set"] = set
+	}
+	set["status"] = to
+
+	res, err := enrollmentsCol.UpdateOne(ctx, bson.M{"_id": enrollment.ID, "status": enrollment.Status}, update)
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount == 0 {
+		return ErrConcurrentModification.Newf("enrollment changed concurrently").WithParam("enrollment_id", enrollment.ID)
+	}
+	enrollment.Status = to
+	return nil
+}
+
+// ============================================================================
+// Department Helpers
+// ============================================================================
+
+// DepartmentCollation compares department names case-insensitively
+var DepartmentCollation = &options.Collation{Locale: "en", Strength: 2}
+
+// FindDepartment looks a department up by its code or, ignoring case, its name,
+// so "CS", "cs" and "computer science" all find Computer Science. It returns
+// mongo.ErrNoDocuments when none matches.
+func FindDepartment(ctx context.Context, departmentsCol *mongo.Collection, codeOrName string) (*Department, error) {
+	value := strings.TrimSpace(codeOrName)
+	var department Department
+	err := departmentsCol.FindOne(ctx,
+		bson.M{"$or": []bson.M{{"_id": NormalizeDepartment(value)}, {"name": value}}},
+		options.FindOne().SetCollation(DepartmentCollation),
+	).Decode(&department)
+	if err != nil {
+		return nil, err
+	}
+	return &department, nil
+}
+
+// RegisterDepartments adds the codes missing from departments, named after the
+// code until an admin renames them. Departments parsed from course codes are
+// registered this way so every course department is listed.
+func RegisterDepartments(ctx context.Context, departmentsCol *mongo.Collection, codes ...string) error {
+	for _, code := range codes {
+		if code = NormalizeDepartment(code); code == "" {
+			continue
+		}
+		_, err := departmentsCol.UpdateOne(ctx,
+			bson.M{"_id": code},
+			bson.M{"$setOnInsert": bson.M{"name": code, "created_at": time.Now()}},
+			options.Update().SetUpsert(true),
+		)
+		if err != nil {
+			return fmt.Errorf("failed to register department %q: %w", code, err)
+		}
+	}
+	return nil
+}
+
 // ============================================================================
 // Query Helpers
 // ============================================================================
@@ -482,18 +1351,107 @@ func FindOneWithTimeout(ctx context.Context, col *mongo.Collection, filter bson.
 	return nil
 }
 
+// ============================================================================
+// Pagination
+// ============================================================================
+
+// Page size bounds Paginate enforces; list RPCs with tighter limits apply them
+// with NormalizePage first
+const (
+	DefaultPageSize = 50
+	MaxPageSize     = 500
+)
+
+// PageInfo describes one page of a paginated list
+type PageInfo struct {
+	Total    int64
+	Page     int32
+	PageSize int32
+	HasMore  bool
+}
+
+// NormalizePage clamps page to at least 1 and pageSize to 1..max, using def when
+// no page size was requested
+func NormalizePage(page, pageSize, def, max int32) (int32, int32) {
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 {
+		pageSize = def
+	}
+	if pageSize > max {
+		pageSize = max
+	}
+	return page, pageSize
+}
+
+// newPageInfo reports whether documents remain after the given page
+func newPageInfo(total int64, page, pageSize int32) PageInfo {
+	return PageInfo{
+		Total:    total,
+		Page:     page,
+		PageSize: pageSize,
+		HasMore:  int64(page)*int64(pageSize) < total,
+	}
+}
+
+// pageSkip is the number of documents before the given page
+func pageSkip(page, pageSize int32) int64 {
+	return int64(page-1) * int64(pageSize)
+}
+
+// Paginate counts the documents matching filter and decodes the requested page of
+// them as T. opts should sort on a unique tiebreaker (such as _id) so pages never
+// overlap; its skip and limit are overwritten. page and pageSize are normalized
+// with DefaultPageSize and MaxPageSize.
+func Paginate[T any](ctx context.Context, col *mongo.Collection, filter interface{}, opts *options.FindOptions, page, pageSize int32) ([]T, PageInfo, error) {
+	page, pageSize = NormalizePage(page, pageSize, DefaultPageSize, MaxPageSize)
+
+	total, err := col.CountDocuments(ctx, filter)
+	if err != nil {
+		return nil, PageInfo{}, fmt.Errorf("failed to count documents: %w", err)
+	}
+
+	if opts == nil {
+		opts = options.Find()
+	}
+	opts.SetSkip(pageSkip(page, pageSize)).SetLimit(int64(pageSize))
+	cursor, err := col.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, PageInfo{}, fmt.Errorf("failed to find page %d: %w", page, err)
+	}
+	var results []T
+	if err := cursor.All(ctx, &results); err != nil {
+		return nil, PageInfo{}, fmt.Errorf("failed to decode page %d: %w", page, err)
+	}
+
+	return results, newPageInfo(total, page, pageSize), nil
+}
+
 // ============================================================================
 // Transaction Helpers
 // ============================================================================
 
-// WithTransaction executes a function within a MongoDB transaction
+// WithTransaction executes a function within a MongoDB transaction. On a standalone
+// mongod, which cannot run transactions, the function runs in a plain session instead.
 func WithTransaction(ctx context.Context, client *mongo.Client, fn func(sessCtx mongo.SessionContext) error) error {
+	mode, err := DetectTransactionMode(ctx, client)
+	if err != nil {
+		// Unknown topology: attempt the transaction and let the server decide
+		log.Printf("Warning: could not detect transaction support: %v", err)
+		mode = TransactionModeTransactional
+	}
+
 	session, err := client.StartSession()
 	if err != nil {
 		return fmt.Errorf("failed to start session: %w", err)
 	}
 	defer session.EndSession(ctx)
 
+	if mode == TransactionModeBestEffort {
+		return mongo.WithSession(ctx, session, fn)
+	}
+
 	_, err = session.WithTransaction(ctx, func(sessCtx mongo.SessionContext) (interface{}, error) {
 		return nil, fn(sessCtx)
 	})
@@ -501,6 +1459,48 @@ func WithTransaction(ctx context.Context, client *mongo.Client, fn func(sessCtx
 	return err
 }
 
+// DetectTransactionMode asks the server whether it is a replica set member or mongos,
+// the deployments that support transactions. The result is cached per client.
+func DetectTransactionMode(ctx context.Context, client *mongo.Client) (TransactionMode, error) {
+	if mode, ok := transactionModes.Load(client); ok {
+		return mode.(TransactionMode), nil
+	}
+
+	var hello struct {
+		SetName string `bson:"setName"`
+		Msg     string `bson:"msg"`
+	}
+	admin := client.Database("admin")
+	err := admin.RunCommand(ctx, bson.D{{Key: "hello", Value: 1}}).Decode(&hello)
+	if err != nil {
+		// Servers older than 4.4.2 only know the legacy command
+		err = admin.RunCommand(ctx, bson.D{{Key: "isMaster", Value: 1}}).Decode(&hello)
+	}
+	if err != nil {
+		return "", err
+	}
+
+	mode := transactionModeFromHello(hello.SetName, hello.Msg)
+	if mode == TransactionModeBestEffort {
+		log.Println("WARNING: ==========================================================")
+		log.Println("WARNING: MongoDB is a standalone server without transaction support.")
+		log.Println("WARNING: Multi-document writes (enroll, drop, overrides) run best-effort")
+		log.Println("WARNING: and are not atomic. Use a replica set outside local development.")
+		log.Println("WARNING: ==========================================================")
+	}
+	transactionModes.Store(client, mode)
+	return mode, nil
+}
+
+// transactionModeFromHello classifies a hello reply: replica set members report
+// setName and mongos reports msg "isdbgrid"
+func transactionModeFromHello(setName, msg string) TransactionMode {
+	if setName != "" || msg == "isdbgrid" {
+		return TransactionModeTransactional
+	}
+	return TransactionModeBestEffort
+}
+
 // ============================================================================
 // Validation Helpers
 // ============================================================================
@@ -515,6 +1515,46 @@ func ValidateRequiredFields(doc bson.M, requiredFields []string) error {
 	return nil
 }
 
+// configIntRanges bounds the system config values that must be whole numbers
+var configIntRanges = map[string][2]int{
+	ConfigMaxCourses: {1, MaxCoursesInCartSetting},
+	ConfigMaxUnits:   {1, MaxUnitsPerSemesterSetting},
+	ConfigMaxDrops:   {0, MaxDropsPerSemesterSetting},
+}
+
+// ValidateConfigValue checks a system config value before it is stored. Keys without
+// a known range accept any value. The returned ErrInvalidField carries the key as its
+// "field" param.
+func ValidateConfigValue(key, value string) error {
+	bounds, ok := configIntRanges[key]
+	if !ok {
+		return nil
+	}
+	n, err := strconv.Atoi(strings.TrimSpace(value))
+	if err != nil || n < bounds[0] || n > bounds[1] {
+		return ErrInvalidField.Newf("%s must be a whole number from %d to %d", key, bounds[0], bounds[1]).WithParam("field", key)
+	}
+	return nil
+}
+
+// ValidateText checks a free-text field: valid UTF-8, at most maxLen characters
+// and free of control characters, though multiline fields may keep newlines and
+// tabs. The returned ErrInvalidField carries the field name as its "field" param.
+func ValidateText(field, value string, maxLen int, multiline bool) error {
+	if !utf8.ValidString(value) {
+		return ErrInvalidField.Newf("%s must be valid UTF-8", field).WithParam("field", field)
+	}
+	if utf8.RuneCountInString(value) > maxLen {
+		return ErrInvalidField.Newf("%s must be at most %d characters", field, maxLen).WithParam("field", field)
+	}
+	for _, r := range value {
+		if unicode.IsControl(r) && !(multiline && (r == '\n' || r == '\r' || r == '\t')) {
+			return ErrInvalidField.Newf("%s must not contain control characters", field).WithParam("field", field)
+		}
+	}
+	return nil
+}
+
 // IsValidGrade checks if grade is valid according to schema
 func IsValidGrade(grade string) bool {
 	validGrades := map[string]bool{
@@ -526,11 +1566,30 @@ func IsValidGrade(grade string) bool {
 // IsValidEnrollmentStatus checks if enrollment status is valid
 func IsValidEnrollmentStatus(status string) bool {
 	validStatuses := map[string]bool{
-		"enrolled": true, "dropped": true, "completed": true,
+		"enrolled": true, "dropped": true, "completed": true, "withdrawn": true,
 	}
 	return validStatuses[status]
 }
 
+// IsValidHoldType checks if a hold type is one of the known kinds
+func IsValidHoldType(holdType string) bool {
+	switch holdType {
+	case HoldFinancial, HoldDisciplinary, HoldAcademic, HoldAdministrative:
+		return true
+	}
+	return false
+}
+
+// NormalizeEmail is the stored form of a login email: trimmed and lowercased,
+// so the same address in any case names one account
+func NormalizeEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
+
+// EmailCollation compares login emails case-insensitively; it backs the unique
+// email index and duplicate checks against emails stored before normalization
+var EmailCollation = &options.Collation{Locale: "en", Strength: 2}
+
 // IsValidRole checks if user role is valid
 func IsValidRole(role string) bool {
 	validRoles := map[string]bool{
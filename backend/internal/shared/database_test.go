@@ -0,0 +1,451 @@
+package shared
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"go.mongodb.org/mongo-driver/mongo"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestValidateSchedule(t *testing.T) {
+	valid := []string{"MWF 9:00-10:00", "TTH 14:00-15:30", "S 08:00-12:00"}
+	for _, s := range valid {
+		if err := ValidateSchedule(s); err != nil {
+			t.Errorf("Expected %q to be valid, got %v", s, err)
+		}
+	}
+
+	invalid := []string{"", "MWF", "MWF 9:00", "MXF 9:00-10:00", "MMW 9:00-10:00", "MWF 9:60-10:00", "MWF 25:00-26:00", "MWF 10:00-9:00", "MWF 9:00-10:00 extra"}
+	for _, s := range invalid {
+		if err := ValidateSchedule(s); err == nil {
+			t.Errorf("Expected %q to be rejected", s)
+		}
+	}
+}
+
+func TestWeeklyContactHours(t *testing.T) {
+	cases := map[string]float64{
+		"MWF 9:00-10:00":  3,
+		"TTH 14:00-15:30": 3,
+		"S 08:00-12:00":   4,
+		"":                0,
+		"MWF":             0,
+	}
+	for schedule, want := range cases {
+		if got := WeeklyContactHours(schedule); got != want {
+			t.Errorf("WeeklyContactHours(%q) = %v, want %v", schedule, got, want)
+		}
+	}
+}
+
+func TestNewScheduleSlot(t *testing.T) {
+	slot := NewScheduleSlot("TTH 14:00-15:30")
+	if slot == nil || len(slot.Days) != 2 || slot.Days[1] != "TH" || slot.StartMinute != 840 || slot.EndMinute != 930 {
+		t.Errorf("Expected T/TH 840-930, got %+v", slot)
+	}
+	if NewScheduleSlot("") != nil || NewScheduleSlot("MWF 10:00-9:00") != nil {
+		t.Error("Expected no slot for unscheduled or invalid schedules")
+	}
+}
+
+func TestParseMeetingDays(t *testing.T) {
+	for value, want := range map[string]string{
+		"TTH":     "T TH",
+		"t, th":   "T TH",
+		"MWF,M":   "M W F",
+		"S":       "S",
+		"":        "",
+		"TTH,TTH": "T TH",
+	} {
+		days, err := ParseMeetingDays(value)
+		if err != nil || strings.Join(days, " ") != want {
+			t.Errorf("ParseMeetingDays(%q) = %v, %v; want %q", value, days, err, want)
+		}
+	}
+	if _, err := ParseMeetingDays("MX"); err == nil {
+		t.Error("Expected an unknown day to be rejected")
+	}
+}
+
+func TestParseTimeWindow(t *testing.T) {
+	if from, to, err := ParseTimeWindow("12:00", "18:30"); err != nil || from != 720 || to != 1110 {
+		t.Errorf("Expected 720-1110, got %d-%d (%v)", from, to, err)
+	}
+	if from, to, err := ParseTimeWindow("", "9:00"); err != nil || from != 0 || to != 540 {
+		t.Errorf("Expected an open start to be midnight, got %d-%d (%v)", from, to, err)
+	}
+	if from, to, err := ParseTimeWindow("13:00", ""); err != nil || from != 780 || to != 24*60 {
+		t.Errorf("Expected an open end to be the end of the day, got %d-%d (%v)", from, to, err)
+	}
+	for _, bounds := range [][2]string{{"18:00", "12:00"}, {"12:00", "12:00"}, {"noon", ""}, {"", "24:00"}} {
+		if _, _, err := ParseTimeWindow(bounds[0], bounds[1]); err == nil {
+			t.Errorf("Expected window %v to be rejected", bounds)
+		}
+	}
+}
+
+func TestToProtoTime(t *testing.T) {
+	if ts := ToProtoTime(time.Time{}); ts != nil {
+		t.Errorf("Expected nil for the zero time, got %v", ts)
+	}
+
+	now := time.Now()
+	ts := ToProtoTime(now)
+	if ts == nil || !ts.AsTime().Equal(now) {
+		t.Errorf("Expected %v, got %v", now, ts)
+	}
+	if !FromProtoTime(nil).IsZero() || !FromProtoTime(ts).Equal(now) {
+		t.Error("Expected FromProtoTime to round-trip and map nil to the zero time")
+	}
+}
+
+func TestParseEnrollmentPeriod(t *testing.T) {
+	if _, _, err := ParseEnrollmentPeriod("2031-01-01T08:00:00Z", "2031-01-15"); err != nil {
+		t.Errorf("Expected RFC 3339 and date-only bounds to parse, got %v", err)
+	}
+	if _, end, _ := ParseEnrollmentPeriod("2031-01-15", "2031-01-15"); end.Day() != 15 || end.Hour() != 23 {
+		t.Errorf("Expected a date-only end to cover the whole day, got %v", end)
+	}
+
+	invalid := map[string][2]string{
+		"malformed start": {"01/01/2031", "2031-01-15"},
+		"malformed end":   {"2031-01-01", "next week"},
+		"missing end":     {"2031-01-01", ""},
+		"reversed range":  {"2031-02-01T00:00:00Z", "2031-01-01T00:00:00Z"},
+		"empty range":     {"2031-01-01T00:00:00Z", "2031-01-01T00:00:00Z"},
+	}
+	for name, period := range invalid {
+		if _, _, err := ParseEnrollmentPeriod(period[0], period[1]); err == nil {
+			t.Errorf("%s: expected %q to %q to be rejected", name, period[0], period[1])
+		}
+	}
+}
+
+func TestIdempotencyKeyFromContext(t *testing.T) {
+	if key := IdempotencyKeyFromContext(context.Background()); key != "" {
+		t.Errorf("Expected no key without metadata, got %q", key)
+	}
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(MetadataIdempotencyKey, "retry-1"))
+	if key := IdempotencyKeyFromContext(ctx); key != "retry-1" {
+		t.Errorf("Expected the forwarded key, got %q", key)
+	}
+}
+
+func TestParseCourseWindow(t *testing.T) {
+	if opens, closes, err := ParseCourseWindow("", ""); err != nil || !opens.IsZero() || !closes.IsZero() {
+		t.Errorf("Expected an empty window to be unbounded, got %v-%v (err %v)", opens, closes, err)
+	}
+	if opens, closes, err := ParseCourseWindow("2031-01-01", ""); err != nil || opens.IsZero() || !closes.IsZero() {
+		t.Errorf("Expected an open-ended window, got %v-%v (err %v)", opens, closes, err)
+	}
+	if _, closes, _ := ParseCourseWindow("", "2031-01-15"); closes.Day() != 15 || closes.Hour() != 23 {
+		t.Errorf("Expected a date-only close to cover the whole day, got %v", closes)
+	}
+
+	invalid := map[string][2]string{
+		"malformed open":  {"01/01/2031", ""},
+		"malformed close": {"", "next week"},
+		"reversed range":  {"2031-02-01T00:00:00Z", "2031-01-01T00:00:00Z"},
+	}
+	for name, window := range invalid {
+		if _, _, err := ParseCourseWindow(window[0], window[1]); err == nil {
+			t.Errorf("%s: expected %q to %q to be rejected", name, window[0], window[1])
+		}
+	}
+}
+
+func TestNormalizePage(t *testing.T) {
+	cases := []struct {
+		page, size         int32
+		wantPage, wantSize int32
+	}{
+		{0, 0, 1, 20},
+		{-3, -5, 1, 20},
+		{2, 10, 2, 10},
+		{1, 1000, 1, 100},
+	}
+	for _, c := range cases {
+		page, size := NormalizePage(c.page, c.size, 20, 100)
+		if page != c.wantPage || size != c.wantSize {
+			t.Errorf("NormalizePage(%d, %d) = (%d, %d), want (%d, %d)", c.page, c.size, page, size, c.wantPage, c.wantSize)
+		}
+	}
+}
+
+func TestPageMath(t *testing.T) {
+	// 45 documents in pages of 20: two full pages, then a partial last page of 5
+	cases := []struct {
+		page    int32
+		skip    int64
+		hasMore bool
+		name    string
+	}{
+		{1, 0, true, "first page"},
+		{2, 20, true, "middle page"},
+		{3, 40, false, "last partial page"},
+		{4, 60, false, "page past the end"},
+	}
+	for _, c := range cases {
+		if skip := pageSkip(c.page, 20); skip != c.skip {
+			t.Errorf("%s: skip = %d, want %d", c.name, skip, c.skip)
+		}
+		info := newPageInfo(45, c.page, 20)
+		if info.HasMore != c.hasMore || info.Total != 45 || info.Page != c.page || info.PageSize != 20 {
+			t.Errorf("%s: got %+v, want has_more %v", c.name, info, c.hasMore)
+		}
+	}
+
+	// A total that fills its last page exactly has nothing more after it
+	if info := newPageInfo(40, 2, 20); info.HasMore {
+		t.Error("Expected no more pages after an exactly full last page")
+	}
+	if info := newPageInfo(0, 1, 20); info.HasMore {
+		t.Error("Expected no more pages for an empty list")
+	}
+}
+
+func TestNormalizeEmail(t *testing.T) {
+	cases := map[string]string{
+		"student@example.com":       "student@example.com",
+		"Student@Example.COM":       "student@example.com",
+		"  Mixed.Case@Example.com ": "mixed.case@example.com",
+	}
+	for in, want := range cases {
+		if got := NormalizeEmail(in); got != want {
+			t.Errorf("NormalizeEmail(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+func TestValidateText(t *testing.T) {
+	cases := []struct {
+		value     string
+		maxLen    int
+		multiline bool
+		valid     bool
+	}{
+		{"Data Structures", MaxTitleLength, false, true},
+		{"Ñandú über café", 15, false, true}, // counted in characters, not bytes
+		{strings.Repeat("a", 11), 10, false, false},
+		{"line one\nline two\ttabbed", MaxDescriptionLength, true, true},
+		{"line one\nline two", MaxTitleLength, false, false},
+		{"bell\a", MaxDescriptionLength, true, false},
+		{"nul\x00", MaxNameLength, false, false},
+		{"escape\x1b[31m", MaxNameLength, false, false},
+		{"bad \xff utf8", MaxNameLength, false, false},
+	}
+	for _, c := range cases {
+		err := ValidateText("title", c.value, c.maxLen, c.multiline)
+		if (err == nil) != c.valid {
+			t.Errorf("ValidateText(%q, %d, %v) = %v, want valid=%v", c.value, c.maxLen, c.multiline, err, c.valid)
+			continue
+		}
+		if err != nil && (ErrorCodeOf(err) != ErrCodeInvalidField || ErrorParamsOf(err)["field"] != "title") {
+			t.Errorf("Expected INVALID_FIELD for title, got %v (%v)", ErrorCodeOf(err), ErrorParamsOf(err))
+		}
+	}
+}
+
+func TestTransactionModeFromHello(t *testing.T) {
+	cases := []struct {
+		setName, msg string
+		want         TransactionMode
+	}{
+		{"rs0", "", TransactionModeTransactional},
+		{"", "isdbgrid", TransactionModeTransactional},
+		{"", "", TransactionModeBestEffort},
+	}
+	for _, c := range cases {
+		if got := transactionModeFromHello(c.setName, c.msg); got != c.want {
+			t.Errorf("setName=%q msg=%q: expected %s, got %s", c.setName, c.msg, c.want, got)
+		}
+	}
+}
+
+func TestDefaultSemester(t *testing.T) {
+	cases := map[time.Month]string{
+		time.January:  "Spring 2025",
+		time.May:      "Spring 2025",
+		time.June:     "Summer 2025",
+		time.July:     "Summer 2025",
+		time.August:   "Fall 2025",
+		time.December: "Fall 2025",
+	}
+	for month, want := range cases {
+		if got := DefaultSemester(time.Date(2025, month, 15, 0, 0, 0, 0, time.UTC)); got != want {
+			t.Errorf("DefaultSemester(%s) = %q, want %q", month, got, want)
+		}
+	}
+}
+
+func TestDefaultConfig(t *testing.T) {
+	now := time.Date(2025, time.September, 1, 0, 0, 0, 0, time.UTC)
+	values := map[string]string{}
+	for _, cfg := range DefaultConfig(now) {
+		if _, dup := values[cfg.Key]; dup {
+			t.Errorf("Duplicate default for %s", cfg.Key)
+		}
+		values[cfg.Key] = cfg.Value
+	}
+
+	keys := []string{
+		ConfigEnrollmentStart, ConfigEnrollmentEnd, ConfigEnrollmentOn, ConfigDropGrace, ConfigMaxUnits,
+		ConfigMaxCourses, ConfigCurrentSemester, ConfigGradeDeadline, ConfigGradeEnrolled, ConfigCapacityWarning, ConfigRestoreWindow,
+		ConfigOverrideAutoClose, ConfigGradesPageSize, ConfigCartExpiryDays, ConfigSeatHoldsEnabled,
+		ConfigSeatHoldMinutes, ConfigMinFullTimeUnits, ConfigCartValidationTTL, ConfigAppealWindowDays,
+		ConfigFacultyLoadMaxUnits, ConfigMaxDrops,
+	}
+	for _, key := range keys {
+		if _, ok := values[key]; !ok {
+			t.Errorf("Missing default for %s", key)
+		}
+	}
+	if len(values) != len(keys) {
+		t.Errorf("Expected %d defaults, got %d", len(keys), len(values))
+	}
+
+	// LoadEnrollmentWindow treats a missing flag as enabled; seeding it must not close enrollment
+	if values[ConfigEnrollmentOn] != "true" {
+		t.Errorf("Expected enrollment to default to enabled, got %q", values[ConfigEnrollmentOn])
+	}
+	if values[ConfigCurrentSemester] != "Fall 2025" {
+		t.Errorf("Expected current semester Fall 2025, got %q", values[ConfigCurrentSemester])
+	}
+}
+
+func TestValidateConfigValue(t *testing.T) {
+	valid := map[string][]string{
+		ConfigMaxCourses:   {"1", "6", " 20 "},
+		ConfigMaxUnits:     {"1", "18", "40"},
+		ConfigMaxDrops:     {"0", "2", "20"},
+		"maintenance_mode": {"anything"},
+	}
+	for key, values := range valid {
+		for _, v := range values {
+			if err := ValidateConfigValue(key, v); err != nil {
+				t.Errorf("Expected %s=%q to be valid, got %v", key, v, err)
+			}
+		}
+	}
+
+	invalid := map[string][]string{
+		ConfigMaxCourses: {"", "0", "21", "six", "3.5"},
+		ConfigMaxUnits:   {"-1", "41", "18u"},
+		ConfigMaxDrops:   {"", "-1", "21", "two"},
+	}
+	for key, values := range invalid {
+		for _, v := range values {
+			err := ValidateConfigValue(key, v)
+			if ErrorCodeOf(err) != ErrCodeInvalidField || ErrorParamsOf(err)["field"] != key {
+				t.Errorf("Expected %s=%q to be rejected with field %s, got %v", key, v, key, err)
+			}
+		}
+	}
+}
+
+func TestCourseSlug(t *testing.T) {
+	cases := map[[2]string]string{
+		{"CS-101", "Fall 2024"}:     "cs101-fall24",
+		{"math 102", "Spring 2025"}: "math102-spring25",
+		{"HIS110", "TestSem"}:       "his110-testsem",
+		{"---", ""}:                 "course",
+	}
+	for in, want := range cases {
+		if got := CourseSlug(in[0], in[1]); got != want {
+			t.Errorf("CourseSlug(%q, %q) = %q, want %q", in[0], in[1], got, want)
+		}
+	}
+}
+
+// TestInsertWithCourseID_Concurrent creates many courses with the same code and
+// semester at once; suffix collisions are retried, so every ID comes out unique
+func TestInsertWithCourseID_Concurrent(t *testing.T) {
+	var mu sync.Mutex
+	taken := make(map[string]bool)
+	insert := func(courseID string) error {
+		mu.Lock()
+		defer mu.Unlock()
+		if taken[courseID] {
+			return duplicateKeyError("_id_")
+		}
+		taken[courseID] = true
+		return nil
+	}
+
+	const n = 500
+	ids := make(chan string, n)
+	var wg sync.WaitGroup
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			id, err := InsertWithCourseID("CS-101", "Fall 2024", insert)
+			if err != nil {
+				t.Errorf("InsertWithCourseID failed: %v", err)
+			}
+			ids <- id
+		}()
+	}
+	wg.Wait()
+	close(ids)
+
+	seen := make(map[string]bool)
+	for id := range ids {
+		if !strings.HasPrefix(id, "cs101-fall24-") {
+			t.Errorf("Expected a cs101-fall24- ID, got %q", id)
+		}
+		if seen[id] {
+			t.Errorf("Duplicate course ID %q", id)
+		}
+		seen[id] = true
+	}
+
+	// Every suffix taken: give up after a bounded number of tries
+	calls := 0
+	if _, err := InsertWithCourseID("CS-101", "Fall 2024", func(string) error { calls++; return duplicateKeyError("_id_") }); err == nil || calls != maxCourseIDAttempts {
+		t.Errorf("Expected failure after %d attempts, got %v after %d", maxCourseIDAttempts, err, calls)
+	}
+
+	// A clash on another unique index is not an ID collision
+	calls = 0
+	if _, err := InsertWithCourseID("CS-101", "Fall 2024", func(string) error { calls++; return duplicateKeyError("code_1_semester_1") }); err == nil || calls != 1 {
+		t.Errorf("Expected the first error returned without retry, got %v after %d calls", err, calls)
+	}
+}
+
+func TestGenerateID_Concurrent(t *testing.T) {
+	const workers, perWorker = 20, 500
+	ids := make(chan string, workers*perWorker)
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < perWorker; i++ {
+				ids <- GenerateEnrollmentID()
+			}
+		}()
+	}
+	wg.Wait()
+	close(ids)
+
+	seen := make(map[string]bool)
+	for id := range ids {
+		if seen[id] {
+			t.Fatalf("Duplicate enrollment ID %q", id)
+		}
+		seen[id] = true
+	}
+}
+
+// duplicateKeyError is the write error MongoDB returns for a clash on index
+func duplicateKeyError(index string) error {
+	return mongo.WriteException{WriteErrors: []mongo.WriteError{{
+		Code:    11000,
+		Message: "E11000 duplicate key error collection: test.courses index: " + index + " dup key",
+	}}}
+}
@@ -0,0 +1,253 @@
+// ============================================================================
+// backend/shared/errors.go
+// Shared error taxonomy carried in gRPC status details
+// ============================================================================
+
+package shared
+
+import (
+	"fmt"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ErrorCode is a stable, machine-readable error identifier exposed to clients
+type ErrorCode string
+
+// ErrorDomain identifies this system in google.rpc.ErrorInfo details
+const ErrorDomain = "stdiscm_p4"
+
+// ============================================================================
+// Error Codes
+// ============================================================================
+
+const (
+	// Generic codes (derived from the gRPC status code when no detail is attached)
+	ErrCodeInvalidArgument    ErrorCode = "INVALID_ARGUMENT"
+	ErrCodeInvalidField       ErrorCode = "INVALID_FIELD"
+	ErrCodeUnauthenticated    ErrorCode = "UNAUTHENTICATED"
+	ErrCodePermissionDenied   ErrorCode = "PERMISSION_DENIED"
+	ErrCodeNotFound           ErrorCode = "NOT_FOUND"
+	ErrCodeAlreadyExists      ErrorCode = "ALREADY_EXISTS"
+	ErrCodeFailedPrecondition ErrorCode = "FAILED_PRECONDITION"
+	ErrCodeServiceUnavailable ErrorCode = "SERVICE_UNAVAILABLE"
+	ErrCodeTimeout            ErrorCode = "TIMEOUT"
+	ErrCodeInternal           ErrorCode = "INTERNAL"
+
+	// Auth
+	ErrCodeInvalidCredentials     ErrorCode = "INVALID_CREDENTIALS"
+	ErrCodeAccountInactive        ErrorCode = "ACCOUNT_INACTIVE"
+	ErrCodePasswordChangeRequired ErrorCode = "PASSWORD_CHANGE_REQUIRED"
+
+	// Courses
+	ErrCodeCourseNotFound   ErrorCode = "COURSE_NOT_FOUND"
+	ErrCodeCourseClosed     ErrorCode = "COURSE_CLOSED"
+	ErrCodeCourseFull       ErrorCode = "COURSE_FULL"
+	ErrCodeCourseRestricted ErrorCode = "COURSE_RESTRICTED"
+	ErrCodeCourseCancelled  ErrorCode = "COURSE_CANCELLED"
+	ErrCodePrereqCycle      ErrorCode = "PREREQUISITE_CYCLE"
+	ErrCodeAlreadyReviewed  ErrorCode = "ALREADY_REVIEWED"
+
+	// Students
+	ErrCodeStudentNotFound ErrorCode = "STUDENT_NOT_FOUND"
+
+	// Cart & Enrollment
+	ErrCodeCartFull          ErrorCode = "CART_FULL"
+	ErrCodeCartEmpty         ErrorCode = "CART_EMPTY"
+	ErrCodeAlreadyInCart     ErrorCode = "ALREADY_IN_CART"
+	ErrCodeAlreadyEnrolled   ErrorCode = "ALREADY_ENROLLED"
+	ErrCodeNotEnrolled       ErrorCode = "NOT_ENROLLED"
+	ErrCodeScheduleConflict  ErrorCode = "SCHEDULE_CONFLICT"
+	ErrCodePrereqNotMet      ErrorCode = "PREREQ_NOT_MET"
+	ErrCodeUnitLimitExceeded ErrorCode = "UNIT_LIMIT_EXCEEDED"
+	ErrCodeEnrollmentClosed  ErrorCode = "ENROLLMENT_CLOSED"
+	ErrCodeEnrollmentHold    ErrorCode = "ENROLLMENT_HOLD"
+	ErrCodeDropLimitReached  ErrorCode = "DROP_LIMIT_REACHED"
+
+	// Grades
+	ErrCodeNotCourseFaculty   ErrorCode = "NOT_COURSE_FACULTY"
+	ErrCodeInvalidGrade       ErrorCode = "INVALID_GRADE"
+	ErrCodeAppealOpen         ErrorCode = "APPEAL_ALREADY_OPEN"
+	ErrCodeAppealResolved     ErrorCode = "APPEAL_RESOLVED"
+	ErrCodeAppealWindowClosed ErrorCode = "APPEAL_WINDOW_CLOSED"
+
+	// Concurrency
+	ErrCodeConcurrentModification ErrorCode = "CONCURRENT_MODIFICATION"
+	ErrCodeOperationInProgress    ErrorCode = "OPERATION_IN_PROGRESS"
+	ErrCodeIdempotencyKeyReused   ErrorCode = "IDEMPOTENCY_KEY_REUSED"
+
+	// Enrollment lifecycle
+	ErrCodeInvalidStatusTransition ErrorCode = "INVALID_STATUS_TRANSITION"
+	ErrCodeSemesterClosed          ErrorCode = "SEMESTER_CLOSED"
+
+	// Gateway request bodies
+	ErrCodeMalformedJSON ErrorCode = "MALFORMED_JSON"
+	ErrCodeUnknownField  ErrorCode = "UNKNOWN_FIELD"
+	ErrCodeBodyTooLarge  ErrorCode = "BODY_TOO_LARGE"
+	ErrCodeEmptyBody     ErrorCode = "EMPTY_BODY"
+)
+
+// ============================================================================
+// Domain Errors
+// ============================================================================
+
+// DomainError is a business rule violation carrying a machine code and optional
+// parameters. Services may return it from anywhere, including inside transactions;
+// it becomes a gRPC status with ErrorInfo details when it crosses the handler
+// boundary (via GRPCStatus), and errors.Is matches it against the sentinels by code.
+type DomainError struct {
+	Status  codes.Code
+	Code    ErrorCode
+	Message string
+	Params  map[string]string
+}
+
+// Sentinel domain errors for cross-service business rules. Use Newf and WithParam
+// to attach a specific message and parameters; errors.Is still matches the sentinel.
+var (
+	ErrCourseNotFound         = &DomainError{Status: codes.NotFound, Code: ErrCodeCourseNotFound, Message: "course not found"}
+	ErrCourseClosed           = &DomainError{Status: codes.FailedPrecondition, Code: ErrCodeCourseClosed, Message: "course is closed"}
+	ErrCourseFull             = &DomainError{Status: codes.FailedPrecondition, Code: ErrCodeCourseFull, Message: "course is full"}
+	ErrCourseRestricted       = &DomainError{Status: codes.FailedPrecondition, Code: ErrCodeCourseRestricted, Message: "course restrictions not met"}
+	ErrCourseCancelled        = &DomainError{Status: codes.FailedPrecondition, Code: ErrCodeCourseCancelled, Message: "course has been cancelled"}
+	ErrPrereqCycle            = &DomainError{Status: codes.FailedPrecondition, Code: ErrCodePrereqCycle, Message: "prerequisite would create a cycle"}
+	ErrAlreadyReviewed        = &DomainError{Status: codes.AlreadyExists, Code: ErrCodeAlreadyReviewed, Message: "course already reviewed for this enrollment"}
+	ErrAlreadyEnrolled        = &DomainError{Status: codes.AlreadyExists, Code: ErrCodeAlreadyEnrolled, Message: "already enrolled"}
+	ErrNotEnrolled            = &DomainError{Status: codes.NotFound, Code: ErrCodeNotEnrolled, Message: "enrollment not found"}
+	ErrInvalidTransition      = &DomainError{Status: codes.FailedPrecondition, Code: ErrCodeInvalidStatusTransition, Message: "enrollment status change not allowed"}
+	ErrSemesterClosed         = &DomainError{Status: codes.FailedPrecondition, Code: ErrCodeSemesterClosed, Message: "semester has already been closed"}
+	ErrPrereqNotMet           = &DomainError{Status: codes.FailedPrecondition, Code: ErrCodePrereqNotMet, Message: "prerequisites not met"}
+	ErrEnrollmentClosed       = &DomainError{Status: codes.FailedPrecondition, Code: ErrCodeEnrollmentClosed, Message: "enrollment is closed"}
+	ErrDropLimitReached       = &DomainError{Status: codes.FailedPrecondition, Code: ErrCodeDropLimitReached, Message: "drop limit for the semester reached"}
+	ErrNotCourseFaculty       = &DomainError{Status: codes.PermissionDenied, Code: ErrCodeNotCourseFaculty, Message: "not the faculty assigned to this course"}
+	ErrInvalidField           = &DomainError{Status: codes.InvalidArgument, Code: ErrCodeInvalidField, Message: "invalid field"}
+	ErrInvalidGrade           = &DomainError{Status: codes.InvalidArgument, Code: ErrCodeInvalidGrade, Message: "invalid grade"}
+	ErrAppealAlreadyOpen      = &DomainError{Status: codes.AlreadyExists, Code: ErrCodeAppealOpen, Message: "an appeal for this grade is already open"}
+	ErrAppealResolved         = &DomainError{Status: codes.FailedPrecondition, Code: ErrCodeAppealResolved, Message: "appeal has already been resolved"}
+	ErrAppealWindowClosed     = &DomainError{Status: codes.FailedPrecondition, Code: ErrCodeAppealWindowClosed, Message: "the appeal window for this grade has closed"}
+	ErrConcurrentModification = &DomainError{Status: codes.Aborted, Code: ErrCodeConcurrentModification, Message: "record changed concurrently"}
+	ErrOperationInProgress    = &DomainError{Status: codes.Aborted, Code: ErrCodeOperationInProgress, Message: "operation in progress"}
+)
+
+// Error implements the error interface
+func (e *DomainError) Error() string {
+	return e.Message
+}
+
+// Is reports whether target is a DomainError with the same code
+func (e *DomainError) Is(target error) bool {
+	t, ok := target.(*DomainError)
+	return ok && t.Code == e.Code
+}
+
+// GRPCStatus converts the error to a gRPC status; the code and params travel
+// in a google.rpc.ErrorInfo detail
+func (e *DomainError) GRPCStatus() *status.Status {
+	st := status.New(e.Status, e.Message)
+	withDetails, err := st.WithDetails(&errdetails.ErrorInfo{
+		Reason:   string(e.Code),
+		Domain:   ErrorDomain,
+		Metadata: e.Params,
+	})
+	if err != nil {
+		return st
+	}
+	return withDetails
+}
+
+// Newf returns a copy of the error with a formatted message
+func (e *DomainError) Newf(format string, args ...interface{}) *DomainError {
+	c := e.clone()
+	c.Message = fmt.Sprintf(format, args...)
+	return c
+}
+
+// WithParam returns a copy of the error with an added parameter
+func (e *DomainError) WithParam(key, value string) *DomainError {
+	c := e.clone()
+	c.Params[key] = value
+	return c
+}
+
+// clone copies the error so sentinels are never modified
+func (e *DomainError) clone() *DomainError {
+	c := *e
+	c.Params = make(map[string]string, len(e.Params)+1)
+	for k, v := range e.Params {
+		c.Params[k] = v
+	}
+	return &c
+}
+
+// ============================================================================
+// Helpers
+// ============================================================================
+
+// NewError returns a DomainError, which gRPC sends as a status tagged with an ErrorCode
+func NewError(c codes.Code, code ErrorCode, msg string) error {
+	return &DomainError{Status: c, Code: code, Message: msg}
+}
+
+// Errorf is NewError with a formatted message
+func Errorf(c codes.Code, code ErrorCode, format string, args ...interface{}) error {
+	return NewError(c, code, fmt.Sprintf(format, args...))
+}
+
+// ErrorCodeOf returns the ErrorCode attached to a gRPC error,
+// falling back to one derived from its status code
+func ErrorCodeOf(err error) ErrorCode {
+	st, ok := status.FromError(err)
+	if !ok {
+		return ErrCodeInternal
+	}
+
+	for _, detail := range st.Details() {
+		if info, ok := detail.(*errdetails.ErrorInfo); ok && info.Domain == ErrorDomain && info.Reason != "" {
+			return ErrorCode(info.Reason)
+		}
+	}
+	return ErrorCodeFromGRPC(st.Code())
+}
+
+// ErrorParamsOf returns the parameters attached to a domain error (nil when there are none)
+func ErrorParamsOf(err error) map[string]string {
+	st, ok := status.FromError(err)
+	if !ok {
+		return nil
+	}
+
+	for _, detail := range st.Details() {
+		if info, ok := detail.(*errdetails.ErrorInfo); ok && info.Domain == ErrorDomain && len(info.Metadata) > 0 {
+			return info.Metadata
+		}
+	}
+	return nil
+}
+
+// ErrorCodeFromGRPC maps a gRPC status code to its generic ErrorCode
+func ErrorCodeFromGRPC(c codes.Code) ErrorCode {
+	switch c {
+	case codes.InvalidArgument, codes.OutOfRange:
+		return ErrCodeInvalidArgument
+	case codes.Unauthenticated:
+		return ErrCodeUnauthenticated
+	case codes.PermissionDenied:
+		return ErrCodePermissionDenied
+	case codes.NotFound:
+		return ErrCodeNotFound
+	case codes.AlreadyExists:
+		return ErrCodeAlreadyExists
+	case codes.FailedPrecondition:
+		return ErrCodeFailedPrecondition
+	case codes.Aborted:
+		return ErrCodeConcurrentModification
+	case codes.Unavailable:
+		return ErrCodeServiceUnavailable
+	case codes.DeadlineExceeded:
+		return ErrCodeTimeout
+	default:
+		return ErrCodeInternal
+	}
+}
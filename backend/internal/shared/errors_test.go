@@ -0,0 +1,46 @@
+package shared
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestDomainError(t *testing.T) {
+	err := ErrCourseFull.Newf("course %s is full", "CS101").WithParam("course_id", "c1")
+
+	// Sentinels match by code, even through wrapping
+	if !errors.Is(err, ErrCourseFull) || !errors.Is(fmt.Errorf("enroll: %w", err), ErrCourseFull) {
+		t.Error("Expected error to match ErrCourseFull")
+	}
+	if errors.Is(err, ErrCourseClosed) {
+		t.Error("Expected error not to match ErrCourseClosed")
+	}
+
+	// Newf and WithParam never modify the sentinel
+	if ErrCourseFull.Message != "course is full" || len(ErrCourseFull.Params) != 0 {
+		t.Errorf("Sentinel was modified: %+v", ErrCourseFull)
+	}
+
+	// Code and params survive the trip through a gRPC status
+	st := status.Convert(err)
+	wire := status.FromProto(st.Proto()).Err()
+	if status.Code(wire) != codes.FailedPrecondition || st.Message() != "course CS101 is full" {
+		t.Errorf("Unexpected status: %v", st)
+	}
+	if ErrorCodeOf(wire) != ErrCodeCourseFull {
+		t.Errorf("Expected %s, got %s", ErrCodeCourseFull, ErrorCodeOf(wire))
+	}
+	if ErrorParamsOf(wire)["course_id"] != "c1" {
+		t.Errorf("Expected course_id param, got %v", ErrorParamsOf(wire))
+	}
+
+	// Plain gRPC errors fall back to a code derived from the status
+	plain := status.Error(codes.NotFound, "missing")
+	if ErrorCodeOf(plain) != ErrCodeNotFound || ErrorParamsOf(plain) != nil {
+		t.Errorf("Unexpected code %s / params %v", ErrorCodeOf(plain), ErrorParamsOf(plain))
+	}
+}
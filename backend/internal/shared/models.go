@@ -6,6 +6,10 @@
 package shared
 
 import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
 	"time"
 )
 
@@ -32,8 +36,15 @@ type User struct {
 	FacultyID  string `bson:"faculty_id,omitempty" json:"faculty_id,omitempty"`
 	Department string `bson:"department,omitempty" json:"department,omitempty"`
 
+	// Self-editable contact details
+	DisplayName  string `bson:"display_name,omitempty" json:"display_name,omitempty"`
+	ContactEmail string `bson:"contact_email,omitempty" json:"contact_email,omitempty"`
+	Phone        string `bson:"phone,omitempty" json:"phone,omitempty"`
+
 	// Account status
-	IsActive bool `bson:"is_active" json:"is_active"`
+	IsActive           bool      `bson:"is_active" json:"is_active"`
+	MustChangePassword bool      `bson:"must_change_password,omitempty" json:"must_change_password,omitempty"` // set by admin-issued passwords
+	LastLoginAt        time.Time `bson:"last_login_at,omitempty" json:"last_login_at,omitempty"`
 }
 
 // Session represents an active user session (for JWT tracking)
@@ -64,8 +75,79 @@ type Course struct {
 	FacultyID   string    `bson:"faculty_id" json:"faculty_id"`
 	IsOpen      bool      `bson:"is_open" json:"is_open"`
 	Semester    string    `bson:"semester" json:"semester"` // e.g., "Spring 2024"
+	Department  string    `bson:"department,omitempty" json:"department,omitempty"`
 	CreatedAt   time.Time `bson:"created_at" json:"created_at"`
 	UpdatedAt   time.Time `bson:"updated_at,omitempty" json:"updated_at,omitempty"`
+
+	// Syllabus and resource links (metadata only)
+	Materials []CourseMaterial `bson:"materials,omitempty" json:"materials,omitempty"`
+
+	// Optional enrollment restrictions (empty/zero means unrestricted)
+	AllowedMajors []string `bson:"allowed_majors,omitempty" json:"allowed_majors,omitempty"`
+	MinYearLevel  int32    `bson:"min_year_level,omitempty" json:"min_year_level,omitempty"`
+
+	// Optional per-course enrollment window (zero means unbounded on that side)
+	EnrollOpenAt  time.Time `bson:"enroll_open_at,omitempty" json:"enroll_open_at,omitempty"`
+	EnrollCloseAt time.Time `bson:"enroll_close_at,omitempty" json:"enroll_close_at,omitempty"`
+
+	// Parsed schedule for day and time filters; absent for unscheduled courses
+	ScheduleSlot *ScheduleSlot `bson:"schedule_slot,omitempty" json:"schedule_slot,omitempty"`
+
+	// Set by CancelCourse; a cancelled course stays closed and is hidden from listings
+	Cancelled   bool      `bson:"cancelled,omitempty" json:"cancelled,omitempty"`
+	CancelledAt time.Time `bson:"cancelled_at,omitempty" json:"cancelled_at,omitempty"`
+}
+
+// ScheduleSlot is a course schedule parsed when it is written, with times as
+// minutes since midnight so they can be compared in queries
+type ScheduleSlot struct {
+	Days        []string `bson:"days" json:"days"`                 // ["T", "TH"]
+	StartMinute int32    `bson:"start_minute" json:"start_minute"` // 14:00 -> 840
+	EndMinute   int32    `bson:"end_minute" json:"end_minute"`
+}
+
+// CourseMaterial is a syllabus or resource link attached to a course
+type CourseMaterial struct {
+	ID      string    `bson:"id" json:"id"`
+	Title   string    `bson:"title" json:"title"`
+	URL     string    `bson:"url" json:"url"`
+	AddedAt time.Time `bson:"added_at" json:"added_at"`
+}
+
+// CourseReview is a student's evaluation of a course they completed. It is kept
+// anonymous: the student is never stored, and enrollment_id (which enforces one
+// review per enrollment) is not exposed outside the course service.
+type CourseReview struct {
+	ID           string        `bson:"_id" json:"id"`
+	EnrollmentID string        `bson:"enrollment_id" json:"-"`
+	CourseID     string        `bson:"course_id" json:"course_id"`
+	Semester     string        `bson:"semester" json:"semester"`
+	Ratings      ReviewRatings `bson:"ratings" json:"ratings"`
+	Comment      string        `bson:"comment,omitempty" json:"comment,omitempty"`
+	SubmittedAt  time.Time     `bson:"submitted_at" json:"submitted_at"`
+}
+
+// ReviewRatings holds the fixed review dimensions, each MinReviewRating..MaxReviewRating
+type ReviewRatings struct {
+	Content      int32 `bson:"content" json:"content"`
+	Instruction  int32 `bson:"instruction" json:"instruction"`
+	Organization int32 `bson:"organization" json:"organization"`
+	Overall      int32 `bson:"overall" json:"overall"`
+}
+
+// Validate checks that every dimension was rated within range
+func (r ReviewRatings) Validate() error {
+	for _, d := range []struct {
+		name  string
+		value int32
+	}{
+		{"content", r.Content}, {"instruction", r.Instruction}, {"organization", r.Organization}, {"overall", r.Overall},
+	} {
+		if d.value < MinReviewRating || d.value > MaxReviewRating {
+			return fmt.Errorf("%s rating must be between %d and %d", d.name, MinReviewRating, MaxReviewRating)
+		}
+	}
+	return nil
 }
 
 // Prerequisite represents a prerequisite relationship between courses
@@ -90,10 +172,43 @@ type Enrollment struct {
 	ID           string       `bson:"_id" json:"id"`
 	StudentID    string       `bson:"student_id" json:"student_id"`
 	CourseID     string       `bson:"course_id" json:"course_id"`
-	Status       string       `bson:"status" json:"status"` // enrolled, dropped, completed
+	Status       string       `bson:"status" json:"status"` // enrolled, dropped, completed, withdrawn
 	EnrolledAt   time.Time    `bson:"enrolled_at" json:"enrolled_at"`
 	DroppedAt    time.Time    `bson:"dropped_at,omitempty" json:"dropped_at,omitempty"`
+	WithdrawnAt  time.Time    `bson:"withdrawn_at,omitempty" json:"withdrawn_at,omitempty"`
+	CompletedAt  time.Time    `bson:"completed_at,omitempty" json:"completed_at,omitempty"`
+	SeatHeld     bool         `bson:"seat_held,omitempty" json:"seat_held,omitempty"`     // withdrawn after the drop deadline; still counted against capacity
+	DropReason   string       `bson:"drop_reason,omitempty" json:"drop_reason,omitempty"` // set when the drop was not the student's, e.g. DropReasonAdminForced
 	ScheduleInfo ScheduleInfo `bson:"schedule_info,omitempty" json:"schedule_info,omitempty"`
+	Conditional  bool         `bson:"conditional,omitempty" json:"conditional,omitempty"` // prerequisites were still in progress at enrollment
+}
+
+// EnrollmentEvent records one seat taken (+1) or released (-1) in a course,
+// kept for enrollment trend reports
+type EnrollmentEvent struct {
+	ID        string    `bson:"_id" json:"id"`
+	CourseID  string    `bson:"course_id" json:"course_id"`
+	Delta     int32     `bson:"delta" json:"delta"`
+	Source    string    `bson:"source" json:"source"` // self, override, grade
+	Timestamp time.Time `bson:"timestamp" json:"timestamp"`
+}
+
+// EnrollmentReceipt is the confirmation record of one EnrollAll transaction
+type EnrollmentReceipt struct {
+	ID         string          `bson:"_id" json:"reference_id"`
+	StudentID  string          `bson:"student_id" json:"student_id"`
+	Courses    []ReceiptCourse `bson:"courses" json:"courses"`
+	TotalUnits int32           `bson:"total_units" json:"total_units"`
+	CreatedAt  time.Time       `bson:"created_at" json:"created_at"`
+}
+
+// ReceiptCourse is a course listed on an enrollment receipt
+type ReceiptCourse struct {
+	EnrollmentID string `bson:"enrollment_id" json:"enrollment_id"`
+	CourseID     string `bson:"course_id" json:"course_id"`
+	CourseCode   string `bson:"course_code" json:"course_code"`
+	CourseTitle  string `bson:"course_title" json:"course_title"`
+	Units        int32  `bson:"units" json:"units"`
 }
 
 // Cart represents a student's shopping cart
@@ -102,13 +217,31 @@ type Cart struct {
 	CourseIDs         []string        `bson:"course_ids" json:"course_ids"`
 	UpdatedAt         time.Time       `bson:"updated_at" json:"updated_at"`
 	ValidationResults *CartValidation `bson:"validation_results,omitempty" json:"validation_results,omitempty"`
+
+	// Set by the cleanup sweep on a cart it emptied, so the next GetCart can explain why
+	ExpiredAt     time.Time `bson:"expired_at,omitempty" json:"expired_at,omitempty"`
+	ExpiredReason string    `bson:"expired_reason,omitempty" json:"expired_reason,omitempty"`
 }
 
-// CartValidation stores validation results for a cart
+// CartValidation stores the results of the last GetCart validation of a cart, so
+// EnrollAll can reuse them instead of asking the Course Service again
 type CartValidation struct {
-	TotalUnits           int32    `bson:"total_units" json:"total_units"`
-	HasConflicts         bool     `bson:"has_conflicts" json:"has_conflicts"`
-	MissingPrerequisites []string `bson:"missing_prerequisites" json:"missing_prerequisites"`
+	TotalUnits            int32               `bson:"total_units" json:"total_units"`
+	HasConflicts          bool                `bson:"has_conflicts" json:"has_conflicts"`
+	MissingPrerequisites  []string            `bson:"missing_prerequisites" json:"missing_prerequisites"`
+	RestrictionViolations []string            `bson:"restriction_violations,omitempty" json:"restriction_violations,omitempty"`
+	ConditionalCourses    []string            `bson:"conditional_courses,omitempty" json:"conditional_courses,omitempty"`
+	Items                 []ValidatedCartItem `bson:"items" json:"items"`
+	CartUpdatedAt         time.Time           `bson:"cart_updated_at" json:"cart_updated_at"` // the cart's updated_at that was validated
+	ComputedAt            time.Time           `bson:"computed_at" json:"computed_at"`
+}
+
+// ValidatedCartItem is a cart course as the Course Service described it at validation
+type ValidatedCartItem struct {
+	CourseID    string `bson:"course_id" json:"course_id"`
+	CourseCode  string `bson:"course_code" json:"course_code"`
+	CourseTitle string `bson:"course_title" json:"course_title"`
+	Units       int32  `bson:"units" json:"units"`
 }
 
 // ============================================================================
@@ -126,6 +259,106 @@ type Grade struct {
 	OverrideReason string    `bson:"override_reason,omitempty" json:"override_reason,omitempty"`
 	LastModifiedBy string    `bson:"last_modified_by,omitempty" json:"last_modified_by,omitempty"`
 	LastModifiedAt time.Time `bson:"last_modified_at,omitempty" json:"last_modified_at,omitempty"`
+
+	// Set by the consistency checker when the enrollment no longer exists; such grades are kept
+	OrphanedAt time.Time `bson:"orphaned_at,omitempty" json:"orphaned_at,omitempty"`
+}
+
+// GradeDraft holds grades a faculty member has entered but not yet uploaded.
+// Drafts are unvalidated; FinalizeDraft uploads them like UploadGrades.
+type GradeDraft struct {
+	CourseID  string            `bson:"course_id" json:"course_id"`
+	FacultyID string            `bson:"faculty_id" json:"faculty_id"`
+	Grades    map[string]string `bson:"grades" json:"grades"` // student_id -> grade
+	UpdatedAt time.Time         `bson:"updated_at" json:"updated_at"`
+	ExpiresAt time.Time         `bson:"expires_at" json:"expires_at"` // removed by a TTL index
+}
+
+// GradeAppeal is a student's request to review a published grade.
+// At most one appeal per enrollment may be open at a time.
+type GradeAppeal struct {
+	ID            string    `bson:"_id" json:"id"`
+	EnrollmentID  string    `bson:"enrollment_id" json:"enrollment_id"`
+	StudentID     string    `bson:"student_id" json:"student_id"`
+	CourseID      string    `bson:"course_id" json:"course_id"`
+	CourseCode    string    `bson:"course_code" json:"course_code"`
+	FacultyID     string    `bson:"faculty_id" json:"faculty_id"` // course faculty when submitted
+	OriginalGrade string    `bson:"original_grade" json:"original_grade"`
+	Reason        string    `bson:"reason" json:"reason"`
+	Status        string    `bson:"status" json:"status"` // open, approved, denied
+	NewGrade      string    `bson:"new_grade,omitempty" json:"new_grade,omitempty"`
+	Comment       string    `bson:"comment,omitempty" json:"comment,omitempty"`
+	SubmittedAt   time.Time `bson:"submitted_at" json:"submitted_at"`
+	ResolvedBy    string    `bson:"resolved_by,omitempty" json:"resolved_by,omitempty"`
+	ResolvedAt    time.Time `bson:"resolved_at,omitempty" json:"resolved_at,omitempty"`
+}
+
+// TransferCredit represents credit a student earned at another institution.
+// It satisfies prerequisites by course code and counts toward earned units,
+// but carries no grade and is excluded from GPA.
+type TransferCredit struct {
+	ID                string    `bson:"_id" json:"id"`
+	StudentID         string    `bson:"student_id" json:"student_id"`
+	CourseCode        string    `bson:"course_code" json:"course_code"`
+	EquivalentUnits   int32     `bson:"equivalent_units" json:"equivalent_units"`
+	SourceInstitution string    `bson:"source_institution" json:"source_institution"`
+	AddedBy           string    `bson:"added_by" json:"added_by"`
+	CreatedAt         time.Time `bson:"created_at" json:"created_at"`
+}
+
+// Hold blocks a student from enrolling while active. Cleared holds are kept for history.
+type Hold struct {
+	ID        string    `bson:"_id" json:"id"`
+	StudentID string    `bson:"student_id" json:"student_id"`
+	Type      string    `bson:"type" json:"type"` // financial, disciplinary, academic, administrative
+	Reason    string    `bson:"reason" json:"reason"`
+	Active    bool      `bson:"active" json:"active"`
+	PlacedBy  string    `bson:"placed_by" json:"placed_by"`
+	PlacedAt  time.Time `bson:"placed_at" json:"placed_at"`
+	ClearedBy string    `bson:"cleared_by,omitempty" json:"cleared_by,omitempty"`
+	ClearedAt time.Time `bson:"cleared_at,omitempty" json:"cleared_at,omitempty"`
+}
+
+// SeatHold reserves a seat in a nearly full course for a student who has it in
+// their cart, while seat_holds_enabled is on. It counts against the course's
+// available seats until it expires, the course leaves the cart, or EnrollAll uses it.
+type SeatHold struct {
+	ID        string    `bson:"_id" json:"id"`
+	CourseID  string    `bson:"course_id" json:"course_id"`
+	StudentID string    `bson:"student_id" json:"student_id"`
+	CreatedAt time.Time `bson:"created_at" json:"created_at"`
+	ExpiresAt time.Time `bson:"expires_at" json:"expires_at"`
+}
+
+// Notification is a message for a user about something that happened to their
+// records, e.g. a course of theirs being cancelled
+type Notification struct {
+	ID        string    `bson:"_id" json:"id"`
+	UserID    string    `bson:"user_id" json:"user_id"` // student or faculty ID the message is for
+	Type      string    `bson:"type" json:"type"`
+	Message   string    `bson:"message" json:"message"`
+	CourseID  string    `bson:"course_id,omitempty" json:"course_id,omitempty"`
+	Read      bool      `bson:"read" json:"read"`
+	CreatedAt time.Time `bson:"created_at" json:"created_at"`
+}
+
+// Department is an academic department. Courses store its code in their
+// department field; faculty store its name.
+type Department struct {
+	Code      string    `bson:"_id" json:"code"` // e.g. "CS"
+	Name      string    `bson:"name" json:"name"`
+	CreatedAt time.Time `bson:"created_at" json:"created_at"`
+	UpdatedAt time.Time `bson:"updated_at,omitempty" json:"updated_at,omitempty"`
+}
+
+// SemesterClosure records a semester rollover; its presence keeps CloseSemester
+// from running twice for the same semester
+type SemesterClosure struct {
+	Semester             string    `bson:"_id" json:"semester"`
+	ClosedBy             string    `bson:"closed_by" json:"closed_by"`
+	ClosedAt             time.Time `bson:"closed_at" json:"closed_at"`
+	CoursesClosed        int32     `bson:"courses_closed" json:"courses_closed"`
+	EnrollmentsCompleted int32     `bson:"enrollments_completed" json:"enrollments_completed"`
 }
 
 // GradeEntry represents a single grade entry (for bulk upload)
@@ -151,6 +384,14 @@ type SemesterGPA struct {
 	CoursesCount int32   `json:"courses_count"`
 }
 
+// GPARecord is a grade as it counts toward GPA
+type GPARecord struct {
+	CourseID string `bson:"course_id"`
+	Grade    string `bson:"grade"`
+	Units    int32  `bson:"units"`
+	Semester string `bson:"semester"`
+}
+
 // ============================================================================
 // System Configuration Models
 // ============================================================================
@@ -180,6 +421,10 @@ type SystemStats struct {
 	TotalEnrollments int32  `json:"total_enrollments"`
 	EnrollmentOpen   bool   `json:"enrollment_open"`
 	CurrentSemester  string `json:"current_semester"`
+
+	EnrollmentsToday int32   `json:"enrollments_today"`
+	DropsToday       int32   `json:"drops_today"`
+	AverageFillRate  float64 `json:"average_fill_rate"` // percent
 }
 
 // ============================================================================
@@ -195,6 +440,9 @@ type AuditLog struct {
 	Resource  string                 `bson:"resource" json:"resource"`
 	Details   map[string]interface{} `bson:"details,omitempty" json:"details,omitempty"`
 	IPAddress string                 `bson:"ip_address,omitempty" json:"ip_address,omitempty"`
+
+	// Idempotency-Key of the gateway request that caused the change, if any
+	IdempotencyKey string `bson:"idempotency_key,omitempty" json:"idempotency_key,omitempty"`
 }
 
 // ============================================================================
@@ -260,6 +508,75 @@ func GetGradePoints(grade string) float64 {
 	return 0.0
 }
 
+// semesterTerms ranks term names within a calendar year
+var semesterTerms = map[string]int{
+	"winter": 1,
+	"spring": 2,
+	"summer": 3,
+	"fall":   4,
+	"autumn": 4,
+}
+
+// CompareSemesters orders semester labels such as "Spring 2024" and "Fall 2024"
+// chronologically, returning -1, 0 or 1. Labels without a year sort last, alphabetically.
+func CompareSemesters(a, b string) int {
+	yearA, termA, okA := parseSemester(a)
+	yearB, termB, okB := parseSemester(b)
+
+	switch {
+	case okA && !okB:
+		return -1
+	case !okA && okB:
+		return 1
+	case okA && okB && yearA != yearB:
+		if yearA < yearB {
+			return -1
+		}
+		return 1
+	case okA && okB && termA != termB:
+		if termA < termB {
+			return -1
+		}
+		return 1
+	}
+	return strings.Compare(a, b)
+}
+
+// SemesterSortKey derives a chronologically sortable term key (year*10 + term rank)
+// from a semester label, so "Fall 2024" sorts after "Spring 2024". Labels without
+// a year return 0.
+func SemesterSortKey(semester string) int32 {
+	year, term, ok := parseSemester(semester)
+	if !ok {
+		return 0
+	}
+	return int32(year*10 + term)
+}
+
+// IsFutureSemester reports whether semester comes after current. Labels without
+// a year cannot be placed on the calendar, so they are never in the future.
+func IsFutureSemester(semester, current string) bool {
+	_, _, ok := parseSemester(semester)
+	_, _, okCurrent := parseSemester(current)
+	return ok && okCurrent && CompareSemesters(semester, current) > 0
+}
+
+// parseSemester extracts the year and term rank from a label like "Fall 2024"
+func parseSemester(semester string) (year, term int, ok bool) {
+	for _, field := range strings.Fields(semester) {
+		if rank, found := semesterTerms[strings.ToLower(field)]; found {
+			term = rank
+			continue
+		}
+		if len(field) == 4 {
+			if y, err := strconv.Atoi(field); err == nil {
+				year, ok = y, true
+			}
+		}
+	}
+	return year, term, ok
+}
+
 // IsPassingGrade checks if a grade is passing
 func IsPassingGrade(grade string) bool {
 	passingGrades := map[string]bool{
@@ -277,34 +594,294 @@ func IsGradeCountedInGPA(grade string) bool {
 	return grade != "I" && grade != "W"
 }
 
-// GetSeatsAvailable calculates available seats for a course
-func (c *Course) GetSeatsAvailable() int32 {
-	available := c.Capacity - c.Enrolled
+// ComputeGPA is the GPA arithmetic used wherever a GPA is shown: grade points
+// weighted by units, overall and per semester, oldest semester first. Grades not
+// counted in GPA (I, W) are skipped. Term GPA equals CGPA here; callers narrowing
+// to one term set it themselves.
+func ComputeGPA(records []GPARecord) GPAInfo {
+	var overallPoints, overallUnits float64
+	type semesterTotals struct {
+		points, units float64
+		count         int
+	}
+	semesterMap := make(map[string]*semesterTotals)
+
+	for _, g := range records {
+		if !IsGradeCountedInGPA(g.Grade) {
+			continue
+		}
+
+		points := GetGradePoints(g.Grade)
+		units := float64(g.Units)
+
+		overallPoints += points * units
+		overallUnits += units
+
+		if _, exists := semesterMap[g.Semester]; !exists {
+			semesterMap[g.Semester] = &semesterTotals{}
+		}
+		sm := semesterMap[g.Semester]
+		sm.points += points * units
+		sm.units += units
+		sm.count++
+	}
+
+	info := GPAInfo{
+		TotalUnitsAttempted: int32(overallUnits),
+		TotalUnitsEarned:    int32(overallUnits),
+	}
+	if overallUnits > 0 {
+		info.TermGPA = overallPoints / overallUnits
+		info.CGPA = overallPoints / overallUnits
+	}
+
+	for sem, data := range semesterMap {
+		sgpa := 0.0
+		if data.units > 0 {
+			sgpa = data.points / data.units
+		}
+		info.SemesterBreakdown = append(info.SemesterBreakdown, SemesterGPA{
+			Semester: sem, GPA: sgpa, Units: int32(data.units), CoursesCount: int32(data.count),
+		})
+	}
+	sort.Slice(info.SemesterBreakdown, func(i, j int) bool {
+		return CompareSemesters(info.SemesterBreakdown[i].Semester, info.SemesterBreakdown[j].Semester) < 0
+	})
+
+	return info
+}
+
+// SeatsAvailable returns capacity minus enrolled, clamped at zero so an
+// over-enrolled course (e.g. after an admin override) never reports negative seats
+func SeatsAvailable(capacity, enrolled int32) int32 {
+	available := capacity - enrolled
 	if available < 0 {
 		return 0
 	}
 	return available
 }
 
+// GetSeatsAvailable calculates available seats for a course
+func (c *Course) GetSeatsAvailable() int32 {
+	return SeatsAvailable(c.Capacity, c.Enrolled)
+}
+
+// IsFull reports whether the course has no seats left
+func (c *Course) IsFull() bool {
+	return c.GetSeatsAvailable() == 0
+}
+
+// FillRate returns the enrolled count as a percentage of capacity
+func (c *Course) FillRate() float64 {
+	if c.Capacity <= 0 {
+		return 0
+	}
+	return float64(c.Enrolled) * 100 / float64(c.Capacity)
+}
+
+// IsNearlyFull reports whether the fill rate has reached the given percent
+func (c *Course) IsNearlyFull(percent int32) bool {
+	return c.Capacity > 0 && c.Enrolled*100 >= percent*c.Capacity
+}
+
+// NeedsSeatHold reports whether one more seat, on top of those enrolled and the
+// held seats, brings the course to the given fill rate percent
+func (c *Course) NeedsSeatHold(held, percent int32) bool {
+	return c.Capacity > 0 && (c.Enrolled+held+1)*100 >= percent*c.Capacity
+}
+
 // IsAvailable checks if a course is available for enrollment
 func (c *Course) IsAvailable() bool {
-	return c.IsOpen && c.GetSeatsAvailable() > 0
+	return c.IsOpenAt(time.Now()) && c.GetSeatsAvailable() > 0
+}
+
+// IsOpenAt reports whether the course accepts enrollment at the given time
+func (c *Course) IsOpenAt(now time.Time) bool {
+	return CourseClosedReason(c.IsOpen, c.EnrollOpenAt, c.EnrollCloseAt, now) == ""
+}
+
+// ClosedReason explains why the course does not accept enrollment at the given
+// time, or returns "" when it does
+func (c *Course) ClosedReason(now time.Time) string {
+	if c.Cancelled {
+		return CourseCancelledReason
+	}
+	return CourseClosedReason(c.IsOpen, c.EnrollOpenAt, c.EnrollCloseAt, now)
+}
+
+// CourseClosedReason evaluates a course's effective open state: is_open must be
+// set and now must fall within the course's own window, when it has one. The
+// global enrollment period is checked separately.
+func CourseClosedReason(isOpen bool, openAt, closeAt, now time.Time) string {
+	switch {
+	case !isOpen:
+		return "course is closed for enrollment"
+	case !openAt.IsZero() && now.Before(openAt):
+		return fmt.Sprintf("course opens for enrollment at %s", openAt.UTC().Format(time.RFC3339))
+	case !closeAt.IsZero() && !now.Before(closeAt):
+		return fmt.Sprintf("course closed for enrollment at %s", closeAt.UTC().Format(time.RFC3339))
+	}
+	return ""
 }
 
-// IsCartFull checks if cart has reached maximum courses
-func (c *Cart) IsCartFull() bool {
-	return len(c.CourseIDs) >= 6
+// RestrictionViolation returns why a student with the given major and year level
+// may not take the course, or "" when the course restrictions are satisfied
+func (c *Course) RestrictionViolation(major string, yearLevel int32) string {
+	if len(c.AllowedMajors) > 0 {
+		allowed := false
+		for _, m := range c.AllowedMajors {
+			if strings.EqualFold(m, major) {
+				allowed = true
+				break
+			}
+		}
+		if !allowed {
+			return "restricted to majors: " + strings.Join(c.AllowedMajors, ", ")
+		}
+	}
+	if c.MinYearLevel > 0 && yearLevel < c.MinYearLevel {
+		return fmt.Sprintf("requires year level %d+", c.MinYearLevel)
+	}
+	return ""
 }
 
-// CanAddCourse checks if a course can be added to cart
-func (c *Cart) CanAddCourse(courseID string) bool {
+// DepartmentFromCode derives a department from a course code: the letters that open
+// its first segment starting with a letter, so "CS-101", "CS101" and "2024-CS-101"
+// all give "CS". It returns "" when the code has no such segment.
+func DepartmentFromCode(code string) string {
+	segments := strings.FieldsFunc(strings.ToUpper(code), func(r rune) bool {
+		return !(r >= 'A' && r <= 'Z' || r >= '0' && r <= '9')
+	})
+	for _, seg := range segments {
+		end := 0
+		for end < len(seg) && seg[end] >= 'A' && seg[end] <= 'Z' {
+			end++
+		}
+		if end > 0 {
+			return seg[:end]
+		}
+	}
+	return ""
+}
+
+// NormalizeDepartment is the stored form of a department filter or value
+func NormalizeDepartment(department string) string {
+	return strings.ToUpper(strings.TrimSpace(department))
+}
+
+// IsValidDepartmentCode reports whether a normalized code has the shape
+// DepartmentFromCode produces: 1 to 10 letters
+func IsValidDepartmentCode(code string) bool {
+	if code == "" || len(code) > 10 {
+		return false
+	}
+	for _, r := range code {
+		if r < 'A' || r > 'Z' {
+			return false
+		}
+	}
+	return true
+}
+
+// HasRestrictions checks if the course limits enrollment by major or year level
+func (c *Course) HasRestrictions() bool {
+	return len(c.AllowedMajors) > 0 || c.MinYearLevel > 0
+}
+
+// enrollmentTransitions lists the statuses each enrollment status may move to.
+// "" is a new enrollment. A student who drops and enrolls again gets a new
+// document; dropped -> enrolled is the admin restore of the original one.
+// Withdrawal follows a W grade and is undone when the W is corrected; otherwise
+// completed enrollments are final.
+var enrollmentTransitions = map[string][]string{
+	"":              {StatusEnrolled},
+	StatusEnrolled:  {StatusDropped, StatusCompleted, StatusWithdrawn},
+	StatusDropped:   {StatusEnrolled},
+	StatusCompleted: {StatusWithdrawn},
+	StatusWithdrawn: {StatusCompleted},
+}
+
+// CanTransition reports whether an enrollment may change from one status to another
+func CanTransition(from, to string) bool {
+	for _, next := range enrollmentTransitions[from] {
+		if next == to {
+			return true
+		}
+	}
+	return false
+}
+
+// CheckTransition returns ErrInvalidTransition when CanTransition disallows the change
+func CheckTransition(from, to string) error {
+	if CanTransition(from, to) {
+		return nil
+	}
+	name := from
+	if name == "" {
+		name = "new"
+	}
+	return ErrInvalidTransition.Newf("cannot change a %s enrollment to %s", name, to).
+		WithParam("from", name).
+		WithParam("to", to)
+}
+
+// Summary describes the hold for students, e.g. "financial hold". The reason is
+// the admin's internal note and only shows in the admin views.
+func (h *Hold) Summary() string {
+	return h.Type + " hold"
+}
+
+// IsCartFull checks if cart has reached the given maximum number of courses
+func (c *Cart) IsCartFull(maxCourses int) bool {
+	return len(c.CourseIDs) >= maxCourses
+}
+
+// ExpiryReason reports why the cart no longer counts: it was already expired by the
+// cleanup sweep, it sat untouched longer than maxIdle, or it was last changed before
+// an enrollment period that has since ended. It returns "" for a live cart.
+func (c *Cart) ExpiryReason(now time.Time, maxIdle time.Duration, window EnrollmentWindow) string {
+	switch {
+	case !c.ExpiredAt.IsZero():
+		return c.ExpiredReason
+	case c.UpdatedAt.IsZero():
+		return ""
+	case !window.End.IsZero() && now.After(window.End) && c.UpdatedAt.Before(window.End):
+		return CartExpiredPeriodEnded
+	case maxIdle > 0 && now.Sub(c.UpdatedAt) > maxIdle:
+		return CartExpiredInactive
+	default:
+		return ""
+	}
+}
+
+// FreshValidation returns the stored validation results when they were computed for
+// the cart's current contents no more than ttl ago, and nil when they must be recomputed
+func (c *Cart) FreshValidation(now time.Time, ttl time.Duration) *CartValidation {
+	v := c.ValidationResults
+	if v == nil || !v.CartUpdatedAt.Equal(c.UpdatedAt) || now.Sub(v.ComputedAt) > ttl {
+		return nil
+	}
+	return v
+}
+
+// FullTimeLoadWarning returns a notice when a semester load of units falls below
+// minUnits, the full-time floor, and "" when it does not or no floor is set
+func FullTimeLoadWarning(units, minUnits int32) string {
+	if minUnits <= 0 || units >= minUnits {
+		return ""
+	}
+	return fmt.Sprintf("%d units is below the full-time load of %d units", units, minUnits)
+}
+
+// CanAddCourse checks if a course can be added to a cart holding at most maxCourses
+func (c *Cart) CanAddCourse(courseID string, maxCourses int) bool {
 	// Check if already in cart
 	for _, id := range c.CourseIDs {
 		if id == courseID {
 			return false
 		}
 	}
-	return !c.IsCartFull()
+	return !c.IsCartFull(maxCourses)
 }
 
 // IsExpired checks if a session has expired
@@ -312,25 +889,167 @@ func (s *Session) IsExpired() bool {
 	return time.Now().After(s.ExpiresAt)
 }
 
+// EnrollmentWindow is the enrollment period from system config. A zero Start or End
+// leaves that side of the window unbounded.
+type EnrollmentWindow struct {
+	Start     time.Time
+	End       time.Time
+	Enabled   bool          // enrollment_enabled; false closes enrollment regardless of dates
+	DropGrace time.Duration // how long after End students may still drop
+}
+
+// Phase returns the enrollment phase at the given time:
+// "preview" before Start, "open" within the window and "closed" after End
+func (w EnrollmentWindow) Phase(now time.Time) string {
+	switch {
+	case !w.Enabled:
+		return PhaseClosed
+	case !w.Start.IsZero() && now.Before(w.Start):
+		return PhasePreview
+	case !w.End.IsZero() && now.After(w.End):
+		return PhaseClosed
+	default:
+		return PhaseOpen
+	}
+}
+
+// DropDeadline is the last moment drops are accepted, zero when unbounded
+func (w EnrollmentWindow) DropDeadline() time.Time {
+	if w.End.IsZero() {
+		return time.Time{}
+	}
+	return w.End.Add(w.DropGrace)
+}
+
+// CanAddToCart reports whether students may change their carts (preview and open)
+func (w EnrollmentWindow) CanAddToCart(now time.Time) bool {
+	return w.Phase(now) != PhaseClosed
+}
+
+// CanEnroll reports whether EnrollAll is accepted (open only)
+func (w EnrollmentWindow) CanEnroll(now time.Time) bool {
+	return w.Phase(now) == PhaseOpen
+}
+
+// CanDrop reports whether students may drop (open, plus the grace period after End)
+func (w EnrollmentWindow) CanDrop(now time.Time) bool {
+	switch w.Phase(now) {
+	case PhaseOpen:
+		return true
+	case PhaseClosed:
+		return w.Enabled && !w.End.IsZero() && !now.After(w.DropDeadline())
+	default:
+		return false
+	}
+}
+
 // ============================================================================
 // Validation Constants
 // ============================================================================
 
 const (
-	// Cart limits
+	// Cart limits, used when max_courses_in_cart and max_units_per_semester are unset
 	MaxCoursesInCart    = 6
 	MaxUnitsPerSemester = 18
 
+	// Highest values an admin may configure for those limits
+	MaxCoursesInCartSetting    = 20
+	MaxUnitsPerSemesterSetting = 40
+	MaxDropsPerSemesterSetting = 20
+
+	// Free-text field limits in characters, enforced by ValidateText
+	MaxNameLength        = 100  // user names and display names
+	MaxTitleLength       = 200  // course titles
+	MaxDescriptionLength = 5000 // course descriptions
+	MaxReasonLength      = 500  // reasons given for admin actions
+
+	// Fill rate (percent of capacity) at which a course counts as nearly full
+	DefaultCapacityWarningPercent = 90
+
+	// Hours after dropped_at during which an admin may restore an enrollment
+	DefaultRestoreWindowHours = 48
+
+	// Page sizes for a student's grade history
+	DefaultGradesPageSize = 50
+	MaxGradesPageSize     = 200
+
+	// Days a grade draft is kept when no grade upload deadline is configured
+	DefaultGradeDraftDays = 30
+
+	// Days a cart may sit untouched before it expires
+	DefaultCartExpiryDays = 14
+
+	// Minutes a seat hold lasts before the seat returns to the course
+	DefaultSeatHoldMinutes = 15
+
+	// Seconds EnrollAll may reuse the validation results GetCart stored on a cart
+	DefaultCartValidationTTLSeconds = 30
+
+	// Days after publication during which a grade may be appealed
+	DefaultAppealWindowDays = 30
+
+	// Units per semester a faculty member may teach before the load report flags them
+	DefaultFacultyLoadMaxUnits = 12
+
+	// Why a cart expired (Cart.ExpiryReason)
+	CartExpiredInactive    = "inactive"
+	CartExpiredPeriodEnded = "enrollment_period_ended"
+
+	// Grade appeal statuses; the resolved ones double as ResolveGradeAppeal decisions
+	AppealOpen     = "open"
+	AppealApproved = "approved"
+	AppealDenied   = "denied"
+
+	// Grade appeal text limits
+	MaxAppealReasonLength  = 1000
+	MaxAppealCommentLength = 1000
+
 	// Enrollment statuses
 	StatusEnrolled  = "enrolled"
 	StatusDropped   = "dropped"
 	StatusCompleted = "completed"
+	StatusWithdrawn = "withdrawn" // graded W
+
+	// Why an enrollment was dropped on the student's behalf (Enrollment.DropReason)
+	DropReasonCourseCancelled = "course_cancelled"
+	DropReasonAdminForced     = "admin_forced"
+
+	// DropSourceSelf is the source DropSource reports for a drop the student made;
+	// other drops report their drop reason
+	DropSourceSelf = "self"
+
+	// ClosedReason of a cancelled course
+	CourseCancelledReason = "course has been cancelled"
+
+	// Notification types
+	NotificationCourseCancelled = "course_cancelled"
+	NotificationGradesPublished = "grades_published"
+	NotificationAppealResolved  = "grade_appeal_resolved"
+
+	// Enrollment event sources
+	EventSourceSelf     = "self"     // student enrolled or dropped
+	EventSourceOverride = "override" // admin override or restore
+	EventSourceGrade    = "grade"    // W grade recorded or corrected
 
 	// User roles
 	RoleStudent = "student"
 	RoleFaculty = "faculty"
 	RoleAdmin   = "admin"
 
+	// Enrollment phases
+	PhasePreview = "preview"
+	PhaseOpen    = "open"
+	PhaseClosed  = "closed"
+
+	// Hours after enrollment_end during which students may still drop
+	DefaultDropGraceHours = 168
+
+	// Hold types
+	HoldFinancial      = "financial"
+	HoldDisciplinary   = "disciplinary"
+	HoldAcademic       = "academic"
+	HoldAdministrative = "administrative"
+
 	// Grades
 	GradeA = "A"
 	GradeB = "B"
@@ -340,25 +1059,109 @@ const (
 	GradeI = "I" // Incomplete
 	GradeW = "W" // Withdrawn
 
+	// Course materials limits
+	MaxCourseMaterials     = 10
+	MaxMaterialTitleLength = 200
+	MaxMaterialURLLength   = 2048
+
+	// Course review limits
+	MinReviewRating        = 1
+	MaxReviewRating        = 5
+	MaxReviewCommentLength = 2000
+
+	// Dean's list defaults
+	DeansListMinGPA   = 3.5
+	DeansListMinUnits = 12
+
 	// Audit actions
-	ActionLogin        = "login"
-	ActionLogout       = "logout"
-	ActionEnroll       = "enroll"
-	ActionDrop         = "drop"
-	ActionGradeUpload  = "grade_upload"
-	ActionCourseCreate = "course_create"
-	ActionCourseUpdate = "course_update"
-	ActionUserCreate   = "user_create"
-	ActionUserUpdate   = "user_update"
-	ActionConfigChange = "config_change"
+	ActionLogin         = "login"
+	ActionLogout        = "logout"
+	ActionEnroll        = "enroll"
+	ActionDrop          = "drop"
+	ActionGradeUpload   = "grade_upload"
+	ActionCourseCreate  = "course_create"
+	ActionCourseUpdate  = "course_update"
+	ActionUserCreate    = "user_create"
+	ActionUserUpdate    = "user_update"
+	ActionConfigChange  = "config_change"
+	ActionCountRepair   = "enrollment_count_repair"
+	ActionTransferAdd   = "transfer_credit_add"
+	ActionCapacityWarn  = "course_capacity_warning"
+	ActionEnrollRestore = "enrollment_restore"
+	ActionHoldPlace     = "hold_place"
+	ActionHoldClear     = "hold_clear"
+	ActionGradeChange   = "grade_change"
+	ActionAppealSubmit  = "grade_appeal_submit"
+	ActionAppealResolve = "grade_appeal_resolve"
+	ActionPrereqAdd     = "prerequisite_add"
+	ActionPrereqRemove  = "prerequisite_remove"
+	ActionDeptCreate    = "department_create"
+	ActionDeptUpdate    = "department_update"
+	ActionDeptDelete    = "department_delete"
+	ActionSemesterClose = "semester_close"
+	ActionCourseCancel  = "course_cancel"
+	ActionGradesPublish = "grades_publish_all"
 
 	// System config keys
 	ConfigEnrollmentStart = "enrollment_start"
 	ConfigEnrollmentEnd   = "enrollment_end"
+	ConfigEnrollmentOn    = "enrollment_enabled"
+	ConfigDropGrace       = "drop_grace_period_hours"
 	ConfigMaxUnits        = "max_units_per_semester"
 	ConfigMaxCourses      = "max_courses_in_cart"
 	ConfigCurrentSemester = "current_semester"
 	ConfigGradeDeadline   = "grade_upload_deadline"
+
+	// ConfigGradeEnrolled lets faculty grade students whose enrollment is still "enrolled"
+	// ("true" by default); "false" requires the semester to be closed first
+	ConfigGradeEnrolled = "grade_enrolled_students"
+
+	// ConfigCapacityWarning is the fill rate percent (1-100) that triggers a capacity warning
+	ConfigCapacityWarning = "capacity_warning_threshold"
+
+	// ConfigRestoreWindow is how many hours after a drop RestoreEnrollment is allowed
+	ConfigRestoreWindow = "restore_window_hours"
+
+	// ConfigOverrideAutoClose closes a course once force_enroll fills it ("true" by default)
+	ConfigOverrideAutoClose = "override_auto_close"
+
+	// ConfigGradesPageSize is the default GetStudentGrades page size (1 to MaxGradesPageSize)
+	ConfigGradesPageSize = "grades_page_size"
+
+	// ConfigCartExpiryDays is how many days an untouched cart is kept (DefaultCartExpiryDays)
+	ConfigCartExpiryDays = "cart_expiry_days"
+
+	// ConfigSeatHoldsEnabled turns on seat holds for nearly full courses in carts ("false" by default)
+	ConfigSeatHoldsEnabled = "seat_holds_enabled"
+
+	// ConfigSeatHoldMinutes is how many minutes a seat hold lasts (DefaultSeatHoldMinutes)
+	ConfigSeatHoldMinutes = "seat_hold_minutes"
+
+	// ConfigMinFullTimeUnits is the semester load below which students are warned they
+	// are not full-time; unset or 0 turns the warning off. It never blocks enrollment.
+	ConfigMinFullTimeUnits = "min_full_time_units"
+
+	// ConfigCartValidationTTL is how many seconds stored cart validation stays reusable (DefaultCartValidationTTLSeconds)
+	ConfigCartValidationTTL = "cart_validation_ttl_seconds"
+
+	// ConfigAppealWindowDays is how many days after published_at a grade may be appealed (DefaultAppealWindowDays)
+	ConfigAppealWindowDays = "grade_appeal_window_days"
+
+	// ConfigFacultyLoadMaxUnits is the units per semester above which a faculty load is flagged
+	ConfigFacultyLoadMaxUnits = "faculty_load_max_units"
+
+	// ConfigMaxDrops is how many courses a student may drop per semester; unset or 0
+	// means no limit. Admin force drops and course cancellations do not count.
+	ConfigMaxDrops = "max_drops_per_semester"
+
+	// ConfigCatalogVersion is stamped by course changes so the course catalog cache drops
+	// its pages; it is bookkeeping, not a setting (BumpCatalogVersion)
+	ConfigCatalogVersion = "course_catalog_version"
+
+	// gRPC metadata keys set by the gateway
+	MetadataAuthorization  = "authorization"   // "Bearer <token>" of the calling user
+	MetadataClientIP       = "x-client-ip"     // originating client IP address
+	MetadataIdempotencyKey = "idempotency-key" // client Idempotency-Key of a mutation, recorded in audit logs
 )
 
 // ============================================================================
@@ -0,0 +1,322 @@
+package shared
+
+import (
+	"sort"
+	"testing"
+	"time"
+)
+
+func TestCompareSemesters(t *testing.T) {
+	semesters := []string{"Fall 2024", "TestSem", "Spring 2024", "Fall 2023", "Summer 2024", "Spring 2025"}
+	sort.Slice(semesters, func(i, j int) bool { return CompareSemesters(semesters[i], semesters[j]) < 0 })
+
+	expected := []string{"Fall 2023", "Spring 2024", "Summer 2024", "Fall 2024", "Spring 2025", "TestSem"}
+	for i := range expected {
+		if semesters[i] != expected[i] {
+			t.Fatalf("Expected %v, got %v", expected, semesters)
+		}
+	}
+
+	// Term names are case-insensitive
+	if CompareSemesters("fall 2024", "Spring 2024") <= 0 {
+		t.Error("Expected fall 2024 to sort after Spring 2024")
+	}
+}
+
+func TestSemesterSortKey(t *testing.T) {
+	if SemesterSortKey("Fall 2024") != 20244 || SemesterSortKey("Spring 2024") != 20242 {
+		t.Errorf("Unexpected keys: Fall 2024=%d, Spring 2024=%d", SemesterSortKey("Fall 2024"), SemesterSortKey("Spring 2024"))
+	}
+	// Lexically "Fall 2024" < "Spring 2024", chronologically it is later
+	if SemesterSortKey("Fall 2024") <= SemesterSortKey("Spring 2024") {
+		t.Error("Expected Fall 2024 to sort after Spring 2024")
+	}
+	if SemesterSortKey("TestSem") != 0 {
+		t.Errorf("Expected 0 for a label without a year, got %d", SemesterSortKey("TestSem"))
+	}
+}
+
+func TestIsFutureSemester(t *testing.T) {
+	cases := []struct {
+		semester, current string
+		future            bool
+	}{
+		{"Spring 2025", "Fall 2024", true},
+		{"Fall 2024", "Fall 2024", false},
+		{"Spring 2024", "Fall 2024", false},
+		{"TestSem", "Fall 2024", false}, // no year, sorts last but is not "future"
+		{"Spring 2025", "", false},
+	}
+	for _, c := range cases {
+		if got := IsFutureSemester(c.semester, c.current); got != c.future {
+			t.Errorf("IsFutureSemester(%q, %q) = %v, want %v", c.semester, c.current, got, c.future)
+		}
+	}
+}
+
+func TestCourseFillRate(t *testing.T) {
+	c := Course{Capacity: 10, Enrolled: 9}
+	if c.FillRate() != 90 {
+		t.Errorf("Expected fill rate 90, got %v", c.FillRate())
+	}
+	if !c.IsNearlyFull(90) || c.IsNearlyFull(91) {
+		t.Error("Expected 9/10 to reach 90% but not 91%")
+	}
+
+	// Courses without capacity are never nearly full
+	empty := Course{}
+	if empty.FillRate() != 0 || empty.IsNearlyFull(1) {
+		t.Error("Expected zero-capacity course to report no fill")
+	}
+}
+
+func TestCourseSeatsAvailable(t *testing.T) {
+	c := Course{Capacity: 10, Enrolled: 9}
+	if c.GetSeatsAvailable() != 1 || c.IsFull() {
+		t.Errorf("Expected 1 seat left, got %d (full=%v)", c.GetSeatsAvailable(), c.IsFull())
+	}
+
+	// Admin overrides can push enrollment past capacity; seats never go negative
+	over := Course{Capacity: 10, Enrolled: 12}
+	if over.GetSeatsAvailable() != 0 || !over.IsFull() {
+		t.Errorf("Expected over-enrolled course to be full with 0 seats, got %d", over.GetSeatsAvailable())
+	}
+}
+
+func TestCourseNeedsSeatHold(t *testing.T) {
+	c := Course{Capacity: 10, Enrolled: 7}
+	if c.NeedsSeatHold(0, 90) {
+		t.Error("Expected no hold for the 8th of 10 seats at a 90% threshold")
+	}
+	// The 9th seat, counting one held seat, reaches 90%
+	if !c.NeedsSeatHold(1, 90) {
+		t.Error("Expected a hold for the 9th of 10 seats at a 90% threshold")
+	}
+	if (&Course{}).NeedsSeatHold(0, 90) {
+		t.Error("Expected no hold for a course without capacity")
+	}
+}
+
+func TestCourseOpenAt(t *testing.T) {
+	now := time.Date(2031, 1, 10, 12, 0, 0, 0, time.UTC)
+	opens := now.Add(24 * time.Hour)
+	closes := now.Add(48 * time.Hour)
+
+	// Without a window the boolean alone decides
+	if !(&Course{IsOpen: true}).IsOpenAt(now) || (&Course{}).IsOpenAt(now) {
+		t.Error("Expected is_open to decide for a course without a window")
+	}
+
+	windowed := &Course{IsOpen: true, EnrollOpenAt: opens, EnrollCloseAt: closes}
+	if reason := windowed.ClosedReason(now); reason != "course opens for enrollment at 2031-01-11T12:00:00Z" {
+		t.Errorf("Expected a window that hasn't opened to block enrollment, got %q", reason)
+	}
+	if !windowed.IsOpenAt(opens) || !windowed.IsOpenAt(closes.Add(-time.Second)) {
+		t.Error("Expected the course to be open within its window")
+	}
+	if windowed.IsOpenAt(closes) {
+		t.Error("Expected the window close to be exclusive")
+	}
+
+	// A manual close still wins inside the window
+	windowed.IsOpen = false
+	if windowed.IsOpenAt(opens) {
+		t.Error("Expected is_open=false to close the course within its window")
+	}
+}
+
+func TestCanTransition(t *testing.T) {
+	statuses := []string{"", StatusEnrolled, StatusDropped, StatusCompleted, StatusWithdrawn}
+	allowed := map[[2]string]bool{
+		{"", StatusEnrolled}:               true,
+		{StatusEnrolled, StatusDropped}:    true,
+		{StatusEnrolled, StatusCompleted}:  true,
+		{StatusEnrolled, StatusWithdrawn}:  true,
+		{StatusDropped, StatusEnrolled}:    true,
+		{StatusCompleted, StatusWithdrawn}: true,
+		{StatusWithdrawn, StatusCompleted}: true,
+	}
+
+	for _, from := range statuses {
+		for _, to := range statuses {
+			want := allowed[[2]string{from, to}]
+			if got := CanTransition(from, to); got != want {
+				t.Errorf("CanTransition(%q, %q) = %v, want %v", from, to, got, want)
+			}
+			if err := CheckTransition(from, to); (err == nil) != want {
+				t.Errorf("CheckTransition(%q, %q) = %v", from, to, err)
+			}
+		}
+	}
+
+	err := CheckTransition(StatusCompleted, StatusDropped)
+	if ErrorCodeOf(err) != ErrCodeInvalidStatusTransition || err.Error() != "cannot change a completed enrollment to dropped" {
+		t.Errorf("Expected a completed enrollment to be final, got %v", err)
+	}
+}
+
+func TestCourseRestrictionViolation(t *testing.T) {
+	seniorSeminar := &Course{Code: "CS499", MinYearLevel: 4, AllowedMajors: []string{"Computer Science"}}
+
+	if reason := seniorSeminar.RestrictionViolation("Computer Science", 3); reason != "requires year level 4+" {
+		t.Errorf("Expected a junior to be blocked from a senior-only course, got %q", reason)
+	}
+	if reason := seniorSeminar.RestrictionViolation("computer science", 4); reason != "" {
+		t.Errorf("Expected a senior in the major to be allowed (case-insensitive), got %q", reason)
+	}
+	if reason := seniorSeminar.RestrictionViolation("Mathematics", 4); reason != "restricted to majors: Computer Science" {
+		t.Errorf("Expected a senior outside the major to be blocked, got %q", reason)
+	}
+
+	// Empty/zero restrictions leave existing courses open to everyone
+	open := &Course{Code: "CS101"}
+	if open.HasRestrictions() || open.RestrictionViolation("", 1) != "" {
+		t.Error("Expected a course without restrictions to admit any student")
+	}
+}
+
+func TestDepartmentFromCode(t *testing.T) {
+	for code, want := range map[string]string{
+		"CS-101":      "CS",
+		"CS101":       "CS",
+		"2024-CS-101": "CS",
+		"cs 101":      "CS",
+		"MATH_201L":   "MATH",
+		"2024-101":    "",
+	} {
+		if got := DepartmentFromCode(code); got != want {
+			t.Errorf("DepartmentFromCode(%q) = %q, want %q", code, got, want)
+		}
+	}
+}
+
+func TestIsValidDepartmentCode(t *testing.T) {
+	for code, want := range map[string]bool{
+		"CS":          true,
+		"MATH":        true,
+		"":            false,
+		"CS1":         false,
+		"C-S":         false,
+		"cs":          false, // callers normalize first
+		"ABCDEFGHIJK": false,
+	} {
+		if got := IsValidDepartmentCode(code); got != want {
+			t.Errorf("IsValidDepartmentCode(%q) = %v, want %v", code, got, want)
+		}
+	}
+}
+
+func TestReviewRatingsValidate(t *testing.T) {
+	if err := (ReviewRatings{Content: 1, Instruction: 5, Organization: 3, Overall: 4}).Validate(); err != nil {
+		t.Errorf("Expected ratings within 1-5 to be valid, got %v", err)
+	}
+	if err := (ReviewRatings{Content: 4, Instruction: 6, Organization: 3, Overall: 4}).Validate(); err == nil {
+		t.Error("Expected a rating above 5 to be rejected")
+	}
+	// Every dimension is required; an unrated one is zero
+	if err := (ReviewRatings{Content: 4, Instruction: 4, Overall: 4}).Validate(); err == nil {
+		t.Error("Expected a missing rating to be rejected")
+	}
+}
+
+func TestEnrollmentWindowPhases(t *testing.T) {
+	start := time.Date(2025, 1, 6, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2025, 1, 10, 0, 0, 0, 0, time.UTC)
+	w := EnrollmentWindow{Start: start, End: end, Enabled: true, DropGrace: 48 * time.Hour}
+
+	tests := []struct {
+		name                  string
+		at                    time.Time
+		phase                 string
+		cart, enroll, dropped bool
+	}{
+		{"Before Start", start.Add(-time.Hour), PhasePreview, true, false, false},
+		{"Within Window", start.Add(time.Hour), PhaseOpen, true, true, true},
+		{"Within Drop Grace", end.Add(24 * time.Hour), PhaseClosed, false, false, true},
+		{"After Drop Grace", end.Add(72 * time.Hour), PhaseClosed, false, false, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := w.Phase(tt.at); got != tt.phase {
+				t.Errorf("Expected phase %s, got %s", tt.phase, got)
+			}
+			if w.CanAddToCart(tt.at) != tt.cart || w.CanEnroll(tt.at) != tt.enroll || w.CanDrop(tt.at) != tt.dropped {
+				t.Errorf("Expected cart=%v enroll=%v drop=%v", tt.cart, tt.enroll, tt.dropped)
+			}
+		})
+	}
+
+	// No dates configured: always open; disabled: always closed
+	if (EnrollmentWindow{Enabled: true}).Phase(start) != PhaseOpen {
+		t.Error("Expected an unbounded window to be open")
+	}
+	w.Enabled = false
+	if w.Phase(start.Add(time.Hour)) != PhaseClosed || w.CanDrop(start.Add(time.Hour)) {
+		t.Error("Expected a disabled window to be closed without drops")
+	}
+}
+
+func TestCartFreshValidation(t *testing.T) {
+	now := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	updated := now.Add(-time.Minute)
+	ttl := 30 * time.Second
+
+	tests := []struct {
+		name       string
+		validation *CartValidation
+		fresh      bool
+	}{
+		{"Never Validated", nil, false},
+		{"Recent", &CartValidation{CartUpdatedAt: updated, ComputedAt: now.Add(-10 * time.Second)}, true},
+		{"Older Than TTL", &CartValidation{CartUpdatedAt: updated, ComputedAt: now.Add(-time.Minute)}, false},
+		{"Cart Changed Since", &CartValidation{CartUpdatedAt: updated.Add(-time.Hour), ComputedAt: now.Add(-10 * time.Second)}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cart := Cart{UpdatedAt: updated, ValidationResults: tt.validation}
+			if got := cart.FreshValidation(now, ttl); (got != nil) != tt.fresh {
+				t.Errorf("Expected fresh %v, got %+v", tt.fresh, got)
+			}
+		})
+	}
+}
+
+func TestFullTimeLoadWarning(t *testing.T) {
+	if got := FullTimeLoadWarning(9, 12); got != "9 units is below the full-time load of 12 units" {
+		t.Errorf("Expected a warning for 9 of 12 units, got %q", got)
+	}
+	if got := FullTimeLoadWarning(12, 12); got != "" {
+		t.Errorf("Expected no warning at the floor, got %q", got)
+	}
+	if got := FullTimeLoadWarning(3, 0); got != "" {
+		t.Errorf("Expected no warning without a floor, got %q", got)
+	}
+}
+
+func TestCartExpiryReason(t *testing.T) {
+	now := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+	idle := 14 * 24 * time.Hour
+	open := EnrollmentWindow{Enabled: true}
+	ended := EnrollmentWindow{Enabled: true, End: now.Add(-24 * time.Hour)}
+
+	tests := []struct {
+		name   string
+		cart   Cart
+		window EnrollmentWindow
+		want   string
+	}{
+		{"Recently Touched", Cart{UpdatedAt: now.Add(-time.Hour)}, open, ""},
+		{"Untouched Too Long", Cart{UpdatedAt: now.Add(-15 * 24 * time.Hour)}, open, CartExpiredInactive},
+		{"Built Before Period Ended", Cart{UpdatedAt: now.Add(-48 * time.Hour)}, ended, CartExpiredPeriodEnded},
+		{"Built After Period Ended", Cart{UpdatedAt: now.Add(-time.Hour)}, ended, ""},
+		{"Already Expired By Sweep", Cart{UpdatedAt: now, ExpiredAt: now, ExpiredReason: CartExpiredInactive}, open, CartExpiredInactive},
+		{"No Timestamp", Cart{}, open, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.cart.ExpiryReason(now, idle, tt.window); got != tt.want {
+				t.Errorf("Expected %q, got %q", tt.want, got)
+			}
+		})
+	}
+}
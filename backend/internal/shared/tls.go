@@ -0,0 +1,130 @@
+// ============================================================================
+// backend/shared/tls.go
+// Transport credentials for inter-service gRPC (TLS / mutual TLS)
+// ============================================================================
+
+package shared
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
+
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// TLSConfig holds transport security settings for gRPC servers and clients.
+//
+// Environment variables:
+//
+//	GRPC_TLS_ENABLED      serve and dial with TLS (default false)
+//	GRPC_TLS_CERT_FILE    PEM certificate; the server cert, or the client cert for mTLS
+//	GRPC_TLS_KEY_FILE     PEM private key matching GRPC_TLS_CERT_FILE
+//	GRPC_TLS_CA_FILE      PEM CA bundle; servers require client certs signed by it,
+//	                      clients verify servers against it instead of the system roots
+//	GRPC_TLS_SERVER_NAME  name expected in server certificates (clients only)
+//	GRPC_ALLOW_INSECURE   permit plaintext when TLS is disabled
+//	                      (default true only when ENVIRONMENT=development)
+type TLSConfig struct {
+	Enabled       bool
+	CertFile      string
+	KeyFile       string
+	CAFile        string
+	ServerName    string
+	AllowInsecure bool
+}
+
+// LoadTLSConfig reads the gRPC TLS settings from the environment
+func LoadTLSConfig() TLSConfig {
+	return TLSConfig{
+		Enabled:       GetBoolEnv("GRPC_TLS_ENABLED", false),
+		CertFile:      GetEnv("GRPC_TLS_CERT_FILE", ""),
+		KeyFile:       GetEnv("GRPC_TLS_KEY_FILE", ""),
+		CAFile:        GetEnv("GRPC_TLS_CA_FILE", ""),
+		ServerName:    GetEnv("GRPC_TLS_SERVER_NAME", ""),
+		AllowInsecure: GetBoolEnv("GRPC_ALLOW_INSECURE", GetEnv("ENVIRONMENT", "development") == "development"),
+	}
+}
+
+// ServerCredentials builds the transport credentials for a gRPC server.
+// Setting CAFile turns on mutual TLS: clients must present a certificate signed by it.
+func ServerCredentials(cfg TLSConfig) (credentials.TransportCredentials, error) {
+	if !cfg.Enabled {
+		return plaintextCredentials(cfg)
+	}
+
+	if cfg.CertFile == "" || cfg.KeyFile == "" {
+		return nil, fmt.Errorf("GRPC_TLS_CERT_FILE and GRPC_TLS_KEY_FILE are required when TLS is enabled")
+	}
+	cert, err := tls.LoadX509KeyPair(cfg.CertFile, cfg.KeyFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load server key pair: %w", err)
+	}
+
+	tlsCfg := &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		MinVersion:   tls.VersionTLS12,
+	}
+	if cfg.CAFile != "" {
+		pool, err := loadCertPool(cfg.CAFile)
+		if err != nil {
+			return nil, err
+		}
+		tlsCfg.ClientCAs = pool
+		tlsCfg.ClientAuth = tls.RequireAndVerifyClientCert
+	}
+
+	return credentials.NewTLS(tlsCfg), nil
+}
+
+// ClientCredentials builds the transport credentials for dialing a gRPC server.
+// A client certificate is presented when CertFile and KeyFile are set.
+func ClientCredentials(cfg TLSConfig) (credentials.TransportCredentials, error) {
+	if !cfg.Enabled {
+		return plaintextCredentials(cfg)
+	}
+
+	tlsCfg := &tls.Config{
+		ServerName: cfg.ServerName,
+		MinVersion: tls.VersionTLS12,
+	}
+	if cfg.CAFile != "" {
+		pool, err := loadCertPool(cfg.CAFile)
+		if err != nil {
+			return nil, err
+		}
+		tlsCfg.RootCAs = pool
+	}
+	if cfg.CertFile != "" || cfg.KeyFile != "" {
+		cert, err := tls.LoadX509KeyPair(cfg.CertFile, cfg.KeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load client key pair: %w", err)
+		}
+		tlsCfg.Certificates = []tls.Certificate{cert}
+	}
+
+	return credentials.NewTLS(tlsCfg), nil
+}
+
+// plaintextCredentials returns insecure credentials only when explicitly allowed
+func plaintextCredentials(cfg TLSConfig) (credentials.TransportCredentials, error) {
+	if !cfg.AllowInsecure {
+		return nil, fmt.Errorf("gRPC TLS is disabled and plaintext is not allowed: set GRPC_TLS_ENABLED=true or GRPC_ALLOW_INSECURE=true")
+	}
+	return insecure.NewCredentials(), nil
+}
+
+// loadCertPool reads a PEM CA bundle into a certificate pool
+func loadCertPool(caFile string) (*x509.CertPool, error) {
+	pem, err := os.ReadFile(caFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read CA file: %w", err)
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(pem) {
+		return nil, fmt.Errorf("no certificates found in CA file %s", caFile)
+	}
+	return pool, nil
+}
@@ -0,0 +1,161 @@
+package shared
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// writeTestCerts creates a CA plus a server and a client certificate signed by it,
+// returning the paths of the PEM files
+func writeTestCerts(t *testing.T) (caFile, serverCert, serverKey, clientCert, clientKey string) {
+	t.Helper()
+	dir := t.TempDir()
+
+	caKey, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	caTmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test-ca"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+	}
+	caDER, err := x509.CreateCertificate(rand.Reader, caTmpl, caTmpl, &caKey.PublicKey, caKey)
+	if err != nil {
+		t.Fatalf("failed to create CA: %v", err)
+	}
+	caCert, _ := x509.ParseCertificate(caDER)
+
+	issue := func(name string, serial int64, usage x509.ExtKeyUsage) (string, string) {
+		key, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		tmpl := &x509.Certificate{
+			SerialNumber: big.NewInt(serial),
+			Subject:      pkix.Name{CommonName: name},
+			DNSNames:     []string{"localhost"},
+			IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+			NotBefore:    time.Now().Add(-time.Hour),
+			NotAfter:     time.Now().Add(time.Hour),
+			KeyUsage:     x509.KeyUsageDigitalSignature,
+			ExtKeyUsage:  []x509.ExtKeyUsage{usage},
+		}
+		der, err := x509.CreateCertificate(rand.Reader, tmpl, caCert, &key.PublicKey, caKey)
+		if err != nil {
+			t.Fatalf("failed to create %s certificate: %v", name, err)
+		}
+		keyDER, _ := x509.MarshalECPrivateKey(key)
+
+		certPath := filepath.Join(dir, name+".crt")
+		keyPath := filepath.Join(dir, name+".key")
+		os.WriteFile(certPath, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0600)
+		os.WriteFile(keyPath, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}), 0600)
+		return certPath, keyPath
+	}
+
+	caFile = filepath.Join(dir, "ca.crt")
+	os.WriteFile(caFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: caDER}), 0600)
+	serverCert, serverKey = issue("server", 2, x509.ExtKeyUsageServerAuth)
+	clientCert, clientKey = issue("client", 3, x509.ExtKeyUsageClientAuth)
+	return
+}
+
+// startTLSServer serves the health service with the given TLS settings on a local port
+func startTLSServer(t *testing.T, cfg TLSConfig) string {
+	t.Helper()
+	creds, err := ServerCredentials(cfg)
+	if err != nil {
+		t.Fatalf("ServerCredentials failed: %v", err)
+	}
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to listen: %v", err)
+	}
+	server := grpc.NewServer(grpc.Creds(creds))
+	grpc_health_v1.RegisterHealthServer(server, health.NewServer())
+	go server.Serve(lis)
+	t.Cleanup(server.Stop)
+
+	return lis.Addr().String()
+}
+
+// healthCheck performs a single health RPC over a connection with the given options
+func healthCheck(addr string, opts ...grpc.DialOption) error {
+	conn, err := grpc.NewClient(addr, opts...)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+	_, err = grpc_health_v1.NewHealthClient(conn).Check(ctx, &grpc_health_v1.HealthCheckRequest{})
+	return err
+}
+
+func TestTLSCredentials(t *testing.T) {
+	caFile, serverCert, serverKey, clientCert, clientKey := writeTestCerts(t)
+
+	t.Run("TLS Server Rejects Plaintext Client", func(t *testing.T) {
+		addr := startTLSServer(t, TLSConfig{Enabled: true, CertFile: serverCert, KeyFile: serverKey})
+
+		if err := healthCheck(addr, grpc.WithTransportCredentials(insecure.NewCredentials())); err == nil {
+			t.Fatal("Expected plaintext client to be rejected by TLS server")
+		}
+
+		creds, err := ClientCredentials(TLSConfig{Enabled: true, CAFile: caFile, ServerName: "localhost"})
+		if err != nil {
+			t.Fatalf("ClientCredentials failed: %v", err)
+		}
+		if err := healthCheck(addr, grpc.WithTransportCredentials(creds)); err != nil {
+			t.Errorf("Expected TLS client to succeed, got %v", err)
+		}
+	})
+
+	t.Run("Mutual TLS Requires Client Certificate", func(t *testing.T) {
+		addr := startTLSServer(t, TLSConfig{Enabled: true, CertFile: serverCert, KeyFile: serverKey, CAFile: caFile})
+
+		noCert, _ := ClientCredentials(TLSConfig{Enabled: true, CAFile: caFile, ServerName: "localhost"})
+		if err := healthCheck(addr, grpc.WithTransportCredentials(noCert)); err == nil {
+			t.Error("Expected client without certificate to be rejected")
+		}
+
+		withCert, err := ClientCredentials(TLSConfig{
+			Enabled: true, CAFile: caFile, ServerName: "localhost", CertFile: clientCert, KeyFile: clientKey,
+		})
+		if err != nil {
+			t.Fatalf("ClientCredentials failed: %v", err)
+		}
+		if err := healthCheck(addr, grpc.WithTransportCredentials(withCert)); err != nil {
+			t.Errorf("Expected client with certificate to succeed, got %v", err)
+		}
+	})
+
+	t.Run("Plaintext Requires Explicit Opt-In", func(t *testing.T) {
+		if _, err := ServerCredentials(TLSConfig{}); err == nil {
+			t.Error("Expected error when TLS is disabled and insecure is not allowed")
+		}
+		if _, err := ClientCredentials(TLSConfig{AllowInsecure: true}); err != nil {
+			t.Errorf("Expected insecure credentials when explicitly allowed, got %v", err)
+		}
+		if _, err := ServerCredentials(TLSConfig{Enabled: true}); err == nil {
+			t.Error("Expected error when TLS is enabled without a key pair")
+		}
+	})
+}
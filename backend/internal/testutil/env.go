@@ -0,0 +1,229 @@
+// ============================================================================
+// backend/internal/testutil/env.go
+// Real services on in-memory gRPC connections over one test database
+// ============================================================================
+
+package testutil
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"net"
+	"testing"
+	"time"
+
+	"go.mongodb.org/mongo-driver/mongo"
+	"golang.org/x/crypto/bcrypt"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/test/bufconn"
+
+	"stdiscm_p4/backend/internal/admin"
+	"stdiscm_p4/backend/internal/auth"
+	"stdiscm_p4/backend/internal/course"
+	"stdiscm_p4/backend/internal/enrollment"
+	"stdiscm_p4/backend/internal/grade"
+	pb_admin "stdiscm_p4/backend/internal/pb/admin"
+	pb_auth "stdiscm_p4/backend/internal/pb/auth"
+	pb_course "stdiscm_p4/backend/internal/pb/course"
+	pb_enrollment "stdiscm_p4/backend/internal/pb/enrollment"
+	pb_grade "stdiscm_p4/backend/internal/pb/grade"
+	"stdiscm_p4/backend/internal/shared"
+)
+
+const bufSize = 1024 * 1024
+
+// testJWTSecret signs the tokens issued by the test Auth Service
+const testJWTSecret = "testutil-jwt-secret-not-for-production"
+
+// Env is one fresh database with every service running against it, each on its
+// own in-memory gRPC server, and a client for each
+type Env struct {
+	Client *mongo.Client
+	DB     *mongo.Database
+	Config *shared.ServiceConfig
+
+	Auth       pb_auth.AuthServiceClient
+	Course     pb_course.CourseServiceClient
+	Enrollment pb_enrollment.EnrollmentServiceClient
+	Grade      pb_grade.GradeServiceClient
+	Admin      pb_admin.AdminServiceClient
+}
+
+// Option customizes NewEnv
+type Option func(*envOptions)
+
+type envOptions struct {
+	courseClient pb_course.CourseServiceClient
+}
+
+// WithCourseClient gives the Enrollment Service a stub Course Service client
+// instead of the real one
+func WithCourseClient(c pb_course.CourseServiceClient) Option {
+	return func(o *envOptions) { o.courseClient = c }
+}
+
+// NewEnv starts the services against a new database, dropped when the test finishes
+func NewEnv(t testing.TB, opts ...Option) *Env {
+	t.Helper()
+
+	var o envOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	cfg := testConfig(StartMongo(t), "test_"+randomSuffix())
+	client, db, err := shared.ConnectMongoDB(&cfg.MongoDB)
+	if err != nil {
+		t.Fatalf("failed to connect to MongoDB: %v", err)
+	}
+	t.Cleanup(func() {
+		db.Drop(context.Background())
+		shared.DisconnectMongoDB(client)
+	})
+
+	env := &Env{Client: client, DB: db, Config: cfg}
+
+	authSvc := auth.NewAuthService(db, cfg)
+	courseSvc := course.NewCourseService(db)
+	gradeSvc := grade.NewGradeService(db)
+	adminSvc := admin.NewAdminService(client, db, cfg)
+
+	env.Auth = pb_auth.NewAuthServiceClient(serve(t, func(s *grpc.Server) {
+		pb_auth.RegisterAuthServiceServer(s, authSvc)
+	}, grpc.UnaryInterceptor(authSvc.UnaryInterceptor())))
+	env.Course = pb_course.NewCourseServiceClient(serve(t, func(s *grpc.Server) {
+		pb_course.RegisterCourseServiceServer(s, courseSvc)
+	}))
+	env.Grade = pb_grade.NewGradeServiceClient(serve(t, func(s *grpc.Server) {
+		pb_grade.RegisterGradeServiceServer(s, gradeSvc)
+	}))
+	env.Admin = pb_admin.NewAdminServiceClient(serve(t, func(s *grpc.Server) {
+		pb_admin.RegisterAdminServiceServer(s, adminSvc)
+	}))
+
+	courseClient := o.courseClient
+	if courseClient == nil {
+		courseClient = env.Course
+	}
+	enrollmentSvc := enrollment.NewEnrollmentService(client, db, courseClient)
+	env.Enrollment = pb_enrollment.NewEnrollmentServiceClient(serve(t, func(s *grpc.Server) {
+		pb_enrollment.RegisterEnrollmentServiceServer(s, enrollmentSvc)
+	}))
+
+	// Indexes as each service creates them at startup
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	for name, ensure := range map[string]func(context.Context) error{
+		"course":     courseSvc.EnsureIndexes,
+		"enrollment": enrollmentSvc.EnsureIndexes,
+		"grade":      gradeSvc.EnsureIndexes,
+		"admin":      adminSvc.EnsureIndexes,
+	} {
+		if err := ensure(ctx); err != nil {
+			t.Fatalf("failed to create %s indexes: %v", name, err)
+		}
+	}
+
+	return env
+}
+
+// Login signs in through the Auth Service and returns the session token
+func (e *Env) Login(t testing.TB, identifier, password string) *pb_auth.LoginResponse {
+	t.Helper()
+	resp, err := e.Auth.Login(context.Background(), &pb_auth.LoginRequest{Identifier: identifier, Password: password})
+	if err != nil {
+		t.Fatalf("login as %s failed: %v", identifier, err)
+	}
+	if !resp.Success || resp.Token == "" {
+		t.Fatalf("login as %s rejected: %s", identifier, resp.Message)
+	}
+	return resp
+}
+
+// UploadGrades streams grades (student ID -> grade) for a course as its faculty
+func (e *Env) UploadGrades(t testing.TB, courseID, facultyID string, grades map[string]string) *pb_grade.UploadGradesResponse {
+	t.Helper()
+	stream, err := e.Grade.UploadGrades(context.Background())
+	if err != nil {
+		t.Fatalf("failed to open grade upload: %v", err)
+	}
+	if err := stream.Send(&pb_grade.UploadGradeEntryRequest{
+		Payload: &pb_grade.UploadGradeEntryRequest_Metadata{Metadata: &pb_grade.UploadMetadata{CourseId: courseID, FacultyId: facultyID}},
+	}); err != nil {
+		t.Fatalf("failed to send upload metadata: %v", err)
+	}
+	for studentID, grade := range grades {
+		if err := stream.Send(&pb_grade.UploadGradeEntryRequest{
+			Payload: &pb_grade.UploadGradeEntryRequest_Entry{Entry: &pb_grade.GradeEntry{StudentId: studentID, Grade: grade}},
+		}); err != nil {
+			t.Fatalf("failed to send grade for %s: %v", studentID, err)
+		}
+	}
+	resp, err := stream.CloseAndRecv()
+	if err != nil {
+		t.Fatalf("grade upload failed: %v", err)
+	}
+	return resp
+}
+
+// WithToken attaches a session token the way the gateway forwards it
+func WithToken(ctx context.Context, token string) context.Context {
+	return metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+token)
+}
+
+// testConfig is the configuration every service in an Env shares
+func testConfig(uri, database string) *shared.ServiceConfig {
+	return &shared.ServiceConfig{
+		ServiceName: "testutil",
+		Environment: "test",
+		LogLevel:    "error",
+		MongoDB: shared.MongoConfig{
+			URI:            uri,
+			Database:       database,
+			ConnectTimeout: 20 * time.Second,
+			MaxPoolSize:    20,
+			MaxIdleTime:    30 * time.Second,
+		},
+		Security: shared.SecurityConfig{
+			JWTSecret:          testJWTSecret,
+			JWTKeys:            []shared.JWTKey{{ID: "v1", Secret: testJWTSecret}},
+			JWTExpirationHours: 1,
+			SessionTimeout:     30 * time.Minute,
+			BCryptCost:         bcrypt.MinCost,
+		},
+	}
+}
+
+// serve runs a gRPC server on an in-memory listener and returns a connection to it;
+// both are closed when the test finishes
+func serve(t testing.TB, register func(*grpc.Server), opts ...grpc.ServerOption) *grpc.ClientConn {
+	t.Helper()
+
+	lis := bufconn.Listen(bufSize)
+	server := grpc.NewServer(opts...)
+	register(server)
+	go server.Serve(lis)
+
+	conn, err := grpc.NewClient("passthrough://bufnet",
+		grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) { return lis.Dial() }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatalf("failed to dial in-memory server: %v", err)
+	}
+	t.Cleanup(func() {
+		conn.Close()
+		server.Stop()
+	})
+	return conn
+}
+
+// randomSuffix keeps database names apart when tests share a server
+func randomSuffix() string {
+	b := make([]byte, 6)
+	rand.Read(b)
+	return hex.EncodeToString(b)
+}
@@ -0,0 +1,243 @@
+// ============================================================================
+// backend/internal/testutil/fixtures.go
+// Fixture builders mirroring the data cmd/seeder loads
+// ============================================================================
+
+package testutil
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"golang.org/x/crypto/bcrypt"
+
+	"stdiscm_p4/backend/internal/shared"
+)
+
+// Identities, semesters and courses loaded by SeedCatalog, matching cmd/seeder
+const (
+	AdminID    = "admin-001"
+	FacultyID1 = "faculty-001"
+	FacultyID2 = "faculty-002"
+	StudentID1 = "student-001" // John Student, student@example.com
+	StudentID2 = "student-002" // Alice Wonderland, student2@example.com
+	StudentID3 = "student-003" // Bob Builder, student3@example.com
+
+	Password = "password" // every fixture user's password
+
+	CurrentSemester  = "Fall 2024"
+	PreviousSemester = "Spring 2024"
+
+	CS101ID       = "CS101_Fall24"
+	CS201ID       = "CS201_Fall24" // requires CS101PrevID
+	MATH101ID     = "MATH101_Fall24"
+	HIS101ID      = "HIS101_Fall24"
+	CS101PrevID   = "CS101_Spring24"
+	MATH101PrevID = "MATH101_Spring24"
+)
+
+// Student builds an active student; callers adjust fields before AddUser
+func Student(id, email, name, studentNumber string) shared.User {
+	return shared.User{
+		ID: id, Email: email, Name: name, Role: shared.RoleStudent, IsActive: true,
+		StudentID: studentNumber, Major: "Computer Science", YearLevel: 1,
+	}
+}
+
+// Faculty builds an active faculty member
+func Faculty(id, email, name, facultyNumber string) shared.User {
+	return shared.User{
+		ID: id, Email: email, Name: name, Role: shared.RoleFaculty, IsActive: true,
+		FacultyID: facultyNumber,
+	}
+}
+
+// Course builds an open course with the seeder's defaults (3 units, 30 seats);
+// an empty schedule makes it unscheduled
+func Course(id, code, semester, facultyID, schedule string) shared.Course {
+	now := time.Now()
+	return shared.Course{
+		ID: id, Code: code, Title: code, Units: 3, Capacity: 30,
+		Schedule: schedule, Room: "SCI-101", FacultyID: facultyID, IsOpen: true, Semester: semester,
+		Department:   shared.DepartmentFromCode(code),
+		ScheduleSlot: shared.NewScheduleSlot(schedule),
+		CreatedAt:    now, UpdatedAt: now,
+	}
+}
+
+// AddUser stores a user whose password is Password unless a hash is already set
+func (e *Env) AddUser(t testing.TB, u shared.User) shared.User {
+	t.Helper()
+	if u.PasswordHash == "" {
+		hash, err := bcrypt.GenerateFromPassword([]byte(Password), bcrypt.MinCost)
+		if err != nil {
+			t.Fatalf("failed to hash password: %v", err)
+		}
+		u.PasswordHash = string(hash)
+	}
+	if u.CreatedAt.IsZero() {
+		u.CreatedAt = time.Now()
+	}
+	if _, err := e.DB.Collection("users").InsertOne(context.Background(), u); err != nil {
+		t.Fatalf("failed to add user %s: %v", u.ID, err)
+	}
+	return u
+}
+
+// AddCourse stores a course as given
+func (e *Env) AddCourse(t testing.TB, c shared.Course) shared.Course {
+	t.Helper()
+	if _, err := e.DB.Collection("courses").InsertOne(context.Background(), c); err != nil {
+		t.Fatalf("failed to add course %s: %v", c.ID, err)
+	}
+	return c
+}
+
+// AddPrerequisite makes courseID require prereqID
+func (e *Env) AddPrerequisite(t testing.TB, courseID, prereqID string) {
+	t.Helper()
+	if _, err := e.DB.Collection("prerequisites").InsertOne(context.Background(), shared.Prerequisite{CourseID: courseID, PrereqID: prereqID}); err != nil {
+		t.Fatalf("failed to add prerequisite %s -> %s: %v", courseID, prereqID, err)
+	}
+}
+
+// AddEnrollment stores an enrollment in the course and counts its seat, as EnrollAll would
+func (e *Env) AddEnrollment(t testing.TB, id, studentID string, c shared.Course, status string) shared.Enrollment {
+	t.Helper()
+	ctx := context.Background()
+	enr := shared.Enrollment{
+		ID: id, StudentID: studentID, CourseID: c.ID, Status: status,
+		EnrolledAt:   time.Now().AddDate(0, 0, -1),
+		ScheduleInfo: shared.NewScheduleInfo(c.Schedule),
+	}
+	if status == shared.StatusCompleted {
+		enr.CompletedAt = time.Now()
+	}
+	if _, err := e.DB.Collection("enrollments").InsertOne(ctx, enr); err != nil {
+		t.Fatalf("failed to add enrollment %s: %v", id, err)
+	}
+	if status == shared.StatusEnrolled {
+		if _, err := e.DB.Collection("courses").UpdateOne(ctx, bson.M{"_id": c.ID}, bson.M{"$inc": bson.M{"enrolled": 1}}); err != nil {
+			t.Fatalf("failed to count enrollment %s: %v", id, err)
+		}
+	}
+	return enr
+}
+
+// AddPublishedGrade stores a published grade for the enrollment with the
+// denormalized fields the Grade Service reads
+func (e *Env) AddPublishedGrade(t testing.TB, enr shared.Enrollment, c shared.Course, grade string) {
+	t.Helper()
+	ctx := context.Background()
+
+	var student shared.User
+	e.DB.Collection("users").FindOne(ctx, bson.M{"_id": enr.StudentID}).Decode(&student)
+
+	now := time.Now()
+	_, err := e.DB.Collection("grades").InsertOne(ctx, bson.M{
+		"enrollment_id": enr.ID,
+		"grade":         grade,
+		"uploaded_by":   c.FacultyID,
+		"uploaded_at":   now,
+		"published":     true,
+		"published_at":  now,
+		"student_id":    enr.StudentID,
+		"student_name":  student.Name,
+		"course_id":     c.ID,
+		"course_code":   c.Code,
+		"course_title":  c.Title,
+		"units":         c.Units,
+		"semester":      c.Semester,
+		"term_key":      shared.SemesterSortKey(c.Semester),
+	})
+	if err != nil {
+		t.Fatalf("failed to add grade for %s: %v", enr.ID, err)
+	}
+}
+
+// SetConfig stores a system_config value
+func (e *Env) SetConfig(t testing.TB, key, value string) {
+	t.Helper()
+	_, err := e.DB.Collection("system_config").UpdateOne(context.Background(),
+		bson.M{"key": key},
+		bson.M{"$set": shared.SystemConfig{Key: key, Value: value, UpdatedAt: time.Now(), UpdatedBy: AdminID}},
+		options.Update().SetUpsert(true),
+	)
+	if err != nil {
+		t.Fatalf("failed to set config %s: %v", key, err)
+	}
+}
+
+// OpenEnrollment makes semester current with an enrollment period running from
+// a month ago to a month from now, as the seeder does
+func (e *Env) OpenEnrollment(t testing.TB, semester string) {
+	t.Helper()
+	now := time.Now()
+	e.SetConfig(t, shared.ConfigCurrentSemester, semester)
+	e.SetConfig(t, shared.ConfigEnrollmentStart, now.AddDate(0, -1, 0).Format(time.RFC3339))
+	e.SetConfig(t, shared.ConfigEnrollmentEnd, now.AddDate(0, 1, 0).Format(time.RFC3339))
+	e.SetConfig(t, "enrollment_enabled", "true")
+}
+
+// SeedCatalog loads the seeder's data set: departments, the six users, current
+// and previous semester courses, the CS201 prerequisite, enrollment and grade
+// history, and an open enrollment period for CurrentSemester
+func (e *Env) SeedCatalog(t testing.TB) {
+	t.Helper()
+	ctx := context.Background()
+
+	for _, d := range []shared.Department{
+		{Code: "CS", Name: "Computer Science"},
+		{Code: "MATH", Name: "Mathematics"},
+		{Code: "HIS", Name: "History"},
+		{Code: "IS", Name: "Information Systems"},
+	} {
+		d.CreatedAt = time.Now()
+		if _, err := e.DB.Collection("departments").InsertOne(ctx, d); err != nil {
+			t.Fatalf("failed to add department %s: %v", d.Code, err)
+		}
+	}
+
+	e.AddUser(t, shared.User{ID: AdminID, Name: "Super Admin", Email: "admin@example.com", Role: shared.RoleAdmin, IsActive: true})
+	fac1 := Faculty(FacultyID1, "faculty@example.com", "Dr. Jane Professor", "FAC-001")
+	fac1.Department = "Computer Science"
+	e.AddUser(t, fac1)
+	fac2 := Faculty(FacultyID2, "faculty2@example.com", "Prof. Alan Turing", "FAC-002")
+	fac2.Department = "Mathematics"
+	e.AddUser(t, fac2)
+	e.AddUser(t, Student(StudentID1, "student@example.com", "John Student", "202400001"))
+	alice := Student(StudentID2, "student2@example.com", "Alice Wonderland", "202400002")
+	alice.Major, alice.YearLevel = "Information Systems", 2
+	e.AddUser(t, alice)
+	bob := Student(StudentID3, "student3@example.com", "Bob Builder", "202400003")
+	bob.YearLevel = 3
+	e.AddUser(t, bob)
+
+	course := func(id, code, title string, units, capacity int32, facultyID, schedule string, open bool, semester string) shared.Course {
+		c := Course(id, code, semester, facultyID, schedule)
+		c.Title, c.Units, c.Capacity, c.IsOpen = title, units, capacity, open
+		return e.AddCourse(t, c)
+	}
+	cs101 := course(CS101ID, "CS-101", "Introduction to Programming", 3, 50, FacultyID1, "MWF 9:00-10:00", true, CurrentSemester)
+	course(CS201ID, "CS-201", "Data Structures & Algorithms", 3, 30, FacultyID1, "TTH 14:00-15:30", true, CurrentSemester)
+	math101 := course(MATH101ID, "MATH-101", "Calculus I", 4, 60, FacultyID2, "MW 11:00-12:30", true, CurrentSemester)
+	his101 := course(HIS101ID, "HIS-101", "World History", 3, 45, FacultyID2, "MWF 13:00-14:00", true, CurrentSemester)
+	cs101Prev := course(CS101PrevID, "CS-101", "Intro to Programming", 3, 50, FacultyID1, "MWF 9:00-10:00", false, PreviousSemester)
+	math101Prev := course(MATH101PrevID, "MATH-101", "Calculus I", 4, 60, FacultyID2, "MW 11:00-12:30", false, PreviousSemester)
+	e.AddPrerequisite(t, CS201ID, CS101PrevID)
+
+	// History: John passed CS101 and Alice passed MATH101 last semester
+	e.AddPublishedGrade(t, e.AddEnrollment(t, "ENR-1-"+StudentID1, StudentID1, cs101Prev, shared.StatusCompleted), cs101Prev, shared.GradeA)
+	e.AddPublishedGrade(t, e.AddEnrollment(t, "ENR-2-"+StudentID2, StudentID2, math101Prev, shared.StatusCompleted), math101Prev, shared.GradeB)
+
+	// Current semester
+	e.AddEnrollment(t, "ENR-3-"+StudentID1, StudentID1, math101, shared.StatusEnrolled)
+	e.AddEnrollment(t, "ENR-4-"+StudentID2, StudentID2, cs101, shared.StatusEnrolled)
+	e.AddEnrollment(t, "ENR-5-"+StudentID3, StudentID3, cs101, shared.StatusEnrolled)
+	e.AddEnrollment(t, "ENR-6-"+StudentID3, StudentID3, his101, shared.StatusEnrolled)
+
+	e.OpenEnrollment(t, CurrentSemester)
+}
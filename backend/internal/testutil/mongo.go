@@ -0,0 +1,208 @@
+// ============================================================================
+// backend/internal/testutil/mongo.go
+// Throwaway MongoDB servers for integration and end-to-end tests
+// ============================================================================
+
+// Package testutil runs the real services against a throwaway MongoDB so tests
+// can exercise whole flows across services.
+//
+// The database server comes from the first of these that is available:
+//   - TEST_MONGO_URI: an existing server (each Env still gets its own database)
+//   - mongod on PATH, or the binary named by TEST_MONGOD: a single-node replica
+//     set started in a temporary directory
+//   - docker on PATH: a container from TEST_MONGO_IMAGE (default mongo:7)
+//
+// TEST_MONGO=uri|mongod|docker picks one explicitly. Tests are skipped when no
+// server can be found, so packages using the harness stay green without one.
+package testutil
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// replSetName is the single-node replica set started servers run as, so
+// shared.WithTransaction gets real transactions
+const replSetName = "rs0"
+
+// defaultMongoImage is the container image used when TEST_MONGO_IMAGE is unset
+const defaultMongoImage = "mongo:7"
+
+// mongoStartTimeout bounds how long a started server has to accept writes
+const mongoStartTimeout = 60 * time.Second
+
+// StartMongo returns the URI of a MongoDB server for the test, starting one when
+// needed. A started server is stopped when the test finishes. The test is
+// skipped when no server is available.
+func StartMongo(t testing.TB) string {
+	t.Helper()
+
+	mode := strings.ToLower(os.Getenv("TEST_MONGO"))
+	if mode == "" {
+		mode = detectMongoMode()
+	}
+
+	var uri string
+	var err error
+	switch mode {
+	case "uri":
+		uri = os.Getenv("TEST_MONGO_URI")
+		if uri == "" {
+			t.Fatal("TEST_MONGO=uri needs TEST_MONGO_URI")
+		}
+	case "mongod":
+		uri, err = startMongod(t)
+	case "docker":
+		uri, err = startMongoContainer(t)
+	case "":
+		t.Skip("no MongoDB available: set TEST_MONGO_URI, or install mongod or docker")
+	default:
+		t.Fatalf("unknown TEST_MONGO mode %q (want uri, mongod or docker)", mode)
+	}
+	if err != nil {
+		t.Fatalf("failed to start MongoDB (%s): %v", mode, err)
+	}
+	return uri
+}
+
+// detectMongoMode picks the first available way to get a server, or "" when there is none
+func detectMongoMode() string {
+	if os.Getenv("TEST_MONGO_URI") != "" {
+		return "uri"
+	}
+	if _, err := exec.LookPath(mongodBinary()); err == nil {
+		return "mongod"
+	}
+	if _, err := exec.LookPath("docker"); err == nil {
+		return "docker"
+	}
+	return ""
+}
+
+func mongodBinary() string {
+	if bin := os.Getenv("TEST_MONGOD"); bin != "" {
+		return bin
+	}
+	return "mongod"
+}
+
+// startMongod runs a local mongod on a free port with its data in a temporary directory
+func startMongod(t testing.TB) (string, error) {
+	port, err := freePort()
+	if err != nil {
+		return "", err
+	}
+	dir := t.TempDir()
+
+	cmd := exec.Command(mongodBinary(),
+		"--dbpath", dir,
+		"--port", strconv.Itoa(port),
+		"--bind_ip", "127.0.0.1",
+		"--replSet", replSetName,
+		"--logpath", filepath.Join(dir, "mongod.log"),
+	)
+	if err := cmd.Start(); err != nil {
+		return "", err
+	}
+	// Registered after TempDir, so the server stops before its directory is removed
+	t.Cleanup(func() {
+		cmd.Process.Kill()
+		cmd.Wait()
+	})
+
+	uri := fmt.Sprintf("mongodb://127.0.0.1:%d/?directConnection=true", port)
+	if err := initReplicaSet(uri, fmt.Sprintf("127.0.0.1:%d", port)); err != nil {
+		return "", err
+	}
+	return uri, nil
+}
+
+// startMongoContainer runs a mongo container published on a random local port
+func startMongoContainer(t testing.TB) (string, error) {
+	image := os.Getenv("TEST_MONGO_IMAGE")
+	if image == "" {
+		image = defaultMongoImage
+	}
+
+	out, err := exec.Command("docker", "run", "-d", "--rm", "-p", "127.0.0.1::27017", image,
+		"--replSet", replSetName, "--bind_ip_all").Output()
+	if err != nil {
+		return "", fmt.Errorf("docker run: %w", err)
+	}
+	id := strings.TrimSpace(string(out))
+	t.Cleanup(func() { exec.Command("docker", "rm", "-f", id).Run() })
+
+	out, err = exec.Command("docker", "port", id, "27017/tcp").Output()
+	if err != nil {
+		return "", fmt.Errorf("docker port: %w", err)
+	}
+	// One line per published address, e.g. "127.0.0.1:49153"
+	addr := strings.TrimSpace(strings.SplitN(string(out), "\n", 2)[0])
+
+	uri := fmt.Sprintf("mongodb://%s/?directConnection=true", addr)
+	if err := initReplicaSet(uri, "127.0.0.1:27017"); err != nil {
+		return "", err
+	}
+	return uri, nil
+}
+
+// initReplicaSet waits for the server to come up, initiates a single-member
+// replica set whose member is reachable at host, and waits for it to become primary
+func initReplicaSet(uri, host string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), mongoStartTimeout)
+	defer cancel()
+
+	client, err := mongo.Connect(ctx, options.Client().ApplyURI(uri).SetServerSelectionTimeout(time.Second))
+	if err != nil {
+		return err
+	}
+	defer client.Disconnect(context.Background())
+	admin := client.Database("admin")
+
+	config := bson.M{"_id": replSetName, "members": bson.A{bson.M{"_id": 0, "host": host}}}
+	for {
+		err := admin.RunCommand(ctx, bson.D{{Key: "replSetInitiate", Value: config}}).Err()
+		if err == nil || strings.Contains(err.Error(), "already initialized") {
+			break
+		}
+		if ctx.Err() != nil {
+			return fmt.Errorf("replSetInitiate: %w", err)
+		}
+		time.Sleep(250 * time.Millisecond)
+	}
+
+	for {
+		var hello struct {
+			IsWritablePrimary bool `bson:"isWritablePrimary"`
+		}
+		if err := admin.RunCommand(ctx, bson.D{{Key: "hello", Value: 1}}).Decode(&hello); err == nil && hello.IsWritablePrimary {
+			return nil
+		}
+		if ctx.Err() != nil {
+			return fmt.Errorf("replica set did not elect a primary within %s", mongoStartTimeout)
+		}
+		time.Sleep(250 * time.Millisecond)
+	}
+}
+
+// freePort asks the kernel for an unused local TCP port
+func freePort() (int, error) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return 0, err
+	}
+	defer l.Close()
+	return l.Addr().(*net.TCPAddr).Port, nil
+}
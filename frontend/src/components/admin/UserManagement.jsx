@@ -1,5 +1,6 @@
-import React, { useState } from 'react';
+import React, { useState, useEffect } from 'react';
 import { useAdmin } from '../../hooks/useAdmin';
+import { courseService } from '../../services/courseService';
 import { UserPlus } from 'lucide-react';
 import Loader from '../common/Loader';
 
@@ -9,6 +10,14 @@ const UserManagement = () => {
     name: '', email: '', password: 'password', role: 'student',
     student_id: '', faculty_id: '', department: ''
   });
+  const [departments, setDepartments] = useState([]);
+
+  // Faculty departments must be one of the registered departments
+  useEffect(() => {
+    courseService.listDepartments()
+      .then(data => setDepartments(data.departments || []))
+      .catch(() => setDepartments([]));
+  }, []);
 
   const handleSubmit = async (e) => {
     e.preventDefault();
@@ -87,11 +96,16 @@ const UserManagement = () => {
               value={formData.faculty_id} onChange={e => setFormData({...formData, faculty_id: e.target.value})}
               disabled={loading}
             />
-            <input 
-              type="text" placeholder="Department" className="input-field" required
+            <select
+              className="input-field" required
               value={formData.department} onChange={e => setFormData({...formData, department: e.target.value})}
               disabled={loading}
-            />
+            >
+              <option value="">Department</option>
+              {departments.map(dept => (
+                <option key={dept.code} value={dept.name}>{dept.name}</option>
+              ))}
+            </select>
           </div>
         )}
 
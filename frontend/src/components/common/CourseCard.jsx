@@ -2,7 +2,7 @@ import React from 'react';
 import { BookOpen, Users, Clock, MapPin } from 'lucide-react';
 
 const CourseCard = ({ course, actionButton, isInCart = false }) => {
-  const seatsAvailable = Math.max(0, course.capacity - course.enrolled);
+  const seatsAvailable = course.seats_available ?? Math.max(0, course.capacity - course.enrolled);
   const isFull = seatsAvailable === 0;
 
   return (
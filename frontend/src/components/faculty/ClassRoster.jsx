@@ -12,6 +12,8 @@ const ClassRoster = () => {
   const { user } = useAuth();
   const [course, setCourse] = useState(null);
   const [roster, setRoster] = useState([]);
+  const [withdrawn, setWithdrawn] = useState([]);
+  const [summary, setSummary] = useState(null);
   const [loading, setLoading] = useState(true);
   const [error, setError] = useState(null);
 
@@ -33,6 +35,8 @@ const ClassRoster = () => {
       // Load roster
       const rosterData = await gradeService.getClassRoster(courseId);
       setRoster(rosterData.students || []);
+      setWithdrawn(rosterData.withdrawn || []);
+      setSummary(rosterData.summary || null);
     } catch (err) {
       setError(err.message);
     } finally {
@@ -71,6 +75,13 @@ const ClassRoster = () => {
                   <Users className="h-4 w-4 mr-2" />
                   <span>{roster.length} students</span>
                 </div>
+                {summary && (
+                  <div className="flex items-center text-sm text-gray-600">
+                    <span>
+                      {summary.graded ?? 0} graded, {summary.ungraded ?? 0} ungraded, {summary.published ?? 0} published
+                    </span>
+                  </div>
+                )}
                 <div className="flex items-center text-sm text-gray-600">
                   <Calendar className="h-4 w-4 mr-2" />
                   <span>{course.semester}</span>
@@ -176,6 +187,28 @@ const ClassRoster = () => {
           </div>
         )}
       </div>
+
+      {/* Students withdrawn with a W grade */}
+      {withdrawn.length > 0 && (
+        <div className="card overflow-hidden">
+          <div className="px-6 py-4 border-b border-gray-200">
+            <h3 className="text-lg font-semibold text-gray-900">Withdrawn ({withdrawn.length})</h3>
+          </div>
+          <ul className="divide-y divide-gray-200">
+            {withdrawn.map((student) => (
+              <li key={student.student_id} className="px-6 py-3 flex items-center justify-between">
+                <div>
+                  <div className="text-sm font-medium text-gray-900">{student.student_name}</div>
+                  <div className="text-sm text-gray-500">{student.student_id}</div>
+                </div>
+                <span className="inline-flex items-center px-2.5 py-0.5 rounded-full text-xs font-medium bg-gray-100 text-gray-800">
+                  W
+                </span>
+              </li>
+            ))}
+          </ul>
+        </div>
+      )}
     </div>
   );
 };
@@ -118,7 +118,7 @@ const FacultyCoursesView = () => {
             <div className="pt-4 border-t">
               <div className="flex justify-between items-center">
                 <span className="text-sm text-gray-600">
-                  Seats available: {Math.max(0, course.capacity - course.enrolled)}
+                  Seats available: {course.seats_available ?? Math.max(0, course.capacity - course.enrolled)}
                 </span>
                 <button
                   onClick={() => window.location.href = `/faculty/roster/${course.id}`}
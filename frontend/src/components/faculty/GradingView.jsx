@@ -51,7 +51,8 @@ const FacultyGradingView = () => {
       setLoading(true);
       setError(null);
       const data = await gradeService.getClassRoster(courseId);
-      const rosterData = data.students || [];
+      // Withdrawn students stay gradable so a W can be corrected
+      const rosterData = [...(data.students || []), ...(data.withdrawn || [])];
       setRoster(rosterData);
       
       // Initialize grades object
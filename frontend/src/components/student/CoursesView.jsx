@@ -1,5 +1,6 @@
 import React, { useState } from 'react';
 import { useCourses } from '../../hooks/useCourses';
+import { courseService } from '../../services/courseService';
 import { useEnrollment } from '../../hooks/useEnrollment';
 import { useAuth } from '../../hooks/useAuth';
 import CourseCard from '../common/CourseCard';
@@ -12,26 +13,22 @@ const CoursesView = () => {
     search: '',
     open_only: true,
     semester: '',
+    department: '',
   });
   const [searchInput, setSearchInput] = useState('');
   const [departments, setDepartments] = useState([]);
-  const [selectedDept, setSelectedDept] = useState('');
   
   const { user } = useAuth();
   const { courses, loading, error, reload } = useCourses(filters);
   const { addToCart, removeFromCart, cart } = useEnrollment();
   const [processing, setProcessing] = useState({});
 
-  // Extract unique departments from courses
+  // Departments come from the catalog, not from the courses on screen
   React.useEffect(() => {
-    if (courses.length > 0) {
-      const depts = [...new Set(courses
-        .map(c => c.code?.split(' ')[0])
-        .filter(Boolean)
-      )].sort();
-      setDepartments(depts);
-    }
-  }, [courses]);
+    courseService.listDepartments()
+      .then(data => setDepartments(data.departments || []))
+      .catch(() => setDepartments([]));
+  }, []);
 
   const handleSearch = (e) => {
     e.preventDefault();
@@ -66,13 +63,6 @@ const CoursesView = () => {
     return cart?.courseIds?.includes(courseId);
   };
 
-  const filteredCourses = courses.filter(course => {
-    if (selectedDept && !course.code?.startsWith(selectedDept)) {
-      return false;
-    }
-    return true;
-  });
-
   if (loading) {
     return <Loader fullScreen text="Loading courses..." />;
   }
@@ -117,13 +107,13 @@ const CoursesView = () => {
             <div className="flex items-center gap-2">
               <Filter className="h-5 w-5 text-gray-500" />
               <select
-                value={selectedDept}
-                onChange={(e) => setSelectedDept(e.target.value)}
+                value={filters.department}
+                onChange={(e) => setFilters(prev => ({ ...prev, department: e.target.value }))}
                 className="input-field"
               >
                 <option value="">All Departments</option>
                 {departments.map(dept => (
-                  <option key={dept} value={dept}>{dept}</option>
+                  <option key={dept.code} value={dept.code}>{dept.name}</option>
                 ))}
               </select>
             </div>
@@ -143,10 +133,10 @@ const CoursesView = () => {
 
       {/* Courses Grid */}
       <div className="grid grid-cols-1 md:grid-cols-2 lg:grid-cols-3 gap-6">
-        {filteredCourses.map((course) => {
+        {courses.map((course) => {
           const inCart = isInCart(course.id);
           const isProcessing = processing[course.id];
-          const seatsAvailable = Math.max(0, course.capacity - course.enrolled);
+          const seatsAvailable = course.seats_available ?? Math.max(0, course.capacity - course.enrolled);
           const isFull = seatsAvailable === 0;
           const isOpen = course.is_open && !isFull;
 
@@ -199,7 +189,7 @@ const CoursesView = () => {
         })}
       </div>
 
-      {filteredCourses.length === 0 && !loading && (
+      {courses.length === 0 && !loading && (
         <div className="text-center py-12">
           <Search className="h-12 w-12 text-gray-300 mx-auto mb-4" />
           <h3 className="text-lg font-medium text-gray-900 mb-2">No courses found</h3>
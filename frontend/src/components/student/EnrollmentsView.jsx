@@ -6,12 +6,16 @@ import Loader from '../common/Loader';
 import { BookOpen, Clock, MapPin, Users, Trash2, CheckCircle } from 'lucide-react';
 
 const EnrollmentsView = () => {
-  const { enrollments, dropCourse, loading, error } = useEnrollment();
+  const { enrollments, dropLimit, dropCourse, loading, error } = useEnrollment();
   const { courses } = useCourses({});
   const [dropping, setDropping] = useState(null);
 
   const handleDrop = async (courseId) => {
-    if (!window.confirm('Are you sure you want to drop this course?')) {
+    let message = 'Are you sure you want to drop this course?';
+    if (dropLimit?.remaining === 1) {
+      message += `\n\nThis is your last drop allowed for ${dropLimit.semester || 'this semester'}.`;
+    }
+    if (!window.confirm(message)) {
       return;
     }
 
@@ -8,7 +8,7 @@ import CourseCard from '../common/CourseCard';
 import { CheckCircle, Trash2, AlertTriangle, ShoppingCart as CartIcon } from 'lucide-react';
 
 const ShoppingCart = () => {
-  const { cart, enroll, removeFromCart, loading, error } = useEnrollment();
+  const { cart, cartLimits, enroll, removeFromCart, loading, error } = useEnrollment();
   const { courses } = useCourses({});
   const [enrolling, setEnrolling] = useState(false);
   const [removing, setRemoving] = useState(null);
@@ -21,6 +21,10 @@ const ShoppingCart = () => {
     return cartCourses.reduce((total, course) => total + (course.units || 0), 0);
   };
 
+  // Limits come from system config; until they load, the server still enforces them
+  const maxUnits = cartLimits?.maxUnits;
+  const exceedsMaxUnits = maxUnits != null && calculateTotalUnits() > maxUnits;
+
   const handleEnroll = async () => {
     if (!window.confirm('Are you sure you want to enroll in all courses in your cart?')) {
       return;
@@ -81,7 +85,7 @@ const ShoppingCart = () => {
         <>
           <div className="space-y-4">
             {cartCourses.map((course) => {
-              const seatsAvailable = Math.max(0, course.capacity - course.enrolled);
+              const seatsAvailable = course.seats_available ?? Math.max(0, course.capacity - course.enrolled);
               const isFull = seatsAvailable === 0;
               const isOpen = course.is_open && !isFull;
 
@@ -119,15 +123,15 @@ const ShoppingCart = () => {
                 <h3 className="text-lg font-semibold text-gray-900">Cart Summary</h3>
                 <div className="mt-2 space-y-1">
                   <p className="text-sm text-gray-600">
-                    {cartCourses.length} course(s) selected
+                    {cartCourses.length}{cartLimits?.maxCourses ? ` of ${cartLimits.maxCourses}` : ''} course(s) selected
                   </p>
                   <p className="text-sm text-gray-600">
                     Total units: {calculateTotalUnits()}
                   </p>
-                  {calculateTotalUnits() > 18 && (
+                  {exceedsMaxUnits && (
                     <p className="text-sm text-yellow-600 flex items-center">
                       <AlertTriangle className="h-4 w-4 mr-1" />
-                      Exceeds maximum units per semester (18)
+                      Exceeds maximum units per semester ({maxUnits})
                     </p>
                   )}
                 </div>
@@ -142,7 +146,7 @@ const ShoppingCart = () => {
                 </button>
                 <button
                   onClick={handleEnroll}
-                  disabled={enrolling || calculateTotalUnits() > 18}
+                  disabled={enrolling || exceedsMaxUnits}
                   className="btn-primary flex items-center justify-center"
                 >
                   {enrolling ? (
@@ -174,6 +178,14 @@ const ShoppingCart = () => {
               message={`You are missing prerequisites for: ${cart.validation_results.missing_prerequisites.join(', ')}`}
             />
           )}
+
+          {cart?.warnings?.length > 0 && (
+            <Alert
+              type="info"
+              title="Unit Load Notice"
+              message={cart.warnings.join(' ')}
+            />
+          )}
         </>
       )}
     </div>
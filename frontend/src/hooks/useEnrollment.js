@@ -6,6 +6,8 @@ export const useEnrollment = () => {
   const { user } = useAuth();
   const [cart, setCart] = useState(null);
   const [enrollments, setEnrollments] = useState([]);
+  const [dropLimit, setDropLimit] = useState(null); // null when drops are not limited
+  const [cartLimits, setCartLimits] = useState(null); // system config limits, from GET /cart
   const [loading, setLoading] = useState(true);
   const [error, setError] = useState(null);
 
@@ -16,6 +18,7 @@ export const useEnrollment = () => {
     try {
       const data = await enrollmentService.getCart(user.id);
       setCart(data.cart);
+      setCartLimits({ maxCourses: data.max_courses, maxUnits: data.max_units });
     } catch (err) {
       // Handle "cart not found" or empty cart gracefully
       if (err.message.includes("not found") || err.message.includes("empty")) {
@@ -34,6 +37,11 @@ export const useEnrollment = () => {
       setLoading(true);
       const data = await enrollmentService.getEnrollments(user.id);
       setEnrollments(data.enrollments || []);
+      setDropLimit(
+        data.max_drops_per_semester
+          ? { max: data.max_drops_per_semester, remaining: data.remaining_drops, semester: data.drops_semester }
+          : null
+      );
     } catch (err) {
       setError(err.message);
     } finally {
@@ -113,6 +121,8 @@ export const useEnrollment = () => {
   return {
     cart,
     enrollments,
+    dropLimit,
+    cartLimits,
     loading,
     error,
     addToCart,
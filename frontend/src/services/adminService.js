@@ -4,7 +4,9 @@ export const adminService = {
   // --- User Management ---
   createUser: async (userData) => {
     // userData: { name, email, role, password, student_id?, faculty_id?, department? }
-    return api.post("/admin/users", userData);
+    // The gateway rejects unknown fields and sets the initial password itself
+    const { password, ...payload } = userData;
+    return api.post("/admin/users", payload);
   },
 
   getAllUsers: async () => {
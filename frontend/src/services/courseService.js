@@ -17,6 +17,10 @@ export const courseService = {
     return api.get(`/courses/${id}`);
   },
 
+  listDepartments: async () => {
+    return api.get("/departments");
+  },
+
   getAvailability: async (id) => {
     return api.get(`/courses/${id}/availability`);
   },
@@ -6,6 +6,11 @@ export const enrollmentService = {
     return api.get("/cart");
   },
 
+  getCartSummary: async () => {
+    // Item count and total units only, for the cart badge
+    return api.get("/cart/summary");
+  },
+
   addToCart: async (studentId, courseId) => {
     // FIX: Path is /cart/add, removed studentId from body
     return api.post("/cart/add", { course_id: courseId });
@@ -29,6 +29,3 @@ export const GRADE_OPTIONS = [
   { value: 'I', label: 'I - Incomplete' },
   { value: 'W', label: 'W - Withdrawn' },
 ];
-
-export const MAX_CART_COURSES = 6;
-export const MAX_UNITS_PER_SEMESTER = 18;
\ No newline at end of file